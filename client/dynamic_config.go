@@ -46,6 +46,7 @@ var backwardCompatibleNames = map[string]names{
 	"linuxConfig.Interface":            names{protoName: "interfaces", jsonName: "interfaces"},
 	"linuxConfig.ARPEntry":             names{protoName: "arp_entries", jsonName: "arpEntries"},
 	"linuxConfig.Route":                names{protoName: "routes", jsonName: "routes"},
+	"linuxConfig.Rule":                 names{protoName: "rules", jsonName: "rules"},
 	"linuxConfig.RuleChain":            names{protoName: "RuleChain", jsonName: "RuleChain"},
 	"vppConfig.ABF":                    names{protoName: "abfs", jsonName: "abfs"},
 	"vppConfig.ACL":                    names{protoName: "acls", jsonName: "acls"},
@@ -69,10 +70,16 @@ var backwardCompatibleNames = map[string]names{
 	"vppConfig.DHCPProxy":              names{protoName: "dhcp_proxies", jsonName: "dhcpProxies"},
 	"vppConfig.L3XConnect":             names{protoName: "l3xconnects", jsonName: "l3xconnects"},
 	"vppConfig.TeibEntry":              names{protoName: "teib_entries", jsonName: "teibEntries"},
+	"vppConfig.MRoute":                 names{protoName: "mroutes", jsonName: "mroutes"},
 	"vppConfig.Nat44Global":            names{protoName: "nat44_global", jsonName: "nat44Global"},
 	"vppConfig.DNat44":                 names{protoName: "dnat44s", jsonName: "dnat44s"},
 	"vppConfig.Nat44Interface":         names{protoName: "nat44_interfaces", jsonName: "nat44Interfaces"},
 	"vppConfig.Nat44AddressPool":       names{protoName: "nat44_pools", jsonName: "nat44Pools"},
+	"vppConfig.Nat64IPv6Prefix":        names{protoName: "nat64_prefixes", jsonName: "nat64Prefixes"},
+	"vppConfig.Nat64Interface":         names{protoName: "nat64_interfaces", jsonName: "nat64Interfaces"},
+	"vppConfig.Nat64AddressPool":       names{protoName: "nat64_pools", jsonName: "nat64Pools"},
+	"vppConfig.Nat64StaticBIB":         names{protoName: "nat64_static_bibs", jsonName: "nat64StaticBibs"},
+	"vppConfig.Det44Mapping":           names{protoName: "det44_mappings", jsonName: "det44Mappings"},
 	"vppConfig.IPRedirect":             names{protoName: "punt_ipredirects", jsonName: "puntIpredirects"},
 	"vppConfig.ToHost":                 names{protoName: "punt_tohosts", jsonName: "puntTohosts"},
 	"vppConfig.Exception":              names{protoName: "punt_exceptions", jsonName: "puntExceptions"},
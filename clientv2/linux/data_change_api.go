@@ -19,6 +19,11 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+	linux_punt "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
@@ -64,8 +69,22 @@ type PutDSL interface {
 	LinuxArpEntry(val *linux_l3.ARPEntry) PutDSL
 	// LinuxRoute adds a request to crete or update Linux route
 	LinuxRoute(val *linux_l3.Route) PutDSL
+	// LinuxRule adds a request to create or update Linux policy routing rule
+	LinuxRule(val *linux_l3.Rule) PutDSL
 	// IptablesRuleChain adds request to create or update iptables rule chain.
 	IptablesRuleChain(val *linux_iptables.RuleChain) PutDSL
+	// NftablesTable adds request to create or update nftables table.
+	NftablesTable(val *linux_nftables.Table) PutDSL
+	// LinuxSysctl adds request to create or update Linux kernel network setting.
+	LinuxSysctl(val *linux_sysctl.Sysctl) PutDSL
+	// TcQdisc adds request to create or update Linux tc qdisc.
+	TcQdisc(val *linux_tc.Qdisc) PutDSL
+	// TcClass adds request to create or update Linux tc class.
+	TcClass(val *linux_tc.Class) PutDSL
+	// TcFilter adds request to create or update Linux tc filter.
+	TcFilter(val *linux_tc.Filter) PutDSL
+	// PuntProxy adds request to create or update Linux punt proxy.
+	PuntProxy(val *linux_punt.Proxy) PutDSL
 
 	// VppInterface adds a request to create or update VPP network interface.
 	VppInterface(val *vpp_interfaces.Interface) PutDSL
@@ -114,6 +133,16 @@ type PutDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) PutDSL
 	// NAT44AddressPool adds a request to create or update NAT44 address pool.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) PutDSL
+	// NAT64IPv6Prefix adds a request to create or update NAT64 IPv6 prefix.
+	NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) PutDSL
+	// NAT64Interface adds a request to create or update NAT64 interface configuration.
+	NAT64Interface(natIf *nat.Nat64Interface) PutDSL
+	// NAT64AddressPool adds a request to create or update NAT64 address pool.
+	NAT64AddressPool(pool *nat.Nat64AddressPool) PutDSL
+	// NAT64StaticBIB adds a request to create or update NAT64 static BIB entry.
+	NAT64StaticBIB(bib *nat.Nat64StaticBIB) PutDSL
+	// DET44Mapping adds a request to create or update DET44 mapping.
+	DET44Mapping(mapping *nat.Det44Mapping) PutDSL
 	// IPSecSA adds request to create a new Security Association
 	IPSecSA(sa *ipsec.SecurityAssociation) PutDSL
 	// IPSecSPD adds request to create a new Security Policy Database
@@ -136,6 +165,8 @@ type PutDSL interface {
 	FlowprobeFeature(val *ipfix.FlowProbeFeature) PutDSL
 	// VRRP adds a request to create or update VPP L3 VRRP.
 	VRRP(val *vpp_l3.VRRPEntry) PutDSL
+	// MRoute adds a request to create or update VPP L3 multicast route.
+	MRoute(val *vpp_l3.MRoute) PutDSL
 
 	// Delete changes the DSL mode to allow removing an existing configuration.
 	// See documentation for DataChangeDSL.Delete().
@@ -155,8 +186,23 @@ type DeleteDSL interface {
 	LinuxArpEntry(ifaceName string, ipAddr string) DeleteDSL
 	// LinuxRoute adds a request to delete Linux route
 	LinuxRoute(dstAddr, outIfaceName string) DeleteDSL
+	// LinuxRule adds a request to delete Linux policy routing rule
+	LinuxRule(family linux_l3.Rule_AddressFamily, priority uint32, ns *linux_namespace.NetNamespace) DeleteDSL
 	// IptablesRuleChain adds request to delete iptables rule chain.
 	IptablesRuleChain(name string) DeleteDSL
+	// NftablesTable adds request to delete nftables table.
+	NftablesTable(name string) DeleteDSL
+	// LinuxSysctl adds request to delete Linux kernel network setting
+	// (global if ifaceName is empty, per-interface otherwise).
+	LinuxSysctl(name, ifaceName string, ns *linux_namespace.NetNamespace) DeleteDSL
+	// TcQdisc adds request to delete Linux tc qdisc.
+	TcQdisc(ifaceName, parent string) DeleteDSL
+	// TcClass adds request to delete Linux tc class.
+	TcClass(ifaceName, classID string) DeleteDSL
+	// TcFilter adds request to delete Linux tc filter.
+	TcFilter(ifaceName, parent string, priority uint32) DeleteDSL
+	// PuntProxy adds request to delete Linux punt proxy.
+	PuntProxy(name string) DeleteDSL
 
 	// VppInterface adds a request to delete an existing VPP network interface.
 	VppInterface(ifaceName string) DeleteDSL
@@ -207,6 +253,16 @@ type DeleteDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) DeleteDSL
 	// NAT44AddressPool adds a request to delete NAT44 address pool.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) DeleteDSL
+	// NAT64IPv6Prefix adds a request to delete NAT64 IPv6 prefix.
+	NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) DeleteDSL
+	// NAT64Interface adds a request to delete NAT64 interface configuration.
+	NAT64Interface(natIf *nat.Nat64Interface) DeleteDSL
+	// NAT64AddressPool adds a request to delete NAT64 address pool.
+	NAT64AddressPool(pool *nat.Nat64AddressPool) DeleteDSL
+	// NAT64StaticBIB adds a request to delete NAT64 static BIB entry.
+	NAT64StaticBIB(bib *nat.Nat64StaticBIB) DeleteDSL
+	// DET44Mapping adds a request to delete DET44 mapping.
+	DET44Mapping(mapping *nat.Det44Mapping) DeleteDSL
 	// IPSecSA adds request to delete a Security Association
 	IPSecSA(saIndex uint32) DeleteDSL
 	// IPSecSPD adds request to delete a Security Policy Database
@@ -225,6 +281,8 @@ type DeleteDSL interface {
 	FlowprobeFeature(val *ipfix.FlowProbeFeature) DeleteDSL
 	// VRRP adds a request to delete VPP L3 VRRP.
 	VRRP(val *vpp_l3.VRRPEntry) DeleteDSL
+	// MRoute adds a request to delete VPP L3 multicast route.
+	MRoute(val *vpp_l3.MRoute) DeleteDSL
 
 	// Put changes the DSL mode to allow configuration editing.
 	// See documentation for DataChangeDSL.Put().
@@ -19,6 +19,10 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+	linux_punt "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 	vpp_abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	vpp_acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
@@ -44,8 +48,22 @@ type DataResyncDSL interface {
 	LinuxArpEntry(arp *linux_l3.ARPEntry) DataResyncDSL
 	// LinuxInterface adds Linux route to the RESYNC request.
 	LinuxRoute(route *linux_l3.Route) DataResyncDSL
+	// LinuxRule adds Linux policy routing rule to the RESYNC request.
+	LinuxRule(rule *linux_l3.Rule) DataResyncDSL
 	// IptablesRuleChain adds iptables rule chain to the RESYNC request.
 	IptablesRuleChain(val *linux_iptables.RuleChain) DataResyncDSL
+	// NftablesTable adds nftables table to the RESYNC request.
+	NftablesTable(val *linux_nftables.Table) DataResyncDSL
+	// LinuxSysctl adds Linux kernel network setting to the RESYNC request.
+	LinuxSysctl(val *linux_sysctl.Sysctl) DataResyncDSL
+	// TcQdisc adds Linux tc qdisc to the RESYNC request.
+	TcQdisc(val *linux_tc.Qdisc) DataResyncDSL
+	// TcClass adds Linux tc class to the RESYNC request.
+	TcClass(val *linux_tc.Class) DataResyncDSL
+	// TcFilter adds Linux tc filter to the RESYNC request.
+	TcFilter(val *linux_tc.Filter) DataResyncDSL
+	// PuntProxy adds Linux punt proxy to the RESYNC request.
+	PuntProxy(val *linux_punt.Proxy) DataResyncDSL
 
 	// VppInterface adds VPP interface to the RESYNC request.
 	VppInterface(intf *vpp_interfaces.Interface) DataResyncDSL
@@ -94,6 +112,16 @@ type DataResyncDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) DataResyncDSL
 	// NAT44AddressPool adds NAT44 address pool configuration to the RESYNC request.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) DataResyncDSL
+	// NAT64IPv6Prefix adds NAT64 IPv6 prefix to the RESYNC request.
+	NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) DataResyncDSL
+	// NAT64Interface adds NAT64 interface configuration to the RESYNC request.
+	NAT64Interface(natIf *nat.Nat64Interface) DataResyncDSL
+	// NAT64AddressPool adds NAT64 address pool to the RESYNC request.
+	NAT64AddressPool(pool *nat.Nat64AddressPool) DataResyncDSL
+	// NAT64StaticBIB adds NAT64 static BIB entry to the RESYNC request.
+	NAT64StaticBIB(bib *nat.Nat64StaticBIB) DataResyncDSL
+	// DET44Mapping adds DET44 mapping to the RESYNC request.
+	DET44Mapping(mapping *nat.Det44Mapping) DataResyncDSL
 	// IPSecSA adds request to RESYNC a new Security Association
 	IPSecSA(sa *ipsec.SecurityAssociation) DataResyncDSL
 	// IPSecSPD adds request to RESYNC a new Security Policy Database
@@ -116,6 +144,8 @@ type DataResyncDSL interface {
 	FlowprobeFeature(val *ipfix.FlowProbeFeature) DataResyncDSL
 	// VRRP adds VPP L3 VRRP to the RESYNC request.
 	VRRP(val *vpp_l3.VRRPEntry) DataResyncDSL
+	// MRoute adds VPP L3 multicast route to the RESYNC request.
+	MRoute(val *vpp_l3.MRoute) DataResyncDSL
 
 	// Send propagates the RESYNC request to the plugins.
 	Send() vpp_clientv2.Reply
@@ -24,6 +24,11 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+	linux_punt "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 	abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
@@ -95,7 +100,13 @@ func (dsl *PutDSL) LinuxArpEntry(val *linux_l3.ARPEntry) linuxclient.PutDSL {
 
 // LinuxRoute adds a request to create or update Linux route.
 func (dsl *PutDSL) LinuxRoute(val *linux_l3.Route) linuxclient.PutDSL {
-	dsl.parent.txn.Put(linux_l3.RouteKey(val.DstNetwork, val.OutgoingInterface), val)
+	dsl.parent.txn.Put(models.Key(val), val)
+	return dsl
+}
+
+// LinuxRule adds a request to create or update Linux policy routing rule.
+func (dsl *PutDSL) LinuxRule(val *linux_l3.Rule) linuxclient.PutDSL {
+	dsl.parent.txn.Put(linux_l3.RuleKey(val.Family, val.Priority, val.Namespace), val)
 	return dsl
 }
 
@@ -105,6 +116,42 @@ func (dsl *PutDSL) IptablesRuleChain(val *linux_iptables.RuleChain) linuxclient.
 	return dsl
 }
 
+// NftablesTable adds request to create or update nftables table.
+func (dsl *PutDSL) NftablesTable(val *linux_nftables.Table) linuxclient.PutDSL {
+	dsl.parent.txn.Put(linux_nftables.TableKey(val.Name), val)
+	return dsl
+}
+
+// LinuxSysctl adds request to create or update Linux kernel network setting.
+func (dsl *PutDSL) LinuxSysctl(val *linux_sysctl.Sysctl) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(val), val)
+	return dsl
+}
+
+// TcQdisc adds request to create or update Linux tc qdisc.
+func (dsl *PutDSL) TcQdisc(val *linux_tc.Qdisc) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(val), val)
+	return dsl
+}
+
+// TcClass adds request to create or update Linux tc class.
+func (dsl *PutDSL) TcClass(val *linux_tc.Class) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(val), val)
+	return dsl
+}
+
+// TcFilter adds request to create or update Linux tc filter.
+func (dsl *PutDSL) TcFilter(val *linux_tc.Filter) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(val), val)
+	return dsl
+}
+
+// PuntProxy adds request to create or update Linux punt proxy.
+func (dsl *PutDSL) PuntProxy(val *linux_punt.Proxy) linuxclient.PutDSL {
+	dsl.parent.txn.Put(linux_punt.ProxyKey(val.Name), val)
+	return dsl
+}
+
 // VppInterface adds a request to create or update VPP network interface.
 func (dsl *PutDSL) VppInterface(val *interfaces.Interface) linuxclient.PutDSL {
 	dsl.vppPut.Interface(val)
@@ -240,6 +287,36 @@ func (dsl *PutDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) linuxclient.PutD
 	return dsl
 }
 
+// NAT64IPv6Prefix adds a request to create or update NAT64 IPv6 prefix.
+func (dsl *PutDSL) NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(prefix), prefix)
+	return dsl
+}
+
+// NAT64Interface adds a request to create or update NAT64 interface configuration.
+func (dsl *PutDSL) NAT64Interface(natIf *nat.Nat64Interface) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(natIf), natIf)
+	return dsl
+}
+
+// NAT64AddressPool adds a request to create or update NAT64 address pool.
+func (dsl *PutDSL) NAT64AddressPool(pool *nat.Nat64AddressPool) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(pool), pool)
+	return dsl
+}
+
+// NAT64StaticBIB adds a request to create or update NAT64 static BIB entry.
+func (dsl *PutDSL) NAT64StaticBIB(bib *nat.Nat64StaticBIB) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(bib), bib)
+	return dsl
+}
+
+// DET44Mapping adds a request to create or update DET44 mapping.
+func (dsl *PutDSL) DET44Mapping(mapping *nat.Det44Mapping) linuxclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(mapping), mapping)
+	return dsl
+}
+
 // IPSecSA adds request to create a new Security Association
 func (dsl *PutDSL) IPSecSA(sa *ipsec.SecurityAssociation) linuxclient.PutDSL {
 	dsl.vppPut.IPSecSA(sa)
@@ -306,6 +383,12 @@ func (dsl *PutDSL) VRRP(val *l3.VRRPEntry) linuxclient.PutDSL {
 	return dsl
 }
 
+// MRoute adds a request to create or update VPP L3 multicast route.
+func (dsl *PutDSL) MRoute(val *l3.MRoute) linuxclient.PutDSL {
+	dsl.vppPut.MRoute(val)
+	return dsl
+}
+
 // Delete changes the DSL mode to allow removal of an existing configuration.
 func (dsl *PutDSL) Delete() linuxclient.DeleteDSL {
 	return &DeleteDSL{dsl.parent, dsl.vppPut.Delete()}
@@ -335,12 +418,59 @@ func (dsl *DeleteDSL) LinuxRoute(dstAddr, outIfaceName string) linuxclient.Delet
 	return dsl
 }
 
+// LinuxRule adds a request to delete Linux policy routing rule.
+func (dsl *DeleteDSL) LinuxRule(family linux_l3.Rule_AddressFamily, priority uint32,
+	ns *linux_namespace.NetNamespace) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_l3.RuleKey(family, priority, ns))
+	return dsl
+}
+
 // IptablesRuleChain adds request to delete iptables rule chain.
 func (dsl *DeleteDSL) IptablesRuleChain(name string) linuxclient.DeleteDSL {
 	dsl.parent.txn.Delete(linux_iptables.RuleChainKey(name))
 	return dsl
 }
 
+// NftablesTable adds request to delete nftables table.
+func (dsl *DeleteDSL) NftablesTable(name string) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_nftables.TableKey(name))
+	return dsl
+}
+
+// LinuxSysctl adds request to delete Linux kernel network setting.
+func (dsl *DeleteDSL) LinuxSysctl(name, ifaceName string, ns *linux_namespace.NetNamespace) linuxclient.DeleteDSL {
+	if ifaceName != "" {
+		dsl.parent.txn.Delete(linux_sysctl.InterfaceSysctlKey(name, ifaceName))
+	} else {
+		dsl.parent.txn.Delete(linux_sysctl.SysctlKey(name, ns))
+	}
+	return dsl
+}
+
+// TcQdisc adds request to delete Linux tc qdisc.
+func (dsl *DeleteDSL) TcQdisc(ifaceName, parent string) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_tc.QdiscKey(ifaceName, parent))
+	return dsl
+}
+
+// TcClass adds request to delete Linux tc class.
+func (dsl *DeleteDSL) TcClass(ifaceName, classID string) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_tc.ClassKey(ifaceName, classID))
+	return dsl
+}
+
+// TcFilter adds request to delete Linux tc filter.
+func (dsl *DeleteDSL) TcFilter(ifaceName, parent string, priority uint32) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_tc.FilterKey(ifaceName, parent, priority))
+	return dsl
+}
+
+// PuntProxy adds request to delete Linux punt proxy.
+func (dsl *DeleteDSL) PuntProxy(name string) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(linux_punt.ProxyKey(name))
+	return dsl
+}
+
 // VppInterface adds a request to delete an existing VPP network interface.
 func (dsl *DeleteDSL) VppInterface(ifaceName string) linuxclient.DeleteDSL {
 	dsl.vppDelete.Interface(ifaceName)
@@ -477,6 +607,36 @@ func (dsl *DeleteDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) linuxclient.D
 	return dsl
 }
 
+// NAT64IPv6Prefix adds a request to delete an existing NAT64 IPv6 prefix.
+func (dsl *DeleteDSL) NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(prefix))
+	return dsl
+}
+
+// NAT64Interface adds a request to delete an existing NAT64 interface configuration.
+func (dsl *DeleteDSL) NAT64Interface(natIf *nat.Nat64Interface) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(natIf))
+	return dsl
+}
+
+// NAT64AddressPool adds a request to delete an existing NAT64 address pool.
+func (dsl *DeleteDSL) NAT64AddressPool(pool *nat.Nat64AddressPool) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(pool))
+	return dsl
+}
+
+// NAT64StaticBIB adds a request to delete an existing NAT64 static BIB entry.
+func (dsl *DeleteDSL) NAT64StaticBIB(bib *nat.Nat64StaticBIB) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(bib))
+	return dsl
+}
+
+// DET44Mapping adds a request to delete an existing DET44 mapping.
+func (dsl *DeleteDSL) DET44Mapping(mapping *nat.Det44Mapping) linuxclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(mapping))
+	return dsl
+}
+
 // IPSecSA adds request to delete a Security Association
 func (dsl *DeleteDSL) IPSecSA(saIndex uint32) linuxclient.DeleteDSL {
 	dsl.vppDelete.IPSecSA(saIndex)
@@ -540,3 +700,9 @@ func (dsl *DeleteDSL) VRRP(val *l3.VRRPEntry) linuxclient.DeleteDSL {
 	dsl.vppDelete.VRRP(val)
 	return dsl
 }
+
+// MRoute adds a request to delete an existing VPP L3 multicast route.
+func (dsl *DeleteDSL) MRoute(val *l3.MRoute) linuxclient.DeleteDSL {
+	dsl.vppDelete.MRoute(val)
+	return dsl
+}
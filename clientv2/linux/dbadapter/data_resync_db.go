@@ -24,6 +24,10 @@ import (
 	linux_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+	linux_punt "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
 	abf "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/abf"
 	acl "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/acl"
 	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
@@ -76,7 +80,16 @@ func (dsl *DataResyncDSL) LinuxArpEntry(val *linux_l3.ARPEntry) linuxclient.Data
 
 // LinuxRoute adds Linux route to the RESYNC request.
 func (dsl *DataResyncDSL) LinuxRoute(val *linux_l3.Route) linuxclient.DataResyncDSL {
-	key := linux_l3.RouteKey(val.DstNetwork, val.OutgoingInterface)
+	key := models.Key(val)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// LinuxRule adds Linux policy routing rule to the RESYNC request.
+func (dsl *DataResyncDSL) LinuxRule(val *linux_l3.Rule) linuxclient.DataResyncDSL {
+	key := linux_l3.RuleKey(val.Family, val.Priority, val.Namespace)
 	dsl.txn.Put(key, val)
 	dsl.txnKeys = append(dsl.txnKeys, key)
 
@@ -92,6 +105,60 @@ func (dsl *DataResyncDSL) IptablesRuleChain(val *linux_iptables.RuleChain) linux
 	return dsl
 }
 
+// NftablesTable adds nftables table to the RESYNC request.
+func (dsl *DataResyncDSL) NftablesTable(val *linux_nftables.Table) linuxclient.DataResyncDSL {
+	key := linux_nftables.TableKey(val.Name)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// LinuxSysctl adds Linux kernel network setting to the RESYNC request.
+func (dsl *DataResyncDSL) LinuxSysctl(val *linux_sysctl.Sysctl) linuxclient.DataResyncDSL {
+	key := models.Key(val)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// TcQdisc adds Linux tc qdisc to the RESYNC request.
+func (dsl *DataResyncDSL) TcQdisc(val *linux_tc.Qdisc) linuxclient.DataResyncDSL {
+	key := models.Key(val)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// TcClass adds Linux tc class to the RESYNC request.
+func (dsl *DataResyncDSL) TcClass(val *linux_tc.Class) linuxclient.DataResyncDSL {
+	key := models.Key(val)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// TcFilter adds Linux tc filter to the RESYNC request.
+func (dsl *DataResyncDSL) TcFilter(val *linux_tc.Filter) linuxclient.DataResyncDSL {
+	key := models.Key(val)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// PuntProxy adds Linux punt proxy to the RESYNC request.
+func (dsl *DataResyncDSL) PuntProxy(val *linux_punt.Proxy) linuxclient.DataResyncDSL {
+	key := linux_punt.ProxyKey(val.Name)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
 // VppInterface adds VPP interface to the RESYNC request.
 func (dsl *DataResyncDSL) VppInterface(intf *interfaces.Interface) linuxclient.DataResyncDSL {
 	dsl.vppDataResync.Interface(intf)
@@ -234,6 +301,51 @@ func (dsl *DataResyncDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) linuxclie
 	return dsl
 }
 
+// NAT64IPv6Prefix adds NAT64 IPv6 prefix to the RESYNC request.
+func (dsl *DataResyncDSL) NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) linuxclient.DataResyncDSL {
+	key := models.Key(prefix)
+	dsl.txn.Put(key, prefix)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// NAT64Interface adds NAT64 interface configuration to the RESYNC request.
+func (dsl *DataResyncDSL) NAT64Interface(natIf *nat.Nat64Interface) linuxclient.DataResyncDSL {
+	key := models.Key(natIf)
+	dsl.txn.Put(key, natIf)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// NAT64AddressPool adds NAT64 address pool to the RESYNC request.
+func (dsl *DataResyncDSL) NAT64AddressPool(pool *nat.Nat64AddressPool) linuxclient.DataResyncDSL {
+	key := models.Key(pool)
+	dsl.txn.Put(key, pool)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// NAT64StaticBIB adds NAT64 static BIB entry to the RESYNC request.
+func (dsl *DataResyncDSL) NAT64StaticBIB(bib *nat.Nat64StaticBIB) linuxclient.DataResyncDSL {
+	key := models.Key(bib)
+	dsl.txn.Put(key, bib)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// DET44Mapping adds DET44 mapping to the RESYNC request.
+func (dsl *DataResyncDSL) DET44Mapping(mapping *nat.Det44Mapping) linuxclient.DataResyncDSL {
+	key := models.Key(mapping)
+	dsl.txn.Put(key, mapping)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
 // IPSecSA adds request to RESYNC a new Security Association
 func (dsl *DataResyncDSL) IPSecSA(sa *ipsec.SecurityAssociation) linuxclient.DataResyncDSL {
 	dsl.vppDataResync.IPSecSA(sa)
@@ -312,6 +424,12 @@ func (dsl *DataResyncDSL) VRRP(val *l3.VRRPEntry) linuxclient.DataResyncDSL {
 	return dsl
 }
 
+// MRoute adds VPP L3 multicast route to the RESYNC request.
+func (dsl *DataResyncDSL) MRoute(val *l3.MRoute) linuxclient.DataResyncDSL {
+	dsl.vppDataResync.MRoute(val)
+	return dsl
+}
+
 // AppendKeys is a helper function that fills the keySet <keys> with values
 // pointed to by the iterator <it>.
 func appendKeys(keys *keySet, it keyval.ProtoKeyIterator) {
@@ -88,6 +88,16 @@ type PutDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) PutDSL
 	// NAT44AddressPool adds a request to create or update NAT44 address pool.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) PutDSL
+	// NAT64IPv6Prefix adds a request to create or update NAT64 IPv6 prefix.
+	NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) PutDSL
+	// NAT64Interface adds a request to create or update NAT64 interface configuration.
+	NAT64Interface(natIf *nat.Nat64Interface) PutDSL
+	// NAT64AddressPool adds a request to create or update NAT64 address pool.
+	NAT64AddressPool(pool *nat.Nat64AddressPool) PutDSL
+	// NAT64StaticBIB adds a request to create or update NAT64 static BIB entry.
+	NAT64StaticBIB(bib *nat.Nat64StaticBIB) PutDSL
+	// DET44Mapping adds a request to create or update DET44 mapping.
+	DET44Mapping(mapping *nat.Det44Mapping) PutDSL
 	// IPSecSA adds request to create a new Security Association
 	IPSecSA(sa *ipsec.SecurityAssociation) PutDSL
 	// IPSecSPD adds request to create a new Security Policy Database
@@ -110,6 +120,8 @@ type PutDSL interface {
 	FlowprobeFeature(val *ipfix.FlowProbeFeature) PutDSL
 	// VRRP adds a request to create or update VPP L3 VRRP.
 	VRRP(val *l3.VRRPEntry) PutDSL
+	// MRoute adds a request to create or update VPP L3 multicast route.
+	MRoute(val *l3.MRoute) PutDSL
 
 	// Delete changes the DSL mode to allow removal of an existing configuration.
 	// See documentation for DataChangeDSL.Delete().
@@ -157,6 +169,16 @@ type DeleteDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) DeleteDSL
 	// NAT44AddressPool adds a request to delete NAT44 address pool.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) DeleteDSL
+	// NAT64IPv6Prefix adds a request to delete NAT64 IPv6 prefix.
+	NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) DeleteDSL
+	// NAT64Interface adds a request to delete NAT64 interface configuration.
+	NAT64Interface(natIf *nat.Nat64Interface) DeleteDSL
+	// NAT64AddressPool adds a request to delete NAT64 address pool.
+	NAT64AddressPool(pool *nat.Nat64AddressPool) DeleteDSL
+	// NAT64StaticBIB adds a request to delete NAT64 static BIB entry.
+	NAT64StaticBIB(bib *nat.Nat64StaticBIB) DeleteDSL
+	// DET44Mapping adds a request to delete DET44 mapping.
+	DET44Mapping(mapping *nat.Det44Mapping) DeleteDSL
 	// IPSecSA adds request to delete a Security Association
 	IPSecSA(saIndex uint32) DeleteDSL
 	// IPSecSPD adds request to delete a Security Policy Database
@@ -175,6 +197,8 @@ type DeleteDSL interface {
 	FlowprobeFeature(val *ipfix.FlowProbeFeature) DeleteDSL
 	// VRRP adds a request to delete VPP L3 VRRP.
 	VRRP(val *l3.VRRPEntry) DeleteDSL
+	// MRoute adds a request to delete VPP L3 multicast route.
+	MRoute(val *l3.MRoute) DeleteDSL
 
 	// Put changes the DSL mode to allow configuration editing.
 	// See documentation for DataChangeDSL.Put().
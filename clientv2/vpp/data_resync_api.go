@@ -68,6 +68,16 @@ type DataResyncDSL interface {
 	NAT44Interface(natIf *nat.Nat44Interface) DataResyncDSL
 	// NAT44AddressPool adds NAT44 address pool configuration to the RESYNC request.
 	NAT44AddressPool(pool *nat.Nat44AddressPool) DataResyncDSL
+	// NAT64IPv6Prefix adds NAT64 IPv6 prefix to the RESYNC request.
+	NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) DataResyncDSL
+	// NAT64Interface adds NAT64 interface configuration to the RESYNC request.
+	NAT64Interface(natIf *nat.Nat64Interface) DataResyncDSL
+	// NAT64AddressPool adds NAT64 address pool to the RESYNC request.
+	NAT64AddressPool(pool *nat.Nat64AddressPool) DataResyncDSL
+	// NAT64StaticBIB adds NAT64 static BIB entry to the RESYNC request.
+	NAT64StaticBIB(bib *nat.Nat64StaticBIB) DataResyncDSL
+	// DET44Mapping adds DET44 mapping to the RESYNC request.
+	DET44Mapping(mapping *nat.Det44Mapping) DataResyncDSL
 	// IPSecSA adds request to RESYNC a new Security Association
 	IPSecSA(sa *ipsec.SecurityAssociation) DataResyncDSL
 	// IPSecSPD adds request to RESYNC a new Security Policy Database
@@ -90,6 +100,8 @@ type DataResyncDSL interface {
 	FlowprobeFeature(val *ipfix.FlowProbeFeature) DataResyncDSL
 	// VRRP adds VPP L3 VRRP to the RESYNC request.
 	VRRP(val *l3.VRRPEntry) DataResyncDSL
+	// MRoute adds VPP L3 multicast route to the RESYNC request.
+	MRoute(val *l3.MRoute) DataResyncDSL
 
 	// Send propagates the RESYNC request to the plugins.
 	Send() Reply
@@ -180,6 +180,36 @@ func (dsl *PutDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) vppclient.PutDSL
 	return dsl
 }
 
+// NAT64IPv6Prefix adds a request to create or update NAT64 IPv6 prefix.
+func (dsl *PutDSL) NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) vppclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(prefix), prefix)
+	return dsl
+}
+
+// NAT64Interface adds a request to create or update NAT64 interface configuration.
+func (dsl *PutDSL) NAT64Interface(natIf *nat.Nat64Interface) vppclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(natIf), natIf)
+	return dsl
+}
+
+// NAT64AddressPool adds a request to create or update NAT64 address pool.
+func (dsl *PutDSL) NAT64AddressPool(pool *nat.Nat64AddressPool) vppclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(pool), pool)
+	return dsl
+}
+
+// NAT64StaticBIB adds a request to create or update NAT64 static BIB entry.
+func (dsl *PutDSL) NAT64StaticBIB(bib *nat.Nat64StaticBIB) vppclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(bib), bib)
+	return dsl
+}
+
+// DET44Mapping adds a request to create or update DET44 mapping.
+func (dsl *PutDSL) DET44Mapping(mapping *nat.Det44Mapping) vppclient.PutDSL {
+	dsl.parent.txn.Put(models.Key(mapping), mapping)
+	return dsl
+}
+
 // IPSecSA adds request to create a new Security Association
 func (dsl *PutDSL) IPSecSA(sa *ipsec.SecurityAssociation) vppclient.PutDSL {
 	dsl.parent.txn.Put(ipsec.SAKey(sa.Index), sa)
@@ -247,6 +277,13 @@ func (dsl *PutDSL) VRRP(val *l3.VRRPEntry) vppclient.PutDSL {
 	return dsl
 }
 
+// MRoute adds a request to create or update VPP L3 multicast route.
+func (dsl *PutDSL) MRoute(val *l3.MRoute) vppclient.PutDSL {
+	key := l3.MRouteKey(val.VrfId, val.GroupAddress, val.SourceAddress)
+	dsl.parent.txn.Put(key, val)
+	return dsl
+}
+
 // Delete changes the DSL mode to allow removal of an existing configuration.
 func (dsl *PutDSL) Delete() vppclient.DeleteDSL {
 	return &DeleteDSL{dsl.parent}
@@ -361,6 +398,36 @@ func (dsl *DeleteDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) vppclient.Del
 	return dsl
 }
 
+// NAT64IPv6Prefix adds a request to delete an existing NAT64 IPv6 prefix.
+func (dsl *DeleteDSL) NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(prefix))
+	return dsl
+}
+
+// NAT64Interface adds a request to delete an existing NAT64 interface configuration.
+func (dsl *DeleteDSL) NAT64Interface(natIf *nat.Nat64Interface) vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(natIf))
+	return dsl
+}
+
+// NAT64AddressPool adds a request to delete an existing NAT64 address pool.
+func (dsl *DeleteDSL) NAT64AddressPool(pool *nat.Nat64AddressPool) vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(pool))
+	return dsl
+}
+
+// NAT64StaticBIB adds a request to delete an existing NAT64 static BIB entry.
+func (dsl *DeleteDSL) NAT64StaticBIB(bib *nat.Nat64StaticBIB) vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(bib))
+	return dsl
+}
+
+// DET44Mapping adds a request to delete an existing DET44 mapping.
+func (dsl *DeleteDSL) DET44Mapping(mapping *nat.Det44Mapping) vppclient.DeleteDSL {
+	dsl.parent.txn.Delete(models.Key(mapping))
+	return dsl
+}
+
 // IPSecSA adds request to create a new Security Association
 func (dsl *DeleteDSL) IPSecSA(saIndex uint32) vppclient.DeleteDSL {
 	dsl.parent.txn.Delete(ipsec.SAKey(saIndex))
@@ -437,3 +504,10 @@ func (dsl *DeleteDSL) VRRP(val *l3.VRRPEntry) vppclient.DeleteDSL {
 	dsl.parent.txn.Delete(key)
 	return dsl
 }
+
+// MRoute adds a request to delete an existing VPP L3 multicast route.
+func (dsl *DeleteDSL) MRoute(val *l3.MRoute) vppclient.DeleteDSL {
+	key := l3.MRouteKey(val.VrfId, val.GroupAddress, val.SourceAddress)
+	dsl.parent.txn.Delete(key)
+	return dsl
+}
@@ -203,6 +203,51 @@ func (dsl *DataResyncDSL) NAT44AddressPool(pool *nat.Nat44AddressPool) vppclient
 	return dsl
 }
 
+// NAT64IPv6Prefix adds NAT64 IPv6 prefix to the RESYNC request.
+func (dsl *DataResyncDSL) NAT64IPv6Prefix(prefix *nat.Nat64IPv6Prefix) vppclient.DataResyncDSL {
+	key := models.Key(prefix)
+	dsl.txn.Put(key, prefix)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// NAT64Interface adds NAT64 interface configuration to the RESYNC request.
+func (dsl *DataResyncDSL) NAT64Interface(natIf *nat.Nat64Interface) vppclient.DataResyncDSL {
+	key := models.Key(natIf)
+	dsl.txn.Put(key, natIf)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// NAT64AddressPool adds NAT64 address pool to the RESYNC request.
+func (dsl *DataResyncDSL) NAT64AddressPool(pool *nat.Nat64AddressPool) vppclient.DataResyncDSL {
+	key := models.Key(pool)
+	dsl.txn.Put(key, pool)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// NAT64StaticBIB adds NAT64 static BIB entry to the RESYNC request.
+func (dsl *DataResyncDSL) NAT64StaticBIB(bib *nat.Nat64StaticBIB) vppclient.DataResyncDSL {
+	key := models.Key(bib)
+	dsl.txn.Put(key, bib)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
+// DET44Mapping adds DET44 mapping to the RESYNC request.
+func (dsl *DataResyncDSL) DET44Mapping(mapping *nat.Det44Mapping) vppclient.DataResyncDSL {
+	key := models.Key(mapping)
+	dsl.txn.Put(key, mapping)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
 // IPSecSA adds request to create a new Security Association
 func (dsl *DataResyncDSL) IPSecSA(sa *ipsec.SecurityAssociation) vppclient.DataResyncDSL {
 	key := ipsec.SAKey(sa.Index)
@@ -301,6 +346,15 @@ func (dsl *DataResyncDSL) VRRP(vrrp *l3.VRRPEntry) vppclient.DataResyncDSL {
 	return dsl
 }
 
+// MRoute adds L3 multicast route to the RESYNC request.
+func (dsl *DataResyncDSL) MRoute(val *l3.MRoute) vppclient.DataResyncDSL {
+	key := l3.MRouteKey(val.VrfId, val.GroupAddress, val.SourceAddress)
+	dsl.txn.Put(key, val)
+	dsl.txnKeys = append(dsl.txnKeys, key)
+
+	return dsl
+}
+
 // AppendKeys is a helper function that fills the keySet <keys> with values
 // pointed to by the iterator <it>.
 func appendKeys(keys *keySet, it keyval.ProtoKeyIterator) {
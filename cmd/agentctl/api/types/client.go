@@ -14,6 +14,8 @@
 
 package types
 
+import "time"
+
 type ModelListOptions struct {
 	Class  string
 	Module string
@@ -38,3 +40,16 @@ type SchedulerHistoryOptions struct {
 	Count  int
 	SeqNum int
 }
+
+type VppStatsHistoryOptions struct {
+	Metric      string
+	Object      string
+	Since       time.Duration
+	Step        time.Duration
+	Aggregation string
+}
+
+type VppRecordOptions struct {
+	File     string
+	Instance string
+}
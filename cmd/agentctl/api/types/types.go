@@ -14,6 +14,8 @@
 
 package types
 
+import "time"
+
 // ErrorResponse represents an error.
 type ErrorResponse struct {
 	Message string `json:"message"`
@@ -58,3 +60,46 @@ type Model struct {
 	GoType       string `json:",omitempty"`
 	PkgPath      string `json:",omitempty"`
 }
+
+// StatsSeries contains a series from response of Agent REST API:
+// GET "/telemetry/history"
+type StatsSeries struct {
+	Metric string       `json:"metric"`
+	Object string       `json:"object"`
+	Points []StatsPoint `json:"points"`
+}
+
+// StatsPoint is a value of metric at the time (unix timestamp in milliseconds).
+type StatsPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// VppRecordStatus contains response of Agent REST API:
+// GET "/govppmux/record"
+type VppRecordStatus struct {
+	Recording bool      `json:"recording"`
+	File      string    `json:"file,omitempty"`
+	Started   time.Time `json:"started,omitempty"`
+	Records   uint64    `json:"records"`
+}
+
+// VppCompatibility contains response of Agent REST API:
+// GET "/govppmux/compatibility"
+type VppCompatibility struct {
+	BinapiVersion string                   `json:"binapi_version"`
+	Versions      []VppBinapiCompatibility `json:"versions"`
+}
+
+// VppBinapiCompatibility describes compatibility of binapi version with VPP.
+type VppBinapiCompatibility struct {
+	Version      string             `json:"version"`
+	Messages     int                `json:"messages"`
+	Incompatible []VppBinapiMessage `json:"incompatible,omitempty"`
+}
+
+// VppBinapiMessage identifies binapi message.
+type VppBinapiMessage struct {
+	Name string `json:"name"`
+	CRC  string `json:"crc"`
+}
@@ -13,6 +13,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 	"google.golang.org/grpc"
 )
 
@@ -63,6 +64,12 @@ type SchedulerAPIClient interface {
 type VppAPIClient interface {
 	VppStatsAPIClient
 	VppRunCli(ctx context.Context, cmd string) (reply string, err error)
+	VppCompatibility(ctx context.Context) (*types.VppCompatibility, error)
+	VppRecordStart(ctx context.Context, opts types.VppRecordOptions) (*types.VppRecordStatus, error)
+	VppRecordStop(ctx context.Context, opts types.VppRecordOptions) (*types.VppRecordStatus, error)
+	VppRecordStatus(ctx context.Context, opts types.VppRecordOptions) (*types.VppRecordStatus, error)
+	VppNatUsers(ctx context.Context) ([]*vpp_nat.Nat44UserState, error)
+	VppNatSessions(ctx context.Context) ([]*vpp_nat.Nat44SessionState, error)
 }
 
 // VppStatsAPIClient defines stats API client methods for the VPP
@@ -73,6 +80,7 @@ type VppStatsAPIClient interface {
 	VppGetSystemStats() (*govppapi.SystemStats, error)
 	VppGetErrorStats() (*govppapi.ErrorStats, error)
 	VppGetInterfaceStats() (*govppapi.InterfaceStats, error)
+	VppStatsHistory(ctx context.Context, opts types.VppStatsHistoryOptions) ([]types.StatsSeries, error)
 }
 
 type MetricsAPIClient interface {
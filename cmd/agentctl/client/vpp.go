@@ -18,8 +18,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 
 	"git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
+	vpp_nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
 )
 
 func (c *Client) VppRunCli(ctx context.Context, cmd string) (reply string, err error) {
@@ -36,6 +40,18 @@ func (c *Client) VppRunCli(ctx context.Context, cmd string) (reply string, err e
 	return reply, nil
 }
 
+func (c *Client) VppCompatibility(ctx context.Context) (*types.VppCompatibility, error) {
+	resp, err := c.get(ctx, "/govppmux/compatibility", nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %v", err)
+	}
+	var compat types.VppCompatibility
+	if err := json.NewDecoder(resp.body).Decode(&compat); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return &compat, nil
+}
+
 func (c *Client) VppGetStats(ctx context.Context, typ string) error {
 	// TODO: implement more generic stats provider that goes beyond GoVPP StatsProvider (git.fd.io/govpp/api/stats.go)
 	//  and can dump any possible stats or all of them (just like in stats dump example in
@@ -43,6 +59,104 @@ func (c *Client) VppGetStats(ctx context.Context, typ string) error {
 	return nil
 }
 
+func (c *Client) VppStatsHistory(ctx context.Context, opts types.VppStatsHistoryOptions) ([]types.StatsSeries, error) {
+	query := url.Values{}
+	if opts.Metric != "" {
+		query.Set("metric", opts.Metric)
+	}
+	if opts.Object != "" {
+		query.Set("object", opts.Object)
+	}
+	if opts.Since > 0 {
+		query.Set("since", opts.Since.String())
+	}
+	if opts.Step > 0 {
+		query.Set("step", opts.Step.String())
+	}
+	if opts.Aggregation != "" {
+		query.Set("agg", opts.Aggregation)
+	}
+
+	resp, err := c.get(ctx, "/telemetry/history", query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %v", err)
+	}
+	var history struct {
+		Series []types.StatsSeries `json:"series"`
+	}
+	if err := json.NewDecoder(resp.body).Decode(&history); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return history.Series, nil
+}
+
+func (c *Client) VppRecordStart(ctx context.Context, opts types.VppRecordOptions) (*types.VppRecordStatus, error) {
+	return c.vppRecord(ctx, "/govppmux/record/start", opts)
+}
+
+func (c *Client) VppRecordStop(ctx context.Context, opts types.VppRecordOptions) (*types.VppRecordStatus, error) {
+	return c.vppRecord(ctx, "/govppmux/record/stop", opts)
+}
+
+func (c *Client) VppRecordStatus(ctx context.Context, opts types.VppRecordOptions) (*types.VppRecordStatus, error) {
+	query := url.Values{}
+	if opts.Instance != "" {
+		query.Set("instance", opts.Instance)
+	}
+	resp, err := c.get(ctx, "/govppmux/record", query, nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %v", err)
+	}
+	var status types.VppRecordStatus
+	if err := json.NewDecoder(resp.body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return &status, nil
+}
+
+func (c *Client) vppRecord(ctx context.Context, path string, opts types.VppRecordOptions) (*types.VppRecordStatus, error) {
+	data := map[string]interface{}{}
+	if opts.File != "" {
+		data["file"] = opts.File
+	}
+	if opts.Instance != "" {
+		data["instance"] = opts.Instance
+	}
+	resp, err := c.post(ctx, path, nil, data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP POST request failed: %v", err)
+	}
+	var status types.VppRecordStatus
+	if err := json.NewDecoder(resp.body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return &status, nil
+}
+
+func (c *Client) VppNatUsers(ctx context.Context) ([]*vpp_nat.Nat44UserState, error) {
+	resp, err := c.get(ctx, "/dump/vpp/v2/nat/users", nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %v", err)
+	}
+	var users []*vpp_nat.Nat44UserState
+	if err := json.NewDecoder(resp.body).Decode(&users); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return users, nil
+}
+
+func (c *Client) VppNatSessions(ctx context.Context) ([]*vpp_nat.Nat44SessionState, error) {
+	resp, err := c.get(ctx, "/dump/vpp/v2/nat/sessions", nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP request failed: %v", err)
+	}
+	var sessions []*vpp_nat.Nat44SessionState
+	if err := json.NewDecoder(resp.body).Decode(&sessions); err != nil {
+		return nil, fmt.Errorf("decoding reply failed: %v", err)
+	}
+	return sessions, nil
+}
+
 func (c *Client) VppGetInterfaceStats() (*api.InterfaceStats, error) {
 	statsProvider, err := c.vppStatsProvider()
 	if err != nil {
@@ -15,13 +15,20 @@
 package commands
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
+	"text/tabwriter"
+	"time"
 
+	"git.fd.io/govpp.git/adapter"
+	"git.fd.io/govpp.git/adapter/socketclient"
 	"github.com/spf13/cobra"
 
+	"go.ligato.io/vpp-agent/v3/cmd/agentctl/api/types"
 	agentcli "go.ligato.io/vpp-agent/v3/cmd/agentctl/cli"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/recorder"
 )
 
 func NewVppCommand(cli agentcli.Cli) *cobra.Command {
@@ -32,6 +39,10 @@ func NewVppCommand(cli agentcli.Cli) *cobra.Command {
 	cmd.AddCommand(
 		newVppCliCommand(cli),
 		newVppInfoCommand(cli),
+		newVppStatsCommand(cli),
+		newVppRecordCommand(cli),
+		newVppReplayCommand(cli),
+		newVppNatCommand(cli),
 	)
 	return cmd
 }
@@ -103,5 +114,351 @@ func runVppInfo(cli agentcli.Cli) error {
 	}
 	fmt.Fprintf(cli.Out(), "CONFIG:\n%s\n", config)
 
+	compat, err := cli.Client().VppCompatibility(ctx)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "BINAPI\tMESSAGES\tINCOMPATIBLE\t\n")
+	for _, vc := range compat.Versions {
+		version := vc.Version
+		if version == compat.BinapiVersion {
+			version += " (in use)"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t\n", version, vc.Messages, len(vc.Incompatible))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(cli.Out(), "COMPATIBILITY:\n%s", buf.String())
+	for _, vc := range compat.Versions {
+		if vc.Version != compat.BinapiVersion || len(vc.Incompatible) == 0 {
+			continue
+		}
+		fmt.Fprintf(cli.Out(), "\nINCOMPATIBLE MESSAGES (%s):\n", vc.Version)
+		for _, msg := range vc.Incompatible {
+			fmt.Fprintf(cli.Out(), " - %s (CRC %s)\n", msg.Name, msg.CRC)
+		}
+	}
+
+	return nil
+}
+
+func newVppStatsCommand(cli agentcli.Cli) *cobra.Command {
+	var opts VppStatsOptions
+
+	cmd := &cobra.Command{
+		Use:     "stats [METRIC]",
+		Aliases: []string{"s"},
+		Short:   "Show history of VPP stats recorded by telemetry",
+		Example: `
+# Show drop rate of all interfaces in the last 10 minutes
+{{.CommandPath}} vpp stats interface.drop_rate --since 10m
+
+# Show maximum of received packets per second of interface 'tap1' per minute
+{{.CommandPath}} vpp stats interface.rx_pps --object '^tap1$' --since 1h --step 1m --agg max
+
+# Show all node rates
+{{.CommandPath}} vpp stats node.
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Metric = args[0]
+			}
+			return runVppStats(cli, opts)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Object, "object", "", "Regular expression filtering objects (interfaces, nodes, buffer pools) by name")
+	flags.DurationVar(&opts.Since, "since", 0, "Show stats recorded since the duration ago (all recorded by default)")
+	flags.DurationVar(&opts.Step, "step", 0, "Aggregate stats within steps of the duration")
+	flags.StringVar(&opts.Aggregation, "agg", "avg", "Aggregation within the step (avg, min, max, sum, last)")
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+type VppStatsOptions struct {
+	Metric      string
+	Object      string
+	Since       time.Duration
+	Step        time.Duration
+	Aggregation string
+	Format      string
+}
+
+func runVppStats(cli agentcli.Cli, opts VppStatsOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	series, err := cli.Client().VppStatsHistory(ctx, types.VppStatsHistoryOptions{
+		Metric:      opts.Metric,
+		Object:      opts.Object,
+		Since:       opts.Since,
+		Step:        opts.Step,
+		Aggregation: opts.Aggregation,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.Format != "" {
+		return formatAsTemplate(cli.Out(), opts.Format, series)
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "TIME\tMETRIC\tOBJECT\tVALUE\t\n")
+	for _, s := range series {
+		for _, point := range s.Points {
+			t := time.Unix(0, point.Timestamp*int64(time.Millisecond))
+			fmt.Fprintf(w, "%s\t%s\t%s\t%.2f\t\n", t.Format(time.RFC3339), s.Metric, s.Object, point.Value)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprint(cli.Out(), buf.String())
+	return nil
+}
+
+func newVppRecordCommand(cli agentcli.Cli) *cobra.Command {
+	var opts VppRecordOptions
+
+	cmd := &cobra.Command{
+		Use:   "record [start|stop|status]",
+		Short: "Record binary API messages exchanged between agent and VPP",
+		Example: `
+# Start recording into file from agent config
+{{.CommandPath}} vpp record start
+
+# Start recording of VPP instance 'numa1' into custom file in the recording directory
+{{.CommandPath}} vpp record start --instance numa1 --file numa1.rec
+
+# Stop recording
+{{.CommandPath}} vpp record stop
+`,
+		Args:      cobra.MaximumNArgs(1),
+		ValidArgs: []string{"start", "stop", "status"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Action = "status"
+			if len(args) > 0 {
+				opts.Action = args[0]
+			}
+			return runVppRecord(cli, opts)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&opts.File, "file", "", "Name of recording file created in the directory of recording file from agent config (file from agent config by default)")
+	flags.StringVar(&opts.Instance, "instance", "", "Name of VPP instance (default instance by default)")
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+type VppRecordOptions struct {
+	Action   string
+	File     string
+	Instance string
+	Format   string
+}
+
+func runVppRecord(cli agentcli.Cli, opts VppRecordOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	recordOpts := types.VppRecordOptions{
+		File:     opts.File,
+		Instance: opts.Instance,
+	}
+	var (
+		status *types.VppRecordStatus
+		err    error
+	)
+	switch opts.Action {
+	case "start":
+		status, err = cli.Client().VppRecordStart(ctx, recordOpts)
+	case "stop":
+		status, err = cli.Client().VppRecordStop(ctx, recordOpts)
+	case "status":
+		status, err = cli.Client().VppRecordStatus(ctx, recordOpts)
+	default:
+		return fmt.Errorf("unknown action %q (use start, stop or status)", opts.Action)
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.Format != "" {
+		return formatAsTemplate(cli.Out(), opts.Format, status)
+	}
+	if status.File == "" {
+		fmt.Fprintln(cli.Out(), "not recording")
+		return nil
+	}
+	state := "stopped"
+	if status.Recording {
+		state = "recording"
+	}
+	fmt.Fprintf(cli.Out(), "%s: %d messages into %s (started %s)\n",
+		state, status.Records, status.File, status.Started.Format(time.RFC3339))
+	return nil
+}
+
+func newVppNatCommand(cli agentcli.Cli) *cobra.Command {
+	var opts VppNatOptions
+
+	cmd := &cobra.Command{
+		Use:   "nat [sessions|users]",
+		Short: "Show NAT44 sessions and users currently active in VPP",
+		Example: `
+# Show active NAT44 sessions
+{{.CommandPath}} vpp nat sessions
+
+# Show NAT44 users in JSON format
+{{.CommandPath}} vpp nat users -f json
+`,
+		Args:      cobra.MaximumNArgs(1),
+		ValidArgs: []string{"sessions", "users"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.State = "sessions"
+			if len(args) > 0 {
+				opts.State = args[0]
+			}
+			return runVppNat(cli, opts)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+type VppNatOptions struct {
+	State  string
+	Format string
+}
+
+func runVppNat(cli agentcli.Cli, opts VppNatOptions) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	switch opts.State {
+	case "sessions":
+		sessions, err := cli.Client().VppNatSessions(ctx)
+		if err != nil {
+			return err
+		}
+		if opts.Format != "" {
+			return formatAsTemplate(cli.Out(), opts.Format, sessions)
+		}
+		fmt.Fprintf(w, "VRF\tPROTOCOL\tINSIDE\tOUTSIDE\tEXTERNAL\tSTATIC\tPACKETS\tBYTES\t\n")
+		for _, s := range sessions {
+			external := "-"
+			if s.ExtHostIpAddress != "" {
+				external = fmt.Sprintf("%s:%d", s.ExtHostIpAddress, s.ExtHostPort)
+			}
+			fmt.Fprintf(w, "%d\t%d\t%s:%d\t%s:%d\t%s\t%t\t%d\t%d\t\n",
+				s.VrfId, s.Protocol, s.InsideIpAddress, s.InsidePort, s.OutsideIpAddress, s.OutsidePort,
+				external, s.IsStatic, s.TotalPackets, s.TotalBytes)
+		}
+	case "users":
+		users, err := cli.Client().VppNatUsers(ctx)
+		if err != nil {
+			return err
+		}
+		if opts.Format != "" {
+			return formatAsTemplate(cli.Out(), opts.Format, users)
+		}
+		fmt.Fprintf(w, "VRF\tADDRESS\tSESSIONS\tSTATIC SESSIONS\t\n")
+		for _, u := range users {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%d\t\n", u.VrfId, u.IpAddress, u.Sessions, u.StaticSessions)
+		}
+	default:
+		return fmt.Errorf("unknown NAT state %q (use sessions or users)", opts.State)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprint(cli.Out(), buf.String())
+	return nil
+}
+
+func newVppReplayCommand(cli agentcli.Cli) *cobra.Command {
+	var opts VppReplayOptions
+
+	cmd := &cobra.Command{
+		Use:   "replay FILE",
+		Short: "Replay recorded binary API messages and compare replies",
+		Long: `Replay re-issues requests from the recording of binary API messages
+against VPP (via its binary API socket) or against mock VPP replying
+with the recorded replies, and reports replies which differ from the recording.`,
+		Example: `
+# Replay recording against local VPP
+{{.CommandPath}} vpp replay /tmp/vpp-binapi.rec
+
+# Replay recording against VPP with custom socket
+{{.CommandPath}} vpp replay /tmp/vpp-binapi.rec --socket /run/vpp/api.sock
+
+# Replay recording against mock VPP
+{{.CommandPath}} vpp replay /tmp/vpp-binapi.rec --mock
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.File = args[0]
+			return runVppReplay(cli, opts)
+		},
+		SilenceUsage: true,
+	}
+	flags := cmd.Flags()
+	flags.StringVar(&opts.Socket, "socket", socketclient.DefaultSocketName, "Path to VPP binary API socket")
+	flags.BoolVar(&opts.Mock, "mock", false, "Replay against mock VPP replying with the recorded replies")
+	flags.DurationVar(&opts.Timeout, "timeout", recorder.DefaultReplyTimeout, "Time to wait for replies of each request")
+	flags.StringVarP(&opts.Format, "format", "f", "", "Format output")
+	return cmd
+}
+
+type VppReplayOptions struct {
+	File    string
+	Socket  string
+	Mock    bool
+	Timeout time.Duration
+	Format  string
+}
+
+func runVppReplay(cli agentcli.Cli, opts VppReplayOptions) error {
+	records, err := recorder.ReadFile(opts.File)
+	if err != nil {
+		return fmt.Errorf("reading recording failed: %v", err)
+	}
+
+	var vppAdapter adapter.VppAPI
+	if opts.Mock {
+		vppAdapter = recorder.NewPlaybackAdapter(records)
+	} else {
+		vppAdapter = socketclient.NewVppClient(opts.Socket)
+	}
+	result, err := recorder.Replay(vppAdapter, records, opts.Timeout)
+	if err != nil {
+		return err
+	}
+
+	if opts.Format != "" {
+		return formatAsTemplate(cli.Out(), opts.Format, result)
+	}
+	for _, mismatch := range result.Mismatches {
+		fmt.Fprintf(cli.Out(), "MISMATCH %v\n", mismatch)
+	}
+	fmt.Fprintf(cli.Out(), "replayed %d requests (%d exchanges), received %d replies in %v, %d mismatches\n",
+		result.Requests, result.Exchanges, result.Replies, result.Took.Round(time.Millisecond), len(result.Mismatches))
+	if len(result.Mismatches) > 0 {
+		return fmt.Errorf("%d replies differ from the recording", len(result.Mismatches))
+	}
 	return nil
 }
@@ -32,11 +32,16 @@ import (
 	linux_ifplugin "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	linux_iptablesplugin "go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin"
 	linux_l3plugin "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin"
+	linux_nftablesplugin "go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin"
 	linux_nsplugin "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	linux_puntplugin "go.ligato.io/vpp-agent/v3/plugins/linux/puntplugin"
+	linux_sysctlplugin "go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin"
+	linux_tcplugin "go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/localregistry"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/watcher"
+	"go.ligato.io/vpp-agent/v3/plugins/otlp"
 	"go.ligato.io/vpp-agent/v3/plugins/restapi"
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin"
@@ -79,6 +84,7 @@ type VPPAgent struct {
 	Probe        *probe.Plugin
 	StatusCheck  *statuscheck.Plugin
 	Telemetry    *telemetry.Plugin
+	OTLP         *otlp.Plugin
 }
 
 // New creates new VPPAgent instance.
@@ -149,6 +155,7 @@ func New() *VPPAgent {
 		Probe:          &probe.DefaultPlugin,
 		StatusCheck:    &statuscheck.DefaultPlugin,
 		Telemetry:      &telemetry.DefaultPlugin,
+		OTLP:           &otlp.DefaultPlugin,
 	}
 }
 
@@ -214,6 +221,10 @@ type Linux struct {
 	L3Plugin       *linux_l3plugin.L3Plugin
 	NSPlugin       *linux_nsplugin.NsPlugin
 	IPTablesPlugin *linux_iptablesplugin.IPTablesPlugin
+	NFTablesPlugin *linux_nftablesplugin.NFTablesPlugin
+	PuntPlugin     *linux_puntplugin.PuntPlugin
+	SysctlPlugin   *linux_sysctlplugin.SysctlPlugin
+	TCPlugin       *linux_tcplugin.TCPlugin
 }
 
 func DefaultLinux() Linux {
@@ -222,5 +233,9 @@ func DefaultLinux() Linux {
 		L3Plugin:       &linux_l3plugin.DefaultPlugin,
 		NSPlugin:       &linux_nsplugin.DefaultPlugin,
 		IPTablesPlugin: &linux_iptablesplugin.DefaultPlugin,
+		NFTablesPlugin: &linux_nftablesplugin.DefaultPlugin,
+		PuntPlugin:     &linux_puntplugin.DefaultPlugin,
+		SysctlPlugin:   &linux_sysctlplugin.DefaultPlugin,
+		TCPlugin:       &linux_tcplugin.DefaultPlugin,
 	}
 }
@@ -0,0 +1,247 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package app
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/health/statuscheck"
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/dnsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipfixplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ipsecplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l2plugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/puntplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/srplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/stnplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/wireguardplugin"
+)
+
+// VPPInstances manages VPP plugins of the named VPP instances defined in govppmux
+// config (instances). Plugins of the instances are created and initialized after
+// govppmux connects to the instances, each instance gets its own plugins
+// which register their own descriptors and metadata maps, handling keys
+// prefixed with "vpp-instance/<name>/".
+type VPPInstances struct {
+	infra.PluginName
+	Log         logging.PluginLogger
+	GoVPP       *govppmux.Plugin
+	KVScheduler *kvscheduler.Scheduler
+	Netalloc    *netalloc.Plugin
+	StatusCheck *statuscheck.Plugin
+
+	instances []*VPPInstance
+}
+
+// NewVPPInstances creates plugin managing VPP plugins of the named VPP instances.
+func NewVPPInstances() *VPPInstances {
+	p := &VPPInstances{
+		PluginName:  "vpp-instances",
+		GoVPP:       &govppmux.DefaultPlugin,
+		KVScheduler: &kvscheduler.DefaultPlugin,
+		Netalloc:    &netalloc.DefaultPlugin,
+		StatusCheck: &statuscheck.DefaultPlugin,
+	}
+	p.Log = logging.ForPlugin(p.String())
+	return p
+}
+
+// Init creates and initializes VPP plugins of the named VPP instances.
+func (p *VPPInstances) Init() error {
+	for _, name := range p.GoVPP.InstanceNames() {
+		vppConn := p.GoVPP.Instance(name)
+		instance := NewVPPInstance(name, vppConn, kvs.ForInstance(p.KVScheduler, name))
+		p.instances = append(p.instances, instance)
+		p.Log.Debugf("initializing plugins of VPP instance %s", name)
+		for _, plugin := range instance.plugins() {
+			if err := plugin.Init(); err != nil {
+				return errors.Errorf("init of plugin %v failed: %v", plugin, err)
+			}
+		}
+		vppConn.SetResync(p.resync(name))
+	}
+	return nil
+}
+
+// AfterInit calls AfterInit of VPP plugins of the named VPP instances.
+func (p *VPPInstances) AfterInit() error {
+	for _, instance := range p.instances {
+		for _, plugin := range instance.plugins() {
+			if postInit, ok := plugin.(interface{ AfterInit() error }); ok {
+				if err := postInit.AfterInit(); err != nil {
+					return errors.Errorf("after init of plugin %v failed: %v", plugin, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Close closes VPP plugins of the named VPP instances in reverse order.
+func (p *VPPInstances) Close() error {
+	for i := len(p.instances) - 1; i >= 0; i-- {
+		plugins := p.instances[i].plugins()
+		for j := len(plugins) - 1; j >= 0; j-- {
+			if err := plugins[j].Close(); err != nil {
+				return errors.Errorf("close of plugin %v failed: %v", plugins[j], err)
+			}
+		}
+	}
+	return nil
+}
+
+// resync returns function which re-synchronizes values of the named VPP instance
+// with the instance, values of other instances are not affected.
+func (p *VPPInstances) resync(name string) func() {
+	return func() {
+		ctx := kvs.WithInstanceResync(context.Background(), name, true)
+		if _, err := p.KVScheduler.StartNBTransaction().Commit(ctx); err != nil {
+			p.Log.Errorf("resync of VPP instance %s failed: %v", name, err)
+		}
+	}
+}
+
+// VPPInstance contains VPP plugins of a named VPP instance.
+type VPPInstance struct {
+	Name string
+	VPP
+}
+
+// NewVPPInstance creates VPP plugins for the named VPP instance using
+// the given connection to the instance and scheduler of the instance.
+func NewVPPInstance(name string, vppConn vpp.Client, scheduler kvs.KVScheduler) *VPPInstance {
+	pluginName := func(n infra.PluginName) infra.PluginName {
+		return n + infra.PluginName("-"+name)
+	}
+
+	ifPlugin := ifplugin.NewPlugin(ifplugin.UseDeps(func(deps *ifplugin.Deps) {
+		deps.PluginName = pluginName(deps.PluginName)
+		deps.VPP = vppConn
+		deps.KVScheduler = scheduler
+	}))
+	aclPlugin := aclplugin.NewPlugin(aclplugin.UseDeps(func(deps *aclplugin.Deps) {
+		deps.PluginName = pluginName(deps.PluginName)
+		deps.VPP = vppConn
+		deps.Scheduler = scheduler
+		deps.IfPlugin = ifPlugin
+	}))
+	vpp := VPP{
+		IfPlugin:  ifPlugin,
+		ACLPlugin: aclPlugin,
+		ABFPlugin: abfplugin.NewPlugin(abfplugin.UseDeps(func(deps *abfplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.Scheduler = scheduler
+			deps.ACLPlugin = aclPlugin
+			deps.IfPlugin = ifPlugin
+		})),
+		DNSPlugin: dnsplugin.NewPlugin(dnsplugin.UseDeps(func(deps *dnsplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.Scheduler = scheduler
+		})),
+		IPFIXPlugin: ipfixplugin.NewPlugin(ipfixplugin.UseDeps(func(deps *ipfixplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.KVScheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+		IPSecPlugin: ipsecplugin.NewPlugin(ipsecplugin.UseDeps(func(deps *ipsecplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.KVScheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+		L2Plugin: l2plugin.NewPlugin(l2plugin.UseDeps(func(deps *l2plugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.KVScheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+		L3Plugin: l3plugin.NewPlugin(l3plugin.UseDeps(func(deps *l3plugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.KVScheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+		NATPlugin: natplugin.NewPlugin(natplugin.UseDeps(func(deps *natplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.KVScheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+		PuntPlugin: puntplugin.NewPlugin(puntplugin.UseDeps(func(deps *puntplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.KVScheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+		STNPlugin: stnplugin.NewPlugin(stnplugin.UseDeps(func(deps *stnplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.KVScheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+		SRPlugin: srplugin.NewPlugin(srplugin.UseDeps(func(deps *srplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.Scheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+		WgPlugin: wireguardplugin.NewPlugin(wireguardplugin.UseDeps(func(deps *wireguardplugin.Deps) {
+			deps.PluginName = pluginName(deps.PluginName)
+			deps.VPP = vppConn
+			deps.KVScheduler = scheduler
+			deps.IfPlugin = ifPlugin
+		})),
+	}
+
+	return &VPPInstance{
+		Name: name,
+		VPP:  vpp,
+	}
+}
+
+// plugins returns VPP plugins of the instance in the order of initialization.
+func (i *VPPInstance) plugins() []infra.Plugin {
+	return []infra.Plugin{
+		i.IfPlugin,
+		i.ACLPlugin,
+		i.ABFPlugin,
+		i.DNSPlugin,
+		i.IPFIXPlugin,
+		i.IPSecPlugin,
+		i.L2Plugin,
+		i.L3Plugin,
+		i.NATPlugin,
+		i.PuntPlugin,
+		i.STNPlugin,
+		i.SRPlugin,
+		i.WgPlugin,
+	}
+}
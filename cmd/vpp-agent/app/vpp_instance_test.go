@@ -0,0 +1,121 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package app
+
+import (
+	"context"
+	"testing"
+
+	govppapi "git.fd.io/govpp.git/api"
+	"git.fd.io/govpp.git/core"
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	l3descriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppsim"
+)
+
+// simClient is VPP client connected to simulated VPP.
+type simClient struct {
+	conn *core.Connection
+	ch   govppapi.Channel
+}
+
+func newSimClient() *simClient {
+	conn, err := core.Connect(vppsim.New().NewAdapter())
+	Expect(err).ToNot(HaveOccurred())
+	ch, err := conn.NewAPIChannel()
+	Expect(err).ToNot(HaveOccurred())
+	return &simClient{conn: conn, ch: ch}
+}
+
+func (c *simClient) NewStream(ctx context.Context) (govppapi.Stream, error) {
+	return c.conn.NewStream(ctx)
+}
+
+func (c *simClient) Invoke(ctx context.Context, req govppapi.Message, reply govppapi.Message) error {
+	return c.conn.Invoke(ctx, req, reply)
+}
+
+func (c *simClient) CheckCompatiblity(msgs ...govppapi.Message) error {
+	return c.ch.CheckCompatiblity(msgs...)
+}
+
+func (c *simClient) NewAPIChannel() (govppapi.Channel, error) {
+	return c.conn.NewAPIChannel()
+}
+
+func (c *simClient) Stats() govppapi.StatsProvider {
+	return nil
+}
+
+func (c *simClient) IsPluginLoaded(plugin string) bool {
+	return true
+}
+
+func (c *simClient) BinapiVersion() vpp.Version {
+	return vppsim.BinapiVersion
+}
+
+func (c *simClient) OnReconnect(h func()) {}
+
+func TestVPPInstances(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheduler := kvscheduler.NewPlugin(kvscheduler.UseDeps(func(deps *kvscheduler.Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	Expect(scheduler.Init()).To(Succeed())
+	defer scheduler.Close()
+
+	// register full set of VPP plugins for two instances
+	names := []string{"numa1", "numa2"}
+	for _, name := range names {
+		client := newSimClient()
+		defer client.conn.Disconnect()
+
+		instance := NewVPPInstance(name, client, kvs.ForInstance(scheduler, name))
+		plugins := instance.plugins()
+		for _, plugin := range plugins {
+			Expect(plugin.Init()).To(Succeed(), "init of plugin %v", plugin)
+		}
+		defer func() {
+			for i := len(plugins) - 1; i >= 0; i-- {
+				Expect(plugins[i].Close()).To(Succeed())
+			}
+		}()
+	}
+
+	// each instance has its own descriptors and metadata maps
+	for _, name := range names {
+		for _, descriptor := range []string{
+			ifdescriptor.InterfaceDescriptorName,
+			ifdescriptor.BondedInterfaceDescriptorName,
+			l3descriptor.VrrpDescriptorName,
+			l3descriptor.DHCPProxyDescriptorName,
+		} {
+			// descriptor of the instance is already registered
+			err := kvs.ForInstance(scheduler, name).RegisterKVDescriptor(&kvs.KVDescriptor{Name: descriptor})
+			Expect(err).To(Equal(kvs.ErrDescriptorExists), "descriptor %s of instance %s", descriptor, name)
+		}
+		metadata := kvs.ForInstance(scheduler, name).GetMetadataMap(ifdescriptor.InterfaceDescriptorName)
+		Expect(metadata).ToNot(BeNil())
+	}
+	Expect(scheduler.GetRegisteredNBKeyPrefixes()).To(ContainElement(
+		"vpp-instance/numa2/config/vpp/v2/interfaces/"))
+}
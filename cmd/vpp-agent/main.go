@@ -76,9 +76,12 @@ func main() {
 		defer debug.Start().Stop()
 	}
 
+	// plugins of VPP instances must register their descriptors
+	// before orchestrator starts watching key prefixes
+	vppInstances := app.NewVPPInstances()
 	vppAgent := app.New()
 	a := agent.NewAgent(
-		agent.AllPlugins(vppAgent),
+		agent.AllPlugins(vppInstances, vppAgent),
 		agent.StartTimeout(startTimeout),
 		agent.StopTimeout(stopTimeout),
 	)
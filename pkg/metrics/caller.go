@@ -0,0 +1,88 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"sync"
+)
+
+type callerCtxKey struct{}
+
+// WithCaller returns context which attributes calls made with the context
+// to the named caller. The caller from context takes precedence over
+// the active caller (see SetCaller), empty name can be used to prevent
+// attributing calls to the active caller.
+func WithCaller(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, callerCtxKey{}, name)
+}
+
+// CallerFromContext returns the name of the caller from the context
+// or the active caller if the context does not define caller.
+func CallerFromContext(ctx context.Context) string {
+	if ctx != nil {
+		if name, ok := ctx.Value(callerCtxKey{}).(string); ok {
+			return name
+		}
+	}
+	return Caller()
+}
+
+var (
+	activeMu      sync.Mutex
+	activeCallers []*activeCaller
+)
+
+type activeCaller struct {
+	name string
+}
+
+// SetCaller sets the active caller (e.g. descriptor executing an operation),
+// calls made without caller in context are attributed to the most recently
+// set active caller until the returned function is called. The returned
+// function removes the caller, callers set and removed in any order
+// do not leave stale active caller behind.
+//
+// Active caller is global, it should be set only around operations which are
+// not executed concurrently (e.g. descriptor operations of KVScheduler
+// transactions), calls made concurrently by other goroutines should use
+// WithCaller to be attributed correctly.
+func SetCaller(name string) (restore func()) {
+	caller := &activeCaller{name: name}
+	activeMu.Lock()
+	activeCallers = append(activeCallers, caller)
+	activeMu.Unlock()
+
+	return func() {
+		activeMu.Lock()
+		defer activeMu.Unlock()
+		for i, c := range activeCallers {
+			if c == caller {
+				activeCallers = append(activeCallers[:i], activeCallers[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+// Caller returns the name of the active caller, or empty string if not set.
+func Caller() string {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	if len(activeCallers) == 0 {
+		return ""
+	}
+	return activeCallers[len(activeCallers)-1].name
+}
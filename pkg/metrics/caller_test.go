@@ -0,0 +1,102 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestConcurrentCallers(t *testing.T) {
+	RegisterTestingT(t)
+
+	const pollers = 10
+	var (
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		wrong []string
+	)
+	check := func(ctx context.Context, expected string) {
+		if caller := CallerFromContext(ctx); caller != expected {
+			mu.Lock()
+			wrong = append(wrong, fmt.Sprintf("expected %q, got %q", expected, caller))
+			mu.Unlock()
+		}
+	}
+
+	// descriptor operations executed one by one
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			descriptor := fmt.Sprintf("descriptor-%d", i%3)
+			restore := SetCaller(descriptor)
+			check(context.Background(), descriptor)
+			restore()
+		}
+	}()
+	// pollers running concurrently with the descriptors
+	for i := 0; i < pollers; i++ {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			ctx := WithCaller(context.Background(), name)
+			for j := 0; j < 1000; j++ {
+				check(ctx, name)
+			}
+		}(fmt.Sprintf("poller-%d", i))
+	}
+	// calls which must not be attributed to any caller
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ctx := WithCaller(context.Background(), "")
+		for j := 0; j < 1000; j++ {
+			check(ctx, "")
+		}
+	}()
+	wg.Wait()
+
+	Expect(wrong).To(BeEmpty())
+	Expect(Caller()).To(BeEmpty())
+}
+
+func TestInterleavedCallers(t *testing.T) {
+	RegisterTestingT(t)
+
+	restoreA := SetCaller("a")
+	restoreB := SetCaller("b")
+	Expect(Caller()).To(Equal("b"))
+
+	// caller set earlier is restored first
+	restoreA()
+	Expect(Caller()).To(Equal("b"))
+	Expect(CallerFromContext(context.Background())).To(Equal("b"))
+	Expect(CallerFromContext(WithCaller(context.Background(), "c"))).To(Equal("c"))
+
+	restoreB()
+	Expect(Caller()).To(BeEmpty())
+
+	// calling restore repeatedly has no effect
+	restoreC := SetCaller("c")
+	restoreB()
+	Expect(Caller()).To(Equal("c"))
+	restoreC()
+	Expect(Caller()).To(BeEmpty())
+}
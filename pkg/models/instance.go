@@ -0,0 +1,67 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package models
+
+import "strings"
+
+// Keys of VPP models (config/vpp/...) and keys of values derived by VPP
+// descriptors (vpp/...) refer to the default VPP instance. Keys of other
+// VPP instances managed by the agent are prefixed with InstanceKeyPrefix
+// followed by the instance name, e.g.:
+//
+//	vpp-instance/numa1/config/vpp/v2/interfaces/loop1
+const InstanceKeyPrefix = "vpp-instance/"
+
+// key prefixes of values which belong to VPP instance
+var instanceScopedKeyPrefixes = []string{
+	"config/vpp/",
+	"vpp/",
+}
+
+// IsInstanceScopedKey returns true if the key (without instance prefix)
+// belongs to a VPP instance.
+func IsInstanceScopedKey(key string) bool {
+	for _, prefix := range instanceScopedKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// InstanceKey returns the key in the namespace of the given VPP instance.
+// Keys of the default instance (empty name) and keys which do not belong
+// to VPP instances are returned unchanged.
+func InstanceKey(instance, key string) string {
+	if instance == "" || !IsInstanceScopedKey(key) {
+		return key
+	}
+	return InstanceKeyPrefix + instance + "/" + key
+}
+
+// ParseInstanceKey returns the name of VPP instance from the key and the key
+// without instance prefix. Empty instance name is returned for keys without
+// the instance prefix.
+func ParseInstanceKey(key string) (instance, instanceKey string) {
+	if !strings.HasPrefix(key, InstanceKeyPrefix) {
+		return "", key
+	}
+	rest := strings.TrimPrefix(key, InstanceKeyPrefix)
+	i := strings.Index(rest, "/")
+	if i <= 0 {
+		return "", key
+	}
+	return rest[:i], rest[i+1:]
+}
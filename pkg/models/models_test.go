@@ -63,3 +63,23 @@ func setupTest(t *testing.T) *testContext {
 	return &testContext{GomegaWithT: g}
 }
 func (tc *testContext) teardownTest() {}
+
+func TestInstanceKey(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	key := "config/vpp/v2/interfaces/loop1"
+	g.Expect(models.InstanceKey("", key)).To(Equal(key))
+	g.Expect(models.InstanceKey("numa1", key)).To(Equal("vpp-instance/numa1/" + key))
+	g.Expect(models.InstanceKey("numa1", "vpp/interface/loop1/address/static/10.0.0.1/24")).
+		To(Equal("vpp-instance/numa1/vpp/interface/loop1/address/static/10.0.0.1/24"))
+	g.Expect(models.InstanceKey("numa1", "config/linux/interfaces/v2/interface/veth1")).
+		To(Equal("config/linux/interfaces/v2/interface/veth1"))
+
+	instance, instanceKey := models.ParseInstanceKey("vpp-instance/numa1/" + key)
+	g.Expect(instance).To(Equal("numa1"))
+	g.Expect(instanceKey).To(Equal(key))
+
+	instance, instanceKey = models.ParseInstanceKey(key)
+	g.Expect(instance).To(BeEmpty())
+	g.Expect(instanceKey).To(Equal(key))
+}
@@ -119,7 +119,9 @@ func (r *LocalRegistry) lazyInitRegisteredTypesByProtoName() {
 }
 
 // GetModelForKey returns registered model for the given key or error.
+// Keys of VPP instances are matched without the instance prefix.
 func (r *LocalRegistry) GetModelForKey(key string) (KnownModel, error) {
+	_, key = ParseInstanceKey(key)
 	for _, model := range r.registeredModelsByGoType {
 		if model.IsKeyValid(key) {
 			return model, nil
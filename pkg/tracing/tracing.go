@@ -0,0 +1,244 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package tracing provides lightweight trace spans which are handed over
+// to the registered exporter once they end. Without exporter, starting
+// a span is a no-op and all methods of the returned nil span are safe to call.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// TraceparentHeader is the W3C Trace Context header (and gRPC metadata key)
+// used to propagate the parent span from the caller.
+const TraceparentHeader = "traceparent"
+
+// TraceID identifies a trace.
+type TraceID [16]byte
+
+// String returns the hex representation of the trace ID.
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// IsValid returns true if the trace ID is non-zero.
+func (id TraceID) IsValid() bool {
+	return id != TraceID{}
+}
+
+// SpanID identifies a span within a trace.
+type SpanID [8]byte
+
+// String returns the hex representation of the span ID.
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+// IsValid returns true if the span ID is non-zero.
+func (id SpanID) IsValid() bool {
+	return id != SpanID{}
+}
+
+// Attribute is a key-value attribute of a span.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// Attr returns span attribute with the value formatted by fmt.Sprint.
+func Attr(key string, value interface{}) Attribute {
+	return Attribute{Key: key, Value: fmt.Sprint(value)}
+}
+
+// Span represents a single timed operation.
+type Span struct {
+	TraceID    TraceID
+	SpanID     SpanID
+	ParentID   SpanID
+	Name       string
+	StartTime  time.Time
+	EndTime    time.Time
+	Attributes []Attribute
+	Err        error
+
+	exporter Exporter
+	mu       sync.Mutex
+	ended    bool
+}
+
+// SetAttributes adds attributes to the span.
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Attributes = append(s.Attributes, attrs...)
+}
+
+// SetError marks the span as failed with the given error (nil is ignored).
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Err = err
+}
+
+// End ends the span and passes it to the exporter. Only the first call has effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.EndTime = time.Now()
+	s.mu.Unlock()
+
+	s.exporter.ExportSpan(s)
+}
+
+// Exporter receives ended spans.
+type Exporter interface {
+	// ExportSpan is called for every ended span. It should not block.
+	ExportSpan(span *Span)
+}
+
+var (
+	mu       sync.RWMutex
+	exporter Exporter
+)
+
+// SetExporter registers exporter for ended spans, nil disables tracing.
+func SetExporter(e Exporter) {
+	mu.Lock()
+	defer mu.Unlock()
+	exporter = e
+}
+
+// Enabled returns true if an exporter is registered.
+func Enabled() bool {
+	return getExporter() != nil
+}
+
+func getExporter() Exporter {
+	mu.RLock()
+	defer mu.RUnlock()
+	return exporter
+}
+
+type spanKey struct{}
+
+type remoteParentKey struct{}
+
+type remoteParent struct {
+	traceID TraceID
+	spanID  SpanID
+}
+
+// StartSpan starts a new span as a child of the span in the context (or the remote
+// parent set by WithTraceparent) and returns context with the new span.
+// If tracing is not enabled, the context is returned unchanged with nil span.
+func StartSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	e := getExporter()
+	if e == nil {
+		return ctx, nil
+	}
+	span := &Span{
+		SpanID:     newSpanID(),
+		Name:       name,
+		StartTime:  time.Now(),
+		Attributes: attrs,
+		exporter:   e,
+	}
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.TraceID = parent.TraceID
+		span.ParentID = parent.SpanID
+	} else if remote, ok := ctx.Value(remoteParentKey{}).(remoteParent); ok {
+		span.TraceID = remote.traceID
+		span.ParentID = remote.spanID
+	} else {
+		span.TraceID = newTraceID()
+	}
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// SpanFromContext returns the span stored in the context or nil.
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// WithTraceparent returns context with remote parent span parsed from
+// W3C traceparent header (e.g. "00-<trace-id>-<parent-id>-<flags>").
+// Invalid header is ignored.
+func WithTraceparent(ctx context.Context, traceparent string) context.Context {
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return ctx
+	}
+	var remote remoteParent
+	if !decodeHex(remote.traceID[:], parts[1]) || !decodeHex(remote.spanID[:], parts[2]) {
+		return ctx
+	}
+	if !remote.traceID.IsValid() || !remote.spanID.IsValid() {
+		return ctx
+	}
+	return context.WithValue(ctx, remoteParentKey{}, remote)
+}
+
+// WithIncomingTraceparent returns context with remote parent span from
+// traceparent in the incoming gRPC metadata (if present).
+func WithIncomingTraceparent(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[TraceparentHeader]) != 1 {
+		return ctx
+	}
+	return WithTraceparent(ctx, md[TraceparentHeader][0])
+}
+
+func decodeHex(dst []byte, s string) bool {
+	if hex.DecodedLen(len(s)) != len(dst) {
+		return false
+	}
+	_, err := hex.Decode(dst, []byte(s))
+	return err == nil
+}
+
+func newTraceID() (id TraceID) {
+	_, _ = rand.Read(id[:])
+	return id
+}
+
+func newSpanID() (id SpanID) {
+	_, _ = rand.Read(id[:])
+	return id
+}
@@ -0,0 +1,95 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+type testExporter struct {
+	spans []*Span
+}
+
+func (e *testExporter) ExportSpan(span *Span) {
+	e.spans = append(e.spans, span)
+}
+
+func TestDisabled(t *testing.T) {
+	RegisterTestingT(t)
+
+	SetExporter(nil)
+	ctx, span := StartSpan(context.Background(), "test")
+	Expect(span).To(BeNil())
+	Expect(SpanFromContext(ctx)).To(BeNil())
+
+	// nil span is safe to use
+	span.SetAttributes(Attr("key", "value"))
+	span.SetError(errors.New("error"))
+	span.End()
+}
+
+func TestChildSpan(t *testing.T) {
+	RegisterTestingT(t)
+
+	exporter := &testExporter{}
+	SetExporter(exporter)
+	defer SetExporter(nil)
+
+	ctx, parent := StartSpan(context.Background(), "parent", Attr("seq", 1))
+	_, child := StartSpan(ctx, "child")
+	child.SetError(errors.New("failed"))
+	child.End()
+	parent.End()
+	parent.End()
+
+	Expect(exporter.spans).To(HaveLen(2))
+	Expect(exporter.spans[0]).To(Equal(child))
+	Expect(exporter.spans[1]).To(Equal(parent))
+	Expect(parent.TraceID.IsValid()).To(BeTrue())
+	Expect(parent.ParentID.IsValid()).To(BeFalse())
+	Expect(parent.Attributes).To(ConsistOf(Attribute{Key: "seq", Value: "1"}))
+	Expect(child.TraceID).To(Equal(parent.TraceID))
+	Expect(child.ParentID).To(Equal(parent.SpanID))
+	Expect(child.Err).To(MatchError("failed"))
+}
+
+func TestTraceparent(t *testing.T) {
+	RegisterTestingT(t)
+
+	SetExporter(&testExporter{})
+	defer SetExporter(nil)
+
+	ctx := WithTraceparent(context.Background(),
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	_, span := StartSpan(ctx, "test")
+	Expect(span.TraceID.String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+	Expect(span.ParentID.String()).To(Equal("00f067aa0ba902b7"))
+
+	for _, invalid := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-xxf067aa0ba902b7-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		ctx := WithTraceparent(context.Background(), invalid)
+		_, span := StartSpan(ctx, "test")
+		Expect(span.ParentID.IsValid()).To(BeFalse(), invalid)
+	}
+}
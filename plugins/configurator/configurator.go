@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	"go.ligato.io/vpp-agent/v3/pkg/util"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
@@ -80,11 +81,18 @@ func (svc *configuratorServer) Get(context.Context, *pb.GetRequest) (*pb.GetResp
 }
 
 // Update adds configuration data present in data request to the VPP/Linux
-func (svc *configuratorServer) Update(ctx context.Context, req *pb.UpdateRequest) (*pb.UpdateResponse, error) {
+func (svc *configuratorServer) Update(ctx context.Context, req *pb.UpdateRequest) (_ *pb.UpdateResponse, err error) {
 	ctx, task := trace.NewTask(ctx, "grpc.Update")
 	defer task.End()
 	trace.Logf(ctx, "updateData", "%+v", req)
 
+	ctx, span := tracing.StartSpan(tracing.WithIncomingTraceparent(ctx), "configurator.Update",
+		tracing.Attr("full_resync", req.FullResync), tracing.Attr("wait_done", req.WaitDone))
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+
 	defer trackOperation("Update")()
 
 	protos := util.ExtractProtos(
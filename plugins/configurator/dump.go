@@ -159,6 +159,31 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		svc.log.Errorf("DumpNAT44AddressPools failed: %v", err)
 		return nil, err
 	}
+	dump.VppConfig.Nat64Prefixes, err = svc.DumpNAT64IPv6Prefixes()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64IPv6Prefixes failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64Interfaces, err = svc.DumpNAT64Interfaces()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64Interfaces failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64Pools, err = svc.DumpNAT64AddressPools()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64AddressPools failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Nat64StaticBibs, err = svc.DumpNAT64StaticBIBs()
+	if err != nil {
+		svc.log.Errorf("DumpNAT64StaticBIBs failed: %v", err)
+		return nil, err
+	}
+	dump.VppConfig.Det44Mappings, err = svc.DumpDET44Mappings()
+	if err != nil {
+		svc.log.Errorf("DumpDET44Mappings failed: %v", err)
+		return nil, err
+	}
 	dump.VppConfig.PuntTohosts, err = svc.DumpPunt()
 	if err != nil {
 		svc.log.Errorf("DumpPunt failed: %v", err)
@@ -196,6 +221,12 @@ func (svc *dumpService) Dump(ctx context.Context, req *rpc.DumpRequest) (*rpc.Du
 		return nil, err
 	}
 
+	dump.LinuxConfig.Rules, err = svc.DumpLinuxRules()
+	if err != nil {
+		svc.log.Errorf("DumpLinuxRules failed: %v", err)
+		return nil, err
+	}
+
 	return &rpc.DumpResponse{Dump: dump}, nil
 }
 
@@ -445,6 +476,76 @@ func (svc *dumpService) DumpNAT44AddressPools() (natPools []*vpp_nat.Nat44Addres
 	return natPools, nil
 }
 
+// DumpNAT64IPv6Prefixes dumps Nat64IPv6Prefixes
+func (svc *dumpService) DumpNAT64IPv6Prefixes() (prefixes []*vpp_nat.Nat64IPv6Prefix, err error) {
+	if svc.natHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	prefixes, err = svc.natHandler.Nat64IPv6PrefixDump()
+	if err != nil {
+		return nil, err
+	}
+	return prefixes, nil
+}
+
+// DumpNAT64Interfaces dumps Nat64Interfaces
+func (svc *dumpService) DumpNAT64Interfaces() (natIfs []*vpp_nat.Nat64Interface, err error) {
+	if svc.natHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	natIfs, err = svc.natHandler.Nat64InterfacesDump()
+	if err != nil {
+		return nil, err
+	}
+	return natIfs, nil
+}
+
+// DumpNAT64AddressPools dumps Nat64AddressPools
+func (svc *dumpService) DumpNAT64AddressPools() (natPools []*vpp_nat.Nat64AddressPool, err error) {
+	if svc.natHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	natPools, err = svc.natHandler.Nat64AddressPoolsDump()
+	if err != nil {
+		return nil, err
+	}
+	return natPools, nil
+}
+
+// DumpNAT64StaticBIBs dumps Nat64StaticBIBs
+func (svc *dumpService) DumpNAT64StaticBIBs() (bibs []*vpp_nat.Nat64StaticBIB, err error) {
+	if svc.natHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	bibs, err = svc.natHandler.Nat64StaticBIBsDump()
+	if err != nil {
+		return nil, err
+	}
+	return bibs, nil
+}
+
+// DumpDET44Mappings dumps Det44Mappings
+func (svc *dumpService) DumpDET44Mappings() (mappings []*vpp_nat.Det44Mapping, err error) {
+	if svc.natHandler == nil {
+		// handler is not available
+		return nil, nil
+	}
+
+	mappings, err = svc.natHandler.Det44MappingsDump()
+	if err != nil {
+		return nil, err
+	}
+	return mappings, nil
+}
+
 // DumpPunt reads VPP Punt socket registrations and returns them as an *PuntResponse.
 func (svc *dumpService) DumpPunt() (punts []*vpp_punt.ToHost, err error) {
 	if svc.puntHandler == nil {
@@ -547,3 +648,21 @@ func (svc *dumpService) DumpLinuxRoutes() (linuxRoutes []*linux_l3.Route, err er
 
 	return linuxRoutes, nil
 }
+
+// DumpLinuxRules reads linux policy routing rules and returns them as an *LinuxRulesResponse.
+// If reading ends up with error, only error is send back in response
+func (svc *dumpService) DumpLinuxRules() (linuxRules []*linux_l3.Rule, err error) {
+	if svc.linuxL3Handler == nil {
+		return nil, errors.New("linuxL3Handler is not available")
+	}
+
+	ruleDetails, err := svc.linuxL3Handler.DumpRules()
+	if err != nil {
+		return nil, err
+	}
+	for _, rule := range ruleDetails {
+		linuxRules = append(linuxRules, rule.Rule)
+	}
+
+	return linuxRules, nil
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.ligato.io/vpp-agent/v3/pkg/metrics"
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 )
 
 var (
@@ -36,12 +37,16 @@ func GetStats() *Stats {
 	statsMu.RLock()
 	*s = stats
 	statsMu.RUnlock()
+	s.VppCalls = govppmux.GetCallStats()
 	return s
 }
 
 type Stats struct {
 	AllOperations metrics.CallStats
 	Operations    metrics.Calls
+	// VppCalls contains stats of VPP binary API requests
+	// per message and calling descriptor.
+	VppCalls []govppmux.CallStats
 }
 
 func (s *Stats) getOrCreateOperation(msg string) *metrics.CallStats {
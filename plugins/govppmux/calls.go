@@ -0,0 +1,123 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	calls   = make(map[callKey]*callStats)
+	callsMu sync.Mutex
+)
+
+// CallStats contains statistics of binary API requests with the message
+// sent by the descriptor. Durations are in seconds.
+type CallStats struct {
+	Message    string  `json:"message"`
+	Descriptor string  `json:"descriptor,omitempty"`
+	Count      uint64  `json:"count"`
+	Errors     uint64  `json:"errors,omitempty"`
+	Avg        float64 `json:"avg,omitempty"`
+	Max        float64 `json:"max,omitempty"`
+	P99        float64 `json:"p99,omitempty"`
+}
+
+type callKey struct {
+	message    string
+	descriptor string
+}
+
+type callStats struct {
+	count  uint64
+	errors uint64
+	total  time.Duration
+	max    time.Duration
+	// counts of requests per bucket of requestDurationBuckets,
+	// last bucket counts requests exceeding the highest bound
+	buckets []uint64
+}
+
+func trackCall(message, descriptor string, took time.Duration, failed bool) {
+	callsMu.Lock()
+	defer callsMu.Unlock()
+
+	key := callKey{message: message, descriptor: descriptor}
+	cs, ok := calls[key]
+	if !ok {
+		cs = &callStats{buckets: make([]uint64, len(requestDurationBuckets)+1)}
+		calls[key] = cs
+	}
+	cs.count++
+	if failed {
+		cs.errors++
+	}
+	cs.total += took
+	if took > cs.max {
+		cs.max = took
+	}
+	cs.buckets[sort.SearchFloat64s(requestDurationBuckets, took.Seconds())]++
+}
+
+// quantile returns upper bound of the bucket containing the q-quantile
+// of request durations in seconds, which is limited by the maximum duration.
+func (cs *callStats) quantile(q float64) float64 {
+	rank := uint64(math.Ceil(q * float64(cs.count)))
+	var cumulative uint64
+	for i, n := range cs.buckets {
+		cumulative += n
+		if cumulative < rank || i == len(requestDurationBuckets) {
+			continue
+		}
+		if bound := requestDurationBuckets[i]; bound < cs.max.Seconds() {
+			return bound
+		}
+		break
+	}
+	return cs.max.Seconds()
+}
+
+// GetCallStats returns statistics of binary API requests per message and
+// calling descriptor, sorted by number of requests.
+func GetCallStats() []CallStats {
+	callsMu.Lock()
+	defer callsMu.Unlock()
+
+	list := make([]CallStats, 0, len(calls))
+	for key, cs := range calls {
+		list = append(list, CallStats{
+			Message:    key.message,
+			Descriptor: key.descriptor,
+			Count:      cs.count,
+			Errors:     cs.errors,
+			Avg:        (cs.total / time.Duration(cs.count)).Seconds(),
+			Max:        cs.max.Seconds(),
+			P99:        cs.quantile(0.99),
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		if list[i].Message != list[j].Message {
+			return list[i].Message < list[j].Message
+		}
+		return list[i].Descriptor < list[j].Descriptor
+	})
+	return list
+}
@@ -0,0 +1,129 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	govppapi "git.fd.io/govpp.git/api"
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/pkg/metrics"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip"
+	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vpe"
+)
+
+func findCallStats(message, descriptor string) *CallStats {
+	for _, cs := range GetCallStats() {
+		if cs.Message == message && cs.Descriptor == descriptor {
+			return &cs
+		}
+	}
+	return nil
+}
+
+func TestTrackCall(t *testing.T) {
+	RegisterTestingT(t)
+
+	for i := 0; i < 98; i++ {
+		trackCall("test_route_add_del", "test-route", time.Millisecond, false)
+	}
+	trackCall("test_route_add_del", "test-route", 100*time.Millisecond, true)
+	trackCall("test_route_add_del", "test-route", 200*time.Millisecond, true)
+	trackCall("test_route_add_del", "", 3*time.Second, false)
+
+	cs := findCallStats("test_route_add_del", "test-route")
+	Expect(cs).ToNot(BeNil())
+	Expect(cs.Count).To(BeEquivalentTo(100))
+	Expect(cs.Errors).To(BeEquivalentTo(2))
+	Expect(cs.Max).To(Equal(0.2))
+	// 99th request falls into bucket with upper bound 128ms
+	Expect(cs.P99).To(Equal(0.128))
+
+	// quantile is limited by maximum duration
+	cs = findCallStats("test_route_add_del", "")
+	Expect(cs).ToNot(BeNil())
+	Expect(cs.Count).To(BeEquivalentTo(1))
+	Expect(cs.P99).To(Equal(3.0))
+}
+
+// testStream is stream replying with the given messages.
+type testStream struct {
+	govppapi.Stream
+	replies []govppapi.Message
+}
+
+func (s *testStream) SendMsg(govppapi.Message) error {
+	return nil
+}
+
+func (s *testStream) RecvMsg() (govppapi.Message, error) {
+	if len(s.replies) == 0 {
+		return nil, errors.New("no reply")
+	}
+	reply := s.replies[0]
+	s.replies = s.replies[1:]
+	return reply, nil
+}
+
+func dumpRoutes(stream govppapi.Stream) error {
+	if err := stream.SendMsg(&vpp_ip.IPRouteDump{}); err != nil {
+		return err
+	}
+	if err := stream.SendMsg(&vpp_vpe.ControlPing{}); err != nil {
+		return err
+	}
+	for {
+		msg, err := stream.RecvMsg()
+		if err != nil {
+			return err
+		}
+		if _, ok := msg.(*vpp_vpe.ControlPingReply); ok {
+			return nil
+		}
+	}
+}
+
+func TestStreamCalls(t *testing.T) {
+	RegisterTestingT(t)
+
+	// the active caller is set by descriptor while the dump is done by poller
+	restore := metrics.SetCaller("test-descriptor")
+	defer restore()
+
+	ctx := metrics.WithCaller(context.Background(), "test-poller")
+	stream := &goVppStream{
+		Stream: &testStream{replies: []govppapi.Message{
+			&vpp_ip.IPRouteDetails{},
+			&vpp_ip.IPRouteDetails{},
+			&vpp_vpe.ControlPingReply{},
+		}},
+		caller: metrics.CallerFromContext(ctx),
+	}
+	Expect(dumpRoutes(stream)).To(Succeed())
+
+	// failed dump is counted as error
+	Expect(dumpRoutes(stream)).ToNot(Succeed())
+
+	cs := findCallStats((&vpp_ip.IPRouteDump{}).GetMessageName(), "test-poller")
+	Expect(cs).ToNot(BeNil())
+	Expect(cs.Count).To(BeEquivalentTo(2))
+	Expect(cs.Errors).To(BeEquivalentTo(1))
+	Expect(findCallStats((&vpp_ip.IPRouteDump{}).GetMessageName(), "test-descriptor")).To(BeNil())
+	Expect(findCallStats((&vpp_vpe.ControlPing{}).GetMessageName(), "test-poller")).To(BeNil())
+}
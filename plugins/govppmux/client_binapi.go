@@ -22,42 +22,175 @@ import (
 	govppapi "git.fd.io/govpp.git/api"
 	"git.fd.io/govpp.git/core"
 	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/metrics"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+)
+
+const (
+	controlPingName      = "control_ping"
+	controlPingReplyName = "control_ping_reply"
 )
 
+// NewStream returns a new stream for communication with VPP via govpp core.
+// Requests sent via the stream are attributed to the caller from the context.
 func (p *Plugin) NewStream(ctx context.Context) (govppapi.Stream, error) {
-	return p.vppConn.NewStream(ctx)
+	s, err := p.vppConn.NewStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &goVppStream{
+		Stream:   s,
+		checkMsg: p.checkMessage,
+		caller:   metrics.CallerFromContext(ctx),
+	}, nil
 }
 
+// Invoke sends request to VPP and receives reply. The request is attributed
+// to the caller from the context.
 func (p *Plugin) Invoke(ctx context.Context, req govppapi.Message, reply govppapi.Message) error {
-	return p.vppConn.Invoke(ctx, req, reply)
+	if err := p.checkMessage(req); err != nil {
+		return err
+	}
+	start := time.Now()
+	reportRequestSent(req)
+
+	err := p.vppConn.Invoke(ctx, req, reply)
+	if err != nil {
+		reportRequestFailed(req, err)
+	} else {
+		reportRequestSuccess(req, start)
+		reportRepliesReceived(reply)
+	}
+	reportRequestDone(req, metrics.CallerFromContext(ctx), start, err)
+	return err
 }
 
 // NewAPIChannel returns a new API channel for communication with VPP via govpp core.
 // It uses default buffer sizes for the request and reply Go channels.
 func (p *Plugin) NewAPIChannel() (govppapi.Channel, error) {
+	return p.newAPIChannel("")
+}
+
+// NewAPIChannelBuffered returns a new API channel for communication with VPP via govpp core.
+// It allows to specify custom buffer sizes for the request and reply Go channels.
+func (p *Plugin) NewAPIChannelBuffered(reqChanBufSize, replyChanBufSize int) (govppapi.Channel, error) {
+	return p.newAPIChannelBuffered("", reqChanBufSize, replyChanBufSize)
+}
+
+func (p *Plugin) newAPIChannel(caller string) (govppapi.Channel, error) {
 	ch, err := p.vppConn.NewAPIChannel()
 	if err != nil {
 		return nil, err
 	}
-	retryCfg := retryConfig{
-		p.config.RetryRequestCount,
-		p.config.RetryRequestTimeout,
-	}
-	return newGovppChan(ch, retryCfg), nil
+	return newGovppChan(ch, p.requestRetryConfig(), p.checkMessage, caller), nil
 }
 
-// NewAPIChannelBuffered returns a new API channel for communication with VPP via govpp core.
-// It allows to specify custom buffer sizes for the request and reply Go channels.
-func (p *Plugin) NewAPIChannelBuffered(reqChanBufSize, replyChanBufSize int) (govppapi.Channel, error) {
+func (p *Plugin) newAPIChannelBuffered(caller string, reqChanBufSize, replyChanBufSize int) (govppapi.Channel, error) {
 	ch, err := p.vppConn.NewAPIChannelBuffered(reqChanBufSize, replyChanBufSize)
 	if err != nil {
 		return nil, err
 	}
-	retryCfg := retryConfig{
+	return newGovppChan(ch, p.requestRetryConfig(), p.checkMessage, caller), nil
+}
+
+func (p *Plugin) requestRetryConfig() retryConfig {
+	return retryConfig{
 		p.config.RetryRequestCount,
 		p.config.RetryRequestTimeout,
 	}
-	return newGovppChan(ch, retryCfg), nil
+}
+
+// WithCaller returns client which attributes all requests sent via the client
+// to the named caller, e.g. plugin polling VPP concurrently with descriptors.
+func (p *Plugin) WithCaller(name string) vpp.Client {
+	return &callerClient{Plugin: p, caller: name}
+}
+
+// callerClient is VPP client attributing requests to the caller.
+type callerClient struct {
+	*Plugin
+	caller string
+}
+
+func (c *callerClient) NewStream(ctx context.Context) (govppapi.Stream, error) {
+	return c.Plugin.NewStream(metrics.WithCaller(ctx, c.caller))
+}
+
+func (c *callerClient) Invoke(ctx context.Context, req govppapi.Message, reply govppapi.Message) error {
+	return c.Plugin.Invoke(metrics.WithCaller(ctx, c.caller), req, reply)
+}
+
+func (c *callerClient) NewAPIChannel() (govppapi.Channel, error) {
+	return c.Plugin.newAPIChannel(c.caller)
+}
+
+func (c *callerClient) NewAPIChannelBuffered(reqChanBufSize, replyChanBufSize int) (govppapi.Channel, error) {
+	return c.Plugin.newAPIChannelBuffered(c.caller, reqChanBufSize, replyChanBufSize)
+}
+
+// goVppStream is govpp stream reporting metrics of requests sent via the stream.
+type goVppStream struct {
+	govppapi.Stream
+	// checkMsg returns error for messages incompatible with VPP
+	checkMsg func(govppapi.Message) error
+	caller   string
+
+	// request in progress and its start time
+	request govppapi.Message
+	start   time.Time
+}
+
+func (s *goVppStream) SendMsg(msg govppapi.Message) error {
+	// messages incompatible with VPP are not sent at all
+	if s.checkMsg != nil {
+		if err := s.checkMsg(msg); err != nil {
+			return err
+		}
+	}
+	if s.request == nil && msg.GetMessageName() != controlPingName {
+		s.request = msg
+		s.start = time.Now()
+		reportRequestSent(msg)
+	}
+	err := s.Stream.SendMsg(msg)
+	if err != nil {
+		s.requestDone(err)
+	}
+	return err
+}
+
+func (s *goVppStream) RecvMsg() (govppapi.Message, error) {
+	msg, err := s.Stream.RecvMsg()
+	if s.request == nil {
+		return msg, err
+	}
+	if err != nil {
+		s.requestDone(err)
+		return msg, err
+	}
+	// request is done with reply to the request or to the control ping
+	// following the dump request
+	switch msg.GetMessageName() {
+	case controlPingReplyName, s.request.GetMessageName() + "_reply":
+		s.requestDone(nil)
+	default:
+		reportRepliesReceived(msg)
+	}
+	return msg, err
+}
+
+func (s *goVppStream) requestDone(err error) {
+	if s.request == nil {
+		return
+	}
+	if err != nil {
+		reportRequestFailed(s.request, err)
+	} else {
+		reportRequestSuccess(s.request, s.start)
+	}
+	reportRequestDone(s.request, s.caller, s.start, err)
+	s.request = nil
 }
 
 // goVppChan implements govpp channel interface. Instance is returned by NewAPIChannel() or NewAPIChannelBuffered(),
@@ -67,17 +200,38 @@ type goVppChan struct {
 	govppapi.Channel
 	// Retry data
 	retry retryConfig
+	// checkMsg returns error for messages incompatible with VPP
+	checkMsg func(govppapi.Message) error
+	// caller of all requests sent via the channel, active caller is used if empty
+	caller string
 }
 
-func newGovppChan(ch govppapi.Channel, retryCfg retryConfig) *goVppChan {
+func newGovppChan(ch govppapi.Channel, retryCfg retryConfig, checkMsg func(govppapi.Message) error, caller string) *goVppChan {
 	govppChan := &goVppChan{
-		Channel: ch,
-		retry:   retryCfg,
+		Channel:  ch,
+		retry:    retryCfg,
+		checkMsg: checkMsg,
+		caller:   caller,
 	}
 	reportChannelsOpened()
 	return govppChan
 }
 
+func (c *goVppChan) checkMessage(msg govppapi.Message) error {
+	if c.checkMsg == nil {
+		return nil
+	}
+	return c.checkMsg(msg)
+}
+
+// requestCaller returns caller of the request sent via the channel.
+func (c *goVppChan) requestCaller() string {
+	if c.caller != "" {
+		return c.caller
+	}
+	return metrics.Caller()
+}
+
 func (c *goVppChan) Close() {
 	c.Channel.Close()
 	reportChannelsClosed()
@@ -101,8 +255,9 @@ type govppRequestCtx struct {
 	// Parameter for sendRequest
 	requestMsg govppapi.Message
 
-	retry retryConfig
-	start time.Time
+	retry  retryConfig
+	start  time.Time
+	caller string
 }
 
 // govppMultirequestCtx is custom govpp MultiRequestCtx.
@@ -115,12 +270,17 @@ type govppMultirequestCtx struct {
 	// Parameter for sendRequest
 	requestMsg govppapi.Message
 
-	start time.Time
+	start  time.Time
+	caller string
 }
 
 // SendRequest sends asynchronous request to the vpp and receives context used to receive reply.
 // Plugin govppmux allows to re-send retry which failed because of disconnected vpp, if enabled.
 func (c *goVppChan) SendRequest(request govppapi.Message) govppapi.RequestCtx {
+	// requests using messages incompatible with VPP fail immediately
+	if err := c.checkMessage(request); err != nil {
+		return &failedRequestCtx{err: err}
+	}
 	ctx, task := trace.NewTask(context.Background(), "govpp.SendRequest")
 	trace.Log(ctx, "messageName", request.GetMessageName())
 
@@ -139,6 +299,7 @@ func (c *goVppChan) SendRequest(request govppapi.Message) govppapi.RequestCtx {
 		requestMsg:  request,
 		retry:       c.retry,
 		start:       start,
+		caller:      c.requestCaller(),
 	}
 }
 
@@ -171,11 +332,15 @@ func (r *govppRequestCtx) ReceiveReply(reply govppapi.Message) error {
 		reportRequestSuccess(r.requestMsg, r.start)
 		reportRepliesReceived(reply)
 	}
+	reportRequestDone(r.requestMsg, r.caller, r.start, err)
 	return err
 }
 
 // SendMultiRequest sends asynchronous request to the vpp and receives context used to receive reply.
 func (c *goVppChan) SendMultiRequest(request govppapi.Message) govppapi.MultiRequestCtx {
+	if err := c.checkMessage(request); err != nil {
+		return &failedMultiRequestCtx{err: err}
+	}
 	ctx, task := trace.NewTask(context.Background(), "govpp.SendMultiRequest")
 	trace.Log(ctx, "msgName", request.GetMessageName())
 
@@ -192,6 +357,7 @@ func (c *goVppChan) SendMultiRequest(request govppapi.Message) govppapi.MultiReq
 		requestCtx: requestCtx,
 		requestMsg: request,
 		start:      start,
+		caller:     c.requestCaller(),
 	}
 }
 
@@ -206,8 +372,27 @@ func (r *govppMultirequestCtx) ReceiveReply(reply govppapi.Message) (bool, error
 		} else {
 			reportRequestSuccess(r.requestMsg, r.start)
 		}
+		reportRequestDone(r.requestMsg, r.caller, r.start, err)
 	} else {
 		reportRepliesReceived(reply)
 	}
 	return last, err
 }
+
+// failedRequestCtx is request context of request that could not be sent.
+type failedRequestCtx struct {
+	err error
+}
+
+func (r *failedRequestCtx) ReceiveReply(govppapi.Message) error {
+	return r.err
+}
+
+// failedMultiRequestCtx is multi request context of request that could not be sent.
+type failedMultiRequestCtx struct {
+	err error
+}
+
+func (r *failedMultiRequestCtx) ReceiveReply(govppapi.Message) (bool, error) {
+	return false, r.err
+}
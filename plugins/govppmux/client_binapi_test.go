@@ -15,12 +15,18 @@
 package govppmux
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"git.fd.io/govpp.git/core"
 	. "github.com/onsi/gomega"
+	pkgerrors "github.com/pkg/errors"
 
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
 )
 
@@ -76,7 +82,7 @@ func TestRequestRetry(t *testing.T) {
 			defer ctx.TeardownTestCtx()
 
 			retryCfg := retryConfig{test.attempts, test.timeout}
-			ch := newGovppChan(ctx.MockChannel, retryCfg)
+			ch := newGovppChan(ctx.MockChannel, retryCfg, nil, "")
 
 			ctx.MockChannel.RetErrs = test.retErrs
 
@@ -99,3 +105,45 @@ func TestRequestRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestIncompatibleMessage(t *testing.T) {
+	RegisterTestingT(t)
+
+	ctx := vppmock.SetupTestCtx(t)
+	defer ctx.TeardownTestCtx()
+
+	incompatible := &vpp_ip.IPRouteAddDel{}
+	p := &Plugin{
+		incompatible: map[string]bool{
+			incompatible.GetMessageName() + "_" + incompatible.GetCrcString(): true,
+		},
+	}
+	expectIncompatible := func(err error) {
+		var msgErr *vpp.IncompatibleMessageError
+		Expect(errors.As(err, &msgErr)).To(BeTrue(), "unexpected error: %v", err)
+		Expect(msgErr.Message).To(Equal(incompatible.GetMessageName()))
+		Expect(msgErr.CRC).To(Equal(incompatible.GetCrcString()))
+		// descriptors failing on incompatible message are not retried
+		Expect(kvscheduler.IsNonRetryableError(pkgerrors.Wrap(err, "create failed"))).To(BeTrue())
+	}
+
+	// request via channel
+	ch := newGovppChan(ctx.MockChannel, retryConfig{}, p.checkMessage, "")
+	expectIncompatible(ch.SendRequest(incompatible).ReceiveReply(&vpp_ip.IPRouteAddDelReply{}))
+	_, err := ch.SendMultiRequest(incompatible).ReceiveReply(&vpp_ip.IPRouteAddDelReply{})
+	expectIncompatible(err)
+	Expect(ctx.MockChannel.Msgs).To(BeEmpty())
+
+	// request via invoke, not sent to VPP
+	expectIncompatible(p.Invoke(context.Background(), incompatible, &vpp_ip.IPRouteAddDelReply{}))
+
+	// request via stream
+	stream := &goVppStream{Stream: &testStream{}, checkMsg: p.checkMessage}
+	expectIncompatible(stream.SendMsg(incompatible))
+
+	// compatible messages are sent
+	ctx.MockVpp.MockReply(&vpp_ip.IPTableAddDelReply{})
+	Expect(ch.SendRequest(&vpp_ip.IPTableAddDel{}).ReceiveReply(&vpp_ip.IPTableAddDelReply{})).To(Succeed())
+	Expect(stream.SendMsg(&vpp_ip.IPRouteDump{})).To(Succeed())
+	Expect(kvscheduler.IsNonRetryableError(errors.New("other failure"))).To(BeFalse())
+}
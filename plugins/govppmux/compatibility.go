@@ -0,0 +1,87 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	govppapi "git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi"
+)
+
+func init() {
+	// requests using messages incompatible with VPP cannot succeed on retry
+	kvscheduler.AddNonRetryableError(vpp.ErrIncompatibleMessage)
+}
+
+// Compatibility describes compatibility of binapi versions with the connected VPP.
+type Compatibility struct {
+	// BinapiVersion is the binapi version used for the connected VPP.
+	BinapiVersion vpp.Version `json:"binapi_version"`
+	// Versions describes compatibility of each binapi version per message.
+	Versions []binapi.VersionCompatibility `json:"versions"`
+}
+
+// Compatibility returns compatibility of binapi versions probed after connecting to VPP.
+func (p *Plugin) Compatibility() Compatibility {
+	p.compatMu.RLock()
+	defer p.compatMu.RUnlock()
+	return Compatibility{
+		BinapiVersion: p.binapiVersion,
+		Versions:      p.compatibility,
+	}
+}
+
+// probeCompatibility checks compatibility of messages of all binapi versions
+// with the connected VPP. Requests using incompatible messages fail with
+// vpp.IncompatibleMessageError and only the features using them are disabled.
+func (p *Plugin) probeCompatibility() {
+	compat, err := binapi.ProbeCompatibility(p)
+	if err != nil {
+		p.Log.Warnf("probing binapi compatibility failed: %v", err)
+		return
+	}
+	incompatible := make(map[string]bool)
+	for _, vc := range compat {
+		p.Log.Debugf("binapi version %v: %d/%d messages compatible",
+			vc.Version, vc.Messages-len(vc.Incompatible), vc.Messages)
+		for _, msg := range vc.Incompatible {
+			incompatible[msg.Name+"_"+msg.CRC] = true
+		}
+		if vc.Version == p.binapiVersion && !vc.Compatible() {
+			p.Log.Warnf("binapi version %v is partly incompatible with VPP, %d messages are not available: %v",
+				vc.Version, len(vc.Incompatible), vc.Incompatible)
+		}
+	}
+
+	p.compatMu.Lock()
+	p.compatibility = compat
+	p.incompatible = incompatible
+	p.compatMu.Unlock()
+}
+
+// checkMessage returns error if the message is incompatible with the connected VPP.
+func (p *Plugin) checkMessage(msg govppapi.Message) error {
+	p.compatMu.RLock()
+	defer p.compatMu.RUnlock()
+	if p.incompatible[msg.GetMessageName()+"_"+msg.GetCrcString()] {
+		return &vpp.IncompatibleMessageError{
+			Message: msg.GetMessageName(),
+			CRC:     msg.GetCrcString(),
+		}
+	}
+	return nil
+}
@@ -14,7 +14,13 @@
 
 package govppmux
 
-import "time"
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/recorder"
+)
 
 // Config defines configurable parameters for govppmux plugin.
 type Config struct {
@@ -57,8 +63,44 @@ type Config struct {
 	HealthCheckReplyTimeout  time.Duration `json:"health-check-reply-timeout"`
 	HealthCheckThreshold     int           `json:"health-check-threshold"`
 
-	// DEPRECATED: TraceEnabled is obsolete and used only in older versions.
+	// DEPRECATED: TraceEnabled is obsolete and has no effect, use RecordEnabled
+	// to record binary API messages.
 	TraceEnabled bool `json:"trace-enabled"`
+
+	// RecordEnabled starts recording of binary API messages into RecordFile
+	// after connecting to VPP. Recording can be also started at runtime via REST API.
+	RecordEnabled bool `json:"record-enabled"`
+
+	// RecordFile defines path to the file with recorded binary API messages.
+	RecordFile string `json:"record-file"`
+
+	// RecordMaxSize defines size of the recording file in bytes after which the file is rotated.
+	RecordMaxSize int64 `json:"record-max-size"`
+
+	// RecordMaxFiles defines number of rotated recording files kept.
+	RecordMaxFiles int `json:"record-max-files"`
+
+	// Instances defines additional named VPP instances managed by the agent.
+	Instances []*InstanceConfig `json:"instances"`
+}
+
+// InstanceConfig defines connection to a named VPP instance. Other parameters
+// of the connection are inherited from the config of the default instance.
+type InstanceConfig struct {
+	// Name of the VPP instance used in keys of its values.
+	Name string `json:"name"`
+
+	// Connect to VPP for configuration requests via the shared memory instead of through the socket.
+	ConnectViaShm bool `json:"connect-via-shm"`
+
+	// ShmPrefix defines prefix prepended to the name used for shared memory (SHM) segments.
+	ShmPrefix string `json:"shm-prefix"`
+
+	// BinAPISocketPath defines path to the binapi socket file.
+	BinAPISocketPath string `json:"binapi-socket-path"`
+
+	// StatsSocketPath defines path to the stats socket file.
+	StatsSocketPath string `json:"stats-socket-path"`
 }
 
 func DefaultConfig() *Config {
@@ -71,7 +113,26 @@ func DefaultConfig() *Config {
 		RetryRequestTimeout:      500 * time.Millisecond,
 		RetryConnectTimeout:      time.Second,
 		ProxyEnabled:             true,
+		RecordFile:               filepath.Join(os.TempDir(), "vpp-binapi.rec"),
+		RecordMaxSize:            recorder.DefaultMaxSize,
+		RecordMaxFiles:           recorder.DefaultMaxFiles,
+	}
+}
+
+// instanceConfig returns config for the named VPP instance.
+func (c *Config) instanceConfig(instance *InstanceConfig) *Config {
+	cfg := *c
+	cfg.ConnectViaShm = instance.ConnectViaShm
+	cfg.ShmPrefix = instance.ShmPrefix
+	cfg.BinAPISocketPath = instance.BinAPISocketPath
+	cfg.StatsSocketPath = instance.StatsSocketPath
+	// proxy is available only for the default instance
+	cfg.ProxyEnabled = false
+	if cfg.RecordFile != "" {
+		cfg.RecordFile += "-" + instance.Name
 	}
+	cfg.Instances = nil
+	return &cfg
 }
 
 func (p *Plugin) loadConfig() (*Config, error) {
@@ -0,0 +1,141 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/infra"
+)
+
+// Instance returns connection to the named VPP instance, the default instance
+// is returned for empty name. VPP instances are defined in the config
+// (instances) and connections to all of them are established in Init.
+func (p *Plugin) Instance(name string) *Plugin {
+	if name == "" {
+		return p
+	}
+	p.instancesMu.Lock()
+	defer p.instancesMu.Unlock()
+
+	if p.instances == nil {
+		p.instances = make(map[string]*Plugin)
+	}
+	instance, ok := p.instances[name]
+	if !ok {
+		instance = &Plugin{
+			Deps:         p.Deps,
+			instanceName: name,
+		}
+		instance.PluginName = p.PluginName + infra.PluginName("-"+name)
+		p.instances[name] = instance
+	}
+	return instance
+}
+
+// InstanceName returns name of the VPP instance, empty for the default instance.
+func (p *Plugin) InstanceName() string {
+	return p.instanceName
+}
+
+// InstanceNames returns sorted names of the named VPP instances.
+func (p *Plugin) InstanceNames() []string {
+	p.instancesMu.Lock()
+	defer p.instancesMu.Unlock()
+
+	names := make([]string, 0, len(p.instances))
+	for name := range p.instances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetResync sets function which re-synchronizes values of the named VPP
+// instance after reconnect to the instance (resync-after-reconnect).
+// Values of other instances are not re-synchronized.
+func (p *Plugin) SetResync(resync func()) {
+	p.instancesMu.Lock()
+	defer p.instancesMu.Unlock()
+	p.resync = resync
+}
+
+// resyncInstance re-synchronizes values of the named VPP instance.
+func (p *Plugin) resyncInstance() {
+	p.instancesMu.Lock()
+	resync := p.resync
+	p.instancesMu.Unlock()
+
+	if resync == nil {
+		p.Log.Warn("Expected resync after VPP reconnect could not start because of missing resync of VPP instance")
+		return
+	}
+	resync()
+}
+
+// findInstance returns the named VPP instance or nil if it does not exist.
+func (p *Plugin) findInstance(name string) *Plugin {
+	p.instancesMu.Lock()
+	defer p.instancesMu.Unlock()
+	return p.instances[name]
+}
+
+func (p *Plugin) getInstances() []*Plugin {
+	var instances []*Plugin
+	for _, name := range p.InstanceNames() {
+		instances = append(instances, p.Instance(name))
+	}
+	return instances
+}
+
+// connectInstances establishes connections to the named VPP instances.
+func (p *Plugin) connectInstances() error {
+	configs := make(map[string]*InstanceConfig)
+	for _, cfg := range p.config.Instances {
+		if cfg.Name == "" || strings.ContainsAny(cfg.Name, "/@") {
+			return errors.Errorf("invalid name of VPP instance: %q", cfg.Name)
+		}
+		if _, duplicate := configs[cfg.Name]; duplicate {
+			return errors.Errorf("duplicate VPP instance: %q", cfg.Name)
+		}
+		if cfg.BinAPISocketPath == "" && cfg.ShmPrefix == "" {
+			return errors.Errorf("VPP instance %q requires binapi-socket-path or shm-prefix", cfg.Name)
+		}
+		configs[cfg.Name] = cfg
+		p.Instance(cfg.Name)
+	}
+
+	for _, instance := range p.getInstances() {
+		cfg, ok := configs[instance.instanceName]
+		if !ok {
+			return errors.Errorf("VPP instance %q is used but not defined in config", instance.instanceName)
+		}
+		instance.Log = p.Log.NewLogger(instance.instanceName)
+		instance.config = p.config.instanceConfig(cfg)
+
+		instance.Log.Debugf("connecting to VPP instance %s", instance.instanceName)
+		if err := instance.connect(); err != nil {
+			return errors.WithMessagef(err, "connecting to VPP instance %q failed", instance.instanceName)
+		}
+		// GoVPP registers messages globally, messages of only one binapi version can be used
+		if instance.binapiVersion != p.binapiVersion {
+			return errors.Errorf("VPP instance %q requires binapi version %s, but binapi version %s is used for default instance",
+				instance.instanceName, instance.binapiVersion, p.binapiVersion)
+		}
+	}
+	return nil
+}
@@ -22,10 +22,16 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
+// requestDurationBuckets are upper bounds of buckets for request durations,
+// lowest bucket start of upper bound 0.0005 sec (0.5 ms) with factor 2 and
+// highest bucket start of 0.0005 sec * 2^12 == 2.048 sec
+var requestDurationBuckets = prometheus.ExponentialBuckets(0.0005, 2, 13)
+
 // Set of raw Prometheus metrics.
 // Labels
 // * message
 // * error
+// * descriptor
 // Do not increment directly, use Report* methods.
 var (
 	channelsCreated = prometheus.NewCounter(prometheus.CounterOpts{
@@ -89,6 +95,23 @@ var (
 	},
 		[]string{"message"},
 	)
+	requestDurationSec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "ligato",
+		Subsystem: "govppmux",
+		Name:      "request_duration_seconds",
+		Help:      "Bucketed histogram of duration of requests by message name and calling descriptor.",
+		Buckets:   requestDurationBuckets,
+	},
+		[]string{"message", "descriptor"},
+	)
+	requestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "ligato",
+		Subsystem: "govppmux",
+		Name:      "request_errors_total",
+		Help:      "The total number of failed requests by message name and calling descriptor.",
+	},
+		[]string{"message", "descriptor"},
+	)
 )
 
 func init() {
@@ -100,6 +123,8 @@ func init() {
 	prometheus.MustRegister(requestsFailed)
 	prometheus.MustRegister(repliesReceived)
 	prometheus.MustRegister(successfulRequestHandlingSec)
+	prometheus.MustRegister(requestDurationSec)
+	prometheus.MustRegister(requestErrors)
 }
 
 func reportChannelsOpened() {
@@ -165,3 +190,14 @@ func reportRepliesReceived(reply govppapi.Message) {
 	atomic.AddUint64(&stats.RequestReplies, 1)
 	trackMsgReply(reply.GetMessageName())
 }
+
+// reportRequestDone reports duration and result of the request sent by the caller,
+// which is the descriptor executing an operation, plugin polling VPP or empty string.
+func reportRequestDone(request govppapi.Message, caller string, startTime time.Time, err error) {
+	took := time.Since(startTime)
+	requestDurationSec.WithLabelValues(request.GetMessageName(), caller).Observe(took.Seconds())
+	if err != nil {
+		requestErrors.WithLabelValues(request.GetMessageName(), caller).Inc()
+	}
+	trackCall(request.GetMessageName(), caller, took, err != nil)
+}
@@ -17,6 +17,7 @@ package govppmux
 import (
 	"context"
 	"encoding/gob"
+	"flag"
 	"fmt"
 	"os"
 	"reflect"
@@ -26,6 +27,7 @@ import (
 	"time"
 
 	"git.fd.io/govpp.git/adapter"
+	govppmock "git.fd.io/govpp.git/adapter/mock"
 	govppapi "git.fd.io/govpp.git/api"
 	govpp "git.fd.io/govpp.git/core"
 	"git.fd.io/govpp.git/proxy"
@@ -36,9 +38,11 @@ import (
 	"go.ligato.io/cn-infra/v2/logging"
 	"go.ligato.io/cn-infra/v2/rpc/rest"
 
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/recorder"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppsim"
 
 	_ "go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls/vpp2001"
 	_ "go.ligato.io/vpp-agent/v3/plugins/govppmux/vppcalls/vpp2005"
@@ -48,8 +52,14 @@ import (
 
 var (
 	disabledSocketClient = os.Getenv("GOVPPMUX_NOSOCK") != ""
+
+	vppBackend = flag.String("vpp", "", "VPP backend used by govppmux, "+
+		"set to \"sim\" to run agent against simulated in-process VPP")
 )
 
+// simulatedVPP is value of the -vpp flag selecting simulated VPP.
+const simulatedVPP = "sim"
+
 // Plugin is the govppmux plugin implementation.
 type Plugin struct {
 	Deps
@@ -60,12 +70,17 @@ type Plugin struct {
 
 	binapiVersion vpp.Version
 	vppAdapter    adapter.VppAPI
+	recordAdapter *recorder.Adapter
+	sim           *vppsim.VPP
 	vppConn       *govpp.Connection
 	vppConChan    chan govpp.ConnectionEvent
 	lastConnErr   error
-	vppapiChan    govppapi.Channel
 	lastPID       uint32
 
+	// vppapiChanMu synchronizes access to channel used for compatibility checks
+	vppapiChanMu sync.Mutex
+	vppapiChan   govppapi.Channel
+
 	onnConnectMu sync.Mutex
 	onConnects   []func()
 
@@ -81,6 +96,19 @@ type Plugin struct {
 	vppInfo   VPPInfo
 	lastEvent govpp.ConnectionEvent
 
+	// compatibility of binapi versions probed after connecting
+	// and set of incompatible messages (name_crc), compatMu
+	// is read-locked by every request
+	compatMu      sync.RWMutex
+	compatibility []binapi.VersionCompatibility
+	incompatible  map[string]bool
+
+	// name of the VPP instance, empty for the default instance
+	instanceName string
+	instancesMu  sync.Mutex
+	instances    map[string]*Plugin
+	resync       func()
+
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 }
@@ -93,12 +121,16 @@ type Deps struct {
 	Resync       *resync.Plugin
 }
 
-// Init is the entry point called by Agent Core. A single binary-API connection to VPP is established.
+// Init is the entry point called by Agent Core. A single binary-API connection to VPP is established
+// for the default VPP instance and for each of the named VPP instances.
 func (p *Plugin) Init() (err error) {
 	if p.config, err = p.loadConfig(); err != nil {
 		return err
 	}
 	p.Log.Debugf("config: %+v", p.config)
+	if p.config.TraceEnabled {
+		p.Log.Warnf("trace-enabled is deprecated and has no effect, use record-enabled to record binary API messages")
+	}
 
 	// set GoVPP config
 	govpp.HealthCheckProbeInterval = p.config.HealthCheckProbeInterval
@@ -106,7 +138,35 @@ func (p *Plugin) Init() (err error) {
 	govpp.HealthCheckThreshold = p.config.HealthCheckThreshold
 	govpp.DefaultReplyTimeout = p.config.ReplyTimeout
 
+	p.Log.Debugf("found %d registered VPP handlers", len(vpp.GetHandlers()))
+	for name, handler := range vpp.GetHandlers() {
+		versions := handler.Versions()
+		p.Log.Debugf("- handler: %-10s has %d versions: %v", name, len(versions), versions)
+	}
+
+	if err := p.connect(); err != nil {
+		return err
+	}
+	if err := p.connectInstances(); err != nil {
+		return err
+	}
+
+	// register REST API handlers
+	p.registerHandlers(p.HTTPHandlers)
+
+	return nil
+}
+
+// connect establishes connection to VPP binary API and stats API.
+func (p *Plugin) connect() (err error) {
 	var address string
+	if *vppBackend == simulatedVPP {
+		p.Log.Infof("using simulated VPP (version %s)", vppsim.Version)
+		p.sim = vppsim.New()
+	} else if *vppBackend != "" {
+		return errors.Errorf("unknown VPP backend %q", *vppBackend)
+	}
+
 	useShm := disabledSocketClient || p.config.ConnectViaShm || p.config.ShmPrefix != ""
 	if useShm {
 		address = p.config.ShmPrefix
@@ -114,19 +174,17 @@ func (p *Plugin) Init() (err error) {
 		address = p.config.BinAPISocketPath
 	}
 
-	p.Log.Debugf("found %d registered VPP handlers", len(vpp.GetHandlers()))
-	for name, handler := range vpp.GetHandlers() {
-		versions := handler.Versions()
-		p.Log.Debugf("- handler: %-10s has %d versions: %v", name, len(versions), versions)
-	}
-
 	// FIXME: this is a hack for GoVPP bug when register of the same message(same CRC and name) but different
 	//  VPP version overwrites the already registered message from one VPP version (map key is only CRC+name
 	//  and that didn't change with VPP version, but generated binapi generated 2 different go types for it).
 	//  Similar fix exists also for integration tests.
-	if err := p.hackForBugInGoVPPMessageCache(address, useShm); err != nil {
-		return errors.Errorf("can't apply hack fixing bug in GoVPP "+
-			"regarding stream's message type resolving: %v", err)
+	//  Messages are registered globally by the default instance only, named instances
+	//  are required to use the same binapi version (see connectInstances).
+	if p.instanceName == "" {
+		if err := p.hackForBugInGoVPPMessageCache(address, useShm); err != nil {
+			return errors.Errorf("can't apply hack fixing bug in GoVPP "+
+				"regarding stream's message type resolving: %v", err)
+		}
 	}
 
 	// TODO: Async connect & automatic reconnect support is not yet implemented in the agent,
@@ -134,7 +192,13 @@ func (p *Plugin) Init() (err error) {
 	startTime := time.Now()
 	p.Log.Debugf("connecting to VPP..")
 
-	p.vppAdapter = NewVppAdapter(address, useShm)
+	p.recordAdapter = recorder.NewAdapter(p.newVppAdapter(address, useShm))
+	p.vppAdapter = p.recordAdapter
+	if p.config.RecordEnabled {
+		if err := p.StartRecording(""); err != nil {
+			p.Log.Warnf("starting recording of binary API messages failed: %v", err)
+		}
+	}
 	p.vppConn, p.vppConChan, err = govpp.AsyncConnect(p.vppAdapter, p.config.RetryConnectCount, p.config.RetryConnectTimeout)
 	if err != nil {
 		return err
@@ -156,7 +220,7 @@ func (p *Plugin) Init() (err error) {
 	} else {
 		statsSocket = adapter.DefaultStatsSocket
 	}
-	statsAdapter := NewStatsAdapter(statsSocket)
+	statsAdapter := p.newStatsAdapter(statsSocket)
 	if statsAdapter == nil {
 		p.Log.Warnf("Unable to connect to the VPP statistics socket, nil stats adapter", err)
 	} else if p.statsConn, err = govpp.ConnectStats(statsAdapter); err != nil {
@@ -170,7 +234,7 @@ func (p *Plugin) Init() (err error) {
 		for _, msg := range msgList.AllMessages() {
 			gob.Register(msg)
 		}
-		err := p.startProxy(NewVppAdapter(address, useShm), NewStatsAdapter(statsSocket))
+		err := p.startProxy(p.newVppAdapter(address, useShm), p.newStatsAdapter(statsSocket))
 		if err != nil {
 			p.Log.Warnf("VPP proxy failed to start: %v", err)
 		} else {
@@ -178,12 +242,27 @@ func (p *Plugin) Init() (err error) {
 		}
 	}
 
-	// register REST API handlers
-	p.registerHandlers(p.HTTPHandlers)
-
 	return nil
 }
 
+// newVppAdapter returns adapter for VPP binary API, which connects
+// to simulated VPP if it is enabled.
+func (p *Plugin) newVppAdapter(address string, useShm bool) adapter.VppAPI {
+	if p.sim != nil {
+		return p.sim.NewAdapter()
+	}
+	return NewVppAdapter(address, useShm)
+}
+
+// newStatsAdapter returns adapter for VPP stats API. Simulated VPP
+// does not provide statistics, so mock adapter is used instead.
+func (p *Plugin) newStatsAdapter(socketName string) adapter.StatsAPI {
+	if p.sim != nil {
+		return govppmock.NewStatsAdapter()
+	}
+	return NewStatsAdapter(socketName)
+}
+
 // waitForConnectionEvent waits for Connected event from govpp
 func (p *Plugin) waitForConnectionEvent(vppConChan chan govpp.ConnectionEvent) error {
 	for {
@@ -205,7 +284,7 @@ func (p *Plugin) waitForConnectionEvent(vppConChan chan govpp.ConnectionEvent) e
 func (p *Plugin) hackForBugInGoVPPMessageCache(address string, useShm bool) error {
 	// connect to VPP
 	startTime := time.Now()
-	vppAdapter := NewVppAdapter(address, useShm)
+	vppAdapter := p.newVppAdapter(address, useShm)
 	vppConn, vppConChan, err := govpp.AsyncConnect(vppAdapter, p.config.RetryConnectCount, p.config.RetryConnectTimeout)
 	if err != nil {
 		return err
@@ -262,18 +341,35 @@ func (p *Plugin) AfterInit() error {
 	p.wg.Add(1)
 	go p.handleVPPConnectionEvents(ctx)
 
+	for _, instance := range p.getInstances() {
+		if err := instance.AfterInit(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 // Close cleans up the resources allocated by the govppmux plugin.
 func (p *Plugin) Close() error {
-	p.cancel()
+	for _, instance := range p.getInstances() {
+		if err := instance.Close(); err != nil {
+			p.Log.Errorf("closing VPP instance %s failed: %v", instance.instanceName, err)
+		}
+	}
+
+	if p.cancel != nil {
+		p.cancel()
+	}
 	p.wg.Wait()
 
 	defer func() {
 		if p.vppConn != nil {
 			p.vppConn.Disconnect()
 		}
+		if _, err := p.StopRecording(); err != nil {
+			p.Log.Errorf("stopping recording of binary API messages failed: %v", err)
+		}
 		if p.statsAdapter != nil {
 			if err := p.statsAdapter.Disconnect(); err != nil {
 				p.Log.Errorf("VPP statistics socket adapter disconnect error: %v", err)
@@ -294,16 +390,25 @@ func (p *Plugin) Version() vpp.Version {
 }
 
 func (p *Plugin) CheckCompatiblity(msgs ...govppapi.Message) error {
-	p.infoMu.Lock()
-	defer p.infoMu.Unlock()
+	apiChan, err := p.compatibilityChannel()
+	if err != nil {
+		return err
+	}
+	return apiChan.CheckCompatiblity(msgs...)
+}
+
+// compatibilityChannel returns channel used for compatibility checks.
+func (p *Plugin) compatibilityChannel() (govppapi.Channel, error) {
+	p.vppapiChanMu.Lock()
+	defer p.vppapiChanMu.Unlock()
 	if p.vppapiChan == nil {
 		apiChan, err := p.vppConn.NewAPIChannel()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		p.vppapiChan = apiChan
 	}
-	return p.vppapiChan.CheckCompatiblity(msgs...)
+	return p.vppapiChan, nil
 }
 
 func (p *Plugin) Stats() govppapi.StatsProvider {
@@ -341,14 +446,19 @@ func (p *Plugin) updateVPPInfo() (err error) {
 		return fmt.Errorf("VPP connection is nil")
 	}
 
-	p.vppapiChan, err = p.vppConn.NewAPIChannel()
+	apiChan, err := p.vppConn.NewAPIChannel()
 	if err != nil {
 		return err
 	}
-	p.binapiVersion, err = binapi.CompatibleVersion(p.vppapiChan)
+	p.vppapiChanMu.Lock()
+	p.vppapiChan = apiChan
+	p.vppapiChanMu.Unlock()
+
+	p.binapiVersion, err = binapi.CompatibleVersion(apiChan)
 	if err != nil {
 		return err
 	}
+	p.probeCompatibility()
 
 	p.vpeHandler, err = vppcalls.NewHandler(p)
 	if err != nil {
@@ -456,7 +566,11 @@ func (p *Plugin) handleVPPConnectionEvents(ctx context.Context) {
 
 				if p.config.ReconnectResync && p.lastConnErr != nil {
 					p.Log.Info("Starting resync after VPP reconnect")
-					if p.Resync != nil {
+					if p.instanceName != "" {
+						// only values of the named instance are re-synced
+						p.resyncInstance()
+						p.lastConnErr = nil
+					} else if p.Resync != nil {
 						p.Resync.DoResync()
 						p.lastConnErr = nil
 					} else {
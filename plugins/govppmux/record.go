@@ -0,0 +1,90 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/recorder"
+)
+
+// ErrInvalidRecordFile is returned when the name of the recording file
+// is not a plain file name.
+var ErrInvalidRecordFile = errors.New("recording file has to be a file name without directory")
+
+// StartRecording starts recording of binary API messages exchanged with VPP
+// into the file with the given name, which is created in the directory
+// of the recording file from config. The file from config is used if the name
+// is empty.
+func (p *Plugin) StartRecording(name string) error {
+	if p.recordAdapter == nil {
+		return errors.New("not connected to VPP")
+	}
+	file, err := p.recordFile(name)
+	if err != nil {
+		return err
+	}
+	rec, err := recorder.NewRecorder(recorder.Options{
+		File:     file,
+		MaxSize:  p.config.RecordMaxSize,
+		MaxFiles: p.config.RecordMaxFiles,
+	})
+	if err != nil {
+		return errors.WithMessage(err, "opening recording file failed")
+	}
+	if err := p.recordAdapter.Start(rec); err != nil {
+		p.Log.Warnf("closing previous recording failed: %v", err)
+	}
+	p.Log.Infof("recording of binary API messages started (file: %s)", file)
+	return nil
+}
+
+// recordFile returns path of the recording file with the given name.
+// Only plain file names are accepted so that the recording (and rotation
+// of the recorded files) cannot touch files outside of the recording directory.
+func (p *Plugin) recordFile(name string) (string, error) {
+	if name == "" {
+		return p.config.RecordFile, nil
+	}
+	if filepath.IsAbs(name) || filepath.Base(name) != name || strings.Contains(name, "..") {
+		return "", ErrInvalidRecordFile
+	}
+	return filepath.Join(filepath.Dir(p.config.RecordFile), name), nil
+}
+
+// StopRecording stops recording of binary API messages and returns status
+// of the stopped recording.
+func (p *Plugin) StopRecording() (recorder.Status, error) {
+	if p.recordAdapter == nil {
+		return recorder.Status{}, nil
+	}
+	status, err := p.recordAdapter.Stop()
+	if status.Recording {
+		p.Log.Infof("recording of binary API messages stopped (file: %s, records: %d)",
+			status.File, status.Records)
+	}
+	return status, err
+}
+
+// RecordingStatus returns status of recording of binary API messages.
+func (p *Plugin) RecordingStatus() recorder.Status {
+	if p.recordAdapter == nil {
+		return recorder.Status{}
+	}
+	return p.recordAdapter.Status()
+}
@@ -0,0 +1,105 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package govppmux
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"git.fd.io/govpp.git/adapter/mock"
+	. "github.com/onsi/gomega"
+	"github.com/unrolled/render"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/plugins/govppmux/recorder"
+)
+
+func newRecordTestPlugin(dir string) *Plugin {
+	p := &Plugin{
+		config:        &Config{RecordFile: filepath.Join(dir, "vpp-binapi.rec")},
+		recordAdapter: recorder.NewAdapter(mock.NewVppAdapter()),
+	}
+	p.Log = logging.ForPlugin("govppmux")
+	return p
+}
+
+func TestRecordFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		expected string
+		err      bool
+	}{
+		{name: "default", file: "", expected: "/var/rec/vpp-binapi.rec"},
+		{name: "file name", file: "numa1.rec", expected: "/var/rec/numa1.rec"},
+		{name: "absolute path", file: "/etc/passwd", err: true},
+		{name: "relative path", file: "sub/numa1.rec", err: true},
+		{name: "parent directory", file: "..", err: true},
+		{name: "path to parent directory", file: "../numa1.rec", err: true},
+		{name: "dots in name", file: "numa1..rec", err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			p := newRecordTestPlugin("/var/rec")
+			file, err := p.recordFile(test.file)
+			if test.err {
+				Expect(err).To(Equal(ErrInvalidRecordFile))
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestRecordStartHandler(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "record")
+	Expect(err).ToNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+	p := newRecordTestPlugin(filepath.Join(dir, "rec"))
+	Expect(os.Mkdir(filepath.Join(dir, "rec"), 0755)).To(Succeed())
+	handler := p.recordStartHandler(render.New())
+
+	start := func(body string) int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/govppmux/record/start", strings.NewReader(body))
+		handler(w, req)
+		return w.Code
+	}
+
+	// files outside of the recording directory are rejected
+	Expect(start(`{"file": "../outside.rec"}`)).To(Equal(http.StatusBadRequest))
+	Expect(start(`{"file": "` + filepath.Join(dir, "outside.rec") + `"}`)).To(Equal(http.StatusBadRequest))
+	_, err = os.Stat(filepath.Join(dir, "outside.rec"))
+	Expect(os.IsNotExist(err)).To(BeTrue())
+	Expect(p.RecordingStatus().Recording).To(BeFalse())
+
+	// file name is joined with the recording directory
+	Expect(start(`{"file": "custom.rec"}`)).To(Equal(http.StatusOK))
+	status, err := p.StopRecording()
+	Expect(err).ToNot(HaveOccurred())
+	Expect(status.File).To(Equal(filepath.Join(dir, "rec", "custom.rec")))
+	_, err = os.Stat(filepath.Join(dir, "rec", "custom.rec"))
+	Expect(err).ToNot(HaveOccurred())
+}
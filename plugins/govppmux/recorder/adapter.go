@@ -0,0 +1,143 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package recorder
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"git.fd.io/govpp.git/adapter"
+	"go.ligato.io/cn-infra/v2/logging"
+)
+
+// Adapter wraps VPP binary API adapter and records messages passing through
+// it while recording is started.
+type Adapter struct {
+	adapter.VppAPI
+
+	mu       sync.RWMutex
+	msgs     map[uint16]msgInfo
+	recorder *Recorder
+}
+
+type msgInfo struct {
+	name string
+	crc  string
+}
+
+// NewAdapter returns adapter which records messages passing through the given adapter.
+func NewAdapter(vppAdapter adapter.VppAPI) *Adapter {
+	return &Adapter{
+		VppAPI: vppAdapter,
+		msgs:   make(map[uint16]msgInfo),
+	}
+}
+
+// Start starts recording of messages using the recorder. Recording already
+// in progress is stopped.
+func (a *Adapter) Start(recorder *Recorder) error {
+	a.mu.Lock()
+	prev := a.recorder
+	a.recorder = recorder
+	a.mu.Unlock()
+
+	if prev != nil {
+		return prev.Close()
+	}
+	return nil
+}
+
+// Stop stops recording of messages and returns status of the stopped recording.
+func (a *Adapter) Stop() (Status, error) {
+	a.mu.Lock()
+	recorder := a.recorder
+	a.recorder = nil
+	a.mu.Unlock()
+
+	if recorder == nil {
+		return Status{}, nil
+	}
+	status := recorder.Status()
+	return status, recorder.Close()
+}
+
+// Status returns status of the recording.
+func (a *Adapter) Status() Status {
+	a.mu.RLock()
+	recorder := a.recorder
+	a.mu.RUnlock()
+
+	if recorder == nil {
+		return Status{}
+	}
+	return recorder.Status()
+}
+
+// GetMsgID returns ID of the message and remembers the message for recording.
+func (a *Adapter) GetMsgID(msgName string, msgCrc string) (uint16, error) {
+	msgID, err := a.VppAPI.GetMsgID(msgName, msgCrc)
+	if err == nil {
+		a.mu.Lock()
+		a.msgs[msgID] = msgInfo{name: msgName, crc: msgCrc}
+		a.mu.Unlock()
+	}
+	return msgID, err
+}
+
+// SendMsg records the request and sends it to VPP.
+func (a *Adapter) SendMsg(context uint32, data []byte) error {
+	if len(data) >= requestHeaderSize {
+		a.record(Request, binary.BigEndian.Uint16(data[0:2]), context, data)
+	}
+	return a.VppAPI.SendMsg(context, data)
+}
+
+// SetMsgCallback sets callback for messages received from VPP which records
+// the messages before passing them to the callback.
+func (a *Adapter) SetMsgCallback(cb adapter.MsgCallback) {
+	a.VppAPI.SetMsgCallback(func(msgID uint16, data []byte) {
+		a.record(Reply, msgID, replyContext(data), data)
+		cb(msgID, data)
+	})
+}
+
+func (a *Adapter) record(direction string, msgID uint16, context uint32, data []byte) {
+	a.mu.RLock()
+	recorder := a.recorder
+	msg := a.msgs[msgID]
+	a.mu.RUnlock()
+
+	if recorder == nil {
+		return
+	}
+	data = append([]byte(nil), data...)
+	if direction == Request {
+		// context is set in the request header by the adapter when sending
+		binary.BigEndian.PutUint32(data[6:10], context)
+	}
+	rec := &Record{
+		Time:      time.Now(),
+		Direction: direction,
+		MsgName:   msg.name,
+		MsgCrc:    msg.crc,
+		MsgID:     msgID,
+		Context:   context,
+		Data:      data,
+	}
+	if err := recorder.Record(rec); err != nil {
+		logging.Warnf("recording of VPP message %s failed: %v", msg.name, err)
+	}
+}
@@ -0,0 +1,208 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package recorder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"git.fd.io/govpp.git/adapter"
+	"github.com/pkg/errors"
+)
+
+// exchange is a group of requests sent with the same context (e.g. dump
+// request followed by control ping) together with their replies.
+type exchange struct {
+	requests []*Record
+	replies  []*Record
+}
+
+// exchanges groups records into exchanges ordered by their first request.
+// Replies without recorded request are skipped.
+func exchanges(records []*Record) []*exchange {
+	var list []*exchange
+	open := make(map[uint32]*exchange)
+	for _, rec := range records {
+		switch rec.Direction {
+		case Request:
+			if e, ok := open[rec.Context]; ok && len(e.replies) == 0 {
+				e.requests = append(e.requests, rec)
+				continue
+			}
+			e := &exchange{requests: []*Record{rec}}
+			open[rec.Context] = e
+			list = append(list, e)
+		case Reply:
+			if e, ok := open[rec.Context]; ok {
+				e.replies = append(e.replies, rec)
+			}
+		}
+	}
+	return list
+}
+
+// PlaybackAdapter is VPP binary API adapter which mocks VPP by replying
+// to requests with replies from the recording. Each recorded exchange is
+// played back once, exchange with the same requests (including their
+// payload) is preferred over exchange with requests of the same messages.
+type PlaybackAdapter struct {
+	mu        sync.Mutex
+	msgIDs    map[string]uint16
+	msgNames  map[uint16]string
+	lastID    uint16
+	exchanges []*exchange
+	played    []bool
+	pending   map[uint32][]*Record
+	callback  adapter.MsgCallback
+}
+
+// NewPlaybackAdapter returns adapter playing back the records.
+func NewPlaybackAdapter(records []*Record) *PlaybackAdapter {
+	a := &PlaybackAdapter{
+		msgIDs:    make(map[string]uint16),
+		msgNames:  make(map[uint16]string),
+		exchanges: exchanges(records),
+		pending:   make(map[uint32][]*Record),
+	}
+	a.played = make([]bool, len(a.exchanges))
+	for _, rec := range records {
+		if rec.MsgName == "" {
+			continue
+		}
+		a.msgIDs[rec.MsgName] = rec.MsgID
+		a.msgNames[rec.MsgID] = rec.MsgName
+		if rec.MsgID > a.lastID {
+			a.lastID = rec.MsgID
+		}
+	}
+	return a
+}
+
+func (a *PlaybackAdapter) Connect() error {
+	return nil
+}
+
+func (a *PlaybackAdapter) Disconnect() error {
+	return nil
+}
+
+func (a *PlaybackAdapter) WaitReady() error {
+	return nil
+}
+
+func (a *PlaybackAdapter) SetMsgCallback(cb adapter.MsgCallback) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.callback = cb
+}
+
+// GetMsgID returns ID of the message from the recording, messages which
+// are not in the recording get new IDs.
+func (a *PlaybackAdapter) GetMsgID(msgName string, msgCrc string) (uint16, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if msgID, ok := a.msgIDs[msgName]; ok {
+		return msgID, nil
+	}
+	a.lastID++
+	a.msgIDs[msgName] = a.lastID
+	a.msgNames[a.lastID] = msgName
+	return a.lastID, nil
+}
+
+// SendMsg finds the recorded exchange for the request and sends its recorded
+// replies to the callback. Replies to requests with multiple requests
+// (e.g. dump followed by control ping) are sent after the last request.
+func (a *PlaybackAdapter) SendMsg(context uint32, data []byte) error {
+	if len(data) < requestHeaderSize {
+		return errors.Errorf("invalid message data, length must be at least %d bytes", requestHeaderSize)
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	msgID := binary.BigEndian.Uint16(data[0:2])
+	req := &Record{
+		Direction: Request,
+		MsgName:   a.msgNames[msgID],
+		MsgID:     msgID,
+		Context:   context,
+		Data:      data,
+	}
+	requests := append(a.pending[context], req)
+
+	i, complete := a.findExchange(requests)
+	if i < 0 {
+		delete(a.pending, context)
+		return errors.Errorf("no recorded reply for message %s", req.MsgName)
+	}
+	if !complete {
+		a.pending[context] = requests
+		return nil
+	}
+	delete(a.pending, context)
+	a.played[i] = true
+
+	var replies [][]byte
+	for _, reply := range a.exchanges[i].replies {
+		if len(reply.Data) < replyHeaderSize {
+			continue
+		}
+		replyData := append([]byte(nil), reply.Data...)
+		binary.BigEndian.PutUint16(replyData[0:2], a.msgIDs[reply.MsgName])
+		binary.BigEndian.PutUint32(replyData[2:6], context)
+		replies = append(replies, replyData)
+	}
+	if cb := a.callback; cb != nil {
+		go func() {
+			for _, reply := range replies {
+				cb(binary.BigEndian.Uint16(reply[0:2]), reply)
+			}
+		}()
+	}
+	return nil
+}
+
+// findExchange returns index of exchange not played yet which starts with
+// the requests and whether the requests complete the exchange.
+func (a *PlaybackAdapter) findExchange(requests []*Record) (index int, complete bool) {
+	index = -1
+	for i, e := range a.exchanges {
+		if a.played[i] || len(e.requests) < len(requests) {
+			continue
+		}
+		sameMsgs, samePayload := true, true
+		for j, req := range requests {
+			if e.requests[j].MsgName != req.MsgName {
+				sameMsgs = false
+				break
+			}
+			if !bytes.Equal(e.requests[j].payload(), req.payload()) {
+				samePayload = false
+			}
+		}
+		if !sameMsgs {
+			continue
+		}
+		if samePayload {
+			return i, len(e.requests) == len(requests)
+		}
+		if index < 0 {
+			index, complete = i, len(e.requests) == len(requests)
+		}
+	}
+	return index, complete
+}
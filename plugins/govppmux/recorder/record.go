@@ -0,0 +1,309 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package recorder implements recording of VPP binary API messages exchanged
+// between the agent and VPP, and replay of the recorded messages against
+// VPP or against playback adapter which mocks VPP with the recorded replies.
+package recorder
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Direction of the recorded message.
+const (
+	Request = "request"
+	Reply   = "reply"
+)
+
+const (
+	// DefaultMaxSize is default size of recording file in bytes before it gets rotated.
+	DefaultMaxSize = 10 * 1024 * 1024
+	// DefaultMaxFiles is default number of rotated recording files kept.
+	DefaultMaxFiles = 5
+)
+
+// Record is a single binary API message sent to or received from VPP.
+type Record struct {
+	// Time when the message was sent or received.
+	Time time.Time `json:"time"`
+	// Direction is either Request or Reply.
+	Direction string `json:"dir"`
+	// MsgName and MsgCrc identify the message.
+	MsgName string `json:"msg"`
+	MsgCrc  string `json:"crc"`
+	// MsgID is ID of the message assigned by VPP during recording.
+	MsgID uint16 `json:"msg_id"`
+	// Context pairs replies with requests.
+	Context uint32 `json:"context"`
+	// Took is time elapsed since the request with the same context (replies only).
+	Took time.Duration `json:"took,omitempty"`
+	// Data is the encoded message including the message header.
+	Data []byte `json:"data"`
+}
+
+// header sizes of encoded messages
+const (
+	requestHeaderSize = 10 // msg ID, client index, context
+	replyHeaderSize   = 6  // msg ID, context
+)
+
+// payload returns the message data without the header.
+func (r *Record) payload() []byte {
+	size := replyHeaderSize
+	if r.Direction == Request {
+		size = requestHeaderSize
+	}
+	if len(r.Data) < size {
+		return nil
+	}
+	return r.Data[size:]
+}
+
+// replyContext returns context from header of encoded reply.
+func replyContext(data []byte) uint32 {
+	if len(data) < replyHeaderSize {
+		return 0
+	}
+	return binary.BigEndian.Uint32(data[2:6])
+}
+
+// max number of requests waiting for reply tracked by recorder
+const maxPending = 10000
+
+// Options defines recording files.
+type Options struct {
+	// File is path to the recording file.
+	File string
+	// MaxSize is size of the file in bytes after which the file is rotated.
+	MaxSize int64
+	// MaxFiles is number of rotated files kept in addition to the current file.
+	MaxFiles int
+}
+
+// Status describes state of the recorder.
+type Status struct {
+	Recording bool      `json:"recording"`
+	File      string    `json:"file,omitempty"`
+	Started   time.Time `json:"started,omitempty"`
+	Records   uint64    `json:"records"`
+}
+
+// Recorder writes records into the recording file. The file is rotated
+// after it reaches its max size, rotated files are suffixed with .1, .2, ...
+// where higher number means older file.
+type Recorder struct {
+	opts Options
+
+	mu      sync.Mutex
+	file    *os.File
+	size    int64
+	records uint64
+	started time.Time
+	pending map[uint32]time.Time
+}
+
+// NewRecorder opens the recording file and returns recorder writing into it.
+func NewRecorder(opts Options) (*Recorder, error) {
+	if opts.File == "" {
+		return nil, errors.New("recording file not defined")
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = DefaultMaxSize
+	}
+	if opts.MaxFiles < 0 {
+		opts.MaxFiles = 0
+	}
+	r := &Recorder{
+		opts:    opts,
+		started: time.Now(),
+		pending: make(map[uint32]time.Time),
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Record writes the record into the recording file.
+func (r *Recorder) Record(rec *Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return errors.New("recorder closed")
+	}
+	switch rec.Direction {
+	case Request:
+		if len(r.pending) >= maxPending {
+			r.pending = make(map[uint32]time.Time)
+		}
+		r.pending[rec.Context] = rec.Time
+	case Reply:
+		if t, ok := r.pending[rec.Context]; ok {
+			rec.Took = rec.Time.Sub(t)
+		}
+	}
+
+	line, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+	if r.size > 0 && r.size+int64(len(line)) > r.opts.MaxSize {
+		if err := r.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := r.file.Write(line)
+	r.size += int64(n)
+	if err != nil {
+		return err
+	}
+	r.records++
+	return nil
+}
+
+// Status returns status of the recorder.
+func (r *Recorder) Status() Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return Status{
+		Recording: r.file != nil,
+		File:      r.opts.File,
+		Started:   r.started,
+		Records:   r.records,
+	}
+}
+
+// Close closes the recording file.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.close()
+}
+
+func (r *Recorder) open() error {
+	if dir := filepath.Dir(r.opts.File); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	f, err := os.OpenFile(r.opts.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.file = f
+	r.size = info.Size()
+	return nil
+}
+
+func (r *Recorder) close() error {
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+func (r *Recorder) rotate() error {
+	if err := r.close(); err != nil {
+		return err
+	}
+	if r.opts.MaxFiles == 0 {
+		if err := os.Remove(r.opts.File); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return r.open()
+	}
+	if err := os.Remove(rotatedFile(r.opts.File, r.opts.MaxFiles)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for i := r.opts.MaxFiles - 1; i >= 1; i-- {
+		err := os.Rename(rotatedFile(r.opts.File, i), rotatedFile(r.opts.File, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	if err := os.Rename(r.opts.File, rotatedFile(r.opts.File, 1)); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+func rotatedFile(file string, i int) string {
+	return fmt.Sprintf("%s.%d", file, i)
+}
+
+// ReadFile reads records from the recording file including its rotated
+// files, records are returned from the oldest.
+func ReadFile(file string) ([]*Record, error) {
+	var files []string
+	for i := 1; ; i++ {
+		rotated := rotatedFile(file, i)
+		if _, err := os.Stat(rotated); err != nil {
+			break
+		}
+		files = append([]string{rotated}, files...)
+	}
+	files = append(files, file)
+
+	var records []*Record
+	for _, f := range files {
+		recs, err := readRecords(f)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, recs...)
+	}
+	return records, nil
+}
+
+func readRecords(file string) ([]*Record, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []*Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		rec := new(Record)
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			return nil, errors.Errorf("%s:%d: invalid record: %v", file, line, err)
+		}
+		records = append(records, rec)
+	}
+	return records, scanner.Err()
+}
@@ -0,0 +1,125 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package recorder
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func request(name string, msgID uint16, context uint32, payload ...byte) *Record {
+	data := make([]byte, requestHeaderSize, requestHeaderSize+len(payload))
+	binary.BigEndian.PutUint16(data[0:2], msgID)
+	binary.BigEndian.PutUint32(data[6:10], context)
+	return &Record{
+		Time:      time.Now(),
+		Direction: Request,
+		MsgName:   name,
+		MsgID:     msgID,
+		Context:   context,
+		Data:      append(data, payload...),
+	}
+}
+
+func reply(name string, msgID uint16, context uint32, payload ...byte) *Record {
+	data := make([]byte, replyHeaderSize, replyHeaderSize+len(payload))
+	binary.BigEndian.PutUint16(data[0:2], msgID)
+	binary.BigEndian.PutUint32(data[2:6], context)
+	return &Record{
+		Time:      time.Now(),
+		Direction: Reply,
+		MsgName:   name,
+		MsgID:     msgID,
+		Context:   context,
+		Data:      append(data, payload...),
+	}
+}
+
+var testRecords = []*Record{
+	request("show_version", 10, 1),
+	reply("show_version_reply", 11, 1, 0, 0, 0, 0, 'v', '1'),
+	request("sw_interface_dump", 20, 2, 0xff),
+	request("control_ping", 30, 2),
+	reply("sw_interface_details", 21, 2, 0, 0, 0, 1),
+	reply("sw_interface_details", 21, 2, 0, 0, 0, 2),
+	reply("control_ping_reply", 31, 2, 0, 0, 0, 0),
+	request("show_version", 10, 3),
+	reply("show_version_reply", 11, 3, 0, 0, 0, 0, 'v', '2'),
+}
+
+func TestRecorderRotation(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "recorder")
+	Expect(err).ToNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "binapi.rec")
+	r, err := NewRecorder(Options{File: file, MaxSize: 300, MaxFiles: 2})
+	Expect(err).ToNot(HaveOccurred())
+	for _, rec := range testRecords {
+		Expect(r.Record(rec)).To(Succeed())
+	}
+	Expect(r.Status().Records).To(BeEquivalentTo(len(testRecords)))
+	Expect(r.Close()).To(Succeed())
+
+	Expect(rotatedFile(file, 1)).To(BeAnExistingFile())
+	Expect(rotatedFile(file, 2)).To(BeAnExistingFile())
+	Expect(rotatedFile(file, 3)).ToNot(BeAnExistingFile())
+
+	records, err := ReadFile(file)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(len(records)).To(BeNumerically("<", len(testRecords)))
+	last := records[len(records)-1]
+	Expect(last.MsgName).To(Equal("show_version_reply"))
+	Expect(last.Data).To(Equal(testRecords[len(testRecords)-1].Data))
+}
+
+func TestReplayPlayback(t *testing.T) {
+	RegisterTestingT(t)
+
+	result, err := Replay(NewPlaybackAdapter(testRecords), testRecords, time.Second)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(result.Exchanges).To(Equal(3))
+	Expect(result.Requests).To(Equal(4))
+	Expect(result.Replies).To(Equal(5))
+	Expect(result.Mismatches).To(BeEmpty())
+}
+
+func TestReplayMismatch(t *testing.T) {
+	RegisterTestingT(t)
+
+	// playback replies to the first show_version with the second reply
+	playback := []*Record{
+		request("show_version", 10, 1),
+		reply("show_version_reply", 11, 1, 0, 0, 0, 0, 'v', '2'),
+	}
+	result, err := Replay(NewPlaybackAdapter(playback), testRecords[:2], 100*time.Millisecond)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(result.Mismatches).To(HaveLen(1))
+	Expect(result.Mismatches[0].Reasons).To(ConsistOf("reply #1 (show_version_reply): payload differs at byte 5"))
+
+	// no recorded reply for the dump
+	result, err = Replay(NewPlaybackAdapter(playback), testRecords[2:7], 100*time.Millisecond)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(result.Mismatches).To(HaveLen(1))
+	Expect(result.Mismatches[0].Requests).To(Equal([]string{"sw_interface_dump", "control_ping"}))
+}
@@ -0,0 +1,224 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package recorder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"git.fd.io/govpp.git/adapter"
+	"github.com/pkg/errors"
+)
+
+// DefaultReplyTimeout is default time to wait for replies during replay.
+const DefaultReplyTimeout = time.Second
+
+// Mismatch describes difference between recorded replies and replies
+// received during replay.
+type Mismatch struct {
+	// Requests are names of the requests of the exchange.
+	Requests []string `json:"requests"`
+	// Context of the recorded requests.
+	Context uint32 `json:"context"`
+	// Reasons describe the differences.
+	Reasons []string `json:"reasons"`
+}
+
+func (m Mismatch) String() string {
+	return fmt.Sprintf("%s (context %d): %s",
+		strings.Join(m.Requests, "+"), m.Context, strings.Join(m.Reasons, "; "))
+}
+
+// Result is a result of replay.
+type Result struct {
+	Exchanges  int           `json:"exchanges"`
+	Requests   int           `json:"requests"`
+	Replies    int           `json:"replies"`
+	Took       time.Duration `json:"took"`
+	Mismatches []Mismatch    `json:"mismatches,omitempty"`
+}
+
+// Replay re-issues recorded requests via the adapter and compares received
+// replies with the recorded ones. The exchanges are replayed one at a time,
+// replies are awaited until all recorded replies are received or until
+// the timeout elapses.
+func Replay(vppAdapter adapter.VppAPI, records []*Record, timeout time.Duration) (*Result, error) {
+	if timeout <= 0 {
+		timeout = DefaultReplyTimeout
+	}
+	start := time.Now()
+
+	recv := &received{
+		replies: make(map[uint32][]*Record),
+		notify:  make(chan struct{}, 1),
+		names:   make(map[uint16]string),
+	}
+	vppAdapter.SetMsgCallback(recv.callback)
+	if err := vppAdapter.Connect(); err != nil {
+		return nil, errors.WithMessage(err, "connecting to VPP failed")
+	}
+	defer vppAdapter.Disconnect()
+
+	// IDs of the messages may differ from the recording
+	msgIDs := make(map[string]uint16)
+	unknown := make(map[string]error)
+	for _, rec := range records {
+		if _, ok := msgIDs[rec.MsgName]; ok || unknown[rec.MsgName] != nil {
+			continue
+		}
+		msgID, err := vppAdapter.GetMsgID(rec.MsgName, rec.MsgCrc)
+		if err != nil {
+			unknown[rec.MsgName] = err
+			continue
+		}
+		msgIDs[rec.MsgName] = msgID
+		recv.setName(msgID, rec.MsgName)
+	}
+
+	result := &Result{}
+	for i, e := range exchanges(records) {
+		context := uint32(i + 1)
+		mismatch := Mismatch{Context: e.requests[0].Context}
+		for _, req := range e.requests {
+			mismatch.Requests = append(mismatch.Requests, req.MsgName)
+		}
+		result.Exchanges++
+
+		var sendErr error
+		for _, req := range e.requests {
+			if err := unknown[req.MsgName]; err != nil {
+				sendErr = errors.Errorf("message %s not supported: %v", req.MsgName, err)
+				break
+			}
+			if len(req.Data) < requestHeaderSize {
+				sendErr = errors.Errorf("invalid recorded message %s", req.MsgName)
+				break
+			}
+			data := append([]byte(nil), req.Data...)
+			binary.BigEndian.PutUint16(data[0:2], msgIDs[req.MsgName])
+			if err := vppAdapter.SendMsg(context, data); err != nil {
+				sendErr = errors.Errorf("sending %s failed: %v", req.MsgName, err)
+				break
+			}
+			result.Requests++
+		}
+		if sendErr != nil {
+			mismatch.Reasons = append(mismatch.Reasons, sendErr.Error())
+			result.Mismatches = append(result.Mismatches, mismatch)
+			continue
+		}
+
+		replies := recv.wait(context, len(e.replies), timeout)
+		result.Replies += len(replies)
+		mismatch.Reasons = compareReplies(e.replies, replies)
+		if len(mismatch.Reasons) > 0 {
+			result.Mismatches = append(result.Mismatches, mismatch)
+		}
+	}
+	result.Took = time.Since(start)
+
+	return result, nil
+}
+
+// compareReplies returns differences between the recorded and received replies.
+func compareReplies(recorded, replies []*Record) (reasons []string) {
+	if len(recorded) != len(replies) {
+		reasons = append(reasons, fmt.Sprintf("expected %d replies, received %d", len(recorded), len(replies)))
+	}
+	for i := 0; i < len(recorded) && i < len(replies); i++ {
+		expected, actual := recorded[i], replies[i]
+		if expected.MsgName != actual.MsgName {
+			reasons = append(reasons, fmt.Sprintf("reply #%d: expected %s, received %s (ID %d)",
+				i+1, expected.MsgName, actual.MsgName, actual.MsgID))
+			continue
+		}
+		if !bytes.Equal(expected.payload(), actual.payload()) {
+			reasons = append(reasons, fmt.Sprintf("reply #%d (%s): payload differs at byte %d",
+				i+1, actual.MsgName, diffOffset(expected.payload(), actual.payload())))
+		}
+	}
+	return reasons
+}
+
+// diffOffset returns offset of the first differing byte.
+func diffOffset(a, b []byte) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+	if len(a) < len(b) {
+		return len(a)
+	}
+	return len(b)
+}
+
+// received collects replies received during replay.
+type received struct {
+	mu      sync.Mutex
+	names   map[uint16]string
+	replies map[uint32][]*Record
+	notify  chan struct{}
+}
+
+func (r *received) setName(msgID uint16, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.names[msgID] = name
+}
+
+func (r *received) callback(msgID uint16, data []byte) {
+	r.mu.Lock()
+	context := replyContext(data)
+	r.replies[context] = append(r.replies[context], &Record{
+		Time:      time.Now(),
+		Direction: Reply,
+		MsgName:   r.names[msgID],
+		MsgID:     msgID,
+		Context:   context,
+		Data:      append([]byte(nil), data...),
+	})
+	r.mu.Unlock()
+
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+// wait waits until the number of replies is received for the context
+// or until the timeout elapses, and returns the received replies.
+func (r *received) wait(context uint32, count int, timeout time.Duration) []*Record {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	for {
+		r.mu.Lock()
+		replies := r.replies[context]
+		r.mu.Unlock()
+		if len(replies) >= count {
+			return replies
+		}
+		select {
+		case <-r.notify:
+		case <-deadline.C:
+			return replies
+		}
+	}
+}
@@ -34,6 +34,10 @@ func (p *Plugin) registerHandlers(http rest.HTTPHandlers) {
 	http.RegisterHTTPHandler("/govppmux/stats", p.statsHandler, "GET")
 	http.RegisterHTTPHandler(rpc.DefaultRPCPath, p.proxyHandler, "CONNECT")
 	http.RegisterHTTPHandler("/vpp/command", p.cliCommandHandler, "POST")
+	http.RegisterHTTPHandler("/govppmux/compatibility", p.compatibilityHandler, "GET")
+	http.RegisterHTTPHandler("/govppmux/record", p.recordStatusHandler, "GET")
+	http.RegisterHTTPHandler("/govppmux/record/start", p.recordStartHandler, "POST")
+	http.RegisterHTTPHandler("/govppmux/record/stop", p.recordStopHandler, "POST")
 }
 
 func (p *Plugin) statsHandler(formatter *render.Render) http.HandlerFunc {
@@ -77,8 +81,18 @@ func (p *Plugin) cliCommandHandler(formatter *render.Render) http.HandlerFunc {
 			return
 		}
 
+		// optional name of VPP instance, default instance is used if not set
+		instance := p
+		if name := reqParam["instance"]; name != "" {
+			if instance = p.findInstance(name); instance == nil {
+				errMsg := fmt.Sprintf("404 Not found: VPP instance %q not found\n", name)
+				_ = formatter.JSON(w, http.StatusNotFound, errMsg)
+				return
+			}
+		}
+
 		p.Log.Debugf("VPPCLI command: %v", command)
-		reply, err := p.vpeHandler.RunCli(req.Context(), command)
+		reply, err := instance.vpeHandler.RunCli(req.Context(), command)
 		if err != nil {
 			errMsg := fmt.Sprintf("500 Internal server error: sending request failed: %v\n", err)
 			_ = formatter.JSON(w, http.StatusInternalServerError, errMsg)
@@ -89,3 +103,94 @@ func (p *Plugin) cliCommandHandler(formatter *render.Render) http.HandlerFunc {
 		_ = formatter.JSON(w, http.StatusOK, reply)
 	}
 }
+
+func (p *Plugin) compatibilityHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		instance := p
+		if name := req.URL.Query().Get("instance"); name != "" {
+			if instance = p.findInstance(name); instance == nil {
+				errMsg := fmt.Sprintf("404 Not found: VPP instance %q not found\n", name)
+				_ = formatter.JSON(w, http.StatusNotFound, errMsg)
+				return
+			}
+		}
+		_ = formatter.JSON(w, http.StatusOK, instance.Compatibility())
+	}
+}
+
+func (p *Plugin) recordStatusHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		instance := p
+		if name := req.URL.Query().Get("instance"); name != "" {
+			if instance = p.findInstance(name); instance == nil {
+				errMsg := fmt.Sprintf("404 Not found: VPP instance %q not found\n", name)
+				_ = formatter.JSON(w, http.StatusNotFound, errMsg)
+				return
+			}
+		}
+		_ = formatter.JSON(w, http.StatusOK, instance.RecordingStatus())
+	}
+}
+
+func (p *Plugin) recordStartHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		instance, reqParam, ok := p.recordRequest(formatter, w, req)
+		if !ok {
+			return
+		}
+		if err := instance.StartRecording(reqParam["file"]); err == ErrInvalidRecordFile {
+			errMsg := fmt.Sprintf("400 Bad request: %v\n", err)
+			_ = formatter.JSON(w, http.StatusBadRequest, errMsg)
+			return
+		} else if err != nil {
+			errMsg := fmt.Sprintf("500 Internal server error: starting recording failed: %v\n", err)
+			_ = formatter.JSON(w, http.StatusInternalServerError, errMsg)
+			return
+		}
+		_ = formatter.JSON(w, http.StatusOK, instance.RecordingStatus())
+	}
+}
+
+func (p *Plugin) recordStopHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		instance, _, ok := p.recordRequest(formatter, w, req)
+		if !ok {
+			return
+		}
+		status, err := instance.StopRecording()
+		if err != nil {
+			errMsg := fmt.Sprintf("500 Internal server error: stopping recording failed: %v\n", err)
+			_ = formatter.JSON(w, http.StatusInternalServerError, errMsg)
+			return
+		}
+		_ = formatter.JSON(w, http.StatusOK, status)
+	}
+}
+
+// recordRequest parses optional parameters (file name, instance) of the recording
+// request and returns the VPP instance to record.
+func (p *Plugin) recordRequest(formatter *render.Render, w http.ResponseWriter, req *http.Request) (*Plugin, map[string]string, bool) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		errMsg := fmt.Sprintf("400 Bad request: failed to parse request body: %v", err)
+		_ = formatter.JSON(w, http.StatusBadRequest, errMsg)
+		return nil, nil, false
+	}
+	reqParam := make(map[string]string)
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, &reqParam); err != nil {
+			errMsg := fmt.Sprintf("400 Bad request: failed to unmarshall request body: %v\n", err)
+			_ = formatter.JSON(w, http.StatusBadRequest, errMsg)
+			return nil, nil, false
+		}
+	}
+	instance := p
+	if name := reqParam["instance"]; name != "" {
+		if instance = p.findInstance(name); instance == nil {
+			errMsg := fmt.Sprintf("404 Not found: VPP instance %q not found\n", name)
+			_ = formatter.JSON(w, http.StatusNotFound, errMsg)
+			return nil, nil, false
+		}
+	}
+	return instance, reqParam, true
+}
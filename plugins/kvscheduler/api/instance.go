@@ -0,0 +1,288 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"go.ligato.io/cn-infra/v2/idxmap"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/kvscheduler"
+)
+
+// separates name of the descriptor from name of the VPP instance
+const instanceSeparator = "@"
+
+// instanceScoped is a registry of names of descriptors registered for VPP
+// instances (see InstanceDescriptor). Descriptors of the default instance
+// are registered under the same names.
+var instanceScoped = struct {
+	sync.RWMutex
+	names map[string]struct{}
+}{names: make(map[string]struct{})}
+
+// markInstanceScoped records that the descriptor handles values of VPP instances.
+func markInstanceScoped(descriptor string) {
+	instanceScoped.Lock()
+	defer instanceScoped.Unlock()
+	instanceScoped.names[descriptor] = struct{}{}
+}
+
+// IsInstanceScopedDescriptor returns true if the descriptor (registered
+// under the given name for the default instance) handles values of VPP
+// instances and therefore has a copy registered for every named instance.
+func IsInstanceScopedDescriptor(descriptor string) bool {
+	instanceScoped.RLock()
+	defer instanceScoped.RUnlock()
+	_, scoped := instanceScoped.names[descriptor]
+	return scoped
+}
+
+// InstanceDescriptorName returns name under which the descriptor of VPP values
+// is registered for the given VPP instance. Names of descriptors of the default
+// instance (empty name) and descriptors which are not instance-scoped are
+// returned unchanged. A descriptor becomes instance-scoped once it is registered
+// via ForInstance, therefore references to it (retrieve dependencies, metadata
+// maps) are resolved only after its registration.
+func InstanceDescriptorName(instance, descriptor string) string {
+	if instance == "" || !IsInstanceScopedDescriptor(descriptor) {
+		return descriptor
+	}
+	return descriptor + instanceSeparator + instance
+}
+
+// DescriptorInstance returns name of the VPP instance whose values are handled
+// by the descriptor, empty name is returned for descriptors registered directly.
+func DescriptorInstance(descriptor string) string {
+	if i := strings.LastIndex(descriptor, instanceSeparator); i >= 0 {
+		return descriptor[i+1:]
+	}
+	return ""
+}
+
+// ForInstance returns KVScheduler used by plugins of the given VPP instance.
+// Descriptors registered via the returned scheduler handle values of the VPP
+// instance - keys of the values are prefixed with the instance prefix
+// (see models.InstanceKey) and names of the descriptors get the instance
+// suffix. Plugins of the instance work with keys without the instance prefix,
+// so the same plugins can be used for multiple VPP instances, each with its
+// own descriptors and metadata maps.
+func ForInstance(scheduler KVScheduler, instance string) KVScheduler {
+	if instance == "" {
+		return scheduler
+	}
+	return &instanceScheduler{
+		KVScheduler: scheduler,
+		instance:    instance,
+	}
+}
+
+type instanceScheduler struct {
+	KVScheduler
+	instance string
+}
+
+func (s *instanceScheduler) RegisterKVDescriptor(descriptors ...*KVDescriptor) error {
+	// descriptors registered together may refer to each other
+	for _, descriptor := range descriptors {
+		markInstanceScoped(descriptor.Name)
+	}
+	for _, descriptor := range descriptors {
+		if err := s.KVScheduler.RegisterKVDescriptor(InstanceDescriptor(s.instance, descriptor)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *instanceScheduler) StartNBTransaction() Txn {
+	return &instanceTxn{
+		Txn:      s.KVScheduler.StartNBTransaction(),
+		instance: s.instance,
+	}
+}
+
+func (s *instanceScheduler) PushSBNotification(notif ...KVWithMetadata) error {
+	return s.KVScheduler.PushSBNotification(instanceKVs(s.instance, notif)...)
+}
+
+func (s *instanceScheduler) GetMetadataMap(descriptor string) idxmap.NamedMapping {
+	return s.KVScheduler.GetMetadataMap(InstanceDescriptorName(s.instance, descriptor))
+}
+
+func (s *instanceScheduler) GetValueStatus(key string) *kvscheduler.BaseValueStatus {
+	return s.KVScheduler.GetValueStatus(models.InstanceKey(s.instance, key))
+}
+
+func (s *instanceScheduler) DumpValuesByDescriptor(descriptor string, view View) ([]KVWithMetadata, error) {
+	kvs, err := s.KVScheduler.DumpValuesByDescriptor(InstanceDescriptorName(s.instance, descriptor), view)
+	return stripInstanceKVs(kvs), err
+}
+
+func (s *instanceScheduler) DumpValuesByKeyPrefix(keyPrefix string, view View) ([]KVWithMetadata, error) {
+	kvs, err := s.KVScheduler.DumpValuesByKeyPrefix(models.InstanceKey(s.instance, keyPrefix), view)
+	return stripInstanceKVs(kvs), err
+}
+
+type instanceTxn struct {
+	Txn
+	instance string
+}
+
+func (txn *instanceTxn) SetValue(key string, value proto.Message) Txn {
+	txn.Txn.SetValue(models.InstanceKey(txn.instance, key), value)
+	return txn
+}
+
+// InstanceDescriptor returns copy of the descriptor which handles values
+// of the given VPP instance. Name of the copy has the instance suffix and
+// the original descriptor is marked as instance-scoped. Callbacks of the
+// original descriptor are called with keys stripped of the instance prefix,
+// keys returned by the callbacks (retrieved and derived values, dependencies)
+// get the instance prefix.
+func InstanceDescriptor(instance string, d *KVDescriptor) *KVDescriptor {
+	if instance == "" {
+		return d
+	}
+	key := func(key string) string {
+		return models.InstanceKey(instance, key)
+	}
+	strip := func(key string) string {
+		_, key = models.ParseInstanceKey(key)
+		return key
+	}
+	markInstanceScoped(d.Name)
+	descriptor := &KVDescriptor{
+		Name:               InstanceDescriptorName(instance, d.Name),
+		ValueTypeName:      d.ValueTypeName,
+		WithMetadata:       d.WithMetadata,
+		MetadataMapFactory: d.MetadataMapFactory,
+		IsRetriableFailure: d.IsRetriableFailure,
+	}
+	if d.NBKeyPrefix != "" {
+		descriptor.NBKeyPrefix = key(d.NBKeyPrefix)
+	}
+	if d.KeySelector != nil {
+		descriptor.KeySelector = instanceKeySelector(instance, d.KeySelector)
+	}
+	if d.KeyLabel != nil {
+		descriptor.KeyLabel = func(k string) string {
+			return d.KeyLabel(strip(k))
+		}
+	}
+	if d.ValueComparator != nil {
+		descriptor.ValueComparator = func(k string, oldValue, newValue proto.Message) bool {
+			return d.ValueComparator(strip(k), oldValue, newValue)
+		}
+	}
+	if d.Validate != nil {
+		descriptor.Validate = func(k string, value proto.Message) error {
+			return d.Validate(strip(k), value)
+		}
+	}
+	if d.Create != nil {
+		descriptor.Create = func(k string, value proto.Message) (Metadata, error) {
+			return d.Create(strip(k), value)
+		}
+	}
+	if d.Delete != nil {
+		descriptor.Delete = func(k string, value proto.Message, metadata Metadata) error {
+			return d.Delete(strip(k), value, metadata)
+		}
+	}
+	if d.Update != nil {
+		descriptor.Update = func(k string, oldValue, newValue proto.Message, oldMetadata Metadata) (Metadata, error) {
+			return d.Update(strip(k), oldValue, newValue, oldMetadata)
+		}
+	}
+	if d.UpdateWithRecreate != nil {
+		descriptor.UpdateWithRecreate = func(k string, oldValue, newValue proto.Message, metadata Metadata) bool {
+			return d.UpdateWithRecreate(strip(k), oldValue, newValue, metadata)
+		}
+	}
+	if d.Retrieve != nil {
+		descriptor.Retrieve = func(correlate []KVWithMetadata) ([]KVWithMetadata, error) {
+			retrieved, err := d.Retrieve(stripInstanceKVs(correlate))
+			return instanceKVs(instance, retrieved), err
+		}
+	}
+	if d.DerivedValues != nil {
+		descriptor.DerivedValues = func(k string, value proto.Message) []KeyValuePair {
+			derived := d.DerivedValues(strip(k), value)
+			for i := range derived {
+				derived[i].Key = key(derived[i].Key)
+			}
+			return derived
+		}
+	}
+	if d.Dependencies != nil {
+		descriptor.Dependencies = func(k string, value proto.Message) []Dependency {
+			deps := d.Dependencies(strip(k), value)
+			for i := range deps {
+				if deps[i].Key != "" {
+					deps[i].Key = key(deps[i].Key)
+				}
+				// prefixes may be shared by multiple calls, do not modify them
+				prefixes := make([]string, 0, len(deps[i].AnyOf.KeyPrefixes))
+				for _, prefix := range deps[i].AnyOf.KeyPrefixes {
+					prefixes = append(prefixes, key(prefix))
+				}
+				deps[i].AnyOf.KeyPrefixes = prefixes
+				if deps[i].AnyOf.KeySelector != nil {
+					deps[i].AnyOf.KeySelector = instanceKeySelector(instance, deps[i].AnyOf.KeySelector)
+				}
+			}
+			return deps
+		}
+	}
+	for _, dep := range d.RetrieveDependencies {
+		descriptor.RetrieveDependencies = append(descriptor.RetrieveDependencies,
+			InstanceDescriptorName(instance, dep))
+	}
+	return descriptor
+}
+
+// instanceKeySelector returns selector which matches VPP keys of the given
+// instance and non-VPP keys selected by the original selector.
+func instanceKeySelector(instance string, selector KeySelector) KeySelector {
+	return func(key string) bool {
+		keyInstance, instanceKey := models.ParseInstanceKey(key)
+		if models.IsInstanceScopedKey(instanceKey) && keyInstance != instance {
+			return false
+		}
+		return selector(instanceKey)
+	}
+}
+
+func instanceKVs(instance string, kvs []KVWithMetadata) []KVWithMetadata {
+	res := make([]KVWithMetadata, 0, len(kvs))
+	for _, kv := range kvs {
+		kv.Key = models.InstanceKey(instance, kv.Key)
+		res = append(res, kv)
+	}
+	return res
+}
+
+func stripInstanceKVs(kvs []KVWithMetadata) []KVWithMetadata {
+	res := make([]KVWithMetadata, 0, len(kvs))
+	for _, kv := range kvs {
+		_, kv.Key = models.ParseInstanceKey(kv.Key)
+		res = append(res, kv)
+	}
+	return res
+}
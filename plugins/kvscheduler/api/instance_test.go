@@ -0,0 +1,111 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+)
+
+func TestInstanceDescriptor(t *testing.T) {
+	const (
+		ifKey      = "config/vpp/v2/interfaces/loop1"
+		addrKey    = "vpp/interface/loop1/address/static/10.0.0.1/24"
+		linuxKey   = "config/linux/interfaces/v2/interface/veth1"
+		instanceIf = "vpp-instance/numa1/" + ifKey
+	)
+	var createdKey string
+	// referenced descriptor of the instance is registered first
+	api.InstanceDescriptor("numa1", &api.KVDescriptor{Name: "vpp-bridge-domain"})
+	descriptor := api.InstanceDescriptor("numa1", &api.KVDescriptor{
+		Name:        "vpp-interface",
+		NBKeyPrefix: "config/vpp/v2/interfaces/",
+		KeySelector: func(key string) bool {
+			return strings.HasPrefix(key, "config/vpp/v2/interfaces/")
+		},
+		Create: func(key string, value proto.Message) (api.Metadata, error) {
+			createdKey = key
+			return nil, nil
+		},
+		DerivedValues: func(key string, value proto.Message) []api.KeyValuePair {
+			return []api.KeyValuePair{{Key: addrKey}}
+		},
+		Dependencies: func(key string, value proto.Message) []api.Dependency {
+			return []api.Dependency{{Key: linuxKey}, {Key: addrKey}}
+		},
+		RetrieveDependencies: []string{"vpp-bridge-domain", "linux-interface"},
+	})
+
+	if descriptor.Name != "vpp-interface@numa1" {
+		t.Fatalf("unexpected descriptor name: %q", descriptor.Name)
+	}
+	if descriptor.NBKeyPrefix != "vpp-instance/numa1/config/vpp/v2/interfaces/" {
+		t.Fatalf("unexpected NB key prefix: %q", descriptor.NBKeyPrefix)
+	}
+	if !descriptor.KeySelector(instanceIf) {
+		t.Fatalf("key %q should be selected", instanceIf)
+	}
+	if descriptor.KeySelector(ifKey) || descriptor.KeySelector("vpp-instance/numa2/"+ifKey) {
+		t.Fatalf("keys of other instances should not be selected")
+	}
+	if _, err := descriptor.Create(instanceIf, nil); err != nil || createdKey != ifKey {
+		t.Fatalf("create called with unexpected key: %q", createdKey)
+	}
+	if derived := descriptor.DerivedValues(instanceIf, nil); derived[0].Key != "vpp-instance/numa1/"+addrKey {
+		t.Fatalf("unexpected derived key: %q", derived[0].Key)
+	}
+	deps := descriptor.Dependencies(instanceIf, nil)
+	if deps[0].Key != linuxKey || deps[1].Key != "vpp-instance/numa1/"+addrKey {
+		t.Fatalf("unexpected dependencies: %+v", deps)
+	}
+	if descriptor.RetrieveDependencies[0] != "vpp-bridge-domain@numa1" ||
+		descriptor.RetrieveDependencies[1] != "linux-interface" {
+		t.Fatalf("unexpected retrieve dependencies: %v", descriptor.RetrieveDependencies)
+	}
+}
+
+func TestInstanceDescriptorName(t *testing.T) {
+	// names of VPP descriptors do not follow any naming convention
+	if name := api.InstanceDescriptorName("numa1", "bond-interface"); name != "bond-interface" {
+		t.Fatalf("unexpected name of unregistered descriptor: %q", name)
+	}
+	descriptor := api.InstanceDescriptor("numa1", &api.KVDescriptor{Name: "bond-interface"})
+	if descriptor.Name != "bond-interface@numa1" {
+		t.Fatalf("unexpected descriptor name: %q", descriptor.Name)
+	}
+	if !api.IsInstanceScopedDescriptor("bond-interface") {
+		t.Fatalf("descriptor registered for instance should be instance-scoped")
+	}
+	if name := api.InstanceDescriptorName("numa2", "bond-interface"); name != "bond-interface@numa2" {
+		t.Fatalf("unexpected descriptor name: %q", name)
+	}
+	if instance := api.DescriptorInstance(descriptor.Name); instance != "numa1" {
+		t.Fatalf("unexpected instance of descriptor: %q", instance)
+	}
+	if instance := api.DescriptorInstance("bond-interface"); instance != "" {
+		t.Fatalf("unexpected instance of descriptor: %q", instance)
+	}
+	// references to non-VPP descriptors are not changed
+	if name := api.InstanceDescriptorName("numa1", "linux-interface"); name != "linux-interface" {
+		t.Fatalf("unexpected descriptor name: %q", name)
+	}
+	if name := api.InstanceDescriptorName("", "bond-interface"); name != "bond-interface" {
+		t.Fatalf("unexpected descriptor name: %q", name)
+	}
+}
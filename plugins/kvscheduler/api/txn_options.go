@@ -66,6 +66,7 @@ var (
 type resyncOpt struct {
 	resyncType       ResyncType
 	verboseSBRefresh bool
+	instance         string
 }
 
 // ResyncType is one of: Upstream, Downstream, Full.
@@ -164,6 +165,28 @@ func IsResync(ctx context.Context) (resyncType ResyncType, verboseSBRefresh bool
 	return resyncArgs.resyncType, resyncArgs.verboseSBRefresh
 }
 
+// WithInstanceResync prepares context for DownstreamResync limited to values
+// of the given VPP instance (see ForInstance). Only descriptors registered
+// for the instance are asked to retrieve values and values of other instances
+// and of non-VPP descriptors are left untouched. The transaction should be empty.
+func WithInstanceResync(ctx context.Context, instance string, verboseSBRefresh bool) context.Context {
+	return context.WithValue(ctx, resyncCtxKey, &resyncOpt{
+		resyncType:       DownstreamResync,
+		verboseSBRefresh: verboseSBRefresh,
+		instance:         instance,
+	})
+}
+
+// IsInstanceResync returns name of the VPP instance the resync
+// is limited to, or empty string for resync of all values.
+func IsInstanceResync(ctx context.Context) string {
+	resyncArgs, isResync := ctx.Value(resyncCtxKey).(*resyncOpt)
+	if !isResync {
+		return ""
+	}
+	return resyncArgs.instance
+}
+
 /* Non-blocking Txn */
 
 // nonBlockingTxnOpt represents the *non-blocking* transaction option.
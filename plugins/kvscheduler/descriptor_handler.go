@@ -1,12 +1,15 @@
 package kvscheduler
 
 import (
+	"context"
 	"errors"
 	"os"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/vishvananda/netns"
 
+	"go.ligato.io/vpp-agent/v3/pkg/metrics"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 )
 
@@ -103,6 +106,9 @@ func (h *descriptorHandler) create(key string, value proto.Message) (metadata kv
 		return nil, kvs.ErrUnimplementedCreate
 	}
 	defer trackDescMethod(h.descriptor.Name, "Create")()
+	// VPP API calls made by Create/Update/Delete/Retrieve, which are never
+	// executed concurrently, are attributed to the descriptor
+	defer metrics.SetCaller(h.descriptor.Name)()
 	metadata, err = h.descriptor.Create(key, value)
 	if nsErr := checkNetNs(); nsErr != nil {
 		err = nsErr
@@ -117,6 +123,7 @@ func (h *descriptorHandler) update(key string, oldValue, newValue proto.Message,
 		return oldMetadata, nil
 	}
 	defer trackDescMethod(h.descriptor.Name, "Update")()
+	defer metrics.SetCaller(h.descriptor.Name)()
 	newMetadata, err = h.descriptor.Update(key, oldValue, newValue, oldMetadata)
 	if nsErr := checkNetNs(); nsErr != nil {
 		err = nsErr
@@ -152,6 +159,7 @@ func (h *descriptorHandler) delete(key string, value proto.Message, metadata kvs
 		return kvs.ErrUnimplementedDelete
 	}
 	defer trackDescMethod(h.descriptor.Name, "Delete")()
+	defer metrics.SetCaller(h.descriptor.Name)()
 	err := h.descriptor.Delete(key, value, metadata)
 	if nsErr := checkNetNs(); nsErr != nil {
 		err = nsErr
@@ -159,6 +167,14 @@ func (h *descriptorHandler) delete(key string, value proto.Message, metadata kvs
 	return err
 }
 
+// traceDescMethod starts trace span for Create/Update/Delete of the descriptor
+// executed within the transaction with the given context.
+func (h *descriptorHandler) traceDescMethod(ctx context.Context, method, key string) *tracing.Span {
+	_, span := tracing.StartSpan(ctx, "kvscheduler.descriptor."+method,
+		tracing.Attr("descriptor", h.descriptor.Name), tracing.Attr("key", key))
+	return span
+}
+
 // isRetriableFailure first checks for errors returned by the handler itself.
 // If descriptor does not define IsRetriableFailure, it is assumed any failure
 // can be potentially fixed by retry.
@@ -198,6 +214,7 @@ func (h *descriptorHandler) retrieve(correlate []kvs.KVWithMetadata) (values []k
 		return values, false, nil
 	}
 	defer trackDescMethod(h.descriptor.Name, "Retrieve")()
+	defer metrics.SetCaller(h.descriptor.Name)()
 	values, err = h.descriptor.Retrieve(correlate)
 	if nsErr := checkNetNs(); nsErr != nil {
 		err = nsErr
@@ -458,6 +458,7 @@ func (txn *SchedulerTxn) Commit(ctx context.Context) (txnSeqNum uint64, err erro
 	// parse transaction options
 	txnData.nb.isBlocking = !kvs.IsNonBlockingTxn(ctx)
 	txnData.nb.resyncType, txnData.nb.verboseRefresh = kvs.IsResync(ctx)
+	txnData.nb.resyncInstance = kvs.IsInstanceResync(ctx)
 	txnData.nb.retryArgs, txnData.nb.retryEnabled = kvs.IsWithRetry(ctx)
 	txnData.nb.revertOnFailure = kvs.IsWithRevert(ctx)
 	txnData.nb.description, _ = kvs.IsWithDescription(ctx)
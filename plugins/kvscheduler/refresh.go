@@ -37,8 +37,9 @@ const (
 
 // resyncData stores data to be used for resync after refresh.
 type resyncData struct {
-	first  bool // true if startup-resync
-	values []kvForTxn
+	first    bool   // true if startup-resync
+	instance string // set if resync is limited to VPP instance
+	values   []kvForTxn
 }
 
 // refreshGraph updates all/some values in the graph to their *real* state
@@ -68,6 +69,12 @@ func (s *Scheduler) refreshGraph(graphW graph.RWAccess,
 
 		// check if this descriptor's key space should be refreshed as well
 		var skip bool
+		if resyncData != nil && resyncData.instance != "" &&
+			kvs.DescriptorInstance(descriptor.Name) != resyncData.instance {
+			// descriptor of other VPP instance or non-VPP values
+			s.skipRefresh(descrNodes, nil, refreshedKeys)
+			continue
+		}
 		if keys != nil {
 			skip = keys.Length() > 0
 			for _, key := range keys.Iterate() {
@@ -2034,3 +2034,70 @@ graphDump := graphR.Dump()
 fmt.Print(graphDump)
 graphR.Release()
 */
+
+func TestInstanceResync(t *testing.T) {
+	RegisterTestingT(t)
+
+	const (
+		vppPrefix = "config/vpp/test/"
+		vppKey    = vppPrefix + baseValue1
+	)
+
+	// prepare KV Scheduler
+	scheduler := NewPlugin(UseDeps(func(deps *Deps) {
+		deps.HTTPHandlers = nil
+	}))
+	err := scheduler.Init()
+	Expect(err).To(BeNil())
+
+	// register the same descriptor for the default and two named VPP instances,
+	// each with its own SB
+	instances := []string{"", "numa1", "numa2"}
+	mockSBs := make(map[string]*test.MockSouthbound)
+	for _, instance := range instances {
+		mockSB := test.NewMockSouthbound()
+		mockSBs[instance] = mockSB
+		descriptor := test.NewMockDescriptor(&KVDescriptor{
+			Name:         "vpp-" + descriptor1Name,
+			NBKeyPrefix:  vppPrefix,
+			KeySelector:  prefixSelector(vppPrefix),
+			WithMetadata: true,
+		}, mockSB, 0)
+		Expect(ForInstance(scheduler, instance).RegisterKVDescriptor(descriptor)).To(Succeed())
+	}
+
+	// configure value in every instance
+	for _, instance := range instances {
+		schedulerTxn := ForInstance(scheduler, instance).StartNBTransaction()
+		schedulerTxn.SetValue(vppKey, test.NewStringValue(baseValue1))
+		_, err = schedulerTxn.Commit(testCtx)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(mockSBs[instance].GetValue(vppKey)).ToNot(BeNil())
+	}
+
+	// simulate restart of all VPPs
+	for _, instance := range instances {
+		mockSBs[instance].SetValue(vppKey, nil, nil, FromNB, false)
+		mockSBs[instance].PopHistoryOfOps()
+	}
+
+	// resync of VPP instance numa1 restores only its value
+	_, err = scheduler.StartNBTransaction().Commit(WithInstanceResync(testCtx, "numa1", false))
+	Expect(err).ShouldNot(HaveOccurred())
+
+	value := mockSBs["numa1"].GetValue(vppKey)
+	Expect(value).ToNot(BeNil())
+	Expect(proto.Equal(value.Value, test.NewStringValue(baseValue1))).To(BeTrue())
+	opHistory := mockSBs["numa1"].PopHistoryOfOps()
+	Expect(opHistory).To(HaveLen(2))
+	Expect(opHistory[0].OpType).To(Equal(test.MockRetrieve))
+	Expect(opHistory[1].OpType).To(Equal(test.MockCreate))
+	Expect(opHistory[1].Key).To(BeEquivalentTo(vppKey))
+
+	for _, instance := range []string{"", "numa2"} {
+		Expect(mockSBs[instance].GetValue(vppKey)).To(BeNil())
+		Expect(mockSBs[instance].PopHistoryOfOps()).To(BeEmpty())
+	}
+	status := ForInstance(scheduler, "numa2").GetValueStatus(vppKey)
+	Expect(status.GetValue().GetState()).To(Equal(ValueState_CONFIGURED))
+}
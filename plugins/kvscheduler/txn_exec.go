@@ -357,7 +357,10 @@ func (s *Scheduler) applyDelete(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 	handler := newDescriptorHandler(descriptor)
 	if !args.dryRun && descriptor != nil {
 		if args.kv.origin != kvs.FromSB {
+			span := handler.traceDescMethod(args.txn.ctx, "Delete", node.GetKey())
 			err = handler.delete(node.GetKey(), node.GetValue(), node.GetMetadata())
+			span.SetError(err)
+			span.End()
 		}
 		if err != nil {
 			retriableErr = handler.isRetriableFailure(err)
@@ -447,7 +450,10 @@ func (s *Scheduler) applyCreate(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 		var metadata interface{}
 
 		if args.kv.origin != kvs.FromSB {
+			span := handler.traceDescMethod(args.txn.ctx, "Create", node.GetKey())
 			metadata, err = handler.create(node.GetKey(), node.GetValue())
+			span.SetError(err)
+			span.End()
 		} else {
 			// already created in SB
 			metadata = args.kv.metadata
@@ -606,7 +612,10 @@ func (s *Scheduler) applyUpdate(node graph.NodeRW, txnOp *kvs.RecordedTxnOp, arg
 
 		// call Update handler
 		if args.kv.origin != kvs.FromSB {
+			span := handler.traceDescMethod(args.txn.ctx, "Update", node.GetKey())
 			newMetadata, err = handler.update(node.GetKey(), prevValue, node.GetValue(), node.GetMetadata())
+			span.SetError(err)
+			span.End()
 		} else {
 			// already modified in SB
 			newMetadata = args.kv.metadata
@@ -23,6 +23,8 @@ import (
 
 	"go.ligato.io/cn-infra/v2/logging"
 
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/graph"
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler/internal/utils"
@@ -54,6 +56,7 @@ type kvForTxn struct {
 // nbTxn encapsulates data for NB transaction.
 type nbTxn struct {
 	resyncType     kvs.ResyncType
+	resyncInstance string
 	verboseRefresh bool
 	isBlocking     bool
 
@@ -66,6 +69,16 @@ type nbTxn struct {
 	resultChan      chan txnResult
 }
 
+// inResyncScope returns true if the value is re-synced by the transaction,
+// resync of VPP instance is limited to values of the instance.
+func (nb *nbTxn) inResyncScope(key string) bool {
+	if nb.resyncInstance == "" {
+		return true
+	}
+	instance, _ := models.ParseInstanceKey(key)
+	return instance == nb.resyncInstance
+}
+
 // retryTxn encapsulates data for retry of failed operations.
 type retryTxn struct {
 	retryTxnMeta
@@ -119,10 +132,16 @@ func (s *Scheduler) processTransaction(txn *transaction) {
 	defer s.txnLock.Unlock()
 	defer trackTransactionMethod("processTransaction")()
 
+	var span *tracing.Span
+	txn.ctx, span = tracing.StartSpan(txn.ctx, "kvscheduler.txn",
+		tracing.Attr("txn.type", txn.txnType))
+	defer span.End()
+
 	startTime := time.Now()
 
 	// 1. Pre-processing:
 	skipExec, skipSimulation, record := s.preProcessTransaction(txn)
+	span.SetAttributes(tracing.Attr("txn.seq_num", txn.seqNum))
 
 	// 2. Ordering:
 	if !skipExec {
@@ -152,6 +171,13 @@ func (s *Scheduler) processTransaction(txn *transaction) {
 		executedOps = s.executeTransaction(txn, graphW, false)
 		graphW.Release()
 	}
+	span.SetAttributes(tracing.Attr("txn.ops", len(executedOps)))
+	for _, op := range executedOps {
+		if op.NewErr != nil {
+			span.SetError(op.NewErr)
+			break
+		}
+	}
 
 	stopTime := time.Now()
 
@@ -219,6 +245,9 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 		// for downstream resync it is assumed that scheduler is in-sync with NB
 		currentNodes := graphW.GetNodes(nil, nbBaseValsSelectors()...)
 		for _, node := range currentNodes {
+			if !txn.nb.inResyncScope(node.GetKey()) {
+				continue
+			}
 			lastUpdate := getNodeLastUpdate(node)
 			txn.values = append(txn.values,
 				kvForTxn{
@@ -240,15 +269,16 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 	// state of SB
 	if txn.nb.resyncType != kvs.UpstreamResync {
 		s.refreshGraph(graphW, nil, &resyncData{
-			first:  s.resyncCount == 1,
-			values: txn.values,
+			first:    s.resyncCount == 1,
+			values:   txn.values,
+			instance: txn.nb.resyncInstance,
 		}, txn.nb.verboseRefresh)
 	}
 
 	// collect deletes for obsolete values
 	currentNodes := graphW.GetNodes(nil, nbBaseValsSelectors()...)
 	for _, node := range currentNodes {
-		if nbKey := nbKeys.Has(node.GetKey()); nbKey {
+		if nbKey := nbKeys.Has(node.GetKey()); nbKey || !txn.nb.inResyncScope(node.GetKey()) {
 			continue
 		}
 		txn.values = append(txn.values,
@@ -262,7 +292,7 @@ func (s *Scheduler) preProcessNBTransaction(txn *transaction) (skip bool) {
 	// update (record) SB values
 	sbNodes := graphW.GetNodes(nil, sbBaseValsSelectors()...)
 	for _, node := range sbNodes {
-		if nbKey := nbKeys.Has(node.GetKey()); nbKey {
+		if nbKey := nbKeys.Has(node.GetKey()); nbKey || !txn.nb.inResyncScope(node.GetKey()) {
 			continue
 		}
 		txn.values = append(txn.values,
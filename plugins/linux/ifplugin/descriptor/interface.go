@@ -61,10 +61,18 @@ const (
 	defaultLoopbackMTU = 65536
 	defaultVrfDevMTU   = 65536
 
+	// maximum 802.1Q VLAN ID (4095 is reserved)
+	maxVlanID = 4094
+
+	// maximum VXLAN network identifier (24 bits)
+	maxVxlanVNI = 1<<24 - 1
+
 	// dependency labels
 	existingHostInterfaceDep = "host-interface-exists"
 	tapInterfaceDep          = "vpp-tap-interface-exists"
 	vethPeerDep              = "veth-peer-exists"
+	parentInterfaceDep       = "parent-interface-exists"
+	tunnelUnderlayDep        = "tunnel-underlay-exists"
 	microserviceDep          = "microservice-available"
 
 	// suffix attached to logical names of duplicate VETH interfaces
@@ -126,6 +134,42 @@ var (
 
 	// ErrVRFDevInsideVrf is returned when VRF device is configured to be inside another VRF.
 	ErrVRFDevInsideVrf = errors.New("VRF device cannot be inside another VRF")
+
+	// ErrVLANWithoutParent is returned when VLAN interface is missing reference to the parent interface.
+	ErrVLANWithoutParent = errors.New("VLAN interface defined without parent interface reference")
+
+	// ErrMacvlanWithoutParent is returned when MACVLAN interface is missing reference to the parent interface.
+	ErrMacvlanWithoutParent = errors.New("MACVLAN interface defined without parent interface reference")
+
+	// ErrIPVlanWithoutParent is returned when IPVLAN interface is missing reference to the parent interface.
+	ErrIPVlanWithoutParent = errors.New("IPVLAN interface defined without parent interface reference")
+
+	// ErrIPVlanWithMACAddr is returned when IPVLAN interface is configured with a MAC address
+	// (IPVLAN always uses the MAC address of the parent).
+	ErrIPVlanWithMACAddr = errors.New("it is unsupported to set MAC address to an IPVLAN interface")
+
+	// ErrVLANWithInvalidID is returned when VLAN interface is configured with VLAN ID out of the 1-4094 range.
+	ErrVLANWithInvalidID = errors.New("VLAN interface defined with invalid VLAN ID")
+
+	// ErrTunnelWithoutRemoteIP is returned when VXLAN or GRE tunnel is missing remote IP address.
+	ErrTunnelWithoutRemoteIP = errors.New("tunnel interface defined without remote IP address")
+
+	// ErrTunnelWithInvalidIP is returned when VXLAN or GRE tunnel is configured with invalid IP address
+	// or with local and remote IP addresses of different families.
+	ErrTunnelWithInvalidIP = errors.New("tunnel interface defined with invalid IP address")
+
+	// ErrVxlanWithInvalidVNI is returned when VXLAN is configured with VNI that does not fit into 24 bits.
+	ErrVxlanWithInvalidVNI = errors.New("VXLAN interface defined with invalid VNI")
+
+	// ErrWireguardWithInvalidKey is returned when WireGuard key is not a base64-encoded 32-byte key.
+	ErrWireguardWithInvalidKey = errors.New("WireGuard interface defined with invalid key")
+
+	// ErrWireguardWithInvalidEndpoint is returned when WireGuard peer endpoint is not in the <IP>:<port> form.
+	ErrWireguardWithInvalidEndpoint = errors.New("WireGuard interface defined with invalid peer endpoint")
+
+	// ErrInvalidMasterMember is returned when bridge port or bond member reference is empty
+	// or refers to the bridge/bond itself.
+	ErrInvalidMasterMember = errors.New("invalid reference to bridge port or bond member")
 )
 
 // InterfaceDescriptor teaches KVScheduler how to configure Linux interfaces.
@@ -230,6 +274,43 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 		if oldIntf.GetVrfDev().GetRoutingTable() != newIntf.GetVrfDev().GetRoutingTable() {
 			return false
 		}
+	case interfaces.Interface_BRIDGE:
+		if oldIntf.GetBridge().GetStpEnabled() != newIntf.GetBridge().GetStpEnabled() ||
+			oldIntf.GetBridge().GetVlanFiltering() != newIntf.GetBridge().GetVlanFiltering() {
+			return false
+		}
+	case interfaces.Interface_VLAN:
+		if oldIntf.GetVlan().GetParentInterface() != newIntf.GetVlan().GetParentInterface() ||
+			oldIntf.GetVlan().GetVlanId() != newIntf.GetVlan().GetVlanId() {
+			return false
+		}
+	case interfaces.Interface_BOND:
+		if oldIntf.GetBond().GetMode() != newIntf.GetBond().GetMode() ||
+			oldIntf.GetBond().GetMiimon() != newIntf.GetBond().GetMiimon() {
+			return false
+		}
+	case interfaces.Interface_MACVLAN:
+		if oldIntf.GetMacvlan().GetParentInterface() != newIntf.GetMacvlan().GetParentInterface() ||
+			oldIntf.GetMacvlan().GetMode() != newIntf.GetMacvlan().GetMode() {
+			return false
+		}
+	case interfaces.Interface_IPVLAN:
+		if oldIntf.GetIpvlan().GetParentInterface() != newIntf.GetIpvlan().GetParentInterface() ||
+			oldIntf.GetIpvlan().GetMode() != newIntf.GetIpvlan().GetMode() {
+			return false
+		}
+	case interfaces.Interface_VXLAN:
+		if !equivalentVxlan(oldIntf.GetVxlan(), newIntf.GetVxlan()) {
+			return false
+		}
+	case interfaces.Interface_GRE:
+		if !equivalentGRE(oldIntf.GetGre(), newIntf.GetGre()) {
+			return false
+		}
+	case interfaces.Interface_WIREGUARD:
+		if !equivalentWireguard(oldIntf.GetWireguard(), newIntf.GetWireguard()) {
+			return false
+		}
 	}
 
 	if !proto.Equal(oldIntf.Namespace, newIntf.Namespace) {
@@ -257,7 +338,8 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 		return false
 	}
 
-	// IP addresses and VRFs are derived out and therefore not compared here
+	// IP addresses, VRFs and bridge/bond members are derived out and therefore
+	// not compared here
 
 	return true
 }
@@ -316,6 +398,41 @@ func (d *InterfaceDescriptor) Validate(key string, linuxIf *interfaces.Interface
 		if linuxIf.GetVrfMasterInterface() != "" {
 			return kvs.NewInvalidValueError(ErrVRFDevInsideVrf, "type", "vrf")
 		}
+	case interfaces.Interface_VLAN:
+		if linuxIf.GetVlan().GetParentInterface() == "" {
+			return kvs.NewInvalidValueError(ErrVLANWithoutParent, "parent_interface")
+		}
+		if vlanID := linuxIf.GetVlan().GetVlanId(); vlanID == 0 || vlanID > maxVlanID {
+			return kvs.NewInvalidValueError(ErrVLANWithInvalidID, "vlan_id")
+		}
+	case interfaces.Interface_MACVLAN:
+		if linuxIf.GetMacvlan().GetParentInterface() == "" {
+			return kvs.NewInvalidValueError(ErrMacvlanWithoutParent, "parent_interface")
+		}
+	case interfaces.Interface_IPVLAN:
+		if linuxIf.GetIpvlan().GetParentInterface() == "" {
+			return kvs.NewInvalidValueError(ErrIPVlanWithoutParent, "parent_interface")
+		}
+		if linuxIf.GetPhysAddress() != "" {
+			return kvs.NewInvalidValueError(ErrIPVlanWithMACAddr, "type", "phys_address")
+		}
+	case interfaces.Interface_VXLAN:
+		vxlan := linuxIf.GetVxlan()
+		if vxlan.GetVni() > maxVxlanVNI {
+			return kvs.NewInvalidValueError(ErrVxlanWithInvalidVNI, "vni")
+		}
+		if err := validateTunnelIPs(vxlan.GetLocalIp(), vxlan.GetRemoteIp(), false); err != nil {
+			return err
+		}
+	case interfaces.Interface_GRE:
+		gre := linuxIf.GetGre()
+		if err := validateTunnelIPs(gre.GetLocalIp(), gre.GetRemoteIp(), true); err != nil {
+			return err
+		}
+	case interfaces.Interface_WIREGUARD:
+		if err := validateWireguard(linuxIf.GetWireguard()); err != nil {
+			return err
+		}
 	case interfaces.Interface_UNDEFINED:
 		return kvs.NewInvalidValueError(ErrInterfaceWithoutType, "type")
 	}
@@ -336,6 +453,48 @@ func (d *InterfaceDescriptor) Validate(key string, linuxIf *interfaces.Interface
 		if linuxIf.GetVeth().GetPeerIfName() == "" {
 			return kvs.NewInvalidValueError(ErrVETHWithoutPeer, "peer_if_name")
 		}
+	case *interfaces.Interface_Bridge:
+		if linuxIf.GetType() != interfaces.Interface_BRIDGE {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+		for i, port := range linuxIf.GetBridge().GetPorts() {
+			if port == "" || port == linuxIf.GetName() {
+				return kvs.NewInvalidValueError(ErrInvalidMasterMember, fmt.Sprintf("ports[%d]", i))
+			}
+		}
+	case *interfaces.Interface_Vlan:
+		if linuxIf.GetType() != interfaces.Interface_VLAN {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+	case *interfaces.Interface_Bond:
+		if linuxIf.GetType() != interfaces.Interface_BOND {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+		for i, member := range linuxIf.GetBond().GetMembers() {
+			if member == "" || member == linuxIf.GetName() {
+				return kvs.NewInvalidValueError(ErrInvalidMasterMember, fmt.Sprintf("members[%d]", i))
+			}
+		}
+	case *interfaces.Interface_Macvlan:
+		if linuxIf.GetType() != interfaces.Interface_MACVLAN {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+	case *interfaces.Interface_Ipvlan:
+		if linuxIf.GetType() != interfaces.Interface_IPVLAN {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+	case *interfaces.Interface_Vxlan:
+		if linuxIf.GetType() != interfaces.Interface_VXLAN {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+	case *interfaces.Interface_Gre:
+		if linuxIf.GetType() != interfaces.Interface_GRE {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
+	case *interfaces.Interface_Wireguard:
+		if linuxIf.GetType() != interfaces.Interface_WIREGUARD {
+			return kvs.NewInvalidValueError(ErrInterfaceReferenceMismatch, "link")
+		}
 	}
 
 	return nil
@@ -382,6 +541,22 @@ func (d *InterfaceDescriptor) Create(key string, linuxIf *interfaces.Interface)
 		metadata, err = d.createVRF(nsCtx, linuxIf)
 	case interfaces.Interface_DUMMY:
 		metadata, err = d.createDummyIf(nsCtx, linuxIf)
+	case interfaces.Interface_BRIDGE:
+		metadata, err = d.createBridge(nsCtx, linuxIf)
+	case interfaces.Interface_VLAN:
+		metadata, err = d.createVLAN(nsCtx, linuxIf)
+	case interfaces.Interface_BOND:
+		metadata, err = d.createBond(nsCtx, linuxIf)
+	case interfaces.Interface_MACVLAN:
+		metadata, err = d.createMacvlan(nsCtx, linuxIf)
+	case interfaces.Interface_IPVLAN:
+		metadata, err = d.createIPVlan(nsCtx, linuxIf)
+	case interfaces.Interface_VXLAN:
+		metadata, err = d.createVxlan(nsCtx, linuxIf)
+	case interfaces.Interface_GRE:
+		metadata, err = d.createGRE(nsCtx, linuxIf)
+	case interfaces.Interface_WIREGUARD:
+		metadata, err = d.createWireguard(nsCtx, linuxIf)
 	default:
 		return nil, ErrUnsupportedLinuxInterfaceType
 	}
@@ -482,6 +657,22 @@ func (d *InterfaceDescriptor) Delete(key string, linuxIf *interfaces.Interface,
 		return d.deleteVRF(linuxIf)
 	case interfaces.Interface_DUMMY:
 		return d.deleteDummyIf(linuxIf)
+	case interfaces.Interface_BRIDGE:
+		return d.deleteBridge(linuxIf)
+	case interfaces.Interface_VLAN:
+		return d.deleteVLAN(linuxIf)
+	case interfaces.Interface_BOND:
+		return d.deleteBond(linuxIf)
+	case interfaces.Interface_MACVLAN:
+		return d.deleteMacvlan(linuxIf)
+	case interfaces.Interface_IPVLAN:
+		return d.deleteIPVlan(linuxIf)
+	case interfaces.Interface_VXLAN:
+		return d.deleteVxlan(linuxIf)
+	case interfaces.Interface_GRE:
+		return d.deleteGRE(linuxIf)
+	case interfaces.Interface_WIREGUARD:
+		return d.deleteWireguard(linuxIf)
 	}
 
 	err = ErrUnsupportedLinuxInterfaceType
@@ -583,6 +774,34 @@ func (d *InterfaceDescriptor) Update(key string, oldLinuxIf, newLinuxIf *interfa
 		}
 	}
 
+	// update bridge options
+	if newLinuxIf.Type == interfaces.Interface_BRIDGE {
+		stp := newLinuxIf.GetBridge().GetStpEnabled()
+		vlanFiltering := newLinuxIf.GetBridge().GetVlanFiltering()
+		if stp != oldLinuxIf.GetBridge().GetStpEnabled() ||
+			vlanFiltering != oldLinuxIf.GetBridge().GetVlanFiltering() {
+			err = d.ifHandler.SetBridgeOptions(newHostName, stp, vlanFiltering)
+			if err != nil {
+				err = errors.Errorf("failed to reconfigure bridge options (stp=%t,vlan_filtering=%t) for linux interface %s: %v",
+					stp, vlanFiltering, newLinuxIf.Name, err)
+				d.log.Error(err)
+				return nil, err
+			}
+		}
+	}
+
+	// update WireGuard keys and peers
+	if newLinuxIf.Type == interfaces.Interface_WIREGUARD &&
+		!equivalentWireguard(oldLinuxIf.GetWireguard(), newLinuxIf.GetWireguard()) {
+		err = d.ifHandler.SetWireguardDevice(newHostName, newLinuxIf.GetWireguard())
+		if err != nil {
+			err = errors.Errorf("failed to reconfigure WireGuard linux interface %s: %v",
+				newLinuxIf.Name, err)
+			d.log.Error(err)
+			return nil, err
+		}
+	}
+
 	// update metadata
 	oldMetadata.HostIfName = newHostName
 	oldMetadata.VrfMasterIf = newLinuxIf.VrfMasterInterface
@@ -608,6 +827,22 @@ func (d *InterfaceDescriptor) UpdateWithRecreate(key string, oldLinuxIf, newLinu
 		return oldLinuxIf.GetTap().GetVppTapIfName() != newLinuxIf.GetTap().GetVppTapIfName()
 	case interfaces.Interface_VRF_DEVICE:
 		return oldLinuxIf.GetVrfDev().GetRoutingTable() != newLinuxIf.GetVrfDev().GetRoutingTable()
+	case interfaces.Interface_VLAN:
+		return oldLinuxIf.GetVlan().GetParentInterface() != newLinuxIf.GetVlan().GetParentInterface() ||
+			oldLinuxIf.GetVlan().GetVlanId() != newLinuxIf.GetVlan().GetVlanId()
+	case interfaces.Interface_BOND:
+		return oldLinuxIf.GetBond().GetMode() != newLinuxIf.GetBond().GetMode() ||
+			oldLinuxIf.GetBond().GetMiimon() != newLinuxIf.GetBond().GetMiimon()
+	case interfaces.Interface_MACVLAN:
+		return oldLinuxIf.GetMacvlan().GetParentInterface() != newLinuxIf.GetMacvlan().GetParentInterface() ||
+			oldLinuxIf.GetMacvlan().GetMode() != newLinuxIf.GetMacvlan().GetMode()
+	case interfaces.Interface_IPVLAN:
+		return oldLinuxIf.GetIpvlan().GetParentInterface() != newLinuxIf.GetIpvlan().GetParentInterface() ||
+			oldLinuxIf.GetIpvlan().GetMode() != newLinuxIf.GetIpvlan().GetMode()
+	case interfaces.Interface_VXLAN:
+		return !equivalentVxlan(oldLinuxIf.GetVxlan(), newLinuxIf.GetVxlan())
+	case interfaces.Interface_GRE:
+		return !equivalentGRE(oldLinuxIf.GetGre(), newLinuxIf.GetGre())
 	}
 	return false
 }
@@ -642,6 +877,40 @@ func (d *InterfaceDescriptor) Dependencies(key string, linuxIf *interfaces.Inter
 		}
 	}
 
+	// VLAN, MACVLAN and IPVLAN depend on the parent interface
+	// (if the parent is re-created, e.g. moved to another namespace,
+	// the interface stacked over it gets re-created as well)
+	var parentName string
+	switch linuxIf.Type {
+	case interfaces.Interface_VLAN:
+		parentName = linuxIf.GetVlan().GetParentInterface()
+	case interfaces.Interface_MACVLAN:
+		parentName = linuxIf.GetMacvlan().GetParentInterface()
+	case interfaces.Interface_IPVLAN:
+		parentName = linuxIf.GetIpvlan().GetParentInterface()
+	}
+	if parentName != "" {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: parentInterfaceDep,
+			Key:   interfaces.InterfaceKey(parentName),
+		})
+	}
+
+	// VXLAN and GRE tunnels depend on the underlay interface (if referenced)
+	var underlayName string
+	switch linuxIf.Type {
+	case interfaces.Interface_VXLAN:
+		underlayName = linuxIf.GetVxlan().GetUnderlayInterface()
+	case interfaces.Interface_GRE:
+		underlayName = linuxIf.GetGre().GetUnderlayInterface()
+	}
+	if underlayName != "" {
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: tunnelUnderlayDep,
+			Key:   interfaces.InterfaceKey(underlayName),
+		})
+	}
+
 	if linuxIf.GetNamespace().GetType() == namespace.NetNamespace_MICROSERVICE {
 		dependencies = append(dependencies, kvs.Dependency{
 			Label: microserviceDep,
@@ -655,6 +924,7 @@ func (d *InterfaceDescriptor) Dependencies(key string, linuxIf *interfaces.Inter
 // DerivedValues derives:
 //   - one empty value to represent interface state
 //   - one empty value to represent assignment of the interface to a (non-default) VRF
+//   - one value for every port of a bridge / member of a bond to represent enslavement
 //   - one empty value for every IP address assigned to the interface.
 func (d *InterfaceDescriptor) DerivedValues(key string, linuxIf *interfaces.Interface) (derValues []kvs.KeyValuePair) {
 	// interface state
@@ -675,6 +945,24 @@ func (d *InterfaceDescriptor) DerivedValues(key string, linuxIf *interfaces.Inte
 			},
 		})
 	}
+	var members []string
+	switch linuxIf.GetType() {
+	case interfaces.Interface_BRIDGE:
+		members = linuxIf.GetBridge().GetPorts()
+	case interfaces.Interface_BOND:
+		members = linuxIf.GetBond().GetMembers()
+	}
+	for _, member := range members {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key: interfaces.InterfaceMasterKey(member, linuxIf.Name),
+			// master descriptor resolves host names of both the member and the master
+			// from the interface index, the value only tells bridge and bond apart
+			Value: &interfaces.Interface{
+				Name: linuxIf.Name,
+				Type: linuxIf.Type,
+			},
+		})
+	}
 	if !linuxIf.GetLinkOnly() || linuxIf.GetType() == interfaces.Interface_EXISTING {
 		var ipSource netalloc_api.IPAddressSource
 		if linuxIf.GetLinkOnly() { // interface type = EXISTING
@@ -756,6 +1044,8 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 	ifaces := make(map[string]adapter.InterfaceKVWithMetadata)
 	// already retrieved interfaces by their Linux indexes
 	indexes := make(map[int]struct{})
+	// bridges and bonds from the default namespace by their Linux indexes
+	masters := make(map[int]*interfaces.Interface)
 
 	for _, ifDetail := range ifDetails {
 		// Transform linux interface details to the type-safe value with metadata
@@ -768,6 +1058,10 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 			if vrf, existingVrf := existingIfaces[ifDetail.Meta.MasterIndex]; existingVrf {
 				ifDetail.Interface.VrfMasterInterface = vrf.Value.Name
 			}
+			if ifDetail.Interface.Type == interfaces.Interface_BRIDGE ||
+				ifDetail.Interface.Type == interfaces.Interface_BOND {
+				masters[ifDetail.Meta.LinuxIfIndex] = ifDetail.Interface
+			}
 		}
 		kv := adapter.InterfaceKVWithMetadata{
 			Origin: kvs.FromNB,
@@ -821,6 +1115,25 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 		ifaces[kv.Value.Name] = kv
 	}
 
+	// handle existing (i.e. not managed) interfaces enslaved to managed bridges/bonds
+	for ifIndex, existing := range existingIfaces {
+		link, err := d.ifHandler.GetLinkByIndex(ifIndex)
+		if err != nil {
+			d.log.Warnf("failed to get link of existing interface %s: %v", existing.Value.Name, err)
+			continue
+		}
+		master, hasMaster := masters[link.Attrs().MasterIndex]
+		if !hasMaster {
+			continue
+		}
+		switch master.Type {
+		case interfaces.Interface_BRIDGE:
+			master.GetBridge().Ports = append(master.GetBridge().Ports, existing.Value.Name)
+		case interfaces.Interface_BOND:
+			master.GetBond().Members = append(master.GetBond().Members, existing.Value.Name)
+		}
+	}
+
 	// collect VETHs with duplicate logical names
 	for ifName, kv := range ifaces {
 		if kv.Value.Type == interfaces.Interface_VETH {
@@ -986,6 +1299,54 @@ func (d *InterfaceDescriptor) retrieveLinkDetails(link netlink.Link, iface *inte
 	}
 }
 
+// getUnderlayHostIfName returns host name of the underlay interface of a tunnel.
+// The underlay interface is optional (empty host name is returned if not referenced),
+// but if referenced it has to be in the same namespace as the tunnel.
+func (d *InterfaceDescriptor) getUnderlayHostIfName(linuxIf *interfaces.Interface, underlayName string) (string, error) {
+	if underlayName == "" {
+		return "", nil
+	}
+	underlayMeta, found := d.intfIndex.LookupByName(underlayName)
+	if !found {
+		return "", errors.Errorf("failed to find underlay interface %s", underlayName)
+	}
+	if !proto.Equal(underlayMeta.Namespace, linuxIf.Namespace) {
+		return "", errors.Errorf("underlay interface %s is not in the same namespace as %v %s",
+			underlayName, linuxIf.Type, linuxIf.Name)
+	}
+	return underlayMeta.HostIfName, nil
+}
+
+// validateTunnelIPs validates local and remote IP address of a tunnel interface.
+func validateTunnelIPs(localIP, remoteIP string, remoteRequired bool) error {
+	if remoteIP == "" {
+		if remoteRequired {
+			return kvs.NewInvalidValueError(ErrTunnelWithoutRemoteIP, "remote_ip")
+		}
+	} else if net.ParseIP(remoteIP) == nil {
+		return kvs.NewInvalidValueError(ErrTunnelWithInvalidIP, "remote_ip")
+	}
+	if localIP == "" {
+		return nil
+	}
+	local := net.ParseIP(localIP)
+	if local == nil {
+		return kvs.NewInvalidValueError(ErrTunnelWithInvalidIP, "local_ip")
+	}
+	if remoteIP != "" && (local.To4() == nil) != (net.ParseIP(remoteIP).To4() == nil) {
+		return kvs.NewInvalidValueError(ErrTunnelWithInvalidIP, "local_ip", "remote_ip")
+	}
+	return nil
+}
+
+// equalIPs compares two IP addresses given as strings (regardless of the notation).
+func equalIPs(ip1, ip2 string) bool {
+	if ip1 == "" || ip2 == "" {
+		return ip1 == ip2
+	}
+	return net.ParseIP(ip1).Equal(net.ParseIP(ip2))
+}
+
 // setInterfaceNamespace moves linux interface from the current to the desired
 // namespace.
 func (d *InterfaceDescriptor) setInterfaceNamespace(ctx nslinuxcalls.NamespaceMgmtCtx, ifName string, namespace *namespace.NetNamespace) error {
@@ -0,0 +1,91 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// createGRE creates a new GRE (or ip6gre) tunnel interface.
+func (d *InterfaceDescriptor) createGRE(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	hostName := getHostIfName(linuxIf)
+	gre := linuxIf.GetGre()
+	agentPrefix := d.serviceLabel.GetAgentPrefix()
+
+	underlayHostName, err := d.getUnderlayHostIfName(linuxIf, gre.GetUnderlayInterface())
+	if err != nil {
+		return nil, err
+	}
+
+	// move to the namespace with the interface
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, linuxIf.Namespace)
+	if err != nil {
+		d.log.Error("switch to namespace failed:", err)
+		return nil, err
+	}
+	defer revert()
+
+	// create a new GRE tunnel
+	err = d.ifHandler.AddGRE(hostName, gre, underlayHostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to add GRE %s (remote: %s)", hostName, gre.GetRemoteIp())
+	}
+
+	// add alias
+	err = d.ifHandler.SetInterfaceAlias(hostName, agentPrefix+linuxcalls.GetGreAlias(linuxIf))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error setting GRE %s alias", hostName)
+	}
+
+	// build metadata
+	link, err := d.ifHandler.GetLinkByName(hostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error getting link %s", hostName)
+	}
+
+	return &ifaceidx.LinuxIfMetadata{
+		Namespace:    linuxIf.Namespace,
+		LinuxIfIndex: link.Attrs().Index,
+		HostIfName:   hostName,
+	}, nil
+}
+
+// deleteGRE removes GRE tunnel interface.
+func (d *InterfaceDescriptor) deleteGRE(linuxIf *interfaces.Interface) error {
+	hostName := getHostIfName(linuxIf)
+	err := d.ifHandler.DeleteInterface(hostName)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// equivalentGRE compares two GRE configurations.
+func equivalentGRE(gre1, gre2 *interfaces.GreLink) bool {
+	return equalIPs(gre1.GetLocalIp(), gre2.GetLocalIp()) &&
+		equalIPs(gre1.GetRemoteIp(), gre2.GetRemoteIp()) &&
+		gre1.GetTtl() == gre2.GetTtl() &&
+		gre1.GetInputKey() == gre2.GetInputKey() &&
+		gre1.GetOutputKey() == gre2.GetOutputKey() &&
+		gre1.GetUnderlayInterface() == gre2.GetUnderlayInterface()
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// createMacvlan creates a new MACVLAN interface in the namespace of the parent
+// interface and moves it into the requested namespace if it differs.
+func (d *InterfaceDescriptor) createMacvlan(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	mode := linuxIf.GetMacvlan().GetMode()
+	return d.createLinkOverParent(nsCtx, linuxIf, linuxIf.GetMacvlan().GetParentInterface(),
+		linuxcalls.GetMacvlanAlias(linuxIf),
+		func(hostName, parentHostName string) error {
+			err := d.ifHandler.AddMacvlan(hostName, parentHostName, mode)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to add MACVLAN %s (parent: %s, mode: %v)",
+					hostName, parentHostName, mode)
+			}
+			return nil
+		})
+}
+
+// createIPVlan creates a new IPVLAN interface in the namespace of the parent
+// interface and moves it into the requested namespace if it differs.
+func (d *InterfaceDescriptor) createIPVlan(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	mode := linuxIf.GetIpvlan().GetMode()
+	return d.createLinkOverParent(nsCtx, linuxIf, linuxIf.GetIpvlan().GetParentInterface(),
+		linuxcalls.GetIPVlanAlias(linuxIf),
+		func(hostName, parentHostName string) error {
+			err := d.ifHandler.AddIPVlan(hostName, parentHostName, mode)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to add IPVLAN %s (parent: %s, mode: %v)",
+					hostName, parentHostName, mode)
+			}
+			return nil
+		})
+}
+
+// deleteMacvlan removes MACVLAN interface.
+func (d *InterfaceDescriptor) deleteMacvlan(linuxIf *interfaces.Interface) error {
+	return d.deleteLinkOverParent(linuxIf)
+}
+
+// deleteIPVlan removes IPVLAN interface.
+func (d *InterfaceDescriptor) deleteIPVlan(linuxIf *interfaces.Interface) error {
+	return d.deleteLinkOverParent(linuxIf)
+}
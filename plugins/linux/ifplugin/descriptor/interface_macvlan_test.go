@@ -0,0 +1,241 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+func macvlanInterface(parent string, mode interfaces.MacvlanLink_Mode) *interfaces.Interface {
+	return &interfaces.Interface{Name: "macvlan1", Type: interfaces.Interface_MACVLAN,
+		Link: &interfaces.Interface_Macvlan{Macvlan: &interfaces.MacvlanLink{ParentInterface: parent, Mode: mode}}}
+}
+
+func ipvlanInterface(parent string, mode interfaces.IpvlanLink_Mode) *interfaces.Interface {
+	return &interfaces.Interface{Name: "ipvlan1", Type: interfaces.Interface_IPVLAN,
+		Link: &interfaces.Interface_Ipvlan{Ipvlan: &interfaces.IpvlanLink{ParentInterface: parent, Mode: mode}}}
+}
+
+func TestValidateMacvlanAndIPVlan(t *testing.T) {
+	ipvlanWithMAC := ipvlanInterface("eth0", interfaces.IpvlanLink_L2)
+	ipvlanWithMAC.PhysAddress = "aa:bb:cc:dd:ee:ff"
+	tests := []struct {
+		name   string
+		iface  *interfaces.Interface
+		err    error
+		fields []string
+	}{
+		{
+			name:  "valid MACVLAN",
+			iface: macvlanInterface("eth0", interfaces.MacvlanLink_VEPA),
+		},
+		{
+			name:   "MACVLAN without parent",
+			iface:  macvlanInterface("", interfaces.MacvlanLink_BRIDGE),
+			err:    ErrMacvlanWithoutParent,
+			fields: []string{"parent_interface"},
+		},
+		{
+			name:  "valid IPVLAN",
+			iface: ipvlanInterface("eth0", interfaces.IpvlanLink_L3S),
+		},
+		{
+			name:   "IPVLAN without parent",
+			iface:  ipvlanInterface("", interfaces.IpvlanLink_L2),
+			err:    ErrIPVlanWithoutParent,
+			fields: []string{"parent_interface"},
+		},
+		{
+			name:   "IPVLAN with MAC address",
+			iface:  ipvlanWithMAC,
+			err:    ErrIPVlanWithMACAddr,
+			fields: []string{"type", "phys_address"},
+		},
+		{
+			name: "IPVLAN link with MACVLAN type",
+			iface: &interfaces.Interface{Name: "macvlan1", Type: interfaces.Interface_MACVLAN,
+				Link: &interfaces.Interface_Ipvlan{Ipvlan: &interfaces.IpvlanLink{ParentInterface: "eth0"}}},
+			err:    ErrMacvlanWithoutParent,
+			fields: []string{"parent_interface"},
+		},
+		{
+			name: "MACVLAN link with IPVLAN type",
+			iface: &interfaces.Interface{Name: "ipvlan1", Type: interfaces.Interface_IPVLAN,
+				Link: &interfaces.Interface_Macvlan{Macvlan: &interfaces.MacvlanLink{ParentInterface: "eth0"}}},
+			err:    ErrIPVlanWithoutParent,
+			fields: []string{"parent_interface"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			err := descriptor.Validate(interfaces.InterfaceKey(test.iface.Name), test.iface)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(Equal(kvs.NewInvalidValueError(test.err, test.fields...)))
+		})
+	}
+}
+
+func TestCreateMacvlanAndIPVlan(t *testing.T) {
+	ms1 := &namespace.NetNamespace{Type: namespace.NetNamespace_MICROSERVICE, Reference: "ms1"}
+	macvlanInMs := macvlanInterface("eth0", interfaces.MacvlanLink_PRIVATE)
+	macvlanInMs.Namespace = ms1
+	ipvlanInMs := ipvlanInterface("ms-eth0", interfaces.IpvlanLink_L3)
+	ipvlanInMs.Namespace = ms1
+	tests := []struct {
+		name  string
+		iface *interfaces.Interface
+		calls []string
+	}{
+		{
+			name:  "MACVLAN in the namespace of the parent",
+			iface: macvlanInterface("eth0", interfaces.MacvlanLink_BRIDGE),
+			calls: []string{
+				"AddMacvlan macvlan1 parent=eth0 mode=BRIDGE",
+				"SetInterfaceAlias macvlan1 test-agent/macvlan1/eth0",
+			},
+		},
+		{
+			name:  "MACVLAN moved into container",
+			iface: macvlanInMs,
+			calls: []string{
+				"AddMacvlan macvlan1 parent=eth0 mode=PRIVATE",
+				"SetLinkNamespace macvlan1",
+				"SetInterfaceMTU macvlan1 0",
+				"SetInterfaceAlias macvlan1 test-agent/macvlan1/eth0",
+			},
+		},
+		{
+			name:  "IPVLAN in the namespace of the parent",
+			iface: ipvlanInMs,
+			calls: []string{
+				"AddIPVlan ipvlan1 parent=eth0 mode=L3",
+				"SetInterfaceAlias ipvlan1 test-agent/ipvlan1/ms-eth0",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			ifHandler := newNetlinkMock()
+			descriptor := newTestInterfaceDescriptor(ifHandler)
+			metadata, err := descriptor.Create(interfaces.InterfaceKey(test.iface.Name), test.iface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ifHandler.calls).To(Equal(test.calls))
+			Expect(metadata).To(Equal(&ifaceidx.LinuxIfMetadata{
+				Namespace:    test.iface.Namespace,
+				LinuxIfIndex: 1,
+				HostIfName:   test.iface.Name,
+			}))
+		})
+	}
+}
+
+func TestDeleteMacvlanRemovedWithParent(t *testing.T) {
+	RegisterTestingT(t)
+
+	ifHandler := newNetlinkMock()
+	descriptor := newTestInterfaceDescriptor(ifHandler)
+	macvlan := macvlanInterface("eth0", interfaces.MacvlanLink_BRIDGE)
+	metadata, err := descriptor.Create(interfaces.InterfaceKey(macvlan.Name), macvlan)
+	Expect(err).ToNot(HaveOccurred())
+
+	ifHandler.calls = nil
+	Expect(descriptor.Delete(interfaces.InterfaceKey(macvlan.Name), macvlan, metadata)).To(Succeed())
+	Expect(ifHandler.calls).To(Equal([]string{"DeleteInterface macvlan1"}))
+
+	// the kernel removes MACVLAN together with its parent
+	ifHandler.calls = nil
+	Expect(descriptor.Delete(interfaces.InterfaceKey(macvlan.Name), macvlan, metadata)).To(Succeed())
+	Expect(ifHandler.calls).To(BeEmpty())
+}
+
+func TestMacvlanAndIPVlanParentDependency(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+	for _, iface := range []*interfaces.Interface{
+		macvlanInterface("eth0", interfaces.MacvlanLink_BRIDGE),
+		ipvlanInterface("eth0", interfaces.IpvlanLink_L2),
+	} {
+		Expect(descriptor.Dependencies(interfaces.InterfaceKey(iface.Name), iface)).To(Equal([]kvs.Dependency{
+			{Label: parentInterfaceDep, Key: interfaces.InterfaceKey("eth0")},
+		}))
+	}
+}
+
+func TestUpdateWithRecreateMacvlanAndIPVlan(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldIface *interfaces.Interface
+		newIface *interfaces.Interface
+		recreate bool
+	}{
+		{
+			name:     "same MACVLAN",
+			oldIface: macvlanInterface("eth0", interfaces.MacvlanLink_VEPA),
+			newIface: macvlanInterface("eth0", interfaces.MacvlanLink_VEPA),
+		},
+		{
+			name:     "MACVLAN mode changed",
+			oldIface: macvlanInterface("eth0", interfaces.MacvlanLink_VEPA),
+			newIface: macvlanInterface("eth0", interfaces.MacvlanLink_BRIDGE),
+			recreate: true,
+		},
+		{
+			name:     "MACVLAN parent changed",
+			oldIface: macvlanInterface("eth0", interfaces.MacvlanLink_VEPA),
+			newIface: macvlanInterface("eth1", interfaces.MacvlanLink_VEPA),
+			recreate: true,
+		},
+		{
+			name:     "IPVLAN mode changed",
+			oldIface: ipvlanInterface("eth0", interfaces.IpvlanLink_L2),
+			newIface: ipvlanInterface("eth0", interfaces.IpvlanLink_L3S),
+			recreate: true,
+		},
+		{
+			name:     "IPVLAN parent changed",
+			oldIface: ipvlanInterface("eth0", interfaces.IpvlanLink_L2),
+			newIface: ipvlanInterface("eth1", interfaces.IpvlanLink_L2),
+			recreate: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			recreate := descriptor.UpdateWithRecreate(interfaces.InterfaceKey(test.oldIface.Name),
+				test.oldIface, test.newIface, &ifaceidx.LinuxIfMetadata{})
+			Expect(recreate).To(Equal(test.recreate))
+			Expect(descriptor.EquivalentInterfaces(interfaces.InterfaceKey(test.oldIface.Name),
+				test.oldIface, test.newIface)).To(Equal(!test.recreate))
+		})
+	}
+}
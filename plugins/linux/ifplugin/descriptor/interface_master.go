@@ -0,0 +1,188 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	iflinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+const (
+	// InterfaceMasterDescriptorName is the name of the descriptor for enslaving Linux interfaces
+	// to bridges and bonds.
+	InterfaceMasterDescriptorName = "linux-interface-master"
+
+	// dependency labels
+	masterMemberDep = "member-interface-exists"
+)
+
+// InterfaceMasterDescriptor attaches/detaches Linux interfaces to/from bridge or bond
+// (master) devices.
+type InterfaceMasterDescriptor struct {
+	log       logging.Logger
+	ifHandler iflinuxcalls.NetlinkAPI
+	nsPlugin  nsplugin.API
+	intfIndex ifaceidx.LinuxIfMetadataIndex
+}
+
+// NewInterfaceMasterDescriptor creates a new instance of InterfaceMasterDescriptor.
+func NewInterfaceMasterDescriptor(nsPlugin nsplugin.API,
+	ifHandler iflinuxcalls.NetlinkAPI, log logging.PluginLogger) (descr *kvs.KVDescriptor, ctx *InterfaceMasterDescriptor) {
+
+	ctx = &InterfaceMasterDescriptor{
+		ifHandler: ifHandler,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("interface-master-descriptor"),
+	}
+	typedDescr := &adapter.InterfaceMasterDescriptor{
+		Name:        InterfaceMasterDescriptorName,
+		KeySelector: ctx.IsInterfaceMasterKey,
+		ValueComparator: func(_ string, _, _ *interfaces.Interface) bool {
+			// enslavement is fully identified by its key (member and master logical
+			// names) - the value carries only the type of the master, which cannot
+			// change without re-creating the master together with all its enslavements
+			return true
+		},
+		Validate:     ctx.Validate,
+		Create:       ctx.Create,
+		Delete:       ctx.Delete,
+		Dependencies: ctx.Dependencies,
+	}
+	descr = adapter.NewInterfaceMasterDescriptor(typedDescr)
+	return
+}
+
+// SetInterfaceIndex should be used to provide interface index immediately after
+// the descriptor registration.
+func (d *InterfaceMasterDescriptor) SetInterfaceIndex(intfIndex ifaceidx.LinuxIfMetadataIndex) {
+	d.intfIndex = intfIndex
+}
+
+// IsInterfaceMasterKey returns true if the key represents enslavement of a Linux interface
+// to a bridge or a bond.
+func (d *InterfaceMasterDescriptor) IsInterfaceMasterKey(key string) bool {
+	_, _, _, isMasterKey := interfaces.ParseInterfaceMasterKey(key)
+	return isMasterKey
+}
+
+// Validate validates derived key.
+func (d *InterfaceMasterDescriptor) Validate(key string, master *interfaces.Interface) (err error) {
+	_, _, invalidKey, _ := interfaces.ParseInterfaceMasterKey(key)
+	if invalidKey {
+		return errors.New("invalid key")
+	}
+	return nil
+}
+
+// Create enslaves interface to the bridge/bond.
+func (d *InterfaceMasterDescriptor) Create(key string, master *interfaces.Interface) (metadata interface{}, err error) {
+	ifaceName, masterName, _, _ := interfaces.ParseInterfaceMasterKey(key)
+	ifMeta, found := d.intfIndex.LookupByName(ifaceName)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", ifaceName)
+		d.log.Error(err)
+		return nil, err
+	}
+	masterMeta, found := d.intfIndex.LookupByName(masterName)
+	if !found {
+		err = errors.Errorf("failed to find %v %s", master.GetType(), masterName)
+		d.log.Error(err)
+		return nil, err
+	}
+	if !proto.Equal(ifMeta.Namespace, masterMeta.Namespace) {
+		err = errors.Errorf("interface %s is not in the same namespace as %v %s",
+			ifaceName, master.GetType(), masterName)
+		d.log.Error(err)
+		return nil, err
+	}
+
+	// switch to the namespace with the interface
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, ifMeta.Namespace)
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	defer revert()
+
+	err = d.ifHandler.PutInterfaceIntoMaster(ifMeta.HostIfName, masterMeta.HostIfName)
+	if err != nil {
+		err = errors.WithMessagef(err, "failed to put interface '%s' into %v '%s'",
+			ifMeta.HostIfName, master.GetType(), masterMeta.HostIfName)
+	}
+	return nil, err
+}
+
+// Delete releases interface from the bridge/bond.
+func (d *InterfaceMasterDescriptor) Delete(key string, master *interfaces.Interface, metadata interface{}) (err error) {
+	ifaceName, masterName, _, _ := interfaces.ParseInterfaceMasterKey(key)
+	ifMeta, found := d.intfIndex.LookupByName(ifaceName)
+	if !found {
+		err = errors.Errorf("failed to find interface %s", ifaceName)
+		d.log.Error(err)
+		return err
+	}
+	masterMeta, found := d.intfIndex.LookupByName(masterName)
+	if !found {
+		err = errors.Errorf("failed to find %v %s", master.GetType(), masterName)
+		d.log.Error(err)
+		return err
+	}
+
+	// switch to the namespace with the interface
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, ifMeta.Namespace)
+	if err != nil {
+		if _, ok := err.(*nsplugin.UnavailableMicroserviceErr); ok {
+			// Assume that the delete was called by scheduler because the namespace
+			// was removed. Do not return error in this case.
+			d.log.Debugf("Interface %s assumed to be released from %s, required namespace %+v does not exist",
+				ifaceName, masterName, ifMeta.Namespace)
+			return nil
+		}
+		d.log.Error(err)
+		return err
+	}
+	defer revert()
+
+	err = d.ifHandler.RemoveInterfaceFromMaster(ifMeta.HostIfName, masterMeta.HostIfName)
+	if err != nil {
+		err = errors.WithMessagef(err, "failed to remove interface '%s' from %v '%s'",
+			ifMeta.HostIfName, master.GetType(), masterMeta.HostIfName)
+	}
+	return err
+}
+
+// Dependencies lists the member interface as the only dependency
+// (the master is the interface the value is derived from).
+func (d *InterfaceMasterDescriptor) Dependencies(key string, master *interfaces.Interface) (deps []kvs.Dependency) {
+	ifaceName, _, _, _ := interfaces.ParseInterfaceMasterKey(key)
+	if ifaceName != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: masterMemberDep,
+			Key:   interfaces.InterfaceKey(ifaceName),
+		})
+	}
+	return deps
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+var testBridge = &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE}
+
+func newTestInterfaceMasterDescriptor(ifHandler *netlinkMock, nsPlugin *nsPluginMock) *InterfaceMasterDescriptor {
+	ms1 := &namespace.NetNamespace{Type: namespace.NetNamespace_MICROSERVICE, Reference: "ms1"}
+	ifIndex := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndex.Put("eth0", &ifaceidx.LinuxIfMetadata{HostIfName: "eth0-host", LinuxIfIndex: 1})
+	ifIndex.Put("br1", &ifaceidx.LinuxIfMetadata{HostIfName: "br1-host", LinuxIfIndex: 2})
+	ifIndex.Put("ms-eth0", &ifaceidx.LinuxIfMetadata{HostIfName: "eth0", LinuxIfIndex: 1, Namespace: ms1})
+	ifIndex.Put("ms-br1", &ifaceidx.LinuxIfMetadata{HostIfName: "br1", LinuxIfIndex: 2, Namespace: ms1})
+	return &InterfaceMasterDescriptor{
+		log:       logrus.NewLogger("test"),
+		ifHandler: ifHandler,
+		nsPlugin:  nsPlugin,
+		intfIndex: ifIndex,
+	}
+}
+
+func TestInterfaceMasterKey(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := newTestInterfaceMasterDescriptor(newNetlinkMock(), &nsPluginMock{})
+	key := interfaces.InterfaceMasterKey("eth0", "br1")
+	Expect(descriptor.IsInterfaceMasterKey(key)).To(BeTrue())
+	Expect(descriptor.IsInterfaceMasterKey(interfaces.InterfaceKey("eth0"))).To(BeFalse())
+	Expect(descriptor.IsInterfaceMasterKey(interfaces.InterfaceVrfKey("eth0", "vrf1"))).To(BeFalse())
+	Expect(descriptor.Validate(key, testBridge)).To(Succeed())
+	Expect(descriptor.Dependencies(key, testBridge)).To(Equal([]kvs.Dependency{
+		{Label: masterMemberDep, Key: interfaces.InterfaceKey("eth0")},
+	}))
+}
+
+func TestInterfaceMasterCreate(t *testing.T) {
+	tests := []struct {
+		name   string
+		member string
+		master string
+		calls  []string
+		err    bool
+	}{
+		{
+			name:   "member and master in the default namespace",
+			member: "eth0",
+			master: "br1",
+			calls:  []string{"PutInterfaceIntoMaster eth0-host br1-host"},
+		},
+		{
+			name:   "member and master in the same namespace",
+			member: "ms-eth0",
+			master: "ms-br1",
+			calls:  []string{"PutInterfaceIntoMaster eth0 br1"},
+		},
+		{
+			name:   "member and master in different namespaces",
+			member: "ms-eth0",
+			master: "br1",
+			err:    true,
+		},
+		{
+			name:   "unknown member",
+			member: "eth1",
+			master: "br1",
+			err:    true,
+		},
+		{
+			name:   "unknown master",
+			member: "eth0",
+			master: "br2",
+			err:    true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			ifHandler := newNetlinkMock()
+			descriptor := newTestInterfaceMasterDescriptor(ifHandler, &nsPluginMock{})
+			_, err := descriptor.Create(interfaces.InterfaceMasterKey(test.member, test.master), testBridge)
+			if test.err {
+				Expect(err).To(HaveOccurred())
+				Expect(ifHandler.calls).To(BeEmpty())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ifHandler.calls).To(Equal(test.calls))
+		})
+	}
+}
+
+func TestInterfaceMasterDelete(t *testing.T) {
+	RegisterTestingT(t)
+
+	ifHandler := newNetlinkMock()
+	nsPlugin := &nsPluginMock{}
+	descriptor := newTestInterfaceMasterDescriptor(ifHandler, nsPlugin)
+	key := interfaces.InterfaceMasterKey("ms-eth0", "ms-br1")
+	Expect(descriptor.Delete(key, testBridge, nil)).To(Succeed())
+	Expect(ifHandler.calls).To(Equal([]string{"RemoveInterfaceFromMaster eth0 br1"}))
+
+	// the member was released together with the removed namespace
+	ifHandler.calls = nil
+	nsPlugin.switchErr = &nsplugin.UnavailableMicroserviceErr{}
+	Expect(descriptor.Delete(key, testBridge, nil)).To(Succeed())
+	Expect(ifHandler.calls).To(BeEmpty())
+
+	nsPlugin.switchErr = errors.New("failed to switch namespace")
+	Expect(descriptor.Delete(key, testBridge, nil)).ToNot(Succeed())
+	Expect(ifHandler.calls).To(BeEmpty())
+}
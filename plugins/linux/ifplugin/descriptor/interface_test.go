@@ -0,0 +1,616 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	iflinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+const testAgentPrefix = "test-agent/"
+
+// netlinkMock records calls made by the descriptor and simulates links.
+// Methods not used by the tests are left to the (nil) embedded interface.
+type netlinkMock struct {
+	iflinuxcalls.NetlinkAPI
+
+	calls   []string
+	links   map[string]netlink.Link
+	details []*iflinuxcalls.InterfaceDetails
+}
+
+func newNetlinkMock() *netlinkMock {
+	return &netlinkMock{links: make(map[string]netlink.Link)}
+}
+
+func (h *netlinkMock) addLink(link netlink.Link) {
+	h.links[link.Attrs().Name] = link
+}
+
+func (h *netlinkMock) record(format string, args ...interface{}) {
+	h.calls = append(h.calls, fmt.Sprintf(format, args...))
+}
+
+func (h *netlinkMock) AddBridge(bridgeName string) error {
+	h.record("AddBridge %s", bridgeName)
+	h.addLink(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: bridgeName, Index: len(h.links) + 1}})
+	return nil
+}
+
+func (h *netlinkMock) SetBridgeOptions(bridgeName string, stp, vlanFiltering bool) error {
+	h.record("SetBridgeOptions %s stp=%t vlan_filtering=%t", bridgeName, stp, vlanFiltering)
+	return nil
+}
+
+func (h *netlinkMock) AddVLAN(vlanName, parentIfName string, vlanID uint32) error {
+	h.record("AddVLAN %s parent=%s id=%d", vlanName, parentIfName, vlanID)
+	h.addLink(&netlink.Vlan{LinkAttrs: netlink.LinkAttrs{Name: vlanName, Index: len(h.links) + 1}, VlanId: int(vlanID)})
+	return nil
+}
+
+func (h *netlinkMock) AddBond(bondName string, mode interfaces.BondLink_Mode, miimon uint32) error {
+	h.record("AddBond %s mode=%v miimon=%d", bondName, mode, miimon)
+	h.addLink(&netlink.Bond{LinkAttrs: netlink.LinkAttrs{Name: bondName, Index: len(h.links) + 1}})
+	return nil
+}
+
+func (h *netlinkMock) AddVxlan(vxlanName string, vxlan *interfaces.VxlanLink, underlayIfName string) error {
+	h.record("AddVxlan %s vni=%d underlay=%s", vxlanName, vxlan.GetVni(), underlayIfName)
+	h.addLink(&netlink.Vxlan{LinkAttrs: netlink.LinkAttrs{Name: vxlanName, Index: len(h.links) + 1}})
+	return nil
+}
+
+func (h *netlinkMock) AddGRE(greName string, gre *interfaces.GreLink, underlayIfName string) error {
+	h.record("AddGRE %s remote=%s underlay=%s", greName, gre.GetRemoteIp(), underlayIfName)
+	h.addLink(&netlink.GenericLink{LinkAttrs: netlink.LinkAttrs{Name: greName, Index: len(h.links) + 1},
+		LinkType: "gre"})
+	return nil
+}
+
+func (h *netlinkMock) AddWireguard(wgName string) error {
+	h.record("AddWireguard %s", wgName)
+	h.addLink(&netlink.GenericLink{LinkAttrs: netlink.LinkAttrs{Name: wgName, Index: len(h.links) + 1},
+		LinkType: "wireguard"})
+	return nil
+}
+
+func (h *netlinkMock) SetWireguardDevice(wgName string, wg *interfaces.WireguardLink) error {
+	h.record("SetWireguardDevice %s peers=%d", wgName, len(wg.GetPeers()))
+	return nil
+}
+
+func (h *netlinkMock) SetInterfaceAlias(ifName, alias string) error {
+	h.record("SetInterfaceAlias %s %s", ifName, alias)
+	return nil
+}
+
+func (h *netlinkMock) SetInterfaceUp(ifName string) error {
+	h.record("SetInterfaceUp %s", ifName)
+	return nil
+}
+
+func (h *netlinkMock) SetInterfaceMTU(ifName string, mtu int) error {
+	h.record("SetInterfaceMTU %s %d", ifName, mtu)
+	return nil
+}
+
+func (h *netlinkMock) SetInterfaceMac(ifName string, macAddress string) error {
+	h.record("SetInterfaceMac %s %s", ifName, macAddress)
+	return nil
+}
+
+func (h *netlinkMock) SetLinkNamespace(link netlink.Link, ns netns.NsHandle) error {
+	h.record("SetLinkNamespace %s", link.Attrs().Name)
+	return nil
+}
+
+func (h *netlinkMock) PutInterfaceIntoMaster(ifName, masterName string) error {
+	h.record("PutInterfaceIntoMaster %s %s", ifName, masterName)
+	return nil
+}
+
+func (h *netlinkMock) RemoveInterfaceFromMaster(ifName, masterName string) error {
+	h.record("RemoveInterfaceFromMaster %s %s", ifName, masterName)
+	return nil
+}
+
+func (h *netlinkMock) AddMacvlan(macvlanName, parentIfName string, mode interfaces.MacvlanLink_Mode) error {
+	h.record("AddMacvlan %s parent=%s mode=%v", macvlanName, parentIfName, mode)
+	h.addLink(&netlink.Macvlan{LinkAttrs: netlink.LinkAttrs{Name: macvlanName, Index: len(h.links) + 1}})
+	return nil
+}
+
+func (h *netlinkMock) AddIPVlan(ipvlanName, parentIfName string, mode interfaces.IpvlanLink_Mode) error {
+	h.record("AddIPVlan %s parent=%s mode=%v", ipvlanName, parentIfName, mode)
+	h.addLink(&netlink.IPVlan{LinkAttrs: netlink.LinkAttrs{Name: ipvlanName, Index: len(h.links) + 1}})
+	return nil
+}
+
+func (h *netlinkMock) InterfaceExists(ifName string) (bool, error) {
+	_, exists := h.links[ifName]
+	return exists, nil
+}
+
+func (h *netlinkMock) DeleteInterface(ifName string) error {
+	h.record("DeleteInterface %s", ifName)
+	delete(h.links, ifName)
+	return nil
+}
+
+func (h *netlinkMock) IsInterfaceUp(ifName string) (bool, error) {
+	return false, nil
+}
+
+func (h *netlinkMock) GetAddressList(ifName string) ([]netlink.Addr, error) {
+	return nil, nil
+}
+
+func (h *netlinkMock) GetLinkByName(ifName string) (netlink.Link, error) {
+	link, exists := h.links[ifName]
+	if !exists {
+		return nil, errors.Errorf("link %s not found", ifName)
+	}
+	return link, nil
+}
+
+func (h *netlinkMock) GetLinkByIndex(ifIdx int) (netlink.Link, error) {
+	for _, link := range h.links {
+		if link.Attrs().Index == ifIdx {
+			return link, nil
+		}
+	}
+	return nil, errors.Errorf("link with index %d not found", ifIdx)
+}
+
+func (h *netlinkMock) GetLinkList() (links []netlink.Link, err error) {
+	for _, link := range h.links {
+		links = append(links, link)
+	}
+	return links, nil
+}
+
+func (h *netlinkMock) DumpInterfacesFromNamespaces(nsList []*namespace.NetNamespace) ([]*iflinuxcalls.InterfaceDetails, error) {
+	return h.details, nil
+}
+
+// nsPluginMock pretends that namespace switching succeeds unless switchErr is set.
+type nsPluginMock struct {
+	switchErr error
+}
+
+func (p *nsPluginMock) SwitchToNamespace(ctx nslinuxcalls.NamespaceMgmtCtx, ns *namespace.NetNamespace) (revert func(), err error) {
+	if p.switchErr != nil {
+		return nil, p.switchErr
+	}
+	return func() {}, nil
+}
+
+func (p *nsPluginMock) GetNamespaceHandle(ctx nslinuxcalls.NamespaceMgmtCtx, ns *namespace.NetNamespace) (handle netns.NsHandle, err error) {
+	return netns.None(), nil
+}
+
+// serviceLabelMock returns a fixed agent prefix.
+type serviceLabelMock struct{}
+
+func (l *serviceLabelMock) GetAgentLabel() string {
+	return "test-agent"
+}
+
+func (l *serviceLabelMock) GetAgentPrefix() string {
+	return testAgentPrefix
+}
+
+func newTestInterfaceDescriptor(ifHandler *netlinkMock) *InterfaceDescriptor {
+	ifIndex := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndex.Put("eth0", &ifaceidx.LinuxIfMetadata{HostIfName: "eth0", LinuxIfIndex: 1})
+	ifIndex.Put("ms-eth0", &ifaceidx.LinuxIfMetadata{HostIfName: "eth0", LinuxIfIndex: 1,
+		Namespace: &namespace.NetNamespace{Type: namespace.NetNamespace_MICROSERVICE, Reference: "ms1"}})
+	return &InterfaceDescriptor{
+		log:          logrus.NewLogger("test"),
+		serviceLabel: &serviceLabelMock{},
+		ifHandler:    ifHandler,
+		nsPlugin:     &nsPluginMock{},
+		addrAlloc:    netalloc_mock.NewMockNetAlloc(),
+		intfIndex:    ifIndex,
+	}
+}
+
+func TestValidateMasterAndVlanInterfaces(t *testing.T) {
+	tests := []struct {
+		name   string
+		iface  *interfaces.Interface
+		err    error
+		fields []string
+	}{
+		{
+			name: "valid bridge",
+			iface: &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE,
+				Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"eth0", "eth1"}}}},
+		},
+		{
+			name: "bridge with empty port",
+			iface: &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE,
+				Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"eth0", ""}}}},
+			err:    ErrInvalidMasterMember,
+			fields: []string{"ports[1]"},
+		},
+		{
+			name: "bridge enslaved to itself",
+			iface: &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE,
+				Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"br1"}}}},
+			err:    ErrInvalidMasterMember,
+			fields: []string{"ports[0]"},
+		},
+		{
+			name: "valid bond",
+			iface: &interfaces.Interface{Name: "bond1", Type: interfaces.Interface_BOND,
+				Link: &interfaces.Interface_Bond{Bond: &interfaces.BondLink{Mode: interfaces.BondLink_LACP,
+					Members: []string{"eth0", "eth1"}}}},
+		},
+		{
+			name: "bond enslaved to itself",
+			iface: &interfaces.Interface{Name: "bond1", Type: interfaces.Interface_BOND,
+				Link: &interfaces.Interface_Bond{Bond: &interfaces.BondLink{Members: []string{"eth0", "bond1"}}}},
+			err:    ErrInvalidMasterMember,
+			fields: []string{"members[1]"},
+		},
+		{
+			name: "bond link with bridge type",
+			iface: &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE,
+				Link: &interfaces.Interface_Bond{Bond: &interfaces.BondLink{}}},
+			err:    ErrInterfaceReferenceMismatch,
+			fields: []string{"link"},
+		},
+		{
+			name: "valid VLAN",
+			iface: &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN,
+				Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "eth0", VlanId: 10}}},
+		},
+		{
+			name:   "VLAN without parent",
+			iface:  &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN},
+			err:    ErrVLANWithoutParent,
+			fields: []string{"parent_interface"},
+		},
+		{
+			name: "VLAN without ID",
+			iface: &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN,
+				Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "eth0"}}},
+			err:    ErrVLANWithInvalidID,
+			fields: []string{"vlan_id"},
+		},
+		{
+			name: "reserved VLAN ID",
+			iface: &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN,
+				Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "eth0", VlanId: 4095}}},
+			err:    ErrVLANWithInvalidID,
+			fields: []string{"vlan_id"},
+		},
+		{
+			name: "VLAN link with dummy type",
+			iface: &interfaces.Interface{Name: "dummy1", Type: interfaces.Interface_DUMMY,
+				Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "eth0", VlanId: 10}}},
+			err:    ErrInterfaceReferenceMismatch,
+			fields: []string{"link"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			err := descriptor.Validate(interfaces.InterfaceKey(test.iface.Name), test.iface)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(Equal(kvs.NewInvalidValueError(test.err, test.fields...)))
+		})
+	}
+}
+
+func TestCreateMasterAndVlanInterfaces(t *testing.T) {
+	tests := []struct {
+		name  string
+		iface *interfaces.Interface
+		calls []string
+	}{
+		{
+			name: "bridge with default options",
+			iface: &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE, HostIfName: "br-host",
+				Enabled: true, Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"eth0"}}}},
+			calls: []string{
+				"AddBridge br-host",
+				"SetInterfaceAlias br-host test-agent/br1",
+				"SetInterfaceUp br-host",
+			},
+		},
+		{
+			name: "bridge with STP and VLAN filtering",
+			iface: &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE, Mtu: 9000,
+				Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{StpEnabled: true, VlanFiltering: true}}},
+			calls: []string{
+				"AddBridge br1",
+				"SetBridgeOptions br1 stp=true vlan_filtering=true",
+				"SetInterfaceAlias br1 test-agent/br1",
+				"SetInterfaceMTU br1 9000",
+			},
+		},
+		{
+			name: "VLAN in the namespace of the parent",
+			iface: &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN, Enabled: true,
+				PhysAddress: "aa:bb:cc:dd:ee:ff",
+				Link:        &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "eth0", VlanId: 10}}},
+			calls: []string{
+				"AddVLAN vlan10 parent=eth0 id=10",
+				"SetInterfaceAlias vlan10 test-agent/vlan10/eth0",
+				"SetInterfaceUp vlan10",
+				"SetInterfaceMac vlan10 aa:bb:cc:dd:ee:ff",
+			},
+		},
+		{
+			name: "VLAN moved out of the namespace of the parent",
+			iface: &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN,
+				Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "ms-eth0", VlanId: 10}}},
+			calls: []string{
+				"AddVLAN vlan10 parent=eth0 id=10",
+				"SetLinkNamespace vlan10",
+				"SetInterfaceMTU vlan10 0",
+				"SetInterfaceAlias vlan10 test-agent/vlan10/ms-eth0",
+			},
+		},
+		{
+			name: "bond",
+			iface: &interfaces.Interface{Name: "bond1", Type: interfaces.Interface_BOND, Enabled: true,
+				Link: &interfaces.Interface_Bond{Bond: &interfaces.BondLink{Mode: interfaces.BondLink_ACTIVE_BACKUP,
+					Miimon: 100, Members: []string{"eth0"}}}},
+			calls: []string{
+				"AddBond bond1 mode=ACTIVE_BACKUP miimon=100",
+				"SetInterfaceAlias bond1 test-agent/bond1",
+				"SetInterfaceUp bond1",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			ifHandler := newNetlinkMock()
+			descriptor := newTestInterfaceDescriptor(ifHandler)
+			metadata, err := descriptor.Create(interfaces.InterfaceKey(test.iface.Name), test.iface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ifHandler.calls).To(Equal(test.calls))
+
+			hostName := getHostIfName(test.iface)
+			Expect(metadata.HostIfName).To(Equal(hostName))
+			Expect(metadata.LinuxIfIndex).To(Equal(ifHandler.links[hostName].Attrs().Index))
+		})
+	}
+}
+
+func TestCreateVlanWithUnknownParent(t *testing.T) {
+	RegisterTestingT(t)
+
+	ifHandler := newNetlinkMock()
+	descriptor := newTestInterfaceDescriptor(ifHandler)
+	vlan := &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN,
+		Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "eth1", VlanId: 10}}}
+	_, err := descriptor.Create(interfaces.InterfaceKey(vlan.Name), vlan)
+	Expect(err).To(HaveOccurred())
+	Expect(ifHandler.calls).To(BeEmpty())
+}
+
+func TestUpdateBridgeOptions(t *testing.T) {
+	RegisterTestingT(t)
+
+	ifHandler := newNetlinkMock()
+	descriptor := newTestInterfaceDescriptor(ifHandler)
+	oldBridge := &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE,
+		Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"eth0"}}}}
+	metadata := &ifaceidx.LinuxIfMetadata{HostIfName: "br1", LinuxIfIndex: 1}
+
+	// ports are handled by derived values, not by the bridge itself
+	newBridge := &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE,
+		Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"eth0", "eth1"}}}}
+	Expect(descriptor.UpdateWithRecreate(interfaces.InterfaceKey("br1"), oldBridge, newBridge, metadata)).To(BeFalse())
+	_, err := descriptor.Update(interfaces.InterfaceKey("br1"), oldBridge, newBridge, metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ifHandler.calls).To(BeEmpty())
+
+	newBridge = &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE,
+		Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"eth0"}, VlanFiltering: true}}}
+	Expect(descriptor.UpdateWithRecreate(interfaces.InterfaceKey("br1"), oldBridge, newBridge, metadata)).To(BeFalse())
+	_, err = descriptor.Update(interfaces.InterfaceKey("br1"), oldBridge, newBridge, metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ifHandler.calls).To(Equal([]string{"SetBridgeOptions br1 stp=false vlan_filtering=true"}))
+}
+
+func TestUpdateWithRecreateVlanAndBond(t *testing.T) {
+	vlan := func(parent string, id uint32) *interfaces.Interface {
+		return &interfaces.Interface{Name: "vlan1", Type: interfaces.Interface_VLAN,
+			Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: parent, VlanId: id}}}
+	}
+	bond := func(mode interfaces.BondLink_Mode, miimon uint32, members ...string) *interfaces.Interface {
+		return &interfaces.Interface{Name: "bond1", Type: interfaces.Interface_BOND,
+			Link: &interfaces.Interface_Bond{Bond: &interfaces.BondLink{Mode: mode, Miimon: miimon, Members: members}}}
+	}
+	tests := []struct {
+		name     string
+		oldIface *interfaces.Interface
+		newIface *interfaces.Interface
+		recreate bool
+	}{
+		{
+			name:     "same VLAN",
+			oldIface: vlan("eth0", 10),
+			newIface: vlan("eth0", 10),
+		},
+		{
+			name:     "VLAN ID changed",
+			oldIface: vlan("eth0", 10),
+			newIface: vlan("eth0", 20),
+			recreate: true,
+		},
+		{
+			name:     "VLAN parent changed",
+			oldIface: vlan("eth0", 10),
+			newIface: vlan("eth1", 10),
+			recreate: true,
+		},
+		{
+			name:     "bond members changed",
+			oldIface: bond(interfaces.BondLink_LACP, 100, "eth0"),
+			newIface: bond(interfaces.BondLink_LACP, 100, "eth0", "eth1"),
+		},
+		{
+			name:     "bond mode changed",
+			oldIface: bond(interfaces.BondLink_LACP, 100),
+			newIface: bond(interfaces.BondLink_ACTIVE_BACKUP, 100),
+			recreate: true,
+		},
+		{
+			name:     "bond MII monitoring changed",
+			oldIface: bond(interfaces.BondLink_LACP, 100),
+			newIface: bond(interfaces.BondLink_LACP, 0),
+			recreate: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			recreate := descriptor.UpdateWithRecreate(interfaces.InterfaceKey(test.oldIface.Name),
+				test.oldIface, test.newIface, &ifaceidx.LinuxIfMetadata{})
+			Expect(recreate).To(Equal(test.recreate))
+		})
+	}
+}
+
+func TestMasterAndVlanDependencies(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+	vlan := &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN,
+		Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "eth0", VlanId: 10}}}
+	Expect(descriptor.Dependencies(interfaces.InterfaceKey(vlan.Name), vlan)).To(Equal([]kvs.Dependency{
+		{Label: parentInterfaceDep, Key: interfaces.InterfaceKey("eth0")},
+	}))
+
+	// bridge and bond do not depend on their ports/members, which are enslaved
+	// via derived values instead
+	bridge := &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE,
+		Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"eth0"}}}}
+	Expect(descriptor.Dependencies(interfaces.InterfaceKey(bridge.Name), bridge)).To(BeEmpty())
+}
+
+func TestMasterDerivedValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		iface   *interfaces.Interface
+		members []string
+	}{
+		{
+			name: "bridge ports",
+			iface: &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE, HostIfName: "br-host",
+				Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{Ports: []string{"eth0", "eth1"}}}},
+			members: []string{"eth0", "eth1"},
+		},
+		{
+			name: "bond members",
+			iface: &interfaces.Interface{Name: "bond1", Type: interfaces.Interface_BOND,
+				Link: &interfaces.Interface_Bond{Bond: &interfaces.BondLink{Members: []string{"eth0"}}}},
+			members: []string{"eth0"},
+		},
+		{
+			name: "VLAN is not a master",
+			iface: &interfaces.Interface{Name: "vlan10", Type: interfaces.Interface_VLAN,
+				Link: &interfaces.Interface_Vlan{Vlan: &interfaces.VlanLink{ParentInterface: "eth0", VlanId: 10}}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			var members []string
+			for _, kv := range descriptor.DerivedValues(interfaces.InterfaceKey(test.iface.Name), test.iface) {
+				member, master, _, isMasterKey := interfaces.ParseInterfaceMasterKey(kv.Key)
+				if !isMasterKey {
+					continue
+				}
+				Expect(master).To(Equal(test.iface.Name))
+				Expect(kv.Value).To(Equal(&interfaces.Interface{
+					Name: test.iface.Name,
+					Type: test.iface.Type,
+				}))
+				members = append(members, member)
+			}
+			Expect(members).To(Equal(test.members))
+		})
+	}
+}
+
+func TestRetrieveExistingMembers(t *testing.T) {
+	RegisterTestingT(t)
+
+	ifHandler := newNetlinkMock()
+	ifHandler.addLink(&netlink.Device{LinkAttrs: netlink.LinkAttrs{Name: "eth0", Index: 1, MasterIndex: 3}})
+	ifHandler.addLink(&netlink.Device{LinkAttrs: netlink.LinkAttrs{Name: "eth1", Index: 2, MasterIndex: 4}})
+	ifHandler.addLink(&netlink.Bridge{LinkAttrs: netlink.LinkAttrs{Name: "br1", Index: 3}})
+	ifHandler.addLink(&netlink.Bond{LinkAttrs: netlink.LinkAttrs{Name: "bond1", Index: 4}})
+	ifHandler.details = []*iflinuxcalls.InterfaceDetails{
+		{
+			Interface: &interfaces.Interface{Name: "br1", Type: interfaces.Interface_BRIDGE, HostIfName: "br1",
+				Link: &interfaces.Interface_Bridge{Bridge: &interfaces.BridgeLink{}}},
+			Meta: &iflinuxcalls.InterfaceMeta{LinuxIfIndex: 3},
+		},
+		{
+			Interface: &interfaces.Interface{Name: "bond1", Type: interfaces.Interface_BOND, HostIfName: "bond1",
+				Link: &interfaces.Interface_Bond{Bond: &interfaces.BondLink{}}},
+			Meta: &iflinuxcalls.InterfaceMeta{LinuxIfIndex: 4},
+		},
+	}
+
+	descriptor := newTestInterfaceDescriptor(ifHandler)
+	retrieved, err := descriptor.Retrieve([]adapter.InterfaceKVWithMetadata{
+		{Value: &interfaces.Interface{Name: "existing0", Type: interfaces.Interface_EXISTING, HostIfName: "eth0"}},
+		{Value: &interfaces.Interface{Name: "existing1", Type: interfaces.Interface_EXISTING, HostIfName: "eth1"}},
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	retrievedIfaces := make(map[string]*interfaces.Interface)
+	for _, kv := range retrieved {
+		retrievedIfaces[kv.Value.Name] = kv.Value
+	}
+	Expect(retrievedIfaces).To(HaveLen(4))
+	Expect(retrievedIfaces["br1"].GetBridge().GetPorts()).To(Equal([]string{"existing0"}))
+	Expect(retrievedIfaces["bond1"].GetBond().GetMembers()).To(Equal([]string{"existing1"}))
+}
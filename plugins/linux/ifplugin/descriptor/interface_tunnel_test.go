@@ -0,0 +1,233 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+func vxlanInterface(vxlan *interfaces.VxlanLink) *interfaces.Interface {
+	return &interfaces.Interface{Name: "vxlan1", Type: interfaces.Interface_VXLAN,
+		Link: &interfaces.Interface_Vxlan{Vxlan: vxlan}}
+}
+
+func greInterface(gre *interfaces.GreLink) *interfaces.Interface {
+	return &interfaces.Interface{Name: "gre1", Type: interfaces.Interface_GRE,
+		Link: &interfaces.Interface_Gre{Gre: gre}}
+}
+
+func TestValidateTunnels(t *testing.T) {
+	tests := []struct {
+		name   string
+		iface  *interfaces.Interface
+		err    error
+		fields []string
+	}{
+		{
+			name:  "VXLAN without endpoints",
+			iface: vxlanInterface(&interfaces.VxlanLink{Vni: 10}),
+		},
+		{
+			name:  "VXLAN with IPv6 endpoints",
+			iface: vxlanInterface(&interfaces.VxlanLink{Vni: 10, LocalIp: "2001:db8::1", RemoteIp: "2001:db8::2"}),
+		},
+		{
+			name:   "VXLAN with VNI over 24 bits",
+			iface:  vxlanInterface(&interfaces.VxlanLink{Vni: 1 << 24}),
+			err:    ErrVxlanWithInvalidVNI,
+			fields: []string{"vni"},
+		},
+		{
+			name:   "VXLAN with invalid local IP",
+			iface:  vxlanInterface(&interfaces.VxlanLink{Vni: 10, LocalIp: "10.0.0"}),
+			err:    ErrTunnelWithInvalidIP,
+			fields: []string{"local_ip"},
+		},
+		{
+			name:   "VXLAN with mixed IP families",
+			iface:  vxlanInterface(&interfaces.VxlanLink{Vni: 10, LocalIp: "10.0.0.1", RemoteIp: "2001:db8::2"}),
+			err:    ErrTunnelWithInvalidIP,
+			fields: []string{"local_ip", "remote_ip"},
+		},
+		{
+			name:  "GRE with IPv4 endpoints",
+			iface: greInterface(&interfaces.GreLink{LocalIp: "10.0.0.1", RemoteIp: "10.0.0.2", InputKey: 1}),
+		},
+		{
+			name:   "GRE without remote IP",
+			iface:  greInterface(&interfaces.GreLink{LocalIp: "10.0.0.1"}),
+			err:    ErrTunnelWithoutRemoteIP,
+			fields: []string{"remote_ip"},
+		},
+		{
+			name:   "GRE with invalid remote IP",
+			iface:  greInterface(&interfaces.GreLink{RemoteIp: "remote"}),
+			err:    ErrTunnelWithInvalidIP,
+			fields: []string{"remote_ip"},
+		},
+		{
+			name: "GRE link with VXLAN type",
+			iface: &interfaces.Interface{Name: "vxlan1", Type: interfaces.Interface_VXLAN,
+				Link: &interfaces.Interface_Gre{Gre: &interfaces.GreLink{RemoteIp: "10.0.0.2"}}},
+			err:    ErrInterfaceReferenceMismatch,
+			fields: []string{"link"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			err := descriptor.Validate(interfaces.InterfaceKey(test.iface.Name), test.iface)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(Equal(kvs.NewInvalidValueError(test.err, test.fields...)))
+		})
+	}
+}
+
+func TestCreateTunnels(t *testing.T) {
+	tests := []struct {
+		name  string
+		iface *interfaces.Interface
+		calls []string
+	}{
+		{
+			name:  "VXLAN without underlay",
+			iface: vxlanInterface(&interfaces.VxlanLink{Vni: 10, RemoteIp: "10.0.0.2"}),
+			calls: []string{
+				"AddVxlan vxlan1 vni=10 underlay=",
+				"SetInterfaceAlias vxlan1 test-agent/vxlan1/",
+			},
+		},
+		{
+			name:  "VXLAN over underlay",
+			iface: vxlanInterface(&interfaces.VxlanLink{Vni: 10, RemoteIp: "10.0.0.2", UnderlayInterface: "eth0"}),
+			calls: []string{
+				"AddVxlan vxlan1 vni=10 underlay=eth0",
+				"SetInterfaceAlias vxlan1 test-agent/vxlan1/eth0",
+			},
+		},
+		{
+			name:  "GRE over underlay",
+			iface: greInterface(&interfaces.GreLink{RemoteIp: "10.0.0.2", UnderlayInterface: "eth0"}),
+			calls: []string{
+				"AddGRE gre1 remote=10.0.0.2 underlay=eth0",
+				"SetInterfaceAlias gre1 test-agent/gre1/eth0",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			ifHandler := newNetlinkMock()
+			descriptor := newTestInterfaceDescriptor(ifHandler)
+			metadata, err := descriptor.Create(interfaces.InterfaceKey(test.iface.Name), test.iface)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metadata).To(Equal(&ifaceidx.LinuxIfMetadata{LinuxIfIndex: 1, HostIfName: test.iface.Name}))
+			Expect(ifHandler.calls).To(Equal(test.calls))
+		})
+	}
+}
+
+func TestCreateTunnelWithUnderlayInOtherNamespace(t *testing.T) {
+	RegisterTestingT(t)
+
+	ifHandler := newNetlinkMock()
+	descriptor := newTestInterfaceDescriptor(ifHandler)
+	gre := greInterface(&interfaces.GreLink{RemoteIp: "10.0.0.2", UnderlayInterface: "ms-eth0"})
+	_, err := descriptor.Create(interfaces.InterfaceKey(gre.Name), gre)
+	Expect(err).To(HaveOccurred())
+
+	gre.Namespace = &namespace.NetNamespace{Type: namespace.NetNamespace_MICROSERVICE, Reference: "ms1"}
+	_, err = descriptor.Create(interfaces.InterfaceKey(gre.Name), gre)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ifHandler.calls[0]).To(Equal("AddGRE gre1 remote=10.0.0.2 underlay=eth0"))
+}
+
+func TestTunnelDependencies(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+	vxlan := vxlanInterface(&interfaces.VxlanLink{Vni: 10, UnderlayInterface: "eth0"})
+	Expect(descriptor.Dependencies(interfaces.InterfaceKey(vxlan.Name), vxlan)).To(Equal([]kvs.Dependency{
+		{Label: tunnelUnderlayDep, Key: interfaces.InterfaceKey("eth0")},
+	}))
+	gre := greInterface(&interfaces.GreLink{RemoteIp: "10.0.0.2"})
+	Expect(descriptor.Dependencies(interfaces.InterfaceKey(gre.Name), gre)).To(BeEmpty())
+}
+
+func TestUpdateWithRecreateTunnels(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldIface *interfaces.Interface
+		newIface *interfaces.Interface
+		recreate bool
+	}{
+		{
+			name:     "VXLAN with default port given explicitly",
+			oldIface: vxlanInterface(&interfaces.VxlanLink{Vni: 10, RemoteIp: "10.0.0.2"}),
+			newIface: vxlanInterface(&interfaces.VxlanLink{Vni: 10, RemoteIp: "10.0.0.2", DstPort: 4789}),
+		},
+		{
+			name:     "VXLAN remote IP in different notation",
+			oldIface: vxlanInterface(&interfaces.VxlanLink{Vni: 10, RemoteIp: "2001:db8::2"}),
+			newIface: vxlanInterface(&interfaces.VxlanLink{Vni: 10, RemoteIp: "2001:db8:0::2"}),
+		},
+		{
+			name:     "VXLAN VNI changed",
+			oldIface: vxlanInterface(&interfaces.VxlanLink{Vni: 10}),
+			newIface: vxlanInterface(&interfaces.VxlanLink{Vni: 20}),
+			recreate: true,
+		},
+		{
+			name:     "VXLAN underlay changed",
+			oldIface: vxlanInterface(&interfaces.VxlanLink{Vni: 10}),
+			newIface: vxlanInterface(&interfaces.VxlanLink{Vni: 10, UnderlayInterface: "eth0"}),
+			recreate: true,
+		},
+		{
+			name:     "GRE unchanged",
+			oldIface: greInterface(&interfaces.GreLink{RemoteIp: "10.0.0.2", Ttl: 64}),
+			newIface: greInterface(&interfaces.GreLink{RemoteIp: "10.0.0.2", Ttl: 64}),
+		},
+		{
+			name:     "GRE key changed",
+			oldIface: greInterface(&interfaces.GreLink{RemoteIp: "10.0.0.2", OutputKey: 1}),
+			newIface: greInterface(&interfaces.GreLink{RemoteIp: "10.0.0.2", OutputKey: 2}),
+			recreate: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			recreate := descriptor.UpdateWithRecreate(interfaces.InterfaceKey(test.oldIface.Name),
+				test.oldIface, test.newIface, &ifaceidx.LinuxIfMetadata{})
+			Expect(recreate).To(Equal(test.recreate))
+		})
+	}
+}
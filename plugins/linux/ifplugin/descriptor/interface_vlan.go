@@ -0,0 +1,146 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// createVLAN creates a new VLAN sub-interface in the namespace of the parent
+// interface and moves it into the requested namespace if it differs.
+func (d *InterfaceDescriptor) createVLAN(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	vlanID := linuxIf.GetVlan().GetVlanId()
+	return d.createLinkOverParent(nsCtx, linuxIf, linuxIf.GetVlan().GetParentInterface(),
+		linuxcalls.GetVlanAlias(linuxIf),
+		func(hostName, parentHostName string) error {
+			err := d.ifHandler.AddVLAN(hostName, parentHostName, vlanID)
+			if err != nil {
+				return errors.WithMessagef(err, "failed to add VLAN %s (parent: %s, vlan-id: %d)",
+					hostName, parentHostName, vlanID)
+			}
+			return nil
+		})
+}
+
+// createLinkOverParent creates a new link stacked over the parent interface
+// (VLAN, MACVLAN, IPVLAN). The link is created by <addLink> inside the namespace
+// of the parent and then moved into the namespace of the interface if it differs.
+func (d *InterfaceDescriptor) createLinkOverParent(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface, parentName, alias string,
+	addLink func(hostName, parentHostName string) error,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	hostName := getHostIfName(linuxIf)
+	agentPrefix := d.serviceLabel.GetAgentPrefix()
+
+	parentMeta, found := d.intfIndex.LookupByName(parentName)
+	if !found {
+		return nil, errors.Errorf("failed to find %v parent interface %s", linuxIf.GetType(), parentName)
+	}
+
+	if err = d.addLinkOverParent(nsCtx, linuxIf, parentMeta, addLink); err != nil {
+		return nil, err
+	}
+
+	// move to the namespace with the interface
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, linuxIf.Namespace)
+	if err != nil {
+		d.log.Error("switch to namespace failed:", err)
+		return nil, err
+	}
+	defer revert()
+
+	// add alias
+	err = d.ifHandler.SetInterfaceAlias(hostName, agentPrefix+alias)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error setting %v %s alias", linuxIf.GetType(), hostName)
+	}
+
+	// build metadata
+	link, err := d.ifHandler.GetLinkByName(hostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error getting link %s (%v)", hostName, linuxIf.GetType())
+	}
+
+	return &ifaceidx.LinuxIfMetadata{
+		Namespace:    linuxIf.Namespace,
+		LinuxIfIndex: link.Attrs().Index,
+		HostIfName:   hostName,
+	}, nil
+}
+
+// addLinkOverParent adds link over the parent interface (inside the parent's namespace)
+// and moves it into the namespace of the interface.
+func (d *InterfaceDescriptor) addLinkOverParent(nsCtx nslinuxcalls.NamespaceMgmtCtx,
+	linuxIf *interfaces.Interface, parentMeta *ifaceidx.LinuxIfMetadata,
+	addLink func(hostName, parentHostName string) error) error {
+	hostName := getHostIfName(linuxIf)
+
+	// move to the namespace with the parent interface
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, parentMeta.Namespace)
+	if err != nil {
+		d.log.Error("switch to namespace failed:", err)
+		return err
+	}
+	defer revert()
+
+	if err = addLink(hostName, parentMeta.HostIfName); err != nil {
+		return err
+	}
+
+	if !proto.Equal(parentMeta.Namespace, linuxIf.Namespace) {
+		err = d.setInterfaceNamespace(nsCtx, hostName, linuxIf.Namespace)
+		if err != nil {
+			return errors.WithMessagef(err, "error setting interface %s to namespace %v",
+				hostName, linuxIf.Namespace)
+		}
+	}
+	return nil
+}
+
+// deleteVLAN removes VLAN sub-interface.
+func (d *InterfaceDescriptor) deleteVLAN(linuxIf *interfaces.Interface) error {
+	return d.deleteLinkOverParent(linuxIf)
+}
+
+// deleteLinkOverParent removes link stacked over the parent interface.
+// The link may have been already removed by the kernel together with the parent
+// (e.g. EXISTING parent removed externally), which is not treated as an error.
+func (d *InterfaceDescriptor) deleteLinkOverParent(linuxIf *interfaces.Interface) error {
+	hostName := getHostIfName(linuxIf)
+	exists, err := d.ifHandler.InterfaceExists(hostName)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	if !exists {
+		d.log.Debugf("%v interface %s was already removed together with the parent",
+			linuxIf.GetType(), hostName)
+		return nil
+	}
+	err = d.ifHandler.DeleteInterface(hostName)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
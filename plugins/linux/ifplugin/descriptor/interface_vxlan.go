@@ -0,0 +1,100 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// createVxlan creates a new VXLAN tunnel interface.
+func (d *InterfaceDescriptor) createVxlan(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	hostName := getHostIfName(linuxIf)
+	vxlan := linuxIf.GetVxlan()
+	agentPrefix := d.serviceLabel.GetAgentPrefix()
+
+	underlayHostName, err := d.getUnderlayHostIfName(linuxIf, vxlan.GetUnderlayInterface())
+	if err != nil {
+		return nil, err
+	}
+
+	// move to the namespace with the interface
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, linuxIf.Namespace)
+	if err != nil {
+		d.log.Error("switch to namespace failed:", err)
+		return nil, err
+	}
+	defer revert()
+
+	// create a new VXLAN
+	err = d.ifHandler.AddVxlan(hostName, vxlan, underlayHostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to add VXLAN %s (vni: %d)", hostName, vxlan.GetVni())
+	}
+
+	// add alias
+	err = d.ifHandler.SetInterfaceAlias(hostName, agentPrefix+linuxcalls.GetVxlanAlias(linuxIf))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error setting VXLAN %s alias", hostName)
+	}
+
+	// build metadata
+	link, err := d.ifHandler.GetLinkByName(hostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error getting link %s", hostName)
+	}
+
+	return &ifaceidx.LinuxIfMetadata{
+		Namespace:    linuxIf.Namespace,
+		LinuxIfIndex: link.Attrs().Index,
+		HostIfName:   hostName,
+	}, nil
+}
+
+// deleteVxlan removes VXLAN tunnel interface.
+func (d *InterfaceDescriptor) deleteVxlan(linuxIf *interfaces.Interface) error {
+	hostName := getHostIfName(linuxIf)
+	err := d.ifHandler.DeleteInterface(hostName)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// getVxlanDstPort returns VXLAN destination port (handles the default).
+func getVxlanDstPort(vxlan *interfaces.VxlanLink) uint32 {
+	if vxlan.GetDstPort() == 0 {
+		return linuxcalls.DefaultVxlanPort
+	}
+	return vxlan.GetDstPort()
+}
+
+// equivalentVxlan compares two VXLAN configurations.
+func equivalentVxlan(vxlan1, vxlan2 *interfaces.VxlanLink) bool {
+	return vxlan1.GetVni() == vxlan2.GetVni() &&
+		equalIPs(vxlan1.GetLocalIp(), vxlan2.GetLocalIp()) &&
+		equalIPs(vxlan1.GetRemoteIp(), vxlan2.GetRemoteIp()) &&
+		getVxlanDstPort(vxlan1) == getVxlanDstPort(vxlan2) &&
+		vxlan1.GetUnderlayInterface() == vxlan2.GetUnderlayInterface() &&
+		vxlan1.GetLearning() == vxlan2.GetLearning() &&
+		vxlan1.GetTtl() == vxlan2.GetTtl()
+}
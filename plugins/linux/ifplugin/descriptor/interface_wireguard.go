@@ -0,0 +1,190 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// createWireguard creates a new WireGuard interface and configures its keys and peers.
+func (d *InterfaceDescriptor) createWireguard(
+	nsCtx nslinuxcalls.NamespaceMgmtCtx, linuxIf *interfaces.Interface,
+) (md *ifaceidx.LinuxIfMetadata, err error) {
+	hostName := getHostIfName(linuxIf)
+	agentPrefix := d.serviceLabel.GetAgentPrefix()
+
+	// move to the namespace with the interface
+	revert, err := d.nsPlugin.SwitchToNamespace(nsCtx, linuxIf.Namespace)
+	if err != nil {
+		d.log.Error("switch to namespace failed:", err)
+		return nil, err
+	}
+	defer revert()
+
+	// create a new WireGuard interface
+	err = d.ifHandler.AddWireguard(hostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to add WireGuard interface %s", hostName)
+	}
+
+	// configure keys and peers
+	err = d.ifHandler.SetWireguardDevice(hostName, linuxIf.GetWireguard())
+	if err != nil {
+		return nil, errors.WithMessagef(err, "failed to configure WireGuard interface %s", hostName)
+	}
+
+	// add alias
+	err = d.ifHandler.SetInterfaceAlias(hostName, agentPrefix+linuxcalls.GetWireguardAlias(linuxIf))
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error setting WireGuard %s alias", hostName)
+	}
+
+	// build metadata
+	link, err := d.ifHandler.GetLinkByName(hostName)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error getting link %s", hostName)
+	}
+
+	return &ifaceidx.LinuxIfMetadata{
+		Namespace:    linuxIf.Namespace,
+		LinuxIfIndex: link.Attrs().Index,
+		HostIfName:   hostName,
+	}, nil
+}
+
+// deleteWireguard removes WireGuard interface.
+func (d *InterfaceDescriptor) deleteWireguard(linuxIf *interfaces.Interface) error {
+	hostName := getHostIfName(linuxIf)
+	err := d.ifHandler.DeleteInterface(hostName)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	return nil
+}
+
+// validateWireguard validates keys, endpoints and allowed IPs of WireGuard configuration.
+func validateWireguard(wg *interfaces.WireguardLink) error {
+	if !isValidWireguardKey(wg.GetPrivateKey()) {
+		return kvs.NewInvalidValueError(ErrWireguardWithInvalidKey, "private_key")
+	}
+	for i, peer := range wg.GetPeers() {
+		if peer.GetPublicKey() == "" || !isValidWireguardKey(peer.GetPublicKey()) {
+			return kvs.NewInvalidValueError(ErrWireguardWithInvalidKey,
+				fmt.Sprintf("peers[%d].public_key", i))
+		}
+		if !isValidWireguardKey(peer.GetPresharedKey()) {
+			return kvs.NewInvalidValueError(ErrWireguardWithInvalidKey,
+				fmt.Sprintf("peers[%d].preshared_key", i))
+		}
+		if endpoint := peer.GetEndpoint(); endpoint != "" {
+			host, _, err := net.SplitHostPort(endpoint)
+			if err != nil || net.ParseIP(host) == nil {
+				return kvs.NewInvalidValueError(ErrWireguardWithInvalidEndpoint,
+					fmt.Sprintf("peers[%d].endpoint", i))
+			}
+		}
+		for j, allowedIP := range peer.GetAllowedIps() {
+			if _, _, err := net.ParseCIDR(allowedIP); err != nil {
+				return kvs.NewInvalidValueError(ErrInvalidIPWithMask,
+					fmt.Sprintf("peers[%d].allowed_ips[%d]", i, j))
+			}
+		}
+	}
+	return nil
+}
+
+// isValidWireguardKey returns true if the key is empty or a base64-encoded 32-byte key.
+func isValidWireguardKey(key string) bool {
+	if key == "" {
+		return true
+	}
+	bytes, err := base64.StdEncoding.DecodeString(key)
+	return err == nil && len(bytes) == linuxcalls.WireguardKeyLen
+}
+
+// equivalentWireguard compares two WireGuard configurations, ignoring the order
+// of peers and allowed IPs and the notation of allowed IP networks.
+// Undefined listen port of wg2 (chosen randomly by the kernel) matches any port.
+func equivalentWireguard(wg1, wg2 *interfaces.WireguardLink) bool {
+	if wg1.GetPrivateKey() != wg2.GetPrivateKey() ||
+		(wg2.GetListenPort() != 0 && wg1.GetListenPort() != wg2.GetListenPort()) ||
+		wg1.GetFwmark() != wg2.GetFwmark() ||
+		len(wg1.GetPeers()) != len(wg2.GetPeers()) {
+		return false
+	}
+	peers1 := make(map[string]*interfaces.WireguardLink_Peer)
+	for _, peer := range wg1.GetPeers() {
+		peers1[peer.GetPublicKey()] = peer
+	}
+	for _, peer2 := range wg2.GetPeers() {
+		peer1, found := peers1[peer2.GetPublicKey()]
+		if !found {
+			return false
+		}
+		if peer1.GetPresharedKey() != peer2.GetPresharedKey() ||
+			peer1.GetPersistentKeepalive() != peer2.GetPersistentKeepalive() ||
+			normalizeEndpoint(peer1.GetEndpoint()) != normalizeEndpoint(peer2.GetEndpoint()) {
+			return false
+		}
+		allowedIPs1 := normalizeAllowedIPs(peer1.GetAllowedIps())
+		allowedIPs2 := normalizeAllowedIPs(peer2.GetAllowedIps())
+		if len(allowedIPs1) != len(allowedIPs2) {
+			return false
+		}
+		for i := range allowedIPs1 {
+			if allowedIPs1[i] != allowedIPs2[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// normalizeEndpoint returns endpoint in the canonical <IP>:<port> form.
+func normalizeEndpoint(endpoint string) string {
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		host = ip.String()
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// normalizeAllowedIPs returns sorted list of allowed IP networks in the canonical form.
+func normalizeAllowedIPs(allowedIPs []string) []string {
+	normalized := make([]string, 0, len(allowedIPs))
+	for _, allowedIP := range allowedIPs {
+		if _, ipNet, err := net.ParseCIDR(allowedIP); err == nil {
+			allowedIP = ipNet.String()
+		}
+		normalized = append(normalized, allowedIP)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
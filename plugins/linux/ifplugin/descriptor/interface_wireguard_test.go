@@ -0,0 +1,240 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"encoding/base64"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/linuxcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// wireguardKey returns base64-encoded key with all bytes set to b.
+func wireguardKey(b byte) string {
+	key := make([]byte, linuxcalls.WireguardKeyLen)
+	for i := range key {
+		key[i] = b
+	}
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func wireguardInterface(wg *interfaces.WireguardLink) *interfaces.Interface {
+	return &interfaces.Interface{Name: "wg1", Type: interfaces.Interface_WIREGUARD,
+		Link: &interfaces.Interface_Wireguard{Wireguard: wg}}
+}
+
+func TestValidateWireguard(t *testing.T) {
+	tests := []struct {
+		name   string
+		wg     *interfaces.WireguardLink
+		err    error
+		fields []string
+	}{
+		{
+			name: "valid configuration",
+			wg: &interfaces.WireguardLink{
+				PrivateKey: wireguardKey(1),
+				ListenPort: 51820,
+				Peers: []*interfaces.WireguardLink_Peer{
+					{PublicKey: wireguardKey(2), PresharedKey: wireguardKey(3), Endpoint: "10.0.0.2:51820",
+						AllowedIps: []string{"10.1.0.0/16"}},
+					{PublicKey: wireguardKey(4), Endpoint: "[2001:db8::2]:51820", AllowedIps: []string{"fd00::/64"}},
+				},
+			},
+		},
+		{
+			name: "without private key",
+			wg:   &interfaces.WireguardLink{},
+		},
+		{
+			name:   "invalid private key",
+			wg:     &interfaces.WireguardLink{PrivateKey: "not a key"},
+			err:    ErrWireguardWithInvalidKey,
+			fields: []string{"private_key"},
+		},
+		{
+			name:   "private key of invalid length",
+			wg:     &interfaces.WireguardLink{PrivateKey: base64.StdEncoding.EncodeToString([]byte("short"))},
+			err:    ErrWireguardWithInvalidKey,
+			fields: []string{"private_key"},
+		},
+		{
+			name: "peer without public key",
+			wg: &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{
+				{PublicKey: wireguardKey(2)}, {Endpoint: "10.0.0.2:51820"},
+			}},
+			err:    ErrWireguardWithInvalidKey,
+			fields: []string{"peers[1].public_key"},
+		},
+		{
+			name: "invalid preshared key",
+			wg: &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{
+				{PublicKey: wireguardKey(2), PresharedKey: "?"},
+			}},
+			err:    ErrWireguardWithInvalidKey,
+			fields: []string{"peers[0].preshared_key"},
+		},
+		{
+			name: "endpoint without port",
+			wg: &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{
+				{PublicKey: wireguardKey(2), Endpoint: "10.0.0.2"},
+			}},
+			err:    ErrWireguardWithInvalidEndpoint,
+			fields: []string{"peers[0].endpoint"},
+		},
+		{
+			name: "endpoint with host name",
+			wg: &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{
+				{PublicKey: wireguardKey(2), Endpoint: "peer.example.com:51820"},
+			}},
+			err:    ErrWireguardWithInvalidEndpoint,
+			fields: []string{"peers[0].endpoint"},
+		},
+		{
+			name: "allowed IP without mask",
+			wg: &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{
+				{PublicKey: wireguardKey(2), AllowedIps: []string{"10.1.0.0/16", "10.2.0.1"}},
+			}},
+			err:    ErrInvalidIPWithMask,
+			fields: []string{"peers[0].allowed_ips[1]"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			wgIf := wireguardInterface(test.wg)
+			err := descriptor.Validate(interfaces.InterfaceKey(wgIf.Name), wgIf)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(Equal(kvs.NewInvalidValueError(test.err, test.fields...)))
+		})
+	}
+}
+
+func TestEquivalentWireguard(t *testing.T) {
+	peer := func(key byte, endpoint string, allowedIPs ...string) *interfaces.WireguardLink_Peer {
+		return &interfaces.WireguardLink_Peer{PublicKey: wireguardKey(key), Endpoint: endpoint, AllowedIps: allowedIPs}
+	}
+	tests := []struct {
+		name       string
+		wg1        *interfaces.WireguardLink
+		wg2        *interfaces.WireguardLink
+		equivalent bool
+	}{
+		{
+			name:       "reordered peers and allowed IPs",
+			wg1:        &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(2, "", "10.0.0.0/8", "fd00::/64"), peer(3, "")}},
+			wg2:        &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(3, ""), peer(2, "", "fd00::/64", "10.0.0.0/8")}},
+			equivalent: true,
+		},
+		{
+			name:       "allowed IP with host bits",
+			wg1:        &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(2, "", "10.0.0.0/24")}},
+			wg2:        &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(2, "", "10.0.0.1/24")}},
+			equivalent: true,
+		},
+		{
+			name:       "endpoint in different notation",
+			wg1:        &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(2, "[2001:db8::1]:51820")}},
+			wg2:        &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(2, "[2001:0db8:0::1]:51820")}},
+			equivalent: true,
+		},
+		{
+			name:       "listen port chosen by the kernel",
+			wg1:        &interfaces.WireguardLink{ListenPort: 40000},
+			wg2:        &interfaces.WireguardLink{},
+			equivalent: true,
+		},
+		{
+			name: "different listen port",
+			wg1:  &interfaces.WireguardLink{ListenPort: 40000},
+			wg2:  &interfaces.WireguardLink{ListenPort: 51820},
+		},
+		{
+			name: "different private key",
+			wg1:  &interfaces.WireguardLink{PrivateKey: wireguardKey(1)},
+			wg2:  &interfaces.WireguardLink{PrivateKey: wireguardKey(2)},
+		},
+		{
+			name: "different peer",
+			wg1:  &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(2, "")}},
+			wg2:  &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(3, "")}},
+		},
+		{
+			name: "added allowed IP",
+			wg1:  &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(2, "", "10.0.0.0/8")}},
+			wg2:  &interfaces.WireguardLink{Peers: []*interfaces.WireguardLink_Peer{peer(2, "", "10.0.0.0/8", "fd00::/64")}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			Expect(equivalentWireguard(test.wg1, test.wg2)).To(Equal(test.equivalent))
+
+			descriptor := newTestInterfaceDescriptor(newNetlinkMock())
+			Expect(descriptor.EquivalentInterfaces(interfaces.InterfaceKey("wg1"),
+				wireguardInterface(test.wg1), wireguardInterface(test.wg2))).To(Equal(test.equivalent))
+		})
+	}
+}
+
+func TestCreateAndUpdateWireguard(t *testing.T) {
+	RegisterTestingT(t)
+
+	ifHandler := newNetlinkMock()
+	descriptor := newTestInterfaceDescriptor(ifHandler)
+	oldWg := wireguardInterface(&interfaces.WireguardLink{
+		PrivateKey: wireguardKey(1),
+		Peers:      []*interfaces.WireguardLink_Peer{{PublicKey: wireguardKey(2)}},
+	})
+	oldWg.Enabled = true
+	metadata, err := descriptor.Create(interfaces.InterfaceKey(oldWg.Name), oldWg)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(metadata).To(Equal(&ifaceidx.LinuxIfMetadata{LinuxIfIndex: 1, HostIfName: "wg1"}))
+	Expect(ifHandler.calls).To(Equal([]string{
+		"AddWireguard wg1",
+		"SetWireguardDevice wg1 peers=1",
+		"SetInterfaceAlias wg1 test-agent/wg1",
+		"SetInterfaceUp wg1",
+	}))
+
+	// keys and peers are updated in place
+	ifHandler.calls = nil
+	newWg := wireguardInterface(&interfaces.WireguardLink{
+		PrivateKey: wireguardKey(1),
+		Peers:      []*interfaces.WireguardLink_Peer{{PublicKey: wireguardKey(2)}, {PublicKey: wireguardKey(3)}},
+	})
+	newWg.Enabled = true
+	Expect(descriptor.UpdateWithRecreate(interfaces.InterfaceKey(oldWg.Name), oldWg, newWg, metadata)).To(BeFalse())
+	_, err = descriptor.Update(interfaces.InterfaceKey(oldWg.Name), oldWg, newWg, metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ifHandler.calls).To(Equal([]string{"SetWireguardDevice wg1 peers=2"}))
+
+	// nothing to do for equivalent configuration
+	ifHandler.calls = nil
+	_, err = descriptor.Update(interfaces.InterfaceKey(oldWg.Name), newWg, newWg, metadata)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ifHandler.calls).To(BeEmpty())
+}
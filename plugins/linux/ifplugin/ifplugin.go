@@ -14,6 +14,7 @@
 
 //go:generate descriptor-adapter --descriptor-name Interface  --value-type *linux_interfaces.Interface --meta-type *ifaceidx.LinuxIfMetadata --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces" --import "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name InterfaceVrf  --value-type *linux_interfaces.Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name InterfaceMaster  --value-type *linux_interfaces.Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name InterfaceAddress  --value-type *linux_interfaces.Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces" --output-dir "descriptor"
 
 package ifplugin
@@ -40,7 +41,7 @@ const (
 	defaultGoRoutinesCnt = 10
 )
 
-// IfPlugin configures Linux VETH and TAP interfaces using Netlink API.
+// IfPlugin configures Linux interfaces (VETH, TAP, bridge, VLAN, bond, ...) using Netlink API.
 type IfPlugin struct {
 	Deps
 
@@ -55,6 +56,7 @@ type IfPlugin struct {
 	ifWatcher        *descriptor.InterfaceWatcher
 	ifAddrDescriptor *descriptor.InterfaceAddressDescriptor
 	ifVrfDescriptor  *descriptor.InterfaceVrfDescriptor
+	ifMstDescriptor  *descriptor.InterfaceMasterDescriptor
 
 	// index map
 	ifIndex ifaceidx.LinuxIfMetadataIndex
@@ -115,11 +117,12 @@ func (p *IfPlugin) Init() error {
 		config.GoRoutinesCnt, p.Log)
 	p.ifDescriptor.SetInterfaceHandler(p.ifHandler)
 
-	var addrDescriptor, vrfDescriptor *kvs.KVDescriptor
+	var addrDescriptor, vrfDescriptor, masterDescriptor *kvs.KVDescriptor
 	addrDescriptor, p.ifAddrDescriptor = descriptor.NewInterfaceAddressDescriptor(p.NsPlugin,
 		p.AddrAlloc, p.ifHandler, p.Log)
 	vrfDescriptor, p.ifVrfDescriptor = descriptor.NewInterfaceVrfDescriptor(p.NsPlugin, p.ifHandler, p.Log)
-	err = p.Deps.KVScheduler.RegisterKVDescriptor(addrDescriptor, vrfDescriptor)
+	masterDescriptor, p.ifMstDescriptor = descriptor.NewInterfaceMasterDescriptor(p.NsPlugin, p.ifHandler, p.Log)
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(addrDescriptor, vrfDescriptor, masterDescriptor)
 	if err != nil {
 		return err
 	}
@@ -141,6 +144,7 @@ func (p *IfPlugin) Init() error {
 	p.ifDescriptor.SetInterfaceIndex(p.ifIndex)
 	p.ifAddrDescriptor.SetInterfaceIndex(p.ifIndex)
 	p.ifVrfDescriptor.SetInterfaceIndex(p.ifIndex)
+	p.ifMstDescriptor.SetInterfaceIndex(p.ifIndex)
 
 	// start interface watching
 	if err = p.ifWatcher.StartWatching(); err != nil {
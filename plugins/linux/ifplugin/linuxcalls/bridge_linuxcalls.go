@@ -0,0 +1,70 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// Bridge attributes (IFLA_INFO_DATA of the "bridge" link kind) not yet exposed
+// by the netlink library (see include/uapi/linux/if_link.h).
+const (
+	iflaBrStpState      = 5
+	iflaBrVlanFiltering = 7
+)
+
+// SetBridgeOptions enables/disables STP and VLAN filtering on the given Linux bridge.
+// Equivalent to: `ip link set dev $bridgeName type bridge stp_state $stp vlan_filtering $vlanFiltering`
+func (h *NetLinkHandler) SetBridgeOptions(bridgeName string, stp, vlanFiltering bool) error {
+	link, err := h.GetLinkByName(bridgeName)
+	if err != nil {
+		return err
+	}
+
+	req, data := newLinkInfoRequest(0, link.Attrs().Index, "", "bridge")
+	var stpState uint32
+	if stp {
+		stpState = 1
+	}
+	nl.NewRtAttrChild(data, iflaBrStpState, nl.Uint32Attr(stpState))
+	nl.NewRtAttrChild(data, iflaBrVlanFiltering, boolAttr(vlanFiltering))
+
+	if _, err := req.Execute(unix.NETLINK_ROUTE, 0); err != nil {
+		return errors.Wrapf(err, "LinkModify (bridge=%s, stp=%t, vlan-filtering=%t)",
+			bridgeName, stp, vlanFiltering)
+	}
+	return nil
+}
+
+// GetBridgeOptions returns the state of STP and VLAN filtering for the given Linux bridge.
+func (h *NetLinkHandler) GetBridgeOptions(bridgeName string) (stp, vlanFiltering bool, err error) {
+	attrs, err := h.getLinkInfoData(bridgeName)
+	if err != nil {
+		return false, false, err
+	}
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case iflaBrStpState:
+			stp = native.Uint32(attr.Value[0:4]) != 0
+		case iflaBrVlanFiltering:
+			vlanFiltering = attr.Value[0] != 0
+		}
+	}
+	return stp, vlanFiltering, nil
+}
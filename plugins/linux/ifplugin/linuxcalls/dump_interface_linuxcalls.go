@@ -220,6 +220,123 @@ func ParseVRFAlias(alias string) (vrfName string) {
 	return alias
 }
 
+// GetBridgeAlias returns alias for Linux bridge managed by the agent.
+func GetBridgeAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name
+}
+
+// ParseBridgeAlias parses out logical name of a bridge from the alias.
+// Currently there are no other logical information stored in the alias so it is very straightforward.
+func ParseBridgeAlias(alias string) (bridgeName string) {
+	return alias
+}
+
+// GetVlanAlias returns alias for Linux VLAN interface managed by the agent.
+// The alias stores the VLAN logical name together with the logical name of the parent.
+func GetVlanAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name + "/" + linuxIf.GetVlan().GetParentInterface()
+}
+
+// ParseVlanAlias parses out VLAN logical name together with the parent name from the alias.
+func ParseVlanAlias(alias string) (vlanName, parentIfName string) {
+	aliasParts := strings.Split(alias, "/")
+	vlanName = aliasParts[0]
+	if len(aliasParts) > 1 {
+		parentIfName = aliasParts[1]
+	}
+	return
+}
+
+// GetMacvlanAlias returns alias for Linux MACVLAN interface managed by the agent.
+// The alias stores the MACVLAN logical name together with the logical name of the parent.
+func GetMacvlanAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name + "/" + linuxIf.GetMacvlan().GetParentInterface()
+}
+
+// ParseMacvlanAlias parses out MACVLAN logical name together with the parent name from the alias.
+func ParseMacvlanAlias(alias string) (macvlanName, parentIfName string) {
+	aliasParts := strings.Split(alias, "/")
+	macvlanName = aliasParts[0]
+	if len(aliasParts) > 1 {
+		parentIfName = aliasParts[1]
+	}
+	return
+}
+
+// GetIPVlanAlias returns alias for Linux IPVLAN interface managed by the agent.
+// The alias stores the IPVLAN logical name together with the logical name of the parent.
+func GetIPVlanAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name + "/" + linuxIf.GetIpvlan().GetParentInterface()
+}
+
+// ParseIPVlanAlias parses out IPVLAN logical name together with the parent name from the alias.
+func ParseIPVlanAlias(alias string) (ipvlanName, parentIfName string) {
+	aliasParts := strings.Split(alias, "/")
+	ipvlanName = aliasParts[0]
+	if len(aliasParts) > 1 {
+		parentIfName = aliasParts[1]
+	}
+	return
+}
+
+// GetBondAlias returns alias for Linux bond interface managed by the agent.
+func GetBondAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name
+}
+
+// ParseBondAlias parses out logical name of a bond from the alias.
+// Currently there are no other logical information stored in the alias so it is very straightforward.
+func ParseBondAlias(alias string) (bondName string) {
+	return alias
+}
+
+// GetVxlanAlias returns alias for Linux VXLAN interface managed by the agent.
+// The alias stores the VXLAN logical name together with the logical name
+// of the underlay interface (if any).
+func GetVxlanAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name + "/" + linuxIf.GetVxlan().GetUnderlayInterface()
+}
+
+// ParseVxlanAlias parses out VXLAN logical name together with the underlay
+// interface name from the alias.
+func ParseVxlanAlias(alias string) (vxlanName, underlayIfName string) {
+	aliasParts := strings.Split(alias, "/")
+	vxlanName = aliasParts[0]
+	if len(aliasParts) > 1 {
+		underlayIfName = aliasParts[1]
+	}
+	return
+}
+
+// GetGreAlias returns alias for Linux GRE interface managed by the agent.
+// The alias stores the GRE logical name together with the logical name
+// of the underlay interface (if any).
+func GetGreAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name + "/" + linuxIf.GetGre().GetUnderlayInterface()
+}
+
+// ParseGreAlias parses out GRE logical name together with the underlay
+// interface name from the alias.
+func ParseGreAlias(alias string) (greName, underlayIfName string) {
+	aliasParts := strings.Split(alias, "/")
+	greName = aliasParts[0]
+	if len(aliasParts) > 1 {
+		underlayIfName = aliasParts[1]
+	}
+	return
+}
+
+// GetWireguardAlias returns alias for Linux WireGuard interface managed by the agent.
+func GetWireguardAlias(linuxIf *interfaces.Interface) string {
+	return linuxIf.Name
+}
+
+// ParseWireguardAlias parses out logical name of a WireGuard interface from the alias.
+// Currently there are no other logical information stored in the alias so it is very straightforward.
+func ParseWireguardAlias(alias string) (wgName string) {
+	return alias
+}
+
 // retrieveInterfaces is run by a separate go routine to retrieve all interfaces
 // present in every <goRoutineIdx>-th network namespace from the list.
 func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, goRoutineIdx, goRoutinesCnt int, ch chan<- retrievedInterfaces) {
@@ -247,7 +364,8 @@ func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, g
 
 		// retrieve every interface managed by this agent
 		var ifaces []*InterfaceDetails
-		vrfDevs := make(map[int]string) // vrf index -> vrf name
+		vrfDevs := make(map[int]string)                // vrf index -> vrf name
+		masters := make(map[int]*interfaces.Interface) // bridge/bond index -> bridge/bond
 		for _, link := range links {
 			iface := &interfaces.Interface{
 				Namespace:   nsRef,
@@ -302,6 +420,132 @@ func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, g
 					},
 				}
 				vrfDevs[link.Attrs().Index] = iface.Name
+			} else if link.Type() == "bridge" {
+				iface.Type = interfaces.Interface_BRIDGE
+				iface.Name = ParseBridgeAlias(alias)
+				bridge := &interfaces.BridgeLink{}
+				stp, vlanFiltering, err := h.GetBridgeOptions(link.Attrs().Name)
+				if err != nil {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warn("Failed to read bridge options:", err)
+				} else {
+					bridge.StpEnabled = stp
+					bridge.VlanFiltering = vlanFiltering
+				}
+				iface.Link = &interfaces.Interface_Bridge{Bridge: bridge}
+				masters[link.Attrs().Index] = iface
+			} else if link.Type() == "vlan" {
+				vlan, isVlan := link.(*netlink.Vlan)
+				if !isVlan {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve VLAN-specific attributes")
+					continue
+				}
+				iface.Type = interfaces.Interface_VLAN
+				var parentIfName string
+				iface.Name, parentIfName = ParseVlanAlias(alias)
+				iface.Link = &interfaces.Interface_Vlan{
+					Vlan: &interfaces.VlanLink{
+						ParentInterface: parentIfName,
+						VlanId:          uint32(vlan.VlanId),
+					},
+				}
+			} else if link.Type() == "macvlan" {
+				macvlan, isMacvlan := link.(*netlink.Macvlan)
+				if !isMacvlan {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve MACVLAN-specific attributes")
+					continue
+				}
+				iface.Type = interfaces.Interface_MACVLAN
+				var parentIfName string
+				iface.Name, parentIfName = ParseMacvlanAlias(alias)
+				iface.Link = &interfaces.Interface_Macvlan{
+					Macvlan: &interfaces.MacvlanLink{
+						ParentInterface: parentIfName,
+						Mode:            macvlanModeFromNetlink(macvlan.Mode),
+					},
+				}
+			} else if link.Type() == "ipvlan" {
+				ipvlan, isIPVlan := link.(*netlink.IPVlan)
+				if !isIPVlan {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve IPVLAN-specific attributes")
+					continue
+				}
+				iface.Type = interfaces.Interface_IPVLAN
+				var parentIfName string
+				iface.Name, parentIfName = ParseIPVlanAlias(alias)
+				iface.Link = &interfaces.Interface_Ipvlan{
+					Ipvlan: &interfaces.IpvlanLink{
+						ParentInterface: parentIfName,
+						Mode:            ipvlanModeFromNetlink(ipvlan.Mode),
+					},
+				}
+			} else if link.Type() == "bond" {
+				bond, isBond := link.(*netlink.Bond)
+				if !isBond {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve bond-specific attributes")
+					continue
+				}
+				iface.Type = interfaces.Interface_BOND
+				iface.Name = ParseBondAlias(alias)
+				bondLink := &interfaces.BondLink{
+					Mode: bondModeFromNetlink(bond.Mode),
+				}
+				if bond.Miimon > 0 {
+					bondLink.Miimon = uint32(bond.Miimon)
+				}
+				iface.Link = &interfaces.Interface_Bond{Bond: bondLink}
+				masters[link.Attrs().Index] = iface
+			} else if link.Type() == "vxlan" {
+				vxlan, isVxlan := link.(*netlink.Vxlan)
+				if !isVxlan {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warnf("Unable to retrieve VXLAN-specific attributes")
+					continue
+				}
+				iface.Type = interfaces.Interface_VXLAN
+				vxlanLink := vxlanFromLink(vxlan)
+				iface.Name, vxlanLink.UnderlayInterface = ParseVxlanAlias(alias)
+				iface.Link = &interfaces.Interface_Vxlan{Vxlan: vxlanLink}
+			} else if link.Type() == greKind || link.Type() == ip6greKind {
+				greLink, err := h.GetGRE(link.Attrs().Name)
+				if err != nil {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warn("Unable to retrieve GRE-specific attributes:", err)
+					continue
+				}
+				iface.Type = interfaces.Interface_GRE
+				iface.Name, greLink.UnderlayInterface = ParseGreAlias(alias)
+				iface.Link = &interfaces.Interface_Gre{Gre: greLink}
+			} else if link.Type() == wgGenlName {
+				wgLink, err := h.GetWireguardDevice(link.Attrs().Name)
+				if err != nil {
+					h.log.WithFields(logging.Fields{
+						"if-host-name": link.Attrs().Name,
+						"namespace":    nsRef,
+					}).Warn("Unable to retrieve WireGuard-specific attributes:", err)
+					continue
+				}
+				iface.Type = interfaces.Interface_WIREGUARD
+				iface.Name = ParseWireguardAlias(alias)
+				iface.Link = &interfaces.Interface_Wireguard{Wireguard: wgLink}
 			} else if link.Attrs().Name == DefaultLoopbackName {
 				iface.Type = interfaces.Interface_LOOPBACK
 				iface.Name = alias
@@ -360,6 +604,20 @@ func (h *NetLinkHandler) retrieveInterfaces(nsList []*namespaces.NetNamespace, g
 				iface.Interface.VrfMasterInterface = vrfDev
 			}
 		}
+
+		// fill bridge ports and bond members
+		for _, iface := range ifaces {
+			master, hasMaster := masters[iface.Meta.MasterIndex]
+			if !hasMaster {
+				continue
+			}
+			switch master.Type {
+			case interfaces.Interface_BRIDGE:
+				master.GetBridge().Ports = append(master.GetBridge().Ports, iface.Interface.Name)
+			case interfaces.Interface_BOND:
+				master.GetBond().Members = append(master.GetBond().Members, iface.Interface.Name)
+			}
+		}
 		retrieved.interfaces = append(retrieved.interfaces, ifaces...)
 
 		// switch back to the default namespace
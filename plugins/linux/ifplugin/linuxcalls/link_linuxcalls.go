@@ -22,6 +22,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/vishvananda/netlink"
 	"github.com/vishvananda/netns"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 )
 
 // GetLinkByName calls netlink API to get Link type from interface name
@@ -299,6 +301,130 @@ func (h *NetLinkHandler) RemoveInterfaceFromVRF(ifName, vrfDevName string) error
 	return nil
 }
 
+// AddBridge configures new Linux bridge.
+func (h *NetLinkHandler) AddBridge(bridgeName string) error {
+	link := &netlink.Bridge{
+		LinkAttrs: newLinkAttrs(bridgeName),
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (bridge=%s)", bridgeName)
+	}
+	return nil
+}
+
+// AddVLAN configures new 802.1Q VLAN sub-interface over the given parent interface.
+func (h *NetLinkHandler) AddVLAN(vlanName, parentIfName string, vlanID uint32) error {
+	parentLink, err := h.GetLinkByName(parentIfName)
+	if err != nil {
+		return err
+	}
+	attrs := newLinkAttrs(vlanName)
+	attrs.ParentIndex = parentLink.Attrs().Index
+	link := &netlink.Vlan{
+		LinkAttrs: attrs,
+		VlanId:    int(vlanID),
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (vlan=%s, parent=%s, vlan-id=%d)",
+			vlanName, parentIfName, vlanID)
+	}
+	return nil
+}
+
+// AddMacvlan configures new MACVLAN interface over the given parent interface.
+func (h *NetLinkHandler) AddMacvlan(macvlanName, parentIfName string, mode interfaces.MacvlanLink_Mode) error {
+	parentLink, err := h.GetLinkByName(parentIfName)
+	if err != nil {
+		return err
+	}
+	attrs := newLinkAttrs(macvlanName)
+	attrs.ParentIndex = parentLink.Attrs().Index
+	link := &netlink.Macvlan{
+		LinkAttrs: attrs,
+		Mode:      macvlanModeToNetlink(mode),
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (macvlan=%s, parent=%s, mode=%v)",
+			macvlanName, parentIfName, mode)
+	}
+	return nil
+}
+
+// AddIPVlan configures new IPVLAN interface over the given parent interface.
+func (h *NetLinkHandler) AddIPVlan(ipvlanName, parentIfName string, mode interfaces.IpvlanLink_Mode) error {
+	parentLink, err := h.GetLinkByName(parentIfName)
+	if err != nil {
+		return err
+	}
+	attrs := newLinkAttrs(ipvlanName)
+	attrs.ParentIndex = parentLink.Attrs().Index
+	link := &netlink.IPVlan{
+		LinkAttrs: attrs,
+		Mode:      ipvlanModeToNetlink(mode),
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (ipvlan=%s, parent=%s, mode=%v)",
+			ipvlanName, parentIfName, mode)
+	}
+	return nil
+}
+
+// AddBond configures new bond interface.
+func (h *NetLinkHandler) AddBond(bondName string, mode interfaces.BondLink_Mode, miimon uint32) error {
+	link := netlink.NewLinkBond(newLinkAttrs(bondName))
+	link.Mode = bondModeToNetlink(mode)
+	if miimon != 0 {
+		link.Miimon = int(miimon)
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (bond=%s, mode=%v)", bondName, mode)
+	}
+	return nil
+}
+
+// PutInterfaceIntoMaster enslaves Linux interface to a given master device (bridge or bond).
+func (h *NetLinkHandler) PutInterfaceIntoMaster(ifName, masterName string) error {
+	ifLink, err := h.GetLinkByName(ifName)
+	if err != nil {
+		return err
+	}
+	masterLink, err := h.GetLinkByName(masterName)
+	if err != nil {
+		return err
+	}
+	// bond members have to be down while being enslaved
+	var wasUp bool
+	if masterLink.Type() == "bond" && isLinkUp(ifLink) {
+		wasUp = true
+		if err = h.LinkSetDown(ifLink); err != nil {
+			return errors.Wrapf(err, "LinkSetDown %v", ifLink)
+		}
+	}
+	if err := h.LinkSetMasterByIndex(ifLink, masterLink.Attrs().Index); err != nil {
+		return errors.Wrapf(err, "LinkSetMasterByIndex (interface=%s, master=%s, master-index=%d)",
+			ifName, masterName, masterLink.Attrs().Index)
+	}
+	if wasUp {
+		if err = h.LinkSetUp(ifLink); err != nil {
+			return errors.Wrapf(err, "LinkSetUp %v", ifLink)
+		}
+	}
+	return nil
+}
+
+// RemoveInterfaceFromMaster releases Linux interface from a given master device.
+func (h *NetLinkHandler) RemoveInterfaceFromMaster(ifName, masterName string) error {
+	ifLink, err := h.GetLinkByName(ifName)
+	if err != nil {
+		return err
+	}
+	if err := h.LinkSetNoMaster(ifLink); err != nil {
+		return errors.Wrapf(err, "LinkSetNoMaster (interface=%s, master=%s)",
+			ifName, masterName)
+	}
+	return nil
+}
+
 func isLinkUp(link netlink.Link) bool {
 	return (link.Attrs().Flags & net.FlagUp) == net.FlagUp
 }
@@ -308,3 +434,83 @@ func newLinkAttrs(name string) netlink.LinkAttrs {
 	attrs.Name = name
 	return attrs
 }
+
+func bondModeToNetlink(mode interfaces.BondLink_Mode) netlink.BondMode {
+	switch mode {
+	case interfaces.BondLink_ACTIVE_BACKUP:
+		return netlink.BOND_MODE_ACTIVE_BACKUP
+	case interfaces.BondLink_BALANCE_XOR:
+		return netlink.BOND_MODE_BALANCE_XOR
+	case interfaces.BondLink_BROADCAST:
+		return netlink.BOND_MODE_BROADCAST
+	case interfaces.BondLink_LACP:
+		return netlink.BOND_MODE_802_3AD
+	case interfaces.BondLink_BALANCE_TLB:
+		return netlink.BOND_MODE_BALANCE_TLB
+	case interfaces.BondLink_BALANCE_ALB:
+		return netlink.BOND_MODE_BALANCE_ALB
+	}
+	return netlink.BOND_MODE_BALANCE_RR
+}
+
+func bondModeFromNetlink(mode netlink.BondMode) interfaces.BondLink_Mode {
+	switch mode {
+	case netlink.BOND_MODE_ACTIVE_BACKUP:
+		return interfaces.BondLink_ACTIVE_BACKUP
+	case netlink.BOND_MODE_BALANCE_XOR:
+		return interfaces.BondLink_BALANCE_XOR
+	case netlink.BOND_MODE_BROADCAST:
+		return interfaces.BondLink_BROADCAST
+	case netlink.BOND_MODE_802_3AD:
+		return interfaces.BondLink_LACP
+	case netlink.BOND_MODE_BALANCE_TLB:
+		return interfaces.BondLink_BALANCE_TLB
+	case netlink.BOND_MODE_BALANCE_ALB:
+		return interfaces.BondLink_BALANCE_ALB
+	}
+	return interfaces.BondLink_BALANCE_RR
+}
+
+func macvlanModeToNetlink(mode interfaces.MacvlanLink_Mode) netlink.MacvlanMode {
+	switch mode {
+	case interfaces.MacvlanLink_PRIVATE:
+		return netlink.MACVLAN_MODE_PRIVATE
+	case interfaces.MacvlanLink_VEPA:
+		return netlink.MACVLAN_MODE_VEPA
+	case interfaces.MacvlanLink_PASSTHRU:
+		return netlink.MACVLAN_MODE_PASSTHRU
+	}
+	return netlink.MACVLAN_MODE_BRIDGE
+}
+
+func macvlanModeFromNetlink(mode netlink.MacvlanMode) interfaces.MacvlanLink_Mode {
+	switch mode {
+	case netlink.MACVLAN_MODE_PRIVATE:
+		return interfaces.MacvlanLink_PRIVATE
+	case netlink.MACVLAN_MODE_VEPA:
+		return interfaces.MacvlanLink_VEPA
+	case netlink.MACVLAN_MODE_PASSTHRU:
+		return interfaces.MacvlanLink_PASSTHRU
+	}
+	return interfaces.MacvlanLink_BRIDGE
+}
+
+func ipvlanModeToNetlink(mode interfaces.IpvlanLink_Mode) netlink.IPVlanMode {
+	switch mode {
+	case interfaces.IpvlanLink_L3:
+		return netlink.IPVLAN_MODE_L3
+	case interfaces.IpvlanLink_L3S:
+		return netlink.IPVLAN_MODE_L3S
+	}
+	return netlink.IPVLAN_MODE_L2
+}
+
+func ipvlanModeFromNetlink(mode netlink.IPVlanMode) interfaces.IpvlanLink_Mode {
+	switch mode {
+	case netlink.IPVLAN_MODE_L3:
+		return interfaces.IpvlanLink_L3
+	case netlink.IPVLAN_MODE_L3S:
+		return interfaces.IpvlanLink_L3S
+	}
+	return interfaces.IpvlanLink_L2
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+func TestMacvlanModes(t *testing.T) {
+	RegisterTestingT(t)
+
+	for mode := range interfaces.MacvlanLink_Mode_name {
+		mode := interfaces.MacvlanLink_Mode(mode)
+		Expect(macvlanModeFromNetlink(macvlanModeToNetlink(mode))).To(Equal(mode))
+	}
+	Expect(macvlanModeToNetlink(interfaces.MacvlanLink_BRIDGE)).To(Equal(netlink.MACVLAN_MODE_BRIDGE))
+	Expect(macvlanModeToNetlink(interfaces.MacvlanLink_PASSTHRU)).To(Equal(netlink.MACVLAN_MODE_PASSTHRU))
+}
+
+func TestIPVlanModes(t *testing.T) {
+	RegisterTestingT(t)
+
+	for mode := range interfaces.IpvlanLink_Mode_name {
+		mode := interfaces.IpvlanLink_Mode(mode)
+		Expect(ipvlanModeFromNetlink(ipvlanModeToNetlink(mode))).To(Equal(mode))
+	}
+	Expect(ipvlanModeToNetlink(interfaces.IpvlanLink_L2)).To(Equal(netlink.IPVLAN_MODE_L2))
+	Expect(ipvlanModeToNetlink(interfaces.IpvlanLink_L3S)).To(Equal(netlink.IPVLAN_MODE_L3S))
+}
+
+func TestMacvlanAndIPVlanAliases(t *testing.T) {
+	RegisterTestingT(t)
+
+	macvlan := &interfaces.Interface{Name: "macvlan1", Type: interfaces.Interface_MACVLAN,
+		Link: &interfaces.Interface_Macvlan{Macvlan: &interfaces.MacvlanLink{ParentInterface: "eth0"}}}
+	name, parent := ParseMacvlanAlias(GetMacvlanAlias(macvlan))
+	Expect(name).To(Equal("macvlan1"))
+	Expect(parent).To(Equal("eth0"))
+
+	ipvlan := &interfaces.Interface{Name: "ipvlan1", Type: interfaces.Interface_IPVLAN,
+		Link: &interfaces.Interface_Ipvlan{Ipvlan: &interfaces.IpvlanLink{ParentInterface: "eth1"}}}
+	name, parent = ParseIPVlanAlias(GetIPVlanAlias(ipvlan))
+	Expect(name).To(Equal("ipvlan1"))
+	Expect(parent).To(Equal("eth1"))
+
+	// alias set outside of the agent
+	name, parent = ParseIPVlanAlias("ipvlan2")
+	Expect(name).To(Equal("ipvlan2"))
+	Expect(parent).To(BeEmpty())
+}
@@ -0,0 +1,105 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// Helpers for link attributes (IFLA_INFO_DATA) which are not (fully)
+// supported by the netlink library.
+
+var native = nl.NativeEndian()
+
+// newLinkInfoRequest prepares RTM_NEWLINK request for link of the given kind.
+// The link is referenced by index (when modified) or by name (when created).
+// Returned data attribute is used to fill the kind-specific attributes.
+func newLinkInfoRequest(flags int, index int, name, kind string) (req *nl.NetlinkRequest, data *nl.RtAttr) {
+	req = nl.NewNetlinkRequest(unix.RTM_NEWLINK, flags|unix.NLM_F_ACK)
+	msg := nl.NewIfInfomsg(unix.AF_UNSPEC)
+	msg.Index = int32(index)
+	req.AddData(msg)
+	if name != "" {
+		req.AddData(nl.NewRtAttr(unix.IFLA_IFNAME, nl.ZeroTerminated(name)))
+	}
+
+	linkInfo := nl.NewRtAttr(unix.IFLA_LINKINFO, nil)
+	nl.NewRtAttrChild(linkInfo, nl.IFLA_INFO_KIND, nl.NonZeroTerminated(kind))
+	data = nl.NewRtAttrChild(linkInfo, nl.IFLA_INFO_DATA, nil)
+	req.AddData(linkInfo)
+	return req, data
+}
+
+// getLinkInfoData returns kind-specific attributes (IFLA_INFO_DATA) of the given link.
+func (h *NetLinkHandler) getLinkInfoData(ifName string) ([]syscall.NetlinkRouteAttr, error) {
+	link, err := h.GetLinkByName(ifName)
+	if err != nil {
+		return nil, err
+	}
+
+	req := nl.NewNetlinkRequest(unix.RTM_GETLINK, unix.NLM_F_ACK)
+	msg := nl.NewIfInfomsg(unix.AF_UNSPEC)
+	msg.Index = int32(link.Attrs().Index)
+	req.AddData(msg)
+
+	msgs, err := req.Execute(unix.NETLINK_ROUTE, unix.RTM_NEWLINK)
+	if err != nil {
+		return nil, errors.Wrapf(err, "LinkGet (interface=%s)", ifName)
+	}
+	if len(msgs) == 0 {
+		return nil, errors.Errorf("no link data returned for interface %s", ifName)
+	}
+	return parseLinkInfoData(msgs[0])
+}
+
+// parseLinkInfoData returns kind-specific attributes (IFLA_INFO_DATA) from the link
+// message (starting with the interface info header).
+func parseLinkInfoData(msg []byte) ([]syscall.NetlinkRouteAttr, error) {
+	if len(msg) < unix.SizeofIfInfomsg {
+		return nil, errors.Errorf("link message too short: %d bytes", len(msg))
+	}
+	attrs, err := nl.ParseRouteAttr(msg[unix.SizeofIfInfomsg:])
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range attrs {
+		if attr.Attr.Type != unix.IFLA_LINKINFO {
+			continue
+		}
+		infos, err := nl.ParseRouteAttr(attr.Value)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if info.Attr.Type == nl.IFLA_INFO_DATA {
+				return nl.ParseRouteAttr(info.Value)
+			}
+		}
+	}
+	return nil, nil
+}
+
+func boolAttr(val bool) []byte {
+	if val {
+		return nl.Uint8Attr(1)
+	}
+	return nl.Uint8Attr(0)
+}
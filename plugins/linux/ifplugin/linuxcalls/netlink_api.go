@@ -80,6 +80,30 @@ type NetlinkAPI interface {
 	PutInterfaceIntoVRF(ifName, vrfDevName string) error
 	// RemoveInterfaceFromVRF un-assigns Linux interface from a given VRF.
 	RemoveInterfaceFromVRF(ifName, vrfDevName string) error
+	// AddBridge configures new Linux bridge.
+	AddBridge(bridgeName string) error
+	// SetBridgeOptions enables/disables STP and VLAN filtering on the given Linux bridge.
+	SetBridgeOptions(bridgeName string, stp, vlanFiltering bool) error
+	// AddVLAN configures new 802.1Q VLAN sub-interface over the given parent interface.
+	AddVLAN(vlanName, parentIfName string, vlanID uint32) error
+	// AddMacvlan configures new MACVLAN interface over the given parent interface.
+	AddMacvlan(macvlanName, parentIfName string, mode interfaces.MacvlanLink_Mode) error
+	// AddIPVlan configures new IPVLAN interface over the given parent interface.
+	AddIPVlan(ipvlanName, parentIfName string, mode interfaces.IpvlanLink_Mode) error
+	// AddBond configures new bond interface.
+	AddBond(bondName string, mode interfaces.BondLink_Mode, miimon uint32) error
+	// AddVxlan configures new VXLAN tunnel interface.
+	AddVxlan(vxlanName string, vxlan *interfaces.VxlanLink, underlayIfName string) error
+	// AddGRE configures new GRE (IPv4) or ip6gre (IPv6) tunnel interface.
+	AddGRE(greName string, gre *interfaces.GreLink, underlayIfName string) error
+	// AddWireguard configures new WireGuard interface.
+	AddWireguard(wgName string) error
+	// SetWireguardDevice (re)configures keys, listen port and peers of the given WireGuard interface.
+	SetWireguardDevice(wgName string, wg *interfaces.WireguardLink) error
+	// PutInterfaceIntoMaster enslaves Linux interface to a given master device (bridge or bond).
+	PutInterfaceIntoMaster(ifName, masterName string) error
+	// RemoveInterfaceFromMaster releases Linux interface from a given master device.
+	RemoveInterfaceFromMaster(ifName, masterName string) error
 	// DeleteInterface removes the given interface.
 	DeleteInterface(ifName string) error
 	// SetInterfaceUp sets interface state to 'up'
@@ -132,6 +156,12 @@ type NetlinkAPIRead interface {
 	// GetChecksumOffloading returns the state of Rx/Tx checksum offloading
 	// for the given interface.
 	GetChecksumOffloading(ifName string) (rxOn, txOn bool, err error)
+	// GetBridgeOptions returns the state of STP and VLAN filtering for the given Linux bridge.
+	GetBridgeOptions(bridgeName string) (stp, vlanFiltering bool, err error)
+	// GetGRE returns configuration of the given GRE (or ip6gre) tunnel interface.
+	GetGRE(greName string) (*interfaces.GreLink, error)
+	// GetWireguardDevice returns the configuration of the given WireGuard interface.
+	GetWireguardDevice(wgName string) (*interfaces.WireguardLink, error)
 	// DumpInterfaces uses local cache to gather information about linux
 	// namespaces and retrieves interfaces from them.
 	DumpInterfaces() ([]*InterfaceDetails, error)
@@ -0,0 +1,213 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"encoding/binary"
+	"net"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+const (
+	// DefaultVxlanPort is the IANA-assigned UDP port used by VXLAN tunnels
+	// if not configured otherwise (the kernel would use the legacy 8472 instead).
+	DefaultVxlanPort = 4789
+
+	// GRE netdevice kinds.
+	greKind    = "gre"
+	ip6greKind = "ip6gre"
+)
+
+// AddVxlan configures new VXLAN tunnel interface.
+// Underlay interface (referenced by host name) is optional.
+func (h *NetLinkHandler) AddVxlan(vxlanName string, vxlan *interfaces.VxlanLink, underlayIfName string) error {
+	link := vxlanToLink(vxlanName, vxlan)
+	if underlayIfName != "" {
+		underlay, err := h.GetLinkByName(underlayIfName)
+		if err != nil {
+			return err
+		}
+		link.VtepDevIndex = underlay.Attrs().Index
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (vxlan=%s, vni=%d, remote=%s)",
+			vxlanName, vxlan.GetVni(), vxlan.GetRemoteIp())
+	}
+	return nil
+}
+
+// vxlanToLink converts VXLAN configuration into netlink link (without the underlay).
+func vxlanToLink(vxlanName string, vxlan *interfaces.VxlanLink) *netlink.Vxlan {
+	link := &netlink.Vxlan{
+		LinkAttrs: newLinkAttrs(vxlanName),
+		VxlanId:   int(vxlan.GetVni()),
+		Learning:  vxlan.GetLearning(),
+		TTL:       int(vxlan.GetTtl()),
+		Port:      int(vxlan.GetDstPort()),
+	}
+	if link.Port == 0 {
+		link.Port = DefaultVxlanPort
+	}
+	if vxlan.GetLocalIp() != "" {
+		link.SrcAddr = net.ParseIP(vxlan.GetLocalIp())
+	}
+	if vxlan.GetRemoteIp() != "" {
+		link.Group = net.ParseIP(vxlan.GetRemoteIp())
+	}
+	return link
+}
+
+// vxlanFromLink converts netlink VXLAN link into VXLAN configuration
+// (without the underlay, which is referenced by the interface alias).
+func vxlanFromLink(link *netlink.Vxlan) *interfaces.VxlanLink {
+	vxlan := &interfaces.VxlanLink{
+		Vni:      uint32(link.VxlanId),
+		DstPort:  uint32(link.Port),
+		Learning: link.Learning,
+		Ttl:      uint32(link.TTL),
+	}
+	if link.SrcAddr != nil && !link.SrcAddr.IsUnspecified() {
+		vxlan.LocalIp = link.SrcAddr.String()
+	}
+	if link.Group != nil && !link.Group.IsUnspecified() {
+		vxlan.RemoteIp = link.Group.String()
+	}
+	return vxlan
+}
+
+// AddGRE configures new GRE tunnel interface ("gre" for IPv4 and "ip6gre" for IPv6
+// tunnel endpoints). Underlay interface (referenced by host name) is optional.
+// The tunnel is configured with raw netlink request, since the netlink library
+// supports only IPv4 GRE tunnels.
+func (h *NetLinkHandler) AddGRE(greName string, gre *interfaces.GreLink, underlayIfName string) error {
+	var underlayIndex int
+	if underlayIfName != "" {
+		underlay, err := h.GetLinkByName(underlayIfName)
+		if err != nil {
+			return err
+		}
+		underlayIndex = underlay.Attrs().Index
+	}
+	req, kind, err := greLinkRequest(greName, gre, underlayIndex)
+	if err != nil {
+		return err
+	}
+	if _, err := req.Execute(unix.NETLINK_ROUTE, 0); err != nil {
+		return errors.Wrapf(err, "LinkAdd (%s=%s, remote=%s)", kind, greName, gre.GetRemoteIp())
+	}
+	return nil
+}
+
+// greLinkRequest prepares request to create GRE tunnel interface and returns it
+// together with the netdevice kind selected by the IP version of the remote endpoint.
+// Zero underlay index means that the tunnel is not bound to any underlay interface.
+func greLinkRequest(greName string, gre *interfaces.GreLink, underlayIndex int) (
+	req *nl.NetlinkRequest, kind string, err error) {
+	remote := net.ParseIP(gre.GetRemoteIp())
+	if remote == nil {
+		return nil, "", errors.Errorf("invalid GRE remote IP address: %q", gre.GetRemoteIp())
+	}
+	var local net.IP
+	if gre.GetLocalIp() != "" {
+		local = net.ParseIP(gre.GetLocalIp())
+	}
+	kind = greKind
+	if remote.To4() == nil {
+		kind = ip6greKind
+	}
+
+	req, data := newLinkInfoRequest(unix.NLM_F_CREATE|unix.NLM_F_EXCL, 0, greName, kind)
+	if kind == greKind {
+		if local != nil {
+			nl.NewRtAttrChild(data, nl.IFLA_GRE_LOCAL, []byte(local.To4()))
+		}
+		nl.NewRtAttrChild(data, nl.IFLA_GRE_REMOTE, []byte(remote.To4()))
+	} else {
+		if local != nil {
+			nl.NewRtAttrChild(data, nl.IFLA_GRE_LOCAL, []byte(local.To16()))
+		}
+		nl.NewRtAttrChild(data, nl.IFLA_GRE_REMOTE, []byte(remote.To16()))
+	}
+	var iflags, oflags uint16
+	if gre.GetInputKey() != 0 {
+		nl.NewRtAttrChild(data, nl.IFLA_GRE_IKEY, beUint32Attr(gre.GetInputKey()))
+		iflags |= uint16(nl.GRE_KEY)
+	}
+	if gre.GetOutputKey() != 0 {
+		nl.NewRtAttrChild(data, nl.IFLA_GRE_OKEY, beUint32Attr(gre.GetOutputKey()))
+		oflags |= uint16(nl.GRE_KEY)
+	}
+	nl.NewRtAttrChild(data, nl.IFLA_GRE_IFLAGS, beUint16Attr(iflags))
+	nl.NewRtAttrChild(data, nl.IFLA_GRE_OFLAGS, beUint16Attr(oflags))
+	nl.NewRtAttrChild(data, nl.IFLA_GRE_TTL, nl.Uint8Attr(uint8(gre.GetTtl())))
+	if underlayIndex != 0 {
+		nl.NewRtAttrChild(data, nl.IFLA_GRE_LINK, nl.Uint32Attr(uint32(underlayIndex)))
+	}
+	return req, kind, nil
+}
+
+// GetGRE returns configuration of the given GRE (or ip6gre) tunnel interface.
+// Underlay interface is not included (it is referenced by the logical name
+// stored in the interface alias).
+func (h *NetLinkHandler) GetGRE(greName string) (*interfaces.GreLink, error) {
+	attrs, err := h.getLinkInfoData(greName)
+	if err != nil {
+		return nil, err
+	}
+	return greFromLinkInfoData(attrs), nil
+}
+
+// greFromLinkInfoData converts GRE-specific link attributes into GRE configuration.
+func greFromLinkInfoData(attrs []syscall.NetlinkRouteAttr) *interfaces.GreLink {
+	gre := &interfaces.GreLink{}
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case nl.IFLA_GRE_LOCAL:
+			if ip := net.IP(attr.Value); !ip.IsUnspecified() {
+				gre.LocalIp = ip.String()
+			}
+		case nl.IFLA_GRE_REMOTE:
+			gre.RemoteIp = net.IP(attr.Value).String()
+		case nl.IFLA_GRE_IKEY:
+			gre.InputKey = binary.BigEndian.Uint32(attr.Value[0:4])
+		case nl.IFLA_GRE_OKEY:
+			gre.OutputKey = binary.BigEndian.Uint32(attr.Value[0:4])
+		case nl.IFLA_GRE_TTL:
+			gre.Ttl = uint32(attr.Value[0])
+		}
+	}
+	return gre
+}
+
+func beUint16Attr(v uint16) []byte {
+	bytes := make([]byte, 2)
+	binary.BigEndian.PutUint16(bytes, v)
+	return bytes
+}
+
+func beUint32Attr(v uint32) []byte {
+	bytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(bytes, v)
+	return bytes
+}
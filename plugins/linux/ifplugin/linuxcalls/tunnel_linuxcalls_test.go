@@ -0,0 +1,167 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+func TestVxlanRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		vxlan    *interfaces.VxlanLink
+		expVxlan *interfaces.VxlanLink
+	}{
+		{
+			name:  "IPv4 endpoints",
+			vxlan: &interfaces.VxlanLink{Vni: 100, LocalIp: "192.168.1.1", RemoteIp: "192.168.1.2", DstPort: 4790},
+		},
+		{
+			name:  "IPv6 remote with learning and TTL",
+			vxlan: &interfaces.VxlanLink{Vni: 1<<24 - 1, RemoteIp: "2001:db8::2", Learning: true, Ttl: 64, DstPort: 8472},
+		},
+		{
+			name:     "default destination port",
+			vxlan:    &interfaces.VxlanLink{Vni: 10, RemoteIp: "239.1.1.1"},
+			expVxlan: &interfaces.VxlanLink{Vni: 10, RemoteIp: "239.1.1.1", DstPort: DefaultVxlanPort},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			link := vxlanToLink("vxlan1", test.vxlan)
+			Expect(link.Attrs().Name).To(Equal("vxlan1"))
+			Expect(link.Type()).To(Equal("vxlan"))
+
+			expVxlan := test.expVxlan
+			if expVxlan == nil {
+				expVxlan = test.vxlan
+			}
+			vxlan := vxlanFromLink(link)
+			Expect(proto.Equal(vxlan, expVxlan)).To(BeTrue(), "retrieved: %v", vxlan)
+		})
+	}
+}
+
+func TestGRERoundTrip(t *testing.T) {
+	tests := []struct {
+		name          string
+		gre           *interfaces.GreLink
+		underlayIndex int
+		kind          string
+	}{
+		{
+			name: "IPv4 remote only",
+			gre:  &interfaces.GreLink{RemoteIp: "10.0.0.2"},
+			kind: greKind,
+		},
+		{
+			name: "IPv4 with keys and TTL",
+			gre: &interfaces.GreLink{LocalIp: "10.0.0.1", RemoteIp: "10.0.0.2",
+				InputKey: 100, OutputKey: 200, Ttl: 64},
+			underlayIndex: 5,
+			kind:          greKind,
+		},
+		{
+			name: "IPv6",
+			gre:  &interfaces.GreLink{LocalIp: "2001:db8::1", RemoteIp: "2001:db8::2", OutputKey: 1},
+			kind: ip6greKind,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req, kind, err := greLinkRequest("gre1", test.gre, test.underlayIndex)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kind).To(Equal(test.kind))
+
+			msg := req.Serialize()[unix.SizeofNlMsghdr:]
+			attrs, err := parseLinkInfoData(msg)
+			Expect(err).ToNot(HaveOccurred())
+			gre := greFromLinkInfoData(attrs)
+			Expect(proto.Equal(gre, test.gre)).To(BeTrue(), "retrieved: %v", gre)
+
+			// underlay is referenced only by the request
+			var underlayIndex int
+			for _, attr := range attrs {
+				if attr.Attr.Type == nl.IFLA_GRE_LINK {
+					underlayIndex = int(native.Uint32(attr.Value))
+				}
+			}
+			Expect(underlayIndex).To(Equal(test.underlayIndex))
+		})
+	}
+}
+
+func TestGRERequestKind(t *testing.T) {
+	RegisterTestingT(t)
+
+	req, _, err := greLinkRequest("gre1", &interfaces.GreLink{RemoteIp: "2001:db8::2"}, 0)
+	Expect(err).ToNot(HaveOccurred())
+	attrs, err := nl.ParseRouteAttr(req.Serialize()[unix.SizeofNlMsghdr+unix.SizeofIfInfomsg:])
+	Expect(err).ToNot(HaveOccurred())
+
+	var name, kind string
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case unix.IFLA_IFNAME:
+			name = string(attr.Value[:len(attr.Value)-1])
+		case unix.IFLA_LINKINFO:
+			infos, err := nl.ParseRouteAttr(attr.Value)
+			Expect(err).ToNot(HaveOccurred())
+			for _, info := range infos {
+				if info.Attr.Type == nl.IFLA_INFO_KIND {
+					kind = string(info.Value)
+				}
+			}
+		}
+	}
+	Expect(name).To(Equal("gre1"))
+	Expect(kind).To(Equal(ip6greKind))
+}
+
+func TestGRERequestWithInvalidRemote(t *testing.T) {
+	RegisterTestingT(t)
+
+	for _, remote := range []string{"", "10.0.0", "host"} {
+		_, _, err := greLinkRequest("gre1", &interfaces.GreLink{RemoteIp: remote}, 0)
+		Expect(err).To(HaveOccurred())
+	}
+}
+
+func TestParseLinkInfoDataWithoutInfo(t *testing.T) {
+	RegisterTestingT(t)
+
+	req := nl.NewNetlinkRequest(unix.RTM_NEWLINK, 0)
+	req.AddData(nl.NewIfInfomsg(unix.AF_UNSPEC))
+	req.AddData(nl.NewRtAttr(unix.IFLA_IFNAME, nl.ZeroTerminated("eth0")))
+	attrs, err := parseLinkInfoData(req.Serialize()[unix.SizeofNlMsghdr:])
+	Expect(err).ToNot(HaveOccurred())
+	Expect(attrs).To(BeEmpty())
+
+	_, err = parseLinkInfoData(make([]byte, unix.SizeofIfInfomsg-1))
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,371 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// WireGuard generic netlink API (see include/uapi/linux/wireguard.h).
+const (
+	wgGenlName    = "wireguard"
+	wgGenlVersion = 1
+
+	// WireguardKeyLen is the length of WireGuard keys in bytes.
+	WireguardKeyLen = 32
+
+	wgCmdGetDevice = 0
+	wgCmdSetDevice = 1
+
+	wgDeviceFReplacePeers = 1 << 0
+
+	wgDeviceAIfname     = 2
+	wgDeviceAPrivateKey = 3
+	wgDeviceAFlags      = 5
+	wgDeviceAListenPort = 6
+	wgDeviceAFwmark     = 7
+	wgDeviceAPeers      = 8
+
+	wgPeerFReplaceAllowedIPs = 1 << 1
+
+	wgPeerAPublicKey           = 1
+	wgPeerAPresharedKey        = 2
+	wgPeerAFlags               = 3
+	wgPeerAEndpoint            = 4
+	wgPeerAPersistentKeepalive = 5
+	wgPeerAAllowedIPs          = 9
+
+	wgAllowedIPAFamily   = 1
+	wgAllowedIPAIPAddr   = 2
+	wgAllowedIPACidrMask = 3
+
+	// NLA_F_NESTED flag of nested attributes (typed differently across netlink
+	// library versions) and mask to strip NLA_F_NESTED and NLA_F_NET_BYTEORDER
+	// from attribute type
+	nlaFNested  = 1 << 15
+	nlaTypeMask = 0x3fff
+)
+
+// AddWireguard configures new WireGuard interface.
+// Keys and peers are configured by SetWireguardDevice.
+func (h *NetLinkHandler) AddWireguard(wgName string) error {
+	link := &netlink.GenericLink{
+		LinkAttrs: newLinkAttrs(wgName),
+		LinkType:  wgGenlName,
+	}
+	if err := h.LinkAdd(link); err != nil {
+		return errors.Wrapf(err, "LinkAdd (wireguard=%s)", wgName)
+	}
+	return nil
+}
+
+// SetWireguardDevice (re)configures keys, listen port and peers of the given
+// WireGuard interface. Previously configured peers are replaced.
+func (h *NetLinkHandler) SetWireguardDevice(wgName string, wg *interfaces.WireguardLink) error {
+	family, err := h.GenlFamilyGet(wgGenlName)
+	if err != nil {
+		return errors.Wrapf(err, "GenlFamilyGet %s", wgGenlName)
+	}
+
+	req := nl.NewNetlinkRequest(int(family.ID), unix.NLM_F_ACK)
+	if err := wireguardDeviceRequest(req, wgName, wg); err != nil {
+		return err
+	}
+
+	if _, err := req.Execute(unix.NETLINK_GENERIC, 0); err != nil {
+		return errors.Wrapf(err, "WireGuard set device (wireguard=%s)", wgName)
+	}
+	return nil
+}
+
+// wireguardDeviceRequest fills the request to set keys, listen port and peers
+// of the given WireGuard interface.
+func wireguardDeviceRequest(req *nl.NetlinkRequest, wgName string, wg *interfaces.WireguardLink) error {
+	req.AddData(&nl.Genlmsg{
+		Command: wgCmdSetDevice,
+		Version: wgGenlVersion,
+	})
+	req.AddData(nl.NewRtAttr(wgDeviceAIfname, nl.ZeroTerminated(wgName)))
+	req.AddData(nl.NewRtAttr(wgDeviceAFlags, nl.Uint32Attr(wgDeviceFReplacePeers)))
+
+	// zero private key removes the key
+	privateKey, err := decodeWireguardKey(wg.GetPrivateKey())
+	if err != nil {
+		return errors.WithMessage(err, "invalid private key")
+	}
+	req.AddData(nl.NewRtAttr(wgDeviceAPrivateKey, privateKey))
+	if wg.GetListenPort() != 0 {
+		req.AddData(nl.NewRtAttr(wgDeviceAListenPort, nl.Uint16Attr(uint16(wg.GetListenPort()))))
+	}
+	req.AddData(nl.NewRtAttr(wgDeviceAFwmark, nl.Uint32Attr(wg.GetFwmark())))
+
+	peers := nl.NewRtAttr(wgDeviceAPeers|nlaFNested, nil)
+	for i, peer := range wg.GetPeers() {
+		peerAttr := nl.NewRtAttrChild(peers, i|nlaFNested, nil)
+		publicKey, err := decodeWireguardKey(peer.GetPublicKey())
+		if err != nil {
+			return errors.WithMessagef(err, "invalid public key of peer %d", i)
+		}
+		nl.NewRtAttrChild(peerAttr, wgPeerAPublicKey, publicKey)
+		nl.NewRtAttrChild(peerAttr, wgPeerAFlags, nl.Uint32Attr(wgPeerFReplaceAllowedIPs))
+		presharedKey, err := decodeWireguardKey(peer.GetPresharedKey())
+		if err != nil {
+			return errors.WithMessagef(err, "invalid pre-shared key of peer %d", i)
+		}
+		nl.NewRtAttrChild(peerAttr, wgPeerAPresharedKey, presharedKey)
+		if peer.GetEndpoint() != "" {
+			endpoint, err := encodeSockaddr(peer.GetEndpoint())
+			if err != nil {
+				return errors.WithMessagef(err, "invalid endpoint of peer %d", i)
+			}
+			nl.NewRtAttrChild(peerAttr, wgPeerAEndpoint, endpoint)
+		}
+		nl.NewRtAttrChild(peerAttr, wgPeerAPersistentKeepalive,
+			nl.Uint16Attr(uint16(peer.GetPersistentKeepalive())))
+		allowedIPs := nl.NewRtAttrChild(peerAttr, wgPeerAAllowedIPs|nlaFNested, nil)
+		for j, allowedIP := range peer.GetAllowedIps() {
+			_, ipNet, err := net.ParseCIDR(allowedIP)
+			if err != nil {
+				return errors.Wrapf(err, "invalid allowed IP of peer %d", i)
+			}
+			ipFamily, ip := unix.AF_INET, ipNet.IP.To4()
+			if ip == nil {
+				ipFamily, ip = unix.AF_INET6, ipNet.IP.To16()
+			}
+			ones, _ := ipNet.Mask.Size()
+			ipAttr := nl.NewRtAttrChild(allowedIPs, j|nlaFNested, nil)
+			nl.NewRtAttrChild(ipAttr, wgAllowedIPAFamily, nl.Uint16Attr(uint16(ipFamily)))
+			nl.NewRtAttrChild(ipAttr, wgAllowedIPAIPAddr, []byte(ip))
+			nl.NewRtAttrChild(ipAttr, wgAllowedIPACidrMask, nl.Uint8Attr(uint8(ones)))
+		}
+	}
+	req.AddData(peers)
+	return nil
+}
+
+// GetWireguardDevice returns the configuration of the given WireGuard interface.
+func (h *NetLinkHandler) GetWireguardDevice(wgName string) (*interfaces.WireguardLink, error) {
+	family, err := h.GenlFamilyGet(wgGenlName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "GenlFamilyGet %s", wgGenlName)
+	}
+
+	req := nl.NewNetlinkRequest(int(family.ID), unix.NLM_F_DUMP)
+	req.AddData(&nl.Genlmsg{
+		Command: wgCmdGetDevice,
+		Version: wgGenlVersion,
+	})
+	req.AddData(nl.NewRtAttr(wgDeviceAIfname, nl.ZeroTerminated(wgName)))
+
+	msgs, err := req.Execute(unix.NETLINK_GENERIC, 0)
+	if err != nil {
+		return nil, errors.Wrapf(err, "WireGuard get device (wireguard=%s)", wgName)
+	}
+
+	// device configuration may be split into multiple messages
+	wg := &interfaces.WireguardLink{}
+	for _, msg := range msgs {
+		if err := parseWireguardDevice(msg, wg); err != nil {
+			return nil, err
+		}
+	}
+	return wg, nil
+}
+
+// parseWireguardDevice parses one (possibly partial) generic netlink message
+// with WireGuard device configuration into wg.
+func parseWireguardDevice(msg []byte, wg *interfaces.WireguardLink) error {
+	if len(msg) < nl.SizeofGenlmsg {
+		return errors.Errorf("WireGuard message too short (%d bytes)", len(msg))
+	}
+	attrs, err := nl.ParseRouteAttr(msg[nl.SizeofGenlmsg:])
+	if err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		switch attr.Attr.Type & nlaTypeMask {
+		case wgDeviceAPrivateKey:
+			wg.PrivateKey = encodeWireguardKey(attr.Value)
+		case wgDeviceAListenPort:
+			wg.ListenPort = uint32(native.Uint16(attr.Value[0:2]))
+		case wgDeviceAFwmark:
+			wg.Fwmark = native.Uint32(attr.Value[0:4])
+		case wgDeviceAPeers:
+			if err := parseWireguardPeers(attr.Value, wg); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// parseWireguardPeers parses (possibly partial) list of peers.
+// A peer split between two messages is merged with the previously parsed one.
+func parseWireguardPeers(data []byte, wg *interfaces.WireguardLink) error {
+	peerAttrs, err := nl.ParseRouteAttr(data)
+	if err != nil {
+		return err
+	}
+	for _, peerAttr := range peerAttrs {
+		attrs, err := nl.ParseRouteAttr(peerAttr.Value)
+		if err != nil {
+			return err
+		}
+		peer := &interfaces.WireguardLink_Peer{}
+		for _, attr := range attrs {
+			switch attr.Attr.Type & nlaTypeMask {
+			case wgPeerAPublicKey:
+				peer.PublicKey = encodeWireguardKey(attr.Value)
+			case wgPeerAPresharedKey:
+				peer.PresharedKey = encodeWireguardKey(attr.Value)
+			case wgPeerAEndpoint:
+				peer.Endpoint = decodeSockaddr(attr.Value)
+			case wgPeerAPersistentKeepalive:
+				peer.PersistentKeepalive = uint32(native.Uint16(attr.Value[0:2]))
+			case wgPeerAAllowedIPs:
+				peer.AllowedIps, err = parseWireguardAllowedIPs(attr.Value)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if n := len(wg.Peers); n > 0 && wg.Peers[n-1].PublicKey == peer.PublicKey {
+			wg.Peers[n-1].AllowedIps = append(wg.Peers[n-1].AllowedIps, peer.AllowedIps...)
+			continue
+		}
+		wg.Peers = append(wg.Peers, peer)
+	}
+	return nil
+}
+
+func parseWireguardAllowedIPs(data []byte) (allowedIPs []string, err error) {
+	ipAttrs, err := nl.ParseRouteAttr(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, ipAttr := range ipAttrs {
+		attrs, err := nl.ParseRouteAttr(ipAttr.Value)
+		if err != nil {
+			return nil, err
+		}
+		var (
+			ip   net.IP
+			ones int
+		)
+		for _, attr := range attrs {
+			switch attr.Attr.Type & nlaTypeMask {
+			case wgAllowedIPAIPAddr:
+				ip = net.IP(attr.Value)
+			case wgAllowedIPACidrMask:
+				ones = int(attr.Value[0])
+			}
+		}
+		if ip == nil {
+			continue
+		}
+		ipNet := &net.IPNet{IP: ip, Mask: net.CIDRMask(ones, len(ip)*8)}
+		allowedIPs = append(allowedIPs, ipNet.String())
+	}
+	return allowedIPs, nil
+}
+
+// decodeWireguardKey decodes base64-encoded key. Empty key is returned as all-zero key.
+func decodeWireguardKey(key string) ([]byte, error) {
+	if key == "" {
+		return make([]byte, WireguardKeyLen), nil
+	}
+	bytes, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes) != WireguardKeyLen {
+		return nil, errors.Errorf("key has invalid length %d", len(bytes))
+	}
+	return bytes, nil
+}
+
+// encodeWireguardKey encodes key into base64. All-zero key is returned as empty string.
+func encodeWireguardKey(key []byte) string {
+	for _, b := range key {
+		if b != 0 {
+			return base64.StdEncoding.EncodeToString(key)
+		}
+	}
+	return ""
+}
+
+// encodeSockaddr encodes <IP>:<port> into struct sockaddr_in or sockaddr_in6.
+func encodeSockaddr(endpoint string) ([]byte, error) {
+	host, portStr, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return nil, errors.Errorf("invalid IP address %q", host)
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid port %q", portStr)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		sa := make([]byte, unix.SizeofSockaddrInet4)
+		native.PutUint16(sa[0:2], unix.AF_INET)
+		binary.BigEndian.PutUint16(sa[2:4], uint16(port))
+		copy(sa[4:8], ip4)
+		return sa, nil
+	}
+	sa := make([]byte, unix.SizeofSockaddrInet6)
+	native.PutUint16(sa[0:2], unix.AF_INET6)
+	binary.BigEndian.PutUint16(sa[2:4], uint16(port))
+	copy(sa[8:24], ip.To16())
+	return sa, nil
+}
+
+// decodeSockaddr decodes struct sockaddr_in or sockaddr_in6 into <IP>:<port>.
+func decodeSockaddr(sa []byte) string {
+	if len(sa) < 2 {
+		return ""
+	}
+	var ip net.IP
+	switch native.Uint16(sa[0:2]) {
+	case unix.AF_INET:
+		if len(sa) < unix.SizeofSockaddrInet4 {
+			return ""
+		}
+		ip = net.IP(sa[4:8])
+	case unix.AF_INET6:
+		if len(sa) < unix.SizeofSockaddrInet6 {
+			return ""
+		}
+		ip = net.IP(sa[8:24])
+	default:
+		return ""
+	}
+	port := binary.BigEndian.Uint16(sa[2:4])
+	return net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+)
+
+// testWireguardKey returns base64-encoded key with all bytes set to b.
+func testWireguardKey(b byte) string {
+	key := make([]byte, WireguardKeyLen)
+	for i := range key {
+		key[i] = b
+	}
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+// wireguardDeviceMsg returns generic netlink message (without the netlink
+// header) to set the given WireGuard device.
+func wireguardDeviceMsg(wg *interfaces.WireguardLink) []byte {
+	req := nl.NewNetlinkRequest(unix.GENL_ID_CTRL, 0)
+	Expect(wireguardDeviceRequest(req, "wg0", wg)).To(Succeed())
+	return req.Serialize()[unix.SizeofNlMsghdr:]
+}
+
+func TestWireguardDeviceRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		wg   *interfaces.WireguardLink
+	}{
+		{
+			name: "device without peers",
+			wg: &interfaces.WireguardLink{
+				PrivateKey: testWireguardKey(1),
+				ListenPort: 51820,
+			},
+		},
+		{
+			name: "device without private key",
+			wg: &interfaces.WireguardLink{
+				Fwmark: 0x100,
+			},
+		},
+		{
+			name: "peers with IPv4 and IPv6 endpoints",
+			wg: &interfaces.WireguardLink{
+				PrivateKey: testWireguardKey(1),
+				ListenPort: 51820,
+				Fwmark:     0x100,
+				Peers: []*interfaces.WireguardLink_Peer{
+					{
+						PublicKey:           testWireguardKey(2),
+						PresharedKey:        testWireguardKey(3),
+						Endpoint:            "192.168.1.1:51820",
+						PersistentKeepalive: 25,
+						AllowedIps:          []string{"10.0.0.0/24", "10.1.0.1/32"},
+					},
+					{
+						PublicKey:  testWireguardKey(4),
+						Endpoint:   "[2001:db8::1]:51821",
+						AllowedIps: []string{"fd00::/64", "0.0.0.0/0"},
+					},
+					{
+						PublicKey: testWireguardKey(5),
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			wg := &interfaces.WireguardLink{}
+			Expect(parseWireguardDevice(wireguardDeviceMsg(test.wg), wg)).To(Succeed())
+			Expect(proto.Equal(wg, test.wg)).To(BeTrue(), "parsed: %v", wg)
+		})
+	}
+}
+
+func TestWireguardDeviceNormalizedAllowedIPs(t *testing.T) {
+	RegisterTestingT(t)
+
+	msg := wireguardDeviceMsg(&interfaces.WireguardLink{
+		Peers: []*interfaces.WireguardLink_Peer{
+			{
+				PublicKey:  testWireguardKey(2),
+				AllowedIps: []string{"10.0.0.1/24", "fd00::1/64"},
+			},
+		},
+	})
+	wg := &interfaces.WireguardLink{}
+	Expect(parseWireguardDevice(msg, wg)).To(Succeed())
+	Expect(wg.Peers).To(HaveLen(1))
+	Expect(wg.Peers[0].AllowedIps).To(Equal([]string{"10.0.0.0/24", "fd00::/64"}))
+}
+
+func TestWireguardDeviceSplitPeer(t *testing.T) {
+	RegisterTestingT(t)
+
+	// kernel continues the peer with remaining allowed IPs in the next message
+	first := wireguardDeviceMsg(&interfaces.WireguardLink{
+		PrivateKey: testWireguardKey(1),
+		Peers: []*interfaces.WireguardLink_Peer{
+			{PublicKey: testWireguardKey(2), AllowedIps: []string{"10.0.0.0/24"}},
+		},
+	})
+	second := wireguardDeviceMsg(&interfaces.WireguardLink{
+		PrivateKey: testWireguardKey(1),
+		Peers: []*interfaces.WireguardLink_Peer{
+			{PublicKey: testWireguardKey(2), AllowedIps: []string{"10.1.0.0/24"}},
+			{PublicKey: testWireguardKey(3), AllowedIps: []string{"10.2.0.0/24"}},
+		},
+	})
+
+	wg := &interfaces.WireguardLink{}
+	Expect(parseWireguardDevice(first, wg)).To(Succeed())
+	Expect(parseWireguardDevice(second, wg)).To(Succeed())
+	Expect(proto.Equal(wg, &interfaces.WireguardLink{
+		PrivateKey: testWireguardKey(1),
+		Peers: []*interfaces.WireguardLink_Peer{
+			{PublicKey: testWireguardKey(2), AllowedIps: []string{"10.0.0.0/24", "10.1.0.0/24"}},
+			{PublicKey: testWireguardKey(3), AllowedIps: []string{"10.2.0.0/24"}},
+		},
+	})).To(BeTrue(), "parsed: %v", wg)
+}
+
+func TestWireguardDeviceRequestErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		wg   *interfaces.WireguardLink
+	}{
+		{
+			name: "private key is not base64",
+			wg:   &interfaces.WireguardLink{PrivateKey: "not a key"},
+		},
+		{
+			name: "private key too short",
+			wg:   &interfaces.WireguardLink{PrivateKey: base64.StdEncoding.EncodeToString([]byte("short"))},
+		},
+		{
+			name: "invalid public key of peer",
+			wg: &interfaces.WireguardLink{
+				Peers: []*interfaces.WireguardLink_Peer{{PublicKey: "?"}},
+			},
+		},
+		{
+			name: "endpoint without port",
+			wg: &interfaces.WireguardLink{
+				Peers: []*interfaces.WireguardLink_Peer{{PublicKey: testWireguardKey(2), Endpoint: "10.0.0.1"}},
+			},
+		},
+		{
+			name: "endpoint with host name",
+			wg: &interfaces.WireguardLink{
+				Peers: []*interfaces.WireguardLink_Peer{{PublicKey: testWireguardKey(2), Endpoint: "peer:51820"}},
+			},
+		},
+		{
+			name: "invalid allowed IP",
+			wg: &interfaces.WireguardLink{
+				Peers: []*interfaces.WireguardLink_Peer{{PublicKey: testWireguardKey(2), AllowedIps: []string{"10.0.0.1"}}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := nl.NewNetlinkRequest(unix.GENL_ID_CTRL, 0)
+			Expect(wireguardDeviceRequest(req, "wg0", test.wg)).ToNot(Succeed())
+		})
+	}
+}
+
+func TestWireguardSockaddr(t *testing.T) {
+	RegisterTestingT(t)
+
+	for _, endpoint := range []string{"10.0.0.1:51820", "[2001:db8::1]:1"} {
+		sa, err := encodeSockaddr(endpoint)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decodeSockaddr(sa)).To(Equal(endpoint))
+	}
+
+	// unknown address family and truncated address
+	Expect(decodeSockaddr(make([]byte, unix.SizeofSockaddrInet4))).To(BeEmpty())
+	sa, err := encodeSockaddr("[2001:db8::1]:1")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(decodeSockaddr(sa[:unix.SizeofSockaddrInet4])).To(BeEmpty())
+}
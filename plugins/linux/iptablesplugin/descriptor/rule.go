@@ -0,0 +1,441 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/utils/addrs"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
+	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
+	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+const (
+	// mark mask selecting all bits of the firewall mark
+	fullMarkMask = 0xffffffff
+
+	// maximum transport-layer port number
+	maxPort = 65535
+)
+
+// A list of non-retriable errors returned for invalid structured rules:
+var (
+	// ErrRuleInInterfaceInChain is returned when the incoming interface is matched in a chain
+	// traversed only by locally generated packets.
+	ErrRuleInInterfaceInChain = errors.New("incoming interface cannot be matched in OUTPUT and POSTROUTING chains")
+
+	// ErrRuleOutInterfaceInChain is returned when the outgoing interface is matched in a chain
+	// traversed by packets before the routing decision.
+	ErrRuleOutInterfaceInChain = errors.New("outgoing interface cannot be matched in INPUT and PREROUTING chains")
+
+	// ErrRuleProtocolForFamily is returned when ICMP is matched in an IPv6 chain or ICMPv6 in an IPv4 chain.
+	ErrRuleProtocolForFamily = errors.New("protocol is not valid for the IP version of the rule chain")
+
+	// ErrRuleAddressForFamily is returned when the IP version of the address does not match the rule chain.
+	ErrRuleAddressForFamily = errors.New("address is not valid for the IP version of the rule chain")
+
+	// ErrRulePortWithoutProtocol is returned when port is used without protocol that has ports.
+	ErrRulePortWithoutProtocol = errors.New("port can be used only with TCP, UDP or SCTP protocol")
+
+	// ErrRuleInvalidPortRange is returned when port range is not valid.
+	ErrRuleInvalidPortRange = errors.New("invalid port range")
+
+	// ErrRuleNATOutsideNATTable is returned when DNAT or SNAT target is used outside of the NAT table.
+	ErrRuleNATOutsideNATTable = errors.New("DNAT and SNAT targets can be used only in the NAT table")
+
+	// ErrRuleNATInvalidChain is returned when DNAT is used in a chain other than PREROUTING/OUTPUT
+	// or SNAT in a chain other than POSTROUTING/INPUT.
+	ErrRuleNATInvalidChain = errors.New("DNAT target is valid only in PREROUTING and OUTPUT chains, " +
+		"SNAT target only in POSTROUTING and INPUT chains")
+
+	// ErrRuleNATWithoutAddress is returned when DNAT or SNAT target is used without the address to translate to.
+	ErrRuleNATWithoutAddress = errors.New("DNAT and SNAT targets require address to translate to")
+
+	// ErrRuleMarkOutsideMangleTable is returned when MARK target is used outside of the MANGLE table.
+	ErrRuleMarkOutsideMangleTable = errors.New("MARK target can be used only in the MANGLE table")
+
+	// ErrRuleMarkWithoutValue is returned when MARK target is used without the mark to set.
+	ErrRuleMarkWithoutValue = errors.New("MARK target requires mark to set")
+
+	// ErrRuleJumpWithoutChain is returned when JUMP target is used without the chain to jump to.
+	ErrRuleJumpWithoutChain = errors.New("JUMP target requires chain to jump to")
+
+	// ErrRuleParamForTarget is returned when a target parameter is defined for a different target.
+	ErrRuleParamForTarget = errors.New("parameter is not applicable to the rule target")
+)
+
+// validateRule validates structured iptables rule of the given rule chain.
+func (d *RuleChainDescriptor) validateRule(rch *linux_iptables.RuleChain, rule *linux_iptables.Rule) error {
+	// interfaces
+	if rule.InInterface != "" && (rch.ChainType == linux_iptables.RuleChain_OUTPUT ||
+		rch.ChainType == linux_iptables.RuleChain_POSTROUTING) {
+		return kvs.NewInvalidValueError(ErrRuleInInterfaceInChain,
+			"chain_type", "structured_rules.in_interface")
+	}
+	if rule.OutInterface != "" && (rch.ChainType == linux_iptables.RuleChain_INPUT ||
+		rch.ChainType == linux_iptables.RuleChain_PREROUTING) {
+		return kvs.NewInvalidValueError(ErrRuleOutInterfaceInChain,
+			"chain_type", "structured_rules.out_interface")
+	}
+
+	// protocol and ports
+	ipv6 := rch.Protocol == linux_iptables.RuleChain_IPV6
+	if (rule.Protocol == linux_iptables.Rule_ICMP && ipv6) ||
+		(rule.Protocol == linux_iptables.Rule_ICMPV6 && !ipv6) {
+		return kvs.NewInvalidValueError(ErrRuleProtocolForFamily, "protocol", "structured_rules.protocol")
+	}
+	ports := []struct {
+		field     string
+		portRange *linux_iptables.Rule_PortRange
+	}{
+		{field: "structured_rules.source_port", portRange: rule.SourcePort},
+		{field: "structured_rules.destination_port", portRange: rule.DestinationPort},
+		{field: "structured_rules.to_port", portRange: rule.ToPort},
+	}
+	for _, port := range ports {
+		field, portRange := port.field, port.portRange
+		if portRange == nil {
+			continue
+		}
+		if !hasPorts(rule.Protocol) {
+			return kvs.NewInvalidValueError(ErrRulePortWithoutProtocol, "structured_rules.protocol", field)
+		}
+		if portRange.From == 0 || portRange.From > maxPort || portRange.To > maxPort ||
+			(portRange.To != 0 && portRange.To < portRange.From) {
+			return kvs.NewInvalidValueError(ErrRuleInvalidPortRange, field)
+		}
+	}
+
+	// addresses
+	for _, address := range ruleAddresses(rule) {
+		field, addr := "structured_rules."+address.field, address.addr
+		if addr == "" {
+			continue
+		}
+		err := d.addrAlloc.ValidateIPAddress(addr, "", field, netalloc.GWRefAllowed)
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(addr, netalloc_api.AllocRefPrefix) {
+			continue
+		}
+		if isIPv6, _ := addrs.IsIPv6(addr); isIPv6 != ipv6 {
+			return kvs.NewInvalidValueError(ErrRuleAddressForFamily, "protocol", field)
+		}
+	}
+
+	// target
+	isNAT := rule.Target == linux_iptables.Rule_DNAT || rule.Target == linux_iptables.Rule_SNAT
+	if !isNAT && (rule.ToAddress != "" || rule.ToPort != nil) {
+		return kvs.NewInvalidValueError(ErrRuleParamForTarget,
+			"structured_rules.target", "structured_rules.to_address", "structured_rules.to_port")
+	}
+	if rule.Target != linux_iptables.Rule_MARK && rule.SetMark != nil {
+		return kvs.NewInvalidValueError(ErrRuleParamForTarget, "structured_rules.target", "structured_rules.set_mark")
+	}
+	if rule.Target != linux_iptables.Rule_JUMP && rule.JumpChain != "" {
+		return kvs.NewInvalidValueError(ErrRuleParamForTarget, "structured_rules.target", "structured_rules.jump_chain")
+	}
+	switch rule.Target {
+	case linux_iptables.Rule_DNAT, linux_iptables.Rule_SNAT:
+		if rch.Table != linux_iptables.RuleChain_NAT {
+			return kvs.NewInvalidValueError(ErrRuleNATOutsideNATTable, "table", "structured_rules.target")
+		}
+		if !isAllowedNATChain(rule.Target, rch.ChainType) {
+			return kvs.NewInvalidValueError(ErrRuleNATInvalidChain, "chain_type", "structured_rules.target")
+		}
+		if rule.ToAddress == "" {
+			return kvs.NewInvalidValueError(ErrRuleNATWithoutAddress, "structured_rules.to_address")
+		}
+	case linux_iptables.Rule_MARK:
+		if rch.Table != linux_iptables.RuleChain_MANGLE {
+			return kvs.NewInvalidValueError(ErrRuleMarkOutsideMangleTable, "table", "structured_rules.target")
+		}
+		if rule.SetMark == nil {
+			return kvs.NewInvalidValueError(ErrRuleMarkWithoutValue, "structured_rules.set_mark")
+		}
+	case linux_iptables.Rule_JUMP:
+		if rule.JumpChain == "" {
+			return kvs.NewInvalidValueError(ErrRuleJumpWithoutChain, "structured_rules.jump_chain")
+		}
+	}
+	return nil
+}
+
+// ruleDependencies lists dependencies of the structured rule with the given index.
+func (d *RuleChainDescriptor) ruleDependencies(rch *linux_iptables.RuleChain, idx int,
+	rule *linux_iptables.Rule) (deps []kvs.Dependency) {
+	for _, address := range ruleAddresses(rule) {
+		if address.addr == "" {
+			continue
+		}
+		// netalloc reference must be allocated first
+		labelPrefix := fmt.Sprintf("structured_rules-%d-%s-", idx, address.field)
+		allocDep, hasAllocDep := d.addrAlloc.GetAddressAllocDep(address.addr, "", labelPrefix)
+		if hasAllocDep {
+			deps = append(deps, allocDep)
+		}
+	}
+	// chain to jump to must be created first
+	if rule.Target == linux_iptables.Rule_JUMP && rule.JumpChain != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: fmt.Sprintf("structured_rules-%d-jump_chain", idx),
+			Key:   linux_iptables.CustomChainKey(rch.Namespace, rch.Protocol, rch.Table, rule.JumpChain),
+		})
+	}
+	return deps
+}
+
+// renderRules returns all rules of the given rule chain in the iptables syntax,
+// i.e. rules defined as strings followed by rendered structured rules.
+func (d *RuleChainDescriptor) renderRules(rch *linux_iptables.RuleChain) ([]string, error) {
+	if len(rch.StructuredRules) == 0 {
+		return rch.Rules, nil
+	}
+	rules := make([]string, 0, len(rch.Rules)+len(rch.StructuredRules))
+	rules = append(rules, rch.Rules...)
+	for i, rule := range rch.StructuredRules {
+		ruleStr, err := d.renderRule(rch, rule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render structured rule #%d", i)
+		}
+		rules = append(rules, ruleStr)
+	}
+	return rules, nil
+}
+
+// renderRule renders structured rule into the iptables syntax.
+// The rule is rendered in the same form as printed by "iptables -S" so that it can be
+// compared with the retrieved rules.
+func (d *RuleChainDescriptor) renderRule(rch *linux_iptables.RuleChain, rule *linux_iptables.Rule) (string, error) {
+	var args []string
+
+	// addresses
+	if rule.Source != "" {
+		source, err := d.addrAlloc.GetOrParseIPAddress(rule.Source, "", ruleAddressForm(rule.Source))
+		if err != nil {
+			return "", err
+		}
+		args = append(args, "-s", source.String())
+	}
+	if rule.Destination != "" {
+		destination, err := d.addrAlloc.GetOrParseIPAddress(rule.Destination, "", ruleAddressForm(rule.Destination))
+		if err != nil {
+			return "", err
+		}
+		args = append(args, "-d", destination.String())
+	}
+
+	// interfaces
+	if rule.InInterface != "" {
+		hostName, err := d.hostIfName(rule.InInterface)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, "-i", hostName)
+	}
+	if rule.OutInterface != "" {
+		hostName, err := d.hostIfName(rule.OutInterface)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, "-o", hostName)
+	}
+
+	// protocol and ports
+	if rule.Protocol != linux_iptables.Rule_ANY {
+		protoName := protocolStr(rule.Protocol)
+		args = append(args, "-p", protoName)
+		if rule.SourcePort != nil || rule.DestinationPort != nil {
+			args = append(args, "-m", protoName)
+		}
+		if rule.SourcePort != nil {
+			args = append(args, "--sport", portRangeStr(rule.SourcePort, ":"))
+		}
+		if rule.DestinationPort != nil {
+			args = append(args, "--dport", portRangeStr(rule.DestinationPort, ":"))
+		}
+	}
+
+	// connection tracking state
+	if len(rule.ConnStates) > 0 {
+		args = append(args, "-m", "conntrack", "--ctstate", connStatesStr(rule.ConnStates))
+	}
+
+	// firewall mark
+	if rule.Mark != nil {
+		mark := fmt.Sprintf("0x%x", rule.Mark.Value)
+		if rule.Mark.Mask != 0 && rule.Mark.Mask != fullMarkMask {
+			mark += fmt.Sprintf("/0x%x", rule.Mark.Mask)
+		}
+		args = append(args, "-m", "mark", "--mark", mark)
+	}
+
+	// target
+	switch rule.Target {
+	case linux_iptables.Rule_DROP:
+		args = append(args, "-j", "DROP")
+	case linux_iptables.Rule_DNAT, linux_iptables.Rule_SNAT:
+		toAddr, err := d.addrAlloc.GetOrParseIPAddress(rule.ToAddress, "", netalloc_api.IPAddressForm_ADDR_ONLY)
+		if err != nil {
+			return "", err
+		}
+		to := toAddr.IP.String()
+		if rule.ToPort != nil {
+			if toAddr.IP.To4() == nil {
+				to = "[" + to + "]"
+			}
+			to += ":" + portRangeStr(rule.ToPort, "-")
+		}
+		if rule.Target == linux_iptables.Rule_DNAT {
+			args = append(args, "-j", "DNAT", "--to-destination", to)
+		} else {
+			args = append(args, "-j", "SNAT", "--to-source", to)
+		}
+	case linux_iptables.Rule_MARK:
+		mask := rule.SetMark.Mask
+		if mask == 0 {
+			mask = fullMarkMask
+		}
+		args = append(args, "-j", "MARK", "--set-xmark", fmt.Sprintf("0x%x/0x%x", rule.SetMark.Value, mask))
+	case linux_iptables.Rule_JUMP:
+		args = append(args, "-j", rule.JumpChain)
+	default:
+		args = append(args, "-j", "ACCEPT")
+	}
+
+	return strings.Join(args, " "), nil
+}
+
+// hostIfName returns host name of the Linux interface with the given logical name.
+func (d *RuleChainDescriptor) hostIfName(ifName string) (string, error) {
+	ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+	if !found || ifMeta == nil {
+		return "", errors.Errorf("failed to obtain metadata for interface %s", ifName)
+	}
+	return ifMeta.HostIfName, nil
+}
+
+// structuredRuleInterfaces returns logical names of all interfaces referenced by the structured rules.
+func structuredRuleInterfaces(rch *linux_iptables.RuleChain) (ifaces []string) {
+	for _, rule := range rch.StructuredRules {
+		for _, iface := range []string{rule.InInterface, rule.OutInterface} {
+			if iface != "" && !sliceContains(ifaces, iface) {
+				ifaces = append(ifaces, iface)
+			}
+		}
+	}
+	return ifaces
+}
+
+// ruleAddress is an address (or netalloc reference) used by a structured rule.
+type ruleAddress struct {
+	field string
+	addr  string
+}
+
+// ruleAddresses returns all addresses used by the given structured rule (incl. undefined).
+func ruleAddresses(rule *linux_iptables.Rule) []ruleAddress {
+	return []ruleAddress{
+		{field: "source", addr: rule.Source},
+		{field: "destination", addr: rule.Destination},
+		{field: "to_address", addr: rule.ToAddress},
+	}
+}
+
+// ruleAddressForm returns the form in which the given address or netalloc reference
+// is matched by the rule - referenced addresses are matched as single host addresses.
+func ruleAddressForm(addrOrAllocRef string) netalloc_api.IPAddressForm {
+	if strings.HasPrefix(addrOrAllocRef, netalloc_api.AllocRefPrefix) {
+		return netalloc_api.IPAddressForm_SINGLE_ADDR_NET
+	}
+	return netalloc_api.IPAddressForm_ADDR_NET
+}
+
+// isAllowedNATChain returns true if the given NAT target can be used in the given chain.
+func isAllowedNATChain(target linux_iptables.Rule_Target, chain linux_iptables.RuleChain_ChainType) bool {
+	switch chain {
+	case linux_iptables.RuleChain_CUSTOM:
+		// validity depends on the chain jumping to this one
+		return true
+	case linux_iptables.RuleChain_PREROUTING, linux_iptables.RuleChain_OUTPUT:
+		return target == linux_iptables.Rule_DNAT
+	case linux_iptables.RuleChain_POSTROUTING, linux_iptables.RuleChain_INPUT:
+		return target == linux_iptables.Rule_SNAT
+	}
+	return false
+}
+
+// hasPorts returns true if the given protocol has ports.
+func hasPorts(protocol linux_iptables.Rule_Protocol) bool {
+	switch protocol {
+	case linux_iptables.Rule_TCP, linux_iptables.Rule_UDP, linux_iptables.Rule_SCTP:
+		return true
+	}
+	return false
+}
+
+// protocolStr returns iptables name of the given protocol.
+func protocolStr(protocol linux_iptables.Rule_Protocol) string {
+	switch protocol {
+	case linux_iptables.Rule_TCP:
+		return "tcp"
+	case linux_iptables.Rule_UDP:
+		return "udp"
+	case linux_iptables.Rule_ICMP:
+		return "icmp"
+	case linux_iptables.Rule_ICMPV6:
+		return "ipv6-icmp"
+	case linux_iptables.Rule_SCTP:
+		return "sctp"
+	default:
+		return "all"
+	}
+}
+
+// portRangeStr returns port range in the iptables syntax, using the given separator
+// between the first and the last port.
+func portRangeStr(portRange *linux_iptables.Rule_PortRange, separator string) string {
+	if portRange.To == 0 || portRange.To == portRange.From {
+		return fmt.Sprint(portRange.From)
+	}
+	return fmt.Sprintf("%d%s%d", portRange.From, separator, portRange.To)
+}
+
+// connStatesStr returns connection tracking states in the iptables syntax,
+// ordered the same way as printed by iptables.
+func connStatesStr(states []linux_iptables.Rule_ConnState) string {
+	var strs []string
+	for _, state := range []linux_iptables.Rule_ConnState{
+		linux_iptables.Rule_INVALID,
+		linux_iptables.Rule_NEW,
+		linux_iptables.Rule_RELATED,
+		linux_iptables.Rule_ESTABLISHED,
+		linux_iptables.Rule_UNTRACKED,
+	} {
+		for _, s := range states {
+			if s == state {
+				strs = append(strs, state.String())
+				break
+			}
+		}
+	}
+	return strings.Join(strs, ",")
+}
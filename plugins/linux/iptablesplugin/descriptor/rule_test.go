@@ -0,0 +1,262 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	netalloc_mock "go.ligato.io/vpp-agent/v3/plugins/netalloc/mock"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+// ifPluginMock gives access to the index of Linux interfaces.
+type ifPluginMock struct {
+	ifIndex ifaceidx.LinuxIfMetadataIndexRW
+}
+
+func (p *ifPluginMock) GetInterfaceIndex() ifaceidx.LinuxIfMetadataIndex {
+	return p.ifIndex
+}
+
+func (p *ifPluginMock) SetNotifyService(notify func(notification *linux.Notification)) {}
+
+func newTestRuleChainDescriptor() *RuleChainDescriptor {
+	ifIndex := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndex.Put("veth1", &ifaceidx.LinuxIfMetadata{HostIfName: "eth0"})
+	ifIndex.Put("veth2", &ifaceidx.LinuxIfMetadata{HostIfName: "eth1"})
+	addrAlloc := netalloc_mock.NewMockNetAlloc()
+	addrAlloc.Allocate("net1", "veth1", "10.10.0.5/24", "10.10.0.1")
+	addrAlloc.Allocate("net2", "veth2", "fd00::5/64", "")
+	return &RuleChainDescriptor{
+		ifPlugin:  &ifPluginMock{ifIndex: ifIndex},
+		addrAlloc: addrAlloc,
+	}
+}
+
+func TestRenderRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		rch       *linux_iptables.RuleChain
+		rule      *linux_iptables.Rule
+		iptablesS string // as printed by "iptables -S" (or "ip6tables -S")
+	}{
+		{
+			name: "accept TCP port from network",
+			rch:  &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_INPUT},
+			rule: &linux_iptables.Rule{
+				Protocol:        linux_iptables.Rule_TCP,
+				InInterface:     "veth1",
+				Source:          "10.0.0.0/24",
+				DestinationPort: &linux_iptables.Rule_PortRange{From: 22},
+			},
+			iptablesS: "-A INPUT -s 10.0.0.0/24 -i eth0 -p tcp -m tcp --dport 22 -j ACCEPT",
+		},
+		{
+			name: "host address and port range",
+			rch:  &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_FORWARD},
+			rule: &linux_iptables.Rule{
+				Protocol:     linux_iptables.Rule_UDP,
+				InInterface:  "veth1",
+				OutInterface: "veth2",
+				Destination:  "192.168.1.1",
+				SourcePort:   &linux_iptables.Rule_PortRange{From: 1024, To: 65535},
+				Target:       linux_iptables.Rule_DROP,
+			},
+			iptablesS: "-A FORWARD -d 192.168.1.1/32 -i eth0 -o eth1 -p udp -m udp --sport 1024:65535 -j DROP",
+		},
+		{
+			name: "network given with host bits",
+			rch:  &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_INPUT},
+			rule: &linux_iptables.Rule{
+				Source: "10.0.0.5/8",
+				Target: linux_iptables.Rule_DROP,
+			},
+			iptablesS: "-A INPUT -s 10.0.0.0/8 -j DROP",
+		},
+		{
+			name: "netalloc reference",
+			rch:  &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_INPUT},
+			rule: &linux_iptables.Rule{
+				Source:      "alloc:net1/veth1",
+				Destination: "alloc:net1/veth1/GW",
+			},
+			iptablesS: "-A INPUT -s 10.10.0.5/32 -d 10.10.0.1/32 -j ACCEPT",
+		},
+		{
+			name: "IPv6 netalloc reference and ICMPv6",
+			rch: &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_INPUT,
+				Protocol: linux_iptables.RuleChain_IPV6},
+			rule: &linux_iptables.Rule{
+				Protocol: linux_iptables.Rule_ICMPV6,
+				Source:   "alloc:net2/veth2",
+			},
+			iptablesS: "-A INPUT -s fd00::5/128 -p ipv6-icmp -j ACCEPT",
+		},
+		{
+			name: "connection tracking states",
+			rch:  &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_INPUT},
+			rule: &linux_iptables.Rule{
+				ConnStates: []linux_iptables.Rule_ConnState{
+					linux_iptables.Rule_ESTABLISHED,
+					linux_iptables.Rule_RELATED,
+				},
+			},
+			iptablesS: "-A INPUT -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT",
+		},
+		{
+			name: "firewall mark",
+			rch:  &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_FORWARD},
+			rule: &linux_iptables.Rule{
+				Mark:   &linux_iptables.Rule_Mark{Value: 16, Mask: 0xff},
+				Target: linux_iptables.Rule_DROP,
+			},
+			iptablesS: "-A FORWARD -m mark --mark 0x10/0xff -j DROP",
+		},
+		{
+			name: "DNAT with port",
+			rch: &linux_iptables.RuleChain{Table: linux_iptables.RuleChain_NAT,
+				ChainType: linux_iptables.RuleChain_PREROUTING},
+			rule: &linux_iptables.Rule{
+				Protocol:        linux_iptables.Rule_TCP,
+				DestinationPort: &linux_iptables.Rule_PortRange{From: 80},
+				Target:          linux_iptables.Rule_DNAT,
+				ToAddress:       "alloc:net1/veth1",
+				ToPort:          &linux_iptables.Rule_PortRange{From: 8080},
+			},
+			iptablesS: "-A PREROUTING -p tcp -m tcp --dport 80 -j DNAT --to-destination 10.10.0.5:8080",
+		},
+		{
+			name: "IPv6 SNAT with port range",
+			rch: &linux_iptables.RuleChain{Table: linux_iptables.RuleChain_NAT,
+				ChainType: linux_iptables.RuleChain_POSTROUTING, Protocol: linux_iptables.RuleChain_IPV6},
+			rule: &linux_iptables.Rule{
+				Protocol:     linux_iptables.Rule_UDP,
+				OutInterface: "veth2",
+				Target:       linux_iptables.Rule_SNAT,
+				ToAddress:    "fd00::1",
+				ToPort:       &linux_iptables.Rule_PortRange{From: 10000, To: 20000},
+			},
+			iptablesS: "-A POSTROUTING -o eth1 -p udp -j SNAT --to-source [fd00::1]:10000-20000",
+		},
+		{
+			name: "set mark",
+			rch: &linux_iptables.RuleChain{Table: linux_iptables.RuleChain_MANGLE,
+				ChainType: linux_iptables.RuleChain_PREROUTING},
+			rule: &linux_iptables.Rule{
+				InInterface: "veth1",
+				Target:      linux_iptables.Rule_MARK,
+				SetMark:     &linux_iptables.Rule_Mark{Value: 1},
+			},
+			iptablesS: "-A PREROUTING -i eth0 -j MARK --set-xmark 0x1/0xffffffff",
+		},
+		{
+			name: "jump to custom chain",
+			rch: &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_CUSTOM,
+				ChainName: "MY-CHAIN"},
+			rule: &linux_iptables.Rule{
+				Protocol:  linux_iptables.Rule_SCTP,
+				Target:    linux_iptables.Rule_JUMP,
+				JumpChain: "OTHER-CHAIN",
+			},
+			iptablesS: "-A MY-CHAIN -p sctp -j OTHER-CHAIN",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestRuleChainDescriptor()
+			Expect(descriptor.validateRule(test.rch, test.rule)).To(Succeed())
+			rule, err := descriptor.renderRule(test.rch, test.rule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect("-A " + chainNameStr(test.rch) + " " + rule).To(Equal(test.iptablesS))
+		})
+	}
+}
+
+func TestRenderRuleWithUnknownInterface(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := newTestRuleChainDescriptor()
+	rch := &linux_iptables.RuleChain{ChainType: linux_iptables.RuleChain_INPUT}
+	_, err := descriptor.renderRule(rch, &linux_iptables.Rule{InInterface: "veth3"})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestRuleChainDependencies(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := newTestRuleChainDescriptor()
+	ns := &linux_namespace.NetNamespace{
+		Type:      linux_namespace.NetNamespace_MICROSERVICE,
+		Reference: "microservice1",
+	}
+	rch := &linux_iptables.RuleChain{
+		Name:      "rch1",
+		Namespace: ns,
+		Protocol:  linux_iptables.RuleChain_IPV6,
+		Table:     linux_iptables.RuleChain_MANGLE,
+		ChainType: linux_iptables.RuleChain_PREROUTING,
+		StructuredRules: []*linux_iptables.Rule{
+			{InInterface: "veth1", Target: linux_iptables.Rule_JUMP, JumpChain: "MY-CHAIN"},
+		},
+	}
+	Expect(descriptor.Dependencies("", rch)).To(Equal([]kvs.Dependency{
+		{
+			Label: ruleChainInterfaceDep + "-veth1",
+			Key:   ifmodel.InterfaceKey("veth1"),
+		},
+		{
+			Label: "structured_rules-0-jump_chain",
+			Key: linux_iptables.CustomChainKey(ns, linux_iptables.RuleChain_IPV6,
+				linux_iptables.RuleChain_MANGLE, "MY-CHAIN"),
+		},
+		{
+			Label: microserviceDep + "-microservice1",
+			Key:   linux_namespace.MicroserviceKey("microservice1"),
+		},
+	}))
+
+	// the chain jumped to is derived from the rule chain of the CUSTOM type
+	customRch := &linux_iptables.RuleChain{
+		Name:      "rch2",
+		Namespace: ns,
+		Protocol:  linux_iptables.RuleChain_IPV6,
+		Table:     linux_iptables.RuleChain_MANGLE,
+		ChainType: linux_iptables.RuleChain_CUSTOM,
+		ChainName: "MY-CHAIN",
+	}
+	derived := descriptor.DerivedValues("", customRch)
+	Expect(derived).To(HaveLen(1))
+	Expect(derived[0].Key).To(Equal(descriptor.Dependencies("", rch)[1].Key))
+	Expect(descriptor.DerivedValues("", rch)).To(BeEmpty())
+
+	// microservice dependency is added also without associated interfaces
+	Expect(descriptor.Dependencies("", &linux_iptables.RuleChain{Name: "rch3", Namespace: ns,
+		ChainType: linux_iptables.RuleChain_INPUT})).To(Equal([]kvs.Dependency{
+		{
+			Label: microserviceDep + "-microservice1",
+			Key:   linux_namespace.MicroserviceKey("microservice1"),
+		},
+	}))
+}
@@ -18,14 +18,18 @@ import (
 	"strings"
 
 	"github.com/golang/protobuf/proto"
+	prototypes "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"go.ligato.io/cn-infra/v2/logging"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/descriptor/adapter"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/linuxcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
 	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
+	netalloc_descr "go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor"
 	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
 	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
@@ -62,7 +66,9 @@ var (
 // RuleChainDescriptor teaches KVScheduler how to configure Linux iptables rule chains.
 type RuleChainDescriptor struct {
 	log             logging.Logger
+	ifPlugin        ifplugin.API
 	nsPlugin        nsplugin.API
+	addrAlloc       netalloc.AddressAllocator
 	scheduler       kvs.KVScheduler
 	ipTablesHandler linuxcalls.IPTablesAPI
 
@@ -74,31 +80,38 @@ type RuleChainDescriptor struct {
 
 // NewRuleChainDescriptor creates a new instance of the iptables RuleChain descriptor.
 func NewRuleChainDescriptor(
-	scheduler kvs.KVScheduler, ipTablesHandler linuxcalls.IPTablesAPI, nsPlugin nsplugin.API,
-	log logging.PluginLogger, goRoutinesCnt int, minRuleCountForPerfRuleAddition int) *kvs.KVDescriptor {
+	scheduler kvs.KVScheduler, ipTablesHandler linuxcalls.IPTablesAPI, ifPlugin ifplugin.API,
+	nsPlugin nsplugin.API, addrAlloc netalloc.AddressAllocator, log logging.PluginLogger,
+	goRoutinesCnt int, minRuleCountForPerfRuleAddition int) *kvs.KVDescriptor {
 
 	descrCtx := &RuleChainDescriptor{
 		scheduler:                       scheduler,
 		ipTablesHandler:                 ipTablesHandler,
+		ifPlugin:                        ifPlugin,
 		nsPlugin:                        nsPlugin,
+		addrAlloc:                       addrAlloc,
 		goRoutinesCnt:                   goRoutinesCnt,
 		minRuleCountForPerfRuleAddition: minRuleCountForPerfRuleAddition,
 		log:                             log.NewLogger("ipt-rulechain-descriptor"),
 	}
 
 	typedDescr := &adapter.RuleChainDescriptor{
-		Name:                 RuleChainDescriptorName,
-		NBKeyPrefix:          linux_iptables.ModelRuleChain.KeyPrefix(),
-		ValueTypeName:        linux_iptables.ModelRuleChain.ProtoName(),
-		KeySelector:          linux_iptables.ModelRuleChain.IsKeyValid,
-		KeyLabel:             linux_iptables.ModelRuleChain.StripKeyPrefix,
-		ValueComparator:      descrCtx.EquivalentRuleChains,
-		Validate:             descrCtx.Validate,
-		Create:               descrCtx.Create,
-		Delete:               descrCtx.Delete,
-		Retrieve:             descrCtx.Retrieve,
-		Dependencies:         descrCtx.Dependencies,
-		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+		Name:            RuleChainDescriptorName,
+		NBKeyPrefix:     linux_iptables.ModelRuleChain.KeyPrefix(),
+		ValueTypeName:   linux_iptables.ModelRuleChain.ProtoName(),
+		KeySelector:     linux_iptables.ModelRuleChain.IsKeyValid,
+		KeyLabel:        linux_iptables.ModelRuleChain.StripKeyPrefix,
+		ValueComparator: descrCtx.EquivalentRuleChains,
+		Validate:        descrCtx.Validate,
+		Create:          descrCtx.Create,
+		Delete:          descrCtx.Delete,
+		Retrieve:        descrCtx.Retrieve,
+		Dependencies:    descrCtx.Dependencies,
+		DerivedValues:   descrCtx.DerivedValues,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			netalloc_descr.IPAllocDescriptorName,
+		},
 	}
 	return adapter.NewRuleChainDescriptor(typedDescr)
 }
@@ -107,20 +120,37 @@ func NewRuleChainDescriptor(
 func (d *RuleChainDescriptor) EquivalentRuleChains(key string, oldRCh, newRch *linux_iptables.RuleChain) bool {
 
 	// first, compare everything except the rules
-	oldRules := oldRCh.Rules
-	newRules := newRch.Rules
+	oldRules, oldStructured := oldRCh.Rules, oldRCh.StructuredRules
+	newRules, newStructured := newRch.Rules, newRch.StructuredRules
 
-	oldRCh.Rules = nil
-	newRch.Rules = nil
-	defer func() {
-		oldRCh.Rules = oldRules
-		newRch.Rules = newRules
-	}()
+	oldRCh.Rules, oldRCh.StructuredRules = nil, nil
+	newRch.Rules, newRch.StructuredRules = nil, nil
+	equal := proto.Equal(oldRCh, newRch)
+	oldRCh.Rules, oldRCh.StructuredRules = oldRules, oldStructured
+	newRch.Rules, newRch.StructuredRules = newRules, newStructured
 
-	if !proto.Equal(oldRCh, newRch) {
+	if !equal {
 		return false
 	}
 
+	// structured rules are compared in the rendered form, which is also
+	// what gets retrieved
+	renderedOld, oldErr := d.renderRules(oldRCh)
+	renderedNew, newErr := d.renderRules(newRch)
+	if oldErr == nil && newErr == nil {
+		oldRules, newRules = renderedOld, renderedNew
+	} else {
+		// referenced interface or address is not available (yet)
+		if len(oldStructured) != len(newStructured) {
+			return false
+		}
+		for i := range oldStructured {
+			if !proto.Equal(oldStructured[i], newStructured[i]) {
+				return false
+			}
+		}
+	}
+
 	// compare rule count
 	if len(oldRules) != len(newRules) {
 		return false
@@ -164,6 +194,11 @@ func (d *RuleChainDescriptor) Validate(key string, rch *linux_iptables.RuleChain
 	if rch.ChainType == linux_iptables.RuleChain_CUSTOM && rch.DefaultPolicy != linux_iptables.RuleChain_NONE {
 		return kvs.NewInvalidValueError(ErrDefaultPolicyOnCustomChain, "default_policy")
 	}
+	for _, rule := range rch.StructuredRules {
+		if err = d.validateRule(rch, rule); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -185,6 +220,12 @@ func (d *RuleChainDescriptor) Create(key string, rch *linux_iptables.RuleChain)
 	// revert network namespace after returning
 	defer nsRevert()
 
+	// render structured rules (appended after the rules defined as strings)
+	rules, err := d.renderRules(rch)
+	if err != nil {
+		return nil, err
+	}
+
 	// create custom chain if needed
 	if rch.ChainType == linux_iptables.RuleChain_CUSTOM {
 		err := d.ipTablesHandler.CreateChain(protocolType(rch), tableNameStr(rch), chainNameStr(rch))
@@ -210,7 +251,7 @@ func (d *RuleChainDescriptor) Create(key string, rch *linux_iptables.RuleChain)
 	}
 
 	// append all rules
-	err = d.ipTablesHandler.AppendRules(protocolType(rch), tableNameStr(rch), chainNameStr(rch), rch.Rules...)
+	err = d.ipTablesHandler.AppendRules(protocolType(rch), tableNameStr(rch), chainNameStr(rch), rules...)
 	if err != nil {
 		return nil, errors.Errorf("Error by adding rules: %v", err)
 	}
@@ -258,18 +299,26 @@ func (d *RuleChainDescriptor) Delete(key string, rch *linux_iptables.RuleChain,
 func (d *RuleChainDescriptor) Dependencies(key string, rch *linux_iptables.RuleChain) []kvs.Dependency {
 	var deps []kvs.Dependency
 
-	// the associated interfaces must exist
-	if len(rch.Interfaces) > 0 {
-		for _, i := range rch.Interfaces {
-			deps = append(deps, kvs.Dependency{
-				Label: ruleChainInterfaceDep + "-" + i,
-				Key:   ifmodel.InterfaceKey(i),
-			})
+	// the associated interfaces (incl. those referenced by structured rules) must exist
+	ifaces := append([]string{}, rch.Interfaces...)
+	for _, i := range structuredRuleInterfaces(rch) {
+		if !sliceContains(ifaces, i) {
+			ifaces = append(ifaces, i)
 		}
-		return deps
+	}
+	for _, i := range ifaces {
+		deps = append(deps, kvs.Dependency{
+			Label: ruleChainInterfaceDep + "-" + i,
+			Key:   ifmodel.InterfaceKey(i),
+		})
 	}
 
-	// microservice must be available
+	// addresses referenced by structured rules must be allocated
+	for idx, rule := range rch.StructuredRules {
+		deps = append(deps, d.ruleDependencies(rch, idx, rule)...)
+	}
+
+	// microservice must be available (also when no interface is associated with the rule chain)
 	if rch.Namespace != nil && rch.Namespace.Type == linux_namespace.NetNamespace_MICROSERVICE {
 		deps = append(deps, kvs.Dependency{
 			Label: microserviceDep + "-" + rch.Namespace.Reference,
@@ -277,7 +326,19 @@ func (d *RuleChainDescriptor) Dependencies(key string, rch *linux_iptables.RuleC
 		})
 	}
 
-	return nil
+	return deps
+}
+
+// DerivedValues derives empty value under CustomChainKey for rule chain of the CUSTOM type.
+// It is used in dependencies of structured rules jumping to the chain.
+func (d *RuleChainDescriptor) DerivedValues(key string, rch *linux_iptables.RuleChain) (derValues []kvs.KeyValuePair) {
+	if rch.ChainType == linux_iptables.RuleChain_CUSTOM {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   linux_iptables.CustomChainKey(rch.Namespace, rch.Protocol, rch.Table, rch.ChainName),
+			Value: &prototypes.Empty{},
+		})
+	}
+	return derValues
 }
 
 // retrievedRuleChains is used as the return value sent via channel by retrieveRuleChains().
@@ -360,6 +421,11 @@ func (d *RuleChainDescriptor) retrieveRuleChains(
 		// build key-value pair for the retrieved rules
 		val := proto.Clone(corrrelRule).(*linux_iptables.RuleChain)
 		val.Rules = rules
+		val.StructuredRules = nil
+		if len(corrrelRule.StructuredRules) > 0 && d.EquivalentRuleChains(correlate[i].Key, corrrelRule, val) {
+			// retrieved rules match the rendered structured rules
+			val = proto.Clone(corrrelRule).(*linux_iptables.RuleChain)
+		}
 		retrieved.chains = append(retrieved.chains, adapter.RuleChainKVWithMetadata{
 			Key:    linux_iptables.RuleChainKey(val.Name),
 			Value:  val,
@@ -21,9 +21,11 @@ import (
 
 	"go.ligato.io/cn-infra/v2/infra"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/descriptor"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/iptablesplugin/linuxcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
 )
 
 const (
@@ -59,6 +61,8 @@ type Deps struct {
 	infra.PluginDeps
 	KVScheduler kvs.KVScheduler
 	NsPlugin    nsplugin.API
+	IfPlugin    ifplugin.API
+	AddrAlloc   netalloc.AddressAllocator
 }
 
 // Config holds the plugin configuration.
@@ -94,7 +98,8 @@ func (p *IPTablesPlugin) Init() error {
 
 	// init & register the descriptor
 	ruleChainDescriptor := descriptor.NewRuleChainDescriptor(
-		p.KVScheduler, p.iptHandler, p.NsPlugin, p.Log, config.GoRoutinesCnt, config.MinRuleCountForPerfRuleAddition)
+		p.KVScheduler, p.iptHandler, p.IfPlugin, p.NsPlugin, p.AddrAlloc, p.Log,
+		config.GoRoutinesCnt, config.MinRuleCountForPerfRuleAddition)
 
 	err = p.Deps.KVScheduler.RegisterKVDescriptor(ruleChainDescriptor)
 	if err != nil {
@@ -19,7 +19,9 @@ import (
 	"go.ligato.io/cn-infra/v2/logging"
 
 	"go.ligato.io/vpp-agent/v3/plugins/kvscheduler"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
 )
 
 // DefaultPlugin is a default instance of IPTablesPlugin.
@@ -32,6 +34,8 @@ func NewPlugin(opts ...Option) *IPTablesPlugin {
 	p.PluginName = "linux-iptablesplugin"
 	p.KVScheduler = &kvscheduler.DefaultPlugin
 	p.NsPlugin = &nsplugin.DefaultPlugin
+	p.IfPlugin = &ifplugin.DefaultPlugin
+	p.AddrAlloc = &netalloc.DefaultPlugin
 
 	for _, o := range opts {
 		o(p)
@@ -17,12 +17,14 @@ package descriptor
 import (
 	"bytes"
 	"net"
+	"strconv"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
 	prototypes "github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
 
 	"go.ligato.io/cn-infra/v2/logging"
 	"go.ligato.io/cn-infra/v2/utils/addrs"
@@ -39,6 +41,7 @@ import (
 	netalloc_descr "go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor"
 	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
 )
 
@@ -49,8 +52,12 @@ const (
 	// dependency labels
 	routeOutInterfaceDep       = "outgoing-interface-is-up"
 	routeOutInterfaceIPAddrDep = "outgoing-interface-has-ip-address"
+	routeAnchorInterfaceDep    = "anchor-interface-exists"
 	routeGwReachabilityDep     = "gw-reachable"
 	allocatedAddrAttached      = "allocated-addr-attached"
+
+	// maximum weight of a next hop of multipath route
+	maxNextHopWeight = 256
 )
 
 // A list of non-retriable errors:
@@ -65,6 +72,32 @@ var (
 	// ErrRouteLinkWithGw is returned when link-local Linux route has gateway address
 	// specified - it shouldn't be since destination is already neighbour by definition.
 	ErrRouteLinkWithGw = errors.New("Link-local Linux Route was defined with non-empty GW address")
+
+	// ErrRouteInLocalTable is returned when Linux Route of other type than LOCAL
+	// is configured to be added into the local routing table, which is maintained by the kernel.
+	ErrRouteInLocalTable = errors.New("only LOCAL Linux Route can be added into the local routing table")
+
+	// ErrRouteWithGwAndNextHops is returned when Linux Route is configured with both
+	// gateway address and a list of next hops.
+	ErrRouteWithGwAndNextHops = errors.New("Linux Route defined with both GW address and next hops")
+
+	// ErrRouteFirstNextHopInterface is returned when the first next hop of multipath
+	// Linux Route goes via other interface than the outgoing interface of the route.
+	ErrRouteFirstNextHopInterface = errors.New("Linux Route defined with the first next hop " +
+		"going via other than the outgoing interface")
+
+	// ErrNextHopWithInvalidWeight is returned when next hop of Linux Route is configured
+	// with weight outside of the allowed range.
+	ErrNextHopWithInvalidWeight = errors.Errorf("weight of Linux Route next hop must be in the range <1, %d>",
+		maxNextHopWeight)
+
+	// ErrDropRouteWithNextHop is returned when Linux Route which drops packets (blackhole,
+	// unreachable, prohibit) is configured with gateway, next hops, source address or onlink flag.
+	ErrDropRouteWithNextHop = errors.New("Linux Route of type BLACKHOLE, UNREACHABLE or PROHIBIT " +
+		"defined with GW address, next hops, source address or onlink flag")
+
+	// ErrRoutePrefWithoutIPv6 is returned when IPv6 preference is configured for IPv4 Linux Route.
+	ErrRoutePrefWithoutIPv6 = errors.New("IPv6 preference defined for non-IPv6 Linux Route")
 )
 
 // RouteDescriptor teaches KVScheduler how to configure Linux routes.
@@ -119,8 +152,13 @@ func NewRouteDescriptor(
 func (d *RouteDescriptor) EquivalentRoutes(key string, oldRoute, newRoute *linux_l3.Route) bool {
 	// attributes compared as usually:
 	if oldRoute.OutgoingInterface != newRoute.OutgoingInterface ||
-		oldRoute.Scope != newRoute.Scope ||
-		oldRoute.Metric != newRoute.Metric {
+		routeScope(oldRoute) != routeScope(newRoute) ||
+		oldRoute.Metric != newRoute.Metric ||
+		oldRoute.Table != newRoute.Table ||
+		oldRoute.Type != newRoute.Type ||
+		oldRoute.Mtu != newRoute.Mtu ||
+		oldRoute.Advmss != newRoute.Advmss ||
+		oldRoute.Ipv6Preference != newRoute.Ipv6Preference {
 		return false
 	}
 
@@ -128,22 +166,66 @@ func (d *RouteDescriptor) EquivalentRoutes(key string, oldRoute, newRoute *linux
 	if !equalNetworks(oldRoute.DstNetwork, newRoute.DstNetwork) {
 		return false
 	}
-	return equalAddrs(getGwAddr(oldRoute), getGwAddr(newRoute))
+	if (oldRoute.SrcAddr == "") != (newRoute.SrcAddr == "") ||
+		!equalAddrs(oldRoute.SrcAddr, newRoute.SrcAddr) {
+		return false
+	}
+	return equalNextHops(oldRoute, newRoute)
 }
 
 // Validate validates static route configuration.
 func (d *RouteDescriptor) Validate(key string, route *linux_l3.Route) (err error) {
-	if route.OutgoingInterface == "" {
+	dropRoute := isDropRoute(route)
+	if route.OutgoingInterface == "" && !dropRoute {
 		return kvs.NewInvalidValueError(ErrRouteWithoutInterface, "outgoing_interface")
 	}
+	if dropRoute && (route.GwAddr != "" || len(route.NextHops) > 0 || route.SrcAddr != "" || route.Onlink) {
+		return kvs.NewInvalidValueError(ErrDropRouteWithNextHop,
+			"type", "gw_addr", "next_hops", "src_addr", "onlink")
+	}
 	if route.Scope == linux_l3.Route_LINK && route.GwAddr != "" {
 		return kvs.NewInvalidValueError(ErrRouteLinkWithGw, "scope", "gw_addr")
 	}
+	if route.Table == unix.RT_TABLE_LOCAL && route.Type != linux_l3.Route_LOCAL {
+		return kvs.NewInvalidValueError(ErrRouteInLocalTable, "type", "table")
+	}
+	if route.GwAddr != "" && len(route.NextHops) > 0 {
+		return kvs.NewInvalidValueError(ErrRouteWithGwAndNextHops, "gw_addr", "next_hops")
+	}
+	for i, nextHop := range route.NextHops {
+		if i == 0 && nextHop.OutgoingInterface != "" && nextHop.OutgoingInterface != route.OutgoingInterface {
+			return kvs.NewInvalidValueError(ErrRouteFirstNextHopInterface,
+				"outgoing_interface", "next_hops.outgoing_interface")
+		}
+		if nextHop.Weight > maxNextHopWeight {
+			return kvs.NewInvalidValueError(ErrNextHopWithInvalidWeight, "next_hops.weight")
+		}
+		if nextHop.GwAddr == "" {
+			continue
+		}
+		err = d.addrAlloc.ValidateIPAddress(nextHop.GwAddr, nextHopInterface(route, nextHop),
+			"next_hops.gw_addr", netalloc.GWRefRequired)
+		if err != nil {
+			return err
+		}
+	}
+	if route.SrcAddr != "" && net.ParseIP(route.SrcAddr) == nil {
+		return kvs.NewInvalidValueError(errors.Errorf("invalid source address %s", route.SrcAddr), "src_addr")
+	}
+	if route.Ipv6Preference != linux_l3.Route_MEDIUM &&
+		!strings.HasPrefix(route.DstNetwork, netalloc_api.AllocRefPrefix) {
+		if ipv6, _ := addrs.IsIPv6(route.DstNetwork); !ipv6 {
+			return kvs.NewInvalidValueError(ErrRoutePrefWithoutIPv6, "ipv6_preference", "dst_network")
+		}
+	}
 	err = d.addrAlloc.ValidateIPAddress(route.DstNetwork, "", "dst_network",
 		netalloc.GWRefAllowed)
 	if err != nil {
 		return err
 	}
+	if dropRoute {
+		return nil
+	}
 	return d.addrAlloc.ValidateIPAddress(getGwAddr(route), route.OutgoingInterface,
 		"gw_addr", netalloc.GWRefRequired)
 }
@@ -156,46 +238,44 @@ func (d *RouteDescriptor) Create(key string, route *linux_l3.Route) (metadata in
 
 // Delete removes Linux route.
 func (d *RouteDescriptor) Delete(key string, route *linux_l3.Route, metadata interface{}) error {
-	return d.updateRoute(route, "delete", d.l3Handler.DelRoute)
+	return d.updateRoute(route, "delete",
+		func(route *netlink.Route, _ l3linuxcalls.RoutePref) error {
+			return d.l3Handler.DelRoute(route)
+		})
 }
 
-// Update is able to change route scope, metric and GW address.
+// Update is able to change route scope, metric, GW address, next hops and other
+// route attributes.
 func (d *RouteDescriptor) Update(key string, oldRoute, newRoute *linux_l3.Route, oldMetadata interface{}) (newMetadata interface{}, err error) {
 	err = d.updateRoute(newRoute, "modify", d.l3Handler.ReplaceRoute)
 	return nil, err
 }
 
 // updateRoute adds, modifies or deletes a Linux route.
-func (d *RouteDescriptor) updateRoute(route *linux_l3.Route, actionName string, actionClb func(route *netlink.Route) error) error {
+func (d *RouteDescriptor) updateRoute(route *linux_l3.Route, actionName string,
+	actionClb func(route *netlink.Route, pref l3linuxcalls.RoutePref) error) error {
 	var err error
 
 	// Prepare Netlink Route object
-	netlinkRoute := &netlink.Route{}
+	netlinkRoute := &netlink.Route{
+		Type: rtTypeFromNBToNetlink(route.Type),
+	}
 
-	// Get interface metadata
-	ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(route.OutgoingInterface)
-	if !found || ifMeta == nil {
-		err = errors.Errorf("failed to obtain metadata for interface %s", route.OutgoingInterface)
+	// Get the namespace and the routing table of the route
+	ns, table, err := d.routeNamespaceAndTable(route)
+	if err != nil {
 		d.log.Error(err)
 		return err
 	}
+	netlinkRoute.Table = table
 
-	// set link index
-	netlinkRoute.LinkIndex = ifMeta.LinuxIfIndex
-
-	// set routing table
-	if ifMeta.VrfMasterIf != "" {
-		// - route depends on interface having an IP address
-		// - IP address depends on the interface already being in the VRF
-		// - VRF assignment depends on the VRF device being configured
-		// => conclusion: VRF device is configured at this point
-		vrfMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifMeta.VrfMasterIf)
-		if !found || vrfMeta == nil {
-			err = errors.Errorf("failed to obtain metadata for VRF device %s", ifMeta.VrfMasterIf)
+	// set link index (route which drops packets has no outgoing interface)
+	if !isDropRoute(route) {
+		netlinkRoute.LinkIndex, err = d.getLinuxIfIndex(route.OutgoingInterface)
+		if err != nil {
 			d.log.Error(err)
 			return err
 		}
-		netlinkRoute.Table = int(vrfMeta.VrfDevRT)
 	}
 
 	// set destination network
@@ -217,9 +297,44 @@ func (d *RouteDescriptor) updateRoute(route *linux_l3.Route, actionName string,
 		}
 		netlinkRoute.Gw = gwAddr.IP
 	}
+	if route.Onlink && len(route.NextHops) == 0 {
+		netlinkRoute.Flags |= unix.RTNH_F_ONLINK
+	}
+
+	// set next hops of multipath route
+	for _, nextHop := range route.NextHops {
+		nhInterface := nextHopInterface(route, nextHop)
+		nhLinkIndex, err := d.getLinuxIfIndex(nhInterface)
+		if err != nil {
+			d.log.Error(err)
+			return err
+		}
+		netlinkNextHop := &netlink.NexthopInfo{
+			LinkIndex: nhLinkIndex,
+			Hops:      int(nextHopWeight(nextHop)) - 1,
+		}
+		if nextHop.GwAddr != "" {
+			gwAddr, err := d.addrAlloc.GetOrParseIPAddress(nextHop.GwAddr, nhInterface,
+				netalloc_api.IPAddressForm_ADDR_ONLY)
+			if err != nil {
+				d.log.Error(err)
+				return err
+			}
+			netlinkNextHop.Gw = gwAddr.IP
+		}
+		if nextHop.Onlink || route.Onlink {
+			netlinkNextHop.Flags |= unix.RTNH_F_ONLINK
+		}
+		netlinkRoute.MultiPath = append(netlinkRoute.MultiPath, netlinkNextHop)
+	}
+
+	// set preferred source address
+	if route.SrcAddr != "" {
+		netlinkRoute.Src = net.ParseIP(route.SrcAddr)
+	}
 
 	// set route scope
-	scope, err := rtScopeFromNBToNetlink(route.Scope)
+	scope, err := rtScopeFromNBToNetlink(routeScope(route))
 	if err != nil {
 		d.log.Error(err)
 		return err
@@ -229,9 +344,13 @@ func (d *RouteDescriptor) updateRoute(route *linux_l3.Route, actionName string,
 	// set route metric
 	netlinkRoute.Priority = int(route.Metric)
 
+	// set route metrics (path attributes)
+	netlinkRoute.MTU = int(route.Mtu)
+	netlinkRoute.AdvMSS = int(route.Advmss)
+
 	// move to the namespace of the associated interface
 	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
-	revertNs, err := d.nsPlugin.SwitchToNamespace(nsCtx, ifMeta.Namespace)
+	revertNs, err := d.nsPlugin.SwitchToNamespace(nsCtx, ns)
 	if err != nil {
 		err = errors.Errorf("failed to switch namespace: %v", err)
 		d.log.Error(err)
@@ -240,7 +359,7 @@ func (d *RouteDescriptor) updateRoute(route *linux_l3.Route, actionName string,
 	defer revertNs()
 
 	// update route in the interface namespace
-	err = actionClb(netlinkRoute)
+	err = actionClb(netlinkRoute, rtPrefFromNBToNetlink(route.Ipv6Preference))
 	if err != nil {
 		err = errors.Errorf("failed to %s linux route: %v", actionName, err)
 		d.log.Error(err)
@@ -250,9 +369,71 @@ func (d *RouteDescriptor) updateRoute(route *linux_l3.Route, actionName string,
 	return nil
 }
 
+// routeNamespaceAndTable returns the namespace and the routing table
+// where the given route is (to be) configured.
+func (d *RouteDescriptor) routeNamespaceAndTable(route *linux_l3.Route) (
+	ns *namespace.NetNamespace, table int, err error) {
+
+	table = unix.RT_TABLE_MAIN
+	if route.Type == linux_l3.Route_LOCAL {
+		table = unix.RT_TABLE_LOCAL
+	}
+	if route.OutgoingInterface != "" {
+		// Get interface metadata
+		ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(route.OutgoingInterface)
+		if !found || ifMeta == nil {
+			return nil, 0, errors.Errorf("failed to obtain metadata for interface %s",
+				route.OutgoingInterface)
+		}
+		ns = ifMeta.Namespace
+		if ifMeta.VrfMasterIf != "" {
+			// - route depends on interface having an IP address
+			// - IP address depends on the interface already being in the VRF
+			// - VRF assignment depends on the VRF device being configured
+			// => conclusion: VRF device is configured at this point
+			// (route which drops packets is only re-tried until VRF device is available)
+			vrfMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifMeta.VrfMasterIf)
+			if !found || vrfMeta == nil {
+				return nil, 0, errors.Errorf("failed to obtain metadata for VRF device %s",
+					ifMeta.VrfMasterIf)
+			}
+			table = int(vrfMeta.VrfDevRT)
+		}
+	}
+	if route.Table != 0 {
+		// explicitly selected routing table
+		table = int(route.Table)
+	}
+	return ns, table, nil
+}
+
+// getLinuxIfIndex returns index of the given interface inside its namespace.
+func (d *RouteDescriptor) getLinuxIfIndex(ifName string) (int, error) {
+	ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+	if !found || ifMeta == nil {
+		return 0, errors.Errorf("failed to obtain metadata for interface %s", ifName)
+	}
+	return ifMeta.LinuxIfIndex, nil
+}
+
 // Dependencies lists dependencies for a Linux route.
 func (d *RouteDescriptor) Dependencies(key string, route *linux_l3.Route) []kvs.Dependency {
 	var dependencies []kvs.Dependency
+	if isDropRoute(route) {
+		// the interface only selects the namespace (and VRF) of the route
+		if route.OutgoingInterface != "" {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: routeAnchorInterfaceDep,
+				Key:   ifmodel.InterfaceKey(route.OutgoingInterface),
+			})
+		}
+		allocDep, hasAllocDep := d.addrAlloc.GetAddressAllocDep(route.DstNetwork, "",
+			"dst_network-")
+		if hasAllocDep {
+			dependencies = append(dependencies, allocDep)
+		}
+		return dependencies
+	}
 	// the outgoing interface must exist and be UP
 	if route.OutgoingInterface != "" {
 		dependencies = append(dependencies, kvs.Dependency{
@@ -266,46 +447,91 @@ func (d *RouteDescriptor) Dependencies(key string, route *linux_l3.Route) []kvs.
 	if hasAllocDep {
 		dependencies = append(dependencies, allocDep)
 	}
+	// GW must be allocated and routable
+	dependencies = append(dependencies, d.gwDependencies(route.GwAddr, route.OutgoingInterface,
+		route.Onlink, "gw_addr-", "")...)
+	if route.OutgoingInterface != "" {
+		// route also requires the interface to be in the L3 mode (have at least one IP address assigned)
+		dependencies = append(dependencies, kvs.Dependency{
+			Label: routeOutInterfaceIPAddrDep,
+			AnyOf: kvs.AnyOfDependency{
+				KeyPrefixes: []string{
+					ifmodel.InterfaceAddressPrefix(route.OutgoingInterface),
+				},
+			},
+		})
+	}
+	// the same is required for every next hop of multipath route
+	for i, nextHop := range route.NextHops {
+		nhInterface := nextHopInterface(route, nextHop)
+		labelSuffix := "-next-hop-" + strconv.Itoa(i)
+		if nhInterface != route.OutgoingInterface {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: routeOutInterfaceDep + labelSuffix,
+				Key:   ifmodel.InterfaceStateKey(nhInterface, true),
+			})
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: routeOutInterfaceIPAddrDep + labelSuffix,
+				AnyOf: kvs.AnyOfDependency{
+					KeyPrefixes: []string{
+						ifmodel.InterfaceAddressPrefix(nhInterface),
+					},
+				},
+			})
+		}
+		dependencies = append(dependencies, d.gwDependencies(nextHop.GwAddr, nhInterface,
+			nextHop.Onlink || route.Onlink, "next_hops-"+strconv.Itoa(i)+"-gw_addr-", labelSuffix)...)
+	}
+	return dependencies
+}
+
+// gwDependencies lists dependencies for a gateway of Linux route reached via the given interface.
+// Gateway which is netalloc reference must be allocated first and unless the onlink
+// flag is set, the gateway must be reachable via the interface.
+func (d *RouteDescriptor) gwDependencies(gwAddr, outIface string, onlink bool,
+	allocLabelPrefix, labelSuffix string) []kvs.Dependency {
+	var dependencies []kvs.Dependency
 	// if GW is netalloc reference, then the address must be allocated first
-	allocDep, hasAllocDep = d.addrAlloc.GetAddressAllocDep(route.GwAddr, route.OutgoingInterface,
-		"gw_addr-")
+	allocDep, hasAllocDep := d.addrAlloc.GetAddressAllocDep(gwAddr, outIface, allocLabelPrefix)
 	if hasAllocDep {
 		dependencies = append(dependencies, allocDep)
 	}
 	// GW must be routable
-	network, iface, _, isRef, _ := d.addrAlloc.ParseAddressAllocRef(route.GwAddr, route.OutgoingInterface)
+	network, iface, _, isRef, _ := d.addrAlloc.ParseAddressAllocRef(gwAddr, outIface)
 	if isRef {
 		// GW is netalloc reference
-		dependencies = append(dependencies, kvs.Dependency{
-			Label: routeGwReachabilityDep,
-			AnyOf: kvs.AnyOfDependency{
-				KeyPrefixes: []string{
-					netalloc_api.NeighGwKey(network, iface),
-					linux_l3.StaticLinkLocalRouteKey(
-						d.addrAlloc.CreateAddressAllocRef(network, iface, true),
-						route.OutgoingInterface),
+		if !onlink {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: routeGwReachabilityDep + labelSuffix,
+				AnyOf: kvs.AnyOfDependency{
+					KeyPrefixes: []string{
+						netalloc_api.NeighGwKey(network, iface),
+						linux_l3.StaticLinkLocalRouteKey(
+							d.addrAlloc.CreateAddressAllocRef(network, iface, true),
+							outIface),
+					},
 				},
-			},
-		})
+			})
+		}
 		dependencies = append(dependencies, kvs.Dependency{
-			Label: allocatedAddrAttached,
+			Label: allocatedAddrAttached + labelSuffix,
 			Key: ifmodel.InterfaceAddressKey(
-				route.OutgoingInterface, d.addrAlloc.CreateAddressAllocRef(network, "", false),
+				outIface, d.addrAlloc.CreateAddressAllocRef(network, "", false),
 				netalloc_api.IPAddressSource_ALLOC_REF),
 		})
-	} else if gwAddr := net.ParseIP(getGwAddr(route)); gwAddr != nil && !gwAddr.IsUnspecified() {
+	} else if gwIP := net.ParseIP(gwAddr); gwIP != nil && !gwIP.IsUnspecified() && !onlink {
 		// GW is not netalloc reference but an actual IP
 		dependencies = append(dependencies, kvs.Dependency{
-			Label: routeGwReachabilityDep,
+			Label: routeGwReachabilityDep + labelSuffix,
 			AnyOf: kvs.AnyOfDependency{
 				KeyPrefixes: []string{
-					ifmodel.InterfaceAddressPrefix(route.OutgoingInterface),
-					linux_l3.StaticLinkLocalRoutePrefix(route.OutgoingInterface),
+					ifmodel.InterfaceAddressPrefix(outIface),
+					linux_l3.StaticLinkLocalRoutePrefix(outIface),
 				},
 				KeySelector: func(key string) bool {
 					dstAddr, ifName, isRouteKey := linux_l3.ParseStaticLinkLocalRouteKey(key)
-					if isRouteKey && ifName == route.OutgoingInterface {
-						if _, dstNet, err := net.ParseCIDR(dstAddr); err == nil && dstNet.Contains(gwAddr) {
+					if isRouteKey && ifName == outIface {
+						if _, dstNet, err := net.ParseCIDR(dstAddr); err == nil && dstNet.Contains(gwIP) {
 							// GW address is neighbour as told by another link-local route
 							return true
 						}
@@ -313,7 +539,7 @@ func (d *RouteDescriptor) Dependencies(key string, route *linux_l3.Route) []kvs.
 					}
 					ifName, address, source, _, isAddrKey := ifmodel.ParseInterfaceAddressKey(key)
 					if isAddrKey && source != netalloc_api.IPAddressSource_ALLOC_REF {
-						if _, network, err := net.ParseCIDR(address); err == nil && network.Contains(gwAddr) {
+						if _, network, err := net.ParseCIDR(address); err == nil && network.Contains(gwIP) {
 							// GW address is inside the local network of the outgoing interface
 							// as given by the assigned IP address
 							return true
@@ -324,24 +550,13 @@ func (d *RouteDescriptor) Dependencies(key string, route *linux_l3.Route) []kvs.
 			},
 		})
 	}
-	if route.OutgoingInterface != "" {
-		// route also requires the interface to be in the L3 mode (have at least one IP address assigned)
-		dependencies = append(dependencies, kvs.Dependency{
-			Label: routeOutInterfaceIPAddrDep,
-			AnyOf: kvs.AnyOfDependency{
-				KeyPrefixes: []string{
-					ifmodel.InterfaceAddressPrefix(route.OutgoingInterface),
-				},
-			},
-		})
-	}
 	return dependencies
 }
 
 // DerivedValues derives empty value under StaticLinkLocalRouteKey if route is link-local.
 // It is used in dependencies for network reachability of a route gateway (see above).
 func (d *RouteDescriptor) DerivedValues(key string, route *linux_l3.Route) (derValues []kvs.KeyValuePair) {
-	if route.Scope == linux_l3.Route_LINK {
+	if route.Scope == linux_l3.Route_LINK && !isDropRoute(route) {
 		derValues = append(derValues, kvs.KeyValuePair{
 			Key:   linux_l3.StaticLinkLocalRouteKey(route.DstNetwork, route.OutgoingInterface),
 			Value: &prototypes.Empty{},
@@ -373,6 +588,16 @@ func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) ([]a
 		route := proto.Clone(kv.Value).(*linux_l3.Route)
 		route.DstNetwork = dstNetwork
 		route.GwAddr = gwAddr
+		for _, nextHop := range route.NextHops {
+			if nextHop.GwAddr == "" {
+				continue
+			}
+			parsed, err = d.addrAlloc.GetOrParseIPAddress(nextHop.GwAddr,
+				nextHopInterface(kv.Value, nextHop), netalloc_api.IPAddressForm_ADDR_ONLY)
+			if err == nil {
+				nextHop.GwAddr = parsed.IP.String()
+			}
+		}
 		key := models.Key(route)
 		expCfg[key] = route
 		nbCfg[key] = kv.Value
@@ -391,19 +616,25 @@ func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) ([]a
 			// route not configured by the agent
 			continue
 		}
+		routeDetails.Route.Scope = scope
+		if isDropRoute(routeDetails.Route) {
+			found := d.correlateDropRoute(routeDetails.Route, routeDetails.Meta, expCfg)
+			if !found && routeDetails.Meta.Namespace != nil {
+				// outside of the default namespace the route cannot be represented
+				// without the interface selecting the namespace
+				continue
+			}
+		}
+		if len(routeDetails.Route.NextHops) > 1 {
+			d.correlateMultipathRoute(routeDetails.Route, routeDetails.Meta, expCfg)
+		}
+		key := models.Key(routeDetails.Route)
 		route := adapter.RouteKVWithMetadata{
-			Key: linux_l3.RouteKey(routeDetails.Route.DstNetwork, routeDetails.Route.OutgoingInterface),
-			Value: &linux_l3.Route{
-				OutgoingInterface: routeDetails.Route.OutgoingInterface,
-				Scope:             scope,
-				DstNetwork:        routeDetails.Route.DstNetwork,
-				GwAddr:            routeDetails.Route.GwAddr,
-				Metric:            routeDetails.Route.Metric,
-			},
+			Key:    key,
+			Value:  routeDetails.Route,
 			Origin: kvs.UnknownOrigin, // let the scheduler to determine the origin
 		}
 
-		key := linux_l3.RouteKey(routeDetails.Route.DstNetwork, routeDetails.Route.OutgoingInterface)
 		if expCfg, hasExpCfg := expCfg[key]; hasExpCfg {
 			if d.EquivalentRoutes(key, route.Value, expCfg) {
 				route.Value = nbCfg[key]
@@ -417,6 +648,60 @@ func (d *RouteDescriptor) Retrieve(correlate []adapter.RouteKVWithMetadata) ([]a
 	return values, nil
 }
 
+// correlateDropRoute finds expected route which drops packets that matches
+// the retrieved one (by destination, type, namespace and routing table) and copies
+// over the interface (selecting the namespace) and the routing table
+// as defined by the expected configuration.
+func (d *RouteDescriptor) correlateDropRoute(route *linux_l3.Route, meta *l3linuxcalls.RouteMeta,
+	expCfg map[string]*linux_l3.Route) (found bool) {
+
+	for _, expRoute := range expCfg {
+		if expRoute.Type != route.Type || !equalNetworks(expRoute.DstNetwork, route.DstNetwork) ||
+			!d.inNamespaceAndTable(expRoute, meta) {
+			continue
+		}
+		route.OutgoingInterface = expRoute.OutgoingInterface
+		route.Table = expRoute.Table
+		return true
+	}
+	return false
+}
+
+// correlateMultipathRoute finds expected multipath route that matches the retrieved
+// one (by destination, type, set of next hops, namespace and routing table) and
+// copies over the outgoing interface and the routing table as defined by the expected
+// configuration. Retrieved multipath route is associated with the interface
+// of the first next hop as dumped by the kernel, which does not have to be
+// the outgoing interface of the expected route (when the order of next hops
+// is not preserved).
+func (d *RouteDescriptor) correlateMultipathRoute(route *linux_l3.Route, meta *l3linuxcalls.RouteMeta,
+	expCfg map[string]*linux_l3.Route) (found bool) {
+
+	for _, expRoute := range expCfg {
+		if expRoute.Type != route.Type || !equalNetworks(expRoute.DstNetwork, route.DstNetwork) ||
+			!equalNextHops(expRoute, route) || !d.inNamespaceAndTable(expRoute, meta) {
+			continue
+		}
+		for _, nextHop := range route.NextHops {
+			nextHop.OutgoingInterface = nextHopInterface(route, nextHop)
+			if nextHop.OutgoingInterface == expRoute.OutgoingInterface {
+				nextHop.OutgoingInterface = ""
+			}
+		}
+		route.OutgoingInterface = expRoute.OutgoingInterface
+		route.Table = expRoute.Table
+		return true
+	}
+	return false
+}
+
+// inNamespaceAndTable returns true if the given expected route is (to be) configured
+// in the namespace and the routing table of the retrieved route.
+func (d *RouteDescriptor) inNamespaceAndTable(expRoute *linux_l3.Route, meta *l3linuxcalls.RouteMeta) bool {
+	ns, table, err := d.routeNamespaceAndTable(expRoute)
+	return err == nil && uint32(table) == meta.Table && proto.Equal(ns, meta.Namespace)
+}
+
 // rtScopeFromNBToNetlink convert Route scope from NB configuration
 // to the corresponding Netlink constant.
 func rtScopeFromNBToNetlink(scope linux_l3.Route_Scope) (netlink.Scope, error) {
@@ -449,6 +734,134 @@ func rtScopeFromNetlinkToNB(scope netlink.Scope) (linux_l3.Route_Scope, error) {
 	return 0, ErrRouteWithUndefinedScope
 }
 
+// rtTypeFromNBToNetlink converts Route type from NB configuration
+// to the corresponding Netlink constant.
+func rtTypeFromNBToNetlink(routeType linux_l3.Route_RouteType) int {
+	switch routeType {
+	case linux_l3.Route_LOCAL:
+		return unix.RTN_LOCAL
+	case linux_l3.Route_BLACKHOLE:
+		return unix.RTN_BLACKHOLE
+	case linux_l3.Route_UNREACHABLE:
+		return unix.RTN_UNREACHABLE
+	case linux_l3.Route_PROHIBIT:
+		return unix.RTN_PROHIBIT
+	}
+	return unix.RTN_UNICAST
+}
+
+// rtPrefFromNBToNetlink converts IPv6 route preference from NB configuration
+// to the corresponding Netlink constant.
+func rtPrefFromNBToNetlink(pref linux_l3.Route_IPv6Preference) l3linuxcalls.RoutePref {
+	switch pref {
+	case linux_l3.Route_HIGH:
+		return l3linuxcalls.RoutePrefHigh
+	case linux_l3.Route_LOW:
+		return l3linuxcalls.RoutePrefLow
+	}
+	return l3linuxcalls.RoutePrefMedium
+}
+
+// routeScope returns the scope of the given route, handling the case when it is
+// left undefined for other than UNICAST route.
+func routeScope(route *linux_l3.Route) linux_l3.Route_Scope {
+	if route.Scope != linux_l3.Route_UNDEFINED {
+		return route.Scope
+	}
+	switch route.Type {
+	case linux_l3.Route_LOCAL:
+		return linux_l3.Route_HOST
+	case linux_l3.Route_BLACKHOLE, linux_l3.Route_UNREACHABLE, linux_l3.Route_PROHIBIT:
+		return linux_l3.Route_GLOBAL
+	}
+	return route.Scope
+}
+
+// isDropRoute returns true if the given route drops packets
+// (i.e. has neither outgoing interface nor gateway).
+func isDropRoute(route *linux_l3.Route) bool {
+	switch route.Type {
+	case linux_l3.Route_BLACKHOLE, linux_l3.Route_UNREACHABLE, linux_l3.Route_PROHIBIT:
+		return true
+	}
+	return false
+}
+
+// routeNextHops returns next hops of the given route. Next hop of single-path route
+// is given by the GW address and the onlink flag of the route itself.
+// Route-level onlink flag applies to all next hops and weight of the only next hop
+// has no meaning (such route is stored by the kernel as single-path).
+func routeNextHops(route *linux_l3.Route) []*linux_l3.Route_NextHop {
+	if len(route.NextHops) == 0 {
+		return []*linux_l3.Route_NextHop{{
+			GwAddr: route.GwAddr,
+			Onlink: route.Onlink,
+		}}
+	}
+	var nextHops []*linux_l3.Route_NextHop
+	for _, nextHop := range route.NextHops {
+		nextHop = proto.Clone(nextHop).(*linux_l3.Route_NextHop)
+		nextHop.Onlink = nextHop.Onlink || route.Onlink
+		if len(route.NextHops) == 1 {
+			nextHop.Weight = 0
+		}
+		nextHops = append(nextHops, nextHop)
+	}
+	return nextHops
+}
+
+// nextHopInterface returns outgoing interface of the given next hop.
+func nextHopInterface(route *linux_l3.Route, nextHop *linux_l3.Route_NextHop) string {
+	if nextHop.OutgoingInterface == "" {
+		return route.OutgoingInterface
+	}
+	return nextHop.OutgoingInterface
+}
+
+// nextHopWeight returns weight of the given next hop, handling the case when
+// it is left undefined.
+func nextHopWeight(nextHop *linux_l3.Route_NextHop) uint32 {
+	if nextHop.Weight == 0 {
+		return 1
+	}
+	return nextHop.Weight
+}
+
+// equalNextHops compares next hops of two routes for equality.
+// Next hops are compared as unordered sets - the kernel does not necessarily
+// preserve the order in which the next hops of a multipath route were configured.
+func equalNextHops(route1, route2 *linux_l3.Route) bool {
+	nextHops1 := routeNextHops(route1)
+	nextHops2 := routeNextHops(route2)
+	if len(nextHops1) != len(nextHops2) {
+		return false
+	}
+	matched := make([]bool, len(nextHops2))
+	for _, nh1 := range nextHops1 {
+		var found bool
+		for j, nh2 := range nextHops2 {
+			if !matched[j] && equalNextHop(route1, nh1, route2, nh2) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// equalNextHop compares next hops of two routes for equality.
+func equalNextHop(route1 *linux_l3.Route, nh1 *linux_l3.Route_NextHop,
+	route2 *linux_l3.Route, nh2 *linux_l3.Route_NextHop) bool {
+	return nextHopInterface(route1, nh1) == nextHopInterface(route2, nh2) &&
+		nextHopWeight(nh1) == nextHopWeight(nh2) &&
+		nh1.Onlink == nh2.Onlink &&
+		equalAddrs(getNextHopGwAddr(route1, nh1), getNextHopGwAddr(route2, nh2))
+}
+
 // equalAddrs compares two IP addresses for equality.
 func equalAddrs(addr1, addr2 string) bool {
 	if strings.HasPrefix(addr1, netalloc_api.AllocRefPrefix) {
@@ -488,3 +901,12 @@ func getGwAddr(route *linux_l3.Route) string {
 	}
 	return route.GwAddr
 }
+
+// getNextHopGwAddr returns the GW address chosen for the given next hop, handling
+// the cases when it is left undefined.
+func getNextHopGwAddr(route *linux_l3.Route, nextHop *linux_l3.Route_NextHop) string {
+	return getGwAddr(&linux_l3.Route{
+		DstNetwork: route.DstNetwork,
+		GwAddr:     nextHop.GwAddr,
+	})
+}
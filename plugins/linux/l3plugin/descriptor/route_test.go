@@ -0,0 +1,212 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"golang.org/x/sys/unix"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	l3linuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
+	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+)
+
+// ifPluginMock gives access to the index of Linux interfaces.
+type ifPluginMock struct {
+	ifIndex ifaceidx.LinuxIfMetadataIndexRW
+}
+
+func (p *ifPluginMock) GetInterfaceIndex() ifaceidx.LinuxIfMetadataIndex {
+	return p.ifIndex
+}
+
+func (p *ifPluginMock) SetNotifyService(notify func(notification *linux.Notification)) {}
+
+func TestEquivalentRoutes(t *testing.T) {
+	multipathRoute := &linux_l3.Route{
+		OutgoingInterface: "veth1",
+		DstNetwork:        "10.20.0.0/16",
+		NextHops: []*linux_l3.Route_NextHop{
+			{GwAddr: "10.0.0.1"},
+			{GwAddr: "10.1.0.1", OutgoingInterface: "veth2", Weight: 2},
+		},
+	}
+	tests := []struct {
+		name       string
+		oldRoute   *linux_l3.Route
+		newRoute   *linux_l3.Route
+		equivalent bool
+	}{
+		{
+			name:       "destination network with host bits",
+			oldRoute:   &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "10.20.0.1/16", GwAddr: "10.0.0.1"},
+			newRoute:   &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "10.20.0.0/16", GwAddr: "10.0.0.1"},
+			equivalent: true,
+		},
+		{
+			name:       "undefined gateway",
+			oldRoute:   &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "fd00::/64"},
+			newRoute:   &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "fd00::/64", GwAddr: "::"},
+			equivalent: true,
+		},
+		{
+			name:       "default scope of blackhole route",
+			oldRoute:   &linux_l3.Route{DstNetwork: "10.0.0.0/8", Type: linux_l3.Route_BLACKHOLE},
+			newRoute:   &linux_l3.Route{DstNetwork: "10.0.0.0/8", Type: linux_l3.Route_BLACKHOLE, Scope: linux_l3.Route_GLOBAL},
+			equivalent: true,
+		},
+		{
+			name:     "different MTU",
+			oldRoute: &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "10.20.0.0/16", Mtu: 1500},
+			newRoute: &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "10.20.0.0/16", Mtu: 1400},
+		},
+		{
+			name:     "different IPv6 preference",
+			oldRoute: &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "fd00::/64"},
+			newRoute: &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "fd00::/64", Ipv6Preference: linux_l3.Route_HIGH},
+		},
+		{
+			name:     "added source address",
+			oldRoute: &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "10.20.0.0/16"},
+			newRoute: &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "10.20.0.0/16", SrcAddr: "10.0.0.5"},
+		},
+		{
+			name:     "single next hop given as gateway",
+			oldRoute: &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "10.20.0.0/16", GwAddr: "10.0.0.1", Onlink: true},
+			newRoute: &linux_l3.Route{OutgoingInterface: "veth1", DstNetwork: "10.20.0.0/16",
+				NextHops: []*linux_l3.Route_NextHop{{GwAddr: "10.0.0.1", Weight: 5, Onlink: true}}},
+			equivalent: true,
+		},
+		{
+			name:     "next hops in different order",
+			oldRoute: multipathRoute,
+			newRoute: &linux_l3.Route{
+				OutgoingInterface: "veth1",
+				DstNetwork:        "10.20.0.0/16",
+				NextHops: []*linux_l3.Route_NextHop{
+					{GwAddr: "10.1.0.1", OutgoingInterface: "veth2", Weight: 2},
+					{GwAddr: "10.0.0.1", OutgoingInterface: "veth1", Weight: 1},
+				},
+			},
+			equivalent: true,
+		},
+		{
+			name:     "next hop with different weight",
+			oldRoute: multipathRoute,
+			newRoute: &linux_l3.Route{
+				OutgoingInterface: "veth1",
+				DstNetwork:        "10.20.0.0/16",
+				NextHops: []*linux_l3.Route_NextHop{
+					{GwAddr: "10.0.0.1", Weight: 2},
+					{GwAddr: "10.1.0.1", OutgoingInterface: "veth2", Weight: 2},
+				},
+			},
+		},
+		{
+			name:     "next hop repeated instead of another",
+			oldRoute: multipathRoute,
+			newRoute: &linux_l3.Route{
+				OutgoingInterface: "veth1",
+				DstNetwork:        "10.20.0.0/16",
+				NextHops: []*linux_l3.Route_NextHop{
+					{GwAddr: "10.0.0.1"},
+					{GwAddr: "10.0.0.1"},
+				},
+			},
+		},
+		{
+			name:     "removed next hop",
+			oldRoute: multipathRoute,
+			newRoute: &linux_l3.Route{
+				OutgoingInterface: "veth1",
+				DstNetwork:        "10.20.0.0/16",
+				NextHops:          []*linux_l3.Route_NextHop{{GwAddr: "10.0.0.1"}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := &RouteDescriptor{}
+			Expect(descriptor.EquivalentRoutes("", test.oldRoute, test.newRoute)).To(Equal(test.equivalent))
+			Expect(descriptor.EquivalentRoutes("", test.newRoute, test.oldRoute)).To(Equal(test.equivalent))
+		})
+	}
+}
+
+func TestCorrelateMultipathRoute(t *testing.T) {
+	RegisterTestingT(t)
+
+	ifIndex := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndex.Put("veth1", &ifaceidx.LinuxIfMetadata{HostIfName: "eth0", LinuxIfIndex: 2})
+	ifIndex.Put("veth2", &ifaceidx.LinuxIfMetadata{HostIfName: "eth1", LinuxIfIndex: 3})
+	descriptor := &RouteDescriptor{ifPlugin: &ifPluginMock{ifIndex: ifIndex}}
+
+	expRoute := &linux_l3.Route{
+		OutgoingInterface: "veth1",
+		DstNetwork:        "10.20.0.0/16",
+		Scope:             linux_l3.Route_GLOBAL,
+		NextHops: []*linux_l3.Route_NextHop{
+			{GwAddr: "10.0.0.1"},
+			{GwAddr: "10.1.0.1", OutgoingInterface: "veth2", Weight: 2},
+		},
+	}
+	expCfg := map[string]*linux_l3.Route{models.Key(expRoute): expRoute}
+
+	// next hops dumped by the kernel in the reverse order
+	retrieved := &linux_l3.Route{
+		OutgoingInterface: "veth2",
+		DstNetwork:        "10.20.0.0/16",
+		Scope:             linux_l3.Route_GLOBAL,
+		NextHops: []*linux_l3.Route_NextHop{
+			{GwAddr: "10.1.0.1", Weight: 2},
+			{GwAddr: "10.0.0.1", OutgoingInterface: "veth1", Weight: 1},
+		},
+	}
+	meta := &l3linuxcalls.RouteMeta{Table: unix.RT_TABLE_MAIN}
+
+	// route in other routing table is not correlated
+	otherTable := proto.Clone(retrieved).(*linux_l3.Route)
+	Expect(descriptor.correlateMultipathRoute(otherTable, &l3linuxcalls.RouteMeta{Table: 10}, expCfg)).To(BeFalse())
+	Expect(proto.Equal(otherTable, retrieved)).To(BeTrue())
+
+	Expect(descriptor.correlateMultipathRoute(retrieved, meta, expCfg)).To(BeTrue())
+	Expect(models.Key(retrieved)).To(Equal(models.Key(expRoute)))
+	Expect(proto.Equal(retrieved, &linux_l3.Route{
+		OutgoingInterface: "veth1",
+		DstNetwork:        "10.20.0.0/16",
+		Scope:             linux_l3.Route_GLOBAL,
+		NextHops: []*linux_l3.Route_NextHop{
+			{GwAddr: "10.1.0.1", OutgoingInterface: "veth2", Weight: 2},
+			{GwAddr: "10.0.0.1", Weight: 1},
+		},
+	})).To(BeTrue(), "correlated: %v", retrieved)
+	Expect(descriptor.EquivalentRoutes("", retrieved, expRoute)).To(BeTrue())
+
+	// route with different next hops is not correlated
+	retrieved.NextHops[1].GwAddr = "10.0.0.2"
+	retrieved.OutgoingInterface = "veth2"
+	retrieved.NextHops[0].OutgoingInterface = ""
+	retrieved.NextHops[1].OutgoingInterface = "veth1"
+	Expect(descriptor.correlateMultipathRoute(retrieved, meta, expCfg)).To(BeFalse())
+	Expect(retrieved.OutgoingInterface).To(Equal("veth2"))
+}
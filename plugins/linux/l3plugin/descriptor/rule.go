@@ -0,0 +1,341 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/descriptor/adapter"
+	l3linuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/l3plugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+const (
+	// RuleDescriptorName is the name of the descriptor for Linux policy routing rules.
+	RuleDescriptorName = "linux-rule"
+
+	// dependency labels
+	ruleInInterfaceDep  = "incoming-interface-exists"
+	ruleOutInterfaceDep = "outgoing-interface-exists"
+	ruleMicroserviceDep = "microservice-available"
+
+	// range of priorities available for the rules configured by the agent
+	// (0, 32766 and 32767 are reserved for the default rules)
+	minRulePriority = 1
+	maxRulePriority = 32765
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrRuleWithInvalidPriority is returned when Linux Rule is configured with priority
+	// outside of the allowed range.
+	ErrRuleWithInvalidPriority = errors.Errorf("Linux Rule priority must be in the range <%d, %d>",
+		minRulePriority, maxRulePriority)
+
+	// ErrRuleWithInvalidPrefix is returned when Linux Rule is configured with source
+	// or destination prefix that cannot be parsed.
+	ErrRuleWithInvalidPrefix = errors.New("Linux Rule defined with invalid IP prefix")
+
+	// ErrRuleWithInvalidFamily is returned when source or destination prefix
+	// of Linux Rule is not from the address family of the rule.
+	ErrRuleWithInvalidFamily = errors.New("Linux Rule defined with prefix not matching the address family")
+
+	// ErrRuleTableWithoutLookup is returned when routing table is defined for Linux Rule
+	// with other action than TABLE.
+	ErrRuleTableWithoutLookup = errors.New("Linux Rule defined with routing table but without the TABLE action")
+)
+
+// RuleDescriptor teaches KVScheduler how to configure Linux policy routing rules.
+type RuleDescriptor struct {
+	log       logging.Logger
+	l3Handler l3linuxcalls.NetlinkAPI
+	ifPlugin  ifplugin.API
+	nsPlugin  nsplugin.API
+	scheduler kvs.KVScheduler
+}
+
+// NewRuleDescriptor creates a new instance of the Rule descriptor.
+func NewRuleDescriptor(
+	scheduler kvs.KVScheduler, ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	l3Handler l3linuxcalls.NetlinkAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &RuleDescriptor{
+		scheduler: scheduler,
+		l3Handler: l3Handler,
+		ifPlugin:  ifPlugin,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("rule-descriptor"),
+	}
+	typedDescr := &adapter.RuleDescriptor{
+		Name:                 RuleDescriptorName,
+		NBKeyPrefix:          linux_l3.ModelRule.KeyPrefix(),
+		ValueTypeName:        linux_l3.ModelRule.ProtoName(),
+		KeySelector:          linux_l3.ModelRule.IsKeyValid,
+		KeyLabel:             linux_l3.ModelRule.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentRules,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewRuleDescriptor(typedDescr)
+}
+
+// EquivalentRules compares two Linux policy routing rules.
+// Address family, priority and namespace are not compared - they are part
+// of the key which is already given to be the same for the two values.
+func (d *RuleDescriptor) EquivalentRules(key string, oldRule, newRule *linux_l3.Rule) bool {
+	if oldRule.IncomingInterface != newRule.IncomingInterface ||
+		oldRule.OutgoingInterface != newRule.OutgoingInterface ||
+		oldRule.Fwmark != newRule.Fwmark ||
+		oldRule.Fwmask != newRule.Fwmask ||
+		oldRule.Action != newRule.Action ||
+		ruleTable(oldRule) != ruleTable(newRule) {
+		return false
+	}
+	return equalOptNetworks(oldRule.From, newRule.From) &&
+		equalOptNetworks(oldRule.To, newRule.To)
+}
+
+// Validate validates policy routing rule configuration.
+func (d *RuleDescriptor) Validate(key string, rule *linux_l3.Rule) error {
+	if rule.Priority < minRulePriority || rule.Priority > maxRulePriority {
+		return kvs.NewInvalidValueError(ErrRuleWithInvalidPriority, "priority")
+	}
+	for _, prefix := range []struct{ field, value string }{{"from", rule.From}, {"to", rule.To}} {
+		if prefix.value == "" {
+			continue
+		}
+		ip, _, err := net.ParseCIDR(prefix.value)
+		if err != nil {
+			return kvs.NewInvalidValueError(ErrRuleWithInvalidPrefix, prefix.field)
+		}
+		if (ip.To4() == nil) != (rule.Family == linux_l3.Rule_IPV6) {
+			return kvs.NewInvalidValueError(ErrRuleWithInvalidFamily, "family", prefix.field)
+		}
+	}
+	if rule.Action != linux_l3.Rule_TABLE && rule.Table != 0 {
+		return kvs.NewInvalidValueError(ErrRuleTableWithoutLookup, "action", "table")
+	}
+	return nil
+}
+
+// Create adds Linux policy routing rule.
+func (d *RuleDescriptor) Create(key string, rule *linux_l3.Rule) (metadata interface{}, err error) {
+	err = d.updateRule(rule, "add", d.l3Handler.AddRule)
+	return nil, err
+}
+
+// Delete removes Linux policy routing rule.
+func (d *RuleDescriptor) Delete(key string, rule *linux_l3.Rule, metadata interface{}) error {
+	return d.updateRule(rule, "delete", d.l3Handler.DelRule)
+}
+
+// updateRule adds or deletes a Linux policy routing rule.
+func (d *RuleDescriptor) updateRule(rule *linux_l3.Rule, actionName string, actionClb func(rule *l3linuxcalls.Rule) error) error {
+	var err error
+
+	// Prepare rule object
+	linuxRule := &l3linuxcalls.Rule{
+		Priority: rule.Priority,
+		Family:   unix.AF_INET,
+		Table:    ruleTable(rule),
+		Mark:     rule.Fwmark,
+		Mask:     rule.Fwmask,
+	}
+	if rule.Family == linux_l3.Rule_IPV6 {
+		linuxRule.Family = unix.AF_INET6
+	}
+
+	// set action
+	switch rule.Action {
+	case linux_l3.Rule_TABLE:
+		linuxRule.Action = nl.FR_ACT_TO_TBL
+	case linux_l3.Rule_BLACKHOLE:
+		linuxRule.Action = nl.FR_ACT_BLACKHOLE
+	case linux_l3.Rule_UNREACHABLE:
+		linuxRule.Action = nl.FR_ACT_UNREACHABLE
+	case linux_l3.Rule_PROHIBIT:
+		linuxRule.Action = nl.FR_ACT_PROHIBIT
+	}
+
+	// set source and destination prefixes
+	if rule.From != "" {
+		if _, linuxRule.Src, err = net.ParseCIDR(rule.From); err != nil {
+			d.log.Error(err)
+			return err
+		}
+	}
+	if rule.To != "" {
+		if _, linuxRule.Dst, err = net.ParseCIDR(rule.To); err != nil {
+			d.log.Error(err)
+			return err
+		}
+	}
+
+	// set incoming and outgoing interfaces
+	if rule.IncomingInterface != "" {
+		if linuxRule.IifName, err = d.getHostIfName(rule.IncomingInterface); err != nil {
+			return err
+		}
+	}
+	if rule.OutgoingInterface != "" {
+		if linuxRule.OifName, err = d.getHostIfName(rule.OutgoingInterface); err != nil {
+			return err
+		}
+	}
+
+	// move to the namespace of the rule
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revertNs, err := d.nsPlugin.SwitchToNamespace(nsCtx, rule.Namespace)
+	if err != nil {
+		err = errors.Errorf("failed to switch namespace: %v", err)
+		d.log.Error(err)
+		return err
+	}
+	defer revertNs()
+
+	// update rule in the namespace
+	err = actionClb(linuxRule)
+	if err != nil {
+		err = errors.Errorf("failed to %s linux rule: %v", actionName, err)
+		d.log.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// getHostIfName returns host name of the interface with the given logical name.
+func (d *RuleDescriptor) getHostIfName(ifName string) (string, error) {
+	ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+	if !found || ifMeta == nil {
+		err := errors.Errorf("failed to obtain metadata for interface %s", ifName)
+		d.log.Error(err)
+		return "", err
+	}
+	return ifMeta.HostIfName, nil
+}
+
+// Dependencies lists dependencies for a Linux policy routing rule.
+func (d *RuleDescriptor) Dependencies(key string, rule *linux_l3.Rule) (deps []kvs.Dependency) {
+	// the referenced interfaces must exist
+	if rule.IncomingInterface != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: ruleInInterfaceDep,
+			Key:   ifmodel.InterfaceKey(rule.IncomingInterface),
+		})
+	}
+	if rule.OutgoingInterface != "" {
+		deps = append(deps, kvs.Dependency{
+			Label: ruleOutInterfaceDep,
+			Key:   ifmodel.InterfaceKey(rule.OutgoingInterface),
+		})
+	}
+	// microservice must be available
+	if rule.Namespace != nil && rule.Namespace.Type == namespace.NetNamespace_MICROSERVICE {
+		deps = append(deps, kvs.Dependency{
+			Label: ruleMicroserviceDep,
+			Key:   namespace.MicroserviceKey(rule.Namespace.Reference),
+		})
+	}
+	return deps
+}
+
+// Retrieve returns all policy routing rules from the default namespace, from
+// the namespaces of the interfaces managed by this agent and from the namespaces
+// of the expected rules.
+func (d *RuleDescriptor) Retrieve(correlate []adapter.RuleKVWithMetadata) ([]adapter.RuleKVWithMetadata, error) {
+	var values []adapter.RuleKVWithMetadata
+
+	expCfg := make(map[string]*linux_l3.Rule)
+	nsList := []*namespace.NetNamespace{nil} // the default namespace
+	for _, kv := range correlate {
+		expCfg[models.Key(kv.Value)] = kv.Value
+		nsList = l3linuxcalls.AppendNamespace(nsList, kv.Value.Namespace)
+	}
+	ifIndex := d.ifPlugin.GetInterfaceIndex()
+	for _, ifName := range ifIndex.ListAllInterfaces() {
+		if ifMeta, found := ifIndex.LookupByName(ifName); found && ifMeta != nil {
+			nsList = l3linuxcalls.AppendNamespace(nsList, ifMeta.Namespace)
+		}
+	}
+
+	ruleDetails, err := d.l3Handler.DumpRulesFromNamespaces(nsList)
+	if err != nil {
+		return nil, errors.Errorf("Failed to retrieve linux rules: %v", err)
+	}
+
+	// multiple rules may share the same priority, but only one of them
+	// can be represented under the given key
+	retrieved := make(map[string]int) // key -> index in values
+	for _, ruleDetail := range ruleDetails {
+		rule := adapter.RuleKVWithMetadata{
+			Key:    models.Key(ruleDetail.Rule),
+			Value:  ruleDetail.Rule,
+			Origin: kvs.UnknownOrigin, // let the scheduler to determine the origin
+		}
+		expected, hasExpCfg := expCfg[rule.Key]
+		isExpected := hasExpCfg && d.EquivalentRules(rule.Key, rule.Value, expected)
+		if isExpected {
+			rule.Value = expected
+		}
+		if idx, duplicate := retrieved[rule.Key]; duplicate {
+			if isExpected {
+				values[idx] = rule
+			}
+			continue
+		}
+		retrieved[rule.Key] = len(values)
+		values = append(values, rule)
+	}
+
+	return values, nil
+}
+
+// ruleTable returns the routing table looked up by the rule.
+func ruleTable(rule *linux_l3.Rule) uint32 {
+	if rule.Action != linux_l3.Rule_TABLE {
+		return 0
+	}
+	if rule.Table == 0 {
+		return unix.RT_TABLE_MAIN
+	}
+	return rule.Table
+}
+
+// equalOptNetworks compares two optional IP networks for equality.
+func equalOptNetworks(net1, net2 string) bool {
+	if net1 == "" || net2 == "" {
+		return net1 == net2
+	}
+	return equalNetworks(net1, net2)
+}
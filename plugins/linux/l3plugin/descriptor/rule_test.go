@@ -0,0 +1,160 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+func TestValidateRule(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   *linux_l3.Rule
+		err    error
+		fields []string
+	}{
+		{
+			name: "valid IPv4 rule",
+			rule: &linux_l3.Rule{Priority: 100, From: "10.0.0.0/24", To: "192.168.1.0/24", Table: 10},
+		},
+		{
+			name: "valid IPv6 rule",
+			rule: &linux_l3.Rule{Priority: 100, Family: linux_l3.Rule_IPV6, From: "fd00::/64",
+				Action: linux_l3.Rule_BLACKHOLE},
+		},
+		{
+			name:   "reserved priority",
+			rule:   &linux_l3.Rule{Priority: 32766},
+			err:    ErrRuleWithInvalidPriority,
+			fields: []string{"priority"},
+		},
+		{
+			name:   "invalid prefix",
+			rule:   &linux_l3.Rule{Priority: 100, To: "10.0.0.1"},
+			err:    ErrRuleWithInvalidPrefix,
+			fields: []string{"to"},
+		},
+		{
+			name:   "IPv6 prefix in IPv4 rule",
+			rule:   &linux_l3.Rule{Priority: 100, From: "fd00::/64"},
+			err:    ErrRuleWithInvalidFamily,
+			fields: []string{"family", "from"},
+		},
+		{
+			name:   "mixed families",
+			rule:   &linux_l3.Rule{Priority: 100, Family: linux_l3.Rule_IPV6, From: "fd00::/64", To: "10.0.0.0/8"},
+			err:    ErrRuleWithInvalidFamily,
+			fields: []string{"family", "to"},
+		},
+		{
+			name:   "table without lookup",
+			rule:   &linux_l3.Rule{Priority: 100, Action: linux_l3.Rule_PROHIBIT, Table: 10},
+			err:    ErrRuleTableWithoutLookup,
+			fields: []string{"action", "table"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := &RuleDescriptor{}
+			err := descriptor.Validate("", test.rule)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(Equal(kvs.NewInvalidValueError(test.err, test.fields...)))
+		})
+	}
+}
+
+func TestEquivalentRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldRule    *linux_l3.Rule
+		newRule    *linux_l3.Rule
+		equivalent bool
+	}{
+		{
+			name:       "main table given explicitly",
+			oldRule:    &linux_l3.Rule{Priority: 100, From: "10.0.0.0/24"},
+			newRule:    &linux_l3.Rule{Priority: 100, From: "10.0.0.0/24", Table: 254},
+			equivalent: true,
+		},
+		{
+			name:       "prefix with host bits",
+			oldRule:    &linux_l3.Rule{Priority: 100, From: "10.0.0.1/24"},
+			newRule:    &linux_l3.Rule{Priority: 100, From: "10.0.0.0/24"},
+			equivalent: true,
+		},
+		{
+			name:    "different table",
+			oldRule: &linux_l3.Rule{Priority: 100, Table: 10},
+			newRule: &linux_l3.Rule{Priority: 100, Table: 20},
+		},
+		{
+			name:    "added destination",
+			oldRule: &linux_l3.Rule{Priority: 100, From: "10.0.0.0/24"},
+			newRule: &linux_l3.Rule{Priority: 100, From: "10.0.0.0/24", To: "10.1.0.0/16"},
+		},
+		{
+			name:    "different action",
+			oldRule: &linux_l3.Rule{Priority: 100, Action: linux_l3.Rule_BLACKHOLE},
+			newRule: &linux_l3.Rule{Priority: 100, Action: linux_l3.Rule_UNREACHABLE},
+		},
+		{
+			name:    "different firewall mark mask",
+			oldRule: &linux_l3.Rule{Priority: 100, Fwmark: 1},
+			newRule: &linux_l3.Rule{Priority: 100, Fwmark: 1, Fwmask: 0xff},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := &RuleDescriptor{}
+			Expect(descriptor.EquivalentRules("", test.oldRule, test.newRule)).To(Equal(test.equivalent))
+		})
+	}
+}
+
+func TestRuleDependencies(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := &RuleDescriptor{}
+	Expect(descriptor.Dependencies("", &linux_l3.Rule{Priority: 100})).To(BeEmpty())
+
+	rule := &linux_l3.Rule{
+		Priority:          100,
+		IncomingInterface: "veth1",
+		OutgoingInterface: "veth2",
+		Namespace: &namespace.NetNamespace{
+			Type:      namespace.NetNamespace_MICROSERVICE,
+			Reference: "microservice1",
+		},
+	}
+	Expect(descriptor.Dependencies("", rule)).To(Equal([]kvs.Dependency{
+		{Label: ruleInInterfaceDep, Key: ifmodel.InterfaceKey("veth1")},
+		{Label: ruleOutInterfaceDep, Key: ifmodel.InterfaceKey("veth2")},
+		{Label: ruleMicroserviceDep, Key: namespace.MicroserviceKey("microservice1")},
+	}))
+}
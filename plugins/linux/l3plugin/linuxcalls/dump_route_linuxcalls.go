@@ -17,12 +17,19 @@
 package linuxcalls
 
 import (
+	"net"
+
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
 	"go.ligato.io/cn-infra/v2/logging"
+	"golang.org/x/sys/unix"
 
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
 	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 )
 
 const (
@@ -33,6 +40,10 @@ const (
 	// minimum number of interfaces to be given to a single Go routine for processing
 	// in the Retrieve operation
 	minWorkForGoRoutine = 3
+
+	// AllRouteTables can be passed to GetRoutes to read routes from all routing
+	// tables (routes installed into the local table by the kernel are skipped).
+	AllRouteTables = -1
 )
 
 // retrievedRoutes is used as the return value sent via channel by retrieveRoutes().
@@ -41,10 +52,39 @@ type retrievedRoutes struct {
 	err    error
 }
 
+// routePrefKey identifies IPv6 route for the purpose of pairing it with its preference.
+type routePrefKey struct {
+	dst       string
+	table     int
+	linkIndex int
+	priority  int
+}
+
+// routePrefs maps IPv6 routes to their preferences.
+type routePrefs map[routePrefKey]RoutePref
+
+// nsKey identifies network namespace for the purpose of pairing it with
+// preferences of IPv6 routes configured inside.
+type nsKey struct {
+	nsType    namespace.NetNamespace_ReferenceType
+	reference string
+}
+
+// nsRoutePrefs maps namespaces to preferences of IPv6 routes configured inside.
+type nsRoutePrefs map[nsKey]routePrefs
+
+// namespaceKey returns key identifying the given namespace.
+func namespaceKey(ns *namespace.NetNamespace) nsKey {
+	return nsKey{nsType: ns.GetType(), reference: ns.GetReference()}
+}
+
 // GetRoutes reads all configured static routes with the given outgoing
 // interface.
 // <interfaceIdx> works as filter, if set to zero, all routes in the namespace
 // are returned.
+// <table> works as filter as well, if set to zero, only routes from the main
+// table are returned, whereas AllRouteTables selects routes from all tables
+// (routes installed into the local table by the kernel are skipped).
 func (h *NetLinkHandler) GetRoutes(interfaceIdx, table int) (v4Routes, v6Routes []netlink.Route, err error) {
 	var routeFilter *netlink.Route
 	var filterMask uint64
@@ -56,6 +96,9 @@ func (h *NetLinkHandler) GetRoutes(interfaceIdx, table int) (v4Routes, v6Routes
 		if interfaceIdx != 0 {
 			filterMask |= netlink.RT_FILTER_OIF
 		}
+		if table == AllRouteTables {
+			routeFilter.Table = unix.RT_TABLE_UNSPEC
+		}
 		if table != 0 {
 			filterMask |= netlink.RT_FILTER_TABLE
 		}
@@ -65,13 +108,109 @@ func (h *NetLinkHandler) GetRoutes(interfaceIdx, table int) (v4Routes, v6Routes
 		return
 	}
 	v6Routes, err = netlink.RouteListFiltered(netlink.FAMILY_V6, routeFilter, filterMask)
+	if err != nil {
+		return
+	}
+	if table == AllRouteTables {
+		v4Routes = withoutKernelLocalRoutes(v4Routes)
+		v6Routes = withoutKernelLocalRoutes(v6Routes)
+	}
 	return
 }
 
+// withoutKernelLocalRoutes filters out routes installed into the local routing
+// table by the kernel.
+func withoutKernelLocalRoutes(routes []netlink.Route) []netlink.Route {
+	var filtered []netlink.Route
+	for _, route := range routes {
+		if route.Table == unix.RT_TABLE_LOCAL && route.Protocol == unix.RTPROT_KERNEL {
+			continue
+		}
+		filtered = append(filtered, route)
+	}
+	return filtered
+}
+
+// getRoutePrefs reads preferences of all IPv6 routes configured in the current
+// namespace. The netlink library does not parse the RTA_PREF attribute, therefore
+// routes have to be dumped once more.
+// Equivalent to: `ip -6 route show table all`
+func getRoutePrefs() (routePrefs, error) {
+	req := nl.NewNetlinkRequest(unix.RTM_GETROUTE, unix.NLM_F_DUMP)
+	req.AddData(nl.NewIfInfomsg(unix.AF_INET6))
+
+	msgs, err := req.Execute(unix.NETLINK_ROUTE, unix.RTM_NEWROUTE)
+	if err != nil {
+		return nil, errors.Wrap(err, "RouteList (IPv6 preferences)")
+	}
+
+	native := nl.NativeEndian()
+	prefs := make(routePrefs)
+	for _, m := range msgs {
+		msg := nl.DeserializeRtMsg(m)
+		attrs, err := nl.ParseRouteAttr(m[msg.Len():])
+		if err != nil {
+			return nil, err
+		}
+		key := routePrefKey{
+			dst:   IPv6AddrAny + "/0",
+			table: int(msg.Table),
+		}
+		var pref RoutePref
+		for _, attr := range attrs {
+			switch attr.Attr.Type {
+			case unix.RTA_DST:
+				key.dst = (&net.IPNet{
+					IP:   net.IP(attr.Value),
+					Mask: net.CIDRMask(int(msg.Dst_len), 8*len(attr.Value)),
+				}).String()
+			case unix.RTA_TABLE:
+				key.table = int(native.Uint32(attr.Value[0:4]))
+			case unix.RTA_OIF:
+				key.linkIndex = int(native.Uint32(attr.Value[0:4]))
+			case unix.RTA_PRIORITY:
+				key.priority = int(native.Uint32(attr.Value[0:4]))
+			case unix.RTA_PREF:
+				pref = RoutePref(attr.Value[0])
+			}
+		}
+		prefs[key] = pref
+	}
+	return prefs, nil
+}
+
+// lookup returns preference of the given IPv6 route.
+func (prefs routePrefs) lookup(route netlink.Route) RoutePref {
+	key := routePrefKey{
+		dst:       IPv6AddrAny + "/0",
+		table:     route.Table,
+		linkIndex: route.LinkIndex,
+		priority:  route.Priority,
+	}
+	if route.Dst != nil {
+		key.dst = route.Dst.String()
+	}
+	return prefs[key]
+}
+
 // DumpRoutes reads all route entries and returns them as details
 // with proto-modeled route data and additional metadata
 func (h *NetLinkHandler) DumpRoutes() ([]*RouteDetails, error) {
 	interfaces := h.ifIndexes.ListAllInterfaces()
+
+	// routes not bound to a single outgoing interface and preferences of IPv6 routes
+	// are retrieved per namespace
+	nsList := []*namespace.NetNamespace{nil} // the default namespace
+	for _, ifName := range interfaces {
+		if ifMeta, found := h.ifIndexes.LookupByName(ifName); found && ifMeta != nil {
+			nsList = AppendNamespace(nsList, ifMeta.Namespace)
+		}
+	}
+	nsRoutes, prefs, err := h.retrieveNamespaceRoutes(nsList)
+	if err != nil {
+		return nil, err
+	}
+
 	goRoutinesCnt := len(interfaces) / minWorkForGoRoutine
 	if goRoutinesCnt == 0 {
 		goRoutinesCnt = 1
@@ -84,9 +223,9 @@ func (h *NetLinkHandler) DumpRoutes() ([]*RouteDetails, error) {
 	// invoke multiple go routines for more efficient parallel route retrieval
 	for idx := 0; idx < goRoutinesCnt; idx++ {
 		if goRoutinesCnt > 1 {
-			go h.retrieveRoutes(interfaces, idx, goRoutinesCnt, ch)
+			go h.retrieveRoutes(interfaces, idx, goRoutinesCnt, prefs, ch)
 		} else {
-			h.retrieveRoutes(interfaces, idx, goRoutinesCnt, ch)
+			h.retrieveRoutes(interfaces, idx, goRoutinesCnt, prefs, ch)
 		}
 	}
 
@@ -100,13 +239,17 @@ func (h *NetLinkHandler) DumpRoutes() ([]*RouteDetails, error) {
 		// correlate with the expected configuration
 		routeDetails = append(routeDetails, retrieved.routes...)
 	}
+	routeDetails = append(routeDetails, nsRoutes...)
 
 	return routeDetails, nil
 }
 
 // retrieveRoutes is run by a separate go routine to retrieve all routes entries
 // associated with every <goRoutineIdx>-th interface.
-func (h *NetLinkHandler) retrieveRoutes(interfaces []string, goRoutineIdx, goRoutinesCnt int, ch chan<- retrievedRoutes) {
+// Preferences of IPv6 routes are given by <prefs> (read-only, shared between
+// the go routines).
+func (h *NetLinkHandler) retrieveRoutes(interfaces []string, goRoutineIdx, goRoutinesCnt int,
+	prefs nsRoutePrefs, ch chan<- retrievedRoutes) {
 	var retrieved retrievedRoutes
 	nsCtx := linuxcalls.NewNamespaceMgmtCtx()
 
@@ -121,12 +264,11 @@ func (h *NetLinkHandler) retrieveRoutes(interfaces []string, goRoutineIdx, goRou
 		}
 
 		// obtain the associated routing table
-		var table int
-		if ifMeta.VrfMasterIf != "" {
-			vrfMeta, found := h.ifIndexes.LookupByName(ifMeta.VrfMasterIf)
-			if found {
-				table = int(vrfMeta.VrfDevRT)
-			}
+		// (for interfaces outside of VRF all tables are read to include routes
+		// configured with an explicit routing table)
+		table := AllRouteTables
+		if vrfTable, inVrf := h.vrfRouteTable(ifMeta); inVrf {
+			table = vrfTable
 		}
 
 		// switch to the namespace of the interface
@@ -151,40 +293,221 @@ func (h *NetLinkHandler) retrieveRoutes(interfaces []string, goRoutineIdx, goRou
 
 		// convert each route from Netlink representation to the NB representation
 		for idx, route := range append(v4Routes, v6Routes...) {
-			var dstNet, gwAddr string
-			if route.Dst == nil {
-				if idx < len(v4Routes) {
-					dstNet = IPv4AddrAny + "/0"
-				} else {
-					dstNet = IPv6AddrAny + "/0"
-				}
-			} else {
-				if route.Dst.IP.To4() == nil && route.Dst.IP.IsLinkLocalUnicast() {
-					// skip link-local IPv6 destinations until there is a requirement to support them
+			details, ok := h.routeToNB(route, ifName, ifMeta, idx >= len(v4Routes),
+				prefs[namespaceKey(ifMeta.Namespace)])
+			if ok {
+				retrieved.routes = append(retrieved.routes, details)
+			}
+		}
+	}
+
+	ch <- retrieved
+}
+
+// retrieveNamespaceRoutes retrieves routes from the given namespaces which
+// are not bound to a single outgoing interface, i.e. multipath routes and
+// routes without next hop (blackhole, unreachable, prohibit).
+// Additionally, preferences of all IPv6 routes are read from each namespace
+// (once, to be shared with the retrieval of routes associated with interfaces).
+func (h *NetLinkHandler) retrieveNamespaceRoutes(nsList []*namespace.NetNamespace) (
+	routeDetails []*RouteDetails, prefs nsRoutePrefs, err error) {
+
+	prefs = make(nsRoutePrefs)
+	nsCtx := linuxcalls.NewNamespaceMgmtCtx()
+
+	for _, nsRef := range nsList {
+		// switch to the namespace
+		revertNs, err := h.nsPlugin.SwitchToNamespace(nsCtx, nsRef)
+		if err != nil {
+			// namespace and all the routes it had contained no longer exist
+			h.log.WithFields(logging.Fields{
+				"err":       err,
+				"namespace": nsRef,
+			}).Warn("Failed to retrieve routes from the namespace")
+			continue
+		}
+
+		var nsPrefs routePrefs
+		v4Routes, v6Routes, err := h.GetRoutes(0, AllRouteTables)
+		if err == nil && len(v6Routes) > 0 {
+			nsPrefs, err = getRoutePrefs()
+		}
+		revertNs()
+		if err != nil {
+			h.log.Error(err)
+			return nil, nil, err
+		}
+		prefs[namespaceKey(nsRef)] = nsPrefs
+
+		for idx, route := range append(v4Routes, v6Routes...) {
+			if route.LinkIndex != 0 {
+				// retrieved together with routes of the outgoing interface
+				continue
+			}
+			var (
+				ifName string
+				ifMeta *ifaceidx.LinuxIfMetadata
+			)
+			if len(route.MultiPath) > 0 {
+				// multipath route is associated with the interface of the first next hop
+				var found bool
+				ifName, ifMeta, found = h.lookupByLinuxIfIndex(route.MultiPath[0].LinkIndex, nsRef)
+				if !found {
 					continue
 				}
-				dstNet = route.Dst.String()
+			} else if !isDropRouteType(route.Type) {
+				continue
 			}
-			if len(route.Gw) != 0 {
-				gwAddr = route.Gw.String()
+			details, ok := h.routeToNB(route, ifName, ifMeta, idx >= len(v4Routes), nsPrefs)
+			if !ok {
+				continue
 			}
-			retrieved.routes = append(retrieved.routes, &RouteDetails{
-				Route: &linux_l3.Route{
-					OutgoingInterface: ifName,
-					DstNetwork:        dstNet,
-					GwAddr:            gwAddr,
-					Metric:            uint32(route.Priority),
-				},
-				Meta: &RouteMeta{
-					InterfaceIndex: uint32(route.LinkIndex),
-					NetlinkScope:   route.Scope,
-					Protocol:       uint32(route.Protocol),
-					MTU:            uint32(route.MTU),
-					Table:          uint32(route.Table),
-				},
-			})
+			details.Meta.Namespace = nsRef
+			routeDetails = append(routeDetails, details)
 		}
 	}
 
-	ch <- retrieved
+	return routeDetails, prefs, nil
+}
+
+// routeToNB converts route from the netlink representation to the NB representation.
+// For routes without next hop <ifName> is empty and <ifMeta> is nil.
+// Returns false if the route cannot be expressed with the NB model.
+func (h *NetLinkHandler) routeToNB(route netlink.Route, ifName string, ifMeta *ifaceidx.LinuxIfMetadata,
+	ipv6 bool, prefs routePrefs) (details *RouteDetails, ok bool) {
+
+	var dstNet string
+	if route.Dst == nil {
+		if ipv6 {
+			dstNet = IPv6AddrAny + "/0"
+		} else {
+			dstNet = IPv4AddrAny + "/0"
+		}
+	} else {
+		if route.Dst.IP.To4() == nil && route.Dst.IP.IsLinkLocalUnicast() {
+			// skip link-local IPv6 destinations until there is a requirement to support them
+			return nil, false
+		}
+		dstNet = route.Dst.String()
+	}
+
+	nbRoute := &linux_l3.Route{
+		OutgoingInterface: ifName,
+		DstNetwork:        dstNet,
+		Metric:            uint32(route.Priority),
+		Mtu:               uint32(route.MTU),
+		Advmss:            uint32(route.AdvMSS),
+		Onlink:            route.Flags&unix.RTNH_F_ONLINK != 0,
+	}
+	switch route.Type {
+	case unix.RTN_UNSPEC, unix.RTN_UNICAST:
+		nbRoute.Type = linux_l3.Route_UNICAST
+	case unix.RTN_LOCAL:
+		nbRoute.Type = linux_l3.Route_LOCAL
+	case unix.RTN_BLACKHOLE:
+		nbRoute.Type = linux_l3.Route_BLACKHOLE
+	case unix.RTN_UNREACHABLE:
+		nbRoute.Type = linux_l3.Route_UNREACHABLE
+	case unix.RTN_PROHIBIT:
+		nbRoute.Type = linux_l3.Route_PROHIBIT
+	default:
+		// broadcast, multicast, etc.
+		return nil, false
+	}
+	if len(route.Gw) != 0 {
+		nbRoute.GwAddr = route.Gw.String()
+	}
+	if len(route.Src) != 0 {
+		nbRoute.SrcAddr = route.Src.String()
+	}
+
+	// the routing table is left undefined if it is the default one for the route
+	defaultTable := unix.RT_TABLE_MAIN
+	if vrfTable, inVrf := h.vrfRouteTable(ifMeta); inVrf {
+		defaultTable = vrfTable
+	} else if nbRoute.Type == linux_l3.Route_LOCAL {
+		defaultTable = unix.RT_TABLE_LOCAL
+	}
+	if route.Table != defaultTable {
+		nbRoute.Table = uint32(route.Table)
+	}
+
+	for _, nh := range route.MultiPath {
+		nbNextHop := &linux_l3.Route_NextHop{
+			Weight: uint32(nh.Hops) + 1,
+			Onlink: nh.Flags&unix.RTNH_F_ONLINK != 0,
+		}
+		if len(nh.Gw) != 0 {
+			nbNextHop.GwAddr = nh.Gw.String()
+		}
+		if nh.LinkIndex != ifMeta.LinuxIfIndex {
+			nhIfName, _, found := h.lookupByLinuxIfIndex(nh.LinkIndex, ifMeta.Namespace)
+			if !found {
+				return nil, false
+			}
+			nbNextHop.OutgoingInterface = nhIfName
+		}
+		nbRoute.NextHops = append(nbRoute.NextHops, nbNextHop)
+	}
+
+	if ipv6 {
+		switch prefs.lookup(route) {
+		case RoutePrefHigh:
+			nbRoute.Ipv6Preference = linux_l3.Route_HIGH
+		case RoutePrefLow:
+			nbRoute.Ipv6Preference = linux_l3.Route_LOW
+		}
+	}
+
+	details = &RouteDetails{
+		Route: nbRoute,
+		Meta: &RouteMeta{
+			InterfaceIndex: uint32(route.LinkIndex),
+			NetlinkScope:   route.Scope,
+			Protocol:       uint32(route.Protocol),
+			MTU:            uint32(route.MTU),
+			Table:          uint32(route.Table),
+			Type:           uint32(route.Type),
+		},
+	}
+	if ifMeta != nil {
+		details.Meta.Namespace = ifMeta.Namespace
+	}
+	return details, true
+}
+
+// vrfRouteTable returns the routing table of the VRF the given interface belongs to.
+func (h *NetLinkHandler) vrfRouteTable(ifMeta *ifaceidx.LinuxIfMetadata) (table int, inVrf bool) {
+	if ifMeta == nil || ifMeta.VrfMasterIf == "" {
+		return 0, false
+	}
+	vrfMeta, found := h.ifIndexes.LookupByName(ifMeta.VrfMasterIf)
+	if !found || vrfMeta == nil {
+		return 0, false
+	}
+	return int(vrfMeta.VrfDevRT), true
+}
+
+// lookupByLinuxIfIndex looks up interface by its index inside the given namespace.
+func (h *NetLinkHandler) lookupByLinuxIfIndex(linuxIfIndex int, ns *namespace.NetNamespace) (
+	ifName string, ifMeta *ifaceidx.LinuxIfMetadata, exists bool) {
+
+	for _, ifName = range h.ifIndexes.ListAllInterfaces() {
+		ifMeta, exists = h.ifIndexes.LookupByName(ifName)
+		if exists && ifMeta != nil && ifMeta.LinuxIfIndex == linuxIfIndex &&
+			proto.Equal(ifMeta.Namespace, ns) {
+			return ifName, ifMeta, true
+		}
+	}
+	return "", nil, false
+}
+
+// isDropRouteType returns true for types of routes which drop packets
+// and therefore have neither outgoing interface nor gateway.
+func isDropRouteType(routeType int) bool {
+	switch routeType {
+	case unix.RTN_BLACKHOLE, unix.RTN_UNREACHABLE, unix.RTN_PROHIBIT:
+		return true
+	}
+	return false
 }
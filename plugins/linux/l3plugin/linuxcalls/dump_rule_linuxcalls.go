@@ -0,0 +1,168 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/vishvananda/netlink/nl"
+	"go.ligato.io/cn-infra/v2/logging"
+	"golang.org/x/sys/unix"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+const (
+	// priorities of the rules installed by default (lookup of local, main and default table)
+	localRulePriority   = 0
+	mainRulePriority    = 32766
+	defaultRulePriority = 32767
+)
+
+// DumpRules reads policy routing rules from the default namespace and from
+// the namespaces of all known interfaces.
+func (h *NetLinkHandler) DumpRules() ([]*RuleDetails, error) {
+	nsList := []*namespace.NetNamespace{nil} // the default namespace
+	for _, ifName := range h.ifIndexes.ListAllInterfaces() {
+		if ifMeta, found := h.ifIndexes.LookupByName(ifName); found && ifMeta != nil {
+			nsList = AppendNamespace(nsList, ifMeta.Namespace)
+		}
+	}
+	return h.DumpRulesFromNamespaces(nsList)
+}
+
+// DumpRulesFromNamespaces reads policy routing rules from the given namespaces and returns
+// them as details with proto-modeled rule data and additional metadata.
+// Default rules, rules installed for VRF devices and rules referencing interfaces
+// not known to the agent are skipped.
+func (h *NetLinkHandler) DumpRulesFromNamespaces(nsList []*namespace.NetNamespace) ([]*RuleDetails, error) {
+	var ruleDetails []*RuleDetails
+	nsCtx := linuxcalls.NewNamespaceMgmtCtx()
+
+	for _, nsRef := range nsList {
+		// switch to the namespace
+		revertNs, err := h.nsPlugin.SwitchToNamespace(nsCtx, nsRef)
+		if err != nil {
+			// namespace and all the rules it had contained no longer exist
+			h.log.WithFields(logging.Fields{
+				"err":       err,
+				"namespace": nsRef,
+			}).Warn("Failed to retrieve rules from the namespace")
+			continue
+		}
+
+		v4Rules, err := h.GetRules(unix.AF_INET)
+		if err != nil {
+			revertNs()
+			h.log.Error(err)
+			return nil, err
+		}
+		v6Rules, err := h.GetRules(unix.AF_INET6)
+		revertNs()
+		if err != nil {
+			h.log.Error(err)
+			return nil, err
+		}
+
+		for _, rule := range append(v4Rules, v6Rules...) {
+			if rule.L3mdev {
+				// rule installed for VRF device
+				continue
+			}
+			switch rule.Priority {
+			case localRulePriority, mainRulePriority, defaultRulePriority:
+				// default rules
+				continue
+			}
+			nbRule, ok := h.ruleToNB(rule, nsRef)
+			if !ok {
+				continue
+			}
+			ruleDetails = append(ruleDetails, &RuleDetails{
+				Rule: nbRule,
+				Meta: &RuleMeta{
+					Family: uint32(rule.Family),
+					Action: uint32(rule.Action),
+					Table:  rule.Table,
+				},
+			})
+		}
+	}
+
+	return ruleDetails, nil
+}
+
+// AppendNamespace appends namespace into the list unless it is already included.
+func AppendNamespace(nsList []*namespace.NetNamespace, ns *namespace.NetNamespace) []*namespace.NetNamespace {
+	for _, ns2 := range nsList {
+		if proto.Equal(ns, ns2) {
+			return nsList
+		}
+	}
+	return append(nsList, ns)
+}
+
+// ruleToNB converts rule from the netlink representation to the NB representation.
+// Returns false if the rule cannot be expressed with the NB model.
+func (h *NetLinkHandler) ruleToNB(rule *Rule, nsRef *namespace.NetNamespace) (nbRule *linux_l3.Rule, ok bool) {
+	nbRule = &linux_l3.Rule{
+		Priority:  rule.Priority,
+		Namespace: nsRef,
+		Fwmark:    rule.Mark,
+		Fwmask:    rule.Mask,
+	}
+	if rule.Family == unix.AF_INET6 {
+		nbRule.Family = linux_l3.Rule_IPV6
+	}
+	switch rule.Action {
+	case nl.FR_ACT_TO_TBL:
+		nbRule.Action = linux_l3.Rule_TABLE
+		if rule.Table != unix.RT_TABLE_MAIN {
+			nbRule.Table = rule.Table
+		}
+	case nl.FR_ACT_BLACKHOLE:
+		nbRule.Action = linux_l3.Rule_BLACKHOLE
+	case nl.FR_ACT_UNREACHABLE:
+		nbRule.Action = linux_l3.Rule_UNREACHABLE
+	case nl.FR_ACT_PROHIBIT:
+		nbRule.Action = linux_l3.Rule_PROHIBIT
+	default:
+		return nil, false
+	}
+	if rule.Src != nil {
+		nbRule.From = rule.Src.String()
+	}
+	if rule.Dst != nil {
+		nbRule.To = rule.Dst.String()
+	}
+	if rule.IifName != "" {
+		iifName, _, found := h.ifIndexes.LookupByHostName(rule.IifName, nsRef)
+		if !found {
+			return nil, false
+		}
+		nbRule.IncomingInterface = iifName
+	}
+	if rule.OifName != "" {
+		oifName, _, found := h.ifIndexes.LookupByHostName(rule.OifName, nsRef)
+		if !found {
+			return nil, false
+		}
+		nbRule.OutgoingInterface = oifName
+	}
+	return nbRule, true
+}
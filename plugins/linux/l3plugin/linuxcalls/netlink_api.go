@@ -21,6 +21,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 )
 
 // ArpDetails is an object combining linux ARP data based on proto
@@ -46,11 +47,38 @@ type RouteDetails struct {
 
 // RouteMeta represents linux Route metadata
 type RouteMeta struct {
-	InterfaceIndex uint32        `json:"interface_index"`
-	NetlinkScope   netlink.Scope `json:"link_scope"`
-	Protocol       uint32        `json:"protocol"`
-	MTU            uint32        `json:"mtu"`
-	Table          uint32        `json:"table"`
+	InterfaceIndex uint32                  `json:"interface_index"`
+	NetlinkScope   netlink.Scope           `json:"link_scope"`
+	Protocol       uint32                  `json:"protocol"`
+	MTU            uint32                  `json:"mtu"`
+	Table          uint32                  `json:"table"`
+	Type           uint32                  `json:"type"`
+	Namespace      *namespace.NetNamespace `json:"namespace"`
+}
+
+// RoutePref is the preference of an IPv6 route (RFC 4191) as encoded
+// in the RTA_PREF attribute, which is not supported by the netlink library.
+type RoutePref uint8
+
+// IPv6 route preferences (see include/uapi/linux/icmpv6.h)
+const (
+	RoutePrefMedium RoutePref = 0
+	RoutePrefHigh   RoutePref = 1
+	RoutePrefLow    RoutePref = 3
+)
+
+// RuleDetails is an object combining linux policy routing rule data based on proto
+// model with additional metadata
+type RuleDetails struct {
+	Rule *linux.Rule
+	Meta *RuleMeta
+}
+
+// RuleMeta represents linux policy routing rule metadata
+type RuleMeta struct {
+	Family uint32 `json:"family"`
+	Action uint32 `json:"action"`
+	Table  uint32 `json:"table"`
 }
 
 // NetlinkAPI interface covers all methods inside linux calls package needed
@@ -71,11 +99,19 @@ type NetlinkAPIWrite interface {
 
 	/* Routes */
 	// AddRoute adds new linux static route.
-	AddRoute(route *netlink.Route) error
+	// Preference other than RoutePrefMedium is applicable only to IPv6 routes.
+	AddRoute(route *netlink.Route, pref RoutePref) error
 	// ReplaceRoute changes existing linux static route.
-	ReplaceRoute(route *netlink.Route) error
+	// Preference other than RoutePrefMedium is applicable only to IPv6 routes.
+	ReplaceRoute(route *netlink.Route, pref RoutePref) error
 	// DelRoute removes linux static route.
 	DelRoute(route *netlink.Route) error
+
+	/* Rules */
+	// AddRule adds new policy routing rule.
+	AddRule(rule *Rule) error
+	// DelRule removes policy routing rule.
+	DelRule(rule *Rule) error
 }
 
 // NetlinkAPIRead interface covers read methods inside linux calls package
@@ -94,12 +130,29 @@ type NetlinkAPIRead interface {
 	// and with the given outgoing interface.
 	// <interfaceIdx> works as filter, if set to zero, all routes in the namespace
 	// are returned.
-	// Zero <table> represents the main routing table.
+	// Zero <table> represents the main routing table, AllRouteTables selects
+	// all tables (routes installed into the local table by the kernel are skipped).
 	GetRoutes(interfaceIdx, table int) (v4Routes, v6Routes []netlink.Route, err error)
 
 	// DumpRoutes reads all route entries and returns them as details
-	// with proto-modeled route data and additional metadata
+	// with proto-modeled route data and additional metadata.
+	// Routes without outgoing interface (blackhole, unreachable and prohibit)
+	// are returned with empty OutgoingInterface and the namespace
+	// they were found in stored in the metadata.
 	DumpRoutes() ([]*RouteDetails, error)
+
+	// GetRules reads all policy routing rules of the given address family
+	// configured in the current namespace.
+	GetRules(family int) ([]*Rule, error)
+
+	// DumpRules reads policy routing rules from the default namespace and from
+	// the namespaces of all known interfaces and returns them as details
+	// with proto-modeled rule data and additional metadata
+	DumpRules() ([]*RuleDetails, error)
+
+	// DumpRulesFromNamespaces reads policy routing rules from the given namespaces
+	// and returns them as details with proto-modeled rule data and additional metadata
+	DumpRulesFromNamespaces(nsList []*namespace.NetNamespace) ([]*RuleDetails, error)
 }
 
 // NetLinkHandler is accessor for Netlink methods.
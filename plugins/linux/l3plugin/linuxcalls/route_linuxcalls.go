@@ -17,20 +17,120 @@
 package linuxcalls
 
 import (
+	"github.com/pkg/errors"
 	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
 )
 
 // AddRoute creates the new static route
-func (h *NetLinkHandler) AddRoute(route *netlink.Route) error {
-	return netlink.RouteAdd(route)
+func (h *NetLinkHandler) AddRoute(route *netlink.Route, pref RoutePref) error {
+	if pref == RoutePrefMedium {
+		return netlink.RouteAdd(route)
+	}
+	flags := unix.NLM_F_CREATE | unix.NLM_F_EXCL | unix.NLM_F_ACK
+	return errors.Wrap(routeWithPref(unix.RTM_NEWROUTE, flags, route, pref), "RouteAdd")
 }
 
 // ReplaceRoute replaces the static route
-func (h *NetLinkHandler) ReplaceRoute(route *netlink.Route) error {
-	return netlink.RouteReplace(route)
+func (h *NetLinkHandler) ReplaceRoute(route *netlink.Route, pref RoutePref) error {
+	if pref == RoutePrefMedium {
+		return netlink.RouteReplace(route)
+	}
+	flags := unix.NLM_F_CREATE | unix.NLM_F_REPLACE | unix.NLM_F_ACK
+	return errors.Wrap(routeWithPref(unix.RTM_NEWROUTE, flags, route, pref), "RouteReplace")
 }
 
 // DelRoute removes the static route
 func (h *NetLinkHandler) DelRoute(route *netlink.Route) error {
 	return netlink.RouteDel(route)
 }
+
+// routeWithPref sends netlink request to add or replace IPv6 route with the given
+// preference.
+func routeWithPref(cmd, flags int, route *netlink.Route, pref RoutePref) error {
+	req := nl.NewNetlinkRequest(cmd, flags)
+	routeRequest(req, route, pref)
+	_, err := req.Execute(unix.NETLINK_ROUTE, 0)
+	return err
+}
+
+// routeRequest fills netlink request with the given IPv6 route and its preference.
+// The request is built the same way as by the netlink library (limited to the route
+// attributes used by the agent), only RTA_PREF is added.
+func routeRequest(req *nl.NetlinkRequest, route *netlink.Route, pref RoutePref) {
+	native := nl.NativeEndian()
+
+	msg := nl.NewRtMsg()
+	msg.Family = unix.AF_INET6
+	msg.Scope = uint8(route.Scope)
+	msg.Flags = uint32(route.Flags)
+	if route.Protocol > 0 {
+		msg.Protocol = uint8(route.Protocol)
+	}
+	if route.Type > 0 {
+		msg.Type = uint8(route.Type)
+	}
+
+	var attrs []*nl.RtAttr
+	if route.Dst != nil && route.Dst.IP != nil {
+		dstLen, _ := route.Dst.Mask.Size()
+		msg.Dst_len = uint8(dstLen)
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_DST, route.Dst.IP.To16()))
+	}
+	if route.Src != nil {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_PREFSRC, route.Src.To16()))
+	}
+	if route.Gw != nil {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_GATEWAY, route.Gw.To16()))
+	}
+	if len(route.MultiPath) > 0 {
+		var buf []byte
+		for _, nh := range route.MultiPath {
+			rtnh := &nl.RtNexthop{
+				RtNexthop: unix.RtNexthop{
+					Hops:    uint8(nh.Hops),
+					Ifindex: int32(nh.LinkIndex),
+					Flags:   uint8(nh.Flags),
+				},
+			}
+			if nh.Gw != nil {
+				rtnh.Children = append(rtnh.Children, nl.NewRtAttr(unix.RTA_GATEWAY, nh.Gw.To16()))
+			}
+			buf = append(buf, rtnh.Serialize()...)
+		}
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_MULTIPATH, buf))
+	}
+	if route.Table > 0 {
+		if route.Table >= 256 {
+			msg.Table = unix.RT_TABLE_UNSPEC
+			attrs = append(attrs, nl.NewRtAttr(unix.RTA_TABLE, nl.Uint32Attr(uint32(route.Table))))
+		} else {
+			msg.Table = uint8(route.Table)
+		}
+	}
+	if route.Priority > 0 {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_PRIORITY, nl.Uint32Attr(uint32(route.Priority))))
+	}
+	if route.MTU > 0 || route.AdvMSS > 0 {
+		metrics := nl.NewRtAttr(unix.RTA_METRICS, nil)
+		if route.MTU > 0 {
+			nl.NewRtAttrChild(metrics, unix.RTAX_MTU, nl.Uint32Attr(uint32(route.MTU)))
+		}
+		if route.AdvMSS > 0 {
+			nl.NewRtAttrChild(metrics, unix.RTAX_ADVMSS, nl.Uint32Attr(uint32(route.AdvMSS)))
+		}
+		attrs = append(attrs, metrics)
+	}
+	attrs = append(attrs, nl.NewRtAttr(unix.RTA_PREF, []byte{uint8(pref)}))
+
+	req.AddData(msg)
+	for _, attr := range attrs {
+		req.AddData(attr)
+	}
+	if route.LinkIndex > 0 {
+		b := make([]byte, 4)
+		native.PutUint32(b, uint32(route.LinkIndex))
+		req.AddData(nl.NewRtAttr(unix.RTA_OIF, b))
+	}
+}
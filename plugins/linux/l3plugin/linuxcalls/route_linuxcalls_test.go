@@ -0,0 +1,350 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"golang.org/x/sys/unix"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+)
+
+// parseRouteRequest parses route attributes set by routeRequest.
+func parseRouteRequest(b []byte) (route *netlink.Route, pref RoutePref, err error) {
+	native := nl.NativeEndian()
+	msg := nl.DeserializeRtMsg(b)
+	attrs, err := nl.ParseRouteAttr(b[msg.Len():])
+	if err != nil {
+		return nil, 0, err
+	}
+	route = &netlink.Route{
+		Scope: netlink.Scope(msg.Scope),
+		Flags: int(msg.Flags),
+		Type:  int(msg.Type),
+		Table: int(msg.Table),
+	}
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case unix.RTA_DST:
+			route.Dst = &net.IPNet{
+				IP:   net.IP(attr.Value),
+				Mask: net.CIDRMask(int(msg.Dst_len), 8*len(attr.Value)),
+			}
+		case unix.RTA_PREFSRC:
+			route.Src = net.IP(attr.Value)
+		case unix.RTA_GATEWAY:
+			route.Gw = net.IP(attr.Value)
+		case unix.RTA_MULTIPATH:
+			for buf := attr.Value; len(buf) > 0; {
+				rtnh := nl.DeserializeRtNexthop(buf)
+				nextHop := &netlink.NexthopInfo{
+					LinkIndex: int(rtnh.Ifindex),
+					Hops:      int(rtnh.Hops),
+					Flags:     int(rtnh.Flags),
+				}
+				nhAttrs, err := nl.ParseRouteAttr(buf[unix.SizeofRtNexthop:rtnh.RtNexthop.Len])
+				if err != nil {
+					return nil, 0, err
+				}
+				for _, nhAttr := range nhAttrs {
+					if nhAttr.Attr.Type == unix.RTA_GATEWAY {
+						nextHop.Gw = net.IP(nhAttr.Value)
+					}
+				}
+				route.MultiPath = append(route.MultiPath, nextHop)
+				buf = buf[rtnh.RtNexthop.Len:]
+			}
+		case unix.RTA_TABLE:
+			route.Table = int(native.Uint32(attr.Value[0:4]))
+		case unix.RTA_PRIORITY:
+			route.Priority = int(native.Uint32(attr.Value[0:4]))
+		case unix.RTA_METRICS:
+			metrics, err := nl.ParseRouteAttr(attr.Value)
+			if err != nil {
+				return nil, 0, err
+			}
+			for _, metric := range metrics {
+				switch metric.Attr.Type {
+				case unix.RTAX_MTU:
+					route.MTU = int(native.Uint32(metric.Value[0:4]))
+				case unix.RTAX_ADVMSS:
+					route.AdvMSS = int(native.Uint32(metric.Value[0:4]))
+				}
+			}
+		case unix.RTA_PREF:
+			pref = RoutePref(attr.Value[0])
+		case unix.RTA_OIF:
+			route.LinkIndex = int(native.Uint32(attr.Value[0:4]))
+		}
+	}
+	return route, pref, nil
+}
+
+func TestRouteRequestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		route *netlink.Route
+		pref  RoutePref
+	}{
+		{
+			name: "route via gateway with high preference",
+			route: &netlink.Route{
+				LinkIndex: 2,
+				Scope:     netlink.SCOPE_UNIVERSE,
+				Type:      unix.RTN_UNICAST,
+				Dst:       mustParseCIDR("2001:db8::/32"),
+				Gw:        net.ParseIP("fd00::1"),
+				Src:       net.ParseIP("fd00::5"),
+				Table:     10,
+				Priority:  100,
+				MTU:       1400,
+				AdvMSS:    1360,
+			},
+			pref: RoutePrefHigh,
+		},
+		{
+			name: "multipath route with low preference in table outside of the 8-bit range",
+			route: &netlink.Route{
+				Scope: netlink.SCOPE_UNIVERSE,
+				Type:  unix.RTN_UNICAST,
+				Dst:   mustParseCIDR("::/0"),
+				Table: 1000,
+				MultiPath: []*netlink.NexthopInfo{
+					{LinkIndex: 2, Gw: net.ParseIP("fd00::1")},
+					{LinkIndex: 3, Gw: net.ParseIP("fd01::1"), Hops: 2, Flags: unix.RTNH_F_ONLINK},
+				},
+			},
+			pref: RoutePrefLow,
+		},
+		{
+			name: "blackhole route",
+			route: &netlink.Route{
+				Scope: netlink.SCOPE_UNIVERSE,
+				Type:  unix.RTN_BLACKHOLE,
+				Dst:   mustParseCIDR("fd02::/64"),
+				Table: unix.RT_TABLE_MAIN,
+			},
+			pref: RoutePrefHigh,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := nl.NewNetlinkRequest(unix.RTM_NEWROUTE, 0)
+			routeRequest(req, test.route, test.pref)
+
+			b := req.Serialize()[unix.SizeofNlMsghdr:]
+			Expect(nl.DeserializeRtMsg(b).Family).To(BeEquivalentTo(unix.AF_INET6))
+			route, pref, err := parseRouteRequest(b)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(route).To(Equal(test.route))
+			Expect(pref).To(Equal(test.pref))
+		})
+	}
+}
+
+func TestRouteToNB(t *testing.T) {
+	ifIndexes := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndexes.Put("veth1", &ifaceidx.LinuxIfMetadata{HostIfName: "eth0", LinuxIfIndex: 2})
+	ifIndexes.Put("veth2", &ifaceidx.LinuxIfMetadata{HostIfName: "eth1", LinuxIfIndex: 3})
+	ifIndexes.Put("vrf1", &ifaceidx.LinuxIfMetadata{HostIfName: "vrf1", LinuxIfIndex: 4, VrfDevRT: 10})
+	ifIndexes.Put("veth3", &ifaceidx.LinuxIfMetadata{HostIfName: "eth2", LinuxIfIndex: 5, VrfMasterIf: "vrf1"})
+	h := &NetLinkHandler{ifIndexes: ifIndexes}
+
+	prefs := routePrefs{
+		{dst: "2001:db8::/32", table: unix.RT_TABLE_MAIN, linkIndex: 2, priority: 1024}: RoutePrefHigh,
+		{dst: "::/0", table: unix.RT_TABLE_MAIN, priority: 1024}:                        RoutePrefLow,
+	}
+
+	tests := []struct {
+		name    string
+		route   netlink.Route
+		ifName  string
+		ipv6    bool
+		nbRoute *linux_l3.Route
+	}{
+		{
+			name: "default route via gateway",
+			route: netlink.Route{
+				LinkIndex: 2,
+				Type:      unix.RTN_UNICAST,
+				Table:     unix.RT_TABLE_MAIN,
+				Gw:        net.ParseIP("10.0.0.1"),
+				Priority:  100,
+			},
+			ifName: "veth1",
+			nbRoute: &linux_l3.Route{
+				OutgoingInterface: "veth1",
+				DstNetwork:        "0.0.0.0/0",
+				GwAddr:            "10.0.0.1",
+				Metric:            100,
+			},
+		},
+		{
+			name: "route with explicit routing table and path attributes",
+			route: netlink.Route{
+				LinkIndex: 2,
+				Type:      unix.RTN_UNICAST,
+				Table:     20,
+				Dst:       mustParseCIDR("192.168.1.0/24"),
+				Src:       net.ParseIP("10.0.0.5"),
+				Flags:     unix.RTNH_F_ONLINK,
+				MTU:       1400,
+				AdvMSS:    1360,
+			},
+			ifName: "veth1",
+			nbRoute: &linux_l3.Route{
+				OutgoingInterface: "veth1",
+				DstNetwork:        "192.168.1.0/24",
+				SrcAddr:           "10.0.0.5",
+				Table:             20,
+				Onlink:            true,
+				Mtu:               1400,
+				Advmss:            1360,
+			},
+		},
+		{
+			name: "route in the routing table of VRF",
+			route: netlink.Route{
+				LinkIndex: 5,
+				Type:      unix.RTN_UNICAST,
+				Table:     10,
+				Dst:       mustParseCIDR("192.168.2.0/24"),
+			},
+			ifName: "veth3",
+			nbRoute: &linux_l3.Route{
+				OutgoingInterface: "veth3",
+				DstNetwork:        "192.168.2.0/24",
+			},
+		},
+		{
+			name: "IPv6 route with high preference",
+			route: netlink.Route{
+				LinkIndex: 2,
+				Type:      unix.RTN_UNICAST,
+				Table:     unix.RT_TABLE_MAIN,
+				Dst:       mustParseCIDR("2001:db8::/32"),
+				Gw:        net.ParseIP("fd00::1"),
+				Priority:  1024,
+			},
+			ifName: "veth1",
+			ipv6:   true,
+			nbRoute: &linux_l3.Route{
+				OutgoingInterface: "veth1",
+				DstNetwork:        "2001:db8::/32",
+				GwAddr:            "fd00::1",
+				Metric:            1024,
+				Ipv6Preference:    linux_l3.Route_HIGH,
+			},
+		},
+		{
+			name: "IPv6 multipath route with low preference",
+			route: netlink.Route{
+				Type:     unix.RTN_UNICAST,
+				Table:    unix.RT_TABLE_MAIN,
+				Priority: 1024,
+				MultiPath: []*netlink.NexthopInfo{
+					{LinkIndex: 2, Gw: net.ParseIP("fd00::1")},
+					{LinkIndex: 3, Gw: net.ParseIP("fd01::1"), Hops: 2, Flags: unix.RTNH_F_ONLINK},
+				},
+			},
+			ifName: "veth1",
+			ipv6:   true,
+			nbRoute: &linux_l3.Route{
+				OutgoingInterface: "veth1",
+				DstNetwork:        "::/0",
+				Metric:            1024,
+				NextHops: []*linux_l3.Route_NextHop{
+					{GwAddr: "fd00::1", Weight: 1},
+					{GwAddr: "fd01::1", OutgoingInterface: "veth2", Weight: 3, Onlink: true},
+				},
+				Ipv6Preference: linux_l3.Route_LOW,
+			},
+		},
+		{
+			name: "blackhole route",
+			route: netlink.Route{
+				Type:  unix.RTN_BLACKHOLE,
+				Table: unix.RT_TABLE_MAIN,
+				Dst:   mustParseCIDR("10.10.0.0/16"),
+			},
+			nbRoute: &linux_l3.Route{
+				DstNetwork: "10.10.0.0/16",
+				Type:       linux_l3.Route_BLACKHOLE,
+			},
+		},
+		{
+			name: "next hop via unknown interface",
+			route: netlink.Route{
+				Type:  unix.RTN_UNICAST,
+				Table: unix.RT_TABLE_MAIN,
+				Dst:   mustParseCIDR("10.20.0.0/16"),
+				MultiPath: []*netlink.NexthopInfo{
+					{LinkIndex: 2, Gw: net.ParseIP("10.0.0.1")},
+					{LinkIndex: 10, Gw: net.ParseIP("10.1.0.1")},
+				},
+			},
+			ifName: "veth1",
+		},
+		{
+			name: "link-local IPv6 destination",
+			route: netlink.Route{
+				LinkIndex: 2,
+				Type:      unix.RTN_UNICAST,
+				Table:     unix.RT_TABLE_MAIN,
+				Dst:       mustParseCIDR("fe80::/64"),
+			},
+			ifName: "veth1",
+			ipv6:   true,
+		},
+		{
+			name: "broadcast route",
+			route: netlink.Route{
+				LinkIndex: 2,
+				Type:      unix.RTN_BROADCAST,
+				Table:     unix.RT_TABLE_LOCAL,
+				Dst:       mustParseCIDR("10.0.0.255/32"),
+			},
+			ifName: "veth1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			var ifMeta *ifaceidx.LinuxIfMetadata
+			if test.ifName != "" {
+				ifMeta, _ = ifIndexes.LookupByName(test.ifName)
+			}
+			details, ok := h.routeToNB(test.route, test.ifName, ifMeta, test.ipv6, prefs)
+			Expect(ok).To(Equal(test.nbRoute != nil))
+			if test.nbRoute != nil {
+				Expect(proto.Equal(details.Route, test.nbRoute)).To(BeTrue(), "converted: %v", details.Route)
+				Expect(details.Meta.Table).To(BeEquivalentTo(test.route.Table))
+			}
+		})
+	}
+}
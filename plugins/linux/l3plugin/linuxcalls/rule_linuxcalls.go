@@ -0,0 +1,223 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// rule attribute carrying l3mdev flag (rules installed for VRF devices),
+// not defined by the netlink library (see include/uapi/linux/fib_rules.h)
+const fraL3mdev = 19
+
+// Rule represents Linux policy routing rule.
+// Unlike netlink.Rule it carries also the rule action (e.g. blackhole),
+// which is not supported by the netlink library.
+type Rule struct {
+	// Priority (preference) of the rule.
+	Priority uint32
+	// Family is the address family (unix.AF_INET or unix.AF_INET6).
+	Family int
+	// Action is one of the nl.FR_ACT_* constants.
+	Action uint8
+	// Table is the routing table to look up (for nl.FR_ACT_TO_TBL).
+	Table uint32
+	// Src and Dst are the source and destination prefixes to match (nil = any).
+	Src, Dst *net.IPNet
+	// IifName and OifName are host names of the interfaces to match.
+	IifName, OifName string
+	// Mark and Mask are the firewall mark and its mask to match (0 = not matched).
+	Mark, Mask uint32
+	// L3mdev is set for rules installed for VRF devices (read-only).
+	L3mdev bool
+}
+
+// AddRule adds new policy routing rule.
+// Equivalent to: `ip rule add ...`
+func (h *NetLinkHandler) AddRule(rule *Rule) error {
+	req := nl.NewNetlinkRequest(unix.RTM_NEWRULE, unix.NLM_F_CREATE|unix.NLM_F_EXCL|unix.NLM_F_ACK)
+	if err := ruleRequest(req, rule); err != nil {
+		return err
+	}
+	if _, err := req.Execute(unix.NETLINK_ROUTE, 0); err != nil {
+		return errors.Wrapf(err, "RuleAdd (priority=%d)", rule.Priority)
+	}
+	return nil
+}
+
+// DelRule removes policy routing rule.
+// Equivalent to: `ip rule del ...`
+func (h *NetLinkHandler) DelRule(rule *Rule) error {
+	req := nl.NewNetlinkRequest(unix.RTM_DELRULE, unix.NLM_F_ACK)
+	if err := ruleRequest(req, rule); err != nil {
+		return err
+	}
+	if _, err := req.Execute(unix.NETLINK_ROUTE, 0); err != nil {
+		return errors.Wrapf(err, "RuleDel (priority=%d)", rule.Priority)
+	}
+	return nil
+}
+
+// GetRules returns all policy routing rules of the given address family
+// configured in the current namespace.
+// Equivalent to: `ip [-6] rule list`
+func (h *NetLinkHandler) GetRules(family int) ([]*Rule, error) {
+	req := nl.NewNetlinkRequest(unix.RTM_GETRULE, unix.NLM_F_DUMP)
+	req.AddData(nl.NewIfInfomsg(family))
+
+	msgs, err := req.Execute(unix.NETLINK_ROUTE, unix.RTM_NEWRULE)
+	if err != nil {
+		return nil, errors.Wrapf(err, "RuleList (family=%d)", family)
+	}
+
+	var rules []*Rule
+	for _, m := range msgs {
+		rule, err := parseRule(m)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// parseRule parses policy routing rule from the netlink message (without header).
+func parseRule(m []byte) (*Rule, error) {
+	native := nl.NativeEndian()
+	msg := nl.DeserializeRtMsg(m)
+	attrs, err := nl.ParseRouteAttr(m[msg.Len():])
+	if err != nil {
+		return nil, err
+	}
+	rule := &Rule{
+		Family: int(msg.Family),
+		Action: msg.Type,
+		Table:  uint32(msg.Table),
+	}
+	for _, attr := range attrs {
+		switch attr.Attr.Type {
+		case nl.FRA_PRIORITY:
+			rule.Priority = native.Uint32(attr.Value[0:4])
+		case nl.FRA_TABLE:
+			rule.Table = native.Uint32(attr.Value[0:4])
+		case nl.FRA_SRC:
+			rule.Src = &net.IPNet{
+				IP:   net.IP(attr.Value),
+				Mask: net.CIDRMask(int(msg.Src_len), 8*len(attr.Value)),
+			}
+		case nl.FRA_DST:
+			rule.Dst = &net.IPNet{
+				IP:   net.IP(attr.Value),
+				Mask: net.CIDRMask(int(msg.Dst_len), 8*len(attr.Value)),
+			}
+		case nl.FRA_IIFNAME:
+			rule.IifName = parseNlString(attr.Value)
+		case nl.FRA_OIFNAME:
+			rule.OifName = parseNlString(attr.Value)
+		case nl.FRA_FWMARK:
+			rule.Mark = native.Uint32(attr.Value[0:4])
+		case nl.FRA_FWMASK:
+			rule.Mask = native.Uint32(attr.Value[0:4])
+		case fraL3mdev:
+			rule.L3mdev = attr.Value[0] != 0
+		}
+	}
+	return rule, nil
+}
+
+// ruleRequest fills netlink request with the rule header and attributes.
+func ruleRequest(req *nl.NetlinkRequest, rule *Rule) error {
+	msg := nl.NewRtMsg()
+	msg.Family = uint8(rule.Family)
+	msg.Protocol = 0
+	msg.Scope = 0
+	msg.Type = rule.Action
+	msg.Table = unix.RT_TABLE_UNSPEC
+	if rule.Action == nl.FR_ACT_TO_TBL && rule.Table < 256 {
+		msg.Table = uint8(rule.Table)
+	}
+
+	var attrs []*nl.RtAttr
+	if rule.Dst != nil {
+		dstLen, _ := rule.Dst.Mask.Size()
+		msg.Dst_len = uint8(dstLen)
+		dst, err := ipForFamily(rule.Dst.IP, rule.Family)
+		if err != nil {
+			return err
+		}
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_DST, dst))
+	}
+	if rule.Src != nil {
+		srcLen, _ := rule.Src.Mask.Size()
+		msg.Src_len = uint8(srcLen)
+		src, err := ipForFamily(rule.Src.IP, rule.Family)
+		if err != nil {
+			return err
+		}
+		attrs = append(attrs, nl.NewRtAttr(nl.FRA_SRC, src))
+	}
+	req.AddData(msg)
+	for _, attr := range attrs {
+		req.AddData(attr)
+	}
+
+	req.AddData(nl.NewRtAttr(nl.FRA_PRIORITY, nl.Uint32Attr(rule.Priority)))
+	if rule.Action == nl.FR_ACT_TO_TBL {
+		req.AddData(nl.NewRtAttr(nl.FRA_TABLE, nl.Uint32Attr(rule.Table)))
+	}
+	if rule.Mark != 0 {
+		req.AddData(nl.NewRtAttr(nl.FRA_FWMARK, nl.Uint32Attr(rule.Mark)))
+	}
+	if rule.Mask != 0 {
+		req.AddData(nl.NewRtAttr(nl.FRA_FWMASK, nl.Uint32Attr(rule.Mask)))
+	}
+	if rule.IifName != "" {
+		req.AddData(nl.NewRtAttr(nl.FRA_IIFNAME, nl.ZeroTerminated(rule.IifName)))
+	}
+	if rule.OifName != "" {
+		req.AddData(nl.NewRtAttr(nl.FRA_OIFNAME, nl.ZeroTerminated(rule.OifName)))
+	}
+	return nil
+}
+
+// ipForFamily returns IP address encoded for the given address family.
+func ipForFamily(ip net.IP, family int) ([]byte, error) {
+	if family == unix.AF_INET {
+		if ip4 := ip.To4(); ip4 != nil {
+			return ip4, nil
+		}
+		return nil, errors.Errorf("IP address %v is not IPv4", ip)
+	}
+	if ip.To4() != nil {
+		return nil, errors.Errorf("IP address %v is not IPv6", ip)
+	}
+	return ip.To16(), nil
+}
+
+// parseNlString parses (possibly zero-terminated) string attribute.
+func parseNlString(value []byte) string {
+	for i, b := range value {
+		if b == 0 {
+			return string(value[:i])
+		}
+	}
+	return string(value)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"net"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink/nl"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"golang.org/x/sys/unix"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	linux_l3 "go.ligato.io/vpp-agent/v3/proto/ligato/linux/l3"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return ipNet
+}
+
+func TestRuleRequestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		rule *Rule
+	}{
+		{
+			name: "IPv4 source rule with table lookup",
+			rule: &Rule{
+				Priority: 100,
+				Family:   unix.AF_INET,
+				Action:   nl.FR_ACT_TO_TBL,
+				Table:    10,
+				Src:      mustParseCIDR("10.0.0.0/24"),
+			},
+		},
+		{
+			name: "IPv6 rule with table outside of the 8-bit range",
+			rule: &Rule{
+				Priority: 200,
+				Family:   unix.AF_INET6,
+				Action:   nl.FR_ACT_TO_TBL,
+				Table:    1000,
+				Src:      mustParseCIDR("fd00::/64"),
+				Dst:      mustParseCIDR("2001:db8::/32"),
+			},
+		},
+		{
+			name: "blackhole rule with interfaces and firewall mark",
+			rule: &Rule{
+				Priority: 300,
+				Family:   unix.AF_INET,
+				Action:   nl.FR_ACT_BLACKHOLE,
+				IifName:  "veth1",
+				OifName:  "veth2",
+				Mark:     0x10,
+				Mask:     0xff,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := nl.NewNetlinkRequest(unix.RTM_NEWRULE, 0)
+			Expect(ruleRequest(req, test.rule)).To(Succeed())
+
+			rule, err := parseRule(req.Serialize()[unix.SizeofNlMsghdr:])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rule).To(Equal(test.rule))
+		})
+	}
+}
+
+func TestRuleRequestWithMixedFamilies(t *testing.T) {
+	RegisterTestingT(t)
+
+	req := nl.NewNetlinkRequest(unix.RTM_NEWRULE, 0)
+	err := ruleRequest(req, &Rule{
+		Priority: 100,
+		Family:   unix.AF_INET,
+		Src:      mustParseCIDR("fd00::/64"),
+	})
+	Expect(err).To(HaveOccurred())
+
+	req = nl.NewNetlinkRequest(unix.RTM_NEWRULE, 0)
+	err = ruleRequest(req, &Rule{
+		Priority: 100,
+		Family:   unix.AF_INET6,
+		Dst:      mustParseCIDR("10.0.0.0/8"),
+	})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestRuleToNB(t *testing.T) {
+	ns := &namespace.NetNamespace{
+		Type:      namespace.NetNamespace_MICROSERVICE,
+		Reference: "microservice1",
+	}
+	ifIndexes := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndexes.Put("veth1", &ifaceidx.LinuxIfMetadata{HostIfName: "eth0", Namespace: ns})
+	h := &NetLinkHandler{ifIndexes: ifIndexes}
+
+	tests := []struct {
+		name   string
+		rule   *Rule
+		nbRule *linux_l3.Rule
+	}{
+		{
+			name: "lookup of the main table",
+			rule: &Rule{
+				Priority: 100,
+				Family:   unix.AF_INET,
+				Action:   nl.FR_ACT_TO_TBL,
+				Table:    unix.RT_TABLE_MAIN,
+				Src:      mustParseCIDR("10.0.0.0/24"),
+			},
+			nbRule: &linux_l3.Rule{
+				Priority:  100,
+				Namespace: ns,
+				From:      "10.0.0.0/24",
+			},
+		},
+		{
+			name: "IPv6 prohibit rule with incoming interface",
+			rule: &Rule{
+				Priority: 200,
+				Family:   unix.AF_INET6,
+				Action:   nl.FR_ACT_PROHIBIT,
+				Dst:      mustParseCIDR("2001:db8::/32"),
+				IifName:  "eth0",
+				Mark:     1,
+			},
+			nbRule: &linux_l3.Rule{
+				Priority:          200,
+				Namespace:         ns,
+				Family:            linux_l3.Rule_IPV6,
+				To:                "2001:db8::/32",
+				IncomingInterface: "veth1",
+				Action:            linux_l3.Rule_PROHIBIT,
+				Fwmark:            1,
+			},
+		},
+		{
+			name: "unknown interface",
+			rule: &Rule{
+				Priority: 300,
+				Family:   unix.AF_INET,
+				Action:   nl.FR_ACT_TO_TBL,
+				Table:    10,
+				OifName:  "eth1",
+			},
+		},
+		{
+			name: "unsupported action",
+			rule: &Rule{
+				Priority: 400,
+				Family:   unix.AF_INET,
+				Action:   nl.FR_ACT_GOTO,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			nbRule, ok := h.ruleToNB(test.rule, ns)
+			Expect(ok).To(Equal(test.nbRule != nil))
+			if test.nbRule != nil {
+				Expect(proto.Equal(nbRule, test.nbRule)).To(BeTrue(), "converted: %v", nbRule)
+			}
+		})
+	}
+}
@@ -0,0 +1,431 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/utils/addrs"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
+	linux_iptables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/iptables"
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+	netalloc_api "go.ligato.io/vpp-agent/v3/proto/ligato/netalloc"
+)
+
+const (
+	// mark mask selecting all bits of the firewall mark
+	fullMarkMask = 0xffffffff
+
+	// maximum transport-layer port number
+	maxPort = 65535
+)
+
+// A list of non-retriable errors returned for invalid structured rules:
+var (
+	// ErrRuleInInterfaceInChain is returned when the incoming interface is matched in a chain
+	// attached to a hook traversed only by locally generated packets.
+	ErrRuleInInterfaceInChain = errors.New("incoming interface cannot be matched in chains attached to OUTPUT and POSTROUTING hooks")
+
+	// ErrRuleOutInterfaceInChain is returned when the outgoing interface is matched in a chain
+	// attached to a hook traversed by packets before the routing decision.
+	ErrRuleOutInterfaceInChain = errors.New("outgoing interface cannot be matched in chains attached to INPUT and PREROUTING hooks")
+
+	// ErrRuleProtocolForFamily is returned when ICMP is matched in an IP6 table or ICMPv6 in an IP table.
+	ErrRuleProtocolForFamily = errors.New("protocol is not valid for the table family")
+
+	// ErrRuleAddressForFamily is returned when the IP version of the address does not match the table family.
+	ErrRuleAddressForFamily = errors.New("address is not valid for the table family")
+
+	// ErrRulePortWithoutProtocol is returned when port is used without protocol that has ports.
+	ErrRulePortWithoutProtocol = errors.New("port can be used only with TCP, UDP or SCTP protocol")
+
+	// ErrRuleInvalidPortRange is returned when port range is not valid.
+	ErrRuleInvalidPortRange = errors.New("invalid port range")
+
+	// ErrRuleNATOutsideNATChain is returned when DNAT or SNAT target is used in a base chain
+	// of type other than NAT.
+	ErrRuleNATOutsideNATChain = errors.New("DNAT and SNAT targets can be used only in base chains of the NAT type")
+
+	// ErrRuleNATInvalidHook is returned when DNAT is used in a chain attached to a hook other than
+	// PREROUTING/OUTPUT or SNAT in a chain attached to a hook other than POSTROUTING/INPUT.
+	ErrRuleNATInvalidHook = errors.New("DNAT target is valid only in PREROUTING and OUTPUT hooks, " +
+		"SNAT target only in POSTROUTING and INPUT hooks")
+
+	// ErrRuleNATWithoutAddress is returned when DNAT or SNAT target is used without the address to translate to.
+	ErrRuleNATWithoutAddress = errors.New("DNAT and SNAT targets require address to translate to")
+
+	// ErrRuleMarkWithoutValue is returned when MARK target is used without the mark to set.
+	ErrRuleMarkWithoutValue = errors.New("MARK target requires mark to set")
+
+	// ErrRuleJumpWithoutChain is returned when JUMP target is used without the chain to jump to.
+	ErrRuleJumpWithoutChain = errors.New("JUMP target requires chain to jump to")
+
+	// ErrRuleJumpToUnknownChain is returned when JUMP target refers to a chain not defined in the table.
+	ErrRuleJumpToUnknownChain = errors.New("JUMP target refers to a chain not defined in the table")
+
+	// ErrRuleParamForTarget is returned when a target parameter is defined for a different target.
+	ErrRuleParamForTarget = errors.New("parameter is not applicable to the rule target")
+)
+
+// validateRule validates structured rule of the given chain.
+func (d *TableDescriptor) validateRule(table *linux_nftables.Table, chain *linux_nftables.Table_Chain,
+	rule *linux_iptables.Rule) error {
+
+	baseChain := chain.BaseChain
+
+	// interfaces
+	if rule.InInterface != "" && baseChain != nil && (baseChain.Hook == linux_nftables.Table_Chain_BaseChain_OUTPUT ||
+		baseChain.Hook == linux_nftables.Table_Chain_BaseChain_POSTROUTING) {
+		return kvs.NewInvalidValueError(ErrRuleInInterfaceInChain,
+			"chains.base_chain.hook", "chains.structured_rules.in_interface")
+	}
+	if rule.OutInterface != "" && baseChain != nil && (baseChain.Hook == linux_nftables.Table_Chain_BaseChain_INPUT ||
+		baseChain.Hook == linux_nftables.Table_Chain_BaseChain_PREROUTING) {
+		return kvs.NewInvalidValueError(ErrRuleOutInterfaceInChain,
+			"chains.base_chain.hook", "chains.structured_rules.out_interface")
+	}
+
+	// protocol and ports
+	if (rule.Protocol == linux_iptables.Rule_ICMP && table.Family == linux_nftables.Table_IP6) ||
+		(rule.Protocol == linux_iptables.Rule_ICMPV6 && table.Family == linux_nftables.Table_IP) {
+		return kvs.NewInvalidValueError(ErrRuleProtocolForFamily, "family", "chains.structured_rules.protocol")
+	}
+	ports := []struct {
+		field     string
+		portRange *linux_iptables.Rule_PortRange
+	}{
+		{field: "chains.structured_rules.source_port", portRange: rule.SourcePort},
+		{field: "chains.structured_rules.destination_port", portRange: rule.DestinationPort},
+		{field: "chains.structured_rules.to_port", portRange: rule.ToPort},
+	}
+	for _, port := range ports {
+		field, portRange := port.field, port.portRange
+		if portRange == nil {
+			continue
+		}
+		if !hasPorts(rule.Protocol) {
+			return kvs.NewInvalidValueError(ErrRulePortWithoutProtocol, "chains.structured_rules.protocol", field)
+		}
+		if portRange.From == 0 || portRange.From > maxPort || portRange.To > maxPort ||
+			(portRange.To != 0 && portRange.To < portRange.From) {
+			return kvs.NewInvalidValueError(ErrRuleInvalidPortRange, field)
+		}
+	}
+
+	// addresses
+	for _, address := range ruleAddresses(rule) {
+		field, addr := "chains.structured_rules."+address.field, address.addr
+		if addr == "" {
+			continue
+		}
+		err := d.addrAlloc.ValidateIPAddress(addr, "", field, netalloc.GWRefAllowed)
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(addr, netalloc_api.AllocRefPrefix) || table.Family == linux_nftables.Table_INET {
+			continue
+		}
+		if isIPv6, _ := addrs.IsIPv6(addr); isIPv6 != (table.Family == linux_nftables.Table_IP6) {
+			return kvs.NewInvalidValueError(ErrRuleAddressForFamily, "family", field)
+		}
+	}
+
+	// target
+	isNAT := rule.Target == linux_iptables.Rule_DNAT || rule.Target == linux_iptables.Rule_SNAT
+	if !isNAT && (rule.ToAddress != "" || rule.ToPort != nil) {
+		return kvs.NewInvalidValueError(ErrRuleParamForTarget, "chains.structured_rules.target",
+			"chains.structured_rules.to_address", "chains.structured_rules.to_port")
+	}
+	if rule.Target != linux_iptables.Rule_MARK && rule.SetMark != nil {
+		return kvs.NewInvalidValueError(ErrRuleParamForTarget,
+			"chains.structured_rules.target", "chains.structured_rules.set_mark")
+	}
+	if rule.Target != linux_iptables.Rule_JUMP && rule.JumpChain != "" {
+		return kvs.NewInvalidValueError(ErrRuleParamForTarget,
+			"chains.structured_rules.target", "chains.structured_rules.jump_chain")
+	}
+	switch rule.Target {
+	case linux_iptables.Rule_DNAT, linux_iptables.Rule_SNAT:
+		if baseChain != nil && baseChain.Type != linux_nftables.Table_Chain_BaseChain_NAT {
+			return kvs.NewInvalidValueError(ErrRuleNATOutsideNATChain,
+				"chains.base_chain.type", "chains.structured_rules.target")
+		}
+		if baseChain != nil && !isAllowedNATHook(rule.Target, baseChain.Hook) {
+			return kvs.NewInvalidValueError(ErrRuleNATInvalidHook,
+				"chains.base_chain.hook", "chains.structured_rules.target")
+		}
+		if rule.ToAddress == "" {
+			return kvs.NewInvalidValueError(ErrRuleNATWithoutAddress, "chains.structured_rules.to_address")
+		}
+	case linux_iptables.Rule_MARK:
+		if rule.SetMark == nil {
+			return kvs.NewInvalidValueError(ErrRuleMarkWithoutValue, "chains.structured_rules.set_mark")
+		}
+	case linux_iptables.Rule_JUMP:
+		if rule.JumpChain == "" {
+			return kvs.NewInvalidValueError(ErrRuleJumpWithoutChain, "chains.structured_rules.jump_chain")
+		}
+		if findChain(table, rule.JumpChain) == nil {
+			return kvs.NewInvalidValueError(ErrRuleJumpToUnknownChain, "chains.structured_rules.jump_chain")
+		}
+	}
+	return nil
+}
+
+// ruleDependencies lists dependencies of the structured rule with the given index.
+func (d *TableDescriptor) ruleDependencies(chain string, idx int, rule *linux_iptables.Rule) (deps []kvs.Dependency) {
+	for _, address := range ruleAddresses(rule) {
+		if address.addr == "" {
+			continue
+		}
+		// netalloc reference must be allocated first
+		labelPrefix := fmt.Sprintf("%s-structured_rules-%d-%s-", chain, idx, address.field)
+		allocDep, hasAllocDep := d.addrAlloc.GetAddressAllocDep(address.addr, "", labelPrefix)
+		if hasAllocDep {
+			deps = append(deps, allocDep)
+		}
+	}
+	return deps
+}
+
+// renderRules returns all rules of the given chain in the nft syntax,
+// i.e. rules defined in the nft syntax followed by rendered structured rules.
+func (d *TableDescriptor) renderRules(table *linux_nftables.Table, chain *linux_nftables.Table_Chain) ([]string, error) {
+	if len(chain.StructuredRules) == 0 {
+		return chain.Rules, nil
+	}
+	rules := make([]string, 0, len(chain.Rules)+len(chain.StructuredRules))
+	rules = append(rules, chain.Rules...)
+	for i, rule := range chain.StructuredRules {
+		ruleStr, err := d.renderRule(table, rule)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to render structured rule #%d of chain %s", i, chain.Name)
+		}
+		rules = append(rules, ruleStr)
+	}
+	return rules, nil
+}
+
+// renderRule renders structured rule into the nft syntax.
+// The rule is rendered in the same form as printed by "nft list" so that it can be
+// compared with the retrieved rules.
+func (d *TableDescriptor) renderRule(table *linux_nftables.Table, rule *linux_iptables.Rule) (string, error) {
+	var args []string
+
+	// interfaces
+	if rule.InInterface != "" {
+		hostName, err := d.hostIfName(rule.InInterface)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, "iifname", fmt.Sprintf("%q", hostName))
+	}
+	if rule.OutInterface != "" {
+		hostName, err := d.hostIfName(rule.OutInterface)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, "oifname", fmt.Sprintf("%q", hostName))
+	}
+
+	// addresses
+	if rule.Source != "" {
+		ip, source, err := d.ruleNetwork(rule.Source)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, ip, "saddr", source)
+	}
+	if rule.Destination != "" {
+		ip, destination, err := d.ruleNetwork(rule.Destination)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, ip, "daddr", destination)
+	}
+
+	// protocol and ports
+	if rule.Protocol != linux_iptables.Rule_ANY {
+		protoName := protocolStr(rule.Protocol)
+		if rule.SourcePort == nil && rule.DestinationPort == nil {
+			args = append(args, "meta", "l4proto", protoName)
+		}
+		if rule.SourcePort != nil {
+			args = append(args, protoName, "sport", portRangeStr(rule.SourcePort))
+		}
+		if rule.DestinationPort != nil {
+			args = append(args, protoName, "dport", portRangeStr(rule.DestinationPort))
+		}
+	}
+
+	// connection tracking state
+	if len(rule.ConnStates) > 0 {
+		args = append(args, "ct", "state", connStatesStr(rule.ConnStates))
+	}
+
+	// firewall mark
+	if rule.Mark != nil {
+		if rule.Mark.Mask != 0 && rule.Mark.Mask != fullMarkMask {
+			args = append(args, "meta", "mark", "&", fmt.Sprintf("0x%08x", rule.Mark.Mask), "==")
+		} else {
+			args = append(args, "meta", "mark")
+		}
+		args = append(args, fmt.Sprintf("0x%08x", rule.Mark.Value))
+	}
+
+	// target
+	switch rule.Target {
+	case linux_iptables.Rule_DROP:
+		args = append(args, "drop")
+	case linux_iptables.Rule_DNAT, linux_iptables.Rule_SNAT:
+		toAddr, err := d.addrAlloc.GetOrParseIPAddress(rule.ToAddress, "", netalloc_api.IPAddressForm_ADDR_ONLY)
+		if err != nil {
+			return "", err
+		}
+		ipv4 := toAddr.IP.To4() != nil
+		to := toAddr.IP.String()
+		if rule.ToPort != nil {
+			if !ipv4 {
+				to = "[" + to + "]"
+			}
+			to += ":" + portRangeStr(rule.ToPort)
+		}
+		args = append(args, strings.ToLower(rule.Target.String()))
+		if table.Family == linux_nftables.Table_INET {
+			// address family of the translation has to be specified in the inet family
+			if ipv4 {
+				args = append(args, "ip")
+			} else {
+				args = append(args, "ip6")
+			}
+		}
+		args = append(args, "to", to)
+	case linux_iptables.Rule_MARK:
+		mark := fmt.Sprintf("0x%08x", rule.SetMark.Value)
+		if mask := rule.SetMark.Mask; mask != 0 && mask != fullMarkMask {
+			mark = fmt.Sprintf("meta mark & 0x%08x | %s", ^mask, mark)
+		}
+		args = append(args, "meta", "mark", "set", mark)
+	case linux_iptables.Rule_JUMP:
+		args = append(args, "jump", rule.JumpChain)
+	default:
+		args = append(args, "accept")
+	}
+
+	return strings.Join(args, " "), nil
+}
+
+// ruleNetwork returns network matched by the rule for the given address or netalloc
+// reference, together with the protocol ("ip" or "ip6") of the network.
+// Referenced addresses are matched as single host addresses.
+func (d *TableDescriptor) ruleNetwork(addrOrAllocRef string) (ip, network string, err error) {
+	_, _, _, isRef, _ := d.addrAlloc.ParseAddressAllocRef(addrOrAllocRef, "")
+	addrForm := netalloc_api.IPAddressForm_ADDR_NET
+	if isRef {
+		addrForm = netalloc_api.IPAddressForm_ADDR_ONLY
+	}
+	ipNet, err := d.addrAlloc.GetOrParseIPAddress(addrOrAllocRef, "", addrForm)
+	if err != nil {
+		return "", "", err
+	}
+	ip = "ip6"
+	if ipNet.IP.To4() != nil {
+		ip = "ip"
+	}
+	// host addresses are printed without the prefix length
+	if isRef {
+		return ip, ipNet.IP.String(), nil
+	}
+	if ones, bits := ipNet.Mask.Size(); ones == bits {
+		return ip, ipNet.IP.String(), nil
+	}
+	return ip, ipNet.String(), nil
+}
+
+// ruleAddress is an address (or netalloc reference) used by a structured rule.
+type ruleAddress struct {
+	field string
+	addr  string
+}
+
+// ruleAddresses returns all addresses used by the given structured rule (incl. undefined).
+func ruleAddresses(rule *linux_iptables.Rule) []ruleAddress {
+	return []ruleAddress{
+		{field: "source", addr: rule.Source},
+		{field: "destination", addr: rule.Destination},
+		{field: "to_address", addr: rule.ToAddress},
+	}
+}
+
+// isAllowedNATHook returns true if the given NAT target can be used in the chain attached to the given hook.
+func isAllowedNATHook(target linux_iptables.Rule_Target, hook linux_nftables.Table_Chain_BaseChain_Hook) bool {
+	switch hook {
+	case linux_nftables.Table_Chain_BaseChain_PREROUTING, linux_nftables.Table_Chain_BaseChain_OUTPUT:
+		return target == linux_iptables.Rule_DNAT
+	case linux_nftables.Table_Chain_BaseChain_POSTROUTING, linux_nftables.Table_Chain_BaseChain_INPUT:
+		return target == linux_iptables.Rule_SNAT
+	}
+	return false
+}
+
+// hasPorts returns true if the given protocol has ports.
+func hasPorts(protocol linux_iptables.Rule_Protocol) bool {
+	switch protocol {
+	case linux_iptables.Rule_TCP, linux_iptables.Rule_UDP, linux_iptables.Rule_SCTP:
+		return true
+	}
+	return false
+}
+
+// protocolStr returns nft name of the given protocol.
+func protocolStr(protocol linux_iptables.Rule_Protocol) string {
+	if protocol == linux_iptables.Rule_ICMPV6 {
+		return "ipv6-icmp"
+	}
+	return strings.ToLower(protocol.String())
+}
+
+// portRangeStr returns port range in the nft syntax.
+func portRangeStr(portRange *linux_iptables.Rule_PortRange) string {
+	if portRange.To == 0 || portRange.To == portRange.From {
+		return fmt.Sprint(portRange.From)
+	}
+	return fmt.Sprintf("%d-%d", portRange.From, portRange.To)
+}
+
+// connStatesStr returns connection tracking states in the nft syntax,
+// ordered the same way as printed by nft.
+func connStatesStr(states []linux_iptables.Rule_ConnState) string {
+	var strs []string
+	for _, state := range []linux_iptables.Rule_ConnState{
+		linux_iptables.Rule_INVALID,
+		linux_iptables.Rule_ESTABLISHED,
+		linux_iptables.Rule_RELATED,
+		linux_iptables.Rule_NEW,
+		linux_iptables.Rule_UNTRACKED,
+	} {
+		for _, s := range states {
+			if s == state {
+				strs = append(strs, strings.ToLower(state.String()))
+				break
+			}
+		}
+	}
+	return strings.Join(strs, ",")
+}
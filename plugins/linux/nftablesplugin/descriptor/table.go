@@ -0,0 +1,831 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
+	netalloc_descr "go.ligato.io/vpp-agent/v3/plugins/netalloc/descriptor"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+const (
+	// TableDescriptorName is the name of the descriptor for nftables tables.
+	TableDescriptorName = "linux-nftables-table-descriptor"
+
+	// dependency labels
+	tableInterfaceDep = "interface-exists"
+	microserviceDep   = "microservice-available"
+
+	// minimum number of tables to be given to a single Go routine for processing
+	// in the Retrieve operation
+	minWorkForGoRoutine = 3
+)
+
+// spaces around the dash of a range, e.g. "1024 - 65535"
+var rangeSpaces = regexp.MustCompile(`\s*-\s*`)
+
+// A list of non-retriable errors:
+var (
+	// ErrTableWithoutName is returned when the table is defined without name.
+	ErrTableWithoutName = errors.New("nftables table defined without name")
+
+	// ErrDuplicateSet is returned when the set name is not unique within the table.
+	ErrDuplicateSet = errors.New("nftables set name is not unique within the table")
+
+	// ErrSetWithoutName is returned when the set is defined without name.
+	ErrSetWithoutName = errors.New("nftables set defined without name")
+
+	// ErrIntervalSetType is returned when the interval flag is used with a set type without ranges.
+	ErrIntervalSetType = errors.New("interval flag is not applicable to the set type")
+
+	// ErrDuplicateChain is returned when the chain name is not unique within the table.
+	ErrDuplicateChain = errors.New("nftables chain name is not unique within the table")
+
+	// ErrChainWithoutName is returned when the chain is defined without name.
+	ErrChainWithoutName = errors.New("nftables chain defined without name")
+
+	// ErrInvalidChainTypeForFamily is returned when the base chain type is not supported by the table family.
+	ErrInvalidChainTypeForFamily = errors.New("base chain type is not valid for the table family")
+
+	// ErrInvalidHookForFamily is returned when the hook is not supported by the table family.
+	ErrInvalidHookForFamily = errors.New("base chain hook is not valid for the table family")
+
+	// ErrRouteChainHook is returned when the ROUTE base chain is attached to a hook other than OUTPUT.
+	ErrRouteChainHook = errors.New("base chain of the ROUTE type can be attached only to the OUTPUT hook")
+
+	// ErrIngressWithoutInterface is returned when the INGRESS base chain is defined without interface.
+	ErrIngressWithoutInterface = errors.New("base chain attached to the INGRESS hook requires interface")
+
+	// ErrInterfaceWithoutIngress is returned when interface is defined for base chain not attached to INGRESS.
+	ErrInterfaceWithoutIngress = errors.New("interface is applicable only to base chain attached to the INGRESS hook")
+
+	// ErrStructuredRuleForFamily is returned when structured rules are used in a table of the family
+	// other than INET, IP or IP6.
+	ErrStructuredRuleForFamily = errors.New("structured rules can be used only in INET, IP and IP6 tables")
+)
+
+// TableDescriptor teaches KVScheduler how to configure nftables tables.
+type TableDescriptor struct {
+	log             logging.Logger
+	ifPlugin        ifplugin.API
+	nsPlugin        nsplugin.API
+	addrAlloc       netalloc.AddressAllocator
+	scheduler       kvs.KVScheduler
+	nfTablesHandler linuxcalls.NFTablesAPI
+
+	// parallelization of the Retrieve operation
+	goRoutinesCnt int
+}
+
+// NewTableDescriptor creates a new instance of the nftables Table descriptor.
+func NewTableDescriptor(
+	scheduler kvs.KVScheduler, nfTablesHandler linuxcalls.NFTablesAPI, ifPlugin ifplugin.API,
+	nsPlugin nsplugin.API, addrAlloc netalloc.AddressAllocator, log logging.PluginLogger,
+	goRoutinesCnt int) *kvs.KVDescriptor {
+
+	descrCtx := &TableDescriptor{
+		scheduler:       scheduler,
+		nfTablesHandler: nfTablesHandler,
+		ifPlugin:        ifPlugin,
+		nsPlugin:        nsPlugin,
+		addrAlloc:       addrAlloc,
+		goRoutinesCnt:   goRoutinesCnt,
+		log:             log.NewLogger("nft-table-descriptor"),
+	}
+
+	typedDescr := &adapter.TableDescriptor{
+		Name:               TableDescriptorName,
+		NBKeyPrefix:        linux_nftables.ModelTable.KeyPrefix(),
+		ValueTypeName:      linux_nftables.ModelTable.ProtoName(),
+		KeySelector:        linux_nftables.ModelTable.IsKeyValid,
+		KeyLabel:           linux_nftables.ModelTable.StripKeyPrefix,
+		ValueComparator:    descrCtx.EquivalentTables,
+		Validate:           descrCtx.Validate,
+		Create:             descrCtx.Create,
+		Delete:             descrCtx.Delete,
+		Update:             descrCtx.Update,
+		UpdateWithRecreate: descrCtx.UpdateWithRecreate,
+		Retrieve:           descrCtx.Retrieve,
+		Dependencies:       descrCtx.Dependencies,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			netalloc_descr.IPAllocDescriptorName,
+		},
+	}
+	return adapter.NewTableDescriptor(typedDescr)
+}
+
+// EquivalentTables is a comparison function for two Table entries.
+func (d *TableDescriptor) EquivalentTables(key string, oldTable, newTable *linux_nftables.Table) bool {
+	// first, compare everything except the sets and chains
+	if oldTable.Name != newTable.Name || tableName(oldTable) != tableName(newTable) ||
+		oldTable.Family != newTable.Family || !proto.Equal(oldTable.Namespace, newTable.Namespace) {
+		return false
+	}
+
+	// compare sets (the order of sets and of their elements is irrelevant)
+	if len(oldTable.Sets) != len(newTable.Sets) {
+		return false
+	}
+	for _, oldSet := range oldTable.Sets {
+		newSet := findSet(newTable, oldSet.Name)
+		if newSet == nil || !equivalentSets(oldSet, newSet) {
+			return false
+		}
+	}
+
+	// compare chains (the order of chains is irrelevant)
+	if len(oldTable.Chains) != len(newTable.Chains) {
+		return false
+	}
+	for _, oldChain := range oldTable.Chains {
+		newChain := findChain(newTable, oldChain.Name)
+		if newChain == nil || !proto.Equal(oldChain.BaseChain, newChain.BaseChain) {
+			return false
+		}
+		if !d.equivalentRules(oldTable, oldChain, newTable, newChain) {
+			return false
+		}
+	}
+	return true
+}
+
+// equivalentRules compares rules of two chains.
+func (d *TableDescriptor) equivalentRules(oldTable *linux_nftables.Table, oldChain *linux_nftables.Table_Chain,
+	newTable *linux_nftables.Table, newChain *linux_nftables.Table_Chain) bool {
+
+	// structured rules are compared in the rendered form, which is also
+	// what gets retrieved
+	oldRules, oldErr := d.renderRules(oldTable, oldChain)
+	newRules, newErr := d.renderRules(newTable, newChain)
+	if oldErr != nil || newErr != nil {
+		// referenced interface or address is not available (yet)
+		if len(oldChain.StructuredRules) != len(newChain.StructuredRules) {
+			return false
+		}
+		for i := range oldChain.StructuredRules {
+			if !proto.Equal(oldChain.StructuredRules[i], newChain.StructuredRules[i]) {
+				return false
+			}
+		}
+		oldRules, newRules = oldChain.Rules, newChain.Rules
+	}
+
+	// compare rule count
+	if len(oldRules) != len(newRules) {
+		return false
+	}
+
+	// compare individual rules one by one
+	// note that the rules can have individual parts reordered, e.g. the rule
+	// "iifname eth0 ip saddr 192.168.0.1 accept" is equivalent to
+	// "ip saddr 192.168.0.1 iifname eth0 accept"
+	family := familyStr(newTable.Family)
+	for i := range oldRules {
+		// tokenize both rules into the form printed by nft
+		oldTokens := normalizeRule(canonicalRule(family, oldRules[i]))
+		newTokens := normalizeRule(canonicalRule(family, newRules[i]))
+		// compare token counts first
+		if len(oldTokens) != len(newTokens) {
+			return false
+		}
+		// check if the rules consist of the same tokens
+		sort.Strings(oldTokens)
+		sort.Strings(newTokens)
+		for j := range oldTokens {
+			if oldTokens[j] != newTokens[j] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Validate validates nftables table.
+func (d *TableDescriptor) Validate(key string, table *linux_nftables.Table) (err error) {
+	if table.Name == "" {
+		return kvs.NewInvalidValueError(ErrTableWithoutName, "name")
+	}
+	for i, set := range table.Sets {
+		if set.Name == "" {
+			return kvs.NewInvalidValueError(ErrSetWithoutName, "sets.name")
+		}
+		for _, prevSet := range table.Sets[:i] {
+			if prevSet.Name == set.Name {
+				return kvs.NewInvalidValueError(ErrDuplicateSet, "sets.name")
+			}
+		}
+		if set.Interval && (set.Type == linux_nftables.Table_Set_ETHER_ADDR ||
+			set.Type == linux_nftables.Table_Set_MARK || set.Type == linux_nftables.Table_Set_IFNAME) {
+			return kvs.NewInvalidValueError(ErrIntervalSetType, "sets.type", "sets.interval")
+		}
+		for _, elem := range set.Elements {
+			if _, err = linuxcalls.CanonicalElement(setTypeStr(set.Type), elem); err != nil {
+				return kvs.NewInvalidValueError(err, "sets.elements")
+			}
+		}
+	}
+	for i, chain := range table.Chains {
+		if chain.Name == "" {
+			return kvs.NewInvalidValueError(ErrChainWithoutName, "chains.name")
+		}
+		for _, prevChain := range table.Chains[:i] {
+			if prevChain.Name == chain.Name {
+				return kvs.NewInvalidValueError(ErrDuplicateChain, "chains.name")
+			}
+		}
+		if err = validateBaseChain(table.Family, chain.BaseChain); err != nil {
+			return err
+		}
+		for _, rule := range chain.Rules {
+			if _, err = linuxcalls.CanonicalRule(familyStr(table.Family), rule); err != nil {
+				return kvs.NewInvalidValueError(err, "chains.rules")
+			}
+		}
+		if len(chain.StructuredRules) > 0 && !isIPFamily(table.Family) {
+			return kvs.NewInvalidValueError(ErrStructuredRuleForFamily, "family", "chains.structured_rules")
+		}
+		for _, rule := range chain.StructuredRules {
+			if err = d.validateRule(table, chain, rule); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateBaseChain validates base chain attributes against the table family.
+func validateBaseChain(family linux_nftables.Table_Family, baseChain *linux_nftables.Table_Chain_BaseChain) error {
+	if baseChain == nil {
+		// regular chain
+		return nil
+	}
+	if baseChain.Type != linux_nftables.Table_Chain_BaseChain_FILTER && !isIPFamily(family) {
+		return kvs.NewInvalidValueError(ErrInvalidChainTypeForFamily, "family", "chains.base_chain.type")
+	}
+	if baseChain.Type == linux_nftables.Table_Chain_BaseChain_ROUTE &&
+		baseChain.Hook != linux_nftables.Table_Chain_BaseChain_OUTPUT {
+		return kvs.NewInvalidValueError(ErrRouteChainHook, "chains.base_chain.type", "chains.base_chain.hook")
+	}
+	if !isAllowedHook(family, baseChain.Hook) {
+		return kvs.NewInvalidValueError(ErrInvalidHookForFamily, "family", "chains.base_chain.hook")
+	}
+	ingress := baseChain.Hook == linux_nftables.Table_Chain_BaseChain_INGRESS
+	if ingress && baseChain.Interface == "" {
+		return kvs.NewInvalidValueError(ErrIngressWithoutInterface, "chains.base_chain.interface")
+	}
+	if !ingress && baseChain.Interface != "" {
+		return kvs.NewInvalidValueError(ErrInterfaceWithoutIngress,
+			"chains.base_chain.hook", "chains.base_chain.interface")
+	}
+	return nil
+}
+
+// Create creates nftables table.
+func (d *TableDescriptor) Create(key string, table *linux_nftables.Table) (metadata interface{}, err error) {
+	d.log.Debugf("CREATE NFT table %s: %v", key, table)
+	return nil, d.updateTable(nil, table)
+}
+
+// Update updates the content of the nftables table in place in a single transaction.
+func (d *TableDescriptor) Update(key string, oldTable, newTable *linux_nftables.Table, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	d.log.Debugf("UPDATE NFT table %s: %v", key, newTable)
+	return nil, d.updateTable(oldTable, newTable)
+}
+
+// UpdateWithRecreate returns true if the table is identified differently in nftables.
+func (d *TableDescriptor) UpdateWithRecreate(key string, oldTable, newTable *linux_nftables.Table, metadata interface{}) bool {
+	return tableName(oldTable) != tableName(newTable) || oldTable.Family != newTable.Family ||
+		!proto.Equal(oldTable.Namespace, newTable.Namespace)
+}
+
+// updateTable renders the table into the nft syntax and creates it (oldTable is nil)
+// or updates it in the table namespace.
+func (d *TableDescriptor) updateTable(oldTable, table *linux_nftables.Table) error {
+	nftTable, err := d.tableToNft(table)
+	if err != nil {
+		return err
+	}
+	var oldNftTable *linuxcalls.Table
+	if oldTable != nil {
+		// previous configuration is only used to detect changes not visible
+		// in the kernel, it may reference interfaces which no longer exist
+		oldNftTable, _ = d.tableToNft(oldTable)
+	}
+
+	// switch network namespace
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	nsRevert, err := d.nsPlugin.SwitchToNamespace(nsCtx, table.Namespace)
+	if err != nil {
+		d.log.WithFields(logging.Fields{
+			"err":       err,
+			"namespace": table.Namespace,
+		}).Warn("Failed to switch the namespace")
+		return err
+	}
+	// revert network namespace after returning
+	defer nsRevert()
+
+	return d.nfTablesHandler.UpdateTable(oldNftTable, nftTable)
+}
+
+// Delete removes nftables table.
+func (d *TableDescriptor) Delete(key string, table *linux_nftables.Table, metadata interface{}) error {
+	d.log.Debugf("DELETE NFT table %s: %v", key, table)
+
+	// switch network namespace
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	nsRevert, err := d.nsPlugin.SwitchToNamespace(nsCtx, table.Namespace)
+	if err != nil {
+		d.log.WithFields(logging.Fields{
+			"err":       err,
+			"namespace": table.Namespace,
+		}).Warn("Failed to switch the namespace")
+		return err
+	}
+	// revert network namespace after returning
+	defer nsRevert()
+
+	return d.nfTablesHandler.DeleteTable(familyStr(table.Family), tableName(table))
+}
+
+// Dependencies lists dependencies for nftables table.
+func (d *TableDescriptor) Dependencies(key string, table *linux_nftables.Table) []kvs.Dependency {
+	var deps []kvs.Dependency
+
+	// referenced interfaces must exist
+	for _, iface := range tableInterfaces(table) {
+		deps = append(deps, kvs.Dependency{
+			Label: tableInterfaceDep + "-" + iface,
+			Key:   ifmodel.InterfaceKey(iface),
+		})
+	}
+
+	// addresses referenced by structured rules must be allocated
+	for _, chain := range table.Chains {
+		for idx, rule := range chain.StructuredRules {
+			deps = append(deps, d.ruleDependencies(chain.Name, idx, rule)...)
+		}
+	}
+
+	// microservice must be available
+	if table.Namespace != nil && table.Namespace.Type == linux_namespace.NetNamespace_MICROSERVICE {
+		deps = append(deps, kvs.Dependency{
+			Label: microserviceDep + "-" + table.Namespace.Reference,
+			Key:   linux_namespace.MicroserviceKey(table.Namespace.Reference),
+		})
+	}
+	return deps
+}
+
+// retrievedTables is used as the return value sent via channel by retrieveTables().
+type retrievedTables struct {
+	tables []adapter.TableKVWithMetadata
+	err    error
+}
+
+// Retrieve returns all nftables tables managed by this agent.
+func (d *TableDescriptor) Retrieve(correlate []adapter.TableKVWithMetadata) ([]adapter.TableKVWithMetadata, error) {
+	var values []adapter.TableKVWithMetadata
+
+	if len(correlate) == 0 {
+		return values, nil
+	}
+
+	goRoutinesCnt := len(correlate) / minWorkForGoRoutine
+	if goRoutinesCnt == 0 {
+		goRoutinesCnt = 1
+	}
+	if goRoutinesCnt > d.goRoutinesCnt {
+		goRoutinesCnt = d.goRoutinesCnt
+	}
+
+	ch := make(chan retrievedTables, goRoutinesCnt)
+
+	// invoke multiple go routines for more efficient parallel table retrieval
+	for idx := 0; idx < goRoutinesCnt; idx++ {
+		if goRoutinesCnt > 1 {
+			go d.retrieveTables(correlate, idx, goRoutinesCnt, ch)
+		} else {
+			d.retrieveTables(correlate, idx, goRoutinesCnt, ch)
+		}
+	}
+
+	// collect results from the go routines
+	for idx := 0; idx < goRoutinesCnt; idx++ {
+		retrieved := <-ch
+		if retrieved.err != nil {
+			return values, retrieved.err
+		}
+		values = append(values, retrieved.tables...)
+	}
+
+	return values, nil
+}
+
+// retrieveTables is run by a separate go routine to retrieve all nftables tables associated
+// with every <goRoutineIdx>-th correlation input.
+func (d *TableDescriptor) retrieveTables(
+	correlate []adapter.TableKVWithMetadata, goRoutineIdx, goRoutinesCnt int, ch chan<- retrievedTables) {
+
+	var retrieved retrievedTables
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+
+	for i := goRoutineIdx; i < len(correlate); i += goRoutinesCnt {
+		corrTable := correlate[i].Value
+
+		// switch to the namespace
+		nsRevert, err := d.nsPlugin.SwitchToNamespace(nsCtx, corrTable.Namespace)
+		if err != nil {
+			d.log.WithFields(logging.Fields{
+				"err":       err,
+				"namespace": corrTable.Namespace,
+			}).Warn("Failed to switch the namespace")
+			continue // continue with the item
+		}
+
+		// read the table with all its content
+		nftTable, err := d.nfTablesHandler.GetTable(familyStr(corrTable.Family), tableName(corrTable))
+
+		// switch back to the default namespace
+		nsRevert()
+
+		if err != nil {
+			d.log.Warnf("Error by reading nftables table: %v", err)
+			continue // continue with the item
+		}
+		if nftTable == nil {
+			// table does not exist
+			continue
+		}
+
+		// build key-value pair for the retrieved table
+		val := d.tableFromNft(corrTable, nftTable)
+		if d.EquivalentTables(correlate[i].Key, corrTable, val) {
+			// retrieved table matches the rendered configuration
+			val = proto.Clone(corrTable).(*linux_nftables.Table)
+		}
+		retrieved.tables = append(retrieved.tables, adapter.TableKVWithMetadata{
+			Key:    linux_nftables.TableKey(val.Name),
+			Value:  val,
+			Origin: kvs.FromNB,
+		})
+	}
+
+	ch <- retrieved
+}
+
+// tableToNft renders the table into the nft syntax.
+func (d *TableDescriptor) tableToNft(table *linux_nftables.Table) (*linuxcalls.Table, error) {
+	nftTable := &linuxcalls.Table{
+		Family: familyStr(table.Family),
+		Name:   tableName(table),
+	}
+	for _, set := range table.Sets {
+		nftSet := &linuxcalls.Set{
+			Name:     set.Name,
+			Type:     setTypeStr(set.Type),
+			Elements: set.Elements,
+		}
+		if set.Interval {
+			nftSet.Flags = []string{"interval"}
+		}
+		nftTable.Sets = append(nftTable.Sets, nftSet)
+	}
+	for _, chain := range table.Chains {
+		rules, err := d.renderRules(table, chain)
+		if err != nil {
+			return nil, err
+		}
+		nftChain := &linuxcalls.Chain{
+			Name:  chain.Name,
+			Rules: rules,
+		}
+		if baseChain := chain.BaseChain; baseChain != nil {
+			nftChain.Type = chainTypeStr(baseChain.Type)
+			nftChain.Hook = hookStr(baseChain.Hook)
+			nftChain.Priority = baseChain.Priority
+			nftChain.Policy = policyStr(baseChain.Policy)
+			if baseChain.Interface != "" {
+				nftChain.Device, err = d.hostIfName(baseChain.Interface)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		nftTable.Chains = append(nftTable.Chains, nftChain)
+	}
+	return nftTable, nil
+}
+
+// tableFromNft converts the table retrieved in the nft syntax into the NB model.
+func (d *TableDescriptor) tableFromNft(corrTable *linux_nftables.Table, nftTable *linuxcalls.Table) *linux_nftables.Table {
+	table := &linux_nftables.Table{
+		Name:      corrTable.Name,
+		TableName: corrTable.TableName,
+		Family:    corrTable.Family,
+		Namespace: corrTable.Namespace,
+	}
+	for _, nftSet := range nftTable.Sets {
+		setType, _ := setTypeFromStr(nftSet.Type)
+		table.Sets = append(table.Sets, &linux_nftables.Table_Set{
+			Name:     nftSet.Name,
+			Type:     setType,
+			Interval: sliceContains(nftSet.Flags, "interval"),
+			Elements: nftSet.Elements,
+		})
+	}
+	for _, nftChain := range nftTable.Chains {
+		chain := &linux_nftables.Table_Chain{
+			Name:  nftChain.Name,
+			Rules: nftChain.Rules,
+		}
+		if nftChain.Hook != "" {
+			chain.BaseChain = &linux_nftables.Table_Chain_BaseChain{
+				Type:     chainTypeFromStr(nftChain.Type),
+				Hook:     hookFromStr(nftChain.Hook),
+				Priority: nftChain.Priority,
+				Policy:   policyFromStr(nftChain.Policy),
+			}
+			if nftChain.Device != "" {
+				ifName, _, found := d.ifPlugin.GetInterfaceIndex().LookupByHostName(nftChain.Device, corrTable.Namespace)
+				if found {
+					chain.BaseChain.Interface = ifName
+				} else {
+					// interface not managed by the agent
+					chain.BaseChain.Interface = nftChain.Device
+				}
+			} else if corrChain := findChain(corrTable, nftChain.Name); corrChain != nil &&
+				corrChain.BaseChain != nil && chain.BaseChain.Hook == linux_nftables.Table_Chain_BaseChain_INGRESS {
+				// device of the ingress chain is not returned by the kernel
+				chain.BaseChain.Interface = corrChain.BaseChain.Interface
+			}
+		}
+		table.Chains = append(table.Chains, chain)
+	}
+	return table
+}
+
+// hostIfName returns host name of the Linux interface with the given logical name.
+func (d *TableDescriptor) hostIfName(ifName string) (string, error) {
+	ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(ifName)
+	if !found || ifMeta == nil {
+		return "", errors.Errorf("failed to obtain metadata for interface %s", ifName)
+	}
+	return ifMeta.HostIfName, nil
+}
+
+// tableInterfaces returns logical names of all interfaces referenced by the table.
+func tableInterfaces(table *linux_nftables.Table) (ifaces []string) {
+	for _, chain := range table.Chains {
+		if chain.BaseChain != nil && chain.BaseChain.Interface != "" &&
+			!sliceContains(ifaces, chain.BaseChain.Interface) {
+			ifaces = append(ifaces, chain.BaseChain.Interface)
+		}
+		for _, rule := range chain.StructuredRules {
+			for _, iface := range []string{rule.InInterface, rule.OutInterface} {
+				if iface != "" && !sliceContains(ifaces, iface) {
+					ifaces = append(ifaces, iface)
+				}
+			}
+		}
+	}
+	return ifaces
+}
+
+// equivalentSets compares two sets, ignoring the order of elements.
+func equivalentSets(oldSet, newSet *linux_nftables.Table_Set) bool {
+	if oldSet.Type != newSet.Type || oldSet.Interval != newSet.Interval ||
+		len(oldSet.Elements) != len(newSet.Elements) {
+		return false
+	}
+	setType := setTypeStr(newSet.Type)
+	var newElements []string
+	for _, elem := range newSet.Elements {
+		newElements = append(newElements, canonicalElement(setType, elem))
+	}
+	for _, elem := range oldSet.Elements {
+		if !sliceContains(newElements, canonicalElement(setType, elem)) {
+			return false
+		}
+	}
+	return true
+}
+
+// canonicalElement returns set element in the form printed by nft,
+// invalid element is only normalized.
+func canonicalElement(setType, elem string) string {
+	if canonical, err := linuxcalls.CanonicalElement(setType, elem); err == nil {
+		return canonical
+	}
+	return normalizeElement(elem)
+}
+
+// canonicalRule returns rule in the form printed by nft, rule which cannot be
+// translated is returned unchanged.
+func canonicalRule(family, rule string) string {
+	if canonical, err := linuxcalls.CanonicalRule(family, rule); err == nil {
+		return canonical
+	}
+	return rule
+}
+
+// normalizeElement returns set element in the form printed by nft
+// (host addresses are printed without the prefix length).
+func normalizeElement(elem string) string {
+	elem = strings.TrimSpace(elem)
+	elem = strings.TrimSuffix(elem, "/32")
+	return strings.TrimSuffix(elem, "/128")
+}
+
+// normalizeRule splits the rule into tokens in the form printed by nft:
+//   - quotes are removed (nft prints interface names quoted),
+//   - counter statements are removed together with the counted values,
+//   - spaces around range dashes are removed,
+//   - anonymous set is turned into a single token with sorted elements,
+//     set with a single element is replaced with the element,
+//   - host addresses are stripped of the prefix length.
+func normalizeRule(rule string) []string {
+	rule = strings.Replace(rule, "\"", "", -1)
+	rule = rangeSpaces.ReplaceAllString(rule, "-")
+
+	var tokens []string
+	for rule != "" {
+		start := strings.Index(rule, "{")
+		end := strings.Index(rule, "}")
+		if start == -1 || end < start {
+			tokens = append(tokens, strings.Fields(rule)...)
+			break
+		}
+		tokens = append(tokens, strings.Fields(rule[:start])...)
+		tokens = append(tokens, normalizeAnonymousSet(rule[start+1:end]))
+		rule = rule[end+1:]
+	}
+
+	normalized := tokens[:0]
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i] == "counter" {
+			if i+4 < len(tokens) && tokens[i+1] == "packets" && tokens[i+3] == "bytes" {
+				i += 4
+			}
+			continue
+		}
+		normalized = append(normalized, normalizeElement(tokens[i]))
+	}
+	return normalized
+}
+
+// normalizeAnonymousSet returns elements of anonymous set (without braces)
+// as a single token.
+func normalizeAnonymousSet(elements string) string {
+	var elems []string
+	for _, elem := range strings.Split(elements, ",") {
+		if elem = strings.Join(strings.Fields(elem), ""); elem != "" {
+			elems = append(elems, normalizeElement(elem))
+		}
+	}
+	if len(elems) == 1 {
+		return elems[0]
+	}
+	sort.Strings(elems)
+	return "{" + strings.Join(elems, ",") + "}"
+}
+
+// findSet returns set of the table with the given name.
+func findSet(table *linux_nftables.Table, name string) *linux_nftables.Table_Set {
+	for _, set := range table.Sets {
+		if set.Name == name {
+			return set
+		}
+	}
+	return nil
+}
+
+// findChain returns chain of the table with the given name.
+func findChain(table *linux_nftables.Table, name string) *linux_nftables.Table_Chain {
+	for _, chain := range table.Chains {
+		if chain.Name == name {
+			return chain
+		}
+	}
+	return nil
+}
+
+// sliceContains returns true if provided slice contains provided value, false otherwise.
+func sliceContains(slice []string, value string) bool {
+	for _, i := range slice {
+		if i == value {
+			return true
+		}
+	}
+	return false
+}
+
+// tableName returns name of the given table in nftables.
+func tableName(table *linux_nftables.Table) string {
+	if table.TableName != "" {
+		return table.TableName
+	}
+	return table.Name
+}
+
+// isIPFamily returns true for table families processing IP packets only.
+func isIPFamily(family linux_nftables.Table_Family) bool {
+	switch family {
+	case linux_nftables.Table_INET, linux_nftables.Table_IP, linux_nftables.Table_IP6:
+		return true
+	}
+	return false
+}
+
+// isAllowedHook returns true if the hook is supported by the given table family.
+func isAllowedHook(family linux_nftables.Table_Family, hook linux_nftables.Table_Chain_BaseChain_Hook) bool {
+	switch family {
+	case linux_nftables.Table_NETDEV:
+		return hook == linux_nftables.Table_Chain_BaseChain_INGRESS
+	case linux_nftables.Table_ARP:
+		return hook == linux_nftables.Table_Chain_BaseChain_INPUT ||
+			hook == linux_nftables.Table_Chain_BaseChain_OUTPUT
+	default:
+		return hook != linux_nftables.Table_Chain_BaseChain_INGRESS
+	}
+}
+
+// familyStr returns nft name of the table family.
+func familyStr(family linux_nftables.Table_Family) string {
+	return strings.ToLower(family.String())
+}
+
+// setTypeStr returns nft name of the set type.
+func setTypeStr(setType linux_nftables.Table_Set_Type) string {
+	return strings.ToLower(setType.String())
+}
+
+// setTypeFromStr returns set type for the given nft name.
+func setTypeFromStr(setType string) (linux_nftables.Table_Set_Type, bool) {
+	value, ok := linux_nftables.Table_Set_Type_value[strings.ToUpper(setType)]
+	return linux_nftables.Table_Set_Type(value), ok
+}
+
+// chainTypeStr returns nft name of the base chain type.
+func chainTypeStr(chainType linux_nftables.Table_Chain_BaseChain_Type) string {
+	return strings.ToLower(chainType.String())
+}
+
+// chainTypeFromStr returns base chain type for the given nft name.
+func chainTypeFromStr(chainType string) linux_nftables.Table_Chain_BaseChain_Type {
+	return linux_nftables.Table_Chain_BaseChain_Type(
+		linux_nftables.Table_Chain_BaseChain_Type_value[strings.ToUpper(chainType)])
+}
+
+// hookStr returns nft name of the hook.
+func hookStr(hook linux_nftables.Table_Chain_BaseChain_Hook) string {
+	return strings.ToLower(hook.String())
+}
+
+// hookFromStr returns hook for the given nft name.
+func hookFromStr(hook string) linux_nftables.Table_Chain_BaseChain_Hook {
+	return linux_nftables.Table_Chain_BaseChain_Hook(
+		linux_nftables.Table_Chain_BaseChain_Hook_value[strings.ToUpper(hook)])
+}
+
+// policyStr returns nft name of the base chain policy.
+func policyStr(policy linux_nftables.Table_Chain_BaseChain_Policy) string {
+	return strings.ToLower(policy.String())
+}
+
+// policyFromStr returns base chain policy for the given nft name.
+func policyFromStr(policy string) linux_nftables.Table_Chain_BaseChain_Policy {
+	return linux_nftables.Table_Chain_BaseChain_Policy(
+		linux_nftables.Table_Chain_BaseChain_Policy_value[strings.ToUpper(policy)])
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	linux_nftables "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables"
+)
+
+func TestEquivalentRules(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldRules   []string
+		newRules   []string
+		equivalent bool
+	}{
+		{
+			name:       "same rules",
+			oldRules:   []string{"ip saddr 10.0.0.1 accept", "drop"},
+			newRules:   []string{"ip saddr 10.0.0.1 accept", "drop"},
+			equivalent: true,
+		},
+		{
+			name:       "reordered matches",
+			oldRules:   []string{"iifname eth0 ip saddr 192.168.0.1 accept"},
+			newRules:   []string{"ip saddr 192.168.0.1 iifname eth0 accept"},
+			equivalent: true,
+		},
+		{
+			name:       "quoted interface name",
+			oldRules:   []string{"iifname eth0 oifname eth1 accept"},
+			newRules:   []string{`iifname "eth0" oifname "eth1" accept`},
+			equivalent: true,
+		},
+		{
+			name:       "counter",
+			oldRules:   []string{"tcp dport 22 counter accept", "counter drop"},
+			newRules:   []string{"tcp dport 22 counter packets 12 bytes 720 accept", "counter packets 0 bytes 0 drop"},
+			equivalent: true,
+		},
+		{
+			name:       "anonymous set",
+			oldRules:   []string{"tcp dport {443,80} accept"},
+			newRules:   []string{"tcp dport { 80, 443 } accept"},
+			equivalent: true,
+		},
+		{
+			name:       "anonymous set with single element",
+			oldRules:   []string{"tcp dport { 22 } accept"},
+			newRules:   []string{"tcp dport 22 accept"},
+			equivalent: true,
+		},
+		{
+			name:       "range",
+			oldRules:   []string{"tcp dport 1024 - 65535 accept", "udp dport { 53, 5000 - 5100 } accept"},
+			newRules:   []string{"tcp dport 1024-65535 accept", "udp dport { 53, 5000-5100 } accept"},
+			equivalent: true,
+		},
+		{
+			name:       "host prefix length",
+			oldRules:   []string{"ip saddr 10.0.0.1/32 ip6 daddr { fd00::1/128, fd00::/64 } accept"},
+			newRules:   []string{"ip saddr 10.0.0.1 ip6 daddr { fd00::/64, fd00::1 } accept"},
+			equivalent: true,
+		},
+		{
+			name:     "different rule count",
+			oldRules: []string{"accept"},
+			newRules: []string{"accept", "drop"},
+		},
+		{
+			name:     "different verdict",
+			oldRules: []string{"iifname eth0 accept"},
+			newRules: []string{`iifname "eth0" drop`},
+		},
+		{
+			name:     "different set elements",
+			oldRules: []string{"tcp dport { 80, 443 } accept"},
+			newRules: []string{"tcp dport { 80, 8443 } accept"},
+		},
+		{
+			name:     "repeated token",
+			oldRules: []string{"ip saddr 10.0.0.1 ip daddr 10.0.0.2 accept"},
+			newRules: []string{"ip saddr 10.0.0.1 ip daddr 10.0.0.1 accept"},
+		},
+		{
+			name:     "rules reordered",
+			oldRules: []string{"accept", "drop"},
+			newRules: []string{"drop", "accept"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			d := &TableDescriptor{}
+			table := func(rules []string) *linux_nftables.Table {
+				return &linux_nftables.Table{
+					Name:   "filter",
+					Family: linux_nftables.Table_INET,
+					Chains: []*linux_nftables.Table_Chain{{Name: "input", Rules: rules}},
+				}
+			}
+			Expect(d.EquivalentTables("", table(test.oldRules), table(test.newRules))).To(Equal(test.equivalent))
+			Expect(d.EquivalentTables("", table(test.newRules), table(test.oldRules))).To(Equal(test.equivalent))
+		})
+	}
+}
+
+func TestEquivalentSets(t *testing.T) {
+	RegisterTestingT(t)
+
+	d := &TableDescriptor{}
+	table := func(sets ...*linux_nftables.Table_Set) *linux_nftables.Table {
+		return &linux_nftables.Table{Name: "filter", Family: linux_nftables.Table_INET, Sets: sets}
+	}
+	set := &linux_nftables.Table_Set{
+		Name:     "allowed",
+		Type:     linux_nftables.Table_Set_IPV4_ADDR,
+		Interval: true,
+		Elements: []string{"10.0.0.1/32", "192.168.0.0/24"},
+	}
+	retrieved := &linux_nftables.Table_Set{
+		Name:     "allowed",
+		Type:     linux_nftables.Table_Set_IPV4_ADDR,
+		Interval: true,
+		Elements: []string{"192.168.0.0/24", "10.0.0.1"},
+	}
+	Expect(d.EquivalentTables("", table(set), table(retrieved))).To(BeTrue())
+
+	retrieved.Interval = false
+	Expect(d.EquivalentTables("", table(set), table(retrieved))).To(BeFalse())
+
+	retrieved.Interval = true
+	retrieved.Elements = []string{"10.0.0.1"}
+	Expect(d.EquivalentTables("", table(set), table(retrieved))).To(BeFalse())
+
+	retrieved.Name = "other"
+	Expect(d.EquivalentTables("", table(set), table(retrieved))).To(BeFalse())
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+// NFTablesAPI interface covers all methods inside linux calls package needed
+// to manage nftables tables.
+type NFTablesAPI interface {
+	NFTablesAPIWrite
+	NFTablesAPIRead
+}
+
+// NFTablesAPIWrite interface covers write methods inside linux calls package
+// needed to manage nftables tables.
+type NFTablesAPIWrite interface {
+	// UpdateTable creates the table (oldTable is nil) or updates the content of the table
+	// from oldTable to newTable, all in a single nftables transaction. The table is updated
+	// in place - unchanged chains, rules and sets are kept together with their counters
+	// and dynamically added set elements.
+	UpdateTable(oldTable, newTable *Table) error
+
+	// DeleteTable deletes the table with all its content.
+	DeleteTable(family, name string) error
+}
+
+// NFTablesAPIRead interface covers read methods inside linux calls package
+// needed to manage nftables tables.
+type NFTablesAPIRead interface {
+	// GetTable reads the table with all its content.
+	// Returns nil if the table does not exist.
+	GetTable(family, name string) (*Table, error)
+}
+
+// Table is an nftables table in the nft syntax.
+type Table struct {
+	Family string
+	Name   string
+	Sets   []*Set
+	Chains []*Chain
+}
+
+// Set is a named nftables set in the nft syntax.
+type Set struct {
+	Name     string
+	Type     string
+	Flags    []string
+	Elements []string
+}
+
+// Chain is an nftables chain in the nft syntax.
+// Hook is empty for regular (non-base) chains.
+type Chain struct {
+	Name     string
+	Type     string
+	Hook     string
+	Device   string
+	Priority int32
+	Policy   string
+	Rules    []string
+}
+
+// NewNFTablesHandler creates new instance of nftables handler.
+func NewNFTablesHandler() *NFTablesHandler {
+	return &NFTablesHandler{}
+}
@@ -0,0 +1,397 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/pkg/errors"
+)
+
+// ifNameSize is the size of interface names in nftables (IFNAMSIZ).
+const ifNameSize = 16
+
+// dataType describes how values of one nftables data type are translated between
+// the nft syntax and the binary form used in netlink messages.
+type dataType struct {
+	setType nftables.SetDatatype
+
+	// ranges is true if the data type supports ranges ("<from>-<to>")
+	// and prefixes are true if it supports prefixes ("<network>/<length>")
+	ranges   bool
+	prefixes bool
+
+	parse  func(value string) ([]byte, error)
+	render func(data []byte) string
+}
+
+// valueRange is a single value (to is nil) or a range of values of the same data type.
+type valueRange struct {
+	from []byte
+	to   []byte
+}
+
+// data types of the supported sets and matches, indexed by nft names
+var dataTypes = map[string]*dataType{
+	"ipv4_addr": {
+		setType:  nftables.TypeIPAddr,
+		ranges:   true,
+		prefixes: true,
+		parse:    parseIPv4,
+		render:   renderIP,
+	},
+	"ipv6_addr": {
+		setType:  nftables.TypeIP6Addr,
+		ranges:   true,
+		prefixes: true,
+		parse:    parseIPv6,
+		render:   renderIP,
+	},
+	"inet_service": {
+		setType: nftables.TypeInetService,
+		ranges:  true,
+		parse:   parsePort,
+		render:  renderPort,
+	},
+	"inet_proto": {
+		setType: nftables.TypeInetProto,
+		parse:   parseL4Proto,
+		render:  renderL4Proto,
+	},
+	"ether_addr": {
+		setType: nftables.TypeEtherAddr,
+		parse:   parseEtherAddr,
+		render:  renderEtherAddr,
+	},
+	"mark": {
+		// marks are stored in the host byte order, ranges are not supported
+		setType: nftables.TypeMark,
+		parse:   parseMark,
+		render:  renderMark,
+	},
+	"ifname": {
+		setType: nftables.TypeIFName,
+		parse:   parseIfName,
+		render:  renderIfName,
+	},
+}
+
+// transport-layer protocols with names printed by nft
+var l4Protocols = map[string]byte{
+	"icmp":      1,
+	"tcp":       6,
+	"udp":       17,
+	"ipv6-icmp": 58,
+	"sctp":      132,
+}
+
+// CanonicalElement returns set element of the given set type in the form printed by nft.
+func CanonicalElement(setType, element string) (string, error) {
+	dt, ok := dataTypes[setType]
+	if !ok {
+		return "", errors.Errorf("unsupported set type %s", setType)
+	}
+	value, err := dt.parseRange(element)
+	if err != nil {
+		return "", err
+	}
+	return dt.renderRange(value), nil
+}
+
+// parseRange parses single value, range or prefix of the data type.
+func (dt *dataType) parseRange(value string) (valueRange, error) {
+	value = strings.TrimSpace(value)
+	if dt.prefixes && strings.Contains(value, "/") {
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return valueRange{}, errors.Errorf("invalid %s prefix %s", dt.setType.Name, value)
+		}
+		from, err := dt.parse(network.IP.String())
+		if err != nil {
+			return valueRange{}, err
+		}
+		to := make([]byte, len(from))
+		for i := range from {
+			to[i] = from[i] | ^network.Mask[i]
+		}
+		return newValueRange(from, to), nil
+	}
+	if dt.ranges && strings.Contains(value, "-") {
+		bounds := strings.SplitN(value, "-", 2)
+		from, err := dt.parse(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return valueRange{}, err
+		}
+		to, err := dt.parse(strings.TrimSpace(bounds[1]))
+		if err != nil {
+			return valueRange{}, err
+		}
+		if bytes.Compare(from, to) > 0 {
+			return valueRange{}, errors.Errorf("invalid %s range %s", dt.setType.Name, value)
+		}
+		return newValueRange(from, to), nil
+	}
+	from, err := dt.parse(value)
+	if err != nil {
+		return valueRange{}, err
+	}
+	return valueRange{from: from}, nil
+}
+
+// renderRange renders single value, range or prefix of the data type.
+// Ranges of addresses that form a network are rendered as prefixes.
+func (dt *dataType) renderRange(value valueRange) string {
+	if value.to == nil {
+		return dt.render(value.from)
+	}
+	if dt.prefixes {
+		if ones, ok := prefixLength(value.from, value.to); ok {
+			return fmt.Sprintf("%s/%d", dt.render(value.from), ones)
+		}
+	}
+	return dt.render(value.from) + "-" + dt.render(value.to)
+}
+
+// newValueRange returns range between the given values, single value if they are equal.
+func newValueRange(from, to []byte) valueRange {
+	if bytes.Equal(from, to) {
+		return valueRange{from: from}
+	}
+	return valueRange{from: from, to: to}
+}
+
+// prefixLength returns length of the prefix covering exactly the given range.
+func prefixLength(from, to []byte) (int, bool) {
+	ones := 0
+	for i := range from {
+		mask := from[i] ^ to[i]
+		if from[i]&mask != 0 || to[i]&mask != mask {
+			return 0, false
+		}
+		for bit := 7; bit >= 0; bit-- {
+			if mask&(1<<uint(bit)) != 0 {
+				// all remaining bits have to be host bits
+				for j := i + 1; j < len(from); j++ {
+					if from[j] != 0 || to[j] != 0xff {
+						return 0, false
+					}
+				}
+				if mask != byte(1<<uint(bit+1)-1) {
+					return 0, false
+				}
+				return ones, true
+			}
+			ones++
+		}
+	}
+	return ones, true
+}
+
+// setElements returns elements of a set containing the given values.
+// Elements of interval sets are encoded as a start and an (exclusive) end
+// of every interval, preceded by the end of the interval starting at zero,
+// which is how nft populates the interval sets.
+func setElements(values []valueRange, interval bool) []nftables.SetElement {
+	var elements []nftables.SetElement
+	if !interval {
+		for _, value := range values {
+			elements = append(elements, nftables.SetElement{Key: value.from})
+		}
+		return elements
+	}
+	sorted := append([]valueRange{}, values...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].from, sorted[j].from) < 0
+	})
+	for i, value := range sorted {
+		if i == 0 && !isZero(value.from) {
+			elements = append(elements, nftables.SetElement{
+				Key:         make([]byte, len(value.from)),
+				IntervalEnd: true,
+			})
+		}
+		to := value.to
+		if to == nil {
+			to = value.from
+		}
+		elements = append(elements, nftables.SetElement{Key: value.from})
+		if end, overflow := increment(to); !overflow {
+			elements = append(elements, nftables.SetElement{Key: end, IntervalEnd: true})
+		}
+	}
+	return elements
+}
+
+// setValues returns values contained in a set with the given elements.
+func setValues(elements []nftables.SetElement, interval bool) []valueRange {
+	var values []valueRange
+	if !interval {
+		for _, elem := range elements {
+			values = append(values, valueRange{from: elem.Key})
+		}
+		return values
+	}
+	sorted := append([]nftables.SetElement{}, elements...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if cmp := bytes.Compare(sorted[i].Key, sorted[j].Key); cmp != 0 {
+			return cmp < 0
+		}
+		// end of the previous interval goes first
+		return sorted[i].IntervalEnd && !sorted[j].IntervalEnd
+	})
+	for i, elem := range sorted {
+		if elem.IntervalEnd {
+			continue
+		}
+		// interval without end element extends to the maximum value
+		to := bytes.Repeat([]byte{0xff}, len(elem.Key))
+		if i+1 < len(sorted) {
+			to = decrement(sorted[i+1].Key)
+		}
+		values = append(values, newValueRange(elem.Key, to))
+	}
+	return values
+}
+
+// increment returns the value increased by one, overflow is true if the value is maximal.
+func increment(value []byte) (result []byte, overflow bool) {
+	result = append([]byte{}, value...)
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i]++
+		if result[i] != 0 {
+			return result, false
+		}
+	}
+	return result, true
+}
+
+// decrement returns the value decreased by one (the value must not be zero).
+func decrement(value []byte) []byte {
+	result := append([]byte{}, value...)
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i]--
+		if result[i] != 0xff {
+			break
+		}
+	}
+	return result
+}
+
+// isZero returns true if all bytes of the value are zero.
+func isZero(value []byte) bool {
+	for _, b := range value {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func parseIPv4(value string) ([]byte, error) {
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() == nil {
+		return nil, errors.Errorf("invalid IPv4 address %s", value)
+	}
+	return ip.To4(), nil
+}
+
+func parseIPv6(value string) ([]byte, error) {
+	ip := net.ParseIP(value)
+	if ip == nil || ip.To4() != nil {
+		return nil, errors.Errorf("invalid IPv6 address %s", value)
+	}
+	return ip.To16(), nil
+}
+
+func renderIP(data []byte) string {
+	return net.IP(data).String()
+}
+
+func parsePort(value string) ([]byte, error) {
+	port, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return nil, errors.Errorf("invalid port %s", value)
+	}
+	return binaryutil.BigEndian.PutUint16(uint16(port)), nil
+}
+
+func renderPort(data []byte) string {
+	return strconv.Itoa(int(binaryutil.BigEndian.Uint16(data)))
+}
+
+func parseL4Proto(value string) ([]byte, error) {
+	if proto, ok := l4Protocols[value]; ok {
+		return []byte{proto}, nil
+	}
+	proto, err := strconv.ParseUint(value, 10, 8)
+	if err != nil {
+		return nil, errors.Errorf("invalid protocol %s", value)
+	}
+	return []byte{byte(proto)}, nil
+}
+
+func renderL4Proto(data []byte) string {
+	for name, proto := range l4Protocols {
+		if data[0] == proto {
+			return name
+		}
+	}
+	return strconv.Itoa(int(data[0]))
+}
+
+func parseEtherAddr(value string) ([]byte, error) {
+	hwAddr, err := net.ParseMAC(value)
+	if err != nil || len(hwAddr) != 6 {
+		return nil, errors.Errorf("invalid ethernet address %s", value)
+	}
+	return hwAddr, nil
+}
+
+func renderEtherAddr(data []byte) string {
+	return net.HardwareAddr(data).String()
+}
+
+func parseMark(value string) ([]byte, error) {
+	mark, err := strconv.ParseUint(value, 0, 32)
+	if err != nil {
+		return nil, errors.Errorf("invalid mark %s", value)
+	}
+	return binaryutil.NativeEndian.PutUint32(uint32(mark)), nil
+}
+
+func renderMark(data []byte) string {
+	return fmt.Sprintf("0x%08x", binaryutil.NativeEndian.Uint32(data))
+}
+
+func parseIfName(value string) ([]byte, error) {
+	value = strings.Trim(value, "\"")
+	if value == "" || len(value) >= ifNameSize {
+		return nil, errors.Errorf("invalid interface name %s", value)
+	}
+	data := make([]byte, ifNameSize)
+	copy(data, value)
+	return data, nil
+}
+
+func renderIfName(data []byte) string {
+	return fmt.Sprintf("%q", string(bytes.TrimRight(data, "\x00")))
+}
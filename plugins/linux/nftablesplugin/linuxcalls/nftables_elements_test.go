@@ -0,0 +1,120 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"testing"
+
+	"github.com/google/nftables"
+	. "github.com/onsi/gomega"
+)
+
+func TestCanonicalElement(t *testing.T) {
+	tests := []struct {
+		setType   string
+		element   string
+		canonical string
+		err       bool
+	}{
+		{setType: "ipv4_addr", element: "10.0.0.1", canonical: "10.0.0.1"},
+		{setType: "ipv4_addr", element: "10.0.0.1/32", canonical: "10.0.0.1"},
+		{setType: "ipv4_addr", element: "10.0.1.5/24", canonical: "10.0.1.0/24"},
+		{setType: "ipv4_addr", element: "10.0.0.0 - 10.0.0.255", canonical: "10.0.0.0/24"},
+		{setType: "ipv4_addr", element: "10.0.0.1-10.0.0.7", canonical: "10.0.0.1-10.0.0.7"},
+		{setType: "ipv4_addr", element: "fd00::1", err: true},
+		{setType: "ipv6_addr", element: "FD00:0::1/128", canonical: "fd00::1"},
+		{setType: "ipv6_addr", element: "fd00::/64", canonical: "fd00::/64"},
+		{setType: "ipv6_addr", element: "10.0.0.1", err: true},
+		{setType: "inet_service", element: "80", canonical: "80"},
+		{setType: "inet_service", element: "1024 - 65535", canonical: "1024-65535"},
+		{setType: "inet_service", element: "http", err: true},
+		{setType: "inet_service", element: "100-10", err: true},
+		{setType: "ether_addr", element: "AA:BB:CC:DD:EE:FF", canonical: "aa:bb:cc:dd:ee:ff"},
+		{setType: "ether_addr", element: "aa:bb:cc:dd:ee:ff-aa:bb:cc:dd:ee:ff", err: true},
+		{setType: "mark", element: "16", canonical: "0x00000010"},
+		{setType: "mark", element: "0x10-0x20", err: true},
+		{setType: "ifname", element: "eth0", canonical: `"eth0"`},
+		{setType: "ifname", element: `"eth0"`, canonical: `"eth0"`},
+		{setType: "ifname", element: "interface-name-0123", err: true},
+		{setType: "integer", element: "1", err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.setType+" "+test.element, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			canonical, err := CanonicalElement(test.setType, test.element)
+			if test.err {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(canonical).To(Equal(test.canonical))
+		})
+	}
+}
+
+func TestIntervalSetElements(t *testing.T) {
+	RegisterTestingT(t)
+
+	values := []valueRange{
+		{from: []byte{192, 168, 1, 1}},
+		{from: []byte{10, 0, 0, 0}, to: []byte{10, 255, 255, 255}},
+	}
+	elements := setElements(values, true)
+	Expect(elements).To(Equal([]nftables.SetElement{
+		{Key: []byte{0, 0, 0, 0}, IntervalEnd: true},
+		{Key: []byte{10, 0, 0, 0}},
+		{Key: []byte{11, 0, 0, 0}, IntervalEnd: true},
+		{Key: []byte{192, 168, 1, 1}},
+		{Key: []byte{192, 168, 1, 2}, IntervalEnd: true},
+	}))
+
+	// elements are decoded regardless of the order returned by the kernel
+	reversed := make([]nftables.SetElement, len(elements))
+	for i, elem := range elements {
+		reversed[len(elements)-1-i] = elem
+	}
+	Expect(setValues(reversed, true)).To(Equal([]valueRange{
+		{from: []byte{10, 0, 0, 0}, to: []byte{10, 255, 255, 255}},
+		{from: []byte{192, 168, 1, 1}},
+	}))
+}
+
+func TestIntervalSetElementsBounds(t *testing.T) {
+	RegisterTestingT(t)
+
+	// intervals starting at zero and ending at the maximum value
+	// have no leading and trailing end elements
+	values := []valueRange{
+		{from: []byte{0, 0}, to: []byte{0, 22}},
+		{from: []byte{4, 0}, to: []byte{255, 255}},
+	}
+	elements := setElements(values, true)
+	Expect(elements).To(Equal([]nftables.SetElement{
+		{Key: []byte{0, 0}},
+		{Key: []byte{0, 23}, IntervalEnd: true},
+		{Key: []byte{4, 0}},
+	}))
+	Expect(setValues(elements, true)).To(Equal(values))
+}
+
+func TestSetElements(t *testing.T) {
+	RegisterTestingT(t)
+
+	values := []valueRange{{from: []byte{0, 80}}, {from: []byte{0, 22}}}
+	elements := setElements(values, false)
+	Expect(elements).To(Equal([]nftables.SetElement{{Key: []byte{0, 80}}, {Key: []byte{0, 22}}}))
+	Expect(setValues(elements, false)).To(Equal(values))
+}
@@ -0,0 +1,670 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// table families indexed by nft names
+var tableFamilies = map[string]nftables.TableFamily{
+	"ip":     nftables.TableFamilyIPv4,
+	"ip6":    nftables.TableFamilyIPv6,
+	"inet":   nftables.TableFamilyINet,
+	"arp":    nftables.TableFamilyARP,
+	"bridge": nftables.TableFamilyBridge,
+	"netdev": nftables.TableFamilyNetdev,
+}
+
+// base chain hooks indexed by nft names
+// (the ingress hook of the netdev family shares the number with prerouting)
+var chainHooks = map[string]nftables.ChainHook{
+	"prerouting":  unix.NF_INET_PRE_ROUTING,
+	"input":       unix.NF_INET_LOCAL_IN,
+	"forward":     unix.NF_INET_FORWARD,
+	"output":      unix.NF_INET_LOCAL_OUT,
+	"postrouting": unix.NF_INET_POST_ROUTING,
+	"ingress":     unix.NF_NETDEV_INGRESS,
+}
+
+// base chain policies indexed by nft names
+var chainPolicies = map[string]nftables.ChainPolicy{
+	"accept": nftables.ChainPolicyAccept,
+	"drop":   nftables.ChainPolicyDrop,
+}
+
+// NFTablesHandler is a handler for all operations on Linux nftables.
+// The handler talks to nf_tables directly over netlink (nfnetlink), all changes
+// of one operation are sent in a single transaction. Operations are executed
+// in the network namespace of the calling thread.
+type NFTablesHandler struct{}
+
+// tableState is the current content of a table in the kernel.
+type tableState struct {
+	table    *nftables.Table
+	sets     []*setState
+	chains   []*chainState
+	anonSets map[string][]valueRange
+}
+
+// setState is the current content of a named set in the kernel.
+type setState struct {
+	set      *nftables.Set
+	elements []nftables.SetElement
+}
+
+// chainState is the current content of a chain in the kernel.
+type chainState struct {
+	chain *nftables.Chain
+	rules []*ruleState
+}
+
+// ruleState is a rule installed in the kernel together with its nft form
+// (empty if the rule is not supported).
+type ruleState struct {
+	rule *nftables.Rule
+	text string
+}
+
+// UpdateTable creates the table (oldTable is nil) or updates the content of the table
+// from oldTable to newTable, all in a single nftables transaction. The table is updated
+// in place - unchanged chains, rules and sets are kept together with their counters
+// and dynamically added set elements.
+func (h *NFTablesHandler) UpdateTable(oldTable, newTable *Table) error {
+	conn, err := nftables.New()
+	if err != nil {
+		return errors.Errorf("failed to open nftables connection: %v", err)
+	}
+	current, err := readTable(conn, newTable.Family, newTable.Name)
+	if err != nil {
+		return err
+	}
+	if err = planTableUpdate(conn, current, oldTable, newTable); err != nil {
+		return errors.Errorf("failed to update table %s %s: %v", newTable.Family, newTable.Name, err)
+	}
+	if err = conn.Flush(); err != nil {
+		return errors.Errorf("failed to update table %s %s: %v", newTable.Family, newTable.Name, err)
+	}
+	return nil
+}
+
+// DeleteTable deletes the table with all its content.
+func (h *NFTablesHandler) DeleteTable(family, name string) error {
+	conn, err := nftables.New()
+	if err != nil {
+		return errors.Errorf("failed to open nftables connection: %v", err)
+	}
+	table, err := findTable(conn, family, name)
+	if err != nil || table == nil {
+		return err
+	}
+	conn.DelTable(table)
+	if err = conn.Flush(); err != nil {
+		return errors.Errorf("failed to delete table %s %s: %v", family, name, err)
+	}
+	return nil
+}
+
+// GetTable reads the table with all its content.
+// Returns nil if the table does not exist.
+func (h *NFTablesHandler) GetTable(family, name string) (*Table, error) {
+	conn, err := nftables.New()
+	if err != nil {
+		return nil, errors.Errorf("failed to open nftables connection: %v", err)
+	}
+	current, err := readTable(conn, family, name)
+	if err != nil || current == nil {
+		return nil, err
+	}
+
+	table := &Table{Family: family, Name: name}
+	for _, cur := range current.sets {
+		set := &Set{
+			Name: cur.set.Name,
+			Type: cur.set.KeyType.Name,
+		}
+		if cur.set.Interval {
+			set.Flags = []string{"interval"}
+		}
+		if dt, ok := dataTypes[set.Type]; ok {
+			for _, value := range setValues(cur.elements, cur.set.Interval) {
+				set.Elements = append(set.Elements, dt.renderRange(value))
+			}
+		}
+		table.Sets = append(table.Sets, set)
+	}
+	for _, cur := range current.chains {
+		chain := &Chain{Name: cur.chain.Name}
+		if cur.chain.Hooknum != nil {
+			chain.Type = string(cur.chain.Type)
+			chain.Hook = hookStr(family, *cur.chain.Hooknum)
+			chain.Device = cur.chain.Device
+			if cur.chain.Priority != nil {
+				chain.Priority = int32(*cur.chain.Priority)
+			}
+			if cur.chain.Policy != nil {
+				chain.Policy = policyStr(*cur.chain.Policy)
+			}
+		}
+		for _, rule := range cur.rules {
+			text := rule.text
+			if text == "" {
+				text = fmt.Sprintf("unsupported rule with handle %d", rule.rule.Handle)
+			}
+			chain.Rules = append(chain.Rules, text)
+		}
+		table.Chains = append(table.Chains, chain)
+	}
+	return table, nil
+}
+
+// findTable returns the table with the given family and name, nil if it does not exist.
+func findTable(conn *nftables.Conn, family, name string) (*nftables.Table, error) {
+	tableFamily, ok := tableFamilies[family]
+	if !ok {
+		return nil, errors.Errorf("unsupported table family %s", family)
+	}
+	tables, err := conn.ListTablesOfFamily(tableFamily)
+	if err != nil {
+		return nil, errors.Errorf("failed to list %s tables: %v", family, err)
+	}
+	for _, table := range tables {
+		if table.Name == name {
+			return table, nil
+		}
+	}
+	return nil, nil
+}
+
+// readTable reads the current content of the table, returns nil if the table does not exist.
+func readTable(conn *nftables.Conn, family, name string) (*tableState, error) {
+	table, err := findTable(conn, family, name)
+	if err != nil || table == nil {
+		return nil, err
+	}
+	current := &tableState{table: table, anonSets: make(map[string][]valueRange)}
+
+	sets, err := conn.GetSets(table)
+	if err != nil {
+		return nil, errors.Errorf("failed to list sets of table %s %s: %v", family, name, err)
+	}
+	for _, set := range sets {
+		elements, err := conn.GetSetElements(set)
+		if err != nil {
+			return nil, errors.Errorf("failed to list elements of set %s: %v", set.Name, err)
+		}
+		if set.Anonymous {
+			// anonymous sets are only used to render the rules
+			current.anonSets[set.Name] = setValues(elements, set.Interval)
+			continue
+		}
+		current.sets = append(current.sets, &setState{set: set, elements: elements})
+	}
+
+	chains, err := conn.ListChainsOfTableFamily(table.Family)
+	if err != nil {
+		return nil, errors.Errorf("failed to list chains of table %s %s: %v", family, name, err)
+	}
+	for _, chain := range chains {
+		if chain.Table == nil || chain.Table.Name != name {
+			continue
+		}
+		chain.Table = table
+		rules, err := conn.GetRules(table, chain)
+		if err != nil {
+			return nil, errors.Errorf("failed to list rules of chain %s: %v", chain.Name, err)
+		}
+		cur := &chainState{chain: chain}
+		for _, rule := range rules {
+			// unsupported rules are left with empty text and are never matched
+			// with the configured rules
+			text, _ := renderRule(family, rule.Exprs, current.anonSets)
+			cur.rules = append(cur.rules, &ruleState{rule: rule, text: text})
+		}
+		current.chains = append(current.chains, cur)
+	}
+	return current, nil
+}
+
+// planTableUpdate adds all the changes needed to get the table from the current state
+// (nil if the table does not exist) to the configured content into the batch
+// of the connection.
+func planTableUpdate(conn *nftables.Conn, current *tableState, oldTable, newTable *Table) error {
+	if current == nil {
+		current = &tableState{}
+	}
+	table := conn.AddTable(&nftables.Table{Family: tableFamilies[newTable.Family], Name: newTable.Name})
+
+	// translate configured sets and rules first so that nothing is changed
+	// if the configuration cannot be applied
+	setVals := make(map[string][]valueRange)
+	for _, set := range newTable.Sets {
+		values, err := parseSetElements(set)
+		if err != nil {
+			return err
+		}
+		setVals[set.Name] = values
+	}
+	chainRules := make(map[string][]*ruleExprs)
+	chainKeys := make(map[string][]string)
+	for _, chain := range newTable.Chains {
+		for _, rule := range chain.Rules {
+			parsed, err := parseRule(newTable.Family, rule)
+			if err != nil {
+				return err
+			}
+			key, err := renderRule(newTable.Family, parsed.exprs, parsed.anonSetValues())
+			if err != nil {
+				return err
+			}
+			chainRules[chain.Name] = append(chainRules[chain.Name], parsed)
+			chainKeys[chain.Name] = append(chainKeys[chain.Name], key)
+		}
+	}
+
+	// sets and chains which are removed (or re-created) together with the rules
+	// referencing them
+	removedSets := make(map[string]*setState)
+	keptSets := make(map[string]*setState)
+	for _, cur := range current.sets {
+		set := findSet(newTable.Sets, cur.set.Name)
+		switch {
+		case set == nil:
+			removedSets[cur.set.Name] = cur
+		case cur.set.KeyType.Name != set.Type || cur.set.Interval != isIntervalSet(set):
+			removedSets[cur.set.Name] = cur
+		default:
+			keptSets[cur.set.Name] = cur
+		}
+	}
+	removedChains := make(map[string]*chainState)
+	keptChains := make(map[string]*chainState)
+	for _, cur := range current.chains {
+		chain := findChain(newTable.Chains, cur.chain.Name)
+		var oldChain *Chain
+		if oldTable != nil {
+			oldChain = findChain(oldTable.Chains, cur.chain.Name)
+		}
+		if chain == nil || !sameChainHook(newTable.Family, cur.chain, chain, oldChain) {
+			removedChains[cur.chain.Name] = cur
+		} else {
+			keptChains[cur.chain.Name] = cur
+		}
+	}
+
+	// match the current rules of kept chains with the configured rules
+	ruleMatches := make(map[string][]int)
+	for _, chain := range newTable.Chains {
+		cur, kept := keptChains[chain.Name]
+		if !kept {
+			continue
+		}
+		var curKeys []string
+		for _, rule := range cur.rules {
+			key := rule.text
+			if referencesRemoved(rule.rule, removedSets, removedChains) {
+				key = ""
+			}
+			curKeys = append(curKeys, key)
+		}
+		ruleMatches[chain.Name] = matchRules(curKeys, chainKeys[chain.Name])
+	}
+
+	// remove unmatched rules, removed chains and sets
+	for _, cur := range current.chains {
+		matches, kept := ruleMatches[cur.chain.Name]
+		if !kept {
+			continue
+		}
+		matched := make(map[int]bool)
+		for _, idx := range matches {
+			matched[idx] = true
+		}
+		for idx, rule := range cur.rules {
+			if !matched[idx] {
+				if err := conn.DelRule(rule.rule); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, cur := range current.chains {
+		if removedChains[cur.chain.Name] != nil {
+			conn.FlushChain(cur.chain)
+		}
+	}
+	for _, cur := range current.chains {
+		if removedChains[cur.chain.Name] != nil {
+			conn.DelChain(cur.chain)
+		}
+	}
+	for _, cur := range current.sets {
+		if removedSets[cur.set.Name] != nil {
+			conn.DelSet(cur.set)
+		}
+	}
+
+	// add new sets and update elements of the kept sets
+	for _, set := range newTable.Sets {
+		values := setVals[set.Name]
+		cur, kept := keptSets[set.Name]
+		if !kept {
+			nftSet := &nftables.Set{
+				Table:    table,
+				Name:     set.Name,
+				KeyType:  dataTypes[set.Type].setType,
+				Interval: isIntervalSet(set),
+			}
+			if err := conn.AddSet(nftSet, setElements(values, nftSet.Interval)); err != nil {
+				return err
+			}
+			continue
+		}
+		var oldValues []valueRange
+		if oldTable != nil {
+			if oldSet := findSet(oldTable.Sets, set.Name); oldSet != nil {
+				oldValues, _ = parseSetElements(oldSet)
+			}
+		}
+		if oldTable != nil && sameValues(oldValues, values) {
+			// elements not changed by the configuration, dynamically added
+			// elements are kept
+			continue
+		}
+		if err := syncSetElements(conn, cur, values); err != nil {
+			return err
+		}
+	}
+
+	// add new chains and update policies of the kept base chains
+	nftChains := make(map[string]*nftables.Chain)
+	for _, chain := range newTable.Chains {
+		nftChain := &nftables.Chain{Table: table, Name: chain.Name}
+		if chain.Hook != "" {
+			nftChain.Type = nftables.ChainType(chain.Type)
+			nftChain.Hooknum = nftables.ChainHookRef(chainHooks[chain.Hook])
+			nftChain.Priority = nftables.ChainPriorityRef(nftables.ChainPriority(chain.Priority))
+			nftChain.Device = chain.Device
+			if policy, ok := chainPolicies[chain.Policy]; ok {
+				nftChain.Policy = &policy
+			}
+		}
+		nftChains[chain.Name] = nftChain
+		cur, kept := keptChains[chain.Name]
+		if !kept || (nftChain.Policy != nil &&
+			(cur.chain.Policy == nil || *cur.chain.Policy != *nftChain.Policy)) {
+			conn.AddChain(nftChain)
+		}
+	}
+
+	// add rules, new rules of kept chains are inserted before the next kept rule
+	for _, chain := range newTable.Chains {
+		matches, kept := ruleMatches[chain.Name]
+		for idx, parsed := range chainRules[chain.Name] {
+			if kept && matches[idx] >= 0 {
+				continue
+			}
+			rule := &nftables.Rule{
+				Table: table,
+				Chain: nftChains[chain.Name],
+				Exprs: parsed.exprs,
+			}
+			for _, anon := range parsed.anonSets {
+				anon.set.Table = table
+				if err := conn.AddSet(anon.set, setElements(anon.values, anon.set.Interval)); err != nil {
+					return err
+				}
+				anon.lookup.SetName = anon.set.Name
+				anon.lookup.SetID = anon.set.ID
+			}
+			var next *nftables.Rule
+			if kept {
+				for _, curIdx := range matches[idx+1:] {
+					if curIdx >= 0 {
+						next = keptChains[chain.Name].rules[curIdx].rule
+						break
+					}
+				}
+			}
+			if next != nil {
+				rule.Position = next.Handle
+				conn.InsertRule(rule)
+			} else {
+				conn.AddRule(rule)
+			}
+		}
+	}
+	return nil
+}
+
+// matchRules matches the current rules of a chain with the configured rules, both given
+// in the canonical nft form. For every configured rule, index of the current rule
+// that is kept in its place is returned, or -1 if the rule has to be added.
+// The kept rules form the longest common subsequence of both lists,
+// current rules with empty text are never kept.
+func matchRules(current, desired []string) []int {
+	// lcs[i][j] is the length of the longest common subsequence
+	// of current[i:] and desired[j:]
+	lcs := make([][]int, len(current)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(desired)+1)
+	}
+	for i := len(current) - 1; i >= 0; i-- {
+		for j := len(desired) - 1; j >= 0; j-- {
+			switch {
+			case current[i] != "" && current[i] == desired[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	matches := make([]int, len(desired))
+	for j := range matches {
+		matches[j] = -1
+	}
+	for i, j := 0, 0; i < len(current) && j < len(desired); {
+		switch {
+		case current[i] != "" && current[i] == desired[j]:
+			matches[j] = i
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return matches
+}
+
+// syncSetElements updates elements of the kept set to the configured values.
+func syncSetElements(conn *nftables.Conn, cur *setState, values []valueRange) error {
+	if cur.set.Interval {
+		// intervals are encoded by pairs of elements, they are re-added
+		// all at once to keep them consistent
+		conn.FlushSet(cur.set)
+		return conn.SetAddElements(cur.set, setElements(values, true))
+	}
+	var toAdd, toDelete []nftables.SetElement
+	for _, elem := range setElements(values, false) {
+		if !containsElement(cur.elements, elem) {
+			toAdd = append(toAdd, elem)
+		}
+	}
+	desired := setElements(values, false)
+	for _, elem := range cur.elements {
+		if !containsElement(desired, elem) {
+			toDelete = append(toDelete, nftables.SetElement{Key: elem.Key})
+		}
+	}
+	if len(toDelete) > 0 {
+		if err := conn.SetDeleteElements(cur.set, toDelete); err != nil {
+			return err
+		}
+	}
+	if len(toAdd) > 0 {
+		return conn.SetAddElements(cur.set, toAdd)
+	}
+	return nil
+}
+
+// parseSetElements parses elements of the configured set.
+func parseSetElements(set *Set) ([]valueRange, error) {
+	dt, ok := dataTypes[set.Type]
+	if !ok {
+		return nil, errors.Errorf("unsupported type %s of set %s", set.Type, set.Name)
+	}
+	var values []valueRange
+	for _, elem := range set.Elements {
+		value, err := dt.parseRange(elem)
+		if err != nil {
+			return nil, errors.Errorf("invalid element of set %s: %v", set.Name, err)
+		}
+		if value.to != nil && !isIntervalSet(set) {
+			return nil, errors.Errorf("set %s without interval flag cannot contain %s", set.Name, elem)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// sameValues returns true if both lists contain the same values (in any order).
+func sameValues(values1, values2 []valueRange) bool {
+	if len(values1) != len(values2) {
+		return false
+	}
+	sorted := func(values []valueRange) []valueRange {
+		values = append([]valueRange{}, values...)
+		sort.Slice(values, func(i, j int) bool {
+			if cmp := bytes.Compare(values[i].from, values[j].from); cmp != 0 {
+				return cmp < 0
+			}
+			return bytes.Compare(values[i].to, values[j].to) < 0
+		})
+		return values
+	}
+	values1, values2 = sorted(values1), sorted(values2)
+	for i := range values1 {
+		if !bytes.Equal(values1[i].from, values2[i].from) || !bytes.Equal(values1[i].to, values2[i].to) {
+			return false
+		}
+	}
+	return true
+}
+
+// sameChainHook returns true if the current chain is attached to the same hook as the configured
+// chain, i.e. it can be updated in place. The device of ingress chains is not returned
+// by the kernel, therefore it is compared with the previous configuration.
+func sameChainHook(family string, cur *nftables.Chain, chain, oldChain *Chain) bool {
+	if cur.Hooknum == nil {
+		return chain.Hook == ""
+	}
+	if chain.Hook == "" || hookStr(family, *cur.Hooknum) != chain.Hook || string(cur.Type) != chain.Type ||
+		cur.Priority == nil || int32(*cur.Priority) != chain.Priority {
+		return false
+	}
+	return oldChain == nil || oldChain.Device == chain.Device
+}
+
+// referencesRemoved returns true if the rule references any of the removed sets or chains.
+func referencesRemoved(rule *nftables.Rule, removedSets map[string]*setState,
+	removedChains map[string]*chainState) bool {
+	for _, e := range rule.Exprs {
+		switch e := e.(type) {
+		case *expr.Lookup:
+			if removedSets[e.SetName] != nil {
+				return true
+			}
+		case *expr.Verdict:
+			if e.Chain != "" && removedChains[e.Chain] != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// containsElement returns true if the element with the same key is in the list.
+func containsElement(elements []nftables.SetElement, elem nftables.SetElement) bool {
+	for _, e := range elements {
+		if bytes.Equal(e.Key, elem.Key) {
+			return true
+		}
+	}
+	return false
+}
+
+// isIntervalSet returns true if the set has the interval flag.
+func isIntervalSet(set *Set) bool {
+	for _, flag := range set.Flags {
+		if flag == "interval" {
+			return true
+		}
+	}
+	return false
+}
+
+// findSet returns set with the given name.
+func findSet(sets []*Set, name string) *Set {
+	for _, set := range sets {
+		if set.Name == name {
+			return set
+		}
+	}
+	return nil
+}
+
+// findChain returns chain with the given name.
+func findChain(chains []*Chain, name string) *Chain {
+	for _, chain := range chains {
+		if chain.Name == name {
+			return chain
+		}
+	}
+	return nil
+}
+
+// hookStr returns nft name of the base chain hook.
+func hookStr(family string, hook nftables.ChainHook) string {
+	if family == "netdev" {
+		return "ingress"
+	}
+	for name, value := range chainHooks {
+		if value == hook && name != "ingress" {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", hook)
+}
+
+// policyStr returns nft name of the base chain policy.
+func policyStr(policy nftables.ChainPolicy) string {
+	for name, value := range chainPolicies {
+		if value == policy {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", policy)
+}
@@ -0,0 +1,379 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/google/nftables"
+	"github.com/mdlayher/netlink"
+	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
+)
+
+func TestMatchRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []string
+		desired  []string
+		expected []int
+	}{
+		{
+			name:     "no change",
+			current:  []string{"a", "b", "c"},
+			desired:  []string{"a", "b", "c"},
+			expected: []int{0, 1, 2},
+		},
+		{
+			name:     "empty chain",
+			desired:  []string{"a", "b"},
+			expected: []int{-1, -1},
+		},
+		{
+			name:     "all removed",
+			current:  []string{"a", "b"},
+			expected: []int{},
+		},
+		{
+			name:     "rule inserted in the middle",
+			current:  []string{"a", "c"},
+			desired:  []string{"a", "b", "c"},
+			expected: []int{0, -1, 1},
+		},
+		{
+			name:     "rule replaced",
+			current:  []string{"a", "b", "c"},
+			desired:  []string{"a", "x", "c"},
+			expected: []int{0, -1, 2},
+		},
+		{
+			name:     "rules reordered",
+			current:  []string{"a", "b", "c", "d"},
+			desired:  []string{"d", "a", "b", "c"},
+			expected: []int{-1, 0, 1, 2},
+		},
+		{
+			name:     "duplicate rules",
+			current:  []string{"a", "a", "b"},
+			desired:  []string{"a", "b", "a"},
+			expected: []int{0, 2, -1},
+		},
+		{
+			name:     "unsupported rules are never kept",
+			current:  []string{"a", "", "b"},
+			desired:  []string{"a", "", "b"},
+			expected: []int{0, -1, 2},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			Expect(matchRules(test.current, test.desired)).To(Equal(test.expected))
+		})
+	}
+}
+
+// installedRule returns rule as it would be read from the kernel.
+func installedRule(table *nftables.Table, chain *nftables.Chain, handle uint64, rule string) *ruleState {
+	parsed, err := parseRule("inet", rule)
+	Expect(err).ToNot(HaveOccurred())
+	return &ruleState{
+		rule: &nftables.Rule{Table: table, Chain: chain, Handle: handle, Exprs: parsed.exprs},
+		text: rule,
+	}
+}
+
+// recordUpdate plans the table update and returns the nftables messages sent
+// to the kernel in a readable form.
+func recordUpdate(current *tableState, oldTable, newTable *Table) ([]string, error) {
+	var messages []string
+	conn, err := nftables.New(nftables.WithTestDial(
+		func(req []netlink.Message) ([]netlink.Message, error) {
+			for _, msg := range req {
+				messages = append(messages, describeMessage(msg))
+			}
+			return req, nil
+		}))
+	Expect(err).ToNot(HaveOccurred())
+	if err := planTableUpdate(conn, current, oldTable, newTable); err != nil {
+		return nil, err
+	}
+	Expect(conn.Flush()).To(Succeed())
+	return messages, nil
+}
+
+// describeMessage returns message type with the name of the object and rule handle
+// or position.
+func describeMessage(msg netlink.Message) string {
+	if msg.Header.Type>>8 != unix.NFNL_SUBSYS_NFTABLES {
+		return "BATCH"
+	}
+	var (
+		msgType, nameAttr string
+		name              string
+		handle, position  uint64
+	)
+	switch msg.Header.Type & 0xff {
+	case unix.NFT_MSG_NEWTABLE:
+		msgType, nameAttr = "NEWTABLE", "table"
+	case unix.NFT_MSG_DELTABLE:
+		msgType, nameAttr = "DELTABLE", "table"
+	case unix.NFT_MSG_NEWCHAIN:
+		msgType, nameAttr = "NEWCHAIN", "chain"
+	case unix.NFT_MSG_DELCHAIN:
+		msgType, nameAttr = "DELCHAIN", "chain"
+	case unix.NFT_MSG_NEWRULE:
+		msgType, nameAttr = "NEWRULE", "rule"
+	case unix.NFT_MSG_DELRULE:
+		msgType, nameAttr = "DELRULE", "rule"
+	case unix.NFT_MSG_NEWSET:
+		msgType, nameAttr = "NEWSET", "set"
+	case unix.NFT_MSG_DELSET:
+		msgType, nameAttr = "DELSET", "set"
+	case unix.NFT_MSG_NEWSETELEM:
+		msgType, nameAttr = "NEWSETELEM", "set"
+	case unix.NFT_MSG_DELSETELEM:
+		msgType, nameAttr = "DELSETELEM", "set"
+	default:
+		return fmt.Sprintf("MSG %d", msg.Header.Type&0xff)
+	}
+	ad, err := netlink.NewAttributeDecoder(msg.Data[4:])
+	Expect(err).ToNot(HaveOccurred())
+	ad.ByteOrder = binary.BigEndian
+	for ad.Next() {
+		switch {
+		case nameAttr == "table" && ad.Type() == unix.NFTA_TABLE_NAME,
+			nameAttr == "chain" && ad.Type() == unix.NFTA_CHAIN_NAME,
+			nameAttr == "rule" && ad.Type() == unix.NFTA_RULE_CHAIN,
+			nameAttr == "set" && ad.Type() == unix.NFTA_SET_NAME:
+			name = ad.String()
+		case nameAttr == "rule" && ad.Type() == unix.NFTA_RULE_HANDLE:
+			handle = ad.Uint64()
+		case nameAttr == "rule" && ad.Type() == unix.NFTA_RULE_POSITION:
+			position = ad.Uint64()
+		}
+	}
+	description := msgType + " " + name
+	if handle != 0 {
+		description += fmt.Sprintf(" handle %d", handle)
+	}
+	if position != 0 {
+		description += fmt.Sprintf(" position %d", position)
+	}
+	return description
+}
+
+// testTable returns table configuration together with its state in the kernel.
+func testTable() (*tableState, *Table) {
+	table := &nftables.Table{Family: nftables.TableFamilyINet, Name: "filter"}
+	input := &nftables.Chain{
+		Table:    table,
+		Name:     "input",
+		Type:     nftables.ChainTypeFilter,
+		Hooknum:  nftables.ChainHookInput,
+		Priority: nftables.ChainPriorityFilter,
+	}
+	accept := nftables.ChainPolicyAccept
+	input.Policy = &accept
+	custom := &nftables.Chain{Table: table, Name: "custom"}
+
+	current := &tableState{
+		table: table,
+		sets: []*setState{
+			{
+				set: &nftables.Set{Table: table, Name: "allowed", KeyType: nftables.TypeIPAddr, Interval: true},
+				elements: setElements([]valueRange{
+					{from: []byte{10, 0, 0, 0}, to: []byte{10, 255, 255, 255}},
+				}, true),
+			},
+			{
+				set: &nftables.Set{Table: table, Name: "ports", KeyType: nftables.TypeInetService},
+				elements: []nftables.SetElement{
+					{Key: []byte{0, 22}}, {Key: []byte{0, 80}},
+					// added dynamically
+					{Key: []byte{0, 81}},
+				},
+			},
+			{
+				set:      &nftables.Set{Table: table, Name: "blocked", KeyType: nftables.TypeIPAddr},
+				elements: []nftables.SetElement{{Key: []byte{192, 168, 0, 1}}},
+			},
+		},
+		chains: []*chainState{
+			{
+				chain: input,
+				rules: []*ruleState{
+					installedRule(table, input, 2, `iifname "lo" accept`),
+					installedRule(table, input, 3, "ip saddr @allowed accept"),
+					installedRule(table, input, 4, "tcp dport @ports counter accept"),
+					installedRule(table, input, 5, "jump custom"),
+				},
+			},
+			{
+				chain: custom,
+				rules: []*ruleState{
+					installedRule(table, custom, 7, "ip saddr @blocked drop"),
+				},
+			},
+		},
+	}
+	config := &Table{
+		Family: "inet",
+		Name:   "filter",
+		Sets: []*Set{
+			{Name: "allowed", Type: "ipv4_addr", Flags: []string{"interval"}, Elements: []string{"10.0.0.0/8"}},
+			{Name: "ports", Type: "inet_service", Elements: []string{"22", "80"}},
+			{Name: "blocked", Type: "ipv4_addr", Elements: []string{"192.168.0.1"}},
+		},
+		Chains: []*Chain{
+			{
+				Name:     "input",
+				Type:     "filter",
+				Hook:     "input",
+				Priority: 0,
+				Policy:   "accept",
+				Rules: []string{
+					`iifname "lo" accept`,
+					"ip saddr @allowed accept",
+					"tcp dport @ports counter accept",
+					"jump custom",
+				},
+			},
+			{
+				Name:  "custom",
+				Rules: []string{"ip saddr @blocked drop"},
+			},
+		},
+	}
+	return current, config
+}
+
+func TestUpdateTableInPlace(t *testing.T) {
+	RegisterTestingT(t)
+
+	current, oldTable := testTable()
+	_, newTable := testTable()
+	// policy changed
+	newTable.Chains[0].Policy = "drop"
+	// rule replaced and a rule appended
+	newTable.Chains[0].Rules = []string{
+		`iifname "lo" accept`,
+		"ct state established,related accept",
+		"tcp dport @ports counter accept",
+		"jump custom",
+		"tcp dport { 8080, 8443 } drop",
+	}
+	// set removed together with the rule referencing it
+	newTable.Sets = newTable.Sets[:2]
+	newTable.Chains[1].Rules = []string{"ip saddr 192.168.0.1 drop"}
+	// set elements changed
+	newTable.Sets[1].Elements = []string{"22", "443"}
+
+	messages, err := recordUpdate(current, oldTable, newTable)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(messages).To(Equal([]string{
+		"BATCH",
+		"NEWTABLE filter",
+		"DELRULE input handle 3",
+		"DELRULE custom handle 7",
+		"DELSET blocked",
+		"DELSETELEM ports",
+		"NEWSETELEM ports",
+		"NEWCHAIN input",
+		"NEWRULE input position 4",
+		"NEWSET __set%d",
+		"NEWSETELEM __set%d",
+		"NEWRULE input",
+		"NEWRULE custom",
+		"BATCH",
+	}))
+}
+
+func TestUpdateTableWithoutChange(t *testing.T) {
+	RegisterTestingT(t)
+
+	// unchanged table is left as is, including the dynamically added set element
+	current, oldTable := testTable()
+	_, newTable := testTable()
+	messages, err := recordUpdate(current, oldTable, newTable)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(messages).To(Equal([]string{"BATCH", "NEWTABLE filter", "BATCH"}))
+}
+
+func TestUpdateTableRecreateChain(t *testing.T) {
+	RegisterTestingT(t)
+
+	current, oldTable := testTable()
+	_, newTable := testTable()
+	// custom chain turned into a base chain, the jump to it is re-added
+	newTable.Chains[1].Type = "filter"
+	newTable.Chains[1].Hook = "forward"
+	newTable.Chains[1].Policy = "accept"
+	// set type changed
+	newTable.Sets[0].Flags = nil
+	newTable.Sets[0].Elements = []string{"10.0.0.1"}
+
+	messages, err := recordUpdate(current, oldTable, newTable)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(messages).To(Equal([]string{
+		"BATCH",
+		"NEWTABLE filter",
+		"DELRULE input handle 3",
+		"DELRULE input handle 5",
+		"DELRULE custom",
+		"DELCHAIN custom",
+		"DELSET allowed",
+		"NEWSET allowed",
+		"NEWSETELEM allowed",
+		"NEWCHAIN custom",
+		"NEWRULE input position 4",
+		"NEWRULE input",
+		"NEWRULE custom",
+		"BATCH",
+	}))
+}
+
+func TestCreateTable(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, newTable := testTable()
+	messages, err := recordUpdate(nil, nil, newTable)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(messages).To(Equal([]string{
+		"BATCH",
+		"NEWTABLE filter",
+		"NEWSET allowed",
+		"NEWSETELEM allowed",
+		"NEWSET ports",
+		"NEWSETELEM ports",
+		"NEWSET blocked",
+		"NEWSETELEM blocked",
+		"NEWCHAIN input",
+		"NEWCHAIN custom",
+		"NEWRULE input",
+		"NEWRULE input",
+		"NEWRULE input",
+		"NEWRULE input",
+		"NEWRULE custom",
+		"BATCH",
+	}))
+
+	// invalid rule is reported before anything is changed
+	newTable.Chains[1].Rules = []string{"log drop"}
+	_, err = recordUpdate(nil, nil, newTable)
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,1037 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// Rules are translated between the nft syntax and netlink expressions
+// the same way as by nft, but only the following subset of the nft syntax
+// is supported:
+//   - matches: iifname, oifname, meta iifname|oifname|l4proto|nfproto|mark,
+//     ip|ip6 saddr|daddr, tcp|udp|sctp sport|dport and ct state,
+//     compared (== or !=) with values, ranges, prefixes, named (@name)
+//     and anonymous ({ ... }) sets,
+//   - statements: counter, meta mark set, dnat, snat and masquerade,
+//   - verdicts: accept, drop, return, continue, jump and goto.
+
+// register used for the matched values, registers 2 and 3 are used for NAT ports
+const (
+	reg1 = unix.NFT_REG_1
+	reg2 = unix.NFT_REG_2
+	reg3 = unix.NFT_REG_3
+)
+
+// ErrUnsupportedRule is returned (wrapped) for rules outside the supported subset
+// of the nft syntax.
+var ErrUnsupportedRule = errors.New("unsupported nftables rule")
+
+// payloadField is a field of the network or transport header matched by rules.
+type payloadField struct {
+	proto    string
+	name     string
+	base     expr.PayloadBase
+	offset   uint32
+	dataType string
+}
+
+// header fields which can be matched by rules
+var payloadFields = []payloadField{
+	{proto: "ip", name: "saddr", base: expr.PayloadBaseNetworkHeader, offset: 12, dataType: "ipv4_addr"},
+	{proto: "ip", name: "daddr", base: expr.PayloadBaseNetworkHeader, offset: 16, dataType: "ipv4_addr"},
+	{proto: "ip6", name: "saddr", base: expr.PayloadBaseNetworkHeader, offset: 8, dataType: "ipv6_addr"},
+	{proto: "ip6", name: "daddr", base: expr.PayloadBaseNetworkHeader, offset: 24, dataType: "ipv6_addr"},
+	{proto: "tcp", name: "sport", base: expr.PayloadBaseTransportHeader, offset: 0, dataType: "inet_service"},
+	{proto: "tcp", name: "dport", base: expr.PayloadBaseTransportHeader, offset: 2, dataType: "inet_service"},
+	{proto: "udp", name: "sport", base: expr.PayloadBaseTransportHeader, offset: 0, dataType: "inet_service"},
+	{proto: "udp", name: "dport", base: expr.PayloadBaseTransportHeader, offset: 2, dataType: "inet_service"},
+	{proto: "sctp", name: "sport", base: expr.PayloadBaseTransportHeader, offset: 0, dataType: "inet_service"},
+	{proto: "sctp", name: "dport", base: expr.PayloadBaseTransportHeader, offset: 2, dataType: "inet_service"},
+}
+
+// network-layer protocols as matched by "meta nfproto" (inet family)
+// and by "meta protocol" (bridge and netdev families)
+var (
+	nfProtocols = map[string]byte{"ip": unix.NFPROTO_IPV4, "ip6": unix.NFPROTO_IPV6}
+	etherTypes  = map[string]uint16{"ip": unix.ETH_P_IP, "ip6": unix.ETH_P_IPV6}
+	nfProtoStr  = map[string]string{"ip": "ipv4", "ip6": "ipv6"}
+)
+
+// connection tracking states in the order printed by nft
+var ctStates = []struct {
+	name string
+	bit  uint32
+}{
+	{name: "invalid", bit: expr.CtStateBitINVALID},
+	{name: "established", bit: expr.CtStateBitESTABLISHED},
+	{name: "related", bit: expr.CtStateBitRELATED},
+	{name: "new", bit: expr.CtStateBitNEW},
+	{name: "untracked", bit: expr.CtStateBitUNTRACKED},
+}
+
+// verdicts without target chain
+var verdicts = map[string]expr.VerdictKind{
+	"accept":   expr.VerdictAccept,
+	"drop":     expr.VerdictDrop,
+	"return":   expr.VerdictReturn,
+	"continue": expr.VerdictContinue,
+}
+
+// ruleExprs is a rule translated into netlink expressions together with
+// the anonymous sets used by the rule.
+type ruleExprs struct {
+	exprs    []expr.Any
+	anonSets []*anonSet
+}
+
+// anonSet is an anonymous set used by a rule, created together with the rule.
+type anonSet struct {
+	set    *nftables.Set
+	values []valueRange
+	lookup *expr.Lookup
+}
+
+// anonSetValues returns values of the anonymous sets used by the rule, indexed by set names.
+func (r *ruleExprs) anonSetValues() map[string][]valueRange {
+	values := make(map[string][]valueRange)
+	for _, set := range r.anonSets {
+		values[set.lookup.SetName] = set.values
+	}
+	return values
+}
+
+// CanonicalRule returns the rule in the form printed by nft. Error is returned
+// if the rule is not valid or not supported.
+func CanonicalRule(family, rule string) (string, error) {
+	parsed, err := parseRule(family, rule)
+	if err != nil {
+		return "", err
+	}
+	return renderRule(family, parsed.exprs, parsed.anonSetValues())
+}
+
+// ruleParser translates a rule in the nft syntax into netlink expressions.
+type ruleParser struct {
+	family string
+	tokens []string
+	pos    int
+	rule   *ruleExprs
+
+	// protocols matched by the rule so far, either explicitly or as dependencies
+	netProto string
+	l4Proto  string
+}
+
+// parseRule translates a rule in the nft syntax into netlink expressions.
+func parseRule(family, rule string) (*ruleExprs, error) {
+	tokens, err := tokenizeRule(rule)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid rule %q", rule)
+	}
+	p := &ruleParser{family: family, tokens: tokens, rule: &ruleExprs{}}
+	if family == "ip" || family == "ip6" {
+		p.netProto = family
+	}
+	for p.pos < len(p.tokens) {
+		if err := p.parseNext(); err != nil {
+			return nil, errors.Wrapf(err, "invalid rule %q", rule)
+		}
+	}
+	return p.rule, nil
+}
+
+// tokenizeRule splits the rule into tokens. Quoted strings are returned as single
+// tokens (including the quotes), braces and commas as separate tokens.
+func tokenizeRule(rule string) ([]string, error) {
+	var (
+		tokens []string
+		token  strings.Builder
+	)
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+	for i := 0; i < len(rule); i++ {
+		switch c := rule[i]; c {
+		case '"':
+			end := strings.IndexByte(rule[i+1:], '"')
+			if end == -1 {
+				return nil, errors.New("unterminated quoted string")
+			}
+			token.WriteString(rule[i : i+end+2])
+			i += end + 1
+		case ' ', '\t':
+			flush()
+		case '{', '}', ',':
+			flush()
+			tokens = append(tokens, string(c))
+		default:
+			token.WriteByte(c)
+		}
+	}
+	flush()
+	return tokens, nil
+}
+
+func (p *ruleParser) next() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	p.pos++
+	return p.tokens[p.pos-1]
+}
+
+func (p *ruleParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *ruleParser) add(exprs ...expr.Any) {
+	p.rule.exprs = append(p.rule.exprs, exprs...)
+}
+
+// parseNext parses the next match or statement of the rule.
+func (p *ruleParser) parseNext() error {
+	switch token := p.next(); token {
+	case "counter":
+		// values counted so far (as printed by nft) are ignored
+		if p.peek() == "packets" && p.pos+2 < len(p.tokens) && p.tokens[p.pos+2] == "bytes" {
+			p.pos += 4
+		}
+		p.add(&expr.Counter{})
+		return nil
+	case "accept", "drop", "return", "continue":
+		p.add(&expr.Verdict{Kind: verdicts[token]})
+		return p.expectEnd(token)
+	case "jump", "goto":
+		chain := p.next()
+		if chain == "" {
+			return errors.Errorf("%s requires chain", token)
+		}
+		kind := expr.VerdictJump
+		if token == "goto" {
+			kind = expr.VerdictGoto
+		}
+		p.add(&expr.Verdict{Kind: kind, Chain: chain})
+		return p.expectEnd(token)
+	case "masquerade":
+		p.add(&expr.Masq{})
+		return p.expectEnd(token)
+	case "dnat", "snat":
+		return p.parseNAT(token)
+	case "iifname", "oifname":
+		return p.parseMeta(token)
+	case "meta":
+		return p.parseMeta(p.next())
+	case "ip", "ip6", "tcp", "udp", "sctp":
+		return p.parsePayload(token, p.next())
+	case "ct":
+		if key := p.next(); key != "state" {
+			return errors.Wrapf(ErrUnsupportedRule, "ct %s", key)
+		}
+		return p.parseCtState()
+	default:
+		return errors.Wrapf(ErrUnsupportedRule, "%s", token)
+	}
+}
+
+// expectEnd returns error if the statement is not the last one in the rule.
+func (p *ruleParser) expectEnd(statement string) error {
+	if p.pos < len(p.tokens) {
+		return errors.Errorf("%s has to be the last statement of the rule", statement)
+	}
+	return nil
+}
+
+// parseMeta parses match (or setting) of the packet meta information.
+func (p *ruleParser) parseMeta(key string) error {
+	switch key {
+	case "iifname", "oifname":
+		metaKey := expr.MetaKeyIIFNAME
+		if key == "oifname" {
+			metaKey = expr.MetaKeyOIFNAME
+		}
+		p.add(&expr.Meta{Key: metaKey, Register: reg1})
+		_, err := p.parseValue("ifname")
+		return err
+	case "l4proto":
+		p.add(&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: reg1})
+		proto, err := p.parseValue("inet_proto")
+		if proto != nil {
+			p.l4Proto = renderL4Proto(proto)
+		}
+		return err
+	case "nfproto":
+		p.add(&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: reg1})
+		op := p.parseOperator()
+		value := p.next()
+		var proto string
+		for netProto, name := range nfProtoStr {
+			if name == value {
+				proto = netProto
+			}
+		}
+		if proto == "" {
+			return errors.Errorf("invalid nfproto %s", value)
+		}
+		p.add(&expr.Cmp{Op: op, Register: reg1, Data: []byte{nfProtocols[proto]}})
+		if op == expr.CmpOpEq {
+			p.netProto = proto
+		}
+		return nil
+	case "mark":
+		if p.peek() == "set" {
+			p.next()
+			return p.parseMarkSet()
+		}
+		p.add(&expr.Meta{Key: expr.MetaKeyMARK, Register: reg1})
+		if p.peek() == "&" {
+			p.next()
+			mask, err := parseMark(p.next())
+			if err != nil {
+				return err
+			}
+			p.add(&expr.Bitwise{SourceRegister: reg1, DestRegister: reg1, Len: 4, Mask: mask, Xor: make([]byte, 4)})
+		}
+		_, err := p.parseValue("mark")
+		return err
+	default:
+		return errors.Wrapf(ErrUnsupportedRule, "meta %s", key)
+	}
+}
+
+// parseMarkSet parses setting of the packet mark, either to a value or by updating
+// bits selected by a mask, e.g. "meta mark set meta mark & 0xffffff00 | 0x00000001".
+func (p *ruleParser) parseMarkSet() error {
+	if p.peek() != "meta" {
+		mark, err := parseMark(p.next())
+		if err != nil {
+			return err
+		}
+		p.add(&expr.Immediate{Register: reg1, Data: mark})
+	} else {
+		p.next()
+		if p.next() != "mark" || p.next() != "&" {
+			return errors.Wrapf(ErrUnsupportedRule, "meta mark set")
+		}
+		mask, err := parseMark(p.next())
+		if err != nil {
+			return err
+		}
+		if p.next() != "|" {
+			return errors.Wrapf(ErrUnsupportedRule, "meta mark set")
+		}
+		mark, err := parseMark(p.next())
+		if err != nil {
+			return err
+		}
+		// (mark & mask) | value = (mark & mask & ^value) ^ value
+		for i := range mask {
+			mask[i] &^= mark[i]
+		}
+		p.add(
+			&expr.Meta{Key: expr.MetaKeyMARK, Register: reg1},
+			&expr.Bitwise{SourceRegister: reg1, DestRegister: reg1, Len: 4, Mask: mask, Xor: mark},
+		)
+	}
+	p.add(&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: reg1})
+	return nil
+}
+
+// parsePayload parses match of a network or transport header field.
+func (p *ruleParser) parsePayload(proto, name string) error {
+	var field *payloadField
+	for i := range payloadFields {
+		if payloadFields[i].proto == proto && payloadFields[i].name == name {
+			field = &payloadFields[i]
+		}
+	}
+	if field == nil {
+		return errors.Wrapf(ErrUnsupportedRule, "%s %s", proto, name)
+	}
+	var err error
+	if field.base == expr.PayloadBaseNetworkHeader {
+		err = p.addNetworkDependency(proto)
+	} else {
+		err = p.addTransportDependency(proto)
+	}
+	if err != nil {
+		return err
+	}
+	p.add(&expr.Payload{
+		DestRegister: reg1,
+		Base:         field.base,
+		Offset:       field.offset,
+		Len:          dataTypes[field.dataType].setType.Bytes,
+	})
+	_, err = p.parseValue(field.dataType)
+	return err
+}
+
+// addNetworkDependency adds match of the network-layer protocol required by the match
+// of the protocol header (unless already matched).
+func (p *ruleParser) addNetworkDependency(proto string) error {
+	if p.netProto == proto {
+		return nil
+	}
+	switch p.family {
+	case "inet":
+		p.add(
+			&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: reg1},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg1, Data: []byte{nfProtocols[proto]}},
+		)
+	case "bridge", "netdev":
+		p.add(
+			&expr.Meta{Key: expr.MetaKeyPROTOCOL, Register: reg1},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg1, Data: binaryutil.BigEndian.PutUint16(etherTypes[proto])},
+		)
+	default:
+		return errors.Errorf("%s header cannot be matched in the %s family", proto, p.family)
+	}
+	p.netProto = proto
+	return nil
+}
+
+// addTransportDependency adds match of the transport-layer protocol required by the match
+// of the protocol header (unless already matched).
+func (p *ruleParser) addTransportDependency(proto string) error {
+	if p.l4Proto == proto {
+		return nil
+	}
+	if p.family != "inet" && p.family != "ip" && p.family != "ip6" {
+		return errors.Errorf("%s header cannot be matched in the %s family", proto, p.family)
+	}
+	p.add(
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: reg1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: reg1, Data: []byte{l4Protocols[proto]}},
+	)
+	p.l4Proto = proto
+	return nil
+}
+
+// parseCtState parses match of the connection tracking states, e.g. "ct state established,related".
+func (p *ruleParser) parseCtState() error {
+	op := p.parseOperator()
+	var mask uint32
+	for {
+		name := p.next()
+		var bit uint32
+		for _, state := range ctStates {
+			if state.name == name {
+				bit = state.bit
+			}
+		}
+		if bit == 0 {
+			return errors.Errorf("invalid connection tracking state %s", name)
+		}
+		mask |= bit
+		if p.peek() != "," {
+			break
+		}
+		p.next()
+	}
+	// packet matches if any of the state bits is set
+	cmpOp := expr.CmpOpNeq
+	if op == expr.CmpOpNeq {
+		cmpOp = expr.CmpOpEq
+	}
+	p.add(
+		&expr.Ct{Register: reg1, Key: expr.CtKeySTATE},
+		&expr.Bitwise{SourceRegister: reg1, DestRegister: reg1, Len: 4,
+			Mask: binaryutil.NativeEndian.PutUint32(mask), Xor: make([]byte, 4)},
+		&expr.Cmp{Op: cmpOp, Register: reg1, Data: make([]byte, 4)},
+	)
+	return nil
+}
+
+// parseNAT parses DNAT or SNAT statement, e.g. "dnat ip to 10.0.0.1:8080".
+func (p *ruleParser) parseNAT(natType string) error {
+	if p.family != "inet" && p.family != "ip" && p.family != "ip6" {
+		return errors.Errorf("%s cannot be used in the %s family", natType, p.family)
+	}
+	var proto string
+	if token := p.peek(); token == "ip" || token == "ip6" {
+		proto = p.next()
+	}
+	if p.next() != "to" {
+		return errors.Errorf("%s requires address to translate to", natType)
+	}
+	addr, ports := splitHostPort(p.next())
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return errors.Errorf("invalid %s address %s", natType, addr)
+	}
+	addrProto := "ip6"
+	if ip.To4() != nil {
+		addrProto, ip = "ip", ip.To4()
+	}
+	switch {
+	case proto == "" && p.family == "inet":
+		return errors.Errorf("address family of %s has to be specified in the inet family", natType)
+	case proto != "" && proto != addrProto, p.family != "inet" && p.family != addrProto:
+		return errors.Errorf("%s address %s does not match the address family", natType, addr)
+	}
+
+	nat := &expr.NAT{
+		Type:       expr.NATTypeDestNAT,
+		Family:     uint32(nfProtocols[addrProto]),
+		RegAddrMin: reg1,
+	}
+	if natType == "snat" {
+		nat.Type = expr.NATTypeSourceNAT
+	}
+	p.add(&expr.Immediate{Register: reg1, Data: ip})
+	if ports != "" {
+		portRange, err := dataTypes["inet_service"].parseRange(ports)
+		if err != nil {
+			return err
+		}
+		p.add(&expr.Immediate{Register: reg2, Data: portRange.from})
+		nat.RegProtoMin = reg2
+		if portRange.to != nil {
+			p.add(&expr.Immediate{Register: reg3, Data: portRange.to})
+			nat.RegProtoMax = reg3
+		}
+	}
+	p.add(nat)
+	return p.expectEnd(natType)
+}
+
+// splitHostPort splits NAT address into the host and (optional) port or port range.
+func splitHostPort(addr string) (host, ports string) {
+	if strings.HasPrefix(addr, "[") {
+		end := strings.Index(addr, "]")
+		if end == -1 {
+			return addr, ""
+		}
+		return addr[1:end], strings.TrimPrefix(addr[end+1:], ":")
+	}
+	if strings.Count(addr, ":") == 1 {
+		idx := strings.Index(addr, ":")
+		return addr[:idx], addr[idx+1:]
+	}
+	return addr, ""
+}
+
+// parseOperator parses optional comparison operator.
+func (p *ruleParser) parseOperator() expr.CmpOp {
+	switch p.peek() {
+	case "==":
+		p.next()
+	case "!=":
+		p.next()
+		return expr.CmpOpNeq
+	}
+	return expr.CmpOpEq
+}
+
+// parseValue parses optional comparison operator followed by a value, range, prefix,
+// named set or anonymous set of the given data type, and adds expressions comparing
+// it with the content of the register 1. The value is returned if the match
+// is an equality with a single value.
+func (p *ruleParser) parseValue(typeName string) (eqValue []byte, err error) {
+	dt := dataTypes[typeName]
+	op := p.parseOperator()
+
+	var value valueRange
+	token := p.next()
+	switch {
+	case token == "":
+		return nil, errors.New("missing value to match")
+	case strings.HasPrefix(token, "@"):
+		p.add(&expr.Lookup{SourceRegister: reg1, SetName: token[1:], Invert: op == expr.CmpOpNeq})
+		return nil, nil
+	case token == "{":
+		values, err := p.parseSetValues(dt)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != 1 {
+			p.addAnonSet(dt, values, op == expr.CmpOpNeq)
+			return nil, nil
+		}
+		// set with a single element is matched as the element
+		value = values[0]
+	case typeName == "ifname" && strings.HasSuffix(strings.Trim(token, "\""), "*"):
+		// interface name prefix
+		prefix := strings.TrimSuffix(strings.Trim(token, "\""), "*")
+		p.add(&expr.Cmp{Op: op, Register: reg1, Data: []byte(prefix)})
+		return nil, nil
+	default:
+		if value, err = p.parseRangeTokens(dt, token); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case value.to == nil:
+		p.add(&expr.Cmp{Op: op, Register: reg1, Data: value.from})
+		if op == expr.CmpOpEq {
+			return value.from, nil
+		}
+	case dt.prefixes && isPrefix(value):
+		mask := make([]byte, len(value.from))
+		for i := range mask {
+			mask[i] = ^(value.from[i] ^ value.to[i])
+		}
+		p.add(
+			&expr.Bitwise{SourceRegister: reg1, DestRegister: reg1, Len: uint32(len(mask)),
+				Mask: mask, Xor: make([]byte, len(mask))},
+			&expr.Cmp{Op: op, Register: reg1, Data: value.from},
+		)
+	default:
+		p.add(&expr.Range{Op: op, Register: reg1, FromData: value.from, ToData: value.to})
+	}
+	return nil, nil
+}
+
+// parseRangeTokens parses value, range or prefix starting with the given token
+// (range can be written with spaces around the dash).
+func (p *ruleParser) parseRangeTokens(dt *dataType, token string) (valueRange, error) {
+	if dt.ranges && p.peek() == "-" {
+		p.next()
+		token += "-" + p.next()
+	}
+	return dt.parseRange(token)
+}
+
+// parseSetValues parses elements of anonymous set up to the closing brace.
+func (p *ruleParser) parseSetValues(dt *dataType) (values []valueRange, err error) {
+	for {
+		token := p.next()
+		switch token {
+		case "":
+			return nil, errors.New("unterminated anonymous set")
+		case "}":
+			if len(values) == 0 {
+				return nil, errors.New("empty anonymous set")
+			}
+			return values, nil
+		case ",":
+			continue
+		}
+		value, err := p.parseRangeTokens(dt, token)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+}
+
+// addAnonSet adds lookup into an anonymous set with the given values.
+func (p *ruleParser) addAnonSet(dt *dataType, values []valueRange, invert bool) {
+	set := &nftables.Set{
+		Name:      fmt.Sprintf("__set%d", len(p.rule.anonSets)),
+		Anonymous: true,
+		Constant:  true,
+		KeyType:   dt.setType,
+	}
+	for _, value := range values {
+		if value.to != nil {
+			set.Interval = true
+		}
+	}
+	lookup := &expr.Lookup{SourceRegister: reg1, SetName: set.Name, Invert: invert}
+	p.rule.anonSets = append(p.rule.anonSets, &anonSet{set: set, values: values, lookup: lookup})
+	p.add(lookup)
+}
+
+// isPrefix returns true if the range of values forms a prefix.
+func isPrefix(value valueRange) bool {
+	_, ok := prefixLength(value.from, value.to)
+	return ok
+}
+
+// ruleRenderer translates netlink expressions of a rule into the nft syntax.
+type ruleRenderer struct {
+	family   string
+	exprs    []expr.Any
+	pos      int
+	anonSets map[string][]valueRange
+
+	// rendered matches and statements
+	parts []string
+
+	// protocols matched so far and indexes of their matches in parts;
+	// a protocol match is a dependency not printed by nft if it is followed
+	// by a match of the protocol header
+	netProto, l4Proto string
+	netDep, l4Dep     int
+
+	// data loaded into registers by immediate expressions
+	immediates map[uint32][]byte
+}
+
+// renderRule translates netlink expressions of a rule into the nft syntax.
+// Values of anonymous sets used by the rule are passed by set names.
+func renderRule(family string, exprs []expr.Any, anonSets map[string][]valueRange) (string, error) {
+	r := &ruleRenderer{
+		family:     family,
+		exprs:      exprs,
+		anonSets:   anonSets,
+		netDep:     -1,
+		l4Dep:      -1,
+		immediates: make(map[uint32][]byte),
+	}
+	if family == "ip" || family == "ip6" {
+		r.netProto = family
+	}
+	for r.pos < len(r.exprs) {
+		if err := r.renderNext(); err != nil {
+			return "", err
+		}
+	}
+	var parts []string
+	for _, part := range r.parts {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, " "), nil
+}
+
+func (r *ruleRenderer) next() expr.Any {
+	if r.pos >= len(r.exprs) {
+		return nil
+	}
+	r.pos++
+	return r.exprs[r.pos-1]
+}
+
+func (r *ruleRenderer) peek(offset int) expr.Any {
+	if r.pos+offset >= len(r.exprs) {
+		return nil
+	}
+	return r.exprs[r.pos+offset]
+}
+
+// add adds rendered match or statement, returns its index.
+func (r *ruleRenderer) add(part string) int {
+	r.parts = append(r.parts, part)
+	return len(r.parts) - 1
+}
+
+// renderNext renders the next match or statement.
+func (r *ruleRenderer) renderNext() error {
+	switch e := r.next().(type) {
+	case *expr.Meta:
+		if e.SourceRegister {
+			mark, ok := r.immediates[e.Register]
+			if e.Key != expr.MetaKeyMARK || !ok {
+				return errors.Wrapf(ErrUnsupportedRule, "meta key %d set", e.Key)
+			}
+			r.add("meta mark set " + renderMark(mark))
+			return nil
+		}
+		return r.renderMeta(e)
+	case *expr.Payload:
+		return r.renderPayload(e)
+	case *expr.Ct:
+		return r.renderCtState(e)
+	case *expr.Immediate:
+		r.immediates[e.Register] = e.Data
+	case *expr.Counter:
+		r.add("counter")
+	case *expr.Verdict:
+		return r.renderVerdict(e)
+	case *expr.NAT:
+		return r.renderNAT(e)
+	case *expr.Masq:
+		r.add("masquerade")
+	default:
+		return errors.Wrapf(ErrUnsupportedRule, "expression %T", e)
+	}
+	return nil
+}
+
+// renderMeta renders match or setting of the packet meta information.
+func (r *ruleRenderer) renderMeta(e *expr.Meta) error {
+	switch e.Key {
+	case expr.MetaKeyIIFNAME, expr.MetaKeyOIFNAME:
+		name := "iifname"
+		if e.Key == expr.MetaKeyOIFNAME {
+			name = "oifname"
+		}
+		if cmp, ok := r.peek(0).(*expr.Cmp); ok && len(cmp.Data) < ifNameSize {
+			// interface name prefix
+			r.next()
+			r.add(name + " " + opPrefix(cmp.Op) + fmt.Sprintf("%q", string(cmp.Data)+"*"))
+			return nil
+		}
+		value, _, err := r.renderValue("ifname")
+		if err != nil {
+			return err
+		}
+		r.add(name + " " + value)
+	case expr.MetaKeyL4PROTO:
+		value, eqValue, err := r.renderValue("inet_proto")
+		if err != nil {
+			return err
+		}
+		idx := r.add("meta l4proto " + value)
+		if eqValue != nil {
+			r.l4Proto, r.l4Dep = renderL4Proto(eqValue), idx
+		}
+	case expr.MetaKeyNFPROTO, expr.MetaKeyPROTOCOL:
+		cmp, ok := r.next().(*expr.Cmp)
+		if !ok || (cmp.Op != expr.CmpOpEq && cmp.Op != expr.CmpOpNeq) {
+			return errors.Wrapf(ErrUnsupportedRule, "meta key %d", e.Key)
+		}
+		var proto string
+		for netProto := range nfProtocols {
+			if (e.Key == expr.MetaKeyNFPROTO && bytes.Equal(cmp.Data, []byte{nfProtocols[netProto]})) ||
+				(e.Key == expr.MetaKeyPROTOCOL && bytes.Equal(cmp.Data,
+					binaryutil.BigEndian.PutUint16(etherTypes[netProto]))) {
+				proto = netProto
+			}
+		}
+		if proto == "" {
+			return errors.Wrapf(ErrUnsupportedRule, "meta key %d value %v", e.Key, cmp.Data)
+		}
+		var idx int
+		if e.Key == expr.MetaKeyNFPROTO {
+			idx = r.add("meta nfproto " + opPrefix(cmp.Op) + nfProtoStr[proto])
+		} else {
+			idx = r.add("meta protocol " + opPrefix(cmp.Op) + proto)
+		}
+		if cmp.Op == expr.CmpOpEq {
+			r.netProto, r.netDep = proto, idx
+		}
+	case expr.MetaKeyMARK:
+		bitwise, isBitwise := r.peek(0).(*expr.Bitwise)
+		if setMark, ok := r.peek(1).(*expr.Meta); isBitwise && ok && setMark.SourceRegister {
+			// setting of the bits selected by mask
+			r.pos += 2
+			r.add(fmt.Sprintf("meta mark set meta mark & %s | %s", renderMark(bitwise.Mask), renderMark(bitwise.Xor)))
+			return nil
+		}
+		if isBitwise {
+			r.next()
+			value, _, err := r.renderValue("mark")
+			if err != nil {
+				return err
+			}
+			if !strings.HasPrefix(value, "!=") {
+				value = "== " + value
+			}
+			r.add(fmt.Sprintf("meta mark & %s %s", renderMark(bitwise.Mask), value))
+			return nil
+		}
+		value, _, err := r.renderValue("mark")
+		if err != nil {
+			return err
+		}
+		r.add("meta mark " + value)
+	default:
+		return errors.Wrapf(ErrUnsupportedRule, "meta key %d", e.Key)
+	}
+	return nil
+}
+
+// renderPayload renders match of a network or transport header field.
+func (r *ruleRenderer) renderPayload(e *expr.Payload) error {
+	var field *payloadField
+	for i := range payloadFields {
+		f := &payloadFields[i]
+		if f.base != e.Base || f.offset != e.Offset || dataTypes[f.dataType].setType.Bytes != e.Len {
+			continue
+		}
+		if f.base == expr.PayloadBaseNetworkHeader || f.proto == r.l4Proto {
+			field = f
+		}
+	}
+	if field == nil || e.OperationType != expr.PayloadLoad {
+		return errors.Wrapf(ErrUnsupportedRule, "payload base %d offset %d", e.Base, e.Offset)
+	}
+	// the header can be matched only after the protocol is matched,
+	// which does not need to be printed then
+	if field.base == expr.PayloadBaseNetworkHeader {
+		if r.netProto != field.proto {
+			return errors.Wrapf(ErrUnsupportedRule, "%s header without protocol dependency", field.proto)
+		}
+		if r.netDep >= 0 {
+			r.parts[r.netDep] = ""
+			r.netDep = -1
+		}
+	} else if r.l4Dep >= 0 {
+		r.parts[r.l4Dep] = ""
+		r.l4Dep = -1
+	}
+	value, _, err := r.renderValue(field.dataType)
+	if err != nil {
+		return err
+	}
+	r.add(fmt.Sprintf("%s %s %s", field.proto, field.name, value))
+	return nil
+}
+
+// renderCtState renders match of the connection tracking states.
+func (r *ruleRenderer) renderCtState(e *expr.Ct) error {
+	bitwise, isBitwise := r.peek(0).(*expr.Bitwise)
+	cmp, isCmp := r.peek(1).(*expr.Cmp)
+	if e.Key != expr.CtKeySTATE || e.SourceRegister || !isBitwise || !isCmp ||
+		len(bitwise.Mask) != 4 || !isZero(bitwise.Xor) || !isZero(cmp.Data) {
+		return errors.Wrapf(ErrUnsupportedRule, "ct key %d", e.Key)
+	}
+	r.pos += 2
+	mask := binaryutil.NativeEndian.Uint32(bitwise.Mask)
+	var states []string
+	for _, state := range ctStates {
+		if mask&state.bit != 0 {
+			states = append(states, state.name)
+			mask &^= state.bit
+		}
+	}
+	if mask != 0 || len(states) == 0 {
+		return errors.Wrapf(ErrUnsupportedRule, "ct state mask 0x%x", binaryutil.NativeEndian.Uint32(bitwise.Mask))
+	}
+	op := ""
+	if cmp.Op == expr.CmpOpEq {
+		op = "!= "
+	}
+	r.add("ct state " + op + strings.Join(states, ","))
+	return nil
+}
+
+// renderVerdict renders verdict statement.
+func (r *ruleRenderer) renderVerdict(e *expr.Verdict) error {
+	switch e.Kind {
+	case expr.VerdictJump:
+		r.add("jump " + e.Chain)
+		return nil
+	case expr.VerdictGoto:
+		r.add("goto " + e.Chain)
+		return nil
+	}
+	for name, kind := range verdicts {
+		if kind == e.Kind {
+			r.add(name)
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrUnsupportedRule, "verdict %d", e.Kind)
+}
+
+// renderNAT renders DNAT or SNAT statement.
+func (r *ruleRenderer) renderNAT(e *expr.NAT) error {
+	addr, ok := r.immediates[e.RegAddrMin]
+	if !ok {
+		return errors.Wrapf(ErrUnsupportedRule, "NAT without address")
+	}
+	natType := "dnat"
+	if e.Type == expr.NATTypeSourceNAT {
+		natType = "snat"
+	}
+	to := net.IP(addr).String()
+	if e.RegProtoMin != 0 {
+		ports := valueRange{from: r.immediates[e.RegProtoMin]}
+		if e.RegProtoMax != 0 {
+			ports = newValueRange(ports.from, r.immediates[e.RegProtoMax])
+		}
+		if len(addr) == net.IPv6len {
+			to = "[" + to + "]"
+		}
+		to += ":" + dataTypes["inet_service"].renderRange(ports)
+	}
+	args := []string{natType}
+	if r.family == "inet" {
+		// address family of the translation is printed in the inet family
+		if e.Family == unix.NFPROTO_IPV4 {
+			args = append(args, "ip")
+		} else {
+			args = append(args, "ip6")
+		}
+	}
+	r.add(strings.Join(append(args, "to", to), " "))
+	return nil
+}
+
+// renderValue renders value (or values) compared with the content of the register
+// by the next expression(s). The value is returned also in the binary form if the match
+// is an equality with a single value.
+func (r *ruleRenderer) renderValue(typeName string) (value string, eqValue []byte, err error) {
+	dt := dataTypes[typeName]
+	switch e := r.next().(type) {
+	case *expr.Cmp:
+		if e.Op != expr.CmpOpEq && e.Op != expr.CmpOpNeq {
+			return "", nil, errors.Wrapf(ErrUnsupportedRule, "comparison %d", e.Op)
+		}
+		if e.Op == expr.CmpOpEq {
+			eqValue = e.Data
+		}
+		return opPrefix(e.Op) + dt.render(e.Data), eqValue, nil
+	case *expr.Bitwise:
+		// prefix
+		cmp, ok := r.next().(*expr.Cmp)
+		if !ok || !dt.prefixes || !isZero(e.Xor) || len(e.Mask) != len(cmp.Data) {
+			return "", nil, errors.Wrapf(ErrUnsupportedRule, "bitwise operation on %s", dt.setType.Name)
+		}
+		to := make([]byte, len(cmp.Data))
+		for i := range to {
+			to[i] = cmp.Data[i] | ^e.Mask[i]
+		}
+		return opPrefix(cmp.Op) + dt.renderRange(newValueRange(cmp.Data, to)), nil, nil
+	case *expr.Range:
+		return opPrefix(e.Op) + dt.renderRange(newValueRange(e.FromData, e.ToData)), nil, nil
+	case *expr.Lookup:
+		prefix := ""
+		if e.Invert {
+			prefix = "!= "
+		}
+		values, isAnonymous := r.anonSets[e.SetName]
+		if !isAnonymous {
+			return prefix + "@" + e.SetName, nil, nil
+		}
+		return prefix + renderAnonSet(dt, values), nil, nil
+	default:
+		return "", nil, errors.Wrapf(ErrUnsupportedRule, "match of %s by %T", dt.setType.Name, e)
+	}
+}
+
+// renderAnonSet renders values of anonymous set.
+func renderAnonSet(dt *dataType, values []valueRange) string {
+	sorted := append([]valueRange{}, values...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].from, sorted[j].from) < 0
+	})
+	var elems []string
+	for _, value := range sorted {
+		elems = append(elems, dt.renderRange(value))
+	}
+	return "{ " + strings.Join(elems, ", ") + " }"
+}
+
+// opPrefix returns comparison operator printed before the value.
+func opPrefix(op expr.CmpOp) string {
+	if op == expr.CmpOpNeq {
+		return "!= "
+	}
+	return ""
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
+)
+
+func TestParseRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		family   string
+		rule     string
+		expected []expr.Any
+	}{
+		{
+			name:   "port match",
+			family: "ip",
+			rule:   "tcp dport 22 accept",
+			expected: []expr.Any{
+				&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{unix.IPPROTO_TCP}},
+				&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{0, 22}},
+				&expr.Verdict{Kind: expr.VerdictAccept},
+			},
+		},
+		{
+			name:   "network match in inet family",
+			family: "inet",
+			rule:   "ip saddr 10.0.0.0/8 counter drop",
+			expected: []expr.Any{
+				&expr.Meta{Key: expr.MetaKeyNFPROTO, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{unix.NFPROTO_IPV4}},
+				&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: 12, Len: 4},
+				&expr.Bitwise{SourceRegister: 1, DestRegister: 1, Len: 4,
+					Mask: []byte{255, 0, 0, 0}, Xor: []byte{0, 0, 0, 0}},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{10, 0, 0, 0}},
+				&expr.Counter{},
+				&expr.Verdict{Kind: expr.VerdictDrop},
+			},
+		},
+		{
+			name:   "network match in bridge family",
+			family: "bridge",
+			rule:   "ip6 daddr != fd00::1 drop",
+			expected: []expr.Any{
+				&expr.Meta{Key: expr.MetaKeyPROTOCOL, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{0x86, 0xdd}},
+				&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: 24, Len: 16},
+				&expr.Cmp{Op: expr.CmpOpNeq, Register: 1, Data: net.ParseIP("fd00::1")},
+				&expr.Verdict{Kind: expr.VerdictDrop},
+			},
+		},
+		{
+			name:   "interface and mark setting",
+			family: "ip",
+			rule:   `iifname "eth0" meta mark set 0x00000010`,
+			expected: []expr.Any{
+				&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte("eth0\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00")},
+				&expr.Immediate{Register: 1, Data: binaryutil.NativeEndian.PutUint32(0x10)},
+				&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: 1},
+			},
+		},
+		{
+			name:   "interface prefix and port range",
+			family: "inet",
+			rule:   `oifname "veth*" udp sport 1024 - 65535 accept`,
+			expected: []expr.Any{
+				&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte("veth")},
+				&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{unix.IPPROTO_UDP}},
+				&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 0, Len: 2},
+				&expr.Range{Op: expr.CmpOpEq, Register: 1, FromData: []byte{4, 0}, ToData: []byte{255, 255}},
+				&expr.Verdict{Kind: expr.VerdictAccept},
+			},
+		},
+		{
+			name:   "connection tracking states",
+			family: "ip",
+			rule:   "ct state established,related accept",
+			expected: []expr.Any{
+				&expr.Ct{Register: 1, Key: expr.CtKeySTATE},
+				&expr.Bitwise{SourceRegister: 1, DestRegister: 1, Len: 4,
+					Mask: binaryutil.NativeEndian.PutUint32(expr.CtStateBitESTABLISHED | expr.CtStateBitRELATED),
+					Xor:  []byte{0, 0, 0, 0}},
+				&expr.Cmp{Op: expr.CmpOpNeq, Register: 1, Data: []byte{0, 0, 0, 0}},
+				&expr.Verdict{Kind: expr.VerdictAccept},
+			},
+		},
+		{
+			name:   "DNAT with port",
+			family: "inet",
+			rule:   "tcp dport 80 dnat ip to 10.0.0.1:8080",
+			expected: []expr.Any{
+				&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{unix.IPPROTO_TCP}},
+				&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+				&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{0, 80}},
+				&expr.Immediate{Register: 1, Data: []byte{10, 0, 0, 1}},
+				&expr.Immediate{Register: 2, Data: []byte{0x1f, 0x90}},
+				&expr.NAT{Type: expr.NATTypeDestNAT, Family: unix.NFPROTO_IPV4, RegAddrMin: 1, RegProtoMin: 2},
+			},
+		},
+		{
+			name:   "named set and jump",
+			family: "ip6",
+			rule:   "ip6 saddr != @blocked jump custom",
+			expected: []expr.Any{
+				&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseNetworkHeader, Offset: 8, Len: 16},
+				&expr.Lookup{SourceRegister: 1, SetName: "blocked", Invert: true},
+				&expr.Verdict{Kind: expr.VerdictJump, Chain: "custom"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			parsed, err := parseRule(test.family, test.rule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.exprs).To(Equal(test.expected))
+			Expect(parsed.anonSets).To(BeEmpty())
+		})
+	}
+}
+
+func TestParseRuleWithAnonymousSet(t *testing.T) {
+	RegisterTestingT(t)
+
+	parsed, err := parseRule("ip", "ip daddr { 10.0.0.1, 10.1.0.0/16 } tcp dport != { 22, 80 } drop")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(parsed.anonSets).To(HaveLen(2))
+
+	addrs := parsed.anonSets[0]
+	Expect(addrs.set.Anonymous).To(BeTrue())
+	Expect(addrs.set.Constant).To(BeTrue())
+	Expect(addrs.set.Interval).To(BeTrue())
+	Expect(addrs.set.KeyType.Name).To(Equal("ipv4_addr"))
+	Expect(addrs.values).To(Equal([]valueRange{
+		{from: []byte{10, 0, 0, 1}},
+		{from: []byte{10, 1, 0, 0}, to: []byte{10, 1, 255, 255}},
+	}))
+	Expect(addrs.lookup.Invert).To(BeFalse())
+
+	ports := parsed.anonSets[1]
+	Expect(ports.set.Interval).To(BeFalse())
+	Expect(ports.set.KeyType.Name).To(Equal("inet_service"))
+	Expect(ports.lookup.Invert).To(BeTrue())
+
+	// lookups refer to the anonymous sets
+	Expect(parsed.exprs).To(ContainElement(addrs.lookup))
+	Expect(parsed.exprs).To(ContainElement(ports.lookup))
+	Expect(parsed.exprs[len(parsed.exprs)-1]).To(Equal(&expr.Verdict{Kind: expr.VerdictDrop}))
+}
+
+func TestCanonicalRule(t *testing.T) {
+	tests := []struct {
+		family    string
+		rule      string
+		canonical string
+	}{
+		{family: "ip", rule: "tcp dport 22 accept", canonical: "tcp dport 22 accept"},
+		{family: "ip", rule: "meta l4proto tcp tcp dport 22 accept", canonical: "tcp dport 22 accept"},
+		{family: "ip", rule: "meta l4proto udp accept", canonical: "meta l4proto udp accept"},
+		{family: "ip", rule: "tcp dport {443,80} accept", canonical: "tcp dport { 80, 443 } accept"},
+		{family: "ip", rule: "tcp dport { 22 } accept", canonical: "tcp dport 22 accept"},
+		{family: "ip", rule: "tcp dport 1024 - 65535 accept", canonical: "tcp dport 1024-65535 accept"},
+		{family: "ip", rule: "counter packets 5 bytes 300 accept", canonical: "counter accept"},
+		{family: "ip", rule: "ip saddr 10.0.0.1/32 drop", canonical: "ip saddr 10.0.0.1 drop"},
+		{family: "ip", rule: "ip saddr 192.168.1.0-192.168.1.255 drop", canonical: "ip saddr 192.168.1.0/24 drop"},
+		{family: "ip", rule: "ip saddr 192.168.1.1-192.168.1.5 drop", canonical: "ip saddr 192.168.1.1-192.168.1.5 drop"},
+		{family: "ip", rule: "ip daddr != { 10.0.0.0/8, 192.168.0.1 } drop",
+			canonical: "ip daddr != { 10.0.0.0/8, 192.168.0.1 } drop"},
+		{family: "inet", rule: "meta nfproto ipv4 ip saddr 10.0.0.1 accept", canonical: "ip saddr 10.0.0.1 accept"},
+		{family: "inet", rule: "meta nfproto ipv6 accept", canonical: "meta nfproto ipv6 accept"},
+		{family: "bridge", rule: "ip saddr 10.0.0.1 accept", canonical: "ip saddr 10.0.0.1 accept"},
+		{family: "ip", rule: "iifname eth0 accept", canonical: `iifname "eth0" accept`},
+		{family: "ip", rule: `meta oifname != "veth*" drop`, canonical: `oifname != "veth*" drop`},
+		{family: "ip", rule: "ct state != invalid accept", canonical: "ct state != invalid accept"},
+		{family: "ip", rule: "ct state related,established accept", canonical: "ct state established,related accept"},
+		{family: "ip", rule: "meta mark 0x10 accept", canonical: "meta mark 0x00000010 accept"},
+		{family: "ip", rule: "meta mark & 0x0000ff00 == 0x00000100 accept",
+			canonical: "meta mark & 0x0000ff00 == 0x00000100 accept"},
+		{family: "ip", rule: "meta mark & 0x0000ff00 != 0x00000100 accept",
+			canonical: "meta mark & 0x0000ff00 != 0x00000100 accept"},
+		{family: "ip", rule: "meta mark set 0x1", canonical: "meta mark set 0x00000001"},
+		{family: "ip", rule: "meta mark set meta mark & 0xffff00ff | 0x00000100",
+			canonical: "meta mark set meta mark & 0xffff00ff | 0x00000100"},
+		{family: "ip", rule: "ip saddr @allowed tcp dport @ports counter accept",
+			canonical: "ip saddr @allowed tcp dport @ports counter accept"},
+		{family: "ip", rule: "tcp dport 80 dnat to 10.0.0.1:8080", canonical: "tcp dport 80 dnat to 10.0.0.1:8080"},
+		{family: "inet", rule: "tcp dport 80 dnat ip to 10.0.0.1", canonical: "tcp dport 80 dnat ip to 10.0.0.1"},
+		{family: "inet", rule: "udp sport 53 snat ip6 to [fd00::1]:1000-2000",
+			canonical: "udp sport 53 snat ip6 to [fd00::1]:1000-2000"},
+		{family: "ip", rule: "oifname eth0 masquerade", canonical: `oifname "eth0" masquerade`},
+		{family: "ip", rule: "jump custom", canonical: "jump custom"},
+		{family: "ip", rule: "goto custom", canonical: "goto custom"},
+		{family: "ip", rule: "return", canonical: "return"},
+	}
+	for _, test := range tests {
+		t.Run(test.family+" "+test.rule, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			canonical, err := CanonicalRule(test.family, test.rule)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(canonical).To(Equal(test.canonical))
+
+			// the canonical form is stable
+			again, err := CanonicalRule(test.family, canonical)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(again).To(Equal(canonical))
+		})
+	}
+}
+
+func TestCanonicalRuleErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		family      string
+		rule        string
+		unsupported bool
+	}{
+		{name: "unsupported statement", family: "ip", rule: `log prefix "dropped" drop`, unsupported: true},
+		{name: "unsupported match", family: "ip", rule: "icmp type echo-request accept", unsupported: true},
+		{name: "unsupported meta key", family: "ip", rule: "meta skuid 0 accept", unsupported: true},
+		{name: "statement after verdict", family: "ip", rule: "accept counter"},
+		{name: "jump without chain", family: "ip", rule: "jump"},
+		{name: "invalid port", family: "ip", rule: "tcp dport 70000 accept"},
+		{name: "invalid address", family: "ip", rule: "ip saddr 10.0.0.256 accept"},
+		{name: "address of other family", family: "ip", rule: "ip6 saddr 10.0.0.1 accept"},
+		{name: "network header in ip6 family", family: "ip6", rule: "ip saddr 10.0.0.1 accept"},
+		{name: "transport header in bridge family", family: "bridge", rule: "tcp dport 22 accept"},
+		{name: "reversed range", family: "ip", rule: "tcp dport 80-22 accept"},
+		{name: "unterminated set", family: "ip", rule: "tcp dport { 22, 80 accept"},
+		{name: "empty set", family: "ip", rule: "tcp dport { } accept"},
+		{name: "unterminated quote", family: "ip", rule: `iifname "eth0 accept`},
+		{name: "too long interface name", family: "ip", rule: "iifname interface-name-0123 accept"},
+		{name: "invalid connection state", family: "ip", rule: "ct state open accept"},
+		{name: "NAT without family in inet", family: "inet", rule: "dnat to 10.0.0.1"},
+		{name: "NAT with mismatched family", family: "inet", rule: "dnat ip6 to 10.0.0.1"},
+		{name: "NAT in bridge family", family: "bridge", rule: "snat to 10.0.0.1"},
+		{name: "missing value", family: "ip", rule: "tcp dport"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			_, err := CanonicalRule(test.family, test.rule)
+			Expect(err).To(HaveOccurred())
+			if test.unsupported {
+				Expect(err.Error()).To(ContainSubstring(ErrUnsupportedRule.Error()))
+			}
+		})
+	}
+}
+
+func TestRenderRuleWithAnonymousSet(t *testing.T) {
+	RegisterTestingT(t)
+
+	// anonymous sets are named by the kernel
+	exprs := []expr.Any{
+		&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: 1},
+		&expr.Cmp{Op: expr.CmpOpEq, Register: 1, Data: []byte{unix.IPPROTO_TCP}},
+		&expr.Payload{DestRegister: 1, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+		&expr.Lookup{SourceRegister: 1, SetName: "__set3", SetID: 3},
+		&expr.Counter{Bytes: 300, Packets: 5},
+		&expr.Verdict{Kind: expr.VerdictAccept},
+	}
+	anonSets := map[string][]valueRange{
+		"__set3": {{from: []byte{1, 187}}, {from: []byte{0, 80}}},
+	}
+	rule, err := renderRule("inet", exprs, anonSets)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(rule).To(Equal("tcp dport { 80, 443 } counter accept"))
+
+	// unknown expressions are not rendered
+	_, err = renderRule("inet", append(exprs, &expr.Log{}), anonSets)
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Table --value-type *linux_nftables.Table --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/nftables" --output-dir "descriptor"
+
+package nftablesplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/infra"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nftablesplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/netalloc"
+)
+
+const (
+	// by default, at most 10 go routines will split the configured tables
+	// to execute the Retrieve operation in parallel.
+	defaultGoRoutinesCnt = 10
+)
+
+// NFTablesPlugin configures Linux nftables tables.
+type NFTablesPlugin struct {
+	Deps
+
+	// From configuration file
+	disabled bool
+
+	// system handlers
+	nftHandler linuxcalls.NFTablesAPI
+}
+
+// Deps lists dependencies of the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	NsPlugin    nsplugin.API
+	IfPlugin    ifplugin.API
+	AddrAlloc   netalloc.AddressAllocator
+}
+
+// Config holds the plugin configuration.
+type Config struct {
+	Disabled      bool `json:"disabled"`
+	GoRoutinesCnt int  `json:"go-routines-count"`
+}
+
+// Init initializes and registers descriptors and handlers for Linux nftables tables.
+func (p *NFTablesPlugin) Init() error {
+	// parse configuration file
+	config, err := p.retrieveConfig()
+	if err != nil {
+		return err
+	}
+	p.Log.Debugf("Linux nftables config: %+v", config)
+	if config.Disabled {
+		p.disabled = true
+		p.Log.Infof("Disabling nftables plugin")
+		return nil
+	}
+
+	// init nftables handler
+	p.nftHandler = linuxcalls.NewNFTablesHandler()
+
+	// init & register the descriptor
+	tableDescriptor := descriptor.NewTableDescriptor(
+		p.KVScheduler, p.nftHandler, p.IfPlugin, p.NsPlugin, p.AddrAlloc, p.Log, config.GoRoutinesCnt)
+
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(tableDescriptor)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close does nothing here.
+func (p *NFTablesPlugin) Close() error {
+	return nil
+}
+
+// retrieveConfig loads plugin configuration file.
+func (p *NFTablesPlugin) retrieveConfig() (*Config, error) {
+	config := &Config{
+		// default configuration
+		GoRoutinesCnt: defaultGoRoutinesCnt,
+	}
+	found, err := p.Cfg.LoadValue(config)
+	if !found {
+		p.Log.Debug("Linux NFTablesPlugin config not found")
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return config, err
+}
@@ -0,0 +1,340 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/puntplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/puntplugin/linuxcalls"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_punt "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt"
+)
+
+const (
+	// ProxyDescriptorName is the name of the descriptor for Linux punt proxies.
+	ProxyDescriptorName = "linux-punt-proxy"
+
+	// dependency labels
+	microserviceDep = "microservice-available"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrProxyWithoutName is returned when punt proxy is defined without name.
+	ErrProxyWithoutName = errors.New("punt proxy defined without name")
+
+	// ErrProxyWithoutRx is returned when punt proxy has neither rx port nor rx socket defined.
+	ErrProxyWithoutRx = errors.New("punt proxy defined without rx port or socket")
+
+	// ErrProxyWithoutTx is returned when punt proxy has neither tx port nor tx socket defined.
+	ErrProxyWithoutTx = errors.New("punt proxy defined without tx port or socket")
+
+	// ErrPortWithoutL4Protocol is returned when port-based socket has undefined L4 protocol.
+	ErrPortWithoutL4Protocol = errors.New("port-based socket defined without L4 protocol")
+
+	// ErrPortWithoutNumber is returned when port-based socket has undefined port number.
+	ErrPortWithoutNumber = errors.New("port-based socket defined without port")
+
+	// ErrSocketWithoutPath is returned when unix domain socket has undefined path.
+	ErrSocketWithoutPath = errors.New("unix domain socket defined without path")
+
+	// ErrL4ProtocolMismatch is returned when TCP port is combined with UDP port.
+	ErrL4ProtocolMismatch = errors.New("TCP port cannot be relayed to UDP port and vice versa")
+)
+
+// ProxyDescriptor teaches KVScheduler how to run punt proxies relaying
+// packets between two sockets in a Linux network namespace.
+type ProxyDescriptor struct {
+	log          logging.Logger
+	nsPlugin     nsplugin.API
+	proxyHandler linuxcalls.PuntProxyAPI
+
+	mu     sync.Mutex
+	relays map[string]*proxyRelay // proxy name -> running relay
+}
+
+// proxyRelay is a relay started for a punt proxy.
+type proxyRelay struct {
+	proxy *linux_punt.Proxy
+	relay linuxcalls.Relay
+}
+
+// NewProxyDescriptor creates a new instance of the punt Proxy descriptor.
+func NewProxyDescriptor(proxyHandler linuxcalls.PuntProxyAPI, nsPlugin nsplugin.API,
+	log logging.PluginLogger) (descr *kvs.KVDescriptor, ctx *ProxyDescriptor) {
+
+	ctx = &ProxyDescriptor{
+		proxyHandler: proxyHandler,
+		nsPlugin:     nsPlugin,
+		relays:       make(map[string]*proxyRelay),
+		log:          log.NewLogger("punt-proxy-descriptor"),
+	}
+
+	typedDescr := &adapter.ProxyDescriptor{
+		Name:          ProxyDescriptorName,
+		NBKeyPrefix:   linux_punt.ModelProxy.KeyPrefix(),
+		ValueTypeName: linux_punt.ModelProxy.ProtoName(),
+		KeySelector:   linux_punt.ModelProxy.IsKeyValid,
+		KeyLabel:      linux_punt.ModelProxy.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewProxyDescriptor(typedDescr), ctx
+}
+
+// Validate validates punt proxy configuration.
+func (d *ProxyDescriptor) Validate(key string, proxy *linux_punt.Proxy) error {
+	if proxy.Name == "" {
+		return kvs.NewInvalidValueError(ErrProxyWithoutName, "name")
+	}
+	switch {
+	case proxy.GetRxPort() != nil:
+		if err := validatePort(proxy.GetRxPort(), "rx_port"); err != nil {
+			return err
+		}
+	case proxy.GetRxSocket() != nil:
+		if proxy.GetRxSocket().Path == "" {
+			return kvs.NewInvalidValueError(ErrSocketWithoutPath, "rx_socket.path")
+		}
+	default:
+		return kvs.NewInvalidValueError(ErrProxyWithoutRx, "rx")
+	}
+	switch {
+	case proxy.GetTxPort() != nil:
+		if err := validatePort(proxy.GetTxPort(), "tx_port"); err != nil {
+			return err
+		}
+	case proxy.GetTxSocket() != nil:
+		if proxy.GetTxSocket().Path == "" {
+			return kvs.NewInvalidValueError(ErrSocketWithoutPath, "tx_socket.path")
+		}
+	default:
+		return kvs.NewInvalidValueError(ErrProxyWithoutTx, "tx")
+	}
+	if rx, tx := proxy.GetRxPort(), proxy.GetTxPort(); rx != nil && tx != nil && rx.L4Protocol != tx.L4Protocol {
+		return kvs.NewInvalidValueError(ErrL4ProtocolMismatch, "rx_port.l4_protocol", "tx_port.l4_protocol")
+	}
+	return nil
+}
+
+// Create starts relay for the punt proxy. Relay of the proxy which has failed
+// before is closed first to release its sockets.
+func (d *ProxyDescriptor) Create(key string, proxy *linux_punt.Proxy) (metadata interface{}, err error) {
+	d.mu.Lock()
+	failed, hasFailed := d.relays[proxy.Name]
+	delete(d.relays, proxy.Name)
+	d.mu.Unlock()
+	if hasFailed {
+		if err := failed.relay.Close(); err != nil {
+			d.log.Warnf("error by closing failed punt proxy %s: %v", proxy.Name, err)
+		}
+	}
+
+	rx, tx := proxyEndpoints(proxy)
+	relay, err := d.proxyHandler.StartRelay(rx, tx, d.namespaceSwitch(proxy.Namespace))
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+
+	d.mu.Lock()
+	d.relays[proxy.Name] = &proxyRelay{proxy: proxy, relay: relay}
+	d.mu.Unlock()
+	return nil, nil
+}
+
+// Delete stops relay of the punt proxy.
+func (d *ProxyDescriptor) Delete(key string, proxy *linux_punt.Proxy, metadata interface{}) error {
+	d.mu.Lock()
+	pr, ok := d.relays[proxy.Name]
+	delete(d.relays, proxy.Name)
+	d.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	if err := pr.relay.Close(); err != nil {
+		d.log.Warnf("error by closing punt proxy %s: %v", proxy.Name, err)
+	}
+	return nil
+}
+
+// Retrieve returns punt proxies with running relays. Relays do not outlive
+// the agent, therefore nothing is retrieved after a restart. Proxies with
+// failed relay are not retrieved either, so that the relay is re-created
+// by the next resync.
+func (d *ProxyDescriptor) Retrieve(correlate []adapter.ProxyKVWithMetadata) (
+	retrieved []adapter.ProxyKVWithMetadata, err error) {
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, pr := range d.relays {
+		if pr.relay.Stats().Failed {
+			continue
+		}
+		retrieved = append(retrieved, adapter.ProxyKVWithMetadata{
+			Key:    linux_punt.ProxyKey(pr.proxy.Name),
+			Value:  pr.proxy,
+			Origin: kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists microservice (of the target namespace) as the only dependency.
+func (d *ProxyDescriptor) Dependencies(key string, proxy *linux_punt.Proxy) (deps []kvs.Dependency) {
+	if proxy.Namespace != nil && proxy.Namespace.Type == linux_namespace.NetNamespace_MICROSERVICE {
+		deps = append(deps, kvs.Dependency{
+			Label: microserviceDep,
+			Key:   linux_namespace.MicroserviceKey(proxy.Namespace.Reference),
+		})
+	}
+	return deps
+}
+
+// ProxyStates returns run-time state of all punt proxies with started relay.
+func (d *ProxyDescriptor) ProxyStates() []*linux_punt.ProxyState {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var states []*linux_punt.ProxyState
+	for name, pr := range d.relays {
+		stats := pr.relay.Stats()
+		state := &linux_punt.ProxyState{
+			Name:              name,
+			Status:            linux_punt.ProxyState_RUNNING,
+			RxPackets:         stats.RxPackets,
+			RxBytes:           stats.RxBytes,
+			TxPackets:         stats.TxPackets,
+			TxBytes:           stats.TxBytes,
+			Dropped:           stats.Dropped,
+			ActiveConnections: stats.ActiveConns,
+			TotalConnections:  stats.TotalConns,
+		}
+		if stats.Failed {
+			state.Status = linux_punt.ProxyState_FAILED
+		}
+		if stats.LastError != nil {
+			state.LastError = stats.LastError.Error()
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+// Close stops relays of all punt proxies.
+func (d *ProxyDescriptor) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for name, pr := range d.relays {
+		if err := pr.relay.Close(); err != nil {
+			d.log.Warnf("error by closing punt proxy %s: %v", name, err)
+		}
+		delete(d.relays, name)
+	}
+}
+
+// namespaceSwitch returns function which switches the current thread into the given namespace.
+func (d *ProxyDescriptor) namespaceSwitch(ns *linux_namespace.NetNamespace) linuxcalls.NamespaceSwitch {
+	return func() (revert func(), err error) {
+		nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+		revert, err = d.nsPlugin.SwitchToNamespace(nsCtx, ns)
+		if err != nil {
+			d.log.WithFields(logging.Fields{
+				"err":       err,
+				"namespace": ns,
+			}).Warn("Failed to switch the namespace")
+		}
+		return revert, err
+	}
+}
+
+// validatePort validates port-based socket.
+func validatePort(port *linux_punt.PortBased, field string) error {
+	if port.L4Protocol == linux_punt.PortBased_UNDEFINED_L4 {
+		return kvs.NewInvalidValueError(ErrPortWithoutL4Protocol, field+".l4_protocol")
+	}
+	if port.Port == 0 {
+		return kvs.NewInvalidValueError(ErrPortWithoutNumber, field+".port")
+	}
+	return nil
+}
+
+// proxyEndpoints translates rx and tx socket of the punt proxy into relay endpoints.
+// Unix domain sockets are stream-based only when combined with TCP port.
+func proxyEndpoints(proxy *linux_punt.Proxy) (rx, tx linuxcalls.Endpoint) {
+	stream := proxy.GetRxPort().GetL4Protocol() == linux_punt.PortBased_TCP ||
+		proxy.GetTxPort().GetL4Protocol() == linux_punt.PortBased_TCP
+
+	if port := proxy.GetRxPort(); port != nil {
+		rx = portEndpoint(port, true)
+	} else {
+		rx = socketEndpoint(proxy.GetRxSocket(), stream)
+	}
+	if port := proxy.GetTxPort(); port != nil {
+		tx = portEndpoint(port, false)
+	} else {
+		tx = socketEndpoint(proxy.GetTxSocket(), stream)
+	}
+	return rx, tx
+}
+
+// portEndpoint returns endpoint for port-based socket. Rx socket listens
+// on all addresses, tx socket connects to the loopback address.
+func portEndpoint(port *linux_punt.PortBased, listen bool) linuxcalls.Endpoint {
+	network := "udp"
+	if port.L4Protocol == linux_punt.PortBased_TCP {
+		network = "tcp"
+	}
+	host := "127.0.0.1"
+	switch port.L3Protocol {
+	case linux_punt.PortBased_IPV4:
+		network += "4"
+	case linux_punt.PortBased_IPV6:
+		network += "6"
+		host = "::1"
+	}
+	if listen {
+		host = ""
+	}
+	return linuxcalls.Endpoint{
+		Network: network,
+		Address: net.JoinHostPort(host, strconv.Itoa(int(port.Port))),
+	}
+}
+
+// socketEndpoint returns endpoint for unix domain socket.
+func socketEndpoint(socket *linux_punt.SocketBased, stream bool) linuxcalls.Endpoint {
+	network := "unixgram"
+	if stream {
+		network = "unix"
+	}
+	return linuxcalls.Endpoint{
+		Network: network,
+		Address: socket.Path,
+	}
+}
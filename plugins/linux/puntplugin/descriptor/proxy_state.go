@@ -0,0 +1,67 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/puntplugin/descriptor/adapter"
+	linux_punt "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt"
+)
+
+const (
+	// ProxyStateDescriptorName is the name of the read-only descriptor for punt proxy state.
+	ProxyStateDescriptorName = "linux-punt-proxy-state"
+)
+
+// ProxyStateDescriptor exposes counters and status of running punt proxies
+// through the KVScheduler SB view. The descriptor only implements Retrieve.
+type ProxyStateDescriptor struct {
+	log       logging.Logger
+	proxyDesc *ProxyDescriptor
+}
+
+// NewProxyStateDescriptor creates a new instance of the read-only punt ProxyState descriptor.
+func NewProxyStateDescriptor(proxyDesc *ProxyDescriptor, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &ProxyStateDescriptor{
+		proxyDesc: proxyDesc,
+		log:       log.NewLogger("punt-proxy-state-descriptor"),
+	}
+	typedDescr := &adapter.ProxyStateDescriptor{
+		Name:                 ProxyStateDescriptorName,
+		NBKeyPrefix:          linux_punt.ModelProxyState.KeyPrefix(),
+		ValueTypeName:        linux_punt.ModelProxyState.ProtoName(),
+		KeySelector:          linux_punt.ModelProxyState.IsKeyValid,
+		KeyLabel:             linux_punt.ModelProxyState.StripKeyPrefix,
+		Retrieve:             ctx.Retrieve,
+		RetrieveDependencies: []string{ProxyDescriptorName},
+	}
+	return adapter.NewProxyStateDescriptor(typedDescr)
+}
+
+// Retrieve returns the state of all running punt proxies.
+func (d *ProxyStateDescriptor) Retrieve(correlate []adapter.ProxyStateKVWithMetadata) (
+	retrieved []adapter.ProxyStateKVWithMetadata, err error) {
+
+	for _, state := range d.proxyDesc.ProxyStates() {
+		retrieved = append(retrieved, adapter.ProxyStateKVWithMetadata{
+			Key:    linux_punt.ProxyStateKey(state.Name),
+			Value:  state,
+			Origin: kvs.FromSB,
+		})
+	}
+	return retrieved, nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/puntplugin/linuxcalls"
+	linux_punt "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt"
+)
+
+// relayMock is a relay with counters set by the test.
+type relayMock struct {
+	stats  linuxcalls.RelayStats
+	closed bool
+}
+
+func (r *relayMock) Stats() linuxcalls.RelayStats {
+	return r.stats
+}
+
+func (r *relayMock) Close() error {
+	r.closed = true
+	return nil
+}
+
+// proxyHandlerMock records relays started by the descriptor.
+type proxyHandlerMock struct {
+	relays []*relayMock
+}
+
+func (h *proxyHandlerMock) StartRelay(rx, tx linuxcalls.Endpoint, switchNs linuxcalls.NamespaceSwitch) (linuxcalls.Relay, error) {
+	relay := &relayMock{}
+	h.relays = append(h.relays, relay)
+	return relay, nil
+}
+
+func TestProxyRelayFailure(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := &proxyHandlerMock{}
+	descriptor := &ProxyDescriptor{
+		log:          logrus.NewLogger("test"),
+		proxyHandler: handler,
+		relays:       make(map[string]*proxyRelay),
+	}
+	proxy := &linux_punt.Proxy{
+		Name: "proxy1",
+		Rx:   &linux_punt.Proxy_RxSocket{RxSocket: &linux_punt.SocketBased{Path: "/tmp/rx.sock"}},
+		Tx:   &linux_punt.Proxy_TxSocket{TxSocket: &linux_punt.SocketBased{Path: "/tmp/tx.sock"}},
+	}
+
+	_, err := descriptor.Create(linux_punt.ProxyKey(proxy.Name), proxy)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(handler.relays).To(HaveLen(1))
+	retrieved, err := descriptor.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Key).To(Equal(linux_punt.ProxyKey(proxy.Name)))
+
+	// failed relay is not retrieved, but its state is still available
+	handler.relays[0].stats = linuxcalls.RelayStats{Failed: true, LastError: errors.New("socket closed")}
+	retrieved, err = descriptor.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(BeEmpty())
+	states := descriptor.ProxyStates()
+	Expect(states).To(HaveLen(1))
+	Expect(states[0].Status).To(Equal(linux_punt.ProxyState_FAILED))
+	Expect(states[0].LastError).To(Equal("socket closed"))
+
+	// re-created proxy replaces the failed relay
+	_, err = descriptor.Create(linux_punt.ProxyKey(proxy.Name), proxy)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(handler.relays).To(HaveLen(2))
+	Expect(handler.relays[0].closed).To(BeTrue())
+	retrieved, err = descriptor.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(descriptor.ProxyStates()[0].Status).To(Equal(linux_punt.ProxyState_RUNNING))
+
+	Expect(descriptor.Delete(linux_punt.ProxyKey(proxy.Name), proxy, nil)).To(Succeed())
+	Expect(handler.relays[1].closed).To(BeTrue())
+	retrieved, err = descriptor.Retrieve(nil)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(BeEmpty())
+}
+
+func TestProxyEndpoints(t *testing.T) {
+	tests := []struct {
+		name  string
+		proxy *linux_punt.Proxy
+		rx    linuxcalls.Endpoint
+		tx    linuxcalls.Endpoint
+	}{
+		{
+			name: "UDP port to socket",
+			proxy: &linux_punt.Proxy{
+				Rx: &linux_punt.Proxy_RxPort{RxPort: &linux_punt.PortBased{
+					L4Protocol: linux_punt.PortBased_UDP, Port: 4789}},
+				Tx: &linux_punt.Proxy_TxSocket{TxSocket: &linux_punt.SocketBased{Path: "/run/tx.sock"}},
+			},
+			rx: linuxcalls.Endpoint{Network: "udp", Address: ":4789"},
+			tx: linuxcalls.Endpoint{Network: "unixgram", Address: "/run/tx.sock"},
+		},
+		{
+			name: "socket to TCP port",
+			proxy: &linux_punt.Proxy{
+				Rx: &linux_punt.Proxy_RxSocket{RxSocket: &linux_punt.SocketBased{Path: "/run/rx.sock"}},
+				Tx: &linux_punt.Proxy_TxPort{TxPort: &linux_punt.PortBased{
+					L4Protocol: linux_punt.PortBased_TCP, Port: 179}},
+			},
+			rx: linuxcalls.Endpoint{Network: "unix", Address: "/run/rx.sock"},
+			tx: linuxcalls.Endpoint{Network: "tcp", Address: "127.0.0.1:179"},
+		},
+		{
+			name: "IPv6 port to IPv4 port",
+			proxy: &linux_punt.Proxy{
+				Rx: &linux_punt.Proxy_RxPort{RxPort: &linux_punt.PortBased{
+					L3Protocol: linux_punt.PortBased_IPV6, L4Protocol: linux_punt.PortBased_UDP, Port: 547}},
+				Tx: &linux_punt.Proxy_TxPort{TxPort: &linux_punt.PortBased{
+					L3Protocol: linux_punt.PortBased_IPV4, L4Protocol: linux_punt.PortBased_UDP, Port: 10547}},
+			},
+			rx: linuxcalls.Endpoint{Network: "udp6", Address: ":547"},
+			tx: linuxcalls.Endpoint{Network: "udp4", Address: "127.0.0.1:10547"},
+		},
+		{
+			name: "IPv6 loopback",
+			proxy: &linux_punt.Proxy{
+				Rx: &linux_punt.Proxy_RxSocket{RxSocket: &linux_punt.SocketBased{Path: "/run/rx.sock"}},
+				Tx: &linux_punt.Proxy_TxPort{TxPort: &linux_punt.PortBased{
+					L3Protocol: linux_punt.PortBased_IPV6, L4Protocol: linux_punt.PortBased_UDP, Port: 547}},
+			},
+			rx: linuxcalls.Endpoint{Network: "unixgram", Address: "/run/rx.sock"},
+			tx: linuxcalls.Endpoint{Network: "udp6", Address: "[::1]:547"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			rx, tx := proxyEndpoints(test.proxy)
+			Expect(rx).To(Equal(test.rx))
+			Expect(tx).To(Equal(test.tx))
+		})
+	}
+}
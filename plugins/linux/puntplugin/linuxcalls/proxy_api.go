@@ -0,0 +1,80 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"go.ligato.io/cn-infra/v2/logging"
+)
+
+// PuntProxyAPI interface covers all methods inside linux calls package needed
+// to manage punt proxy relays.
+type PuntProxyAPI interface {
+	// StartRelay opens the rx socket and starts forwarding everything received
+	// on it to the tx socket. Both sockets are opened inside the network namespace
+	// entered by <switchNs>.
+	StartRelay(rx, tx Endpoint, switchNs NamespaceSwitch) (Relay, error)
+}
+
+// Relay represents a running relay between two sockets.
+type Relay interface {
+	// Stats returns a snapshot of the relay counters.
+	Stats() RelayStats
+
+	// Close stops the relay and releases all its sockets.
+	Close() error
+}
+
+// Endpoint is a socket address in the format accepted by the net package.
+type Endpoint struct {
+	// Network is one of: udp, udp4, udp6, tcp, tcp4, tcp6, unixgram, unix.
+	Network string
+	// Address is host:port for network sockets or path for unix domain sockets.
+	Address string
+}
+
+// NamespaceSwitch switches the current thread into the network namespace of the relay.
+// The returned function reverts the thread back to the original namespace.
+type NamespaceSwitch func() (revert func(), err error)
+
+// RelayStats is a snapshot of relay counters.
+type RelayStats struct {
+	// Failed is true when the relay stopped due to an error.
+	Failed bool
+	// LastError is the last error encountered by the relay.
+	LastError error
+
+	RxPackets   uint64
+	RxBytes     uint64
+	TxPackets   uint64
+	TxBytes     uint64
+	Dropped     uint64
+	ActiveConns uint32
+	TotalConns  uint64
+}
+
+// PuntProxyHandler is a handler for punt proxy relays.
+type PuntProxyHandler struct {
+	log        logging.Logger
+	bufferSize int
+}
+
+// NewPuntProxyHandler creates new instance of the punt proxy handler.
+// Datagrams larger than <bufferSize> are truncated.
+func NewPuntProxyHandler(bufferSize int, log logging.Logger) *PuntProxyHandler {
+	return &PuntProxyHandler{
+		log:        log,
+		bufferSize: bufferSize,
+	}
+}
@@ -0,0 +1,358 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+)
+
+// relay forwards everything received on the rx socket to the tx socket.
+// Datagrams are forwarded one by one through a single tx socket, stream
+// connections are relayed in both directions, each with its own tx connection.
+type relay struct {
+	log        logging.Logger
+	rx, tx     Endpoint
+	switchNs   NamespaceSwitch
+	bufferSize int
+
+	// rx socket (either one is set)
+	packetConn net.PacketConn
+	listener   net.Listener
+
+	// counters (updated atomically)
+	rxPackets   uint64
+	rxBytes     uint64
+	txPackets   uint64
+	txBytes     uint64
+	dropped     uint64
+	totalConns  uint64
+	activeConns int32
+
+	mu      sync.Mutex
+	closed  bool
+	failed  bool
+	lastErr error
+	conns   map[net.Conn]struct{}
+	wg      sync.WaitGroup
+}
+
+// StartRelay opens the rx socket and starts forwarding everything received
+// on it to the tx socket.
+func (h *PuntProxyHandler) StartRelay(rx, tx Endpoint, switchNs NamespaceSwitch) (Relay, error) {
+	if isStream(rx.Network) != isStream(tx.Network) {
+		return nil, errors.Errorf("cannot relay between %s and %s sockets", rx.Network, tx.Network)
+	}
+	r := &relay{
+		log:        h.log,
+		rx:         rx,
+		tx:         tx,
+		switchNs:   switchNs,
+		bufferSize: h.bufferSize,
+		conns:      make(map[net.Conn]struct{}),
+	}
+	if err := r.listen(); err != nil {
+		return nil, err
+	}
+	r.wg.Add(1)
+	if r.listener != nil {
+		go r.acceptConnections()
+	} else {
+		go r.relayPackets()
+	}
+	return r, nil
+}
+
+// Stats returns a snapshot of the relay counters.
+func (r *relay) Stats() RelayStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return RelayStats{
+		Failed:      r.failed,
+		LastError:   r.lastErr,
+		RxPackets:   atomic.LoadUint64(&r.rxPackets),
+		RxBytes:     atomic.LoadUint64(&r.rxBytes),
+		TxPackets:   atomic.LoadUint64(&r.txPackets),
+		TxBytes:     atomic.LoadUint64(&r.txBytes),
+		Dropped:     atomic.LoadUint64(&r.dropped),
+		ActiveConns: uint32(atomic.LoadInt32(&r.activeConns)),
+		TotalConns:  atomic.LoadUint64(&r.totalConns),
+	}
+}
+
+// Close stops the relay and releases all its sockets.
+func (r *relay) Close() error {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	var err error
+	if r.listener != nil {
+		err = r.listener.Close()
+	} else {
+		err = r.packetConn.Close()
+	}
+	for conn := range r.conns {
+		conn.Close()
+	}
+	r.mu.Unlock()
+
+	r.wg.Wait()
+	if isUnix(r.rx.Network) {
+		if rmErr := os.Remove(r.rx.Address); rmErr != nil && !os.IsNotExist(rmErr) {
+			r.log.Warnf("failed to remove punt proxy socket %s: %v", r.rx.Address, rmErr)
+		}
+	}
+	return err
+}
+
+// listen opens the rx socket inside the relay namespace.
+func (r *relay) listen() (err error) {
+	revert, err := r.switchNs()
+	if err != nil {
+		return errors.Wrap(err, "failed to switch namespace")
+	}
+	defer revert()
+
+	if isUnix(r.rx.Network) {
+		if err := removeStaleSocket(r.rx.Address); err != nil {
+			return err
+		}
+	}
+	if isStream(r.rx.Network) {
+		r.listener, err = net.Listen(r.rx.Network, r.rx.Address)
+	} else {
+		r.packetConn, err = net.ListenPacket(r.rx.Network, r.rx.Address)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to listen on %s %s", r.rx.Network, r.rx.Address)
+	}
+	return nil
+}
+
+// dial connects the tx socket inside the relay namespace.
+func (r *relay) dial() (net.Conn, error) {
+	revert, err := r.switchNs()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to switch namespace")
+	}
+	defer revert()
+
+	conn, err := net.Dial(r.tx.Network, r.tx.Address)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to %s %s", r.tx.Network, r.tx.Address)
+	}
+	return conn, nil
+}
+
+// relayPackets forwards datagrams from the rx socket to the tx socket.
+// The tx socket is (re-)connected lazily, so that the relay survives restarts
+// of the receiving side.
+func (r *relay) relayPackets() {
+	defer r.wg.Done()
+
+	var txConn net.Conn
+	defer func() {
+		if txConn != nil {
+			txConn.Close()
+		}
+	}()
+
+	buf := make([]byte, r.bufferSize)
+	for {
+		n, _, err := r.packetConn.ReadFrom(buf)
+		if err != nil {
+			r.fail(err)
+			return
+		}
+		atomic.AddUint64(&r.rxPackets, 1)
+		atomic.AddUint64(&r.rxBytes, uint64(n))
+
+		if txConn == nil {
+			if txConn, err = r.dial(); err != nil {
+				r.drop(err)
+				continue
+			}
+		}
+		if _, err = txConn.Write(buf[:n]); err != nil {
+			txConn.Close()
+			txConn = nil
+			r.drop(err)
+			continue
+		}
+		atomic.AddUint64(&r.txPackets, 1)
+		atomic.AddUint64(&r.txBytes, uint64(n))
+	}
+}
+
+// acceptConnections accepts stream connections on the rx socket
+// and starts relaying each of them.
+func (r *relay) acceptConnections() {
+	defer r.wg.Done()
+
+	for {
+		conn, err := r.listener.Accept()
+		if err != nil {
+			r.fail(err)
+			return
+		}
+		atomic.AddUint64(&r.totalConns, 1)
+		r.wg.Add(1)
+		go r.relayStream(conn)
+	}
+}
+
+// relayStream relays single stream connection. Data received from the rx side
+// are counted, replies from the tx side are passed back without counting.
+func (r *relay) relayStream(rxConn net.Conn) {
+	defer r.wg.Done()
+
+	txConn, err := r.dial()
+	if err != nil {
+		rxConn.Close()
+		r.drop(err)
+		return
+	}
+	if !r.track(rxConn, txConn) {
+		return // relay is being closed
+	}
+	atomic.AddInt32(&r.activeConns, 1)
+	defer func() {
+		r.untrack(rxConn, txConn)
+		atomic.AddInt32(&r.activeConns, -1)
+	}()
+
+	// when either direction is finished, both connections are closed
+	// which terminates the other direction as well
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		buf := make([]byte, r.bufferSize)
+		for {
+			n, err := txConn.Read(buf)
+			if n > 0 {
+				if _, err := rxConn.Write(buf[:n]); err != nil {
+					break
+				}
+			}
+			if err != nil {
+				break
+			}
+		}
+		rxConn.Close()
+		txConn.Close()
+	}()
+
+	buf := make([]byte, r.bufferSize)
+	for {
+		n, err := rxConn.Read(buf)
+		if n > 0 {
+			atomic.AddUint64(&r.rxPackets, 1)
+			atomic.AddUint64(&r.rxBytes, uint64(n))
+			if _, err := txConn.Write(buf[:n]); err != nil {
+				r.drop(err)
+				break
+			}
+			atomic.AddUint64(&r.txPackets, 1)
+			atomic.AddUint64(&r.txBytes, uint64(n))
+		}
+		if err != nil {
+			break
+		}
+	}
+	rxConn.Close()
+	txConn.Close()
+	<-done
+}
+
+// track registers connections of a relayed stream so that they can be closed
+// together with the relay. Returns false (and closes the connections)
+// if the relay is already closed.
+func (r *relay) track(conns ...net.Conn) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		for _, conn := range conns {
+			conn.Close()
+		}
+		return false
+	}
+	for _, conn := range conns {
+		r.conns[conn] = struct{}{}
+	}
+	return true
+}
+
+// untrack removes connections of a finished stream.
+func (r *relay) untrack(conns ...net.Conn) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, conn := range conns {
+		delete(r.conns, conn)
+	}
+}
+
+// drop counts a packet that could not be forwarded.
+func (r *relay) drop(err error) {
+	atomic.AddUint64(&r.dropped, 1)
+	r.mu.Lock()
+	r.lastErr = err
+	r.mu.Unlock()
+	r.log.Debugf("punt proxy %s -> %s: %v", r.rx.Address, r.tx.Address, err)
+}
+
+// fail marks the relay as failed, unless it was closed intentionally.
+func (r *relay) fail(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return
+	}
+	r.failed = true
+	r.lastErr = err
+	r.log.Errorf("punt proxy %s -> %s failed: %v", r.rx.Address, r.tx.Address, err)
+}
+
+// removeStaleSocket removes unix domain socket file left behind by a previous
+// run. Returns error if the path exists and is not a socket.
+func removeStaleSocket(path string) error {
+	fi, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return errors.Errorf("%s exists and is not a socket", path)
+	}
+	return os.Remove(path)
+}
+
+func isStream(network string) bool {
+	return strings.HasPrefix(network, "tcp") || network == "unix"
+}
+
+func isUnix(network string) bool {
+	return strings.HasPrefix(network, "unix")
+}
@@ -0,0 +1,277 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !windows,!darwin
+
+package linuxcalls
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// sameNamespace is a namespace switch which stays in the current namespace.
+func sameNamespace() (revert func(), err error) {
+	return func() {}, nil
+}
+
+// socketPair returns both ends of a connected unix socket pair of the given type.
+// The rx end is returned as file to be turned into the rx socket of the relay.
+func socketPair(sockType int) (rx *os.File, peer net.Conn) {
+	fds, err := unix.Socketpair(unix.AF_UNIX, sockType, 0)
+	Expect(err).ToNot(HaveOccurred())
+	peerFile := os.NewFile(uintptr(fds[1]), "peer")
+	defer peerFile.Close()
+	peer, err = net.FileConn(peerFile)
+	Expect(err).ToNot(HaveOccurred())
+	return os.NewFile(uintptr(fds[0]), "rx"), peer
+}
+
+// testRelay returns relay between rx socket opened by the test and tx socket
+// at the given path.
+func testRelay(rxNetwork, txNetwork, txPath string) *relay {
+	return &relay{
+		log:        logrus.NewLogger("test"),
+		rx:         Endpoint{Network: rxNetwork, Address: "socketpair"},
+		tx:         Endpoint{Network: txNetwork, Address: txPath},
+		switchNs:   sameNamespace,
+		bufferSize: 64,
+		conns:      make(map[net.Conn]struct{}),
+	}
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "punt-proxy")
+	Expect(err).ToNot(HaveOccurred())
+	return dir
+}
+
+func TestRelayPackets(t *testing.T) {
+	RegisterTestingT(t)
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	// receiving side of the relay
+	txPath := filepath.Join(dir, "tx.sock")
+	txConn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: txPath, Net: "unixgram"})
+	Expect(err).ToNot(HaveOccurred())
+
+	rxFile, peer := socketPair(unix.SOCK_DGRAM)
+	defer peer.Close()
+	packetConn, err := net.FilePacketConn(rxFile)
+	Expect(err).ToNot(HaveOccurred())
+	rxFile.Close()
+
+	r := testRelay("unixgram", "unixgram", txPath)
+	r.packetConn = packetConn
+	r.wg.Add(1)
+	go r.relayPackets()
+	defer r.Close()
+
+	// packet is forwarded
+	_, err = peer.Write([]byte("punted"))
+	Expect(err).ToNot(HaveOccurred())
+	buf := make([]byte, 64)
+	Expect(txConn.SetReadDeadline(time.Now().Add(time.Second))).To(Succeed())
+	n, err := txConn.Read(buf)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(buf[:n])).To(Equal("punted"))
+	Eventually(r.Stats).Should(Equal(RelayStats{
+		RxPackets: 1, RxBytes: 6, TxPackets: 1, TxBytes: 6,
+	}))
+
+	// packet is dropped while the receiving side is down
+	txConn.Close()
+	os.Remove(txPath)
+	_, err = peer.Write([]byte("lost"))
+	Expect(err).ToNot(HaveOccurred())
+	Eventually(func() uint64 { return r.Stats().Dropped }).Should(BeEquivalentTo(1))
+	Expect(r.Stats().Failed).To(BeFalse())
+
+	// the relay re-connects once the receiving side is back
+	txConn, err = net.ListenUnixgram("unixgram", &net.UnixAddr{Name: txPath, Net: "unixgram"})
+	Expect(err).ToNot(HaveOccurred())
+	defer txConn.Close()
+	_, err = peer.Write([]byte("again"))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(txConn.SetReadDeadline(time.Now().Add(time.Second))).To(Succeed())
+	n, err = txConn.Read(buf)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(buf[:n])).To(Equal("again"))
+	Eventually(func() uint64 { return r.Stats().TxPackets }).Should(BeEquivalentTo(2))
+}
+
+func TestRelayPacketsFailure(t *testing.T) {
+	RegisterTestingT(t)
+
+	rxFile, peer := socketPair(unix.SOCK_DGRAM)
+	defer peer.Close()
+	packetConn, err := net.FilePacketConn(rxFile)
+	Expect(err).ToNot(HaveOccurred())
+	rxFile.Close()
+
+	r := testRelay("unixgram", "unixgram", "/nonexistent/tx.sock")
+	r.packetConn = packetConn
+	r.wg.Add(1)
+	go r.relayPackets()
+
+	// rx socket closed behind the relay's back stops the relay
+	packetConn.Close()
+	Eventually(func() bool { return r.Stats().Failed }).Should(BeTrue())
+	Expect(r.Stats().LastError).To(HaveOccurred())
+	r.Close()
+	Expect(r.Stats().Failed).To(BeTrue())
+}
+
+func TestRelayClose(t *testing.T) {
+	RegisterTestingT(t)
+
+	rxFile, peer := socketPair(unix.SOCK_DGRAM)
+	defer peer.Close()
+	packetConn, err := net.FilePacketConn(rxFile)
+	Expect(err).ToNot(HaveOccurred())
+	rxFile.Close()
+
+	r := testRelay("unixgram", "unixgram", "/nonexistent/tx.sock")
+	r.packetConn = packetConn
+	r.wg.Add(1)
+	go r.relayPackets()
+
+	// closed relay is not reported as failed
+	Expect(r.Close()).To(Succeed())
+	Expect(r.Stats().Failed).To(BeFalse())
+	Expect(r.Close()).To(Succeed())
+}
+
+func TestRelayStream(t *testing.T) {
+	RegisterTestingT(t)
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	// receiving side of the relay replies with upper-cased data
+	txPath := filepath.Join(dir, "tx.sock")
+	listener, err := net.Listen("unix", txPath)
+	Expect(err).ToNot(HaveOccurred())
+	defer listener.Close()
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 64)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return
+			}
+			if _, err := conn.Write([]byte(strings.ToUpper(string(buf[:n])))); err != nil {
+				return
+			}
+		}
+	}()
+
+	rxFile, peer := socketPair(unix.SOCK_STREAM)
+	rxConn, err := net.FileConn(rxFile)
+	Expect(err).ToNot(HaveOccurred())
+	rxFile.Close()
+
+	r := testRelay("unix", "unix", txPath)
+	r.wg.Add(1)
+	go r.relayStream(rxConn)
+
+	// data are relayed in both directions
+	_, err = peer.Write([]byte("ping"))
+	Expect(err).ToNot(HaveOccurred())
+	buf := make([]byte, 64)
+	Expect(peer.SetReadDeadline(time.Now().Add(time.Second))).To(Succeed())
+	n, err := peer.Read(buf)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(string(buf[:n])).To(Equal("PING"))
+
+	stats := r.Stats()
+	Expect(stats.RxPackets).To(BeEquivalentTo(1))
+	Expect(stats.RxBytes).To(BeEquivalentTo(4))
+	Expect(stats.TxBytes).To(BeEquivalentTo(4))
+	Expect(stats.ActiveConns).To(BeEquivalentTo(1))
+
+	// closing the connection finishes the relayed stream
+	peer.Close()
+	r.wg.Wait()
+	Expect(r.Stats().ActiveConns).To(BeZero())
+	Expect(r.conns).To(BeEmpty())
+}
+
+func TestRelayStreamWithoutReceiver(t *testing.T) {
+	RegisterTestingT(t)
+
+	rxFile, peer := socketPair(unix.SOCK_STREAM)
+	defer peer.Close()
+	rxConn, err := net.FileConn(rxFile)
+	Expect(err).ToNot(HaveOccurred())
+	rxFile.Close()
+
+	r := testRelay("unix", "unix", "/nonexistent/tx.sock")
+	r.wg.Add(1)
+	go r.relayStream(rxConn)
+
+	// connection is closed and dropped when the receiving side is down
+	Expect(peer.SetReadDeadline(time.Now().Add(time.Second))).To(Succeed())
+	_, err = peer.Read(make([]byte, 1))
+	Expect(err).To(HaveOccurred())
+	r.wg.Wait()
+	Expect(r.Stats().Dropped).To(BeEquivalentTo(1))
+	Expect(r.Stats().Failed).To(BeFalse())
+}
+
+func TestStartRelay(t *testing.T) {
+	RegisterTestingT(t)
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	h := NewPuntProxyHandler(64, logrus.NewLogger("test"))
+
+	// datagrams cannot be relayed into stream
+	_, err := h.StartRelay(Endpoint{Network: "udp", Address: "127.0.0.1:0"},
+		Endpoint{Network: "tcp", Address: "127.0.0.1:1"}, sameNamespace)
+	Expect(err).To(HaveOccurred())
+
+	// rx path occupied by regular file
+	filePath := filepath.Join(dir, "file")
+	Expect(ioutil.WriteFile(filePath, nil, 0644)).To(Succeed())
+	_, err = h.StartRelay(Endpoint{Network: "unixgram", Address: filePath},
+		Endpoint{Network: "unixgram", Address: filepath.Join(dir, "tx.sock")}, sameNamespace)
+	Expect(err).To(HaveOccurred())
+
+	// stale socket is replaced and removed when the relay is closed
+	rxPath := filepath.Join(dir, "rx.sock")
+	stale, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: rxPath, Net: "unixgram"})
+	Expect(err).ToNot(HaveOccurred())
+	stale.Close()
+	Expect(rxPath).To(BeAnExistingFile())
+	relay, err := h.StartRelay(Endpoint{Network: "unixgram", Address: rxPath},
+		Endpoint{Network: "unixgram", Address: filepath.Join(dir, "tx.sock")}, sameNamespace)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(relay.Close()).To(Succeed())
+	Expect(rxPath).ToNot(BeAnExistingFile())
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Proxy --value-type *linux_punt.Proxy --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name ProxyState --value-type *linux_punt.ProxyState --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/punt" --output-dir "descriptor"
+
+package puntplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/infra"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/puntplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/puntplugin/linuxcalls"
+)
+
+const (
+	// by default, datagrams up to the maximum size of an IP packet are relayed
+	defaultBufferSize = 65535
+)
+
+// PuntPlugin runs punt proxies - relays forwarding packets between network
+// and/or unix domain sockets in Linux network namespaces. Together with VPP
+// punt to host it can be used to deliver punted traffic to applications
+// which cannot read from the VPP punt socket directly.
+type PuntPlugin struct {
+	Deps
+
+	// From configuration file
+	disabled bool
+
+	// system handlers
+	proxyHandler linuxcalls.PuntProxyAPI
+
+	// descriptors
+	proxyDescriptor *descriptor.ProxyDescriptor
+}
+
+// Deps lists dependencies of the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	NsPlugin    nsplugin.API
+}
+
+// Config holds the plugin configuration.
+type Config struct {
+	Disabled   bool `json:"disabled"`
+	BufferSize int  `json:"buffer-size"`
+}
+
+// Init initializes and registers descriptors and handlers for Linux punt proxies.
+func (p *PuntPlugin) Init() error {
+	// parse configuration file
+	config, err := p.retrieveConfig()
+	if err != nil {
+		return err
+	}
+	p.Log.Debugf("Linux punt config: %+v", config)
+	if config.Disabled {
+		p.disabled = true
+		p.Log.Infof("Disabling Linux punt plugin")
+		return nil
+	}
+
+	// init punt proxy handler
+	p.proxyHandler = linuxcalls.NewPuntProxyHandler(config.BufferSize, p.Log)
+
+	// init & register descriptors
+	proxyDescriptor, proxyDescrCtx := descriptor.NewProxyDescriptor(p.proxyHandler, p.NsPlugin, p.Log)
+	p.proxyDescriptor = proxyDescrCtx
+	proxyStateDescriptor := descriptor.NewProxyStateDescriptor(proxyDescrCtx, p.Log)
+
+	err = p.KVScheduler.RegisterKVDescriptor(
+		proxyDescriptor,
+		proxyStateDescriptor, // read-only
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close stops all running punt proxies.
+func (p *PuntPlugin) Close() error {
+	if p.proxyDescriptor != nil {
+		p.proxyDescriptor.Close()
+	}
+	return nil
+}
+
+// retrieveConfig loads plugin configuration file.
+func (p *PuntPlugin) retrieveConfig() (*Config, error) {
+	config := &Config{
+		// default configuration
+		BufferSize: defaultBufferSize,
+	}
+	found, err := p.Cfg.LoadValue(config)
+	if !found {
+		p.Log.Debug("Linux PuntPlugin config not found")
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if config.BufferSize <= 0 {
+		config.BufferSize = defaultBufferSize
+	}
+	return config, err
+}
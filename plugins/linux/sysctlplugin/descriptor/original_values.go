@@ -0,0 +1,107 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// originalValues keeps values of kernel parameters from before they were
+// configured by the agent. The values are saved into a file (if set) to be
+// restored also by an agent started after the parameters were configured.
+type originalValues struct {
+	mu     sync.Mutex
+	file   string
+	values map[string]string // sysctl key -> original value
+}
+
+// newOriginalValues loads original values saved in the given file.
+func newOriginalValues(file string) (*originalValues, error) {
+	v := &originalValues{
+		file:   file,
+		values: make(map[string]string),
+	}
+	if file == "" {
+		return v, nil
+	}
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return v, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &v.values); err != nil {
+		return nil, errors.Errorf("decoding file with original sysctl values %s failed: %v", filepath.Base(file), err)
+	}
+	return v, nil
+}
+
+// get returns original value of the kernel parameter with the given key.
+func (v *originalValues) get(key string) (value string, found bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	value, found = v.values[key]
+	return value, found
+}
+
+// put remembers original value of the kernel parameter with the given key.
+func (v *originalValues) put(key, value string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if oldValue, found := v.values[key]; found && oldValue == value {
+		return nil
+	}
+	v.values[key] = value
+	return v.saveLocked()
+}
+
+// delete forgets original value of the kernel parameter with the given key.
+func (v *originalValues) delete(key string) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if _, found := v.values[key]; !found {
+		return nil
+	}
+	delete(v.values, key)
+	return v.saveLocked()
+}
+
+func (v *originalValues) saveLocked() error {
+	if v.file == "" {
+		return nil
+	}
+	b, err := json.Marshal(v.values)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(v.file), 0755); err != nil {
+		return err
+	}
+	// write into temporary file first to not corrupt the values on failure
+	tmp := v.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, v.file)
+}
@@ -0,0 +1,338 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+)
+
+const (
+	// SysctlDescriptorName is the name of the descriptor for kernel network settings.
+	SysctlDescriptorName = "linux-sysctl-descriptor"
+
+	// dependency labels
+	sysctlInterfaceDep = "interface-exists"
+	microserviceDep    = "microservice-available"
+
+	// prefix of kernel network parameters
+	netSysctlPrefix = "net."
+
+	// number of components of per-interface parameter names (without the interface)
+	ifSysctlComponents = 4
+)
+
+// prefixes of kernel parameters which can be applied per interface
+var ifSysctlPrefixes = []string{
+	"net.ipv4.conf.",
+	"net.ipv6.conf.",
+	"net.ipv4.neigh.",
+	"net.ipv6.neigh.",
+}
+
+// A list of non-retriable errors:
+var (
+	// ErrSysctlWithoutName is returned when the kernel parameter is defined without name.
+	ErrSysctlWithoutName = errors.New("kernel parameter defined without name")
+
+	// ErrSysctlWithoutValue is returned when the kernel parameter is defined without value.
+	ErrSysctlWithoutValue = errors.New("kernel parameter defined without value")
+
+	// ErrNonNetworkSysctl is returned when the kernel parameter is not a network setting.
+	ErrNonNetworkSysctl = errors.New("only network kernel parameters (under \"net.\") are supported")
+
+	// ErrInvalidSysctlName is returned when the name of the kernel parameter is malformed.
+	ErrInvalidSysctlName = errors.New("invalid name of kernel parameter")
+
+	// ErrNonInterfaceSysctl is returned when the kernel parameter cannot be applied per interface.
+	ErrNonInterfaceSysctl = errors.New("kernel parameter cannot be applied per interface")
+
+	// ErrInterfaceSysctlWithNamespace is returned when the namespace is defined for per-interface parameter.
+	ErrInterfaceSysctlWithNamespace = errors.New("per-interface kernel parameter is applied in the namespace of the interface")
+)
+
+// SysctlMetadata stores the value of the kernel parameter from before it was configured
+// by the agent (the value is also saved to be known after the agent is restarted).
+type SysctlMetadata struct {
+	OriginalValue string
+}
+
+// SysctlDescriptor teaches KVScheduler how to configure kernel network settings.
+type SysctlDescriptor struct {
+	log           logging.Logger
+	ifPlugin      ifplugin.API
+	nsPlugin      nsplugin.API
+	sysctlHandler linuxcalls.SysctlAPI
+
+	originalValues *originalValues
+}
+
+// NewSysctlDescriptor creates a new instance of the Sysctl descriptor.
+// Original values of the configured kernel parameters are saved into
+// originalValuesFile (not saved if empty).
+func NewSysctlDescriptor(sysctlHandler linuxcalls.SysctlAPI, ifPlugin ifplugin.API,
+	nsPlugin nsplugin.API, originalValuesFile string, log logging.PluginLogger) (*kvs.KVDescriptor, error) {
+
+	origValues, err := newOriginalValues(originalValuesFile)
+	if err != nil {
+		return nil, err
+	}
+	descrCtx := &SysctlDescriptor{
+		sysctlHandler:  sysctlHandler,
+		ifPlugin:       ifPlugin,
+		nsPlugin:       nsPlugin,
+		log:            log.NewLogger("sysctl-descriptor"),
+		originalValues: origValues,
+	}
+
+	typedDescr := &adapter.SysctlDescriptor{
+		Name:                 SysctlDescriptorName,
+		NBKeyPrefix:          linux_sysctl.ModelSysctl.KeyPrefix(),
+		ValueTypeName:        linux_sysctl.ModelSysctl.ProtoName(),
+		KeySelector:          linux_sysctl.ModelSysctl.IsKeyValid,
+		KeyLabel:             linux_sysctl.ModelSysctl.StripKeyPrefix,
+		ValueComparator:      descrCtx.EquivalentSysctls,
+		WithMetadata:         true,
+		Validate:             descrCtx.Validate,
+		Create:               descrCtx.Create,
+		Delete:               descrCtx.Delete,
+		Update:               descrCtx.Update,
+		Retrieve:             descrCtx.Retrieve,
+		Dependencies:         descrCtx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewSysctlDescriptor(typedDescr), nil
+}
+
+// EquivalentSysctls is a comparison function for two Sysctl entries.
+// Values are compared with whitespace normalized.
+func (d *SysctlDescriptor) EquivalentSysctls(key string, oldSysctl, newSysctl *linux_sysctl.Sysctl) bool {
+	return oldSysctl.Name == newSysctl.Name &&
+		oldSysctl.Interface == newSysctl.Interface &&
+		proto.Equal(oldSysctl.Namespace, newSysctl.Namespace) &&
+		equivalentValues(oldSysctl.Value, newSysctl.Value)
+}
+
+// Validate validates kernel network setting.
+func (d *SysctlDescriptor) Validate(key string, sysctl *linux_sysctl.Sysctl) error {
+	if sysctl.Name == "" {
+		return kvs.NewInvalidValueError(ErrSysctlWithoutName, "name")
+	}
+	if strings.TrimSpace(sysctl.Value) == "" {
+		return kvs.NewInvalidValueError(ErrSysctlWithoutValue, "value")
+	}
+	if !strings.HasPrefix(sysctl.Name, netSysctlPrefix) {
+		return kvs.NewInvalidValueError(ErrNonNetworkSysctl, "name")
+	}
+	components := strings.Split(sysctl.Name, ".")
+	for _, component := range components {
+		if component == "" || component == ".." || strings.ContainsAny(component, "/ ") {
+			return kvs.NewInvalidValueError(ErrInvalidSysctlName, "name")
+		}
+	}
+	if sysctl.Interface == "" {
+		return nil
+	}
+	if !isInterfaceSysctl(sysctl.Name) || len(components) != ifSysctlComponents {
+		return kvs.NewInvalidValueError(ErrNonInterfaceSysctl, "name", "interface")
+	}
+	if sysctl.Namespace != nil {
+		return kvs.NewInvalidValueError(ErrInterfaceSysctlWithNamespace, "namespace", "interface")
+	}
+	return nil
+}
+
+// Create applies kernel network setting and remembers the original value.
+func (d *SysctlDescriptor) Create(key string, sysctl *linux_sysctl.Sysctl) (metadata interface{}, err error) {
+	var origValue string
+	err = d.inNamespace(sysctl, func(path string) error {
+		origValue, err = d.sysctlHandler.GetSysctl(path)
+		if err != nil {
+			return err
+		}
+		return d.sysctlHandler.SetSysctl(path, sysctl.Value)
+	})
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	meta := &SysctlMetadata{OriginalValue: origValue}
+	d.saveOriginalValue(key, meta)
+	return meta, nil
+}
+
+// Update changes value of the kernel network setting (the original value is preserved).
+func (d *SysctlDescriptor) Update(key string, oldSysctl, newSysctl *linux_sysctl.Sysctl, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	err = d.inNamespace(newSysctl, func(path string) error {
+		return d.sysctlHandler.SetSysctl(path, newSysctl.Value)
+	})
+	if err != nil {
+		d.log.Error(err)
+		return nil, err
+	}
+	// original value retrieved from the system before the first update
+	if meta, ok := oldMetadata.(*SysctlMetadata); ok && meta != nil {
+		d.saveOriginalValue(key, meta)
+	}
+	return oldMetadata, nil
+}
+
+// Delete restores the original value of the kernel network setting.
+func (d *SysctlDescriptor) Delete(key string, sysctl *linux_sysctl.Sysctl, metadata interface{}) error {
+	meta, ok := metadata.(*SysctlMetadata)
+	if !ok || meta == nil {
+		// configured outside of the agent with the same value
+		d.log.Warnf("Original value of the kernel parameter %s is not known, leaving %q", key, sysctl.Value)
+		return nil
+	}
+	err := d.inNamespace(sysctl, func(path string) error {
+		return d.sysctlHandler.SetSysctl(path, meta.OriginalValue)
+	})
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	if err := d.originalValues.delete(key); err != nil {
+		d.log.Warnf("Failed to forget original value of the kernel parameter %s: %v", key, err)
+	}
+	return nil
+}
+
+// Retrieve returns current values of the kernel network settings configured by the agent.
+func (d *SysctlDescriptor) Retrieve(correlate []adapter.SysctlKVWithMetadata) (
+	retrieved []adapter.SysctlKVWithMetadata, err error) {
+
+	for _, expected := range correlate {
+		var value string
+		err = d.inNamespace(expected.Value, func(path string) error {
+			value, err = d.sysctlHandler.GetSysctl(path)
+			return err
+		})
+		if err != nil {
+			// interface or namespace does not exist (yet)
+			d.log.Debugf("Failed to retrieve kernel parameter %s: %v", expected.Key, err)
+			continue
+		}
+
+		meta, _ := expected.Metadata.(*SysctlMetadata)
+		if meta == nil {
+			if origValue, found := d.originalValues.get(expected.Key); found {
+				// configured by the agent before it was restarted
+				meta = &SysctlMetadata{OriginalValue: origValue}
+			} else if !equivalentValues(value, expected.Value.Value) {
+				// not configured by the agent yet
+				meta = &SysctlMetadata{OriginalValue: value}
+			}
+		}
+		sysctl := proto.Clone(expected.Value).(*linux_sysctl.Sysctl)
+		sysctl.Value = value
+		retrieved = append(retrieved, adapter.SysctlKVWithMetadata{
+			Key:      expected.Key,
+			Value:    sysctl,
+			Metadata: meta,
+			Origin:   kvs.FromNB,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists dependencies for kernel network setting.
+func (d *SysctlDescriptor) Dependencies(key string, sysctl *linux_sysctl.Sysctl) (deps []kvs.Dependency) {
+	// the interface must exist
+	if sysctl.Interface != "" {
+		return []kvs.Dependency{
+			{
+				Label: sysctlInterfaceDep,
+				Key:   ifmodel.InterfaceKey(sysctl.Interface),
+			},
+		}
+	}
+
+	// microservice must be available
+	if sysctl.Namespace != nil && sysctl.Namespace.Type == linux_namespace.NetNamespace_MICROSERVICE {
+		deps = append(deps, kvs.Dependency{
+			Label: microserviceDep,
+			Key:   linux_namespace.MicroserviceKey(sysctl.Namespace.Reference),
+		})
+	}
+	return deps
+}
+
+// saveOriginalValue saves the original value of the kernel parameter to be restored
+// also after the agent is restarted.
+func (d *SysctlDescriptor) saveOriginalValue(key string, meta *SysctlMetadata) {
+	if err := d.originalValues.put(key, meta.OriginalValue); err != nil {
+		d.log.Warnf("Failed to save original value of the kernel parameter %s: %v", key, err)
+	}
+}
+
+// inNamespace runs the given function with the path of the kernel parameter
+// (relative to /proc/sys) inside the namespace where the parameter is applied.
+func (d *SysctlDescriptor) inNamespace(sysctl *linux_sysctl.Sysctl, f func(path string) error) error {
+	components := strings.Split(sysctl.Name, ".")
+	ns := sysctl.Namespace
+	if sysctl.Interface != "" {
+		ifMeta, found := d.ifPlugin.GetInterfaceIndex().LookupByName(sysctl.Interface)
+		if !found || ifMeta == nil {
+			return errors.Errorf("failed to obtain metadata for interface %s", sysctl.Interface)
+		}
+		ns = ifMeta.Namespace
+		// interface name is the component preceding the parameter name
+		last := len(components) - 1
+		components = append(components[:last:last], ifMeta.HostIfName, components[last])
+	}
+	path := strings.Join(components, "/")
+
+	// switch network namespace
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	nsRevert, err := d.nsPlugin.SwitchToNamespace(nsCtx, ns)
+	if err != nil {
+		return errors.Errorf("failed to switch to namespace %v: %v", ns, err)
+	}
+	// revert network namespace after returning
+	defer nsRevert()
+
+	return f(path)
+}
+
+// isInterfaceSysctl returns true if the kernel parameter can be applied per interface.
+func isInterfaceSysctl(name string) bool {
+	for _, prefix := range ifSysctlPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// equivalentValues compares values of kernel parameters with whitespace normalized.
+func equivalentValues(value1, value2 string) bool {
+	return strings.Join(strings.Fields(value1), " ") == strings.Join(strings.Fields(value2), " ")
+}
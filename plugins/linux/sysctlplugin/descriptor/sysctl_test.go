@@ -0,0 +1,285 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/vishvananda/netns"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/linux"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+	linux_sysctl "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl"
+)
+
+// sysctlHandlerMock simulates kernel parameters (values by path).
+type sysctlHandlerMock struct {
+	values map[string]string
+}
+
+func (h *sysctlHandlerMock) GetSysctl(path string) (value string, err error) {
+	value, found := h.values[path]
+	if !found {
+		return "", errors.Errorf("failed to read sysctl %s", path)
+	}
+	return value, nil
+}
+
+func (h *sysctlHandlerMock) SetSysctl(path, value string) error {
+	if _, found := h.values[path]; !found {
+		return errors.Errorf("failed to set sysctl %s", path)
+	}
+	h.values[path] = value
+	return nil
+}
+
+// ifPluginMock provides index with the interfaces used by the tests.
+type ifPluginMock struct {
+	ifIndex ifaceidx.LinuxIfMetadataIndex
+}
+
+func (p *ifPluginMock) GetInterfaceIndex() ifaceidx.LinuxIfMetadataIndex {
+	return p.ifIndex
+}
+
+func (p *ifPluginMock) SetNotifyService(notify func(notification *linux.Notification)) {
+}
+
+// nsPluginMock pretends that namespace switching succeeds.
+type nsPluginMock struct{}
+
+func (p *nsPluginMock) SwitchToNamespace(ctx nslinuxcalls.NamespaceMgmtCtx, ns *namespace.NetNamespace) (revert func(), err error) {
+	return func() {}, nil
+}
+
+func (p *nsPluginMock) GetNamespaceHandle(ctx nslinuxcalls.NamespaceMgmtCtx, ns *namespace.NetNamespace) (handle netns.NsHandle, err error) {
+	return netns.None(), nil
+}
+
+func newTestSysctlDescriptor(sysctlHandler *sysctlHandlerMock, originalValuesFile string) *SysctlDescriptor {
+	ifIndex := ifaceidx.NewLinuxIfIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndex.Put("veth1", &ifaceidx.LinuxIfMetadata{HostIfName: "veth1-host", LinuxIfIndex: 1,
+		Namespace: &namespace.NetNamespace{Type: namespace.NetNamespace_MICROSERVICE, Reference: "ms1"}})
+	origValues, err := newOriginalValues(originalValuesFile)
+	Expect(err).ToNot(HaveOccurred())
+	return &SysctlDescriptor{
+		log:            logrus.NewLogger("test"),
+		ifPlugin:       &ifPluginMock{ifIndex: ifIndex},
+		nsPlugin:       &nsPluginMock{},
+		sysctlHandler:  sysctlHandler,
+		originalValues: origValues,
+	}
+}
+
+func TestValidateSysctl(t *testing.T) {
+	tests := []struct {
+		name   string
+		sysctl *linux_sysctl.Sysctl
+		err    error
+		fields []string
+	}{
+		{
+			name:   "global parameter",
+			sysctl: &linux_sysctl.Sysctl{Name: "net.ipv4.ip_forward", Value: "1"},
+		},
+		{
+			name:   "per-interface parameter",
+			sysctl: &linux_sysctl.Sysctl{Name: "net.ipv4.conf.rp_filter", Value: "2", Interface: "veth1"},
+		},
+		{
+			name:   "without value",
+			sysctl: &linux_sysctl.Sysctl{Name: "net.ipv4.ip_forward", Value: " "},
+			err:    ErrSysctlWithoutValue,
+			fields: []string{"value"},
+		},
+		{
+			name:   "non-network parameter",
+			sysctl: &linux_sysctl.Sysctl{Name: "kernel.panic", Value: "1"},
+			err:    ErrNonNetworkSysctl,
+			fields: []string{"name"},
+		},
+		{
+			name:   "empty name component",
+			sysctl: &linux_sysctl.Sysctl{Name: "net...ipv4", Value: "1"},
+			err:    ErrInvalidSysctlName,
+			fields: []string{"name"},
+		},
+		{
+			name:   "global parameter with interface",
+			sysctl: &linux_sysctl.Sysctl{Name: "net.ipv4.ip_forward", Value: "1", Interface: "veth1"},
+			err:    ErrNonInterfaceSysctl,
+			fields: []string{"name", "interface"},
+		},
+		{
+			name: "per-interface parameter with namespace",
+			sysctl: &linux_sysctl.Sysctl{Name: "net.ipv4.conf.rp_filter", Value: "2", Interface: "veth1",
+				Namespace: &namespace.NetNamespace{Type: namespace.NetNamespace_MICROSERVICE, Reference: "ms1"}},
+			err:    ErrInterfaceSysctlWithNamespace,
+			fields: []string{"namespace", "interface"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			descriptor := newTestSysctlDescriptor(&sysctlHandlerMock{}, "")
+			err := descriptor.Validate(linux_sysctl.SysctlKey(test.sysctl.Name, nil), test.sysctl)
+			if test.err == nil {
+				Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			Expect(err).To(Equal(kvs.NewInvalidValueError(test.err, test.fields...)))
+		})
+	}
+}
+
+func TestCreateAndDeleteSysctl(t *testing.T) {
+	RegisterTestingT(t)
+
+	sysctlHandler := &sysctlHandlerMock{values: map[string]string{
+		"net/ipv4/ip_forward":                "0",
+		"net/ipv4/conf/veth1-host/rp_filter": "1",
+	}}
+	descriptor := newTestSysctlDescriptor(sysctlHandler, "")
+
+	// global parameter
+	ipForward := &linux_sysctl.Sysctl{Name: "net.ipv4.ip_forward", Value: "1"}
+	ipForwardKey := linux_sysctl.SysctlKey(ipForward.Name, nil)
+	metadata, err := descriptor.Create(ipForwardKey, ipForward)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(metadata).To(Equal(&SysctlMetadata{OriginalValue: "0"}))
+	Expect(sysctlHandler.values["net/ipv4/ip_forward"]).To(Equal("1"))
+	Expect(descriptor.Delete(ipForwardKey, ipForward, metadata)).To(Succeed())
+	Expect(sysctlHandler.values["net/ipv4/ip_forward"]).To(Equal("0"))
+
+	// per-interface parameter uses the host name of the interface
+	rpFilter := &linux_sysctl.Sysctl{Name: "net.ipv4.conf.rp_filter", Value: "2", Interface: "veth1"}
+	rpFilterKey := linux_sysctl.InterfaceSysctlKey(rpFilter.Name, rpFilter.Interface)
+	metadata, err = descriptor.Create(rpFilterKey, rpFilter)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(metadata).To(Equal(&SysctlMetadata{OriginalValue: "1"}))
+	Expect(sysctlHandler.values["net/ipv4/conf/veth1-host/rp_filter"]).To(Equal("2"))
+	Expect(descriptor.Delete(rpFilterKey, rpFilter, metadata)).To(Succeed())
+	Expect(sysctlHandler.values["net/ipv4/conf/veth1-host/rp_filter"]).To(Equal("1"))
+
+	// unknown interface
+	rpFilter.Interface = "veth2"
+	_, err = descriptor.Create(rpFilterKey, rpFilter)
+	Expect(err).To(HaveOccurred())
+}
+
+func TestRestoreSysctlAfterRestart(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "sysctl")
+	Expect(err).ToNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "sysctl-original-values.json")
+
+	sysctlHandler := &sysctlHandlerMock{values: map[string]string{"net/ipv4/ip_forward": "0"}}
+	sysctl := &linux_sysctl.Sysctl{Name: "net.ipv4.ip_forward", Value: "1"}
+	key := linux_sysctl.SysctlKey(sysctl.Name, nil)
+	_, err = newTestSysctlDescriptor(sysctlHandler, file).Create(key, sysctl)
+	Expect(err).ToNot(HaveOccurred())
+
+	// agent restarted, the parameter already has the expected value
+	descriptor := newTestSysctlDescriptor(sysctlHandler, file)
+	retrieved, err := descriptor.Retrieve([]adapter.SysctlKVWithMetadata{{Key: key, Value: sysctl}})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Metadata).To(Equal(&SysctlMetadata{OriginalValue: "0"}))
+
+	Expect(descriptor.Delete(key, sysctl, retrieved[0].Metadata)).To(Succeed())
+	Expect(sysctlHandler.values["net/ipv4/ip_forward"]).To(Equal("0"))
+
+	// restored value is forgotten
+	descriptor = newTestSysctlDescriptor(sysctlHandler, file)
+	_, found := descriptor.originalValues.get(key)
+	Expect(found).To(BeFalse())
+}
+
+func TestRetrieveSysctlNotConfiguredYet(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "sysctl")
+	Expect(err).ToNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "sysctl-original-values.json")
+
+	sysctlHandler := &sysctlHandlerMock{values: map[string]string{"net/ipv4/ip_forward": "0"}}
+	sysctl := &linux_sysctl.Sysctl{Name: "net.ipv4.ip_forward", Value: "1"}
+	key := linux_sysctl.SysctlKey(sysctl.Name, nil)
+	descriptor := newTestSysctlDescriptor(sysctlHandler, file)
+	retrieved, err := descriptor.Retrieve([]adapter.SysctlKVWithMetadata{{Key: key, Value: sysctl}})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved).To(HaveLen(1))
+	Expect(retrieved[0].Value.Value).To(Equal("0"))
+	Expect(retrieved[0].Metadata).To(Equal(&SysctlMetadata{OriginalValue: "0"}))
+
+	// the retrieved original value is saved with the first update
+	_, err = descriptor.Update(key, retrieved[0].Value, sysctl, retrieved[0].Metadata)
+	Expect(err).ToNot(HaveOccurred())
+	descriptor = newTestSysctlDescriptor(sysctlHandler, file)
+	origValue, found := descriptor.originalValues.get(key)
+	Expect(found).To(BeTrue())
+	Expect(origValue).To(Equal("0"))
+
+	// value set outside of the agent is left in place
+	descriptor = newTestSysctlDescriptor(sysctlHandler, "")
+	retrieved, err = descriptor.Retrieve([]adapter.SysctlKVWithMetadata{{Key: key, Value: sysctl}})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(retrieved[0].Metadata).To(BeNil())
+	Expect(descriptor.Delete(key, sysctl, retrieved[0].Metadata)).To(Succeed())
+	Expect(sysctlHandler.values["net/ipv4/ip_forward"]).To(Equal("1"))
+}
+
+func TestEquivalentSysctls(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := newTestSysctlDescriptor(&sysctlHandlerMock{}, "")
+	key := linux_sysctl.SysctlKey("net.ipv4.ip_local_port_range", nil)
+	Expect(descriptor.EquivalentSysctls(key,
+		&linux_sysctl.Sysctl{Name: "net.ipv4.ip_local_port_range", Value: "32768\t60999"},
+		&linux_sysctl.Sysctl{Name: "net.ipv4.ip_local_port_range", Value: "32768 60999 "})).To(BeTrue())
+	Expect(descriptor.EquivalentSysctls(key,
+		&linux_sysctl.Sysctl{Name: "net.ipv4.ip_local_port_range", Value: "32768 60999"},
+		&linux_sysctl.Sysctl{Name: "net.ipv4.ip_local_port_range", Value: "1024 60999"})).To(BeFalse())
+}
+
+func TestSysctlDependencies(t *testing.T) {
+	RegisterTestingT(t)
+
+	descriptor := newTestSysctlDescriptor(&sysctlHandlerMock{}, "")
+	rpFilter := &linux_sysctl.Sysctl{Name: "net.ipv4.conf.rp_filter", Value: "2", Interface: "veth1"}
+	Expect(descriptor.Dependencies("", rpFilter)).To(Equal([]kvs.Dependency{
+		{Label: sysctlInterfaceDep, Key: ifmodel.InterfaceKey("veth1")},
+	}))
+	ipForward := &linux_sysctl.Sysctl{Name: "net.ipv4.ip_forward", Value: "1",
+		Namespace: &namespace.NetNamespace{Type: namespace.NetNamespace_MICROSERVICE, Reference: "ms1"}}
+	Expect(descriptor.Dependencies("", ipForward)).To(Equal([]kvs.Dependency{
+		{Label: microserviceDep, Key: namespace.MicroserviceKey("ms1")},
+	}))
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+// SysctlAPI interface covers all methods inside linux calls package needed
+// to manage kernel network settings.
+type SysctlAPI interface {
+	// GetSysctl reads value of the kernel parameter with the given path
+	// (relative to /proc/sys, with components separated by slashes)
+	// in the current network namespace.
+	GetSysctl(path string) (value string, err error)
+
+	// SetSysctl sets value of the kernel parameter with the given path
+	// (relative to /proc/sys, with components separated by slashes)
+	// in the current network namespace.
+	SetSysctl(path, value string) error
+}
+
+// NewSysctlHandler creates new instance of sysctl handler.
+func NewSysctlHandler() *SysctlHandler {
+	return &SysctlHandler{}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// procSysDir is the directory with kernel parameters.
+const procSysDir = "/proc/sys"
+
+// SysctlHandler is a handler for all operations on kernel network settings.
+// Network settings (under /proc/sys/net) are accessed in the network namespace
+// of the calling thread.
+type SysctlHandler struct {
+}
+
+// GetSysctl reads value of the kernel parameter with the given path
+// (relative to /proc/sys, with components separated by slashes)
+// in the current network namespace.
+func (h *SysctlHandler) GetSysctl(path string) (value string, err error) {
+	data, err := ioutil.ReadFile(filepath.Join(procSysDir, path))
+	if err != nil {
+		return "", errors.Errorf("failed to read sysctl %s: %v", path, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SetSysctl sets value of the kernel parameter with the given path
+// (relative to /proc/sys, with components separated by slashes)
+// in the current network namespace.
+func (h *SysctlHandler) SetSysctl(path, value string) error {
+	err := ioutil.WriteFile(filepath.Join(procSysDir, path), []byte(value), 0644)
+	if err != nil {
+		return errors.Errorf("failed to set sysctl %s to %q: %v", path, value, err)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate descriptor-adapter --descriptor-name Sysctl --value-type *linux_sysctl.Sysctl --import "go.ligato.io/vpp-agent/v3/proto/ligato/linux/sysctl" --output-dir "descriptor"
+
+package sysctlplugin
+
+import (
+	"go.ligato.io/cn-infra/v2/infra"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/sysctlplugin/linuxcalls"
+)
+
+// SysctlPlugin configures Linux kernel network settings (sysctl).
+type SysctlPlugin struct {
+	Deps
+
+	// From configuration file
+	disabled bool
+
+	// system handlers
+	sysctlHandler linuxcalls.SysctlAPI
+}
+
+// Deps lists dependencies of the plugin.
+type Deps struct {
+	infra.PluginDeps
+	KVScheduler kvs.KVScheduler
+	NsPlugin    nsplugin.API
+	IfPlugin    ifplugin.API
+}
+
+// DefaultOriginalValuesFile is the default file where original values of the configured
+// kernel parameters are saved. Kernel parameters are reset on reboot, therefore
+// the file is kept in the run-time directory.
+const DefaultOriginalValuesFile = "/run/vpp-agent/sysctl-original-values.json"
+
+// Config holds the plugin configuration.
+type Config struct {
+	Disabled           bool   `json:"disabled"`
+	OriginalValuesFile string `json:"original-values-file"`
+}
+
+// Init initializes and registers descriptor for Linux kernel network settings.
+func (p *SysctlPlugin) Init() error {
+	// parse configuration file
+	config, err := p.retrieveConfig()
+	if err != nil {
+		return err
+	}
+	p.Log.Debugf("Linux sysctl config: %+v", config)
+	if config.Disabled {
+		p.disabled = true
+		p.Log.Infof("Disabling sysctl plugin")
+		return nil
+	}
+
+	// init sysctl handler
+	p.sysctlHandler = linuxcalls.NewSysctlHandler()
+
+	// init & register the descriptor
+	sysctlDescriptor, err := descriptor.NewSysctlDescriptor(p.sysctlHandler, p.IfPlugin, p.NsPlugin,
+		config.OriginalValuesFile, p.Log)
+	if err != nil {
+		return err
+	}
+
+	err = p.Deps.KVScheduler.RegisterKVDescriptor(sysctlDescriptor)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Close does nothing here.
+func (p *SysctlPlugin) Close() error {
+	return nil
+}
+
+// retrieveConfig loads plugin configuration file.
+func (p *SysctlPlugin) retrieveConfig() (*Config, error) {
+	config := &Config{
+		OriginalValuesFile: DefaultOriginalValuesFile,
+	}
+	found, err := p.Cfg.LoadValue(config)
+	if !found {
+		p.Log.Debug("Linux SysctlPlugin config not found")
+		return config, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return config, err
+}
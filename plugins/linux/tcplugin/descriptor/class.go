@@ -0,0 +1,298 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+const (
+	// ClassDescriptorName is the name of the descriptor for Linux traffic classes.
+	ClassDescriptorName = "linux-tc-class-descriptor"
+
+	// kind of the supported classes
+	htbKind = "htb"
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrClassWithoutInterface is returned when the class is defined without interface.
+	ErrClassWithoutInterface = errors.New("traffic class defined without interface")
+
+	// ErrInvalidClassID is returned when the class ID is not valid.
+	ErrInvalidClassID = errors.New("invalid class ID (expected format <major>:<minor>, e.g. \"1:a\")")
+
+	// ErrInvalidClassParent is returned when the parent of the class is not valid.
+	ErrInvalidClassParent = errors.New("class parent must be a qdisc handle or a class ID " +
+		"with the same major number as the class")
+
+	// ErrClassWithoutRate is returned when the HTB class is defined without rate.
+	ErrClassWithoutRate = errors.New("HTB class defined without rate")
+
+	// ErrClassCeilBelowRate is returned when the ceil of the HTB class is lower than the rate.
+	ErrClassCeilBelowRate = errors.New("ceil of HTB class must not be lower than rate")
+)
+
+// ClassDescriptor teaches KVScheduler how to configure Linux traffic classes.
+type ClassDescriptor struct {
+	log       logging.Logger
+	tcHandler linuxcalls.TCAPI
+	ifPlugin  ifplugin.API
+	nsPlugin  nsplugin.API
+}
+
+// NewClassDescriptor creates a new instance of the Class descriptor.
+func NewClassDescriptor(tcHandler linuxcalls.TCAPI, ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &ClassDescriptor{
+		tcHandler: tcHandler,
+		ifPlugin:  ifPlugin,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("class-descriptor"),
+	}
+
+	typedDescr := &adapter.ClassDescriptor{
+		Name:                 ClassDescriptorName,
+		NBKeyPrefix:          linux_tc.ModelClass.KeyPrefix(),
+		ValueTypeName:        linux_tc.ModelClass.ProtoName(),
+		KeySelector:          linux_tc.ModelClass.IsKeyValid,
+		KeyLabel:             linux_tc.ModelClass.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentClasses,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewClassDescriptor(typedDescr)
+}
+
+// EquivalentClasses compares traffic classes with the defaults applied by the kernel
+// and in the precision printed by tc.
+func (d *ClassDescriptor) EquivalentClasses(key string, oldClass, newClass *linux_tc.Class) bool {
+	if oldClass.Interface != newClass.Interface || oldClass.ClassId != newClass.ClassId ||
+		oldClass.Parent != newClass.Parent {
+		return false
+	}
+	oldHtb, newHtb := oldClass.GetHtb(), newClass.GetHtb()
+	return equalRates(oldHtb.GetRate(), newHtb.GetRate()) &&
+		equalRates(htbCeil(oldHtb), htbCeil(newHtb)) &&
+		oldHtb.GetBurst() == newHtb.GetBurst() && oldHtb.GetCburst() == newHtb.GetCburst() &&
+		oldHtb.GetPrio() == newHtb.GetPrio() && oldHtb.GetQuantum() == newHtb.GetQuantum()
+}
+
+// htbCeil returns ceil of the HTB class, which defaults to the rate.
+func htbCeil(htb *linux_tc.Class_Htb) uint64 {
+	if htb.GetCeil() == 0 {
+		return htb.GetRate()
+	}
+	return htb.GetCeil()
+}
+
+// Validate validates traffic class configuration.
+func (d *ClassDescriptor) Validate(key string, class *linux_tc.Class) error {
+	if class.Interface == "" {
+		return kvs.NewInvalidValueError(ErrClassWithoutInterface, "interface")
+	}
+	if !isClassID(class.ClassId) {
+		return kvs.NewInvalidValueError(ErrInvalidClassID, "class_id")
+	}
+	if (!isHandle(class.Parent) && !isClassID(class.Parent)) ||
+		handleMajor(class.Parent) != handleMajor(class.ClassId) || class.Parent == class.ClassId {
+		return kvs.NewInvalidValueError(ErrInvalidClassParent, "parent", "class_id")
+	}
+	if class.GetHtb().GetRate() == 0 {
+		return kvs.NewInvalidValueError(ErrClassWithoutRate, "htb.rate")
+	}
+	if class.Htb.Ceil != 0 && class.Htb.Ceil < class.Htb.Rate {
+		return kvs.NewInvalidValueError(ErrClassCeilBelowRate, "htb.ceil", "htb.rate")
+	}
+	return nil
+}
+
+// Create creates the traffic class.
+func (d *ClassDescriptor) Create(key string, class *linux_tc.Class) (metadata interface{}, err error) {
+	err = d.replaceClass(class)
+	return nil, err
+}
+
+// Delete removes the traffic class.
+func (d *ClassDescriptor) Delete(key string, class *linux_tc.Class, metadata interface{}) error {
+	err := inInterfaceNamespace(d.ifPlugin, d.nsPlugin, class.Interface, func(hostName string) error {
+		return d.tcHandler.DeleteClass(hostName, class.ClassId)
+	})
+	if err != nil {
+		d.log.Error(err)
+	}
+	return err
+}
+
+// Update changes attributes of the traffic class.
+func (d *ClassDescriptor) Update(key string, oldClass, newClass *linux_tc.Class, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	err = d.replaceClass(newClass)
+	return nil, err
+}
+
+// UpdateWithRecreate returns true if the parent of the class has changed.
+func (d *ClassDescriptor) UpdateWithRecreate(key string, oldClass, newClass *linux_tc.Class, metadata interface{}) bool {
+	return oldClass.Parent != newClass.Parent
+}
+
+// Retrieve returns traffic classes configured by the agent which exist in the kernel,
+// with attributes as printed by tc (see classFromTc).
+func (d *ClassDescriptor) Retrieve(correlate []adapter.ClassKVWithMetadata) (
+	retrieved []adapter.ClassKVWithMetadata, err error) {
+
+	byIface := make(map[string][]adapter.ClassKVWithMetadata)
+	var ifaces []string
+	for _, kv := range correlate {
+		if _, listed := byIface[kv.Value.Interface]; !listed {
+			ifaces = append(ifaces, kv.Value.Interface)
+		}
+		byIface[kv.Value.Interface] = append(byIface[kv.Value.Interface], kv)
+	}
+
+	for _, iface := range ifaces {
+		var classes []*linuxcalls.Class
+		err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, iface, func(hostName string) error {
+			classes, err = d.tcHandler.GetClasses(hostName)
+			return err
+		})
+		if err != nil {
+			// interface does not exist (yet)
+			d.log.Debugf("Failed to retrieve classes of interface %s: %v", iface, err)
+			continue
+		}
+		for _, kv := range byIface[iface] {
+			for _, class := range classes {
+				if class.Kind != htbKind || class.ClassID != kv.Value.ClassId || class.Parent != kv.Value.Parent {
+					continue
+				}
+				retrieved = append(retrieved, adapter.ClassKVWithMetadata{
+					Key:    kv.Key,
+					Value:  classFromTc(kv.Value, class),
+					Origin: kvs.FromNB,
+				})
+				break
+			}
+		}
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface and the parent qdisc or class as dependencies of the class.
+func (d *ClassDescriptor) Dependencies(key string, class *linux_tc.Class) []kvs.Dependency {
+	deps := []kvs.Dependency{
+		{
+			Label: tcInterfaceDep,
+			Key:   ifmodel.InterfaceKey(class.Interface),
+		},
+	}
+	if isClassID(class.Parent) {
+		deps = append(deps, kvs.Dependency{
+			Label: tcClassDep,
+			Key:   linux_tc.ClassKey(class.Interface, class.Parent),
+		})
+	} else {
+		deps = append(deps, kvs.Dependency{
+			Label: tcQdiscDep,
+			Key:   linux_tc.QdiscHandleKey(class.Interface, class.Parent),
+		})
+	}
+	return deps
+}
+
+// replaceClass creates or changes the class inside the namespace of the interface.
+func (d *ClassDescriptor) replaceClass(class *linux_tc.Class) error {
+	err := inInterfaceNamespace(d.ifPlugin, d.nsPlugin, class.Interface, func(hostName string) error {
+		return d.tcHandler.ReplaceClass(hostName, &linuxcalls.Class{
+			Kind:    htbKind,
+			ClassID: class.ClassId,
+			Parent:  class.Parent,
+			Options: htbClassOptions(class.Htb),
+		})
+	})
+	if err != nil {
+		d.log.Error(err)
+	}
+	return err
+}
+
+// htbClassOptions returns tc options of the HTB class.
+func htbClassOptions(htb *linux_tc.Class_Htb) []string {
+	opts := []string{"rate", bitRate(htb.Rate)}
+	if htb.Ceil != 0 {
+		opts = append(opts, "ceil", bitRate(htb.Ceil))
+	}
+	if htb.Burst != 0 {
+		opts = append(opts, "burst", strconv.FormatUint(uint64(htb.Burst), 10))
+	}
+	if htb.Cburst != 0 {
+		opts = append(opts, "cburst", strconv.FormatUint(uint64(htb.Cburst), 10))
+	}
+	if htb.Prio != 0 {
+		opts = append(opts, "prio", strconv.FormatUint(uint64(htb.Prio), 10))
+	}
+	if htb.Quantum != 0 {
+		opts = append(opts, "quantum", strconv.FormatUint(uint64(htb.Quantum), 10))
+	}
+	return opts
+}
+
+// classFromTc returns the expected traffic class with rate, ceil and priority read back from tc.
+// Burst, cburst and quantum are printed by tc derived from the rate unless configured
+// and are therefore taken over from the expected class, the same applies to the priority
+// of inner classes, which is not printed.
+func classFromTc(expected *linux_tc.Class, tcClass *linuxcalls.Class) *linux_tc.Class {
+	values := optionValues(tcClass.Options, "prio", "quantum", "rate", "overhead", "ceil", "burst", "cburst")
+	htb := &linux_tc.Class_Htb{
+		Burst:   expected.GetHtb().GetBurst(),
+		Cburst:  expected.GetHtb().GetCburst(),
+		Quantum: expected.GetHtb().GetQuantum(),
+		Prio:    expected.GetHtb().GetPrio(),
+	}
+	if rate := values["rate"]; len(rate) > 0 {
+		htb.Rate, _ = parseRate(rate[0])
+	}
+	if ceil := values["ceil"]; len(ceil) > 0 {
+		htb.Ceil, _ = parseRate(ceil[0])
+	}
+	if prio := values["prio"]; len(prio) > 0 {
+		htb.Prio, _ = parseUint(prio[0], "")
+	}
+	return &linux_tc.Class{
+		Interface: expected.Interface,
+		ClassId:   expected.ClassId,
+		Parent:    expected.Parent,
+		Htb:       htb,
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+func TestClassFromTc(t *testing.T) {
+	tests := []struct {
+		name       string
+		expected   *linux_tc.Class_Htb
+		tcOptions  string // as printed by "tc class show"
+		retrieved  *linux_tc.Class_Htb
+		equivalent bool
+	}{
+		{
+			name:       "leaf class",
+			expected:   &linux_tc.Class_Htb{Rate: 2500000, Ceil: 10000000, Prio: 1},
+			tcOptions:  "prio 1 rate 2500Kbit ceil 10Mbit burst 1600b cburst 1600b",
+			retrieved:  &linux_tc.Class_Htb{Rate: 2500000, Ceil: 10000000, Prio: 1},
+			equivalent: true,
+		},
+		{
+			name:       "ceil defaults to rate",
+			expected:   &linux_tc.Class_Htb{Rate: 1000000, Burst: 1600},
+			tcOptions:  "prio 0 rate 1Mbit ceil 1Mbit burst 1600b cburst 1600b",
+			retrieved:  &linux_tc.Class_Htb{Rate: 1000000, Ceil: 1000000, Burst: 1600},
+			equivalent: true,
+		},
+		{
+			name:       "inner class",
+			expected:   &linux_tc.Class_Htb{Rate: 10000000, Prio: 2},
+			tcOptions:  "rate 10Mbit ceil 10Mbit burst 1600b cburst 1600b",
+			retrieved:  &linux_tc.Class_Htb{Rate: 10000000, Ceil: 10000000, Prio: 2},
+			equivalent: true,
+		},
+		{
+			name:      "changed rate and ceil",
+			expected:  &linux_tc.Class_Htb{Rate: 2000000, Ceil: 5000000},
+			tcOptions: "prio 0 rate 1Mbit ceil 10Mbit burst 1600b cburst 1600b",
+			retrieved: &linux_tc.Class_Htb{Rate: 1000000, Ceil: 10000000},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			expected := &linux_tc.Class{Interface: "veth1", ClassId: "1:10", Parent: "1:1", Htb: test.expected}
+			tcClass := &linuxcalls.Class{Kind: htbKind, ClassID: "1:10", Parent: "1:1",
+				Options: strings.Fields(test.tcOptions)}
+			retrieved := classFromTc(expected, tcClass)
+			Expect(proto.Equal(retrieved.Htb, test.retrieved)).To(BeTrue(), "retrieved: %v", retrieved)
+
+			descriptor := &ClassDescriptor{}
+			Expect(descriptor.EquivalentClasses("", retrieved, expected)).To(Equal(test.equivalent))
+		})
+	}
+}
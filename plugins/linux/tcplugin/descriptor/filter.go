@@ -0,0 +1,987 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"encoding/binary"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+const (
+	// FilterDescriptorName is the name of the descriptor for Linux tc filters.
+	FilterDescriptorName = "linux-tc-filter-descriptor"
+
+	// dependency labels
+	actionInterfaceDep = "action-interface-exists"
+
+	// maximum priority of a filter
+	maxFilterPriority = 0xffff
+
+	// maximum VLAN ID
+	maxVlanID = 4095
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrFilterWithoutInterface is returned when the filter is defined without interface.
+	ErrFilterWithoutInterface = errors.New("tc filter defined without interface")
+
+	// ErrInvalidFilterParent is returned when the parent of the filter is not valid.
+	ErrInvalidFilterParent = errors.New("filter parent must be a qdisc handle or \"ingress\"")
+
+	// ErrInvalidFilterPriority is returned when the priority of the filter is out of range.
+	ErrInvalidFilterPriority = errors.New("filter priority must be between 1 and 65535")
+
+	// ErrFilterWithoutClassifier is returned when the filter is defined without classifier.
+	ErrFilterWithoutClassifier = errors.New("tc filter defined without classifier")
+
+	// ErrInvalidFilterClass is returned when the class of the filter is not valid.
+	ErrInvalidFilterClass = errors.New("filter class ID must have the same major number as the parent qdisc " +
+		"and is not applicable to ingress filters")
+
+	// ErrInvalidMatchValue is returned when the value of a u32 match is not valid for the field.
+	ErrInvalidMatchValue = errors.New("invalid value of u32 match for the matched field")
+
+	// ErrMatchProtocolMismatch is returned when the u32 match does not correspond to the protocol of the filter.
+	ErrMatchProtocolMismatch = errors.New("IP fields require protocol IP, IP6 fields require protocol IPV6")
+
+	// ErrInvalidFlowerAttr is returned when an attribute of the flower classifier is not valid.
+	ErrInvalidFlowerAttr = errors.New("invalid attribute of flower classifier")
+
+	// ErrFlowerAttrProtocolMismatch is returned when an attribute of the flower classifier requires
+	// a different protocol (or IP protocol).
+	ErrFlowerAttrProtocolMismatch = errors.New("attribute of flower classifier is not applicable " +
+		"to the protocol of the filter (or to the IP protocol)")
+
+	// ErrMirredWithoutInterface is returned when the MIRROR or REDIRECT action is defined without interface.
+	ErrMirredWithoutInterface = errors.New("MIRROR and REDIRECT actions require target interface")
+
+	// ErrActionWithInterface is returned when the interface is defined for the PASS or DROP action.
+	ErrActionWithInterface = errors.New("interface and direction are applicable only to MIRROR and REDIRECT actions")
+)
+
+// FilterDescriptor teaches KVScheduler how to configure Linux tc filters.
+type FilterDescriptor struct {
+	log       logging.Logger
+	tcHandler linuxcalls.TCAPI
+	ifPlugin  ifplugin.API
+	nsPlugin  nsplugin.API
+}
+
+// NewFilterDescriptor creates a new instance of the Filter descriptor.
+func NewFilterDescriptor(tcHandler linuxcalls.TCAPI, ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &FilterDescriptor{
+		tcHandler: tcHandler,
+		ifPlugin:  ifPlugin,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("filter-descriptor"),
+	}
+
+	typedDescr := &adapter.FilterDescriptor{
+		Name:                 FilterDescriptorName,
+		NBKeyPrefix:          linux_tc.ModelFilter.KeyPrefix(),
+		ValueTypeName:        linux_tc.ModelFilter.ProtoName(),
+		KeySelector:          linux_tc.ModelFilter.IsKeyValid,
+		KeyLabel:             linux_tc.ModelFilter.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentFilters,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Retrieve:             ctx.Retrieve,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewFilterDescriptor(typedDescr)
+}
+
+// EquivalentFilters compares tc filters by the keys matched by the classifier
+// (u32 matches) or with normalized addresses (flower).
+func (d *FilterDescriptor) EquivalentFilters(key string, oldFilter, newFilter *linux_tc.Filter) bool {
+	if oldFilter.Interface != newFilter.Interface || oldFilter.Parent != newFilter.Parent ||
+		oldFilter.Priority != newFilter.Priority || oldFilter.Protocol != newFilter.Protocol ||
+		oldFilter.ClassId != newFilter.ClassId || filterKind(oldFilter) != filterKind(newFilter) ||
+		len(oldFilter.Actions) != len(newFilter.Actions) {
+		return false
+	}
+	for i := range oldFilter.Actions {
+		if !proto.Equal(oldFilter.Actions[i], newFilter.Actions[i]) {
+			return false
+		}
+	}
+	switch classifier := newFilter.Classifier.(type) {
+	case *linux_tc.Filter_U32:
+		oldKeys, err := u32Keys(oldFilter.GetU32().GetMatches())
+		if err != nil {
+			return false
+		}
+		newKeys, err := u32Keys(classifier.U32.GetMatches())
+		if err != nil {
+			return false
+		}
+		return equalU32Keys(oldKeys, newKeys)
+	case *linux_tc.Filter_Flower:
+		return proto.Equal(normalizeFlower(oldFilter.GetFlower()), normalizeFlower(classifier.Flower))
+	}
+	return true
+}
+
+// Validate validates tc filter configuration.
+func (d *FilterDescriptor) Validate(key string, filter *linux_tc.Filter) error {
+	if filter.Interface == "" {
+		return kvs.NewInvalidValueError(ErrFilterWithoutInterface, "interface")
+	}
+	if filter.Parent != linux_tc.IngressParent && !isHandle(filter.Parent) {
+		return kvs.NewInvalidValueError(ErrInvalidFilterParent, "parent")
+	}
+	if filter.Priority == 0 || filter.Priority > maxFilterPriority {
+		return kvs.NewInvalidValueError(ErrInvalidFilterPriority, "priority")
+	}
+	if filter.ClassId != "" {
+		if filter.Parent == linux_tc.IngressParent || !isClassID(filter.ClassId) ||
+			handleMajor(filter.ClassId) != filter.Parent {
+			return kvs.NewInvalidValueError(ErrInvalidFilterClass, "class_id", "parent")
+		}
+	}
+
+	switch classifier := filter.Classifier.(type) {
+	case *linux_tc.Filter_U32:
+		for _, match := range classifier.U32.GetMatches() {
+			if err := validateU32Match(filter.Protocol, match); err != nil {
+				return err
+			}
+		}
+	case *linux_tc.Filter_Flower:
+		if err := validateFlower(filter.Protocol, classifier.Flower); err != nil {
+			return err
+		}
+	default:
+		return kvs.NewInvalidValueError(ErrFilterWithoutClassifier, "classifier")
+	}
+
+	for _, action := range filter.Actions {
+		switch action.Type {
+		case linux_tc.Filter_Action_MIRROR, linux_tc.Filter_Action_REDIRECT:
+			if action.Interface == "" {
+				return kvs.NewInvalidValueError(ErrMirredWithoutInterface, "actions.interface")
+			}
+		default:
+			if action.Interface != "" || action.Direction != linux_tc.Filter_Action_EGRESS {
+				return kvs.NewInvalidValueError(ErrActionWithInterface, "actions.interface", "actions.direction")
+			}
+		}
+	}
+	return nil
+}
+
+// validateU32Match validates a single match of the u32 classifier.
+func validateU32Match(protocol linux_tc.Filter_Protocol, match *linux_tc.Filter_U32Classifier_Match) error {
+	field, value := match.Field, match.Value
+	isIP6 := field >= linux_tc.Filter_U32Classifier_Match_IP6_SRC
+	if (isIP6 && protocol != linux_tc.Filter_IPV6) || (!isIP6 && protocol != linux_tc.Filter_IP) {
+		return kvs.NewInvalidValueError(ErrMatchProtocolMismatch, "u32.matches.field", "protocol")
+	}
+	var valid bool
+	switch field {
+	case linux_tc.Filter_U32Classifier_Match_IP_SRC, linux_tc.Filter_U32Classifier_Match_IP_DST,
+		linux_tc.Filter_U32Classifier_Match_IP6_SRC, linux_tc.Filter_U32Classifier_Match_IP6_DST:
+		valid = isIPOfFamily(value, isIP6)
+	case linux_tc.Filter_U32Classifier_Match_IP_PROTO, linux_tc.Filter_U32Classifier_Match_IP6_PROTO:
+		valid = isNumberInRange(value, 0, 0xff)
+	default:
+		valid = isNumberInRange(value, 1, 0xffff)
+	}
+	if !valid {
+		return kvs.NewInvalidValueError(ErrInvalidMatchValue, "u32.matches.value")
+	}
+	return nil
+}
+
+// validateFlower validates attributes of the flower classifier.
+func validateFlower(protocol linux_tc.Filter_Protocol, flower *linux_tc.Filter_FlowerClassifier) error {
+	if flower == nil {
+		return nil
+	}
+	for _, mac := range []string{flower.SrcMac, flower.DstMac} {
+		if _, err := net.ParseMAC(mac); mac != "" && err != nil {
+			return kvs.NewInvalidValueError(ErrInvalidFlowerAttr, "flower.src_mac", "flower.dst_mac")
+		}
+	}
+	if flower.VlanId != 0 {
+		if protocol != linux_tc.Filter_VLAN_8021Q {
+			return kvs.NewInvalidValueError(ErrFlowerAttrProtocolMismatch, "flower.vlan_id", "protocol")
+		}
+		if flower.VlanId > maxVlanID {
+			return kvs.NewInvalidValueError(ErrInvalidFlowerAttr, "flower.vlan_id")
+		}
+	}
+
+	isIP, isIP6 := protocol == linux_tc.Filter_IP, protocol == linux_tc.Filter_IPV6
+	switch flower.IpProto {
+	case linux_tc.Filter_FlowerClassifier_ANY:
+	case linux_tc.Filter_FlowerClassifier_ICMP:
+		if !isIP {
+			return kvs.NewInvalidValueError(ErrFlowerAttrProtocolMismatch, "flower.ip_proto", "protocol")
+		}
+	case linux_tc.Filter_FlowerClassifier_ICMPV6:
+		if !isIP6 {
+			return kvs.NewInvalidValueError(ErrFlowerAttrProtocolMismatch, "flower.ip_proto", "protocol")
+		}
+	default:
+		if !isIP && !isIP6 {
+			return kvs.NewInvalidValueError(ErrFlowerAttrProtocolMismatch, "flower.ip_proto", "protocol")
+		}
+	}
+	for _, addr := range []string{flower.SrcIp, flower.DstIp} {
+		if addr == "" {
+			continue
+		}
+		if !isIP && !isIP6 {
+			return kvs.NewInvalidValueError(ErrFlowerAttrProtocolMismatch, "flower.src_ip", "flower.dst_ip", "protocol")
+		}
+		if !isIPOfFamily(addr, isIP6) {
+			return kvs.NewInvalidValueError(ErrInvalidFlowerAttr, "flower.src_ip", "flower.dst_ip")
+		}
+	}
+	if flower.SrcPort != 0 || flower.DstPort != 0 {
+		switch flower.IpProto {
+		case linux_tc.Filter_FlowerClassifier_TCP, linux_tc.Filter_FlowerClassifier_UDP,
+			linux_tc.Filter_FlowerClassifier_SCTP:
+		default:
+			return kvs.NewInvalidValueError(ErrFlowerAttrProtocolMismatch,
+				"flower.src_port", "flower.dst_port", "flower.ip_proto")
+		}
+		if flower.SrcPort > 0xffff || flower.DstPort > 0xffff {
+			return kvs.NewInvalidValueError(ErrInvalidFlowerAttr, "flower.src_port", "flower.dst_port")
+		}
+	}
+	return nil
+}
+
+// Create adds the filter to the qdisc.
+func (d *FilterDescriptor) Create(key string, filter *linux_tc.Filter) (metadata interface{}, err error) {
+	err = d.replaceFilter(filter)
+	return nil, err
+}
+
+// Delete removes the filter from the qdisc.
+func (d *FilterDescriptor) Delete(key string, filter *linux_tc.Filter, metadata interface{}) error {
+	err := inInterfaceNamespace(d.ifPlugin, d.nsPlugin, filter.Interface, func(hostName string) error {
+		return d.tcHandler.DeleteFilter(hostName, filter.Parent, filter.Priority)
+	})
+	if err != nil {
+		d.log.Error(err)
+	}
+	return err
+}
+
+// Update replaces the filter.
+func (d *FilterDescriptor) Update(key string, oldFilter, newFilter *linux_tc.Filter, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	err = d.replaceFilter(newFilter)
+	return nil, err
+}
+
+// UpdateWithRecreate returns true if the kind or the protocol of the filter has changed,
+// filter is replaced in place by tc only with the same kind and protocol.
+func (d *FilterDescriptor) UpdateWithRecreate(key string, oldFilter, newFilter *linux_tc.Filter, metadata interface{}) bool {
+	return filterKind(oldFilter) != filterKind(newFilter) || oldFilter.Protocol != newFilter.Protocol
+}
+
+// Retrieve returns tc filters configured by the agent which exist in the kernel,
+// with the classifier, class and actions as printed by tc (see filterFromTc).
+func (d *FilterDescriptor) Retrieve(correlate []adapter.FilterKVWithMetadata) (
+	retrieved []adapter.FilterKVWithMetadata, err error) {
+
+	type qdiscRef struct {
+		iface  string
+		parent string
+	}
+	byQdisc := make(map[qdiscRef][]adapter.FilterKVWithMetadata)
+	var qdiscs []qdiscRef
+	for _, kv := range correlate {
+		ref := qdiscRef{iface: kv.Value.Interface, parent: kv.Value.Parent}
+		if _, listed := byQdisc[ref]; !listed {
+			qdiscs = append(qdiscs, ref)
+		}
+		byQdisc[ref] = append(byQdisc[ref], kv)
+	}
+
+	for _, ref := range qdiscs {
+		var filters []*linuxcalls.Filter
+		err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, ref.iface, func(hostName string) error {
+			filters, err = d.tcHandler.GetFilters(hostName, ref.parent)
+			return err
+		})
+		if err != nil {
+			// interface or qdisc does not exist (yet)
+			d.log.Debugf("Failed to retrieve filters %s of interface %s: %v", ref.parent, ref.iface, err)
+			continue
+		}
+		for _, kv := range byQdisc[ref] {
+			// logical names of the target interfaces by their host names
+			targets := make(map[string]string)
+			for _, iface := range actionInterfaces(kv.Value) {
+				if targetMeta, err := lookupInterface(d.ifPlugin, iface); err == nil {
+					targets[targetMeta.HostIfName] = iface
+				}
+			}
+			for _, filter := range filters {
+				if filter.Priority != kv.Value.Priority || filter.Kind != filterKind(kv.Value) ||
+					filter.Protocol != filterProtocol(kv.Value.Protocol) {
+					continue
+				}
+				retrieved = append(retrieved, adapter.FilterKVWithMetadata{
+					Key:    kv.Key,
+					Value:  filterFromTc(kv.Value, filter, targets),
+					Origin: kvs.FromNB,
+				})
+				break
+			}
+		}
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists the interface, the qdisc and the target interfaces of actions
+// as dependencies of the filter.
+func (d *FilterDescriptor) Dependencies(key string, filter *linux_tc.Filter) []kvs.Dependency {
+	deps := []kvs.Dependency{
+		{
+			Label: tcInterfaceDep,
+			Key:   ifmodel.InterfaceKey(filter.Interface),
+		},
+	}
+	if filter.Parent == linux_tc.IngressParent {
+		deps = append(deps, kvs.Dependency{
+			Label: tcQdiscDep,
+			Key:   linux_tc.QdiscKey(filter.Interface, linux_tc.IngressParent),
+		})
+	} else {
+		deps = append(deps, kvs.Dependency{
+			Label: tcQdiscDep,
+			Key:   linux_tc.QdiscHandleKey(filter.Interface, filter.Parent),
+		})
+	}
+	for _, iface := range actionInterfaces(filter) {
+		deps = append(deps, kvs.Dependency{
+			Label: actionInterfaceDep + "-" + iface,
+			Key:   ifmodel.InterfaceKey(iface),
+		})
+	}
+	return deps
+}
+
+// replaceFilter adds (or replaces) the filter inside the namespace of the interface.
+func (d *FilterDescriptor) replaceFilter(filter *linux_tc.Filter) error {
+	ifMeta, err := lookupInterface(d.ifPlugin, filter.Interface)
+	if err != nil {
+		d.log.Error(err)
+		return err
+	}
+	// resolve host names of the target interfaces, which must be in the same namespace
+	targets := make(map[string]string)
+	for _, iface := range actionInterfaces(filter) {
+		targetMeta, err := lookupInterface(d.ifPlugin, iface)
+		if err != nil {
+			d.log.Error(err)
+			return err
+		}
+		if !proto.Equal(targetMeta.Namespace, ifMeta.Namespace) {
+			err = errors.Errorf("target interface %s of tc action is not in the namespace of interface %s",
+				iface, filter.Interface)
+			d.log.Error(err)
+			return err
+		}
+		targets[iface] = targetMeta.HostIfName
+	}
+
+	err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, filter.Interface, func(hostName string) error {
+		return d.tcHandler.ReplaceFilter(hostName, &linuxcalls.Filter{
+			Parent:   filter.Parent,
+			Priority: filter.Priority,
+			Protocol: filterProtocol(filter.Protocol),
+			Kind:     filterKind(filter),
+			Options:  filterOptions(filter, targets),
+		})
+	})
+	if err != nil {
+		d.log.Error(err)
+	}
+	return err
+}
+
+// actionInterfaces returns (deduplicated) target interfaces of the filter actions.
+func actionInterfaces(filter *linux_tc.Filter) (ifaces []string) {
+	listed := make(map[string]struct{})
+	for _, action := range filter.Actions {
+		if action.Interface == "" {
+			continue
+		}
+		if _, isListed := listed[action.Interface]; !isListed {
+			listed[action.Interface] = struct{}{}
+			ifaces = append(ifaces, action.Interface)
+		}
+	}
+	return ifaces
+}
+
+// filterKind returns kind of the filter as used by tc.
+func filterKind(filter *linux_tc.Filter) string {
+	switch filter.Classifier.(type) {
+	case *linux_tc.Filter_U32:
+		return "u32"
+	case *linux_tc.Filter_Flower:
+		return "flower"
+	}
+	return ""
+}
+
+// filterProtocol returns protocol of the filter as printed by tc.
+func filterProtocol(protocol linux_tc.Filter_Protocol) string {
+	switch protocol {
+	case linux_tc.Filter_IP:
+		return "ip"
+	case linux_tc.Filter_IPV6:
+		return "ipv6"
+	case linux_tc.Filter_ARP:
+		return "arp"
+	case linux_tc.Filter_VLAN_8021Q:
+		return "802.1Q"
+	}
+	return "all"
+}
+
+// filterOptions returns tc options of the filter - classifier, class and actions.
+// Targets map logical names of the action interfaces to host names.
+func filterOptions(filter *linux_tc.Filter, targets map[string]string) (opts []string) {
+	switch classifier := filter.Classifier.(type) {
+	case *linux_tc.Filter_U32:
+		opts = u32Options(classifier.U32)
+	case *linux_tc.Filter_Flower:
+		opts = flowerOptions(classifier.Flower)
+	}
+	if filter.ClassId != "" {
+		opts = append(opts, "classid", filter.ClassId)
+	}
+	for _, action := range filter.Actions {
+		switch action.Type {
+		case linux_tc.Filter_Action_PASS:
+			opts = append(opts, "action", "pass")
+		case linux_tc.Filter_Action_DROP:
+			opts = append(opts, "action", "drop")
+		case linux_tc.Filter_Action_MIRROR, linux_tc.Filter_Action_REDIRECT:
+			opts = append(opts, "action", "mirred",
+				strings.ToLower(action.Direction.String()),
+				strings.ToLower(action.Type.String()),
+				"dev", targets[action.Interface])
+		}
+	}
+	return opts
+}
+
+// u32Options returns tc options of the u32 classifier.
+func u32Options(u32 *linux_tc.Filter_U32Classifier) (opts []string) {
+	if len(u32.GetMatches()) == 0 {
+		// match all packets
+		return []string{"match", "u32", "0", "0"}
+	}
+	for _, match := range u32.Matches {
+		switch match.Field {
+		case linux_tc.Filter_U32Classifier_Match_IP_SRC:
+			opts = append(opts, "match", "ip", "src", match.Value)
+		case linux_tc.Filter_U32Classifier_Match_IP_DST:
+			opts = append(opts, "match", "ip", "dst", match.Value)
+		case linux_tc.Filter_U32Classifier_Match_IP_PROTO:
+			opts = append(opts, "match", "ip", "protocol", match.Value, "0xff")
+		case linux_tc.Filter_U32Classifier_Match_IP_SPORT:
+			opts = append(opts, "match", "ip", "sport", match.Value, "0xffff")
+		case linux_tc.Filter_U32Classifier_Match_IP_DPORT:
+			opts = append(opts, "match", "ip", "dport", match.Value, "0xffff")
+		case linux_tc.Filter_U32Classifier_Match_IP6_SRC:
+			opts = append(opts, "match", "ip6", "src", match.Value)
+		case linux_tc.Filter_U32Classifier_Match_IP6_DST:
+			opts = append(opts, "match", "ip6", "dst", match.Value)
+		case linux_tc.Filter_U32Classifier_Match_IP6_PROTO:
+			opts = append(opts, "match", "ip6", "protocol", match.Value, "0xff")
+		case linux_tc.Filter_U32Classifier_Match_IP6_SPORT:
+			opts = append(opts, "match", "ip6", "sport", match.Value, "0xffff")
+		case linux_tc.Filter_U32Classifier_Match_IP6_DPORT:
+			opts = append(opts, "match", "ip6", "dport", match.Value, "0xffff")
+		}
+	}
+	return opts
+}
+
+// flowerOptions returns tc options of the flower classifier.
+func flowerOptions(flower *linux_tc.Filter_FlowerClassifier) (opts []string) {
+	if flower.GetSrcMac() != "" {
+		opts = append(opts, "src_mac", flower.SrcMac)
+	}
+	if flower.GetDstMac() != "" {
+		opts = append(opts, "dst_mac", flower.DstMac)
+	}
+	if flower.GetVlanId() != 0 {
+		opts = append(opts, "vlan_id", strconv.FormatUint(uint64(flower.VlanId), 10))
+	}
+	if flower.GetIpProto() != linux_tc.Filter_FlowerClassifier_ANY {
+		opts = append(opts, "ip_proto", strings.ToLower(flower.IpProto.String()))
+	}
+	if flower.GetSrcIp() != "" {
+		opts = append(opts, "src_ip", flower.SrcIp)
+	}
+	if flower.GetDstIp() != "" {
+		opts = append(opts, "dst_ip", flower.DstIp)
+	}
+	if flower.GetSrcPort() != 0 {
+		opts = append(opts, "src_port", strconv.FormatUint(uint64(flower.SrcPort), 10))
+	}
+	if flower.GetDstPort() != 0 {
+		opts = append(opts, "dst_port", strconv.FormatUint(uint64(flower.DstPort), 10))
+	}
+	return opts
+}
+
+// filterFromTc returns the expected filter with the classifier, class and actions
+// read back from tc. Targets map host names of the action interfaces to logical names.
+// Filter which cannot be described by the model (e.g. matches unknown fields)
+// is returned without classifier, to be re-created.
+func filterFromTc(expected *linux_tc.Filter, tcFilter *linuxcalls.Filter,
+	targets map[string]string) *linux_tc.Filter {
+
+	filter := &linux_tc.Filter{
+		Interface: expected.Interface,
+		Parent:    expected.Parent,
+		Priority:  expected.Priority,
+		Protocol:  expected.Protocol,
+	}
+	classifierOpts, actionOpts := splitFilterOptions(tcFilter.Options)
+	values := optionValues(classifierOpts, "flowid", "classid", "src_mac", "dst_mac", "vlan_id",
+		"ip_proto", "src_ip", "dst_ip", "src_port", "dst_port", "eth_type", "not_in_hw", "in_hw",
+		"skip_hw", "skip_sw")
+	for _, classID := range [][]string{values["flowid"], values["classid"]} {
+		if len(classID) > 0 {
+			filter.ClassId = classID[0]
+		}
+	}
+	actions, supported := parseActions(actionOpts, targets)
+	if !supported {
+		return filter
+	}
+	filter.Actions = actions
+
+	switch tcFilter.Kind {
+	case "u32":
+		keys, err := parseU32Keys(classifierOpts)
+		if err != nil {
+			return filter
+		}
+		matches, err := u32Matches(expected.Protocol, keys)
+		if err != nil {
+			return filter
+		}
+		filter.Classifier = &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{Matches: matches}}
+
+	case "flower":
+		flower := &linux_tc.Filter_FlowerClassifier{}
+		if mac := values["src_mac"]; len(mac) > 0 {
+			flower.SrcMac = mac[0]
+		}
+		if mac := values["dst_mac"]; len(mac) > 0 {
+			flower.DstMac = mac[0]
+		}
+		if vlanID := values["vlan_id"]; len(vlanID) > 0 {
+			flower.VlanId, _ = parseUint(vlanID[0], "")
+		}
+		if ipProto := values["ip_proto"]; len(ipProto) > 0 {
+			value, known := linux_tc.Filter_FlowerClassifier_IpProto_value[strings.ToUpper(ipProto[0])]
+			if !known {
+				return filter
+			}
+			flower.IpProto = linux_tc.Filter_FlowerClassifier_IpProto(value)
+		}
+		if ip := values["src_ip"]; len(ip) > 0 {
+			flower.SrcIp = ip[0]
+		}
+		if ip := values["dst_ip"]; len(ip) > 0 {
+			flower.DstIp = ip[0]
+		}
+		if port := values["src_port"]; len(port) > 0 {
+			flower.SrcPort, _ = parseUint(port[0], "")
+		}
+		if port := values["dst_port"]; len(port) > 0 {
+			flower.DstPort, _ = parseUint(port[0], "")
+		}
+		filter.Classifier = &linux_tc.Filter_Flower{Flower: flower}
+	}
+	return filter
+}
+
+// splitFilterOptions splits options of the filter printed by tc into options
+// of the classifier and the actions.
+func splitFilterOptions(opts []string) (classifierOpts, actionOpts []string) {
+	for i, opt := range opts {
+		if opt == "action" {
+			return opts[:i], opts[i:]
+		}
+	}
+	return opts, nil
+}
+
+// parseActions parses actions printed by tc, e.g.:
+//
+//	action order 1: mirred (Egress Mirror to device veth2) pipe
+//	action order 2: gact action drop
+//
+// Returns false if some of the actions is not supported by the model.
+func parseActions(opts []string, targets map[string]string) (actions []*linux_tc.Filter_Action, supported bool) {
+	for i := 0; i+3 < len(opts); i++ {
+		if opts[i] != "action" || opts[i+1] != "order" {
+			continue
+		}
+		args := opts[i+4:]
+		switch opts[i+3] {
+		case "gact":
+			if len(args) < 2 || args[0] != "action" {
+				return nil, false
+			}
+			switch args[1] {
+			case "pass":
+				actions = append(actions, &linux_tc.Filter_Action{Type: linux_tc.Filter_Action_PASS})
+			case "drop":
+				actions = append(actions, &linux_tc.Filter_Action{Type: linux_tc.Filter_Action_DROP})
+			default:
+				return nil, false
+			}
+		case "mirred":
+			// (Egress Mirror to device veth2)
+			if len(args) < 5 {
+				return nil, false
+			}
+			direction, dirKnown := linux_tc.Filter_Action_Direction_value[strings.ToUpper(strings.TrimPrefix(args[0], "("))]
+			actionType, typeKnown := linux_tc.Filter_Action_Type_value[strings.ToUpper(args[1])]
+			if !dirKnown || !typeKnown {
+				return nil, false
+			}
+			iface := strings.TrimSuffix(args[4], ")")
+			if logicalName, known := targets[iface]; known {
+				iface = logicalName
+			}
+			actions = append(actions, &linux_tc.Filter_Action{
+				Type:      linux_tc.Filter_Action_Type(actionType),
+				Direction: linux_tc.Filter_Action_Direction(direction),
+				Interface: iface,
+			})
+		default:
+			return nil, false
+		}
+	}
+	return actions, true
+}
+
+// u32Key is a 32-bit key matched by the u32 classifier at the given offset of the IP header.
+type u32Key struct {
+	value  uint32
+	mask   uint32
+	offset int
+}
+
+// offsets of the fields matched by the u32 classifier in the IP header
+// (ports are matched assuming IPv4 header without options)
+const (
+	ipProtoOffset  = 9
+	ipSrcOffset    = 12
+	ipDstOffset    = 16
+	ipSportOffset  = 20
+	ipDportOffset  = 22
+	ip6ProtoOffset = 6
+	ip6SrcOffset   = 8
+	ip6DstOffset   = 24
+	ip6SportOffset = 40
+	ip6DportOffset = 42
+)
+
+// u32Keys returns keys matched by the u32 matches (ordered by offset).
+// Keys at the same offset are merged the same way as by tc.
+func u32Keys(matches []*linux_tc.Filter_U32Classifier_Match) (keys []u32Key, err error) {
+	addKey := func(value, mask uint32, offset int) {
+		for i := range keys {
+			if keys[i].offset == offset {
+				keys[i].value |= value & mask
+				keys[i].mask |= mask
+				return
+			}
+		}
+		keys = append(keys, u32Key{value: value & mask, mask: mask, offset: offset})
+	}
+	// addField adds key matching 8-bit or 16-bit field at the given offset
+	addField := func(value string, bits uint, offset int) error {
+		number, err := strconv.ParseUint(value, 10, int(bits))
+		if err != nil {
+			return err
+		}
+		shift := 32 - bits - uint(offset%4)*8
+		addKey(uint32(number)<<shift, (1<<bits-1)<<shift, offset-offset%4)
+		return nil
+	}
+	// addAddress adds keys matching IP address or network at the given offset
+	addAddress := func(value string, offset int) error {
+		ipNet, err := parseIPNet(value)
+		if err != nil {
+			return err
+		}
+		ip := ipNet.IP.To4()
+		if ip == nil {
+			ip = ipNet.IP.To16()
+		}
+		for i := 0; i < len(ip); i += 4 {
+			mask := binary.BigEndian.Uint32(ipNet.Mask[i:])
+			if mask != 0 {
+				addKey(binary.BigEndian.Uint32(ip[i:]), mask, offset+i)
+			}
+		}
+		return nil
+	}
+
+	for _, match := range matches {
+		switch match.Field {
+		case linux_tc.Filter_U32Classifier_Match_IP_SRC:
+			err = addAddress(match.Value, ipSrcOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP_DST:
+			err = addAddress(match.Value, ipDstOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP_PROTO:
+			err = addField(match.Value, 8, ipProtoOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP_SPORT:
+			err = addField(match.Value, 16, ipSportOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP_DPORT:
+			err = addField(match.Value, 16, ipDportOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP6_SRC:
+			err = addAddress(match.Value, ip6SrcOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP6_DST:
+			err = addAddress(match.Value, ip6DstOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP6_PROTO:
+			err = addField(match.Value, 8, ip6ProtoOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP6_SPORT:
+			err = addField(match.Value, 16, ip6SportOffset)
+		case linux_tc.Filter_U32Classifier_Match_IP6_DPORT:
+			err = addField(match.Value, 16, ip6DportOffset)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].offset < keys[j].offset })
+	return keys, nil
+}
+
+// equalU32Keys returns true if both lists of keys match the same packets.
+func equalU32Keys(keys1, keys2 []u32Key) bool {
+	keys1, keys2 = nonEmptyU32Keys(keys1), nonEmptyU32Keys(keys2)
+	if len(keys1) != len(keys2) {
+		return false
+	}
+	for i := range keys1 {
+		if keys1[i] != keys2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// nonEmptyU32Keys returns keys with non-zero mask (ordered by offset),
+// keys with zero mask match all packets.
+func nonEmptyU32Keys(keys []u32Key) (nonEmpty []u32Key) {
+	for _, key := range keys {
+		if key.mask != 0 {
+			nonEmpty = append(nonEmpty, u32Key{value: key.value & key.mask, mask: key.mask, offset: key.offset})
+		}
+	}
+	sort.Slice(nonEmpty, func(i, j int) bool { return nonEmpty[i].offset < nonEmpty[j].offset })
+	return nonEmpty
+}
+
+// parseU32Keys parses keys of the u32 classifier printed by tc, e.g.:
+//
+//	match 0a000001/ffffffff at 12
+func parseU32Keys(opts []string) (keys []u32Key, err error) {
+	for i := 0; i+3 < len(opts); i++ {
+		if opts[i] != "match" || opts[i+2] != "at" {
+			continue
+		}
+		valueMask := strings.Split(opts[i+1], "/")
+		if len(valueMask) != 2 {
+			return nil, errors.Errorf("invalid u32 key: %s", opts[i+1])
+		}
+		value, err := strconv.ParseUint(valueMask[0], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		mask, err := strconv.ParseUint(valueMask[1], 16, 32)
+		if err != nil {
+			return nil, err
+		}
+		// offsets relative to the next header are not used by the model
+		offset, err := strconv.Atoi(opts[i+3])
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, u32Key{value: uint32(value), mask: uint32(mask), offset: offset})
+	}
+	return keys, nil
+}
+
+// u32Matches returns u32 matches (ordered by field) matching the given keys.
+func u32Matches(protocol linux_tc.Filter_Protocol, keys []u32Key) (
+	matches []*linux_tc.Filter_U32Classifier_Match, err error) {
+
+	// fields of the protocol and their offsets
+	srcField, dstField := linux_tc.Filter_U32Classifier_Match_IP_SRC, linux_tc.Filter_U32Classifier_Match_IP_DST
+	protoField := linux_tc.Filter_U32Classifier_Match_IP_PROTO
+	sportField, dportField := linux_tc.Filter_U32Classifier_Match_IP_SPORT, linux_tc.Filter_U32Classifier_Match_IP_DPORT
+	addrLen, srcOffset, dstOffset, protoOffset, portsOffset := net.IPv4len, ipSrcOffset, ipDstOffset,
+		ipProtoOffset, ipSportOffset
+	if protocol == linux_tc.Filter_IPV6 {
+		srcField, dstField = linux_tc.Filter_U32Classifier_Match_IP6_SRC, linux_tc.Filter_U32Classifier_Match_IP6_DST
+		protoField = linux_tc.Filter_U32Classifier_Match_IP6_PROTO
+		sportField, dportField = linux_tc.Filter_U32Classifier_Match_IP6_SPORT,
+			linux_tc.Filter_U32Classifier_Match_IP6_DPORT
+		addrLen, srcOffset, dstOffset, protoOffset, portsOffset = net.IPv6len, ip6SrcOffset, ip6DstOffset,
+			ip6ProtoOffset, ip6SportOffset
+	}
+	protoShift := 32 - 8 - uint(protoOffset%4)*8
+
+	values := make(map[linux_tc.Filter_U32Classifier_Match_Field]string)
+	src, dst := make([]byte, 2*addrLen), make([]byte, 2*addrLen)
+	for _, key := range nonEmptyU32Keys(keys) {
+		if protocol != linux_tc.Filter_IP && protocol != linux_tc.Filter_IPV6 {
+			return nil, errors.Errorf("u32 key at offset %d not supported for the protocol", key.offset)
+		}
+		switch {
+		case key.offset >= srcOffset && key.offset < srcOffset+addrLen:
+			// address and mask of the source network
+			binary.BigEndian.PutUint32(src[key.offset-srcOffset:], key.value)
+			binary.BigEndian.PutUint32(src[addrLen+key.offset-srcOffset:], key.mask)
+		case key.offset >= dstOffset && key.offset < dstOffset+addrLen:
+			binary.BigEndian.PutUint32(dst[key.offset-dstOffset:], key.value)
+			binary.BigEndian.PutUint32(dst[addrLen+key.offset-dstOffset:], key.mask)
+		case key.offset == protoOffset-protoOffset%4 && key.mask == 0xff<<protoShift:
+			values[protoField] = strconv.FormatUint(uint64(key.value>>protoShift), 10)
+		case key.offset == portsOffset && (key.mask>>16 == 0 || key.mask>>16 == 0xffff) && (key.mask&0xffff == 0 || key.mask&0xffff == 0xffff):
+			if key.mask>>16 != 0 {
+				values[sportField] = strconv.FormatUint(uint64(key.value>>16), 10)
+			}
+			if key.mask&0xffff != 0 {
+				values[dportField] = strconv.FormatUint(uint64(key.value&0xffff), 10)
+			}
+		default:
+			return nil, errors.Errorf("u32 key at offset %d not supported", key.offset)
+		}
+	}
+	for field, network := range map[linux_tc.Filter_U32Classifier_Match_Field][]byte{srcField: src, dstField: dst} {
+		mask := net.IPMask(network[addrLen:])
+		ones, bits := mask.Size()
+		if bits == 0 {
+			return nil, errors.Errorf("u32 keys do not match a network")
+		}
+		if ones == 0 {
+			continue
+		}
+		values[field] = ipNetString(&net.IPNet{IP: net.IP(network[:addrLen]), Mask: mask})
+	}
+
+	for field, value := range values {
+		matches = append(matches, &linux_tc.Filter_U32Classifier_Match{Field: field, Value: value})
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Field < matches[j].Field })
+	return matches, nil
+}
+
+// normalizeFlower returns flower classifier with addresses in the form printed by tc.
+func normalizeFlower(flower *linux_tc.Filter_FlowerClassifier) *linux_tc.Filter_FlowerClassifier {
+	if flower == nil {
+		return nil
+	}
+	flower = proto.Clone(flower).(*linux_tc.Filter_FlowerClassifier)
+	for _, mac := range []*string{&flower.SrcMac, &flower.DstMac} {
+		if hwAddr, err := net.ParseMAC(*mac); err == nil {
+			*mac = hwAddr.String()
+		}
+	}
+	for _, ip := range []*string{&flower.SrcIp, &flower.DstIp} {
+		if ipNet, err := parseIPNet(*ip); err == nil {
+			*ip = ipNetString(ipNet)
+		}
+	}
+	return flower
+}
+
+// parseIPNet parses IP address (as host network) or network.
+func parseIPNet(value string) (*net.IPNet, error) {
+	if ip := net.ParseIP(value); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(value)
+	return ipNet, err
+}
+
+// ipNetString returns network in the form printed by tc (host addresses without prefix length).
+func ipNetString(ipNet *net.IPNet) string {
+	ip := ipNet.IP.Mask(ipNet.Mask)
+	if ones, bits := ipNet.Mask.Size(); ones == bits {
+		return ip.String()
+	}
+	return (&net.IPNet{IP: ip, Mask: ipNet.Mask}).String()
+}
+
+// isIPOfFamily returns true if the value is an IP address or network of the given family.
+func isIPOfFamily(value string, ipv6 bool) bool {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		var err error
+		ip, _, err = net.ParseCIDR(value)
+		if err != nil {
+			return false
+		}
+	}
+	return (ip.To4() == nil) == ipv6
+}
+
+// isNumberInRange returns true if the value is a decimal number within the given range.
+func isNumberInRange(value string, min, max uint64) bool {
+	number, err := strconv.ParseUint(value, 10, 64)
+	return err == nil && number >= min && number <= max
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+// u32Match is a shortcut for the u32 match.
+func u32Match(field linux_tc.Filter_U32Classifier_Match_Field, value string) *linux_tc.Filter_U32Classifier_Match {
+	return &linux_tc.Filter_U32Classifier_Match{Field: field, Value: value}
+}
+
+func TestFilterFromTc(t *testing.T) {
+	mirror := &linux_tc.Filter_Action{
+		Type:      linux_tc.Filter_Action_MIRROR,
+		Interface: "tap2",
+	}
+	tests := []struct {
+		name       string
+		expected   *linux_tc.Filter
+		tcFilter   *linuxcalls.Filter
+		tcOptions  string // as printed by "tc filter show", without the first line
+		retrieved  *linux_tc.Filter
+		equivalent bool
+	}{
+		{
+			name: "u32 with reordered matches",
+			expected: &linux_tc.Filter{Protocol: linux_tc.Filter_IP, ClassId: "1:10",
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{
+					Matches: []*linux_tc.Filter_U32Classifier_Match{
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_DPORT, "80"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_PROTO, "6"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_DST, "10.0.0.5/24"),
+					},
+				}},
+				Actions: []*linux_tc.Filter_Action{mirror},
+			},
+			tcFilter: &linuxcalls.Filter{Kind: "u32", Protocol: "ip", Handle: "800::800"},
+			tcOptions: `order 2048 key ht 800 bkt 0 flowid 1:10 not_in_hw
+  match 0a000000/ffffff00 at 16
+  match 00060000/00ff0000 at 8
+  match 00000050/0000ffff at 20
+	action order 1: mirred (Egress Mirror to device veth2) pipe
+	index 1 ref 1 bind 1`,
+			retrieved: &linux_tc.Filter{Protocol: linux_tc.Filter_IP, ClassId: "1:10",
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{
+					Matches: []*linux_tc.Filter_U32Classifier_Match{
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_DST, "10.0.0.0/24"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_PROTO, "6"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_DPORT, "80"),
+					},
+				}},
+				Actions: []*linux_tc.Filter_Action{mirror},
+			},
+			equivalent: true,
+		},
+		{
+			name: "u32 with merged ports",
+			expected: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{
+					Matches: []*linux_tc.Filter_U32Classifier_Match{
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_SRC, "192.168.1.1"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_SPORT, "22"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_DPORT, "8080"),
+					},
+				}},
+			},
+			tcFilter: &linuxcalls.Filter{Kind: "u32", Protocol: "ip", Handle: "800::800"},
+			tcOptions: `order 2048 key ht 800 bkt 0 not_in_hw
+  match c0a80101/ffffffff at 12
+  match 00161f90/ffffffff at 20`,
+			retrieved: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{
+					Matches: []*linux_tc.Filter_U32Classifier_Match{
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_SRC, "192.168.1.1"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_SPORT, "22"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_DPORT, "8080"),
+					},
+				}},
+			},
+			equivalent: true,
+		},
+		{
+			name: "u32 with IPv6 network",
+			expected: &linux_tc.Filter{Protocol: linux_tc.Filter_IPV6, ClassId: "1:20",
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{
+					Matches: []*linux_tc.Filter_U32Classifier_Match{
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP6_DST, "fd00::/64"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP6_PROTO, "17"),
+					},
+				}},
+			},
+			tcFilter: &linuxcalls.Filter{Kind: "u32", Protocol: "ipv6", Handle: "801::800"},
+			tcOptions: `order 2048 key ht 801 bkt 0 flowid 1:20 not_in_hw
+  match fd000000/ffffffff at 24
+  match 00000000/ffffffff at 28
+  match 00001100/0000ff00 at 4`,
+			retrieved: &linux_tc.Filter{Protocol: linux_tc.Filter_IPV6, ClassId: "1:20",
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{
+					Matches: []*linux_tc.Filter_U32Classifier_Match{
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP6_DST, "fd00::/64"),
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP6_PROTO, "17"),
+					},
+				}},
+			},
+			equivalent: true,
+		},
+		{
+			name: "u32 matching all packets",
+			expected: &linux_tc.Filter{
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{}},
+				Actions:    []*linux_tc.Filter_Action{{Type: linux_tc.Filter_Action_DROP}},
+			},
+			tcFilter: &linuxcalls.Filter{Kind: "u32", Protocol: "all", Handle: "800::800"},
+			tcOptions: `order 2048 key ht 800 bkt 0 not_in_hw
+  match 00000000/00000000 at 0
+	action order 1: gact action drop
+	 random type none pass val 0
+	 index 1 ref 1 bind 1`,
+			retrieved: &linux_tc.Filter{
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{}},
+				Actions:    []*linux_tc.Filter_Action{{Type: linux_tc.Filter_Action_DROP}},
+			},
+			equivalent: true,
+		},
+		{
+			name: "u32 with changed match",
+			expected: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{
+					Matches: []*linux_tc.Filter_U32Classifier_Match{
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_DST, "10.0.0.1"),
+					},
+				}},
+			},
+			tcFilter: &linuxcalls.Filter{Kind: "u32", Protocol: "ip", Handle: "800::800"},
+			tcOptions: `order 2048 key ht 800 bkt 0 not_in_hw
+  match 0a000002/ffffffff at 16`,
+			retrieved: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{
+					Matches: []*linux_tc.Filter_U32Classifier_Match{
+						u32Match(linux_tc.Filter_U32Classifier_Match_IP_DST, "10.0.0.2"),
+					},
+				}},
+			},
+		},
+		{
+			name: "u32 with key not described by the model",
+			expected: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_U32{U32: &linux_tc.Filter_U32Classifier{}},
+			},
+			tcFilter: &linuxcalls.Filter{Kind: "u32", Protocol: "ip", Handle: "800::800"},
+			tcOptions: `order 2048 key ht 800 bkt 0 not_in_hw
+  match 40000000/ff000000 at 8`,
+			retrieved: &linux_tc.Filter{Protocol: linux_tc.Filter_IP},
+		},
+		{
+			name: "flower",
+			expected: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerClassifier{
+					SrcMac:  "AA:BB:CC:DD:EE:FF",
+					IpProto: linux_tc.Filter_FlowerClassifier_TCP,
+					DstIp:   "10.0.0.1/32",
+					DstPort: 80,
+				}},
+				Actions: []*linux_tc.Filter_Action{{
+					Type:      linux_tc.Filter_Action_REDIRECT,
+					Interface: "tap2",
+				}},
+			},
+			tcFilter: &linuxcalls.Filter{Kind: "flower", Protocol: "ip", Handle: "0x1"},
+			tcOptions: `src_mac aa:bb:cc:dd:ee:ff
+  eth_type ipv4
+  ip_proto tcp
+  dst_ip 10.0.0.1
+  dst_port 80
+  not_in_hw
+	action order 1: mirred (Egress Redirect to device veth2) stolen
+	index 1 ref 1 bind 1`,
+			retrieved: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerClassifier{
+					SrcMac:  "aa:bb:cc:dd:ee:ff",
+					IpProto: linux_tc.Filter_FlowerClassifier_TCP,
+					DstIp:   "10.0.0.1",
+					DstPort: 80,
+				}},
+				Actions: []*linux_tc.Filter_Action{{
+					Type:      linux_tc.Filter_Action_REDIRECT,
+					Interface: "tap2",
+				}},
+			},
+			equivalent: true,
+		},
+		{
+			name: "flower with action to another interface",
+			expected: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerClassifier{
+					IpProto: linux_tc.Filter_FlowerClassifier_UDP,
+				}},
+				Actions: []*linux_tc.Filter_Action{mirror},
+			},
+			tcFilter: &linuxcalls.Filter{Kind: "flower", Protocol: "ip", Handle: "0x1"},
+			tcOptions: `eth_type ipv4
+  ip_proto udp
+	action order 1: mirred (Ingress Mirror to device veth3) pipe`,
+			retrieved: &linux_tc.Filter{Protocol: linux_tc.Filter_IP,
+				Classifier: &linux_tc.Filter_Flower{Flower: &linux_tc.Filter_FlowerClassifier{
+					IpProto: linux_tc.Filter_FlowerClassifier_UDP,
+				}},
+				Actions: []*linux_tc.Filter_Action{{
+					Type:      linux_tc.Filter_Action_MIRROR,
+					Direction: linux_tc.Filter_Action_INGRESS,
+					Interface: "veth3",
+				}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			test.tcFilter.Options = strings.Fields(test.tcOptions)
+			targets := map[string]string{"veth2": "tap2"}
+			retrieved := filterFromTc(test.expected, test.tcFilter, targets)
+			Expect(proto.Equal(retrieved, test.retrieved)).To(BeTrue(), "retrieved: %v", retrieved)
+
+			descriptor := &FilterDescriptor{}
+			Expect(descriptor.EquivalentFilters("", retrieved, test.expected)).To(Equal(test.equivalent))
+		})
+	}
+}
+
+func TestU32Keys(t *testing.T) {
+	RegisterTestingT(t)
+
+	keys, err := u32Keys([]*linux_tc.Filter_U32Classifier_Match{
+		u32Match(linux_tc.Filter_U32Classifier_Match_IP_DPORT, "80"),
+		u32Match(linux_tc.Filter_U32Classifier_Match_IP_SRC, "10.1.2.3/16"),
+		u32Match(linux_tc.Filter_U32Classifier_Match_IP_PROTO, "17"),
+		u32Match(linux_tc.Filter_U32Classifier_Match_IP_SPORT, "22"),
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(keys).To(Equal([]u32Key{
+		{value: 0x00110000, mask: 0x00ff0000, offset: 8},
+		{value: 0x0a010000, mask: 0xffff0000, offset: 12},
+		{value: 0x00160050, mask: 0xffffffff, offset: 20},
+	}))
+
+	keys, err = u32Keys([]*linux_tc.Filter_U32Classifier_Match{
+		u32Match(linux_tc.Filter_U32Classifier_Match_IP6_SRC, "fd00:1::/48"),
+		u32Match(linux_tc.Filter_U32Classifier_Match_IP6_PROTO, "6"),
+		u32Match(linux_tc.Filter_U32Classifier_Match_IP6_DPORT, "443"),
+	})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(keys).To(Equal([]u32Key{
+		{value: 0x00000600, mask: 0x0000ff00, offset: 4},
+		{value: 0xfd000001, mask: 0xffffffff, offset: 8},
+		{value: 0x00000000, mask: 0xffff0000, offset: 12},
+		{value: 0x000001bb, mask: 0x0000ffff, offset: 40},
+	}))
+
+	_, err = u32Keys([]*linux_tc.Filter_U32Classifier_Match{
+		u32Match(linux_tc.Filter_U32Classifier_Match_IP_SPORT, "65536"),
+	})
+	Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,596 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strconv"
+	"strings"
+
+	prototypes "github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	ifmodel "go.ligato.io/vpp-agent/v3/proto/ligato/linux/interfaces"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+const (
+	// QdiscDescriptorName is the name of the descriptor for Linux qdiscs.
+	QdiscDescriptorName = "linux-tc-qdisc-descriptor"
+
+	// default maximum latency of the TBF qdisc (if limit is not set either)
+	defaultTbfLatencyUs = 50000
+
+	// defaults applied by the kernel
+	defaultHtbR2Q          = 10
+	defaultFqCodelLimit    = 10240
+	defaultFqCodelFlows    = 1024
+	defaultFqCodelTargetUs = 5000
+	defaultFqCodelInterval = 100000
+	defaultNetemLimit      = 1000
+)
+
+// A list of non-retriable errors:
+var (
+	// ErrQdiscWithoutInterface is returned when the qdisc is defined without interface.
+	ErrQdiscWithoutInterface = errors.New("qdisc defined without interface")
+
+	// ErrInvalidQdiscParent is returned when the parent of the qdisc is not valid.
+	ErrInvalidQdiscParent = errors.New("qdisc parent must be \"root\", \"ingress\" or class ID")
+
+	// ErrInvalidQdiscHandle is returned when the handle of the qdisc is not valid.
+	ErrInvalidQdiscHandle = errors.New("invalid qdisc handle (expected format <major>:, e.g. \"1:\")")
+
+	// ErrQdiscWithoutType is returned when the type of the (non-ingress) qdisc is not defined.
+	ErrQdiscWithoutType = errors.New("qdisc defined without type")
+
+	// ErrIngressQdiscWithType is returned when the type or handle of the ingress qdisc is defined.
+	ErrIngressQdiscWithType = errors.New("type and handle are not applicable to the ingress qdisc")
+
+	// ErrTbfWithoutRate is returned when the TBF qdisc is defined without rate or burst.
+	ErrTbfWithoutRate = errors.New("TBF qdisc requires rate and burst")
+
+	// ErrTbfLatencyWithLimit is returned when both latency and limit of the TBF qdisc are defined.
+	ErrTbfLatencyWithLimit = errors.New("latency and limit of TBF qdisc are mutually exclusive")
+
+	// ErrInvalidNetemProbability is returned when a netem probability or correlation is out of range.
+	ErrInvalidNetemProbability = errors.New("netem probabilities and correlations must be between 0 and 100")
+
+	// ErrNetemDependentAttr is returned when a netem attribute is defined without the attribute it depends on.
+	ErrNetemDependentAttr = errors.New("netem attribute requires its base attribute to be defined " +
+		"(jitter and reorder require delay, correlations require the corresponding probability)")
+)
+
+// QdiscDescriptor teaches KVScheduler how to configure Linux qdiscs.
+type QdiscDescriptor struct {
+	log       logging.Logger
+	tcHandler linuxcalls.TCAPI
+	ifPlugin  ifplugin.API
+	nsPlugin  nsplugin.API
+}
+
+// NewQdiscDescriptor creates a new instance of the Qdisc descriptor.
+func NewQdiscDescriptor(tcHandler linuxcalls.TCAPI, ifPlugin ifplugin.API, nsPlugin nsplugin.API,
+	log logging.PluginLogger) *kvs.KVDescriptor {
+
+	ctx := &QdiscDescriptor{
+		tcHandler: tcHandler,
+		ifPlugin:  ifPlugin,
+		nsPlugin:  nsPlugin,
+		log:       log.NewLogger("qdisc-descriptor"),
+	}
+
+	typedDescr := &adapter.QdiscDescriptor{
+		Name:                 QdiscDescriptorName,
+		NBKeyPrefix:          linux_tc.ModelQdisc.KeyPrefix(),
+		ValueTypeName:        linux_tc.ModelQdisc.ProtoName(),
+		KeySelector:          linux_tc.ModelQdisc.IsKeyValid,
+		KeyLabel:             linux_tc.ModelQdisc.StripKeyPrefix,
+		ValueComparator:      ctx.EquivalentQdiscs,
+		Validate:             ctx.Validate,
+		Create:               ctx.Create,
+		Delete:               ctx.Delete,
+		Update:               ctx.Update,
+		UpdateWithRecreate:   ctx.UpdateWithRecreate,
+		Retrieve:             ctx.Retrieve,
+		DerivedValues:        ctx.DerivedValues,
+		Dependencies:         ctx.Dependencies,
+		RetrieveDependencies: []string{ifdescriptor.InterfaceDescriptorName},
+	}
+	return adapter.NewQdiscDescriptor(typedDescr)
+}
+
+// EquivalentQdiscs compares qdiscs with the defaults applied by the kernel
+// and in the precision printed by tc.
+func (d *QdiscDescriptor) EquivalentQdiscs(key string, oldQdisc, newQdisc *linux_tc.Qdisc) bool {
+	if oldQdisc.Interface != newQdisc.Interface || qdiscParent(oldQdisc) != qdiscParent(newQdisc) ||
+		oldQdisc.Handle != newQdisc.Handle || qdiscKind(oldQdisc) != qdiscKind(newQdisc) {
+		return false
+	}
+	switch qdiscType := newQdisc.Type.(type) {
+	case *linux_tc.Qdisc_Htb:
+		return equivalentHtbQdiscs(oldQdisc.GetHtb(), qdiscType.Htb)
+	case *linux_tc.Qdisc_Tbf:
+		return equivalentTbfQdiscs(oldQdisc.GetTbf(), qdiscType.Tbf)
+	case *linux_tc.Qdisc_FqCodel:
+		return equivalentFqCodelQdiscs(oldQdisc.GetFqCodel(), qdiscType.FqCodel)
+	case *linux_tc.Qdisc_Netem:
+		return equivalentNetemQdiscs(oldQdisc.GetNetem(), qdiscType.Netem)
+	}
+	return true
+}
+
+// equivalentHtbQdiscs compares attributes of HTB qdiscs.
+func equivalentHtbQdiscs(oldHtb, newHtb *linux_tc.Qdisc_HtbQdisc) bool {
+	return oldHtb.GetDefaultClass() == newHtb.GetDefaultClass() &&
+		valueOrDefault(oldHtb.GetR2Q(), defaultHtbR2Q) == valueOrDefault(newHtb.GetR2Q(), defaultHtbR2Q)
+}
+
+// equivalentTbfQdiscs compares attributes of TBF qdiscs.
+func equivalentTbfQdiscs(oldTbf, newTbf *linux_tc.Qdisc_TbfQdisc) bool {
+	return equalRates(oldTbf.GetRate(), newTbf.GetRate()) && oldTbf.GetBurst() == newTbf.GetBurst() &&
+		oldTbf.GetLatencyUs() == newTbf.GetLatencyUs() && oldTbf.GetLimit() == newTbf.GetLimit()
+}
+
+// equivalentFqCodelQdiscs compares attributes of FQ_CODEL qdiscs.
+// Default quantum depends on MTU of the interface, undefined quantum
+// is therefore equivalent to any.
+func equivalentFqCodelQdiscs(oldFqCodel, newFqCodel *linux_tc.Qdisc_FqCodelQdisc) bool {
+	oldQuantum, newQuantum := oldFqCodel.GetQuantum(), newFqCodel.GetQuantum()
+	return valueOrDefault(oldFqCodel.GetLimit(), defaultFqCodelLimit) ==
+		valueOrDefault(newFqCodel.GetLimit(), defaultFqCodelLimit) &&
+		valueOrDefault(oldFqCodel.GetFlows(), defaultFqCodelFlows) ==
+			valueOrDefault(newFqCodel.GetFlows(), defaultFqCodelFlows) &&
+		equalTimes(valueOrDefault(oldFqCodel.GetTargetUs(), defaultFqCodelTargetUs),
+			valueOrDefault(newFqCodel.GetTargetUs(), defaultFqCodelTargetUs)) &&
+		equalTimes(valueOrDefault(oldFqCodel.GetIntervalUs(), defaultFqCodelInterval),
+			valueOrDefault(newFqCodel.GetIntervalUs(), defaultFqCodelInterval)) &&
+		(oldQuantum == newQuantum || oldQuantum == 0 || newQuantum == 0) &&
+		oldFqCodel.GetEcn() == newFqCodel.GetEcn()
+}
+
+// equivalentNetemQdiscs compares attributes of netem qdiscs.
+func equivalentNetemQdiscs(oldNetem, newNetem *linux_tc.Qdisc_NetemQdisc) bool {
+	return valueOrDefault(oldNetem.GetLimit(), defaultNetemLimit) ==
+		valueOrDefault(newNetem.GetLimit(), defaultNetemLimit) &&
+		equalTimes(oldNetem.GetDelayUs(), newNetem.GetDelayUs()) &&
+		equalTimes(oldNetem.GetJitterUs(), newNetem.GetJitterUs()) &&
+		equalPercents(oldNetem.GetDelayCorrelation(), newNetem.GetDelayCorrelation()) &&
+		equalPercents(oldNetem.GetLoss(), newNetem.GetLoss()) &&
+		equalPercents(oldNetem.GetLossCorrelation(), newNetem.GetLossCorrelation()) &&
+		equalPercents(oldNetem.GetDuplicate(), newNetem.GetDuplicate()) &&
+		equalPercents(oldNetem.GetReorder(), newNetem.GetReorder()) &&
+		equalPercents(oldNetem.GetReorderCorrelation(), newNetem.GetReorderCorrelation()) &&
+		equalPercents(oldNetem.GetCorrupt(), newNetem.GetCorrupt()) &&
+		equalRates(oldNetem.GetRate(), newNetem.GetRate())
+}
+
+// Validate validates qdisc configuration.
+func (d *QdiscDescriptor) Validate(key string, qdisc *linux_tc.Qdisc) error {
+	if qdisc.Interface == "" {
+		return kvs.NewInvalidValueError(ErrQdiscWithoutInterface, "interface")
+	}
+	parent := qdiscParent(qdisc)
+	if parent != linux_tc.RootParent && parent != linux_tc.IngressParent && !isClassID(parent) {
+		return kvs.NewInvalidValueError(ErrInvalidQdiscParent, "parent")
+	}
+	if parent == linux_tc.IngressParent {
+		if qdisc.Type != nil || qdisc.Handle != "" {
+			return kvs.NewInvalidValueError(ErrIngressQdiscWithType, "parent", "handle", "type")
+		}
+		return nil
+	}
+	if qdisc.Handle != "" && !isHandle(qdisc.Handle) {
+		return kvs.NewInvalidValueError(ErrInvalidQdiscHandle, "handle")
+	}
+
+	switch qdiscType := qdisc.Type.(type) {
+	case nil:
+		return kvs.NewInvalidValueError(ErrQdiscWithoutType, "type")
+	case *linux_tc.Qdisc_Tbf:
+		if qdiscType.Tbf.GetRate() == 0 || qdiscType.Tbf.GetBurst() == 0 {
+			return kvs.NewInvalidValueError(ErrTbfWithoutRate, "tbf.rate", "tbf.burst")
+		}
+		if qdiscType.Tbf.LatencyUs != 0 && qdiscType.Tbf.Limit != 0 {
+			return kvs.NewInvalidValueError(ErrTbfLatencyWithLimit, "tbf.latency_us", "tbf.limit")
+		}
+	case *linux_tc.Qdisc_Netem:
+		return validateNetem(qdiscType.Netem)
+	}
+	return nil
+}
+
+// validateNetem validates attributes of the netem qdisc.
+func validateNetem(netem *linux_tc.Qdisc_NetemQdisc) error {
+	if netem == nil {
+		return nil
+	}
+	percents := []struct {
+		value float32
+		field string
+	}{
+		{netem.DelayCorrelation, "netem.delay_correlation"},
+		{netem.Loss, "netem.loss"},
+		{netem.LossCorrelation, "netem.loss_correlation"},
+		{netem.Duplicate, "netem.duplicate"},
+		{netem.Reorder, "netem.reorder"},
+		{netem.ReorderCorrelation, "netem.reorder_correlation"},
+		{netem.Corrupt, "netem.corrupt"},
+	}
+	for _, p := range percents {
+		if !isPercent(p.value) {
+			return kvs.NewInvalidValueError(ErrInvalidNetemProbability, p.field)
+		}
+	}
+	if netem.JitterUs != 0 && netem.DelayUs == 0 {
+		return kvs.NewInvalidValueError(ErrNetemDependentAttr, "netem.jitter_us", "netem.delay_us")
+	}
+	if netem.DelayCorrelation != 0 && netem.JitterUs == 0 {
+		return kvs.NewInvalidValueError(ErrNetemDependentAttr, "netem.delay_correlation", "netem.jitter_us")
+	}
+	if netem.LossCorrelation != 0 && netem.Loss == 0 {
+		return kvs.NewInvalidValueError(ErrNetemDependentAttr, "netem.loss_correlation", "netem.loss")
+	}
+	if netem.Reorder != 0 && netem.DelayUs == 0 {
+		return kvs.NewInvalidValueError(ErrNetemDependentAttr, "netem.reorder", "netem.delay_us")
+	}
+	if netem.ReorderCorrelation != 0 && netem.Reorder == 0 {
+		return kvs.NewInvalidValueError(ErrNetemDependentAttr, "netem.reorder_correlation", "netem.reorder")
+	}
+	return nil
+}
+
+// Create attaches the qdisc to the interface (replacing the qdisc attached
+// at the same position, e.g. the default root qdisc).
+func (d *QdiscDescriptor) Create(key string, qdisc *linux_tc.Qdisc) (metadata interface{}, err error) {
+	err = d.replaceQdisc(qdisc)
+	return nil, err
+}
+
+// Delete removes the qdisc from the interface (the kernel re-attaches the default
+// root qdisc).
+func (d *QdiscDescriptor) Delete(key string, qdisc *linux_tc.Qdisc, metadata interface{}) error {
+	err := inInterfaceNamespace(d.ifPlugin, d.nsPlugin, qdisc.Interface, func(hostName string) error {
+		return d.tcHandler.DeleteQdisc(hostName, qdiscParent(qdisc))
+	})
+	if err != nil {
+		d.log.Error(err)
+	}
+	return err
+}
+
+// Update changes attributes of the qdisc.
+func (d *QdiscDescriptor) Update(key string, oldQdisc, newQdisc *linux_tc.Qdisc, oldMetadata interface{}) (
+	newMetadata interface{}, err error) {
+	err = d.replaceQdisc(newQdisc)
+	return nil, err
+}
+
+// UpdateWithRecreate returns true if the type or the handle of the qdisc has changed.
+// Classes and filters of the qdisc are re-created with it.
+func (d *QdiscDescriptor) UpdateWithRecreate(key string, oldQdisc, newQdisc *linux_tc.Qdisc, metadata interface{}) bool {
+	return qdiscKind(oldQdisc) != qdiscKind(newQdisc) || oldQdisc.Handle != newQdisc.Handle
+}
+
+// Retrieve returns qdiscs configured by the agent which are attached to the interfaces,
+// with attributes as printed by tc (see qdiscFromTc).
+func (d *QdiscDescriptor) Retrieve(correlate []adapter.QdiscKVWithMetadata) (
+	retrieved []adapter.QdiscKVWithMetadata, err error) {
+
+	byIface := make(map[string][]adapter.QdiscKVWithMetadata)
+	var ifaces []string
+	for _, kv := range correlate {
+		if _, listed := byIface[kv.Value.Interface]; !listed {
+			ifaces = append(ifaces, kv.Value.Interface)
+		}
+		byIface[kv.Value.Interface] = append(byIface[kv.Value.Interface], kv)
+	}
+
+	for _, iface := range ifaces {
+		var qdiscs []*linuxcalls.Qdisc
+		err = inInterfaceNamespace(d.ifPlugin, d.nsPlugin, iface, func(hostName string) error {
+			qdiscs, err = d.tcHandler.GetQdiscs(hostName)
+			return err
+		})
+		if err != nil {
+			// interface does not exist (yet)
+			d.log.Debugf("Failed to retrieve qdiscs of interface %s: %v", iface, err)
+			continue
+		}
+		for _, kv := range byIface[iface] {
+			for _, qdisc := range qdiscs {
+				if qdisc.Parent != qdiscParent(kv.Value) || qdisc.Kind != qdiscKind(kv.Value) {
+					continue
+				}
+				if kv.Value.Handle != "" && qdisc.Handle != kv.Value.Handle {
+					continue
+				}
+				retrieved = append(retrieved, adapter.QdiscKVWithMetadata{
+					Key:    kv.Key,
+					Value:  qdiscFromTc(kv.Value, qdisc),
+					Origin: kvs.FromNB,
+				})
+				break
+			}
+		}
+	}
+	return retrieved, nil
+}
+
+// DerivedValues derives empty value under QdiscHandleKey if the qdisc has a handle.
+// It is used in dependencies of classes and filters attached to the qdisc.
+func (d *QdiscDescriptor) DerivedValues(key string, qdisc *linux_tc.Qdisc) (derValues []kvs.KeyValuePair) {
+	if qdisc.Handle != "" {
+		derValues = append(derValues, kvs.KeyValuePair{
+			Key:   linux_tc.QdiscHandleKey(qdisc.Interface, qdisc.Handle),
+			Value: &prototypes.Empty{},
+		})
+	}
+	return derValues
+}
+
+// Dependencies lists the interface and the parent class (if any) as dependencies of the qdisc.
+func (d *QdiscDescriptor) Dependencies(key string, qdisc *linux_tc.Qdisc) []kvs.Dependency {
+	deps := []kvs.Dependency{
+		{
+			Label: tcInterfaceDep,
+			Key:   ifmodel.InterfaceKey(qdisc.Interface),
+		},
+	}
+	if parent := qdiscParent(qdisc); isClassID(parent) {
+		deps = append(deps, kvs.Dependency{
+			Label: tcClassDep,
+			Key:   linux_tc.ClassKey(qdisc.Interface, parent),
+		})
+	}
+	return deps
+}
+
+// replaceQdisc attaches the qdisc to the interface inside the namespace of the interface.
+func (d *QdiscDescriptor) replaceQdisc(qdisc *linux_tc.Qdisc) error {
+	err := inInterfaceNamespace(d.ifPlugin, d.nsPlugin, qdisc.Interface, func(hostName string) error {
+		return d.tcHandler.ReplaceQdisc(hostName, &linuxcalls.Qdisc{
+			Kind:    qdiscKind(qdisc),
+			Parent:  qdiscParent(qdisc),
+			Handle:  qdisc.Handle,
+			Options: qdiscOptions(qdisc),
+		})
+	})
+	if err != nil {
+		d.log.Error(err)
+	}
+	return err
+}
+
+// qdiscParent returns the parent of the qdisc with the default applied.
+func qdiscParent(qdisc *linux_tc.Qdisc) string {
+	if qdisc.Parent == "" {
+		return linux_tc.RootParent
+	}
+	return qdisc.Parent
+}
+
+// qdiscKind returns kind of the qdisc as used by tc.
+func qdiscKind(qdisc *linux_tc.Qdisc) string {
+	switch qdisc.Type.(type) {
+	case *linux_tc.Qdisc_Htb:
+		return "htb"
+	case *linux_tc.Qdisc_Tbf:
+		return "tbf"
+	case *linux_tc.Qdisc_FqCodel:
+		return "fq_codel"
+	case *linux_tc.Qdisc_Netem:
+		return "netem"
+	}
+	if qdiscParent(qdisc) == linux_tc.IngressParent {
+		return "ingress"
+	}
+	return ""
+}
+
+// qdiscOptions returns tc options of the qdisc.
+func qdiscOptions(qdisc *linux_tc.Qdisc) (opts []string) {
+	switch qdiscType := qdisc.Type.(type) {
+	case *linux_tc.Qdisc_Htb:
+		htb := qdiscType.Htb
+		if htb.GetDefaultClass() != 0 {
+			opts = append(opts, "default", strconv.FormatUint(uint64(htb.DefaultClass), 16))
+		}
+		if htb.GetR2Q() != 0 {
+			opts = append(opts, "r2q", strconv.FormatUint(uint64(htb.R2Q), 10))
+		}
+
+	case *linux_tc.Qdisc_Tbf:
+		tbf := qdiscType.Tbf
+		opts = append(opts, "rate", bitRate(tbf.Rate), "burst", strconv.FormatUint(uint64(tbf.Burst), 10))
+		switch {
+		case tbf.LatencyUs != 0:
+			opts = append(opts, "latency", microseconds(tbf.LatencyUs))
+		case tbf.Limit != 0:
+			opts = append(opts, "limit", strconv.FormatUint(uint64(tbf.Limit), 10))
+		default:
+			opts = append(opts, "latency", microseconds(defaultTbfLatencyUs))
+		}
+
+	case *linux_tc.Qdisc_FqCodel:
+		fqCodel := qdiscType.FqCodel
+		if fqCodel.GetLimit() != 0 {
+			opts = append(opts, "limit", strconv.FormatUint(uint64(fqCodel.Limit), 10))
+		}
+		if fqCodel.GetFlows() != 0 {
+			opts = append(opts, "flows", strconv.FormatUint(uint64(fqCodel.Flows), 10))
+		}
+		if fqCodel.GetTargetUs() != 0 {
+			opts = append(opts, "target", microseconds(fqCodel.TargetUs))
+		}
+		if fqCodel.GetIntervalUs() != 0 {
+			opts = append(opts, "interval", microseconds(fqCodel.IntervalUs))
+		}
+		if fqCodel.GetQuantum() != 0 {
+			opts = append(opts, "quantum", strconv.FormatUint(uint64(fqCodel.Quantum), 10))
+		}
+		if fqCodel.GetEcn() {
+			opts = append(opts, "ecn")
+		} else {
+			opts = append(opts, "noecn")
+		}
+
+	case *linux_tc.Qdisc_Netem:
+		netem := qdiscType.Netem
+		if netem.GetLimit() != 0 {
+			opts = append(opts, "limit", strconv.FormatUint(uint64(netem.Limit), 10))
+		}
+		if netem.GetDelayUs() != 0 {
+			opts = append(opts, "delay", microseconds(netem.DelayUs))
+			if netem.JitterUs != 0 {
+				opts = append(opts, microseconds(netem.JitterUs))
+				if netem.DelayCorrelation != 0 {
+					opts = append(opts, percent(netem.DelayCorrelation))
+				}
+			}
+		}
+		if netem.GetLoss() != 0 {
+			opts = append(opts, "loss", "random", percent(netem.Loss))
+			if netem.LossCorrelation != 0 {
+				opts = append(opts, percent(netem.LossCorrelation))
+			}
+		}
+		if netem.GetDuplicate() != 0 {
+			opts = append(opts, "duplicate", percent(netem.Duplicate))
+		}
+		if netem.GetReorder() != 0 {
+			opts = append(opts, "reorder", percent(netem.Reorder))
+			if netem.ReorderCorrelation != 0 {
+				opts = append(opts, percent(netem.ReorderCorrelation))
+			}
+		}
+		if netem.GetCorrupt() != 0 {
+			opts = append(opts, "corrupt", percent(netem.Corrupt))
+		}
+		if netem.GetRate() != 0 {
+			opts = append(opts, "rate", bitRate(netem.Rate))
+		}
+	}
+	return opts
+}
+
+// qdiscFromTc returns the expected qdisc with attributes read back from tc.
+// Attributes which are printed by tc only derived from the configuration
+// (burst, latency and limit of TBF) are taken over from the expected qdisc.
+func qdiscFromTc(expected *linux_tc.Qdisc, tcQdisc *linuxcalls.Qdisc) *linux_tc.Qdisc {
+	qdisc := &linux_tc.Qdisc{
+		Interface: expected.Interface,
+		Parent:    expected.Parent,
+		Handle:    expected.Handle,
+	}
+	switch qdiscType := expected.Type.(type) {
+	case *linux_tc.Qdisc_Htb:
+		values := optionValues(tcQdisc.Options, "r2q", "default")
+		htb := &linux_tc.Qdisc_HtbQdisc{}
+		if r2q := values["r2q"]; len(r2q) > 0 {
+			htb.R2Q, _ = parseUint(r2q[0], "")
+		}
+		if defaultClass := values["default"]; len(defaultClass) > 0 {
+			// class is printed in hexadecimal
+			class, _ := strconv.ParseUint(strings.TrimPrefix(defaultClass[0], "0x"), 16, 32)
+			htb.DefaultClass = uint32(class)
+		}
+		qdisc.Type = &linux_tc.Qdisc_Htb{Htb: htb}
+
+	case *linux_tc.Qdisc_Tbf:
+		values := optionValues(tcQdisc.Options, "rate", "burst")
+		tbf := &linux_tc.Qdisc_TbfQdisc{
+			Burst:     qdiscType.Tbf.GetBurst(),
+			LatencyUs: qdiscType.Tbf.GetLatencyUs(),
+			Limit:     qdiscType.Tbf.GetLimit(),
+		}
+		if rate := values["rate"]; len(rate) > 0 {
+			tbf.Rate, _ = parseRate(rate[0])
+		}
+		qdisc.Type = &linux_tc.Qdisc_Tbf{Tbf: tbf}
+
+	case *linux_tc.Qdisc_FqCodel:
+		values := optionValues(tcQdisc.Options, "limit", "flows", "quantum", "target", "interval",
+			"memory_limit", "ecn", "drop_batch")
+		fqCodel := &linux_tc.Qdisc_FqCodelQdisc{}
+		if limit := values["limit"]; len(limit) > 0 {
+			fqCodel.Limit, _ = parseUint(limit[0], "p")
+		}
+		if flows := values["flows"]; len(flows) > 0 {
+			fqCodel.Flows, _ = parseUint(flows[0], "")
+		}
+		if quantum := values["quantum"]; len(quantum) > 0 {
+			fqCodel.Quantum, _ = parseUint(quantum[0], "")
+		}
+		if target := values["target"]; len(target) > 0 {
+			fqCodel.TargetUs, _ = parseTime(target[0])
+		}
+		if interval := values["interval"]; len(interval) > 0 {
+			fqCodel.IntervalUs, _ = parseTime(interval[0])
+		}
+		_, fqCodel.Ecn = values["ecn"]
+		qdisc.Type = &linux_tc.Qdisc_FqCodel{FqCodel: fqCodel}
+
+	case *linux_tc.Qdisc_Netem:
+		values := optionValues(tcQdisc.Options, "limit", "delay", "loss", "duplicate", "reorder",
+			"corrupt", "rate", "gap", "seed", "ecn", "slot")
+		netem := &linux_tc.Qdisc_NetemQdisc{}
+		if limit := values["limit"]; len(limit) > 0 {
+			netem.Limit, _ = parseUint(limit[0], "")
+		}
+		// delay is followed by jitter and correlation
+		if delay := values["delay"]; len(delay) > 0 {
+			netem.DelayUs, _ = parseTime(delay[0])
+			if len(delay) > 1 {
+				netem.JitterUs, _ = parseTime(delay[1])
+			}
+			if len(delay) > 2 {
+				netem.DelayCorrelation, _ = parsePercent(delay[2])
+			}
+		}
+		// probabilities are followed by correlation
+		if loss := values["loss"]; len(loss) > 0 {
+			if loss[0] == "random" {
+				loss = loss[1:]
+			}
+			netem.Loss, netem.LossCorrelation = parseProbability(loss)
+		}
+		netem.Duplicate, _ = parseProbability(values["duplicate"])
+		netem.Reorder, netem.ReorderCorrelation = parseProbability(values["reorder"])
+		netem.Corrupt, _ = parseProbability(values["corrupt"])
+		if rate := values["rate"]; len(rate) > 0 {
+			netem.Rate, _ = parseRate(rate[0])
+		}
+		qdisc.Type = &linux_tc.Qdisc_Netem{Netem: netem}
+	}
+	return qdisc
+}
+
+// parseProbability parses netem probability optionally followed by correlation.
+func parseProbability(values []string) (probability, correlation float32) {
+	if len(values) > 0 {
+		probability, _ = parsePercent(values[0])
+	}
+	if len(values) > 1 {
+		correlation, _ = parsePercent(values[1])
+	}
+	return probability, correlation
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/tcplugin/linuxcalls"
+	linux_tc "go.ligato.io/vpp-agent/v3/proto/ligato/linux/tc"
+)
+
+func TestQdiscFromTc(t *testing.T) {
+	tests := []struct {
+		name       string
+		expected   *linux_tc.Qdisc
+		tcOptions  string // as printed by "tc qdisc show"
+		retrieved  *linux_tc.Qdisc
+		equivalent bool
+	}{
+		{
+			name: "netem",
+			expected: &linux_tc.Qdisc{Interface: "veth1", Parent: "1:10", Handle: "10:",
+				Type: &linux_tc.Qdisc_Netem{Netem: &linux_tc.Qdisc_NetemQdisc{
+					DelayUs: 10000, JitterUs: 2000, DelayCorrelation: 25, Loss: 1, LossCorrelation: 50, Rate: 1000000,
+				}}},
+			tcOptions: "limit 1000 delay 10ms  2ms 25% loss 1% 50% rate 1Mbit",
+			retrieved: &linux_tc.Qdisc{Interface: "veth1", Parent: "1:10", Handle: "10:",
+				Type: &linux_tc.Qdisc_Netem{Netem: &linux_tc.Qdisc_NetemQdisc{
+					Limit: 1000, DelayUs: 10000, JitterUs: 2000, DelayCorrelation: 25, Loss: 1, LossCorrelation: 50,
+					Rate: 1000000,
+				}}},
+			equivalent: true,
+		},
+		{
+			name: "netem printed by older tc",
+			expected: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_Netem{Netem: &linux_tc.Qdisc_NetemQdisc{
+					DelayUs: 1234, Loss: 0.1, Duplicate: 2, Reorder: 25, ReorderCorrelation: 10, Corrupt: 0.5,
+				}}},
+			tcOptions: "limit 1000 delay 1.2ms loss 0.1% duplicate 2% reorder 25% 10% corrupt 0.5% gap 1",
+			retrieved: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_Netem{Netem: &linux_tc.Qdisc_NetemQdisc{
+					Limit: 1000, DelayUs: 1200, Loss: 0.1, Duplicate: 2, Reorder: 25, ReorderCorrelation: 10,
+					Corrupt: 0.5,
+				}}},
+			equivalent: true,
+		},
+		{
+			name: "netem with changed delay and loss",
+			expected: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_Netem{Netem: &linux_tc.Qdisc_NetemQdisc{DelayUs: 20000, Loss: 5}}},
+			tcOptions: "limit 1000 delay 10ms loss 1%",
+			retrieved: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_Netem{Netem: &linux_tc.Qdisc_NetemQdisc{Limit: 1000, DelayUs: 10000, Loss: 1}}},
+		},
+		{
+			name: "htb",
+			expected: &linux_tc.Qdisc{Interface: "veth1", Handle: "1:",
+				Type: &linux_tc.Qdisc_Htb{Htb: &linux_tc.Qdisc_HtbQdisc{DefaultClass: 0x10}}},
+			tcOptions: "r2q 10 default 0x10 direct_packets_stat 0 direct_qlen 1000",
+			retrieved: &linux_tc.Qdisc{Interface: "veth1", Handle: "1:",
+				Type: &linux_tc.Qdisc_Htb{Htb: &linux_tc.Qdisc_HtbQdisc{DefaultClass: 0x10, R2Q: 10}}},
+			equivalent: true,
+		},
+		{
+			name: "htb printed by older tc",
+			expected: &linux_tc.Qdisc{Interface: "veth1", Handle: "1:",
+				Type: &linux_tc.Qdisc_Htb{Htb: &linux_tc.Qdisc_HtbQdisc{DefaultClass: 0x20, R2Q: 5}}},
+			tcOptions: "r2q 5 default 20 direct_packets_stat 0",
+			retrieved: &linux_tc.Qdisc{Interface: "veth1", Handle: "1:",
+				Type: &linux_tc.Qdisc_Htb{Htb: &linux_tc.Qdisc_HtbQdisc{DefaultClass: 0x20, R2Q: 5}}},
+			equivalent: true,
+		},
+		{
+			name: "tbf",
+			expected: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_Tbf{Tbf: &linux_tc.Qdisc_TbfQdisc{Rate: 1000000, Burst: 1600, Limit: 3000}}},
+			tcOptions: "rate 1Mbit burst 1600b lat 19.2ms",
+			retrieved: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_Tbf{Tbf: &linux_tc.Qdisc_TbfQdisc{Rate: 1000000, Burst: 1600, Limit: 3000}}},
+			equivalent: true,
+		},
+		{
+			name: "fq_codel with defaults",
+			expected: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_FqCodel{FqCodel: &linux_tc.Qdisc_FqCodelQdisc{Ecn: true}}},
+			tcOptions: "limit 10240p flows 1024 quantum 1514 target 5ms interval 100ms memory_limit 32Mb ecn drop_batch 64",
+			retrieved: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_FqCodel{FqCodel: &linux_tc.Qdisc_FqCodelQdisc{
+					Limit: 10240, Flows: 1024, Quantum: 1514, TargetUs: 5000, IntervalUs: 100000, Ecn: true,
+				}}},
+			equivalent: true,
+		},
+		{
+			name: "fq_codel without ecn",
+			expected: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_FqCodel{FqCodel: &linux_tc.Qdisc_FqCodelQdisc{Limit: 1000}}},
+			tcOptions: "limit 1000p flows 1024 quantum 1514 target 5ms interval 100ms memory_limit 32Mb ecn drop_batch 64",
+			retrieved: &linux_tc.Qdisc{Interface: "veth1",
+				Type: &linux_tc.Qdisc_FqCodel{FqCodel: &linux_tc.Qdisc_FqCodelQdisc{
+					Limit: 1000, Flows: 1024, Quantum: 1514, TargetUs: 5000, IntervalUs: 100000, Ecn: true,
+				}}},
+		},
+		{
+			name:       "ingress",
+			expected:   &linux_tc.Qdisc{Interface: "veth1", Parent: "ingress"},
+			retrieved:  &linux_tc.Qdisc{Interface: "veth1", Parent: "ingress"},
+			equivalent: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			tcQdisc := &linuxcalls.Qdisc{Options: strings.Fields(test.tcOptions)}
+			retrieved := qdiscFromTc(test.expected, tcQdisc)
+			Expect(proto.Equal(retrieved, test.retrieved)).To(BeTrue(), "retrieved: %v", retrieved)
+
+			descriptor := &QdiscDescriptor{}
+			Expect(descriptor.EquivalentQdiscs("", retrieved, test.expected)).To(Equal(test.equivalent))
+		})
+	}
+}
+
+func TestTcRate(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(tcRate(1000000)).To(Equal("1Mbit"))
+	Expect(tcRate(2500000)).To(Equal("2500Kbit"))
+	Expect(tcRate(1234567)).To(Equal("1234Kbit"))
+	Expect(tcRate(10000000000)).To(Equal("10Gbit"))
+	Expect(tcRate(100)).To(Equal("96bit"))
+
+	rate, err := parseRate("2500Kbit")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(rate).To(BeEquivalentTo(2500000))
+	Expect(equalRates(rate, 2500000)).To(BeTrue())
+	rate, err = parseRate("1234Kbit")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(equalRates(rate, 1234567)).To(BeTrue())
+	Expect(equalRates(rate, 1240000)).To(BeFalse())
+	_, err = parseRate("10Mbps")
+	Expect(err).To(HaveOccurred())
+}
+
+func TestEqualTimes(t *testing.T) {
+	tests := []struct {
+		printed string
+		time    uint32
+		equal   bool
+	}{
+		{printed: "100us", time: 100, equal: true},
+		{printed: "100us", time: 110},
+		{printed: "1.2ms", time: 1234, equal: true},
+		{printed: "1.23ms", time: 1234, equal: true},
+		{printed: "123ms", time: 123456, equal: true},
+		{printed: "123.5ms", time: 123456, equal: true},
+		{printed: "10ms", time: 20000},
+		{printed: "1.5s", time: 1500000, equal: true},
+		{printed: "2s", time: 1500000},
+	}
+	for _, test := range tests {
+		t.Run(test.printed, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			time, err := parseTime(test.printed)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(equalTimes(time, test.time)).To(Equal(test.equal))
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin"
+	nslinuxcalls "go.ligato.io/vpp-agent/v3/plugins/linux/nsplugin/linuxcalls"
+)
+
+const (
+	// dependency labels shared by tc descriptors
+	tcInterfaceDep = "interface-exists"
+	tcQdiscDep     = "qdisc-exists"
+	tcClassDep     = "parent-class-exists"
+)
+
+var (
+	// handle of a qdisc in the format printed by tc, e.g. "1:" (hexadecimal)
+	handleRegexp = regexp.MustCompile(`^[1-9a-f][0-9a-f]{0,3}:$`)
+
+	// class ID in the format printed by tc, e.g. "1:a" (hexadecimal)
+	classIDRegexp = regexp.MustCompile(`^[1-9a-f][0-9a-f]{0,3}:[1-9a-f][0-9a-f]{0,3}$`)
+)
+
+// handle reserved for the ingress qdisc
+const ingressHandle = "ffff:"
+
+// isHandle returns true if the given string is a valid handle of a qdisc.
+func isHandle(handle string) bool {
+	return handleRegexp.MatchString(handle) && handle != ingressHandle
+}
+
+// isClassID returns true if the given string is a valid class ID.
+func isClassID(classID string) bool {
+	return classIDRegexp.MatchString(classID)
+}
+
+// handleMajor returns major part of the class ID or handle as a handle, e.g. "1:a" -> "1:".
+func handleMajor(id string) string {
+	return id[:strings.Index(id, ":")+1]
+}
+
+// lookupInterface returns metadata of the Linux interface with the given logical name.
+func lookupInterface(ifPlugin ifplugin.API, iface string) (*ifaceidx.LinuxIfMetadata, error) {
+	ifMeta, found := ifPlugin.GetInterfaceIndex().LookupByName(iface)
+	if !found || ifMeta == nil {
+		return nil, errors.Errorf("failed to obtain metadata for interface %s", iface)
+	}
+	return ifMeta, nil
+}
+
+// inInterfaceNamespace runs the given function inside the namespace of the interface,
+// passing it the host name of the interface.
+func inInterfaceNamespace(ifPlugin ifplugin.API, nsPlugin nsplugin.API, iface string,
+	f func(hostName string) error) error {
+
+	ifMeta, err := lookupInterface(ifPlugin, iface)
+	if err != nil {
+		return err
+	}
+
+	// switch to the namespace of the interface
+	nsCtx := nslinuxcalls.NewNamespaceMgmtCtx()
+	revertNs, err := nsPlugin.SwitchToNamespace(nsCtx, ifMeta.Namespace)
+	if err != nil {
+		return errors.Errorf("failed to switch namespace: %v", err)
+	}
+	// revert network namespace after returning
+	defer revertNs()
+
+	return f(ifMeta.HostIfName)
+}
+
+// bitRate formats rate in bits per second for tc.
+func bitRate(rate uint64) string {
+	return strconv.FormatUint(rate, 10) + "bit"
+}
+
+// microseconds formats time in microseconds for tc.
+func microseconds(us uint32) string {
+	return fmt.Sprintf("%dus", us)
+}
+
+// percent formats probability or correlation in percent for tc.
+func percent(p float32) string {
+	return strconv.FormatFloat(float64(p), 'g', -1, 32) + "%"
+}
+
+// isPercent returns true if the value is a valid probability or correlation in percent.
+func isPercent(p float32) bool {
+	return p >= 0 && p <= 100
+}
+
+// units of rates printed by tc
+var rateUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"Tibit", 1 << 40}, {"Gibit", 1 << 30}, {"Mibit", 1 << 20}, {"Kibit", 1 << 10},
+	{"Tbit", 1e12}, {"Gbit", 1e9}, {"Mbit", 1e6}, {"Kbit", 1e3}, {"bit", 1},
+}
+
+// units of times printed by tc (in microseconds)
+var timeUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"usec", 1}, {"msec", 1e3}, {"sec", 1e6}, {"us", 1}, {"ms", 1e3}, {"ns", 1e-3}, {"s", 1e6},
+}
+
+// parseRate parses rate printed by tc (e.g. "10Mbit") into bits per second.
+func parseRate(rate string) (uint64, error) {
+	for _, unit := range rateUnits {
+		if strings.HasSuffix(rate, unit.suffix) {
+			value, err := strconv.ParseFloat(strings.TrimSuffix(rate, unit.suffix), 64)
+			if err != nil {
+				return 0, err
+			}
+			return uint64(math.Round(value * unit.multiplier)), nil
+		}
+	}
+	return 0, errors.Errorf("invalid rate: %s", rate)
+}
+
+// parseTime parses time printed by tc (e.g. "10.5ms") into microseconds.
+func parseTime(time string) (uint32, error) {
+	for _, unit := range timeUnits {
+		if strings.HasSuffix(time, unit.suffix) {
+			value, err := strconv.ParseFloat(strings.TrimSuffix(time, unit.suffix), 64)
+			if err != nil {
+				return 0, err
+			}
+			return uint32(math.Round(value * unit.multiplier)), nil
+		}
+	}
+	return 0, errors.Errorf("invalid time: %s", time)
+}
+
+// parsePercent parses probability or correlation printed by tc (e.g. "0.5%").
+func parsePercent(p string) (float32, error) {
+	if !strings.HasSuffix(p, "%") {
+		return 0, errors.Errorf("invalid percentage: %s", p)
+	}
+	value, err := strconv.ParseFloat(strings.TrimSuffix(p, "%"), 32)
+	return float32(value), err
+}
+
+// parseUint parses decimal number printed by tc, ignoring the given unit suffix (e.g. "p" for packets).
+func parseUint(number, unit string) (uint32, error) {
+	value, err := strconv.ParseUint(strings.TrimSuffix(number, unit), 10, 32)
+	return uint32(value), err
+}
+
+// optionValues splits options printed by tc into values of the given keywords.
+// Values of a keyword are all the tokens following the keyword up to the next keyword,
+// options preceding the first keyword are ignored.
+func optionValues(opts []string, keywords ...string) map[string][]string {
+	values := make(map[string][]string)
+	var keyword string
+	for _, opt := range opts {
+		if sliceContains(keywords, opt) {
+			keyword = opt
+			values[keyword] = []string{}
+			continue
+		}
+		if keyword != "" {
+			values[keyword] = append(values[keyword], opt)
+		}
+	}
+	return values
+}
+
+// sliceContains returns true if the slice contains the given string.
+func sliceContains(slice []string, str string) bool {
+	for _, s := range slice {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// tcRate returns rate in bits per second as printed by tc - the kernel stores rates
+// in bytes per second and tc prints them rounded to whole units.
+func tcRate(rate uint64) string {
+	units := []string{"", "K", "M", "G", "T"}
+	rate = rate / 8 * 8
+	i := 0
+	for ; i < len(units)-1; i++ {
+		if rate < 1000 || (rate%1000 != 0 && rate < 1000*1000) {
+			break
+		}
+		rate /= 1000
+	}
+	return fmt.Sprintf("%d%sbit", rate, units[i])
+}
+
+// equalRates returns true if the rates (in bits per second) are the same
+// in the precision used by the kernel and tc.
+func equalRates(rate1, rate2 uint64) bool {
+	return tcRate(rate1) == tcRate(rate2)
+}
+
+// equalTimes returns true if the times (in microseconds) are the same
+// in the precision printed by tc (0.1ms or three significant digits
+// depending on the version of tc).
+func equalTimes(time1, time2 uint32) bool {
+	diff := math.Abs(float64(time1) - float64(time2))
+	max := math.Max(float64(time1), float64(time2))
+	if max < 1000 {
+		// rounding of the time to kernel ticks
+		return diff <= 1
+	}
+	return diff <= math.Max(50, max/200)
+}
+
+// equalPercents returns true if the probabilities or correlations are the same
+// in the precision printed by tc.
+func equalPercents(p1, p2 float32) bool {
+	diff := math.Abs(float64(p1) - float64(p2))
+	return diff <= math.Max(float64(p1), float64(p2))*1e-5+1e-6
+}
+
+// valueOrDefault returns the value if it is defined (non-zero), default value otherwise.
+func valueOrDefault(value, defaultValue uint32) uint32 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+// TCAPI interface covers all methods inside linux calls package needed
+// to manage Linux traffic control (qdiscs, classes and filters).
+type TCAPI interface {
+	// Init initializes a tc handler.
+	Init(config *HandlerConfig) error
+
+	TCAPIWrite
+	TCAPIRead
+}
+
+// TCAPIWrite interface covers write methods inside linux calls package
+// needed to manage Linux traffic control.
+type TCAPIWrite interface {
+	// ReplaceQdisc creates the qdisc or replaces the qdisc attached
+	// at the same position of the interface.
+	ReplaceQdisc(ifName string, qdisc *Qdisc) error
+
+	// DeleteQdisc deletes qdisc attached at the given position of the interface.
+	DeleteQdisc(ifName, parent string) error
+
+	// ReplaceClass creates or changes a traffic class.
+	ReplaceClass(ifName string, class *Class) error
+
+	// DeleteClass deletes a traffic class.
+	DeleteClass(ifName, classID string) error
+
+	// ReplaceFilter creates the filter or replaces the filter of the qdisc
+	// with the same priority in place.
+	ReplaceFilter(ifName string, filter *Filter) error
+
+	// DeleteFilter deletes filter(s) of the qdisc with the given priority.
+	DeleteFilter(ifName, parent string, priority uint32) error
+}
+
+// TCAPIRead interface covers read methods inside linux calls package
+// needed to manage Linux traffic control.
+// Options of the objects are read back as printed by tc (without the identification).
+type TCAPIRead interface {
+	// GetQdiscs reads all qdiscs attached to the interface.
+	GetQdiscs(ifName string) ([]*Qdisc, error)
+
+	// GetClasses reads all traffic classes of the interface.
+	GetClasses(ifName string) ([]*Class, error)
+
+	// GetFilters reads all filters of the given qdisc (one for each priority).
+	GetFilters(ifName, parent string) ([]*Filter, error)
+}
+
+// Qdisc is a queueing discipline in the tc syntax.
+type Qdisc struct {
+	// Kind of the qdisc, e.g. "htb" or "ingress".
+	Kind string
+	// Parent is "root", "ingress" or class ID.
+	Parent string
+	// Handle is empty to let the kernel allocate it.
+	Handle  string
+	Options []string
+}
+
+// Class is a traffic class in the tc syntax.
+type Class struct {
+	Kind    string
+	ClassID string
+	Parent  string
+	Options []string
+}
+
+// Filter is a filter in the tc syntax.
+type Filter struct {
+	// Parent is handle of the qdisc or "ingress".
+	Parent   string
+	Priority uint32
+	Protocol string
+	Kind     string
+	// Handle of the filter, e.g. "800::800" (u32) or "0x1" (flower).
+	// It is empty to let the kernel allocate it or to replace the filter
+	// with the same priority.
+	Handle  string
+	Options []string
+}
+
+// HandlerConfig holds the TCHandler related configuration.
+type HandlerConfig struct {
+	// TcPath is a path to the tc binary (optional, looked up in PATH by default).
+	TcPath string `json:"tc-path"`
+}
+
+// NewTCHandler creates new instance of tc handler.
+func NewTCHandler() *TCHandler {
+	return &TCHandler{}
+}
@@ -0,0 +1,373 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"bytes"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// TcCmd is the name of the traffic control command-line tool.
+	TcCmd string = "tc"
+
+	// parent of the ingress qdisc as printed by tc
+	ingressQdiscParent = "ffff:fff1"
+	// handle of the ingress qdisc
+	ingressHandle = "ffff:"
+
+	rootParent    = "root"
+	ingressParent = "ingress"
+)
+
+// parts of the error messages printed by tc when the object does not exist
+var notFoundErrs = []string{
+	"No such file or directory",
+	"Invalid handle",
+	"Cannot delete qdisc with handle of zero",
+	"Cannot find specified",
+	"not found",
+}
+
+// TCHandler is a handler for all operations on Linux traffic control.
+// The handler uses the tc tool from iproute2.
+type TCHandler struct {
+	tcPath string
+}
+
+// Init initializes a tc handler.
+func (h *TCHandler) Init(config *HandlerConfig) error {
+	if config.TcPath != "" {
+		h.tcPath = config.TcPath
+		return nil
+	}
+	var err error
+	h.tcPath, err = exec.LookPath(TcCmd)
+	if err != nil {
+		// continue, tc just may not be installed
+		h.tcPath = TcCmd
+		return errors.Errorf("error by looking up %s: %v", TcCmd, err)
+	}
+	return nil
+}
+
+// ReplaceQdisc creates the qdisc or replaces the qdisc attached
+// at the same position of the interface.
+func (h *TCHandler) ReplaceQdisc(ifName string, qdisc *Qdisc) error {
+	args := append([]string{"qdisc", "replace", "dev", ifName}, qdiscParentArgs(qdisc.Parent)...)
+	if qdisc.Parent != ingressParent {
+		if qdisc.Handle != "" {
+			args = append(args, "handle", qdisc.Handle)
+		}
+		args = append(args, qdisc.Kind)
+	}
+	args = append(args, qdisc.Options...)
+
+	if _, err := h.runTc(args...); err != nil {
+		return errors.Errorf("failed to replace %s qdisc %s of interface %s: %v",
+			qdisc.Kind, qdisc.Parent, ifName, err)
+	}
+	return nil
+}
+
+// DeleteQdisc deletes qdisc attached at the given position of the interface.
+func (h *TCHandler) DeleteQdisc(ifName, parent string) error {
+	args := append([]string{"qdisc", "del", "dev", ifName}, qdiscParentArgs(parent)...)
+	if _, err := h.runTc(args...); err != nil && !isNotFoundErr(err) {
+		return errors.Errorf("failed to delete qdisc %s of interface %s: %v", parent, ifName, err)
+	}
+	return nil
+}
+
+// ReplaceClass creates or changes a traffic class.
+func (h *TCHandler) ReplaceClass(ifName string, class *Class) error {
+	args := []string{"class", "replace", "dev", ifName,
+		"parent", class.Parent, "classid", class.ClassID, class.Kind}
+	args = append(args, class.Options...)
+
+	if _, err := h.runTc(args...); err != nil {
+		return errors.Errorf("failed to replace class %s of interface %s: %v", class.ClassID, ifName, err)
+	}
+	return nil
+}
+
+// DeleteClass deletes a traffic class.
+func (h *TCHandler) DeleteClass(ifName, classID string) error {
+	if _, err := h.runTc("class", "del", "dev", ifName, "classid", classID); err != nil && !isNotFoundErr(err) {
+		return errors.Errorf("failed to delete class %s of interface %s: %v", classID, ifName, err)
+	}
+	return nil
+}
+
+// ReplaceFilter creates the filter or replaces the filter of the qdisc
+// with the same priority in place.
+// Unless the handle is given, the filter with the same priority, protocol
+// and kind is replaced using its handle allocated by the kernel. Filter with
+// the same priority but different protocol or kind cannot be replaced
+// and is deleted first.
+func (h *TCHandler) ReplaceFilter(ifName string, filter *Filter) error {
+	handle := filter.Handle
+	if handle == "" {
+		filters, err := h.GetFilters(ifName, filter.Parent)
+		if err != nil {
+			return err
+		}
+		for _, installed := range filters {
+			if installed.Priority != filter.Priority {
+				continue
+			}
+			if installed.Protocol == filter.Protocol && installed.Kind == filter.Kind {
+				handle = installed.Handle
+			} else if err = h.DeleteFilter(ifName, filter.Parent, filter.Priority); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	args := []string{"filter", "replace", "dev", ifName, "parent", filterParent(filter.Parent),
+		"prio", strconv.FormatUint(uint64(filter.Priority), 10)}
+	if handle != "" {
+		args = append(args, "handle", handle)
+	}
+	args = append(args, "protocol", filter.Protocol, filter.Kind)
+	args = append(args, filter.Options...)
+
+	if _, err := h.runTc(args...); err != nil {
+		return errors.Errorf("failed to replace filter %s prio %d of interface %s: %v",
+			filter.Parent, filter.Priority, ifName, err)
+	}
+	return nil
+}
+
+// DeleteFilter deletes filter(s) of the qdisc with the given priority.
+func (h *TCHandler) DeleteFilter(ifName, parent string, priority uint32) error {
+	_, err := h.runTc("filter", "del", "dev", ifName, "parent", filterParent(parent),
+		"prio", strconv.FormatUint(uint64(priority), 10))
+	if err != nil && !isNotFoundErr(err) {
+		return errors.Errorf("failed to delete filter %s prio %d of interface %s: %v",
+			parent, priority, ifName, err)
+	}
+	return nil
+}
+
+// GetQdiscs reads all qdiscs attached to the interface.
+func (h *TCHandler) GetQdiscs(ifName string) (qdiscs []*Qdisc, err error) {
+	output, err := h.runTc("qdisc", "show", "dev", ifName)
+	if err != nil {
+		return nil, errors.Errorf("failed to list qdiscs of interface %s: %v", ifName, err)
+	}
+	return parseQdiscs(string(output)), nil
+}
+
+// GetClasses reads all traffic classes of the interface.
+func (h *TCHandler) GetClasses(ifName string) (classes []*Class, err error) {
+	output, err := h.runTc("class", "show", "dev", ifName)
+	if err != nil {
+		return nil, errors.Errorf("failed to list classes of interface %s: %v", ifName, err)
+	}
+	return parseClasses(string(output)), nil
+}
+
+// GetFilters reads all filters of the given qdisc (one for each priority).
+func (h *TCHandler) GetFilters(ifName, parent string) (filters []*Filter, err error) {
+	output, err := h.runTc("filter", "show", "dev", ifName, "parent", filterParent(parent))
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, errors.Errorf("failed to list filters %s of interface %s: %v", parent, ifName, err)
+	}
+	return parseFilters(string(output), parent), nil
+}
+
+// parseQdiscs parses qdiscs printed by tc, e.g.:
+//
+//	qdisc htb 1: root refcnt 2 r2q 10 default 0x10 direct_packets_stat 0 direct_qlen 1000
+//	qdisc netem 10: parent 1:10 limit 1000 delay 10ms  2ms 25% loss 1%
+//	qdisc ingress ffff: parent ffff:fff1 ----------------
+func parseQdiscs(output string) (qdiscs []*Qdisc) {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[0] != "qdisc" {
+			continue
+		}
+		qdisc := &Qdisc{Kind: fields[1], Handle: fields[2]}
+		for i := 3; i < len(fields); i++ {
+			switch {
+			case fields[i] == rootParent:
+				qdisc.Parent = rootParent
+			case (fields[i] == "parent" || fields[i] == "dev" || fields[i] == "refcnt") && i+1 < len(fields):
+				if fields[i] == "parent" {
+					qdisc.Parent = fields[i+1]
+				}
+				i++
+			case strings.Trim(fields[i], "-") == "":
+				// separator printed for qdiscs without options
+			default:
+				qdisc.Options = append(qdisc.Options, fields[i])
+			}
+		}
+		if qdisc.Parent == ingressQdiscParent {
+			qdisc.Parent = ingressParent
+		}
+		qdiscs = append(qdiscs, qdisc)
+	}
+	return qdiscs
+}
+
+// parseClasses parses traffic classes printed by tc, e.g.:
+//
+//	class htb 1:1 root rate 10Mbit ceil 10Mbit burst 1600b cburst 1600b
+//	class htb 1:10 parent 1:1 leaf 10: prio 0 rate 1Mbit ceil 10Mbit burst 1600b cburst 1600b
+//
+// Parent of the top-level classes (printed as "root") is set to the handle of the qdisc.
+func parseClasses(output string) (classes []*Class) {
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[0] != "class" {
+			continue
+		}
+		class := &Class{Kind: fields[1], ClassID: fields[2]}
+		for i := 3; i < len(fields); i++ {
+			switch {
+			case fields[i] == rootParent:
+				class.Parent = class.ClassID[:strings.Index(class.ClassID, ":")+1]
+			case (fields[i] == "parent" || fields[i] == "dev" || fields[i] == "leaf") && i+1 < len(fields):
+				if fields[i] == "parent" {
+					class.Parent = fields[i+1]
+				}
+				i++
+			default:
+				class.Options = append(class.Options, fields[i])
+			}
+		}
+		classes = append(classes, class)
+	}
+	return classes
+}
+
+// parseFilters parses filters of the given qdisc printed by tc, e.g.:
+//
+//	filter parent 1: protocol ip pref 10 u32 chain 0
+//	filter parent 1: protocol ip pref 10 u32 chain 0 fh 800: ht divisor 1
+//	filter parent 1: protocol ip pref 10 u32 chain 0 fh 800::800 order 2048 key ht 800 bkt 0 flowid 1:10 not_in_hw
+//	  match 0a000001/ffffffff at 12
+//		action order 1: mirred (Egress Mirror to device eth1) pipe
+//		index 1 ref 1 bind 1
+//
+// Options of the filter include the lines following the filter.
+// Only one filter is returned for each priority, filters without handle
+// and hash tables of the u32 classifier are skipped.
+func parseFilters(output, parent string) (filters []*Filter) {
+	priorities := make(map[uint32]struct{})
+	var filter *Filter
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] != "filter" {
+			// continuation of the filter
+			if filter != nil {
+				filter.Options = append(filter.Options, fields...)
+			}
+			continue
+		}
+		filter = &Filter{Parent: parent}
+		for i := 1; i < len(fields); i++ {
+			if i+1 == len(fields) {
+				if filter.Kind != "" {
+					filter.Options = append(filter.Options, fields[i])
+				}
+				break
+			}
+			switch fields[i] {
+			case "parent", "dev", "chain":
+			case "protocol":
+				filter.Protocol = fields[i+1]
+			case "pref":
+				prio, err := strconv.ParseUint(fields[i+1], 10, 32)
+				if err == nil {
+					filter.Priority = uint32(prio)
+				}
+				// kind of the filter follows the priority
+				if i+2 < len(fields) {
+					filter.Kind = fields[i+2]
+					i++
+				}
+			case "fh", "handle":
+				filter.Handle = fields[i+1]
+			default:
+				if filter.Kind != "" {
+					filter.Options = append(filter.Options, fields[i])
+				}
+				continue
+			}
+			i++
+		}
+		if _, listed := priorities[filter.Priority]; listed || filter.Priority == 0 ||
+			filter.Handle == "" || strings.HasSuffix(filter.Handle, ":") {
+			filter = nil
+			continue
+		}
+		priorities[filter.Priority] = struct{}{}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// runTc runs the tc tool with the given arguments, returning its output.
+func (h *TCHandler) runTc(args ...string) ([]byte, error) {
+	cmd := exec.Command(h.tcPath, args...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Errorf("%v (%s)", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+// qdiscParentArgs returns tc arguments selecting the position of a qdisc.
+func qdiscParentArgs(parent string) []string {
+	switch parent {
+	case rootParent, ingressParent:
+		return []string{parent}
+	}
+	return []string{"parent", parent}
+}
+
+// filterParent returns handle of the qdisc with filters.
+func filterParent(parent string) string {
+	if parent == ingressParent {
+		return ingressHandle
+	}
+	return parent
+}
+
+// isNotFoundErr returns true if tc failed because the object does not exist.
+func isNotFoundErr(err error) bool {
+	for _, notFoundErr := range notFoundErrs {
+		if strings.Contains(err.Error(), notFoundErr) {
+			return true
+		}
+	}
+	return false
+}
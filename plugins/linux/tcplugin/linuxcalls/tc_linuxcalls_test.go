@@ -0,0 +1,194 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linuxcalls
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+// output of "tc qdisc show dev veth1"
+const qdiscOutput = `qdisc htb 1: root refcnt 2 r2q 10 default 0x10 direct_packets_stat 0 direct_qlen 1000
+qdisc netem 10: parent 1:10 limit 1000 delay 10ms  2ms 25% loss 1% 50% rate 1Mbit
+qdisc fq_codel 20: parent 1:20 limit 10240p flows 1024 quantum 1514 target 5ms interval 100ms memory_limit 32Mb ecn drop_batch 64
+qdisc ingress ffff: parent ffff:fff1 ----------------
+`
+
+// output of "tc class show dev veth1"
+const classOutput = `class htb 1:1 root rate 10Mbit ceil 10Mbit burst 1600b cburst 1600b
+class htb 1:10 parent 1:1 leaf 10: prio 0 rate 1Mbit ceil 10Mbit burst 1600b cburst 1600b
+class htb 1:20 parent 1:1 leaf 20: prio 1 rate 2500Kbit ceil 10Mbit burst 1600b cburst 1600b
+`
+
+// output of "tc filter show dev veth1 parent 1:"
+const u32FilterOutput = `filter protocol ip pref 10 u32 chain 0
+filter protocol ip pref 10 u32 chain 0 fh 800: ht divisor 1
+filter protocol ip pref 10 u32 chain 0 fh 800::800 order 2048 key ht 800 bkt 0 flowid 1:10 not_in_hw
+  match 0a000000/ffffff00 at 16
+  match 00060000/00ff0000 at 8
+  match 00000050/0000ffff at 20
+	action order 1: mirred (Egress Mirror to device veth2) pipe
+	index 1 ref 1 bind 1
+
+filter protocol ipv6 pref 20 u32 chain 0
+filter protocol ipv6 pref 20 u32 chain 0 fh 801: ht divisor 1
+filter protocol ipv6 pref 20 u32 chain 0 fh 801::800 order 2048 key ht 801 bkt 0 flowid 1:20 not_in_hw
+  match fd000000/ffffffff at 24
+  match 00000000/ffffffff at 28
+`
+
+// output of "tc filter show dev veth1 ingress"
+const flowerFilterOutput = `filter parent ffff: protocol ip pref 1 flower chain 0
+filter parent ffff: protocol ip pref 1 flower chain 0 handle 0x1
+  eth_type ipv4
+  ip_proto tcp
+  dst_ip 10.0.0.1
+  dst_port 80
+  not_in_hw
+	action order 1: mirred (Egress Redirect to device veth2) stolen
+	index 1 ref 1 bind 1
+
+filter parent ffff: protocol all pref 2 u32 chain 0
+filter parent ffff: protocol all pref 2 u32 chain 0 fh 800: ht divisor 1
+filter parent ffff: protocol all pref 2 u32 chain 0 fh 800::800 order 2048 key ht 800 bkt 0 not_in_hw
+  match 00000000/00000000 at 0
+	action order 1: gact action drop
+	 random type none pass val 0
+	 index 1 ref 1 bind 1
+
+`
+
+func TestParseQdiscs(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(parseQdiscs(qdiscOutput)).To(Equal([]*Qdisc{
+		{
+			Kind:    "htb",
+			Parent:  "root",
+			Handle:  "1:",
+			Options: []string{"r2q", "10", "default", "0x10", "direct_packets_stat", "0", "direct_qlen", "1000"},
+		},
+		{
+			Kind:    "netem",
+			Parent:  "1:10",
+			Handle:  "10:",
+			Options: []string{"limit", "1000", "delay", "10ms", "2ms", "25%", "loss", "1%", "50%", "rate", "1Mbit"},
+		},
+		{
+			Kind:   "fq_codel",
+			Parent: "1:20",
+			Handle: "20:",
+			Options: []string{"limit", "10240p", "flows", "1024", "quantum", "1514", "target", "5ms",
+				"interval", "100ms", "memory_limit", "32Mb", "ecn", "drop_batch", "64"},
+		},
+		{
+			Kind:   "ingress",
+			Parent: "ingress",
+			Handle: "ffff:",
+		},
+	}))
+}
+
+func TestParseClasses(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(parseClasses(classOutput)).To(Equal([]*Class{
+		{
+			Kind:    "htb",
+			ClassID: "1:1",
+			Parent:  "1:",
+			Options: []string{"rate", "10Mbit", "ceil", "10Mbit", "burst", "1600b", "cburst", "1600b"},
+		},
+		{
+			Kind:    "htb",
+			ClassID: "1:10",
+			Parent:  "1:1",
+			Options: []string{"prio", "0", "rate", "1Mbit", "ceil", "10Mbit", "burst", "1600b", "cburst", "1600b"},
+		},
+		{
+			Kind:    "htb",
+			ClassID: "1:20",
+			Parent:  "1:1",
+			Options: []string{"prio", "1", "rate", "2500Kbit", "ceil", "10Mbit", "burst", "1600b", "cburst", "1600b"},
+		},
+	}))
+}
+
+func TestParseFilters(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(parseFilters(u32FilterOutput, "1:")).To(Equal([]*Filter{
+		{
+			Parent:   "1:",
+			Priority: 10,
+			Protocol: "ip",
+			Kind:     "u32",
+			Handle:   "800::800",
+			Options: []string{"order", "2048", "key", "ht", "800", "bkt", "0", "flowid", "1:10", "not_in_hw",
+				"match", "0a000000/ffffff00", "at", "16",
+				"match", "00060000/00ff0000", "at", "8",
+				"match", "00000050/0000ffff", "at", "20",
+				"action", "order", "1:", "mirred", "(Egress", "Mirror", "to", "device", "veth2)", "pipe",
+				"index", "1", "ref", "1", "bind", "1"},
+		},
+		{
+			Parent:   "1:",
+			Priority: 20,
+			Protocol: "ipv6",
+			Kind:     "u32",
+			Handle:   "801::800",
+			Options: []string{"order", "2048", "key", "ht", "801", "bkt", "0", "flowid", "1:20", "not_in_hw",
+				"match", "fd000000/ffffffff", "at", "24",
+				"match", "00000000/ffffffff", "at", "28"},
+		},
+	}))
+
+	Expect(parseFilters(flowerFilterOutput, "ingress")).To(Equal([]*Filter{
+		{
+			Parent:   "ingress",
+			Priority: 1,
+			Protocol: "ip",
+			Kind:     "flower",
+			Handle:   "0x1",
+			Options: []string{"eth_type", "ipv4", "ip_proto", "tcp", "dst_ip", "10.0.0.1", "dst_port", "80",
+				"not_in_hw",
+				"action", "order", "1:", "mirred", "(Egress", "Redirect", "to", "device", "veth2)", "stolen",
+				"index", "1", "ref", "1", "bind", "1"},
+		},
+		{
+			Parent:   "ingress",
+			Priority: 2,
+			Protocol: "all",
+			Kind:     "u32",
+			Handle:   "800::800",
+			Options: []string{"order", "2048", "key", "ht", "800", "bkt", "0", "not_in_hw",
+				"match", "00000000/00000000", "at", "0",
+				"action", "order", "1:", "gact", "action", "drop",
+				"random", "type", "none", "pass", "val", "0",
+				"index", "1", "ref", "1", "bind", "1"},
+		},
+	}))
+}
+
+func TestParseFiltersWithoutHandle(t *testing.T) {
+	RegisterTestingT(t)
+
+	// only the hash table of the u32 classifier is left
+	output := `filter parent 1: protocol ip pref 10 u32 chain 0
+filter parent 1: protocol ip pref 10 u32 chain 0 fh 800: ht divisor 1
+`
+	Expect(parseFilters(output, "1:")).To(BeEmpty())
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"go.ligato.io/cn-infra/v2/logging"
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
 	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator/contextdecorator"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/generic"
@@ -71,7 +72,14 @@ func (s *genericService) ProtoFileDescriptor(ctx context.Context, req *generic.P
 		"using registered models.", req.FullProtoFileName))
 }
 
-func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRequest) (*generic.SetConfigResponse, error) {
+func (s *genericService) SetConfig(ctx context.Context, req *generic.SetConfigRequest) (_ *generic.SetConfigResponse, err error) {
+	ctx, span := tracing.StartSpan(tracing.WithIncomingTraceparent(ctx), "SetConfig",
+		tracing.Attr("updates", len(req.Updates)), tracing.Attr("overwrite_all", req.OverwriteAll))
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+
 	s.log.Debug("------------------------------")
 	s.log.Debugf("=> GenericMgr.SetConfig: %d items", len(req.Updates))
 	s.log.Debug("------------------------------")
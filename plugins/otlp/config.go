@@ -0,0 +1,90 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package otlp
+
+import "time"
+
+const (
+	defaultTimeout         = time.Second * 10
+	defaultMetricsInterval = time.Second * 30
+	defaultTracesInterval  = time.Second * 5
+	defaultMaxQueueSize    = 2048
+)
+
+// Config file representation for OTLP plugin
+type Config struct {
+	// Allows to disable plugin
+	Disabled bool `json:"disabled"`
+	// Endpoint of the collector receiving OTLP over HTTP (e.g. http://localhost:4318),
+	// the plugin is disabled if not set
+	Endpoint string `json:"endpoint"`
+	// Additional HTTP headers sent with every export request (e.g. authorization)
+	Headers map[string]string `json:"headers"`
+	// Timeout of a single export request, default value is 10s
+	Timeout time.Duration `json:"timeout"`
+	// Encode scope as instrumentation library for collectors implementing
+	// OTLP older than v0.19
+	LegacyScope bool `json:"legacy-scope"`
+	// Allows to disable export of metrics
+	MetricsDisabled bool `json:"metrics-disabled"`
+	// Interval of metrics export, default value is 30s
+	MetricsInterval time.Duration `json:"metrics-interval"`
+	// Allows to disable export of trace spans
+	TracesDisabled bool `json:"traces-disabled"`
+	// Interval of trace spans export, default value is 5s
+	TracesInterval time.Duration `json:"traces-interval"`
+	// Maximum number of spans waiting for export, spans are dropped when
+	// the queue is full, default value is 2048
+	MaxQueueSize int `json:"max-queue-size"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Timeout:         defaultTimeout,
+		MetricsInterval: defaultMetricsInterval,
+		TracesInterval:  defaultTracesInterval,
+		MaxQueueSize:    defaultMaxQueueSize,
+	}
+}
+
+// loadConfig returns OTLP plugin file configuration if exists
+func (p *Plugin) loadConfig() (*Config, error) {
+	cfg := defaultConfig()
+
+	found, err := p.Cfg.LoadValue(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		p.Log.Debug("OTLP config not found")
+		return cfg, nil
+	}
+
+	p.Log.Debugf("OTLP config found: %+v", cfg)
+
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.MetricsInterval <= 0 {
+		cfg.MetricsInterval = defaultMetricsInterval
+	}
+	if cfg.TracesInterval <= 0 {
+		cfg.TracesInterval = defaultTracesInterval
+	}
+	if cfg.MaxQueueSize <= 0 {
+		cfg.MaxQueueSize = defaultMaxQueueSize
+	}
+	return cfg, nil
+}
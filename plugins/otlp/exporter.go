@@ -0,0 +1,279 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
+	"go.ligato.io/vpp-agent/v3/pkg/version"
+)
+
+// Data types below follow the JSON encoding of OTLP, the wire format is pinned
+// to opentelemetry-proto v0.19. Collectors implementing older versions
+// (v0.9 - v0.18) expect instrumentation library instead of scope, it is used
+// if legacy scope is configured. 64-bit integers are encoded as strings,
+// trace and span IDs as hex strings.
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+func stringAttr(key, value string) keyValue {
+	return keyValue{Key: key, Value: anyValue{StringValue: value}}
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+func instrumentationScope() scope {
+	return scope{Name: "go.ligato.io/vpp-agent", Version: version.Version()}
+}
+
+type metricsRequest struct {
+	ResourceMetrics []resourceMetrics `json:"resourceMetrics"`
+}
+
+type resourceMetrics struct {
+	Resource     resource       `json:"resource"`
+	ScopeMetrics []scopeMetrics `json:"scopeMetrics,omitempty"`
+	// used instead of ScopeMetrics before v0.19
+	InstrumentationLibraryMetrics []instrumentationLibraryMetrics `json:"instrumentationLibraryMetrics,omitempty"`
+}
+
+type scopeMetrics struct {
+	Scope   scope    `json:"scope"`
+	Metrics []metric `json:"metrics"`
+}
+
+type instrumentationLibraryMetrics struct {
+	InstrumentationLibrary scope    `json:"instrumentationLibrary"`
+	Metrics                []metric `json:"metrics"`
+}
+
+// newMetricsRequest returns request exporting the metrics of the resource.
+func newMetricsRequest(res resource, sc scope, metrics []metric, legacyScope bool) *metricsRequest {
+	rm := resourceMetrics{Resource: res}
+	if legacyScope {
+		rm.InstrumentationLibraryMetrics = []instrumentationLibraryMetrics{{
+			InstrumentationLibrary: sc,
+			Metrics:                metrics,
+		}}
+	} else {
+		rm.ScopeMetrics = []scopeMetrics{{
+			Scope:   sc,
+			Metrics: metrics,
+		}}
+	}
+	return &metricsRequest{ResourceMetrics: []resourceMetrics{rm}}
+}
+
+type metric struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Gauge       *gauge     `json:"gauge,omitempty"`
+	Sum         *sum       `json:"sum,omitempty"`
+	Summary     *summary   `json:"summary,omitempty"`
+	Histogram   *histogram `json:"histogram,omitempty"`
+}
+
+// aggregation temporality of sums and histograms exported from Prometheus
+const aggregationTemporalityCumulative = 2
+
+type gauge struct {
+	DataPoints []numberDataPoint `json:"dataPoints"`
+}
+
+type sum struct {
+	DataPoints             []numberDataPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type numberDataPoint struct {
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string     `json:"timeUnixNano"`
+	AsDouble          float64    `json:"asDouble"`
+}
+
+type summary struct {
+	DataPoints []summaryDataPoint `json:"dataPoints"`
+}
+
+type summaryDataPoint struct {
+	Attributes     []keyValue      `json:"attributes,omitempty"`
+	TimeUnixNano   string          `json:"timeUnixNano"`
+	Count          string          `json:"count"`
+	Sum            float64         `json:"sum"`
+	QuantileValues []quantileValue `json:"quantileValues,omitempty"`
+}
+
+type quantileValue struct {
+	Quantile float64 `json:"quantile"`
+	Value    float64 `json:"value"`
+}
+
+type histogram struct {
+	DataPoints             []histogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+}
+
+type histogramDataPoint struct {
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	TimeUnixNano      string     `json:"timeUnixNano"`
+	Count             string     `json:"count"`
+	Sum               float64    `json:"sum"`
+	BucketCounts      []string   `json:"bucketCounts"`
+	ExplicitBounds    []float64  `json:"explicitBounds"`
+}
+
+type tracesRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans,omitempty"`
+	// used instead of ScopeSpans before v0.19
+	InstrumentationLibrarySpans []instrumentationLibrarySpans `json:"instrumentationLibrarySpans,omitempty"`
+}
+
+type scopeSpans struct {
+	Scope scope  `json:"scope"`
+	Spans []span `json:"spans"`
+}
+
+type instrumentationLibrarySpans struct {
+	InstrumentationLibrary scope  `json:"instrumentationLibrary"`
+	Spans                  []span `json:"spans"`
+}
+
+// newTracesRequest returns request exporting the spans of the resource.
+func newTracesRequest(res resource, sc scope, spans []span, legacyScope bool) *tracesRequest {
+	rs := resourceSpans{Resource: res}
+	if legacyScope {
+		rs.InstrumentationLibrarySpans = []instrumentationLibrarySpans{{
+			InstrumentationLibrary: sc,
+			Spans:                  spans,
+		}}
+	} else {
+		rs.ScopeSpans = []scopeSpans{{
+			Scope: sc,
+			Spans: spans,
+		}}
+	}
+	return &tracesRequest{ResourceSpans: []resourceSpans{rs}}
+}
+
+// span kind used for all spans recorded by the agent
+const spanKindInternal = 1
+
+// status code of failed spans
+const statusCodeError = 2
+
+type span struct {
+	TraceID           string     `json:"traceId"`
+	SpanID            string     `json:"spanId"`
+	ParentSpanID      string     `json:"parentSpanId,omitempty"`
+	Name              string     `json:"name"`
+	Kind              int        `json:"kind"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string     `json:"endTimeUnixNano"`
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	Status            spanStatus `json:"status"`
+}
+
+type spanStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func convertSpan(s *tracing.Span) span {
+	res := span{
+		TraceID:           s.TraceID.String(),
+		SpanID:            s.SpanID.String(),
+		Name:              s.Name,
+		Kind:              spanKindInternal,
+		StartTimeUnixNano: unixNano(s.StartTime),
+		EndTimeUnixNano:   unixNano(s.EndTime),
+	}
+	if s.ParentID.IsValid() {
+		res.ParentSpanID = s.ParentID.String()
+	}
+	for _, attr := range s.Attributes {
+		res.Attributes = append(res.Attributes, stringAttr(attr.Key, attr.Value))
+	}
+	if s.Err != nil {
+		res.Status = spanStatus{Code: statusCodeError, Message: s.Err.Error()}
+	}
+	return res
+}
+
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// postJSON sends the body encoded as JSON to the collector.
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return errors.Errorf("encoding request failed: %v", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("collector returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
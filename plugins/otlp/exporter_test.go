@@ -0,0 +1,174 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package otlp
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
+)
+
+// Requests are compared with golden files encoded according to OTLP/JSON
+// of the pinned protocol version.
+
+var (
+	testStart    = time.Unix(1600000000, 0)
+	testNow      = testStart.Add(30 * time.Second)
+	testResource = resource{Attributes: []keyValue{stringAttr("service.name", "vpp-agent")}}
+	testScope    = scope{Name: "go.ligato.io/vpp-agent", Version: "v3.2.0"}
+)
+
+func expectGolden(v interface{}, file string) {
+	data, err := json.Marshal(v)
+	Expect(err).ToNot(HaveOccurred())
+	golden, err := ioutil.ReadFile(filepath.Join("testdata", file))
+	Expect(err).ToNot(HaveOccurred())
+	ExpectWithOffset(1, string(data)).To(MatchJSON(golden))
+}
+
+func testSpans() []*tracing.Span {
+	traceID := tracing.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6,
+		0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	parent := &tracing.Span{
+		TraceID:   traceID,
+		SpanID:    tracing.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		Name:      "kvscheduler.txn",
+		StartTime: testStart,
+		EndTime:   testStart.Add(25 * time.Millisecond),
+		Attributes: []tracing.Attribute{
+			tracing.Attr("txn.seq", 5),
+			tracing.Attr("txn.type", "NBTransaction"),
+		},
+	}
+	child := &tracing.Span{
+		TraceID:   traceID,
+		SpanID:    tracing.SpanID{0x53, 0x99, 0x5c, 0x3f, 0x42, 0xcd, 0x8a, 0xd8},
+		ParentID:  parent.SpanID,
+		Name:      "descriptor.create",
+		StartTime: testStart.Add(5 * time.Millisecond),
+		EndTime:   testStart.Add(20 * time.Millisecond),
+		Err:       errors.New("interface not found"),
+	}
+	return []*tracing.Span{parent, child}
+}
+
+func TestConvertSpan(t *testing.T) {
+	RegisterTestingT(t)
+
+	spans := testSpans()
+	expectGolden(convertSpan(spans[0]), "span.json")
+	expectGolden(convertSpan(spans[1]), "span_error.json")
+}
+
+func TestTracesRequest(t *testing.T) {
+	RegisterTestingT(t)
+
+	var spans []span
+	for _, s := range testSpans() {
+		spans = append(spans, convertSpan(s))
+	}
+	expectGolden(newTracesRequest(testResource, testScope, spans, false), "traces.json")
+	expectGolden(newTracesRequest(testResource, testScope, spans, true), "traces_legacy.json")
+}
+
+func testMetricFamilies() []*dto.MetricFamily {
+	label := func(name, value string) []*dto.LabelPair {
+		return []*dto.LabelPair{{Name: proto.String(name), Value: proto.String(value)}}
+	}
+	return []*dto.MetricFamily{
+		{
+			Name: proto.String("txn_count"),
+			Help: proto.String("Number of transactions"),
+			Type: dto.MetricType_COUNTER.Enum(),
+			Metric: []*dto.Metric{
+				{Label: label("type", "NB"), Counter: &dto.Counter{Value: proto.Float64(10)}},
+				{Label: label("type", "retry"), Counter: &dto.Counter{Value: proto.Float64(math.Inf(+1))}},
+			},
+		},
+		{
+			Name: proto.String("vpp_buffers_free"),
+			Type: dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{
+				{Label: label("name", "default"), Gauge: &dto.Gauge{Value: proto.Float64(1024)},
+					TimestampMs: proto.Int64(1600000010000)},
+			},
+		},
+		{
+			Name:   proto.String("up"),
+			Type:   dto.MetricType_UNTYPED.Enum(),
+			Metric: []*dto.Metric{{Untyped: &dto.Untyped{Value: proto.Float64(1)}}},
+		},
+		{
+			// all values are skipped
+			Name:   proto.String("nan_gauge"),
+			Type:   dto.MetricType_GAUGE.Enum(),
+			Metric: []*dto.Metric{{Gauge: &dto.Gauge{Value: proto.Float64(math.NaN())}}},
+		},
+		{
+			Name: proto.String("request_duration"),
+			Type: dto.MetricType_SUMMARY.Enum(),
+			Metric: []*dto.Metric{
+				{Summary: &dto.Summary{
+					SampleCount: proto.Uint64(4),
+					SampleSum:   proto.Float64(2.5),
+					Quantile: []*dto.Quantile{
+						{Quantile: proto.Float64(0.5), Value: proto.Float64(0.5)},
+						{Quantile: proto.Float64(0.99), Value: proto.Float64(math.NaN())},
+					},
+				}},
+			},
+		},
+		{
+			Name: proto.String("txn_duration"),
+			Type: dto.MetricType_HISTOGRAM.Enum(),
+			Metric: []*dto.Metric{
+				{Histogram: &dto.Histogram{
+					SampleCount: proto.Uint64(10),
+					SampleSum:   proto.Float64(1.5),
+					Bucket: []*dto.Bucket{
+						{UpperBound: proto.Float64(0.1), CumulativeCount: proto.Uint64(6)},
+						{UpperBound: proto.Float64(0.5), CumulativeCount: proto.Uint64(9)},
+						{UpperBound: proto.Float64(math.Inf(+1)), CumulativeCount: proto.Uint64(10)},
+					},
+				}},
+			},
+		},
+	}
+}
+
+func TestConvertMetrics(t *testing.T) {
+	RegisterTestingT(t)
+
+	metrics := convertMetrics(testMetricFamilies(), testStart, testNow)
+	expectGolden(metrics, "metrics.json")
+}
+
+func TestMetricsRequest(t *testing.T) {
+	RegisterTestingT(t)
+
+	metrics := convertMetrics(testMetricFamilies()[:1], testStart, testNow)
+	expectGolden(newMetricsRequest(testResource, testScope, metrics, false), "metrics_request.json")
+	expectGolden(newMetricsRequest(testResource, testScope, metrics, true), "metrics_request_legacy.json")
+}
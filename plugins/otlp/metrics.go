@@ -0,0 +1,170 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package otlp
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// convertMetrics converts metric families gathered from Prometheus registry
+// into OTLP metrics. Counters are exported as cumulative monotonic sums
+// starting at the given start time, untyped metrics as gauges.
+// Values which can not be encoded in JSON (NaN, Inf) are skipped.
+func convertMetrics(families []*dto.MetricFamily, start, now time.Time) []metric {
+	var metrics []metric
+	for _, family := range families {
+		m := metric{
+			Name:        family.GetName(),
+			Description: family.GetHelp(),
+		}
+		switch family.GetType() {
+		case dto.MetricType_COUNTER:
+			m.Sum = &sum{
+				AggregationTemporality: aggregationTemporalityCumulative,
+				IsMonotonic:            true,
+			}
+			for _, pm := range family.GetMetric() {
+				if dp, ok := numberPoint(pm, pm.GetCounter().GetValue(), start, now); ok {
+					m.Sum.DataPoints = append(m.Sum.DataPoints, dp)
+				}
+			}
+			if len(m.Sum.DataPoints) == 0 {
+				continue
+			}
+		case dto.MetricType_GAUGE, dto.MetricType_UNTYPED:
+			m.Gauge = &gauge{}
+			for _, pm := range family.GetMetric() {
+				value := pm.GetGauge().GetValue()
+				if pm.Untyped != nil {
+					value = pm.GetUntyped().GetValue()
+				}
+				if dp, ok := numberPoint(pm, value, time.Time{}, now); ok {
+					m.Gauge.DataPoints = append(m.Gauge.DataPoints, dp)
+				}
+			}
+			if len(m.Gauge.DataPoints) == 0 {
+				continue
+			}
+		case dto.MetricType_SUMMARY:
+			m.Summary = &summary{}
+			for _, pm := range family.GetMetric() {
+				s := pm.GetSummary()
+				if !isFinite(s.GetSampleSum()) {
+					continue
+				}
+				dp := summaryDataPoint{
+					Attributes:   labelsToAttributes(pm.GetLabel()),
+					TimeUnixNano: unixNano(metricTime(pm, now)),
+					Count:        strconv.FormatUint(s.GetSampleCount(), 10),
+					Sum:          s.GetSampleSum(),
+				}
+				for _, q := range s.GetQuantile() {
+					if isFinite(q.GetValue()) {
+						dp.QuantileValues = append(dp.QuantileValues, quantileValue{
+							Quantile: q.GetQuantile(),
+							Value:    q.GetValue(),
+						})
+					}
+				}
+				m.Summary.DataPoints = append(m.Summary.DataPoints, dp)
+			}
+			if len(m.Summary.DataPoints) == 0 {
+				continue
+			}
+		case dto.MetricType_HISTOGRAM:
+			m.Histogram = &histogram{
+				AggregationTemporality: aggregationTemporalityCumulative,
+			}
+			for _, pm := range family.GetMetric() {
+				if dp, ok := histogramPoint(pm, start, now); ok {
+					m.Histogram.DataPoints = append(m.Histogram.DataPoints, dp)
+				}
+			}
+			if len(m.Histogram.DataPoints) == 0 {
+				continue
+			}
+		default:
+			continue
+		}
+		metrics = append(metrics, m)
+	}
+	return metrics
+}
+
+func numberPoint(pm *dto.Metric, value float64, start, now time.Time) (numberDataPoint, bool) {
+	if !isFinite(value) {
+		return numberDataPoint{}, false
+	}
+	dp := numberDataPoint{
+		Attributes:   labelsToAttributes(pm.GetLabel()),
+		TimeUnixNano: unixNano(metricTime(pm, now)),
+		AsDouble:     value,
+	}
+	if !start.IsZero() {
+		dp.StartTimeUnixNano = unixNano(start)
+	}
+	return dp, true
+}
+
+// histogramPoint converts cumulative Prometheus buckets into OTLP bucket counts,
+// the last bucket counts values above the highest finite bound.
+func histogramPoint(pm *dto.Metric, start, now time.Time) (histogramDataPoint, bool) {
+	h := pm.GetHistogram()
+	if !isFinite(h.GetSampleSum()) {
+		return histogramDataPoint{}, false
+	}
+	dp := histogramDataPoint{
+		Attributes:        labelsToAttributes(pm.GetLabel()),
+		StartTimeUnixNano: unixNano(start),
+		TimeUnixNano:      unixNano(metricTime(pm, now)),
+		Count:             strconv.FormatUint(h.GetSampleCount(), 10),
+		Sum:               h.GetSampleSum(),
+	}
+	var prev uint64
+	for _, bucket := range h.GetBucket() {
+		if math.IsInf(bucket.GetUpperBound(), +1) {
+			continue
+		}
+		dp.ExplicitBounds = append(dp.ExplicitBounds, bucket.GetUpperBound())
+		dp.BucketCounts = append(dp.BucketCounts,
+			strconv.FormatUint(bucket.GetCumulativeCount()-prev, 10))
+		prev = bucket.GetCumulativeCount()
+	}
+	dp.BucketCounts = append(dp.BucketCounts, strconv.FormatUint(h.GetSampleCount()-prev, 10))
+	return dp, true
+}
+
+func labelsToAttributes(labels []*dto.LabelPair) []keyValue {
+	var attrs []keyValue
+	for _, label := range labels {
+		attrs = append(attrs, stringAttr(label.GetName(), label.GetValue()))
+	}
+	return attrs
+}
+
+func metricTime(pm *dto.Metric, now time.Time) time.Time {
+	if pm.TimestampMs != nil {
+		return time.Unix(0, pm.GetTimestampMs()*int64(time.Millisecond))
+	}
+	return now
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
@@ -0,0 +1,232 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package otlp implements plugin exporting metrics and trace spans
+// of the agent to a collector using OpenTelemetry protocol (OTLP/HTTP).
+package otlp
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.ligato.io/cn-infra/v2/infra"
+	"go.ligato.io/cn-infra/v2/servicelabel"
+
+	"go.ligato.io/vpp-agent/v3/pkg/tracing"
+	"go.ligato.io/vpp-agent/v3/pkg/version"
+)
+
+const (
+	metricsPath = "/v1/metrics"
+	tracesPath  = "/v1/traces"
+
+	// maximum number of spans exported in a single request
+	maxBatchSize = 512
+)
+
+// Plugin periodically pushes metrics gathered from the Prometheus registry
+// (telemetry, kvscheduler, ...) and trace spans recorded by the agent
+// to the configured OTLP collector.
+type Plugin struct {
+	Deps
+
+	config    *Config
+	client    *http.Client
+	resource  resource
+	startTime time.Time
+
+	spans   chan *tracing.Span
+	dropped uint64
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// Deps represents dependencies of OTLP Plugin
+type Deps struct {
+	infra.PluginDeps
+	ServiceLabel servicelabel.ReaderAPI
+	// Gatherer of the exported metrics
+	Gatherer prometheus.Gatherer
+}
+
+// Init initializes OTLP Plugin
+func (p *Plugin) Init() (err error) {
+	p.quit = make(chan struct{})
+	p.startTime = time.Now()
+
+	p.config, err = p.loadConfig()
+	if err != nil {
+		return err
+	}
+	if p.config.Disabled {
+		p.Log.Info("OTLP plugin disabled via config file")
+		return nil
+	}
+	if p.config.Endpoint == "" {
+		p.Log.Info("OTLP endpoint not configured, export disabled")
+		p.config.Disabled = true
+		return nil
+	}
+	p.config.Endpoint = strings.TrimSuffix(p.config.Endpoint, "/")
+
+	p.client = &http.Client{Timeout: p.config.Timeout}
+	p.resource = resource{
+		Attributes: []keyValue{
+			stringAttr("service.name", "vpp-agent"),
+			stringAttr("service.version", version.Version()),
+			stringAttr("service.instance.id", p.ServiceLabel.GetAgentLabel()),
+		},
+	}
+
+	if !p.config.TracesDisabled {
+		p.spans = make(chan *tracing.Span, p.config.MaxQueueSize)
+		tracing.SetExporter(p)
+	}
+
+	p.Log.Infof("exporting to OTLP collector at %s (metrics: %v, traces: %v)",
+		p.config.Endpoint, !p.config.MetricsDisabled, !p.config.TracesDisabled)
+
+	return nil
+}
+
+// AfterInit starts the periodic export.
+func (p *Plugin) AfterInit() error {
+	if p.config.Disabled {
+		return nil
+	}
+	if !p.config.MetricsDisabled {
+		p.wg.Add(1)
+		go p.exportMetricsPeriodically()
+	}
+	if !p.config.TracesDisabled {
+		p.wg.Add(1)
+		go p.exportSpansPeriodically()
+	}
+	return nil
+}
+
+// Close stops the export, spans which are queued are exported before return.
+func (p *Plugin) Close() error {
+	if !p.config.Disabled && !p.config.TracesDisabled {
+		tracing.SetExporter(nil)
+	}
+	close(p.quit)
+	p.wg.Wait()
+	return nil
+}
+
+// ExportSpan queues the ended span for export. The span is dropped if the queue is full.
+func (p *Plugin) ExportSpan(span *tracing.Span) {
+	select {
+	case p.spans <- span:
+	default:
+		atomic.AddUint64(&p.dropped, 1)
+	}
+}
+
+func (p *Plugin) exportMetricsPeriodically() {
+	defer p.wg.Done()
+
+	tick := time.NewTicker(p.config.MetricsInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-tick.C:
+			if err := p.exportMetrics(); err != nil {
+				p.Log.Warnf("exporting metrics failed: %v", err)
+			}
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+func (p *Plugin) exportMetrics() error {
+	families, err := p.Gatherer.Gather()
+	if err != nil {
+		// gathering may partially fail, export what was gathered
+		p.Log.Debugf("gathering metrics: %v", err)
+	}
+	metrics := convertMetrics(families, p.startTime, time.Now())
+	if len(metrics) == 0 {
+		return nil
+	}
+	req := newMetricsRequest(p.resource, instrumentationScope(), metrics, p.config.LegacyScope)
+	return p.post(metricsPath, req)
+}
+
+func (p *Plugin) exportSpansPeriodically() {
+	defer p.wg.Done()
+
+	tick := time.NewTicker(p.config.TracesInterval)
+	defer tick.Stop()
+
+	var batch []*tracing.Span
+	flush := func() {
+		if dropped := atomic.SwapUint64(&p.dropped, 0); dropped > 0 {
+			p.Log.Warnf("%d spans dropped (export queue is full)", dropped)
+		}
+		if len(batch) == 0 {
+			return
+		}
+		if err := p.exportSpans(batch); err != nil {
+			p.Log.Warnf("exporting %d spans failed: %v", len(batch), err)
+		}
+		batch = nil
+	}
+
+	for {
+		select {
+		case span := <-p.spans:
+			batch = append(batch, span)
+			if len(batch) >= maxBatchSize {
+				flush()
+			}
+		case <-tick.C:
+			flush()
+		case <-p.quit:
+			for {
+				select {
+				case span := <-p.spans:
+					batch = append(batch, span)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (p *Plugin) exportSpans(batch []*tracing.Span) error {
+	spans := make([]span, 0, len(batch))
+	for _, s := range batch {
+		spans = append(spans, convertSpan(s))
+	}
+	req := newTracesRequest(p.resource, instrumentationScope(), spans, p.config.LegacyScope)
+	return p.post(tracesPath, req)
+}
+
+func (p *Plugin) post(path string, body interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.config.Timeout)
+	defer cancel()
+	return postJSON(ctx, p.client, p.config.Endpoint+path, p.config.Headers, body)
+}
@@ -182,6 +182,55 @@ func (p *Plugin) registerNATHandlers() {
 		}
 		return p.natHandler.Nat44AddressPoolsDump()
 	})
+	// GET NAT64 IPv6 prefixes
+	p.registerHTTPHandler(resturl.Nat64Prefixes, GET, func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Nat64IPv6PrefixDump()
+	})
+	// GET NAT64 interfaces
+	p.registerHTTPHandler(resturl.Nat64Interfaces, GET, func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Nat64InterfacesDump()
+	})
+	// GET NAT64 address pools
+	p.registerHTTPHandler(resturl.Nat64AddressPools, GET, func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Nat64AddressPoolsDump()
+	})
+	// GET NAT64 static BIB entries
+	p.registerHTTPHandler(resturl.Nat64StaticBIBs, GET, func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Nat64StaticBIBsDump()
+	})
+	// GET DET44 mappings
+	p.registerHTTPHandler(resturl.Det44Mappings, GET, func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Det44MappingsDump()
+	})
+	// GET NAT44 users
+	p.registerHTTPHandler(resturl.NatUsers, GET, func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Nat44UsersDump()
+	})
+	// GET NAT44 sessions
+	p.registerHTTPHandler(resturl.NatSessions, GET, func() (interface{}, error) {
+		if p.natHandler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.natHandler.Nat44SessionsDump()
+	})
 }
 
 // Registers L2 plugin REST handlers
@@ -253,6 +302,13 @@ func (p *Plugin) registerL3Handlers() {
 		}
 		return p.l3Handler.DumpVrrpEntries()
 	})
+	// GET multicast routes
+	p.registerHTTPHandler(resturl.MRoutes, GET, func() (interface{}, error) {
+		if p.l3Handler == nil {
+			return nil, ErrHandlerUnavailable
+		}
+		return p.l3Handler.DumpMRoutes()
+	})
 }
 
 // Registers IPSec plugin REST handlers
@@ -313,6 +369,10 @@ func (p *Plugin) registerLinuxL3Handlers() {
 	p.registerHTTPHandler(resturl.LinuxArps, GET, func() (interface{}, error) {
 		return p.linuxL3Handler.DumpARPEntries()
 	})
+	// GET linux policy routing rules
+	p.registerHTTPHandler(resturl.LinuxRules, GET, func() (interface{}, error) {
+		return p.linuxL3Handler.DumpRules()
+	})
 }
 
 // Registers Telemetry handler
@@ -34,6 +34,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/orchestrator"
 	"go.ligato.io/vpp-agent/v3/plugins/restapi/resturl"
 	telemetryvppcalls "go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 	abfvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/abfplugin/vppcalls"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin"
 	aclvppcalls "go.ligato.io/vpp-agent/v3/plugins/vpp/aclplugin/vppcalls"
@@ -126,54 +127,56 @@ func (p *Plugin) Init() (err error) {
 	// Linux Indexes
 	linuxIfIndexes := p.LinuxIfPlugin.GetInterfaceIndex()
 
-	// Initialize VPP handlers
-	p.vpeHandler, err = vpevppcalls.NewHandler(p.VPP)
+	// Initialize VPP handlers, requests sent by REST handlers
+	// are attributed to this plugin
+	vppClient := vpp.WithCaller(p.VPP, p.String())
+	p.vpeHandler, err = vpevppcalls.NewHandler(vppClient)
 	if err != nil {
 		return fmt.Errorf("VPP core handler error: %w", err)
 	} else if p.vpeHandler == nil {
 		p.Log.Info("VPP core handler is not available, it will be skipped")
 	}
-	p.teleHandler = telemetryvppcalls.CompatibleTelemetryHandler(p.VPP)
+	p.teleHandler = telemetryvppcalls.CompatibleTelemetryHandler(vppClient)
 	if p.teleHandler == nil {
 		p.Log.Info("VPP Telemetry handler is not available, it will be skipped")
 	}
 
 	// core
-	p.ifHandler = ifvppcalls.CompatibleInterfaceVppHandler(p.VPP, p.Log)
+	p.ifHandler = ifvppcalls.CompatibleInterfaceVppHandler(vppClient, p.Log)
 	if p.ifHandler == nil {
 		p.Log.Info("VPP Interface handler is not available, it will be skipped")
 	}
-	p.l2Handler = l2vppcalls.CompatibleL2VppHandler(p.VPP, ifIndexes, bdIndexes, p.Log)
+	p.l2Handler = l2vppcalls.CompatibleL2VppHandler(vppClient, ifIndexes, bdIndexes, p.Log)
 	if p.l2Handler == nil {
 		p.Log.Info("VPP L2 handler is not available, it will be skipped")
 	}
-	p.l3Handler = l3vppcalls.CompatibleL3VppHandler(p.VPP, ifIndexes, vrfIndexes, p.AddrAlloc, p.Log)
+	p.l3Handler = l3vppcalls.CompatibleL3VppHandler(vppClient, ifIndexes, vrfIndexes, p.AddrAlloc, p.Log)
 	if p.l3Handler == nil {
 		p.Log.Info("VPP L3 handler is not available, it will be skipped")
 	}
-	p.ipSecHandler = ipsecvppcalls.CompatibleIPSecVppHandler(p.VPP, ifIndexes, p.Log)
+	p.ipSecHandler = ipsecvppcalls.CompatibleIPSecVppHandler(vppClient, ifIndexes, p.Log)
 	if p.ipSecHandler == nil {
 		p.Log.Info("VPP IPSec handler is not available, it will be skipped")
 	}
 
 	// plugins (might not be available - disabled)
-	p.abfHandler = abfvppcalls.CompatibleABFHandler(p.VPP, aclIndexes, ifIndexes, p.Log)
+	p.abfHandler = abfvppcalls.CompatibleABFHandler(vppClient, aclIndexes, ifIndexes, p.Log)
 	if p.abfHandler == nil {
 		p.Log.Infof("ABF handler is not available, it will be skipped")
 	}
-	p.aclHandler = aclvppcalls.CompatibleACLHandler(p.VPP, ifIndexes)
+	p.aclHandler = aclvppcalls.CompatibleACLHandler(vppClient, ifIndexes)
 	if p.aclHandler == nil {
 		p.Log.Infof("ACL handler is not available, it will be skipped")
 	}
-	p.natHandler = natvppcalls.CompatibleNatVppHandler(p.VPP, ifIndexes, dhcpIndexes, p.Log)
+	p.natHandler = natvppcalls.CompatibleNatVppHandler(vppClient, ifIndexes, dhcpIndexes, p.Log)
 	if p.natHandler == nil {
 		p.Log.Infof("NAT handler is not available, it will be skipped")
 	}
-	p.puntHandler = puntvppcalls.CompatiblePuntVppHandler(p.VPP, ifIndexes, p.Log)
+	p.puntHandler = puntvppcalls.CompatiblePuntVppHandler(vppClient, ifIndexes, p.Log)
 	if p.puntHandler == nil {
 		p.Log.Infof("Punt handler is not available, it will be skipped")
 	}
-	p.wireguardHandler = wireguardvppcalls.CompatibleWgVppHandler(p.VPP, ifIndexes, p.Log)
+	p.wireguardHandler = wireguardvppcalls.CompatibleWgVppHandler(vppClient, ifIndexes, p.Log)
 	if p.wireguardHandler == nil {
 		p.Log.Info("Wireguard handler is not available, it will be skipped")
 	}
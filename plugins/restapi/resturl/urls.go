@@ -47,6 +47,8 @@ const (
 	LinuxRoutes = "/dump/linux/v2/routes"
 	// LinuxArps is the rest linux ARPs path
 	LinuxArps = "/dump/linux/v2/arps"
+	// LinuxRules is the rest linux policy routing rules path
+	LinuxRules = "/dump/linux/v2/rules"
 )
 
 // VPP ABF
@@ -92,6 +94,20 @@ const (
 	NatInterfaces = "/dump/vpp/v2/nat/interfaces"
 	// NatAddressPools is a REST path of NAT address pools config
 	NatAddressPools = "/dump/vpp/v2/nat/pools"
+	// Nat64Prefixes is a REST path of NAT64 IPv6 prefixes config
+	Nat64Prefixes = "/dump/vpp/v2/nat/nat64/prefixes"
+	// Nat64Interfaces is a REST path of NAT64 interfaces config
+	Nat64Interfaces = "/dump/vpp/v2/nat/nat64/interfaces"
+	// Nat64AddressPools is a REST path of NAT64 address pools config
+	Nat64AddressPools = "/dump/vpp/v2/nat/nat64/pools"
+	// Nat64StaticBIBs is a REST path of NAT64 static BIB entries
+	Nat64StaticBIBs = "/dump/vpp/v2/nat/nat64/static-bibs"
+	// Det44Mappings is a REST path of deterministic NAT44 mappings
+	Det44Mappings = "/dump/vpp/v2/nat/det44/mappings"
+	// NatUsers is a REST path of NAT44 users state
+	NatUsers = "/dump/vpp/v2/nat/users"
+	// NatSessions is a REST path of NAT44 sessions state
+	NatSessions = "/dump/vpp/v2/nat/sessions"
 )
 
 // L2 plugin
@@ -118,6 +134,8 @@ const (
 	IPScanNeigh = "/dump/vpp/v2/ipscanneigh"
 	// Vrrps is rest vrrp entries path
 	Vrrps = "/dump/vpp/v2/vrrps"
+	// MRoutes is rest multicast routes path
+	MRoutes = "/dump/vpp/v2/mroutes"
 )
 
 // VPP IPSec plugin
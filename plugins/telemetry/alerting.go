@@ -0,0 +1,548 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+)
+
+const (
+	// default number of recent alert events kept
+	defaultAlertHistorySize = 100
+	// timeout of the webhook request
+	alertWebhookTimeout = time.Second * 5
+	// size of the buffer of alert watchers, events are dropped for slow watchers
+	alertWatcherBufferSize = 100
+	// size of the queue of events posted to the webhook, events are dropped
+	// if the webhook is not keeping up
+	alertWebhookQueueSize = 100
+)
+
+// Metrics which can be used in alert rules and recorded in history.
+const (
+	alertInterfacePrefix = "interface."
+	alertNodePrefix      = "node."
+	alertBufferPrefix    = "buffer."
+
+	alertIfRxPps       = alertInterfacePrefix + ifRateRxPps
+	alertIfTxPps       = alertInterfacePrefix + ifRateTxPps
+	alertIfRxBps       = alertInterfacePrefix + ifRateRxBps
+	alertIfTxBps       = alertInterfacePrefix + ifRateTxBps
+	alertIfDropRate    = alertInterfacePrefix + ifRateDropRate
+	alertNodeCalls     = alertNodePrefix + nodeRateCallsMetric
+	alertNodeVectors   = alertNodePrefix + nodeRateVectorsMetric
+	alertNodeErrors    = alertNodePrefix + nodeRateErrorsMetric
+	alertBufferFree    = alertBufferPrefix + buffersFreeMetric
+	alertBufferAlloc   = alertBufferPrefix + buffersAllocMetric
+	alertBufferFreePct = alertBufferPrefix + "free_percent"
+)
+
+// alertMetrics defines units which can be used in thresholds of the metrics,
+// threshold without unit is accepted for all metrics.
+var alertMetrics = map[string][]string{
+	alertIfRxPps:       {"pps", "/s"},
+	alertIfTxPps:       {"pps", "/s"},
+	alertIfRxBps:       {"bps"},
+	alertIfTxBps:       {"bps"},
+	alertIfDropRate:    {"pps", "/s"},
+	alertNodeCalls:     {"/s"},
+	alertNodeVectors:   {"/s"},
+	alertNodeErrors:    {"/s"},
+	alertBufferFree:    nil,
+	alertBufferAlloc:   nil,
+	alertBufferFreePct: {"%"},
+}
+
+// <metric> <op> <threshold>[multiplier][unit] [for <duration>]
+var alertExprRegexp = regexp.MustCompile(
+	`^\s*([a-z_]+\.[a-z_]+)\s*(>=|<=|>|<)\s*([0-9]*\.?[0-9]+)\s*([kMG]?)(pps|bps|/s|%)?\s*(?:for\s+(\S+))?\s*$`)
+
+var alertMultipliers = map[string]float64{
+	"":  1,
+	"k": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+}
+
+var (
+	// ErrAlertRuleWithoutName is returned when alert rule has no name.
+	ErrAlertRuleWithoutName = errors.New("alert rule name is not defined")
+	// ErrAlertRuleNotFound is returned when deleted alert rule does not exist.
+	ErrAlertRuleNotFound = errors.New("alert rule not found")
+)
+
+// alertRule is a parsed alert rule with the alert state of evaluated objects.
+type alertRule struct {
+	rule      *configurator.AlertRule
+	metric    string
+	operator  string
+	threshold float64
+	duration  time.Duration
+	match     *regexp.Regexp
+
+	// alert state by object name
+	states map[string]*alertState
+}
+
+// alertState is a state of an alert rule evaluated for a single object.
+type alertState struct {
+	// time since the condition holds (zero if it does not)
+	pendingSince time.Time
+	// last firing event (nil if not firing)
+	firing *configurator.AlertEvent
+}
+
+func parseAlertRule(rule *configurator.AlertRule) (*alertRule, error) {
+	if rule.GetName() == "" {
+		return nil, ErrAlertRuleWithoutName
+	}
+	m := alertExprRegexp.FindStringSubmatch(rule.GetExpr())
+	if m == nil {
+		return nil, errors.Errorf("alert rule %s: invalid expression %q", rule.GetName(), rule.GetExpr())
+	}
+	parsed := &alertRule{
+		rule:     rule,
+		metric:   m[1],
+		operator: m[2],
+		states:   make(map[string]*alertState),
+	}
+	units, ok := alertMetrics[parsed.metric]
+	if !ok {
+		return nil, errors.Errorf("alert rule %s: unsupported metric %q", rule.GetName(), parsed.metric)
+	}
+	if unit := m[5]; unit != "" && !containsString(units, unit) {
+		return nil, errors.Errorf("alert rule %s: unit %q cannot be used with metric %s",
+			rule.GetName(), unit, parsed.metric)
+	}
+	threshold, err := strconv.ParseFloat(m[3], 64)
+	if err != nil {
+		return nil, errors.Errorf("alert rule %s: invalid threshold %q: %v", rule.GetName(), m[3], err)
+	}
+	parsed.threshold = threshold * alertMultipliers[m[4]]
+	if m[6] != "" {
+		if parsed.duration, err = time.ParseDuration(m[6]); err != nil {
+			return nil, errors.Errorf("alert rule %s: invalid duration %q: %v", rule.GetName(), m[6], err)
+		}
+	}
+	if rule.GetMatch() != "" {
+		if parsed.match, err = regexp.Compile(rule.GetMatch()); err != nil {
+			return nil, errors.Errorf("alert rule %s: invalid match %q: %v", rule.GetName(), rule.GetMatch(), err)
+		}
+	}
+	return parsed, nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// holds returns true if the condition of the rule holds for the value.
+func (r *alertRule) holds(value float64) bool {
+	switch r.operator {
+	case ">":
+		return value > r.threshold
+	case ">=":
+		return value >= r.threshold
+	case "<":
+		return value < r.threshold
+	case "<=":
+		return value <= r.threshold
+	}
+	return false
+}
+
+func (r *alertRule) newEvent(object string, state configurator.AlertEvent_State,
+	value float64, now time.Time) *configurator.AlertEvent {
+	return &configurator.AlertEvent{
+		Rule:      r.rule.GetName(),
+		Expr:      r.rule.GetExpr(),
+		Object:    object,
+		State:     state,
+		Value:     value,
+		Timestamp: now.UnixNano() / int64(time.Millisecond),
+	}
+}
+
+// metricValues are values of metrics by metric name and object name.
+type metricValues map[string]map[string]float64
+
+func (v metricValues) set(metric, object string, value float64) {
+	if v[metric] == nil {
+		v[metric] = make(map[string]float64)
+	}
+	v[metric][object] = value
+}
+
+// alerter evaluates alert rules and distributes alert events.
+type alerter struct {
+	log         logging.Logger
+	webhook     string
+	historySize int
+	client      *http.Client
+
+	mu       sync.Mutex
+	rules    map[string]*alertRule
+	recent   []*configurator.AlertEvent
+	watchers map[chan *configurator.AlertEvent]map[string]bool
+
+	// events are posted to the webhook one by one by a single sender
+	webhookQueue chan *configurator.AlertEvent
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+}
+
+func newAlerter(log logging.Logger, webhook string, historySize int) *alerter {
+	if historySize <= 0 {
+		historySize = defaultAlertHistorySize
+	}
+	a := &alerter{
+		log:         log,
+		webhook:     webhook,
+		historySize: historySize,
+		client:      &http.Client{Timeout: alertWebhookTimeout},
+		rules:       make(map[string]*alertRule),
+		watchers:    make(map[chan *configurator.AlertEvent]map[string]bool),
+	}
+	if webhook != "" {
+		ctx, cancel := context.WithCancel(context.Background())
+		a.webhookQueue = make(chan *configurator.AlertEvent, alertWebhookQueueSize)
+		a.cancel = cancel
+		a.wg.Add(1)
+		go a.sendWebhooks(ctx)
+	}
+	return a
+}
+
+// close stops the webhook sender, queued events are dropped.
+func (a *alerter) close() {
+	if a == nil || a.cancel == nil {
+		return
+	}
+	a.cancel()
+	a.wg.Wait()
+}
+
+// setRule adds or replaces the alert rule. Alerts firing for the replaced rule are resolved.
+func (a *alerter) setRule(rule *configurator.AlertRule) error {
+	parsed, err := parseAlertRule(rule)
+	if err != nil {
+		return err
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if prev, ok := a.rules[rule.GetName()]; ok {
+		a.resolveAll(prev, time.Now())
+	}
+	a.rules[rule.GetName()] = parsed
+	return nil
+}
+
+// deleteRule removes the alert rule. Alerts firing for the rule are resolved.
+func (a *alerter) deleteRule(name string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	rule, ok := a.rules[name]
+	if !ok {
+		return ErrAlertRuleNotFound
+	}
+	a.resolveAll(rule, time.Now())
+	delete(a.rules, name)
+	return nil
+}
+
+// listRules returns all alert rules sorted by name.
+func (a *alerter) listRules() []*configurator.AlertRule {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var rules []*configurator.AlertRule
+	for _, name := range a.ruleNames() {
+		rules = append(rules, a.rules[name].rule)
+	}
+	return rules
+}
+
+// listAlerts returns currently firing alerts and recent alert events.
+func (a *alerter) listAlerts() (firing, recent []*configurator.AlertEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, name := range a.ruleNames() {
+		rule := a.rules[name]
+		objects := make([]string, 0, len(rule.states))
+		for object := range rule.states {
+			objects = append(objects, object)
+		}
+		sort.Strings(objects)
+		for _, object := range objects {
+			if event := rule.states[object].firing; event != nil {
+				firing = append(firing, event)
+			}
+		}
+	}
+	recent = append(recent, a.recent...)
+	return firing, recent
+}
+
+// usesMetrics returns true if any rule uses metric with the given prefix.
+func (a *alerter) usesMetrics(prefix string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, rule := range a.rules {
+		if strings.HasPrefix(rule.metric, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate evaluates all alert rules over the given values. Alerts firing for
+// objects which are no longer present (e.g. removed interfaces) are resolved.
+// Rules of metrics without any samples (e.g. rates not computed yet or stats
+// which failed to be read) are not evaluated and keep their alert state.
+func (a *alerter) evaluate(values metricValues, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, name := range a.ruleNames() {
+		rule := a.rules[name]
+		samples, ok := values[rule.metric]
+		if !ok {
+			continue
+		}
+		objects := make([]string, 0, len(samples))
+		for object := range samples {
+			if rule.match == nil || rule.match.MatchString(object) {
+				objects = append(objects, object)
+			}
+		}
+		sort.Strings(objects)
+
+		for _, object := range objects {
+			value := samples[object]
+			state, ok := rule.states[object]
+			if !ok {
+				state = &alertState{}
+				rule.states[object] = state
+			}
+			if !rule.holds(value) {
+				state.pendingSince = time.Time{}
+				if state.firing != nil {
+					state.firing = nil
+					a.emit(rule.newEvent(object, configurator.AlertEvent_RESOLVED, value, now))
+				}
+				continue
+			}
+			if state.pendingSince.IsZero() {
+				state.pendingSince = now
+			}
+			if state.firing == nil && now.Sub(state.pendingSince) >= rule.duration {
+				state.firing = rule.newEvent(object, configurator.AlertEvent_FIRING, value, now)
+				a.emit(state.firing)
+			}
+		}
+
+		for object, state := range rule.states {
+			if _, present := samples[object]; present {
+				continue
+			}
+			if state.firing != nil {
+				a.emit(rule.newEvent(object, configurator.AlertEvent_RESOLVED, state.firing.Value, now))
+			}
+			delete(rule.states, object)
+		}
+	}
+}
+
+// watch registers watcher of alert events of the given rules (all rules if empty).
+// The returned function unregisters the watcher.
+func (a *alerter) watch(rules []string) (<-chan *configurator.AlertEvent, func()) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	ch := make(chan *configurator.AlertEvent, alertWatcherBufferSize)
+	filter := make(map[string]bool)
+	for _, rule := range rules {
+		filter[rule] = true
+	}
+	a.watchers[ch] = filter
+
+	return ch, func() {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		delete(a.watchers, ch)
+	}
+}
+
+// resolveAll resolves all alerts firing for the rule, called with lock held.
+func (a *alerter) resolveAll(rule *alertRule, now time.Time) {
+	objects := make([]string, 0, len(rule.states))
+	for object := range rule.states {
+		objects = append(objects, object)
+	}
+	sort.Strings(objects)
+	for _, object := range objects {
+		if firing := rule.states[object].firing; firing != nil {
+			a.emit(rule.newEvent(object, configurator.AlertEvent_RESOLVED, firing.Value, now))
+		}
+	}
+}
+
+// emit records the event and sends it to the watchers and the webhook,
+// called with lock held.
+func (a *alerter) emit(event *configurator.AlertEvent) {
+	if event.State == configurator.AlertEvent_FIRING {
+		a.log.Warnf("alert %s firing for %s: %s (value: %v)", event.Rule, event.Object, event.Expr, event.Value)
+	} else {
+		a.log.Infof("alert %s resolved for %s (value: %v)", event.Rule, event.Object, event.Value)
+	}
+
+	a.recent = append(a.recent, event)
+	if len(a.recent) > a.historySize {
+		a.recent = a.recent[len(a.recent)-a.historySize:]
+	}
+
+	for ch, filter := range a.watchers {
+		if len(filter) > 0 && !filter[event.Rule] {
+			continue
+		}
+		select {
+		case ch <- event:
+		default:
+			a.log.Warnf("alert watcher is not keeping up, dropping event for %s", event.Rule)
+		}
+	}
+
+	if a.webhookQueue != nil {
+		select {
+		case a.webhookQueue <- event:
+		default:
+			a.log.Warnf("alert webhook is not keeping up, dropping event for %s", event.Rule)
+		}
+	}
+}
+
+// sendWebhooks posts queued events to the webhook until the context is cancelled.
+func (a *alerter) sendWebhooks(ctx context.Context) {
+	defer a.wg.Done()
+
+	for {
+		select {
+		case event := <-a.webhookQueue:
+			a.postWebhook(ctx, event)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *alerter) postWebhook(ctx context.Context, event *configurator.AlertEvent) {
+	data, err := json.Marshal(event)
+	if err != nil {
+		a.log.Errorf("encoding alert event failed: %v", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, alertWebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.webhook, bytes.NewReader(data))
+	if err != nil {
+		a.log.Errorf("alert webhook request failed: %v", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		a.log.Warnf("alert webhook request failed: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		a.log.Warnf("alert webhook returned %s", resp.Status)
+	}
+}
+
+func (a *alerter) ruleNames() []string {
+	names := make([]string, 0, len(a.rules))
+	for name := range a.rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// collectValues collects values of metrics used by alert rules and history
+// from the last computed rates and the buffer stats read at the last polling.
+// Rates are not collected until they are computed from two samples.
+func (p *Plugin) collectValues(stats *vppStats) metricValues {
+	values := make(metricValues)
+
+	var ifRates []*vpp.InterfaceRates
+	var nodeRates []*vpp.NodeRates
+	if p.rates.sampled() {
+		ifRates, nodeRates = p.rates.getRates()
+	}
+	for _, rates := range ifRates {
+		values.set(alertIfRxPps, rates.Name, rates.RxPps)
+		values.set(alertIfTxPps, rates.Name, rates.TxPps)
+		values.set(alertIfRxBps, rates.Name, rates.RxBps)
+		values.set(alertIfTxBps, rates.Name, rates.TxBps)
+		values.set(alertIfDropRate, rates.Name, rates.DropRate)
+	}
+	for _, rates := range nodeRates {
+		values.set(alertNodeCalls, rates.Name, rates.CallsPerSec)
+		values.set(alertNodeVectors, rates.Name, rates.VectorsPerSec)
+		values.set(alertNodeErrors, rates.Name, rates.ErrorsPerSec)
+	}
+
+	// buffer pools are summed over all threads
+	free := make(map[string]uint64)
+	alloc := make(map[string]uint64)
+	for _, item := range stats.buffersInfo.GetItems() {
+		free[item.Name] += item.Free
+		alloc[item.Name] += item.Alloc
+	}
+	for name := range free {
+		values.set(alertBufferFree, name, float64(free[name]))
+		values.set(alertBufferAlloc, name, float64(alloc[name]))
+		if total := free[name] + alloc[name]; total > 0 {
+			values.set(alertBufferFreePct, name, 100*float64(free[name])/float64(total))
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,135 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/pkg/errors"
+	"github.com/unrolled/render"
+	"go.ligato.io/cn-infra/v2/rpc/rest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+)
+
+const (
+	// REST endpoints of alerting
+	alertsURL     = "/telemetry/alerts"
+	alertRulesURL = "/telemetry/alerts/rules"
+	alertRuleURL  = "/telemetry/alerts/rules/{name}"
+)
+
+type alertingServer struct {
+	configurator.UnimplementedAlertingServiceServer
+
+	alerter *alerter
+}
+
+func (s *alertingServer) WatchAlerts(req *configurator.WatchAlertsRequest, svr configurator.AlertingService_WatchAlertsServer) error {
+	events, cancel := s.alerter.watch(req.GetRules())
+	defer cancel()
+
+	for {
+		select {
+		case event := <-events:
+			if err := svr.Send(event); err != nil {
+				return err
+			}
+		case <-svr.Context().Done():
+			return svr.Context().Err()
+		}
+	}
+}
+
+func (s *alertingServer) ListAlerts(ctx context.Context, req *configurator.ListAlertsRequest) (*configurator.ListAlertsResponse, error) {
+	firing, recent := s.alerter.listAlerts()
+	return &configurator.ListAlertsResponse{
+		Firing: firing,
+		Recent: recent,
+	}, nil
+}
+
+func (s *alertingServer) ListAlertRules(ctx context.Context, req *configurator.ListAlertRulesRequest) (*configurator.ListAlertRulesResponse, error) {
+	return &configurator.ListAlertRulesResponse{
+		Rules: s.alerter.listRules(),
+	}, nil
+}
+
+func (s *alertingServer) SetAlertRule(ctx context.Context, req *configurator.SetAlertRuleRequest) (*configurator.SetAlertRuleResponse, error) {
+	if err := s.alerter.setRule(req.GetRule()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &configurator.SetAlertRuleResponse{}, nil
+}
+
+func (s *alertingServer) DeleteAlertRule(ctx context.Context, req *configurator.DeleteAlertRuleRequest) (*configurator.DeleteAlertRuleResponse, error) {
+	if err := s.alerter.deleteRule(req.GetName()); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &configurator.DeleteAlertRuleResponse{}, nil
+}
+
+func (s *alertingServer) registerHTTPHandlers(handlers rest.HTTPHandlers) {
+	handlers.RegisterHTTPHandler(alertsURL, s.alertsHandler, http.MethodGet)
+	handlers.RegisterHTTPHandler(alertRulesURL, s.alertRulesHandler, http.MethodGet)
+	handlers.RegisterHTTPHandler(alertRulesURL, s.setAlertRuleHandler, http.MethodPost)
+	handlers.RegisterHTTPHandler(alertRuleURL, s.deleteAlertRuleHandler, http.MethodDelete)
+}
+
+func (s *alertingServer) alertsHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp, _ := s.ListAlerts(req.Context(), &configurator.ListAlertsRequest{})
+		_ = formatter.JSON(w, http.StatusOK, resp)
+	}
+}
+
+func (s *alertingServer) alertRulesHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		resp, _ := s.ListAlertRules(req.Context(), &configurator.ListAlertRulesRequest{})
+		_ = formatter.JSON(w, http.StatusOK, resp)
+	}
+}
+
+func (s *alertingServer) setAlertRuleHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		rule := &configurator.AlertRule{}
+		if err := json.NewDecoder(req.Body).Decode(rule); err != nil {
+			err = errors.Errorf("decoding alert rule failed: %v", err)
+			_ = formatter.JSON(w, http.StatusBadRequest, struct{ Error string }{err.Error()})
+			return
+		}
+		if err := s.alerter.setRule(rule); err != nil {
+			_ = formatter.JSON(w, http.StatusBadRequest, struct{ Error string }{err.Error()})
+			return
+		}
+		_ = formatter.JSON(w, http.StatusOK, rule)
+	}
+}
+
+func (s *alertingServer) deleteAlertRuleHandler(formatter *render.Render) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		name := mux.Vars(req)["name"]
+		if err := s.alerter.deleteRule(name); err != nil {
+			_ = formatter.JSON(w, http.StatusNotFound, struct{ Error string }{err.Error()})
+			return
+		}
+		_ = formatter.JSON(w, http.StatusOK, struct{}{})
+	}
+}
@@ -0,0 +1,309 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+)
+
+func TestParseAlertRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      *configurator.AlertRule
+		metric    string
+		operator  string
+		threshold float64
+		duration  time.Duration
+		err       bool
+	}{
+		{
+			name:      "packet rate with unit",
+			rule:      &configurator.AlertRule{Name: "r", Expr: "interface.drop_rate > 1000pps"},
+			metric:    alertIfDropRate,
+			operator:  ">",
+			threshold: 1000,
+		},
+		{
+			name:      "bit rate with multiplier and duration",
+			rule:      &configurator.AlertRule{Name: "r", Expr: " interface.rx_bps>=1.5Gbps for 1m30s "},
+			metric:    alertIfRxBps,
+			operator:  ">=",
+			threshold: 1.5e9,
+			duration:  time.Minute + 30*time.Second,
+		},
+		{
+			name:      "node rate",
+			rule:      &configurator.AlertRule{Name: "r", Expr: "node.errors_per_sec > 10k/s for 30s"},
+			metric:    alertNodeErrors,
+			operator:  ">",
+			threshold: 1e4,
+			duration:  30 * time.Second,
+		},
+		{
+			name:      "percentage",
+			rule:      &configurator.AlertRule{Name: "r", Expr: "buffer.free_percent < 5%", Match: "^default"},
+			metric:    alertBufferFreePct,
+			operator:  "<",
+			threshold: 5,
+		},
+		{
+			name:      "without unit",
+			rule:      &configurator.AlertRule{Name: "r", Expr: "buffer.free <= .5k"},
+			metric:    alertBufferFree,
+			operator:  "<=",
+			threshold: 500,
+		},
+		{
+			name: "without name",
+			rule: &configurator.AlertRule{Expr: "buffer.free < 10"},
+			err:  true,
+		},
+		{
+			name: "invalid expression",
+			rule: &configurator.AlertRule{Name: "r", Expr: "buffer.free == 10"},
+			err:  true,
+		},
+		{
+			name: "unsupported metric",
+			rule: &configurator.AlertRule{Name: "r", Expr: "interface.mtu > 1500"},
+			err:  true,
+		},
+		{
+			name: "packet unit of bit rate",
+			rule: &configurator.AlertRule{Name: "r", Expr: "interface.rx_bps > 1Mpps"},
+			err:  true,
+		},
+		{
+			name: "percentage of node rate",
+			rule: &configurator.AlertRule{Name: "r", Expr: "node.calls_per_sec > 5%"},
+			err:  true,
+		},
+		{
+			name: "unit of buffer count",
+			rule: &configurator.AlertRule{Name: "r", Expr: "buffer.alloc > 100/s"},
+			err:  true,
+		},
+		{
+			name: "invalid duration",
+			rule: &configurator.AlertRule{Name: "r", Expr: "buffer.free < 10 for 5"},
+			err:  true,
+		},
+		{
+			name: "invalid match",
+			rule: &configurator.AlertRule{Name: "r", Expr: "buffer.free < 10", Match: "("},
+			err:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			rule, err := parseAlertRule(test.rule)
+			if test.err {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rule.metric).To(Equal(test.metric))
+			Expect(rule.operator).To(Equal(test.operator))
+			Expect(rule.threshold).To(BeNumerically("~", test.threshold))
+			Expect(rule.duration).To(Equal(test.duration))
+			Expect(rule.match != nil).To(Equal(test.rule.Match != ""))
+		})
+	}
+}
+
+type alertStep struct {
+	// offset from the start of the test
+	at     time.Duration
+	values metricValues
+	// expected events (object: state)
+	events map[string]configurator.AlertEvent_State
+}
+
+func TestAlertEvaluate(t *testing.T) {
+	drops := func(values map[string]float64) metricValues {
+		return metricValues{alertIfDropRate: values}
+	}
+	tests := []struct {
+		name  string
+		rule  *configurator.AlertRule
+		steps []alertStep
+	}{
+		{
+			name: "fires immediately",
+			rule: &configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 100"},
+			steps: []alertStep{
+				{at: 0, values: drops(map[string]float64{"a": 50, "b": 200}),
+					events: map[string]configurator.AlertEvent_State{"b": configurator.AlertEvent_FIRING}},
+				{at: 5 * time.Second, values: drops(map[string]float64{"a": 50, "b": 300})},
+				{at: 10 * time.Second, values: drops(map[string]float64{"a": 50, "b": 100}),
+					events: map[string]configurator.AlertEvent_State{"b": configurator.AlertEvent_RESOLVED}},
+			},
+		},
+		{
+			name: "fires after duration",
+			rule: &configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 100 for 10s"},
+			steps: []alertStep{
+				{at: 0, values: drops(map[string]float64{"a": 200})},
+				{at: 5 * time.Second, values: drops(map[string]float64{"a": 200})},
+				{at: 10 * time.Second, values: drops(map[string]float64{"a": 200}),
+					events: map[string]configurator.AlertEvent_State{"a": configurator.AlertEvent_FIRING}},
+				{at: 15 * time.Second, values: drops(map[string]float64{"a": 200})},
+			},
+		},
+		{
+			name: "pending condition is restarted",
+			rule: &configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 100 for 10s"},
+			steps: []alertStep{
+				{at: 0, values: drops(map[string]float64{"a": 200})},
+				{at: 5 * time.Second, values: drops(map[string]float64{"a": 50})},
+				{at: 10 * time.Second, values: drops(map[string]float64{"a": 200})},
+				{at: 15 * time.Second, values: drops(map[string]float64{"a": 200})},
+				{at: 20 * time.Second, values: drops(map[string]float64{"a": 200}),
+					events: map[string]configurator.AlertEvent_State{"a": configurator.AlertEvent_FIRING}},
+			},
+		},
+		{
+			name: "resolved for removed object",
+			rule: &configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 100"},
+			steps: []alertStep{
+				{at: 0, values: drops(map[string]float64{"a": 200, "b": 200}),
+					events: map[string]configurator.AlertEvent_State{
+						"a": configurator.AlertEvent_FIRING,
+						"b": configurator.AlertEvent_FIRING,
+					}},
+				{at: 5 * time.Second, values: drops(map[string]float64{"a": 200}),
+					events: map[string]configurator.AlertEvent_State{"b": configurator.AlertEvent_RESOLVED}},
+			},
+		},
+		{
+			name: "state kept without samples",
+			rule: &configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 100"},
+			steps: []alertStep{
+				{at: 0, values: drops(map[string]float64{"a": 200}),
+					events: map[string]configurator.AlertEvent_State{"a": configurator.AlertEvent_FIRING}},
+				{at: 5 * time.Second, values: metricValues{}},
+				{at: 10 * time.Second, values: drops(map[string]float64{"a": 200})},
+			},
+		},
+		{
+			name: "objects filtered by match",
+			rule: &configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 100", Match: "^tap"},
+			steps: []alertStep{
+				{at: 0, values: drops(map[string]float64{"tap0": 200, "memif0": 200}),
+					events: map[string]configurator.AlertEvent_State{"tap0": configurator.AlertEvent_FIRING}},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			a := newAlerter(logrus.NewLogger("test"), "", 0)
+			Expect(a.setRule(test.rule)).To(Succeed())
+			events, cancel := a.watch(nil)
+			defer cancel()
+
+			start := time.Now()
+			for i, step := range test.steps {
+				a.evaluate(step.values, start.Add(step.at))
+				received := make(map[string]configurator.AlertEvent_State)
+				for len(events) > 0 {
+					event := <-events
+					received[event.Object] = event.State
+				}
+				if step.events == nil {
+					step.events = map[string]configurator.AlertEvent_State{}
+				}
+				Expect(received).To(Equal(step.events), "step %d", i)
+			}
+		})
+	}
+}
+
+func TestAlertRuleChanges(t *testing.T) {
+	RegisterTestingT(t)
+
+	a := newAlerter(logrus.NewLogger("test"), "", 2)
+	Expect(a.setRule(&configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 100"})).To(Succeed())
+	a.evaluate(metricValues{alertIfDropRate: {"a": 200}}, time.Now())
+
+	firing, recent := a.listAlerts()
+	Expect(firing).To(HaveLen(1))
+	Expect(recent).To(HaveLen(1))
+
+	// replaced rule resolves alerts of the previous rule
+	Expect(a.setRule(&configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 1000"})).To(Succeed())
+	firing, recent = a.listAlerts()
+	Expect(firing).To(BeEmpty())
+	Expect(recent).To(HaveLen(2))
+	Expect(recent[1].State).To(Equal(configurator.AlertEvent_RESOLVED))
+	Expect(recent[1].Value).To(Equal(200.0))
+
+	// deleted rule resolves its alerts, recent events are limited
+	Expect(a.setRule(&configurator.AlertRule{Name: "low", Expr: "buffer.free < 10"})).To(Succeed())
+	a.evaluate(metricValues{alertBufferFree: {"default": 5}}, time.Now())
+	Expect(a.deleteRule("low")).To(Succeed())
+	Expect(a.deleteRule("low")).To(Equal(ErrAlertRuleNotFound))
+	firing, recent = a.listAlerts()
+	Expect(firing).To(BeEmpty())
+	Expect(recent).To(HaveLen(2))
+	Expect(recent[0].Rule).To(Equal("low"))
+	Expect(recent[0].State).To(Equal(configurator.AlertEvent_FIRING))
+	Expect(recent[1].State).To(Equal(configurator.AlertEvent_RESOLVED))
+
+	Expect(a.listRules()).To(HaveLen(1))
+}
+
+func TestAlertWebhook(t *testing.T) {
+	RegisterTestingT(t)
+
+	received := make(chan *configurator.AlertEvent, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		event := &configurator.AlertEvent{}
+		if err := json.NewDecoder(req.Body).Decode(event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		received <- event
+	}))
+	defer server.Close()
+
+	a := newAlerter(logrus.NewLogger("test"), server.URL, 0)
+	Expect(a.setRule(&configurator.AlertRule{Name: "drops", Expr: "interface.drop_rate > 100"})).To(Succeed())
+	now := time.Now()
+	a.evaluate(metricValues{alertIfDropRate: {"a": 200}}, now)
+	a.evaluate(metricValues{alertIfDropRate: {"a": 50}}, now.Add(time.Second))
+
+	// events are posted in order by the single sender
+	var event *configurator.AlertEvent
+	Eventually(received).Should(Receive(&event))
+	Expect(event.State).To(Equal(configurator.AlertEvent_FIRING))
+	Eventually(received).Should(Receive(&event))
+	Expect(event.State).To(Equal(configurator.AlertEvent_RESOLVED))
+
+	a.close()
+	Expect(a.webhookQueue).To(BeEmpty())
+}
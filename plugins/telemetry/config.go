@@ -14,7 +14,11 @@
 
 package telemetry
 
-import "time"
+import (
+	"time"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+)
 
 const (
 	// default period between updates
@@ -29,16 +33,36 @@ type Config struct {
 	PollingInterval time.Duration `json:"polling-interval"`
 	// Allows to disable plugin
 	Disabled bool `json:"disabled"`
-	// Allows to export prometheus in telemetry plugin
+	// Allows to export prometheus in telemetry plugin, VPP is still polled
+	// for the stats used by rates, alerts and history
 	PrometheusDisabled bool `json:"prometheus-disabled"`
-	// Skip collecting some of the metrics:
+	// Skip collecting some of the metrics (for all the consumers):
 	// 	runtime, memory, buffers, nodes, interfaces
 	Skipped []string `json:"skipped"`
+	// Size of the sliding window used to compute rates of counters,
+	// default value is 1m (at least twice the polling interval)
+	RateWindow time.Duration `json:"rate-window"`
+	// Alert rules evaluated after every polling
+	AlertRules []*configurator.AlertRule `json:"alert-rules"`
+	// URL where alert events are posted (optional)
+	AlertWebhook string `json:"alert-webhook"`
+	// Number of recent alert events kept, default value is 100
+	AlertHistorySize int `json:"alert-history-size"`
+	// Allows to disable history of metrics
+	HistoryDisabled bool `json:"history-disabled"`
+	// Period for which the history of metrics is kept, default value is 1h
+	HistoryRetention time.Duration `json:"history-retention"`
+	// Metrics recorded in history, all metrics are recorded if empty
+	HistoryMetrics []string `json:"history-metrics"`
+	// File where the history is saved to be kept over restarts (optional)
+	HistoryFile string `json:"history-file"`
 }
 
 func defaultConfig() *Config {
 	return &Config{
-		PollingInterval: defaultUpdatePeriod,
+		PollingInterval:  defaultUpdatePeriod,
+		RateWindow:       defaultRateWindow,
+		HistoryRetention: defaultHistoryRetention,
 	}
 }
 
@@ -0,0 +1,449 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/unrolled/render"
+	"go.ligato.io/cn-infra/v2/logging"
+)
+
+const (
+	// default period for which the history of metrics is kept
+	defaultHistoryRetention = time.Hour
+	// period between saves of the history into the history file
+	historySaveInterval = time.Minute
+	// maximum number of points returned for a single series
+	historyMaxPoints = 11000
+
+	// REST endpoint with the history of metrics
+	historyURL = "/telemetry/history"
+)
+
+// Aggregations of history points within the query step.
+const (
+	historyAggAvg  = "avg"
+	historyAggMin  = "min"
+	historyAggMax  = "max"
+	historyAggSum  = "sum"
+	historyAggLast = "last"
+)
+
+// historyPoint is a value of a metric at the given time (in milliseconds).
+type historyPoint struct {
+	Timestamp int64   `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// historySeries is a ring buffer of points of a metric for a single object.
+type historySeries struct {
+	points []historyPoint
+	start  int
+	count  int
+}
+
+func newHistorySeries(capacity int) *historySeries {
+	return &historySeries{
+		points: make([]historyPoint, capacity),
+	}
+}
+
+// add adds the point into the series, the oldest point is overwritten
+// if the series is full.
+func (s *historySeries) add(point historyPoint) {
+	s.points[(s.start+s.count)%len(s.points)] = point
+	if s.count < len(s.points) {
+		s.count++
+	} else {
+		s.start = (s.start + 1) % len(s.points)
+	}
+}
+
+// at returns i-th oldest point of the series.
+func (s *historySeries) at(i int) historyPoint {
+	return s.points[(s.start+i)%len(s.points)]
+}
+
+// between returns points of the series with timestamp within [from, to].
+func (s *historySeries) between(from, to int64) []historyPoint {
+	var points []historyPoint
+	for i := 0; i < s.count; i++ {
+		if point := s.at(i); point.Timestamp >= from && point.Timestamp <= to {
+			points = append(points, point)
+		}
+	}
+	return points
+}
+
+type historyKey struct {
+	metric string
+	object string
+}
+
+// historySeriesData is a series of points of a metric for a single object
+// as returned by the query API and stored in the history file.
+type historySeriesData struct {
+	Metric string         `json:"metric"`
+	Object string         `json:"object"`
+	Points []historyPoint `json:"points"`
+}
+
+// historyQuery selects series and range of the history.
+type historyQuery struct {
+	// metric name or prefix ending with '.' (e.g. "interface."), all if empty
+	metric string
+	// object name filter, all if nil
+	object *regexp.Regexp
+	from   time.Time
+	to     time.Time
+	// points are aggregated within steps if set
+	step        time.Duration
+	aggregation string
+}
+
+// history keeps bounded in-memory time series of selected metrics
+// at the polling resolution, optionally saved into a file.
+type history struct {
+	log       logging.Logger
+	retention time.Duration
+	capacity  int
+	// recorded metrics, all metrics are recorded if empty
+	metrics map[string]bool
+	file    string
+
+	mu       sync.Mutex
+	series   map[historyKey]*historySeries
+	lastSave time.Time
+}
+
+func newHistory(log logging.Logger, retention, period time.Duration, metrics []string, file string) (*history, error) {
+	h := &history{
+		log:       log,
+		retention: retention,
+		capacity:  int(retention/period) + 1,
+		metrics:   make(map[string]bool),
+		file:      file,
+		series:    make(map[historyKey]*historySeries),
+		lastSave:  time.Now(),
+	}
+	for _, metric := range metrics {
+		if _, ok := alertMetrics[metric]; !ok {
+			return nil, errors.Errorf("unknown history metric %q", metric)
+		}
+		h.metrics[metric] = true
+	}
+	return h, nil
+}
+
+// records returns true if any metric with the prefix is recorded.
+func (h *history) records(prefix string) bool {
+	if h == nil {
+		return false
+	}
+	if len(h.metrics) == 0 {
+		return true
+	}
+	for metric := range h.metrics {
+		if strings.HasPrefix(metric, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// record adds values of the recorded metrics into the history. Series which
+// have no points within the retention period (e.g. removed interfaces)
+// are dropped.
+func (h *history) record(values metricValues, now time.Time) {
+	if h == nil {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	timestamp := now.UnixNano() / int64(time.Millisecond)
+	for metric, objects := range values {
+		if len(h.metrics) > 0 && !h.metrics[metric] {
+			continue
+		}
+		for object, value := range objects {
+			key := historyKey{metric: metric, object: object}
+			series, ok := h.series[key]
+			if !ok {
+				series = newHistorySeries(h.capacity)
+				h.series[key] = series
+			}
+			series.add(historyPoint{Timestamp: timestamp, Value: value})
+		}
+	}
+
+	oldest := now.Add(-h.retention).UnixNano() / int64(time.Millisecond)
+	for key, series := range h.series {
+		if series.at(series.count-1).Timestamp < oldest {
+			delete(h.series, key)
+		}
+	}
+
+	if h.file != "" && now.Sub(h.lastSave) >= historySaveInterval {
+		if err := h.saveLocked(); err != nil {
+			h.log.Warnf("saving history failed: %v", err)
+		}
+		h.lastSave = now
+	}
+}
+
+// query returns series selected by the query sorted by metric and object.
+func (h *history) query(q historyQuery) []*historySeriesData {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	from := q.from.UnixNano() / int64(time.Millisecond)
+	to := q.to.UnixNano() / int64(time.Millisecond)
+
+	var result []*historySeriesData
+	for key, series := range h.series {
+		if q.metric != "" && key.metric != q.metric &&
+			!(strings.HasSuffix(q.metric, ".") && strings.HasPrefix(key.metric, q.metric)) {
+			continue
+		}
+		if q.object != nil && !q.object.MatchString(key.object) {
+			continue
+		}
+		points := series.between(from, to)
+		if q.step > 0 {
+			points = aggregatePoints(points, from, int64(q.step/time.Millisecond), q.aggregation)
+		}
+		if len(points) == 0 {
+			continue
+		}
+		result = append(result, &historySeriesData{
+			Metric: key.metric,
+			Object: key.object,
+			Points: points,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Metric != result[j].Metric {
+			return result[i].Metric < result[j].Metric
+		}
+		return result[i].Object < result[j].Object
+	})
+	return result
+}
+
+// aggregatePoints aggregates points within steps starting at from,
+// the timestamp of each aggregated point is the start of its step.
+func aggregatePoints(points []historyPoint, from, step int64, aggregation string) []historyPoint {
+	var (
+		result []historyPoint
+		count  int
+	)
+	for _, point := range points {
+		timestamp := from + (point.Timestamp-from)/step*step
+		n := len(result)
+		if n == 0 || result[n-1].Timestamp != timestamp {
+			if n > 0 && aggregation == historyAggAvg {
+				result[n-1].Value /= float64(count)
+			}
+			result = append(result, historyPoint{Timestamp: timestamp, Value: point.Value})
+			count = 1
+			continue
+		}
+		last := &result[n-1]
+		switch aggregation {
+		case historyAggMin:
+			last.Value = math.Min(last.Value, point.Value)
+		case historyAggMax:
+			last.Value = math.Max(last.Value, point.Value)
+		case historyAggSum, historyAggAvg:
+			last.Value += point.Value
+		case historyAggLast:
+			last.Value = point.Value
+		}
+		count++
+	}
+	if n := len(result); n > 0 && aggregation == historyAggAvg {
+		result[n-1].Value /= float64(count)
+	}
+	return result
+}
+
+// save writes the history into the history file.
+func (h *history) save() error {
+	if h == nil || h.file == "" {
+		return nil
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.saveLocked()
+}
+
+func (h *history) saveLocked() error {
+	data := make([]*historySeriesData, 0, len(h.series))
+	for key, series := range h.series {
+		points := make([]historyPoint, 0, series.count)
+		for i := 0; i < series.count; i++ {
+			points = append(points, series.at(i))
+		}
+		data = append(data, &historySeriesData{
+			Metric: key.metric,
+			Object: key.object,
+			Points: points,
+		})
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	// write into temporary file first to not corrupt the history on failure
+	tmp := h.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, h.file)
+}
+
+// load reads the history from the history file, points older than
+// the retention period are skipped.
+func (h *history) load(now time.Time) error {
+	if h.file == "" {
+		return nil
+	}
+	b, err := ioutil.ReadFile(h.file)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var data []*historySeriesData
+	if err := json.Unmarshal(b, &data); err != nil {
+		return errors.Errorf("decoding history file %s failed: %v", filepath.Base(h.file), err)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	oldest := now.Add(-h.retention).UnixNano() / int64(time.Millisecond)
+	for _, d := range data {
+		if len(h.metrics) > 0 && !h.metrics[d.Metric] {
+			continue
+		}
+		series := newHistorySeries(h.capacity)
+		for _, point := range d.Points {
+			if point.Timestamp >= oldest {
+				series.add(point)
+			}
+		}
+		if series.count > 0 {
+			h.series[historyKey{metric: d.Metric, object: d.Object}] = series
+		}
+	}
+	return nil
+}
+
+// parseHistoryQuery parses query parameters of the history REST API:
+// metric, object (regexp), since (duration), start and end (RFC3339 or unix
+// timestamp in seconds), step (duration) and agg (avg, min, max, sum, last).
+func parseHistoryQuery(req *http.Request, retention time.Duration) (q historyQuery, err error) {
+	params := req.URL.Query()
+	now := time.Now()
+
+	q.metric = params.Get("metric")
+	if object := params.Get("object"); object != "" {
+		if q.object, err = regexp.Compile(object); err != nil {
+			return q, errors.Errorf("invalid object: %v", err)
+		}
+	}
+
+	q.from, q.to = now.Add(-retention), now
+	if since := params.Get("since"); since != "" {
+		d, err := time.ParseDuration(since)
+		if err != nil {
+			return q, errors.Errorf("invalid since: %v", err)
+		}
+		q.from = now.Add(-d)
+	}
+	if start := params.Get("start"); start != "" {
+		if q.from, err = parseHistoryTime(start); err != nil {
+			return q, errors.Errorf("invalid start: %v", err)
+		}
+	}
+	if end := params.Get("end"); end != "" {
+		if q.to, err = parseHistoryTime(end); err != nil {
+			return q, errors.Errorf("invalid end: %v", err)
+		}
+	}
+	if q.to.Before(q.from) {
+		return q, errors.New("end is before start")
+	}
+
+	if step := params.Get("step"); step != "" {
+		if q.step, err = time.ParseDuration(step); err != nil {
+			return q, errors.Errorf("invalid step: %v", err)
+		}
+		if q.step < time.Millisecond {
+			return q, errors.New("step has to be positive")
+		}
+		if q.to.Sub(q.from)/q.step > historyMaxPoints {
+			return q, errors.Errorf("step is too small, maximum number of points is %d", historyMaxPoints)
+		}
+	}
+	q.aggregation = historyAggAvg
+	if agg := params.Get("agg"); agg != "" {
+		switch agg {
+		case historyAggAvg, historyAggMin, historyAggMax, historyAggSum, historyAggLast:
+			q.aggregation = agg
+		default:
+			return q, errors.Errorf("unknown aggregation %q", agg)
+		}
+	}
+	return q, nil
+}
+
+func parseHistoryTime(s string) (time.Time, error) {
+	if sec, err := strconv.ParseFloat(s, 64); err == nil {
+		return time.Unix(0, int64(sec*float64(time.Second))), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func historyHandler(h *history) func(formatter *render.Render) http.HandlerFunc {
+	return func(formatter *render.Render) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			q, err := parseHistoryQuery(req, h.retention)
+			if err != nil {
+				_ = formatter.JSON(w, http.StatusBadRequest, struct{ Error string }{err.Error()})
+				return
+			}
+			_ = formatter.JSON(w, http.StatusOK, struct {
+				Series []*historySeriesData `json:"series"`
+			}{h.query(q)})
+		}
+	}
+}
@@ -0,0 +1,310 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+)
+
+func TestHistorySeries(t *testing.T) {
+	RegisterTestingT(t)
+
+	series := newHistorySeries(3)
+	Expect(series.between(0, 100)).To(BeEmpty())
+
+	series.add(historyPoint{Timestamp: 1, Value: 10})
+	series.add(historyPoint{Timestamp: 2, Value: 20})
+	Expect(series.count).To(Equal(2))
+	Expect(series.between(0, 100)).To(Equal([]historyPoint{{1, 10}, {2, 20}}))
+
+	// the oldest points are overwritten when the series wraps around
+	series.add(historyPoint{Timestamp: 3, Value: 30})
+	series.add(historyPoint{Timestamp: 4, Value: 40})
+	series.add(historyPoint{Timestamp: 5, Value: 50})
+	Expect(series.count).To(Equal(3))
+	Expect(series.at(0)).To(Equal(historyPoint{3, 30}))
+	Expect(series.at(2)).To(Equal(historyPoint{5, 50}))
+	Expect(series.between(0, 100)).To(Equal([]historyPoint{{3, 30}, {4, 40}, {5, 50}}))
+	Expect(series.between(4, 4)).To(Equal([]historyPoint{{4, 40}}))
+	Expect(series.between(1, 2)).To(BeEmpty())
+
+	// full round of the ring
+	for ts := int64(6); ts <= 8; ts++ {
+		series.add(historyPoint{Timestamp: ts, Value: float64(ts * 10)})
+	}
+	Expect(series.between(0, 100)).To(Equal([]historyPoint{{6, 60}, {7, 70}, {8, 80}}))
+}
+
+func TestAggregatePoints(t *testing.T) {
+	points := []historyPoint{
+		{Timestamp: 1000, Value: 4},
+		{Timestamp: 1500, Value: 2},
+		{Timestamp: 1900, Value: 6},
+		{Timestamp: 2000, Value: 1},
+		// no points in the step starting at 3000
+		{Timestamp: 4200, Value: 3},
+		{Timestamp: 4300, Value: 5},
+	}
+	tests := []struct {
+		name        string
+		from        int64
+		step        int64
+		aggregation string
+		expected    []historyPoint
+	}{
+		{
+			name:        "avg",
+			from:        1000,
+			step:        1000,
+			aggregation: historyAggAvg,
+			expected:    []historyPoint{{1000, 4}, {2000, 1}, {4000, 4}},
+		},
+		{
+			name:        "min",
+			from:        1000,
+			step:        1000,
+			aggregation: historyAggMin,
+			expected:    []historyPoint{{1000, 2}, {2000, 1}, {4000, 3}},
+		},
+		{
+			name:        "max",
+			from:        1000,
+			step:        1000,
+			aggregation: historyAggMax,
+			expected:    []historyPoint{{1000, 6}, {2000, 1}, {4000, 5}},
+		},
+		{
+			name:        "sum",
+			from:        1000,
+			step:        1000,
+			aggregation: historyAggSum,
+			expected:    []historyPoint{{1000, 12}, {2000, 1}, {4000, 8}},
+		},
+		{
+			name:        "last",
+			from:        1000,
+			step:        1000,
+			aggregation: historyAggLast,
+			expected:    []historyPoint{{1000, 6}, {2000, 1}, {4000, 5}},
+		},
+		{
+			name:        "steps aligned to from",
+			from:        500,
+			step:        1000,
+			aggregation: historyAggSum,
+			expected:    []historyPoint{{500, 4}, {1500, 9}, {3500, 8}},
+		},
+		{
+			name:        "step larger than range",
+			from:        0,
+			step:        10000,
+			aggregation: historyAggAvg,
+			expected:    []historyPoint{{0, 3.5}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			result := aggregatePoints(points, test.from, test.step, test.aggregation)
+			Expect(result).To(Equal(test.expected))
+		})
+	}
+
+	t.Run("no points", func(t *testing.T) {
+		RegisterTestingT(t)
+		Expect(aggregatePoints(nil, 0, 1000, historyAggAvg)).To(BeEmpty())
+	})
+}
+
+func TestHistoryQuery(t *testing.T) {
+	RegisterTestingT(t)
+
+	h, err := newHistory(logrus.NewLogger("test"), time.Minute, 10*time.Second,
+		[]string{alertIfDropRate, alertIfRxBps, alertBufferFree}, "")
+	Expect(err).ToNot(HaveOccurred())
+
+	start := time.Unix(1600000000, 0)
+	h.record(metricValues{
+		alertIfDropRate: {"tap0": 1, "memif0": 2},
+		alertIfRxBps:    {"tap0": 100},
+		alertBufferFree: {"default": 500},
+		alertNodeCalls:  {"ip4-input": 10},
+	}, start)
+	h.record(metricValues{alertIfDropRate: {"tap0": 3}}, start.Add(10*time.Second))
+
+	// not recorded metric is skipped
+	result := h.query(historyQuery{from: start, to: start.Add(time.Minute)})
+	Expect(result).To(HaveLen(4))
+	Expect(result[0].Metric).To(Equal(alertBufferFree))
+
+	// metric prefix and object filter
+	result = h.query(historyQuery{metric: "interface.", object: regexp.MustCompile("^tap"),
+		from: start, to: start.Add(time.Minute)})
+	Expect(result).To(HaveLen(2))
+	Expect(result[0].Metric).To(Equal(alertIfDropRate))
+	Expect(result[0].Points).To(Equal([]historyPoint{{1600000000000, 1}, {1600000010000, 3}}))
+	Expect(result[1].Metric).To(Equal(alertIfRxBps))
+
+	// series without points within the retention are dropped
+	h.record(metricValues{alertIfDropRate: {"tap0": 5}}, start.Add(65*time.Second))
+	result = h.query(historyQuery{from: start, to: start.Add(2 * time.Minute)})
+	Expect(result).To(HaveLen(1))
+	Expect(result[0].Object).To(Equal("tap0"))
+
+	_, err = newHistory(logrus.NewLogger("test"), time.Minute, 10*time.Second, []string{"interface.mtu"}, "")
+	Expect(err).To(HaveOccurred())
+}
+
+func TestHistorySaveLoad(t *testing.T) {
+	RegisterTestingT(t)
+
+	dir, err := ioutil.TempDir("", "history")
+	Expect(err).ToNot(HaveOccurred())
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "history.json")
+
+	h, err := newHistory(logrus.NewLogger("test"), time.Minute, 10*time.Second, nil, file)
+	Expect(err).ToNot(HaveOccurred())
+	// missing file is not an error
+	Expect(h.load(time.Now())).To(Succeed())
+
+	start := time.Unix(1600000000, 0)
+	for i := 0; i < 5; i++ {
+		values := metricValues{alertIfDropRate: {"tap0": float64(i)}}
+		if i == 0 {
+			values[alertBufferFree] = map[string]float64{"default": 500}
+		}
+		h.record(values, start.Add(time.Duration(i)*10*time.Second))
+	}
+	Expect(h.save()).To(Succeed())
+	_, err = os.Stat(file + ".tmp")
+	Expect(os.IsNotExist(err)).To(BeTrue())
+
+	// points outside the retention at the time of loading are skipped,
+	// series without any point within the retention are not loaded
+	loaded, err := newHistory(logrus.NewLogger("test"), time.Minute, 10*time.Second, nil, file)
+	Expect(err).ToNot(HaveOccurred())
+	now := start.Add(90 * time.Second)
+	Expect(loaded.load(now)).To(Succeed())
+	result := loaded.query(historyQuery{from: start, to: now})
+	Expect(result).To(HaveLen(1))
+	Expect(result[0].Metric).To(Equal(alertIfDropRate))
+	Expect(result[0].Points).To(Equal([]historyPoint{{1600000030000, 3}, {1600000040000, 4}}))
+
+	// only recorded metrics are loaded
+	filtered, err := newHistory(logrus.NewLogger("test"), time.Hour, 10*time.Second,
+		[]string{alertBufferFree}, file)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(filtered.load(now)).To(Succeed())
+	result = filtered.query(historyQuery{from: start, to: now})
+	Expect(result).To(HaveLen(1))
+	Expect(result[0].Metric).To(Equal(alertBufferFree))
+
+	// corrupted file
+	Expect(ioutil.WriteFile(file, []byte("{"), 0644)).To(Succeed())
+	Expect(loaded.load(now)).ToNot(Succeed())
+}
+
+func TestParseHistoryQuery(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		metric      string
+		object      string
+		from        time.Time
+		to          time.Time
+		span        time.Duration
+		step        time.Duration
+		aggregation string
+		err         bool
+	}{
+		{
+			name:        "defaults",
+			query:       "",
+			span:        time.Hour,
+			aggregation: historyAggAvg,
+		},
+		{
+			name:        "metric, object and since",
+			query:       "metric=interface.&object=^tap&since=5m",
+			metric:      "interface.",
+			object:      "^tap",
+			span:        5 * time.Minute,
+			aggregation: historyAggAvg,
+		},
+		{
+			name:        "unix timestamps with step",
+			query:       "start=1600000000&end=1600000060.5&step=10s&agg=max",
+			from:        time.Unix(1600000000, 0),
+			to:          time.Unix(1600000060, int64(500*time.Millisecond)),
+			step:        10 * time.Second,
+			aggregation: historyAggMax,
+		},
+		{
+			name:        "RFC3339 timestamps",
+			query:       "start=2020-09-13T12:26:40Z&end=2020-09-13T12:36:40Z&agg=last",
+			from:        time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC),
+			to:          time.Date(2020, 9, 13, 12, 36, 40, 0, time.UTC),
+			aggregation: historyAggLast,
+		},
+		{name: "invalid object", query: "object=(", err: true},
+		{name: "invalid since", query: "since=5", err: true},
+		{name: "invalid start", query: "start=yesterday", err: true},
+		{name: "invalid end", query: "end=tomorrow", err: true},
+		{name: "end before start", query: "start=1600000060&end=1600000000", err: true},
+		{name: "invalid step", query: "step=10", err: true},
+		{name: "zero step", query: "step=0s", err: true},
+		{name: "too small step", query: "since=1h&step=100ms", err: true},
+		{name: "unknown aggregation", query: "agg=median", err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			req := httptest.NewRequest("GET", historyURL+"?"+test.query, nil)
+			q, err := parseHistoryQuery(req, time.Hour)
+			if test.err {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(q.metric).To(Equal(test.metric))
+			if test.object == "" {
+				Expect(q.object).To(BeNil())
+			} else {
+				Expect(q.object.String()).To(Equal(test.object))
+			}
+			if test.span > 0 {
+				Expect(q.to.Sub(q.from)).To(Equal(test.span))
+				Expect(q.to).To(BeTemporally("~", time.Now(), time.Second))
+			} else {
+				Expect(q.from).To(BeTemporally("==", test.from))
+				Expect(q.to).To(BeTemporally("==", test.to))
+			}
+			Expect(q.step).To(Equal(test.step))
+			Expect(q.aggregation).To(Equal(test.aggregation))
+		})
+	}
+}
@@ -15,11 +15,12 @@
 package telemetry
 
 import (
-	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
 )
 
 const (
@@ -112,6 +113,19 @@ const (
 	ifCounterRxMiss    = "rx_miss"
 )
 
+// Rate metrics
+const (
+	ifRateRxPps    = "rx_pps"
+	ifRateTxPps    = "tx_pps"
+	ifRateRxBps    = "rx_bps"
+	ifRateTxBps    = "tx_bps"
+	ifRateDropRate = "drop_rate"
+
+	nodeRateCallsMetric   = "calls_per_sec"
+	nodeRateVectorsMetric = "vectors_per_sec"
+	nodeRateErrorsMetric  = "errors_per_sec"
+)
+
 type prometheusMetrics struct {
 	runtimeGaugeVecs map[string]*prometheus.GaugeVec
 	runtimeStats     map[string]*runtimeStats
@@ -127,6 +141,9 @@ type prometheusMetrics struct {
 
 	ifCounterGaugeVecs map[string]*prometheus.GaugeVec
 	ifCounterStats     map[string]*ifCounterStats
+
+	ifRateGaugeVecs   map[string]*prometheus.GaugeVec
+	nodeRateGaugeVecs map[string]*prometheus.GaugeVec
 }
 
 type runtimeStats struct {
@@ -329,116 +346,110 @@ func (p *Plugin) registerPrometheus() error {
 		}
 	}
 
-	return nil
-}
+	// Interface rate metrics
+	p.ifRateGaugeVecs = make(map[string]*prometheus.GaugeVec)
 
-func (p *Plugin) updatePrometheus(ctx context.Context) {
-	p.tracef("running update")
-
-	if !p.skipped[runtimeMetricsNamespace] {
-		// Update runtime
-		runtimeInfo, err := p.handler.GetRuntimeInfo(ctx)
-		if err != nil {
-			p.Log.Errorf("GetRuntimeInfo failed: %v", err)
-		} else {
-			p.tracef("runtime info: %+v", runtimeInfo)
-			for _, thread := range runtimeInfo.GetThreads() {
-				for _, item := range thread.Items {
-					stats, ok := p.runtimeStats[item.Name]
-					if !ok {
-						stats = &runtimeStats{
-							threadID:   thread.ID,
-							threadName: thread.Name,
-							itemName:   item.Name,
-							metrics:    map[string]prometheus.Gauge{},
-						}
-						p.runtimeStats[item.Name] = stats
-
-						// add gauges with corresponding labels into vectors
-						for k, vec := range p.runtimeGaugeVecs {
-							stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
-								runtimeItemLabel:     item.Name,
-								runtimeThreadLabel:   thread.Name,
-								runtimeThreadIDLabel: strconv.Itoa(int(thread.ID)),
-							})
-							if err != nil {
-								p.Log.Error(err)
-							}
-						}
-					}
+	for _, metric := range [][2]string{
+		{ifRateRxPps, "RX packets per second"},
+		{ifRateTxPps, "TX packets per second"},
+		{ifRateRxBps, "RX bits per second"},
+		{ifRateTxBps, "TX bits per second"},
+		{ifRateDropRate, "Drops per second"},
+	} {
+		name := metric[0]
+		p.ifRateGaugeVecs[name] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: vppMetricsNamespace,
+			Subsystem: ifMetricsNamespace,
+			Name:      name,
+			Help:      metric[1],
+			ConstLabels: prometheus.Labels{
+				agentLabel: p.ServiceLabel.GetAgentLabel(),
+			},
+		}, []string{ifCounterNameLabel, ifCounterIndexLabel})
+	}
 
-					stats.metrics[runtimeCallsMetric].Set(float64(item.Calls))
-					stats.metrics[runtimeVectorsMetric].Set(float64(item.Vectors))
-					stats.metrics[runtimeSuspendsMetric].Set(float64(item.Suspends))
-					stats.metrics[runtimeClocksMetric].Set(item.Clocks)
-					stats.metrics[runtimeVectorsPerCallMetric].Set(item.VectorsPerCall)
-				}
-			}
+	// register created vectors to prometheus
+	for name, metric := range p.ifRateGaugeVecs {
+		if err := p.Prometheus.Register(registryPath, metric); err != nil {
+			p.Log.Errorf("failed to register %v metric: %v", name, err)
+			return err
 		}
 	}
 
-	if !p.skipped[buffersMetricsNamespace] {
-		// Update buffers
-		buffersInfo, err := p.handler.GetBuffersInfo(ctx)
-		if err != nil {
-			p.Log.Errorf("GetBuffersInfo failed: %v", err)
-		} else {
-			p.tracef("buffers info: %+v", buffersInfo)
-			for _, item := range buffersInfo.GetItems() {
-				stats, ok := p.buffersStats[item.Name]
-				if !ok {
-					stats = &buffersStats{
-						threadID:  item.ThreadID,
-						itemName:  item.Name,
-						itemIndex: item.Index,
-						metrics:   map[string]prometheus.Gauge{},
-					}
-					p.buffersStats[item.Name] = stats
+	// Node rate metrics
+	p.nodeRateGaugeVecs = make(map[string]*prometheus.GaugeVec)
 
-					// add gauges with corresponding labels into vectors
-					for k, vec := range p.buffersGaugeVecs {
-						stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
-							buffersThreadIDLabel: strconv.Itoa(int(item.ThreadID)),
-							buffersItemLabel:     item.Name,
-							buffersIndexLabel:    strconv.Itoa(int(item.Index)),
-						})
-						if err != nil {
-							p.Log.Error(err)
-						}
-					}
-				}
+	for _, metric := range [][2]string{
+		{nodeRateCallsMetric, "Calls per second"},
+		{nodeRateVectorsMetric, "Vectors per second"},
+		{nodeRateErrorsMetric, "Errors per second"},
+	} {
+		name := metric[0]
+		p.nodeRateGaugeVecs[name] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: vppMetricsNamespace,
+			Subsystem: nodeMetricsNamespace,
+			Name:      name,
+			Help:      metric[1],
+			ConstLabels: prometheus.Labels{
+				agentLabel: p.ServiceLabel.GetAgentLabel(),
+			},
+		}, []string{nodeCounterItemLabel})
+	}
 
-				stats.metrics[buffersSizeMetric].Set(float64(item.Size))
-				stats.metrics[buffersAllocMetric].Set(float64(item.Alloc))
-				stats.metrics[buffersFreeMetric].Set(float64(item.Free))
-				stats.metrics[buffersNumAllocMetric].Set(float64(item.NumAlloc))
-				stats.metrics[buffersNumFreeMetric].Set(float64(item.NumFree))
-			}
+	// register created vectors to prometheus
+	for name, metric := range p.nodeRateGaugeVecs {
+		if err := p.Prometheus.Register(registryPath, metric); err != nil {
+			p.Log.Errorf("failed to register %v metric: %v", name, err)
+			return err
 		}
 	}
 
-	if !p.skipped[memoryMetricsNamespace] {
-		// Update memory
-		memoryInfo, err := p.handler.GetMemory(ctx)
-		if err != nil {
-			p.Log.Errorf("GetMemory failed: %v", err)
-		} else {
-			p.tracef("memory info: %+v", memoryInfo)
-			for _, thread := range memoryInfo.GetThreads() {
-				stats, ok := p.memoryStats[thread.Name]
+	return nil
+}
+
+// updateRatesPrometheus sets the rate gauges.
+func (p *Plugin) updateRatesPrometheus(ifRates []*vpp.InterfaceRates, nodeRates []*vpp.NodeRates) {
+	for _, rates := range ifRates {
+		labels := []string{rates.Name, fmt.Sprint(rates.Index)}
+		p.ifRateGaugeVecs[ifRateRxPps].WithLabelValues(labels...).Set(rates.RxPps)
+		p.ifRateGaugeVecs[ifRateTxPps].WithLabelValues(labels...).Set(rates.TxPps)
+		p.ifRateGaugeVecs[ifRateRxBps].WithLabelValues(labels...).Set(rates.RxBps)
+		p.ifRateGaugeVecs[ifRateTxBps].WithLabelValues(labels...).Set(rates.TxBps)
+		p.ifRateGaugeVecs[ifRateDropRate].WithLabelValues(labels...).Set(rates.DropRate)
+	}
+	for _, rates := range nodeRates {
+		p.nodeRateGaugeVecs[nodeRateCallsMetric].WithLabelValues(rates.Name).Set(rates.CallsPerSec)
+		p.nodeRateGaugeVecs[nodeRateVectorsMetric].WithLabelValues(rates.Name).Set(rates.VectorsPerSec)
+		p.nodeRateGaugeVecs[nodeRateErrorsMetric].WithLabelValues(rates.Name).Set(rates.ErrorsPerSec)
+	}
+}
+
+// updatePrometheus sets the metrics from the stats read at the last polling.
+func (p *Plugin) updatePrometheus(polled *vppStats) {
+	p.tracef("running update")
+	var err error
+
+	if runtimeInfo := polled.runtimeInfo; runtimeInfo != nil && !p.skipped[runtimeMetricsNamespace] {
+		// Update runtime
+		p.tracef("runtime info: %+v", runtimeInfo)
+		for _, thread := range runtimeInfo.GetThreads() {
+			for _, item := range thread.Items {
+				stats, ok := p.runtimeStats[item.Name]
 				if !ok {
-					stats = &memoryStats{
-						threadName: thread.Name,
+					stats = &runtimeStats{
 						threadID:   thread.ID,
+						threadName: thread.Name,
+						itemName:   item.Name,
 						metrics:    map[string]prometheus.Gauge{},
 					}
-					p.memoryStats[thread.Name] = stats
+					p.runtimeStats[item.Name] = stats
 
 					// add gauges with corresponding labels into vectors
-					for k, vec := range p.memoryGaugeVecs {
+					for k, vec := range p.runtimeGaugeVecs {
 						stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
-							memoryThreadLabel:   thread.Name,
-							memoryThreadIDLabel: strconv.Itoa(int(thread.ID)),
+							runtimeItemLabel:     item.Name,
+							runtimeThreadLabel:   thread.Name,
+							runtimeThreadIDLabel: strconv.Itoa(int(thread.ID)),
 						})
 						if err != nil {
 							p.Log.Error(err)
@@ -446,99 +457,163 @@ func (p *Plugin) updatePrometheus(ctx context.Context) {
 					}
 				}
 
-				stats.metrics[memoryUsedMetric].Set(float64(thread.Used))
-				stats.metrics[memoryTotalMetric].Set(float64(thread.Total))
-				stats.metrics[memoryFreeMetric].Set(float64(thread.Free))
-				stats.metrics[memorySizeMetric].Set(float64(thread.Size))
-				stats.metrics[memoryPagesMetric].Set(float64(thread.Pages))
-				stats.metrics[memoryTrimmableMetric].Set(float64(thread.Trimmable))
-				stats.metrics[memoryFreeChunksMetric].Set(float64(thread.FreeChunks))
-				stats.metrics[memoryFreeFastbinBlksMetric].Set(float64(thread.FreeFastbinBlks))
-				stats.metrics[memoryMaxTotalAlloc].Set(float64(thread.MaxTotalAlloc))
+				stats.metrics[runtimeCallsMetric].Set(float64(item.Calls))
+				stats.metrics[runtimeVectorsMetric].Set(float64(item.Vectors))
+				stats.metrics[runtimeSuspendsMetric].Set(float64(item.Suspends))
+				stats.metrics[runtimeClocksMetric].Set(item.Clocks)
+				stats.metrics[runtimeVectorsPerCallMetric].Set(item.VectorsPerCall)
 			}
 		}
 	}
 
-	if !p.skipped[nodeMetricsNamespace] {
-		// Update node counters
-		nodeCountersInfo, err := p.handler.GetNodeCounters(ctx)
-		if err != nil {
-			p.Log.Errorf("GetNodeCounters failed: %v", err)
-		} else {
-			p.tracef("node counters info: %+v", nodeCountersInfo)
-			for _, item := range nodeCountersInfo.GetCounters() {
-				stats, ok := p.nodeCounterStats[item.Name]
-				if !ok {
-					stats = &nodeCounterStats{
-						itemName: item.Name,
-						metrics:  map[string]prometheus.Gauge{},
+	if buffersInfo := polled.buffersInfo; buffersInfo != nil {
+		// Update buffers
+		p.tracef("buffers info: %+v", buffersInfo)
+		for _, item := range buffersInfo.GetItems() {
+			stats, ok := p.buffersStats[item.Name]
+			if !ok {
+				stats = &buffersStats{
+					threadID:  item.ThreadID,
+					itemName:  item.Name,
+					itemIndex: item.Index,
+					metrics:   map[string]prometheus.Gauge{},
+				}
+				p.buffersStats[item.Name] = stats
+
+				// add gauges with corresponding labels into vectors
+				for k, vec := range p.buffersGaugeVecs {
+					stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
+						buffersThreadIDLabel: strconv.Itoa(int(item.ThreadID)),
+						buffersItemLabel:     item.Name,
+						buffersIndexLabel:    strconv.Itoa(int(item.Index)),
+					})
+					if err != nil {
+						p.Log.Error(err)
 					}
-					p.nodeCounterStats[item.Name] = stats
+				}
+			}
 
-					// add gauges with corresponding labels into vectors
-					for k, vec := range p.nodeCounterGaugeVecs {
-						stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
-							nodeCounterItemLabel:   item.Node,
-							nodeCounterReasonLabel: item.Name,
-						})
-						if err != nil {
-							p.Log.Error(err)
-						}
+			stats.metrics[buffersSizeMetric].Set(float64(item.Size))
+			stats.metrics[buffersAllocMetric].Set(float64(item.Alloc))
+			stats.metrics[buffersFreeMetric].Set(float64(item.Free))
+			stats.metrics[buffersNumAllocMetric].Set(float64(item.NumAlloc))
+			stats.metrics[buffersNumFreeMetric].Set(float64(item.NumFree))
+		}
+	}
+
+	if memoryInfo := polled.memoryInfo; memoryInfo != nil {
+		// Update memory
+		p.tracef("memory info: %+v", memoryInfo)
+		for _, thread := range memoryInfo.GetThreads() {
+			stats, ok := p.memoryStats[thread.Name]
+			if !ok {
+				stats = &memoryStats{
+					threadName: thread.Name,
+					threadID:   thread.ID,
+					metrics:    map[string]prometheus.Gauge{},
+				}
+				p.memoryStats[thread.Name] = stats
+
+				// add gauges with corresponding labels into vectors
+				for k, vec := range p.memoryGaugeVecs {
+					stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
+						memoryThreadLabel:   thread.Name,
+						memoryThreadIDLabel: strconv.Itoa(int(thread.ID)),
+					})
+					if err != nil {
+						p.Log.Error(err)
 					}
 				}
-
-				stats.metrics[nodeCounterCounterMetric].Set(float64(item.Value))
 			}
+
+			stats.metrics[memoryUsedMetric].Set(float64(thread.Used))
+			stats.metrics[memoryTotalMetric].Set(float64(thread.Total))
+			stats.metrics[memoryFreeMetric].Set(float64(thread.Free))
+			stats.metrics[memorySizeMetric].Set(float64(thread.Size))
+			stats.metrics[memoryPagesMetric].Set(float64(thread.Pages))
+			stats.metrics[memoryTrimmableMetric].Set(float64(thread.Trimmable))
+			stats.metrics[memoryFreeChunksMetric].Set(float64(thread.FreeChunks))
+			stats.metrics[memoryFreeFastbinBlksMetric].Set(float64(thread.FreeFastbinBlks))
+			stats.metrics[memoryMaxTotalAlloc].Set(float64(thread.MaxTotalAlloc))
 		}
 	}
 
-	if !p.skipped[ifMetricsNamespace] {
-		// Update interface counters
-		ifStats, err := p.handler.GetInterfaceStats(ctx)
-		if err != nil {
-			p.Log.Errorf("GetInterfaceStats failed: %v", err)
-			return
-		} else {
-			p.tracef("interface stats: %+v", ifStats)
-			if ifStats == nil {
-				return
-			}
-			for _, item := range ifStats.Interfaces {
-				stats, ok := p.ifCounterStats[item.InterfaceName]
-				if !ok {
-					stats = &ifCounterStats{
-						name:    item.InterfaceName,
-						metrics: map[string]prometheus.Gauge{},
+	if nodeCountersInfo := polled.nodeCounters; nodeCountersInfo != nil {
+		// Update node counters
+		p.tracef("node counters info: %+v", nodeCountersInfo)
+		for _, item := range nodeCountersInfo.GetCounters() {
+			stats, ok := p.nodeCounterStats[item.Name]
+			if !ok {
+				stats = &nodeCounterStats{
+					itemName: item.Name,
+					metrics:  map[string]prometheus.Gauge{},
+				}
+				p.nodeCounterStats[item.Name] = stats
+
+				// add gauges with corresponding labels into vectors
+				for k, vec := range p.nodeCounterGaugeVecs {
+					stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
+						nodeCounterItemLabel:   item.Node,
+						nodeCounterReasonLabel: item.Name,
+					})
+					if err != nil {
+						p.Log.Error(err)
 					}
-					p.ifCounterStats[item.InterfaceName] = stats
+				}
+			}
 
-					// add gauges with corresponding labels into vectors
-					for k, vec := range p.ifCounterGaugeVecs {
-						stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
-							ifCounterNameLabel:  item.InterfaceName,
-							ifCounterIndexLabel: fmt.Sprint(item.InterfaceIndex),
-						})
-						if err != nil {
-							p.Log.Error(err)
-						}
+			stats.metrics[nodeCounterCounterMetric].Set(float64(item.Value))
+		}
+	}
+
+	if ifStats := polled.ifStats; ifStats != nil {
+		// Update interface counters
+		p.tracef("interface stats: %+v", ifStats)
+		for _, item := range ifStats.Interfaces {
+			stats, ok := p.ifCounterStats[item.InterfaceName]
+			if !ok {
+				stats = &ifCounterStats{
+					name:    item.InterfaceName,
+					metrics: map[string]prometheus.Gauge{},
+				}
+				p.ifCounterStats[item.InterfaceName] = stats
+
+				// add gauges with corresponding labels into vectors
+				for k, vec := range p.ifCounterGaugeVecs {
+					stats.metrics[k], err = vec.GetMetricWith(prometheus.Labels{
+						ifCounterNameLabel:  item.InterfaceName,
+						ifCounterIndexLabel: fmt.Sprint(item.InterfaceIndex),
+					})
+					if err != nil {
+						p.Log.Error(err)
 					}
 				}
-
-				stats.metrics[ifCounterRxPackets].Set(float64(item.Rx.Packets))
-				stats.metrics[ifCounterRxBytes].Set(float64(item.Rx.Bytes))
-				stats.metrics[ifCounterRxErrors].Set(float64(item.RxErrors))
-				stats.metrics[ifCounterTxPackets].Set(float64(item.Tx.Packets))
-				stats.metrics[ifCounterTxBytes].Set(float64(item.Tx.Bytes))
-				stats.metrics[ifCounterTxErrors].Set(float64(item.TxErrors))
-				stats.metrics[ifCounterDrops].Set(float64(item.Drops))
-				stats.metrics[ifCounterPunts].Set(float64(item.Punts))
-				stats.metrics[ifCounterIP4].Set(float64(item.IP4))
-				stats.metrics[ifCounterIP6].Set(float64(item.IP6))
-				stats.metrics[ifCounterRxNoBuf].Set(float64(item.RxNoBuf))
-				stats.metrics[ifCounterRxMiss].Set(float64(item.RxMiss))
 			}
+
+			stats.metrics[ifCounterRxPackets].Set(float64(item.Rx.Packets))
+			stats.metrics[ifCounterRxBytes].Set(float64(item.Rx.Bytes))
+			stats.metrics[ifCounterRxErrors].Set(float64(item.RxErrors))
+			stats.metrics[ifCounterTxPackets].Set(float64(item.Tx.Packets))
+			stats.metrics[ifCounterTxBytes].Set(float64(item.Tx.Bytes))
+			stats.metrics[ifCounterTxErrors].Set(float64(item.TxErrors))
+			stats.metrics[ifCounterDrops].Set(float64(item.Drops))
+			stats.metrics[ifCounterPunts].Set(float64(item.Punts))
+			stats.metrics[ifCounterIP4].Set(float64(item.IP4))
+			stats.metrics[ifCounterIP6].Set(float64(item.IP6))
+			stats.metrics[ifCounterRxNoBuf].Set(float64(item.RxNoBuf))
+			stats.metrics[ifCounterRxMiss].Set(float64(item.RxMiss))
 		}
 	}
 
 	p.tracef("update complete")
 }
+
+// resetRatesPrometheus removes all the rate gauges.
+func (p *Plugin) resetRatesPrometheus() {
+	for _, vec := range p.ifRateGaugeVecs {
+		vec.Reset()
+	}
+	for _, vec := range p.nodeRateGaugeVecs {
+		vec.Reset()
+	}
+}
@@ -0,0 +1,244 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/unrolled/render"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+)
+
+const (
+	// default size of the sliding window used to compute rates
+	defaultRateWindow = time.Minute
+
+	// REST endpoint with the computed rates
+	ratesURL = "/telemetry/rates"
+)
+
+// counterSample is a value of a counter read at the given time.
+type counterSample struct {
+	time  time.Time
+	value uint64
+}
+
+// rateTracker keeps a sliding window of samples per counter and computes
+// rates of the counters over the window.
+type rateTracker struct {
+	mu       sync.Mutex
+	window   time.Duration
+	counters map[string][]counterSample
+
+	// rates computed by the last update
+	ifRates   []*vpp.InterfaceRates
+	nodeRates []*vpp.NodeRates
+	// number of updates since the start or the last reset
+	updates int
+}
+
+func newRateTracker(window time.Duration) *rateTracker {
+	return &rateTracker{
+		window:   window,
+		counters: make(map[string][]counterSample),
+	}
+}
+
+// update adds a sample of the counter into its window and returns the rate
+// (per second) of the counter over the window. The window is restarted if
+// the counter has decreased (it was reset).
+func (t *rateTracker) update(key string, now time.Time, value uint64) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	samples := t.counters[key]
+	if n := len(samples); n > 0 && value < samples[n-1].value {
+		samples = nil
+	}
+	samples = append(samples, counterSample{time: now, value: value})
+
+	// keep the samples within the window (at least two to compute the rate)
+	var first int
+	for first < len(samples)-2 && now.Sub(samples[first].time) > t.window {
+		first++
+	}
+	samples = samples[first:]
+	t.counters[key] = samples
+
+	oldest, newest := samples[0], samples[len(samples)-1]
+	elapsed := newest.time.Sub(oldest.time).Seconds()
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(newest.value-oldest.value) / elapsed
+}
+
+// prune removes windows of counters which were not updated since the given time
+// (e.g. counters of removed interfaces).
+func (t *rateTracker) prune(since time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, samples := range t.counters {
+		if samples[len(samples)-1].time.Before(since) {
+			delete(t.counters, key)
+		}
+	}
+}
+
+// reset drops all samples and computed rates, used when VPP restarts.
+func (t *rateTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.counters = make(map[string][]counterSample)
+	t.ifRates = nil
+	t.nodeRates = nil
+	t.updates = 0
+}
+
+// setRates stores the rates computed by the last update.
+func (t *rateTracker) setRates(ifRates []*vpp.InterfaceRates, nodeRates []*vpp.NodeRates) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.ifRates = ifRates
+	t.nodeRates = nodeRates
+	t.updates++
+}
+
+// sampled returns true if the last rates were computed from at least two
+// samples, rates computed from the first sample after the start or the reset
+// are zero.
+func (t *rateTracker) sampled() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.updates > 1
+}
+
+// getRates returns copies of the rates computed by the last update.
+func (t *rateTracker) getRates() (ifRates []*vpp.InterfaceRates, nodeRates []*vpp.NodeRates) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, rates := range t.ifRates {
+		ifRates = append(ifRates, proto.Clone(rates).(*vpp.InterfaceRates))
+	}
+	for _, rates := range t.nodeRates {
+		nodeRates = append(nodeRates, proto.Clone(rates).(*vpp.NodeRates))
+	}
+	return ifRates, nodeRates
+}
+
+// updateRates updates rates of interface and node counters from the stats
+// read at the last polling.
+func (p *Plugin) updateRates(stats *vppStats) {
+	now := stats.time
+
+	var ifRates []*vpp.InterfaceRates
+	if ifStats := stats.ifStats; ifStats != nil {
+		for _, iface := range ifStats.Interfaces {
+			name, _, exists := p.IfPlugin.GetInterfaceIndex().LookupBySwIfIndex(iface.InterfaceIndex)
+			if !exists {
+				// fallback to internal name
+				name = iface.InterfaceName
+			}
+			key := fmt.Sprintf("interface/%d/", iface.InterfaceIndex)
+			ifRates = append(ifRates, &vpp.InterfaceRates{
+				Name:     name,
+				Index:    iface.InterfaceIndex,
+				RxPps:    p.rates.update(key+ifCounterRxPackets, now, iface.Rx.Packets),
+				TxPps:    p.rates.update(key+ifCounterTxPackets, now, iface.Tx.Packets),
+				RxBps:    8 * p.rates.update(key+ifCounterRxBytes, now, iface.Rx.Bytes),
+				TxBps:    8 * p.rates.update(key+ifCounterTxBytes, now, iface.Tx.Bytes),
+				DropRate: p.rates.update(key+ifCounterDrops, now, iface.Drops),
+			})
+		}
+	}
+
+	// both runtime info and node counters are read unless nodes are skipped,
+	// rates are not updated from partial counters if reading any of them failed
+	var nodeRates []*vpp.NodeRates
+	if stats.runtimeInfo != nil && stats.nodeCounters != nil {
+		// node counters are summed over all threads
+		type nodeCounters struct {
+			calls, vectors, errors uint64
+		}
+		nodes := make(map[string]*nodeCounters)
+		getNode := func(name string) *nodeCounters {
+			node, ok := nodes[name]
+			if !ok {
+				node = &nodeCounters{}
+				nodes[name] = node
+			}
+			return node
+		}
+
+		for _, thread := range stats.runtimeInfo.GetThreads() {
+			for _, item := range thread.Items {
+				node := getNode(item.Name)
+				node.calls += item.Calls
+				node.vectors += item.Vectors
+			}
+		}
+		for _, counter := range stats.nodeCounters.GetCounters() {
+			getNode(counter.Node).errors += counter.Value
+		}
+
+		names := make([]string, 0, len(nodes))
+		for name := range nodes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			node, key := nodes[name], "node/"+name+"/"
+			nodeRates = append(nodeRates, &vpp.NodeRates{
+				Name:          name,
+				CallsPerSec:   p.rates.update(key+runtimeCallsMetric, now, node.calls),
+				VectorsPerSec: p.rates.update(key+runtimeVectorsMetric, now, node.vectors),
+				ErrorsPerSec:  p.rates.update(key+nodeRateErrorsMetric, now, node.errors),
+			})
+		}
+	}
+
+	// windows are kept over failed readings, windows of counters of removed
+	// objects are dropped after the rate window
+	p.rates.prune(now.Add(-p.rates.window))
+	p.rates.setRates(ifRates, nodeRates)
+	p.tracef("rates updated: %d interfaces, %d nodes", len(ifRates), len(nodeRates))
+
+	if !p.prometheusDisabled {
+		p.updateRatesPrometheus(ifRates, nodeRates)
+	}
+}
+
+func ratesHandler(rates *rateTracker) func(formatter *render.Render) http.HandlerFunc {
+	return func(formatter *render.Render) http.HandlerFunc {
+		return func(w http.ResponseWriter, req *http.Request) {
+			ifRates, nodeRates := rates.getRates()
+			_ = formatter.JSON(w, http.StatusOK, struct {
+				Interfaces []*vpp.InterfaceRates `json:"interfaces"`
+				Nodes      []*vpp.NodeRates      `json:"nodes"`
+			}{ifRates, nodeRates})
+		}
+	}
+}
@@ -0,0 +1,138 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+)
+
+func TestRateTrackerUpdate(t *testing.T) {
+	type sample struct {
+		at    time.Duration
+		value uint64
+		rate  float64
+	}
+	tests := []struct {
+		name    string
+		window  time.Duration
+		samples []sample
+	}{
+		{
+			name:   "rate over window",
+			window: 30 * time.Second,
+			samples: []sample{
+				{at: 0, value: 0, rate: 0},
+				{at: 10 * time.Second, value: 100, rate: 10},
+				{at: 20 * time.Second, value: 300, rate: 15},
+				// the first sample is out of the window
+				{at: 40 * time.Second, value: 500, rate: 400.0 / 30},
+			},
+		},
+		{
+			name:   "two samples kept for window shorter than polling",
+			window: 10 * time.Second,
+			samples: []sample{
+				{at: 0, value: 0, rate: 0},
+				{at: 30 * time.Second, value: 300, rate: 10},
+				{at: 60 * time.Second, value: 1200, rate: 30},
+			},
+		},
+		{
+			name:   "counter reset",
+			window: time.Minute,
+			samples: []sample{
+				{at: 0, value: 1000, rate: 0},
+				{at: 10 * time.Second, value: 2000, rate: 100},
+				// counter decreased, the window is restarted
+				{at: 20 * time.Second, value: 50, rate: 0},
+				{at: 30 * time.Second, value: 250, rate: 20},
+			},
+		},
+		{
+			name:   "counter unchanged",
+			window: time.Minute,
+			samples: []sample{
+				{at: 0, value: 1000, rate: 0},
+				{at: 10 * time.Second, value: 1000, rate: 0},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			tracker := newRateTracker(test.window)
+			start := time.Now()
+			for i, sample := range test.samples {
+				rate := tracker.update("counter", start.Add(sample.at), sample.value)
+				Expect(rate).To(BeNumerically("~", sample.rate, 1e-9), "sample %d", i)
+			}
+		})
+	}
+}
+
+func TestRateTrackerPrune(t *testing.T) {
+	RegisterTestingT(t)
+
+	tracker := newRateTracker(time.Minute)
+	start := time.Now()
+	tracker.update("removed", start, 100)
+	tracker.update("kept", start, 100)
+	tracker.update("kept", start.Add(10*time.Second), 200)
+
+	tracker.prune(start.Add(5 * time.Second))
+	Expect(tracker.counters).To(HaveKey("kept"))
+	Expect(tracker.counters).ToNot(HaveKey("removed"))
+
+	// pruned counter starts a new window
+	Expect(tracker.update("removed", start.Add(20*time.Second), 300)).To(BeZero())
+}
+
+func TestRateTrackerRates(t *testing.T) {
+	RegisterTestingT(t)
+
+	tracker := newRateTracker(time.Minute)
+	Expect(tracker.sampled()).To(BeFalse())
+
+	ifRates := []*vpp.InterfaceRates{{Name: "tap0", Index: 1, RxPps: 10}}
+	nodeRates := []*vpp.NodeRates{{Name: "ip4-input", CallsPerSec: 5}}
+	tracker.setRates(ifRates, nodeRates)
+	Expect(tracker.sampled()).To(BeFalse())
+	tracker.setRates(ifRates, nodeRates)
+	Expect(tracker.sampled()).To(BeTrue())
+
+	// returned rates are copies
+	gotIfRates, gotNodeRates := tracker.getRates()
+	Expect(gotIfRates).To(HaveLen(1))
+	Expect(gotIfRates[0].RxPps).To(Equal(10.0))
+	Expect(gotNodeRates).To(HaveLen(1))
+	gotIfRates[0].RxPps = 20
+	gotIfRates, _ = tracker.getRates()
+	Expect(gotIfRates[0].RxPps).To(Equal(10.0))
+
+	// reset drops samples and rates
+	tracker.update("counter", time.Now(), 100)
+	tracker.reset()
+	Expect(tracker.sampled()).To(BeFalse())
+	Expect(tracker.counters).To(BeEmpty())
+	gotIfRates, gotNodeRates = tracker.getRates()
+	Expect(gotIfRates).To(BeEmpty())
+	Expect(gotNodeRates).To(BeEmpty())
+}
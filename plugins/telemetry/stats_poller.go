@@ -3,6 +3,8 @@ package telemetry
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sync/atomic"
 	"time"
 
 	govppapi "git.fd.io/govpp.git/api"
@@ -23,24 +25,98 @@ type statsPollerServer struct {
 
 	handler vppcalls.TelemetryVppAPI
 	ifIndex ifaceidx.IfaceMetadataIndex
+	rates   *rateTracker
+
+	// number of VPP reconnects, accessed atomically
+	reconnects uint32
 
 	log logging.Logger
 }
 
+// vppReconnected is called when VPP reconnects, counters of all objects
+// are reset by VPP restart.
+func (s *statsPollerServer) vppReconnected() {
+	atomic.AddUint32(&s.reconnects, 1)
+}
+
+// pollOptions are options of a single PollStats request.
+type pollOptions struct {
+	types        map[configurator.PollStatsRequest_StatsType]bool
+	ifPatterns   []*regexp.Regexp
+	nodePatterns []*regexp.Regexp
+
+	// previous values of counters (by stats key), nil if deltas are not requested
+	prevStats map[string]*vpp.Stats
+	// number of VPP reconnects when the previous values were read
+	reconnects uint32
+}
+
+func newPollOptions(req *configurator.PollStatsRequest) (*pollOptions, error) {
+	opts := &pollOptions{
+		types: make(map[configurator.PollStatsRequest_StatsType]bool),
+	}
+	for _, statsType := range req.GetTypes() {
+		opts.types[statsType] = true
+	}
+	if len(opts.types) == 0 {
+		opts.types[configurator.PollStatsRequest_INTERFACE] = true
+	}
+	var err error
+	if opts.ifPatterns, err = compilePatterns(req.GetInterfacePatterns()); err != nil {
+		return nil, fmt.Errorf("invalid interface pattern: %v", err)
+	}
+	if opts.nodePatterns, err = compilePatterns(req.GetNodePatterns()); err != nil {
+		return nil, fmt.Errorf("invalid node pattern: %v", err)
+	}
+	if req.GetDeltas() {
+		opts.prevStats = make(map[string]*vpp.Stats)
+	}
+	return opts, nil
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var res []*regexp.Regexp
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, re)
+	}
+	return res, nil
+}
+
+func matchesAny(patterns []*regexp.Regexp, name string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, re := range patterns {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *statsPollerServer) PollStats(req *configurator.PollStatsRequest, svr configurator.StatsPollerService_PollStatsServer) error {
 	if req.GetPeriodSec() == 0 && req.GetNumPolls() > 1 {
 		return status.Error(codes.InvalidArgument, "period must be > 0 if number of polls is > 1")
 	}
+	opts, err := newPollOptions(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
 	if s.handler == nil {
 		return status.Errorf(codes.Unavailable, "VPP telemetry handler not available")
 	}
+	opts.reconnects = atomic.LoadUint32(&s.reconnects)
 
 	ctx := svr.Context()
 
 	streamStats := func(pollSeq uint32) (err error) {
 		vppStatsCh := make(chan *vpp.Stats)
 		go func() {
-			err = s.streamVppStats(ctx, vppStatsCh)
+			err = s.streamVppStats(ctx, opts, vppStatsCh)
 			close(vppStatsCh)
 		}()
 		for vppStats := range vppStatsCh {
@@ -95,23 +171,82 @@ func (s *statsPollerServer) PollStats(req *configurator.PollStatsRequest, svr co
 	}
 }
 
-func (s *statsPollerServer) streamVppStats(ctx context.Context, ch chan *vpp.Stats) error {
+func (s *statsPollerServer) streamVppStats(ctx context.Context, opts *pollOptions, ch chan *vpp.Stats) error {
+	type statsGetter func(context.Context, *pollOptions) (map[string]*vpp.Stats, []string, error)
+
+	// stats types in the order in which they are streamed
+	getters := []struct {
+		statsType configurator.PollStatsRequest_StatsType
+		get       statsGetter
+	}{
+		{configurator.PollStatsRequest_INTERFACE, s.getInterfaceStats},
+		{configurator.PollStatsRequest_ERROR, s.getErrorStats},
+		{configurator.PollStatsRequest_NODE, s.getNodeStats},
+		{configurator.PollStatsRequest_BUFFER, s.getBufferStats},
+		{configurator.PollStatsRequest_SYSTEM, s.getSystemStats},
+		{configurator.PollStatsRequest_RATES, s.getRateStats},
+	}
+
+	// previous values are dropped after VPP restart, the first deltas
+	// after the restart are counted from zero (as for any other reset)
+	if reconnects := atomic.LoadUint32(&s.reconnects); opts.prevStats != nil && reconnects != opts.reconnects {
+		s.log.Debugf("VPP reconnected, dropping previous values of %d stats", len(opts.prevStats))
+		opts.prevStats = make(map[string]*vpp.Stats)
+		opts.reconnects = reconnects
+	}
+
+	for _, getter := range getters {
+		if !opts.types[getter.statsType] {
+			continue
+		}
+		stats, keys, err := getter.get(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		s.log.Debugf("streaming %d %v stats", len(keys), getter.statsType)
+
+		for _, key := range keys {
+			vppStats := stats[key]
+			if opts.prevStats != nil {
+				current := vppStats
+				vppStats = statsDelta(current, opts.prevStats[key])
+				opts.prevStats[key] = current
+			}
+
+			select {
+			case ch <- vppStats:
+				// stats sent
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+	return nil
+}
+
+func (s *statsPollerServer) getInterfaceStats(ctx context.Context, opts *pollOptions) (
+	stats map[string]*vpp.Stats, keys []string, err error) {
+
 	ifStats, err := s.handler.GetInterfaceStats(ctx)
 	if err != nil {
-		return err
+		return nil, nil, err
 	} else if ifStats == nil {
-		return fmt.Errorf("interface stats not avaiable")
+		return nil, nil, fmt.Errorf("interface stats not avaiable")
 	}
 
-	s.log.Debugf("streaming %d interface stats", len(ifStats.Interfaces))
-
+	stats = make(map[string]*vpp.Stats)
 	for _, iface := range ifStats.Interfaces {
 		name, _, exists := s.ifIndex.LookupBySwIfIndex(iface.InterfaceIndex)
 		if !exists {
 			// fallback to internal name
 			name = iface.InterfaceName
 		}
-		vppStats := &vpp.Stats{
+		if !matchesAny(opts.ifPatterns, name) {
+			continue
+		}
+		key := fmt.Sprintf("interface/%d", iface.InterfaceIndex)
+		stats[key] = &vpp.Stats{
 			Interface: &vpp_interfaces.InterfaceStats{
 				Name:        name,
 				Rx:          convertInterfaceCombined(iface.Rx),
@@ -133,15 +268,256 @@ func (s *statsPollerServer) streamVppStats(ctx context.Context, ch chan *vpp.Sta
 				Mpls:        iface.Mpls,
 			},
 		}
+		keys = append(keys, key)
+	}
+	return stats, keys, nil
+}
 
-		select {
-		case ch <- vppStats:
-			// stats sent
-		case <-ctx.Done():
-			return ctx.Err()
+func (s *statsPollerServer) getErrorStats(ctx context.Context, opts *pollOptions) (
+	stats map[string]*vpp.Stats, keys []string, err error) {
+
+	nodeCounters, err := s.handler.GetNodeCounters(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats = make(map[string]*vpp.Stats)
+	for _, counter := range nodeCounters.GetCounters() {
+		if !matchesAny(opts.nodePatterns, counter.Node) {
+			continue
+		}
+		key := fmt.Sprintf("error/%s/%s", counter.Node, counter.Name)
+		if _, duplicate := stats[key]; duplicate {
+			continue
+		}
+		stats[key] = &vpp.Stats{
+			Error: &vpp.ErrorStats{
+				Node:   counter.Node,
+				Reason: counter.Name,
+				Value:  counter.Value,
+			},
 		}
+		keys = append(keys, key)
+	}
+	return stats, keys, nil
+}
+
+func (s *statsPollerServer) getNodeStats(ctx context.Context, opts *pollOptions) (
+	stats map[string]*vpp.Stats, keys []string, err error) {
+
+	runtimeInfo, err := s.handler.GetRuntimeInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats = make(map[string]*vpp.Stats)
+	for _, thread := range runtimeInfo.GetThreads() {
+		for _, item := range thread.Items {
+			if !matchesAny(opts.nodePatterns, item.Name) {
+				continue
+			}
+			key := fmt.Sprintf("node/%d/%s", thread.ID, item.Name)
+			stats[key] = &vpp.Stats{
+				Node: &vpp.NodeStats{
+					Name:           item.Name,
+					Index:          uint32(item.Index),
+					ThreadId:       uint32(thread.ID),
+					Calls:          item.Calls,
+					Vectors:        item.Vectors,
+					Suspends:       item.Suspends,
+					Clocks:         item.Clocks,
+					VectorsPerCall: item.VectorsPerCall,
+				},
+			}
+			keys = append(keys, key)
+		}
+	}
+	return stats, keys, nil
+}
+
+func (s *statsPollerServer) getBufferStats(ctx context.Context, opts *pollOptions) (
+	stats map[string]*vpp.Stats, keys []string, err error) {
+
+	buffersInfo, err := s.handler.GetBuffersInfo(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats = make(map[string]*vpp.Stats)
+	for _, item := range buffersInfo.GetItems() {
+		key := fmt.Sprintf("buffer/%d/%d/%s", item.ThreadID, item.Index, item.Name)
+		stats[key] = &vpp.Stats{
+			Buffer: &vpp.BufferStats{
+				Name:     item.Name,
+				Index:    uint32(item.Index),
+				ThreadId: uint32(item.ThreadID),
+				Size:     item.Size,
+				Alloc:    item.Alloc,
+				Free:     item.Free,
+				NumAlloc: item.NumAlloc,
+				NumFree:  item.NumFree,
+			},
+		}
+		keys = append(keys, key)
+	}
+	return stats, keys, nil
+}
+
+func (s *statsPollerServer) getSystemStats(ctx context.Context, opts *pollOptions) (
+	stats map[string]*vpp.Stats, keys []string, err error) {
+
+	sysStats, err := s.handler.GetSystemStats(ctx)
+	if err != nil {
+		return nil, nil, err
+	} else if sysStats == nil {
+		// system stats are available only via stats API
+		return nil, nil, nil
+	}
+
+	const key = "system"
+	stats = map[string]*vpp.Stats{
+		key: {
+			System: &vpp.SystemStats{
+				VectorRate:          sysStats.VectorRate,
+				NumWorkerThreads:    sysStats.NumWorkerThreads,
+				VectorRatePerWorker: sysStats.VectorRatePerWorker,
+				InputRate:           sysStats.InputRate,
+				LastUpdate:          sysStats.LastUpdate,
+				LastStatsClear:      sysStats.LastStatsClear,
+				Heartbeat:           sysStats.Heartbeat,
+			},
+		},
+	}
+	return stats, []string{key}, nil
+}
+
+func (s *statsPollerServer) getRateStats(ctx context.Context, opts *pollOptions) (
+	stats map[string]*vpp.Stats, keys []string, err error) {
+
+	if s.rates == nil {
+		return nil, nil, nil
+	}
+	ifRates, nodeRates := s.rates.getRates()
+
+	stats = make(map[string]*vpp.Stats)
+	for _, rates := range ifRates {
+		if !matchesAny(opts.ifPatterns, rates.Name) {
+			continue
+		}
+		key := fmt.Sprintf("rates/interface/%d", rates.Index)
+		stats[key] = &vpp.Stats{InterfaceRates: rates}
+		keys = append(keys, key)
+	}
+	for _, rates := range nodeRates {
+		if !matchesAny(opts.nodePatterns, rates.Name) {
+			continue
+		}
+		key := fmt.Sprintf("rates/node/%s", rates.Name)
+		stats[key] = &vpp.Stats{NodeRates: rates}
+		keys = append(keys, key)
+	}
+	return stats, keys, nil
+}
+
+// statsDelta returns increments of counters since the previous polling.
+// Counters of an object are reset together (e.g. by "clear" CLI), if any of
+// the counters decreased, absolute values of all the counters are returned
+// as increments since the reset. Counters are 64-bit and do not wrap
+// in practice, decreased counter is always handled as reset. Previous values
+// are dropped after VPP restart (see streamVppStats).
+func statsDelta(current, prev *vpp.Stats) *vpp.Stats {
+	if prev == nil {
+		return current
+	}
+	var d counterDeltas
+	var delta *vpp.Stats
+	switch {
+	case current.Interface != nil:
+		cur, old := current.Interface, prev.GetInterface()
+		delta = &vpp.Stats{
+			Interface: &vpp_interfaces.InterfaceStats{
+				Name:        cur.Name,
+				Rx:          d.combined(cur.Rx, old.GetRx()),
+				Tx:          d.combined(cur.Tx, old.GetTx()),
+				RxUnicast:   d.combined(cur.RxUnicast, old.GetRxUnicast()),
+				RxMulticast: d.combined(cur.RxMulticast, old.GetRxMulticast()),
+				RxBroadcast: d.combined(cur.RxBroadcast, old.GetRxBroadcast()),
+				TxUnicast:   d.combined(cur.TxUnicast, old.GetTxUnicast()),
+				TxMulticast: d.combined(cur.TxMulticast, old.GetTxMulticast()),
+				TxBroadcast: d.combined(cur.TxBroadcast, old.GetTxBroadcast()),
+				RxError:     d.counter(cur.RxError, old.GetRxError()),
+				TxError:     d.counter(cur.TxError, old.GetTxError()),
+				RxNoBuf:     d.counter(cur.RxNoBuf, old.GetRxNoBuf()),
+				RxMiss:      d.counter(cur.RxMiss, old.GetRxMiss()),
+				Drops:       d.counter(cur.Drops, old.GetDrops()),
+				Punts:       d.counter(cur.Punts, old.GetPunts()),
+				Ip4:         d.counter(cur.Ip4, old.GetIp4()),
+				Ip6:         d.counter(cur.Ip6, old.GetIp6()),
+				Mpls:        d.counter(cur.Mpls, old.GetMpls()),
+			},
+		}
+	case current.Error != nil:
+		cur, old := current.Error, prev.GetError()
+		delta = &vpp.Stats{
+			Error: &vpp.ErrorStats{
+				Node:   cur.Node,
+				Reason: cur.Reason,
+				Value:  d.counter(cur.Value, old.GetValue()),
+			},
+		}
+	case current.Node != nil:
+		cur, old := current.Node, prev.GetNode()
+		node := &vpp.NodeStats{
+			Name:     cur.Name,
+			Index:    cur.Index,
+			ThreadId: cur.ThreadId,
+			Calls:    d.counter(cur.Calls, old.GetCalls()),
+			Vectors:  d.counter(cur.Vectors, old.GetVectors()),
+			Suspends: d.counter(cur.Suspends, old.GetSuspends()),
+			Clocks:   cur.Clocks - old.GetClocks(),
+		}
+		if node.Clocks < 0 {
+			d.reset = true
+		}
+		if node.Calls != 0 {
+			node.VectorsPerCall = float64(node.Vectors) / float64(node.Calls)
+		}
+		delta = &vpp.Stats{Node: node}
+	case current.Buffer != nil:
+		cur, old := current.Buffer, prev.GetBuffer()
+		buffer := proto.Clone(cur).(*vpp.BufferStats)
+		buffer.NumAlloc = d.counter(cur.NumAlloc, old.GetNumAlloc())
+		buffer.NumFree = d.counter(cur.NumFree, old.GetNumFree())
+		delta = &vpp.Stats{Buffer: buffer}
+	default:
+		// system stats and rates are gauges
+		return current
+	}
+	if d.reset {
+		return current
+	}
+	return delta
+}
+
+// counterDeltas computes increments of counters of a single object
+// and records whether any of the counters was reset.
+type counterDeltas struct {
+	reset bool
+}
+
+func (d *counterDeltas) counter(current, prev uint64) uint64 {
+	if current < prev {
+		d.reset = true
+		return current
+	}
+	return current - prev
+}
+
+func (d *counterDeltas) combined(current, prev *vpp_interfaces.InterfaceStats_CombinedCounter) *vpp_interfaces.InterfaceStats_CombinedCounter {
+	return &vpp_interfaces.InterfaceStats_CombinedCounter{
+		Bytes:   d.counter(current.GetBytes(), prev.GetBytes()),
+		Packets: d.counter(current.GetPackets(), prev.GetPackets()),
 	}
-	return nil
 }
 
 func convertInterfaceCombined(c govppapi.InterfaceCounterCombined) *vpp_interfaces.InterfaceStats_CombinedCounter {
@@ -0,0 +1,282 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	govppapi "git.fd.io/govpp.git/api"
+	"github.com/golang/protobuf/proto"
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
+	"go.ligato.io/vpp-agent/v3/proto/ligato/vpp"
+	vpp_interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestNewPollOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    *configurator.PollStatsRequest
+		types  []configurator.PollStatsRequest_StatsType
+		ifName string
+		ifOK   bool
+		node   string
+		nodeOK bool
+		deltas bool
+		err    bool
+	}{
+		{
+			name:   "defaults",
+			req:    &configurator.PollStatsRequest{},
+			types:  []configurator.PollStatsRequest_StatsType{configurator.PollStatsRequest_INTERFACE},
+			ifName: "tap0",
+			ifOK:   true,
+			node:   "ip4-input",
+			nodeOK: true,
+		},
+		{
+			name: "types and patterns",
+			req: &configurator.PollStatsRequest{
+				Types: []configurator.PollStatsRequest_StatsType{
+					configurator.PollStatsRequest_ERROR, configurator.PollStatsRequest_NODE,
+				},
+				InterfacePatterns: []string{"^memif", "^tap"},
+				NodePatterns:      []string{"^ip4-"},
+				Deltas:            true,
+			},
+			types: []configurator.PollStatsRequest_StatsType{
+				configurator.PollStatsRequest_ERROR, configurator.PollStatsRequest_NODE,
+			},
+			ifName: "tap0",
+			ifOK:   true,
+			node:   "ip6-input",
+			nodeOK: false,
+			deltas: true,
+		},
+		{
+			name:   "interface not matching",
+			req:    &configurator.PollStatsRequest{InterfacePatterns: []string{"^memif"}},
+			types:  []configurator.PollStatsRequest_StatsType{configurator.PollStatsRequest_INTERFACE},
+			ifName: "tap0",
+			ifOK:   false,
+			node:   "ip4-input",
+			nodeOK: true,
+		},
+		{
+			name: "invalid interface pattern",
+			req:  &configurator.PollStatsRequest{InterfacePatterns: []string{"("}},
+			err:  true,
+		},
+		{
+			name: "invalid node pattern",
+			req:  &configurator.PollStatsRequest{NodePatterns: []string{"[a"}},
+			err:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			opts, err := newPollOptions(test.req)
+			if test.err {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).ToNot(HaveOccurred())
+			Expect(opts.types).To(HaveLen(len(test.types)))
+			for _, statsType := range test.types {
+				Expect(opts.types).To(HaveKeyWithValue(statsType, true))
+			}
+			Expect(matchesAny(opts.ifPatterns, test.ifName)).To(Equal(test.ifOK))
+			Expect(matchesAny(opts.nodePatterns, test.node)).To(Equal(test.nodeOK))
+			Expect(opts.prevStats != nil).To(Equal(test.deltas))
+		})
+	}
+}
+
+func TestStatsDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  *vpp.Stats
+		prev     *vpp.Stats
+		expected *vpp.Stats
+	}{
+		{
+			name:     "first polling",
+			current:  &vpp.Stats{Error: &vpp.ErrorStats{Node: "ip4-input", Reason: "drop", Value: 10}},
+			expected: &vpp.Stats{Error: &vpp.ErrorStats{Node: "ip4-input", Reason: "drop", Value: 10}},
+		},
+		{
+			name: "interface counters",
+			current: &vpp.Stats{Interface: &vpp_interfaces.InterfaceStats{
+				Name:  "tap0",
+				Rx:    &vpp_interfaces.InterfaceStats_CombinedCounter{Packets: 150, Bytes: 15000},
+				Drops: 7,
+			}},
+			prev: &vpp.Stats{Interface: &vpp_interfaces.InterfaceStats{
+				Name:  "tap0",
+				Rx:    &vpp_interfaces.InterfaceStats_CombinedCounter{Packets: 100, Bytes: 10000},
+				Drops: 5,
+			}},
+			expected: &vpp.Stats{Interface: &vpp_interfaces.InterfaceStats{
+				Name:        "tap0",
+				Rx:          &vpp_interfaces.InterfaceStats_CombinedCounter{Packets: 50, Bytes: 5000},
+				Tx:          &vpp_interfaces.InterfaceStats_CombinedCounter{},
+				RxUnicast:   &vpp_interfaces.InterfaceStats_CombinedCounter{},
+				RxMulticast: &vpp_interfaces.InterfaceStats_CombinedCounter{},
+				RxBroadcast: &vpp_interfaces.InterfaceStats_CombinedCounter{},
+				TxUnicast:   &vpp_interfaces.InterfaceStats_CombinedCounter{},
+				TxMulticast: &vpp_interfaces.InterfaceStats_CombinedCounter{},
+				TxBroadcast: &vpp_interfaces.InterfaceStats_CombinedCounter{},
+				Drops:       2,
+			}},
+		},
+		{
+			name: "interface counters reset",
+			// packets grew over the previous value after the reset,
+			// but all the counters are reset together
+			current: &vpp.Stats{Interface: &vpp_interfaces.InterfaceStats{
+				Name:  "tap0",
+				Rx:    &vpp_interfaces.InterfaceStats_CombinedCounter{Packets: 120, Bytes: 9000},
+				Drops: 1,
+			}},
+			prev: &vpp.Stats{Interface: &vpp_interfaces.InterfaceStats{
+				Name:  "tap0",
+				Rx:    &vpp_interfaces.InterfaceStats_CombinedCounter{Packets: 100, Bytes: 10000},
+				Drops: 5,
+			}},
+			expected: &vpp.Stats{Interface: &vpp_interfaces.InterfaceStats{
+				Name:  "tap0",
+				Rx:    &vpp_interfaces.InterfaceStats_CombinedCounter{Packets: 120, Bytes: 9000},
+				Drops: 1,
+			}},
+		},
+		{
+			name:     "error counter reset",
+			current:  &vpp.Stats{Error: &vpp.ErrorStats{Node: "ip4-input", Reason: "drop", Value: 3}},
+			prev:     &vpp.Stats{Error: &vpp.ErrorStats{Node: "ip4-input", Reason: "drop", Value: 10}},
+			expected: &vpp.Stats{Error: &vpp.ErrorStats{Node: "ip4-input", Reason: "drop", Value: 3}},
+		},
+		{
+			name: "node counters",
+			current: &vpp.Stats{Node: &vpp.NodeStats{
+				Name: "ip4-input", Index: 1, Calls: 30, Vectors: 90, Suspends: 1, Clocks: 2.5, VectorsPerCall: 3,
+			}},
+			prev: &vpp.Stats{Node: &vpp.NodeStats{
+				Name: "ip4-input", Index: 1, Calls: 10, Vectors: 10, Suspends: 1, Clocks: 1.5, VectorsPerCall: 1,
+			}},
+			expected: &vpp.Stats{Node: &vpp.NodeStats{
+				Name: "ip4-input", Index: 1, Calls: 20, Vectors: 80, Clocks: 1, VectorsPerCall: 4,
+			}},
+		},
+		{
+			name: "node clocks reset",
+			current: &vpp.Stats{Node: &vpp.NodeStats{
+				Name: "ip4-input", Calls: 30, Vectors: 90, Clocks: 0.5, VectorsPerCall: 3,
+			}},
+			prev: &vpp.Stats{Node: &vpp.NodeStats{
+				Name: "ip4-input", Calls: 10, Vectors: 10, Clocks: 1.5, VectorsPerCall: 1,
+			}},
+			expected: &vpp.Stats{Node: &vpp.NodeStats{
+				Name: "ip4-input", Calls: 30, Vectors: 90, Clocks: 0.5, VectorsPerCall: 3,
+			}},
+		},
+		{
+			name: "buffer counters",
+			current: &vpp.Stats{Buffer: &vpp.BufferStats{
+				Name: "default", Size: 2048, Alloc: 100, Free: 900, NumAlloc: 50, NumFree: 40,
+			}},
+			prev: &vpp.Stats{Buffer: &vpp.BufferStats{
+				Name: "default", Size: 2048, Alloc: 200, Free: 800, NumAlloc: 20, NumFree: 30,
+			}},
+			// alloc and free are gauges
+			expected: &vpp.Stats{Buffer: &vpp.BufferStats{
+				Name: "default", Size: 2048, Alloc: 100, Free: 900, NumAlloc: 30, NumFree: 10,
+			}},
+		},
+		{
+			name:     "system stats",
+			current:  &vpp.Stats{System: &vpp.SystemStats{VectorRate: 10, Heartbeat: 20}},
+			prev:     &vpp.Stats{System: &vpp.SystemStats{VectorRate: 50, Heartbeat: 10}},
+			expected: &vpp.Stats{System: &vpp.SystemStats{VectorRate: 10, Heartbeat: 20}},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			delta := statsDelta(test.current, test.prev)
+			Expect(proto.Equal(delta, test.expected)).To(BeTrue(), "delta: %v", delta)
+		})
+	}
+}
+
+// testStatsHandler returns interface stats with the configured counters.
+type testStatsHandler struct {
+	vppcalls.TelemetryVppAPI
+	rxPackets uint64
+}
+
+func (h *testStatsHandler) GetInterfaceStats(context.Context) (*govppapi.InterfaceStats, error) {
+	return &govppapi.InterfaceStats{
+		Interfaces: []govppapi.InterfaceCounters{
+			{
+				InterfaceIndex: 1,
+				InterfaceName:  "tap0",
+				Rx:             govppapi.InterfaceCounterCombined{Packets: h.rxPackets},
+			},
+		},
+	}, nil
+}
+
+func TestStreamVppStatsDeltas(t *testing.T) {
+	RegisterTestingT(t)
+
+	handler := &testStatsHandler{}
+	server := &statsPollerServer{
+		handler: handler,
+		ifIndex: ifaceidx.NewIfaceIndex(logrus.NewLogger("test"), "test"),
+		log:     logrus.NewLogger("test"),
+	}
+	opts, err := newPollOptions(&configurator.PollStatsRequest{Deltas: true})
+	Expect(err).ToNot(HaveOccurred())
+
+	poll := func(rxPackets uint64) uint64 {
+		handler.rxPackets = rxPackets
+		ch := make(chan *vpp.Stats, 1)
+		Expect(server.streamVppStats(context.Background(), opts, ch)).To(Succeed())
+		Expect(ch).To(HaveLen(1))
+		stats := <-ch
+		Expect(stats.GetInterface().GetName()).To(Equal("tap0"))
+		return stats.GetInterface().GetRx().GetPackets()
+	}
+
+	Expect(poll(100)).To(BeEquivalentTo(100))
+	Expect(poll(150)).To(BeEquivalentTo(50))
+	// counters cleared
+	Expect(poll(20)).To(BeEquivalentTo(20))
+	Expect(poll(30)).To(BeEquivalentTo(10))
+
+	// counters after VPP restart are counted from zero even if they
+	// grew over the previous values
+	server.vppReconnected()
+	Expect(poll(40)).To(BeEquivalentTo(40))
+	Expect(poll(45)).To(BeEquivalentTo(5))
+}
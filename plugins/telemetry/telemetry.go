@@ -22,6 +22,7 @@ import (
 	"sync"
 	"time"
 
+	govppapi "git.fd.io/govpp.git/api"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 	"github.com/unrolled/render"
@@ -36,6 +37,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 	"go.ligato.io/vpp-agent/v3/plugins/govppmux"
 	"go.ligato.io/vpp-agent/v3/plugins/telemetry/vppcalls"
+	vppclient "go.ligato.io/vpp-agent/v3/plugins/vpp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/proto/ligato/configurator"
 
@@ -54,14 +56,23 @@ type Plugin struct {
 	handler vppcalls.TelemetryVppAPI
 
 	statsPollerServer
+	alertingServer
 	prometheusMetrics
 
 	// From config file
 	updatePeriod       time.Duration
+	rateWindow         time.Duration
 	disabled           bool
 	prometheusDisabled bool
+	historyDisabled    bool
+	historyRetention   time.Duration
+	historyMetrics     []string
+	historyFile        string
 	skipped            map[string]bool
 
+	rates   *rateTracker
+	history *history
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -100,32 +111,85 @@ func (p *Plugin) Init() error {
 			p.disabled = true
 			return nil
 		}
+		// This prevents setting the update period to less than 5 seconds,
+		// which can have significant performance hit.
+		if config.PollingInterval > minimumUpdatePeriod {
+			p.updatePeriod = config.PollingInterval
+			p.Log.Infof("polling period changed to %v", p.updatePeriod)
+		} else if config.PollingInterval > 0 {
+			p.Log.Warnf("polling period has to be at least %s, using default: %v",
+				minimumUpdatePeriod, defaultUpdatePeriod)
+		}
+		p.rateWindow = config.RateWindow
+		p.alerter = newAlerter(p.Log.NewLogger("alerting"), config.AlertWebhook, config.AlertHistorySize)
+		for _, rule := range config.AlertRules {
+			if err := p.alerter.setRule(rule); err != nil {
+				return errors.WithMessage(err, "invalid alert rule in config")
+			}
+		}
+		p.historyDisabled = config.HistoryDisabled
+		p.historyRetention = config.HistoryRetention
+		p.historyMetrics = config.HistoryMetrics
+		p.historyFile = config.HistoryFile
 		// Disable prometheus metrics if set by config
 		if config.PrometheusDisabled {
 			p.Log.Info("Prometheus metrics disabled via config file")
 			p.prometheusDisabled = true
-		} else {
-			// This prevents setting the update period to less than 5 seconds,
-			// which can have significant performance hit.
-			if config.PollingInterval > minimumUpdatePeriod {
-				p.updatePeriod = config.PollingInterval
-				p.Log.Infof("polling period changed to %v", p.updatePeriod)
-			} else if config.PollingInterval > 0 {
-				p.Log.Warnf("polling period has to be at least %s, using default: %v",
-					minimumUpdatePeriod, defaultUpdatePeriod)
-			}
-			// Store map of skipped metrics
-			for _, skip := range config.Skipped {
-				p.skipped[skip] = true
-			}
 		}
+		// Store map of skipped metrics, these are not read from VPP at all
+		for _, skip := range config.Skipped {
+			p.skipped[skip] = true
+		}
+	}
+	if p.updatePeriod == 0 {
+		p.updatePeriod = defaultUpdatePeriod
+	}
+
+	// Rates need at least two samples in the window.
+	if p.rateWindow == 0 {
+		p.rateWindow = defaultRateWindow
+	}
+	if p.rateWindow < 2*p.updatePeriod {
+		p.Log.Warnf("rate window has to be at least twice the polling period, using: %v",
+			2*p.updatePeriod)
+		p.rateWindow = 2 * p.updatePeriod
 	}
+	p.rates = newRateTracker(p.rateWindow)
+	if p.alerter == nil {
+		p.alerter = newAlerter(p.Log.NewLogger("alerting"), "", 0)
+	}
+
+	// History keeps at least the last polled value.
+	if !p.historyDisabled {
+		if p.historyRetention == 0 {
+			p.historyRetention = defaultHistoryRetention
+		}
+		if p.historyRetention < p.updatePeriod {
+			p.historyRetention = p.updatePeriod
+		}
+		history, err := newHistory(p.Log.NewLogger("history"), p.historyRetention, p.updatePeriod,
+			p.historyMetrics, p.historyFile)
+		if err != nil {
+			return errors.WithMessage(err, "invalid history config")
+		}
+		if err := history.load(time.Now()); err != nil {
+			p.Log.Warnf("loading history failed: %v", err)
+		}
+		p.history = history
+	}
+
+	// Counters are reset when VPP restarts.
+	p.VPP.OnReconnect(func() {
+		p.Log.Info("VPP reconnected, resetting rates")
+		p.rates.reset()
+		p.statsPollerServer.vppReconnected()
+		if !p.prometheusDisabled {
+			p.resetRatesPrometheus()
+		}
+	})
 
 	// Register prometheus
 	if !p.prometheusDisabled {
-		if p.updatePeriod == 0 {
-			p.updatePeriod = defaultUpdatePeriod
-		}
 		if err := p.registerPrometheus(); err != nil {
 			return err
 		}
@@ -139,6 +203,11 @@ func (p *Plugin) Init() error {
 
 	if p.HTTPHandlers != nil {
 		p.HTTPHandlers.RegisterHTTPHandler("/metrics/{metric}", metricsHandler, "GET")
+		p.HTTPHandlers.RegisterHTTPHandler(ratesURL, ratesHandler(p.rates), "GET")
+		p.alertingServer.registerHTTPHandlers(p.HTTPHandlers)
+		if p.history != nil {
+			p.HTTPHandlers.RegisterHTTPHandler(historyURL, historyHandler(p.history), "GET")
+		}
 	}
 
 	return nil
@@ -147,7 +216,7 @@ func (p *Plugin) Init() error {
 // AfterInit executes after initializion of Telemetry Plugin
 func (p *Plugin) AfterInit() error {
 	// Do not start polling if telemetry is disabled
-	if p.disabled || p.prometheusDisabled {
+	if p.disabled {
 		return nil
 	}
 
@@ -157,16 +226,18 @@ func (p *Plugin) AfterInit() error {
 }
 
 func (p *Plugin) setupStatsPoller() error {
-	h := vppcalls.CompatibleTelemetryHandler(p.VPP)
+	h := vppcalls.CompatibleTelemetryHandler(vppclient.WithCaller(p.VPP, p.String()))
 	if h == nil {
 		p.Log.Warnf("VPP telemetry handler unavailable")
 	} else {
 		p.statsPollerServer.handler = h
 	}
 	p.statsPollerServer.ifIndex = p.IfPlugin.GetInterfaceIndex()
+	p.statsPollerServer.rates = p.rates
 
 	if p.GRPC != nil && p.GRPC.GetServer() != nil {
 		configurator.RegisterStatsPollerServiceServer(p.GRPC.GetServer(), &p.statsPollerServer)
+		configurator.RegisterAlertingServiceServer(p.GRPC.GetServer(), &p.alertingServer)
 	}
 	return nil
 }
@@ -175,11 +246,15 @@ func (p *Plugin) setupStatsPoller() error {
 func (p *Plugin) Close() error {
 	close(p.quit)
 	p.wg.Wait()
+	p.alerter.close()
+	if err := p.history.save(); err != nil {
+		p.Log.Warnf("saving history failed: %v", err)
+	}
 	return nil
 }
 
 func (p *Plugin) startPeriodicUpdates() {
-	p.handler = vppcalls.CompatibleTelemetryHandler(p.VPP)
+	p.handler = vppcalls.CompatibleTelemetryHandler(vppclient.WithCaller(p.VPP, p.String()))
 	if p.handler == nil {
 		p.Log.Warnf("VPP telemetry handler unavailable, skipping periodic updates")
 		return
@@ -189,7 +264,7 @@ func (p *Plugin) startPeriodicUpdates() {
 	go p.periodicUpdates()
 }
 
-// periodic updates for the metrics data
+// periodic updates for the metrics data, rates, alerts and history
 func (p *Plugin) periodicUpdates() {
 	defer p.wg.Done()
 
@@ -200,8 +275,14 @@ func (p *Plugin) periodicUpdates() {
 	for {
 		select {
 		case <-tick.C:
-			ctx := context.Background()
-			p.updatePrometheus(ctx)
+			stats := p.readStats(context.Background())
+			if !p.prometheusDisabled {
+				p.updatePrometheus(stats)
+			}
+			p.updateRates(stats)
+			values := p.collectValues(stats)
+			p.alerter.evaluate(values, stats.time)
+			p.history.record(values, stats.time)
 
 		case <-p.quit:
 			return
@@ -209,6 +290,61 @@ func (p *Plugin) periodicUpdates() {
 	}
 }
 
+// vppStats are stats read from VPP at a single polling, stats which were
+// not read (skipped, not needed or failed to be read) are nil.
+type vppStats struct {
+	time         time.Time
+	runtimeInfo  *vppcalls.RuntimeInfo
+	buffersInfo  *vppcalls.BuffersInfo
+	memoryInfo   *vppcalls.MemoryInfo
+	nodeCounters *vppcalls.NodeCounterInfo
+	ifStats      *govppapi.InterfaceStats
+}
+
+// readStats reads stats from VPP once per polling, the same stats are used
+// to update prometheus metrics, rates, alerts and history. Skipped stats are
+// never read. VPP is polled even if prometheus is disabled, in that case only
+// the stats used for the rates (interface counters, runtime info and node
+// counters) are read, buffers are read only if used by alert rules or history.
+func (p *Plugin) readStats(ctx context.Context) *vppStats {
+	stats := &vppStats{time: time.Now()}
+	var err error
+
+	// runtime info provides node calls and vectors for the node rates
+	if p.exports(runtimeMetricsNamespace) || !p.skipped[nodeMetricsNamespace] {
+		if stats.runtimeInfo, err = p.handler.GetRuntimeInfo(ctx); err != nil {
+			p.Log.Errorf("GetRuntimeInfo failed: %v", err)
+		}
+	}
+	if p.exports(buffersMetricsNamespace) || (!p.skipped[buffersMetricsNamespace] &&
+		(p.alerter.usesMetrics(alertBufferPrefix) || p.history.records(alertBufferPrefix))) {
+		if stats.buffersInfo, err = p.handler.GetBuffersInfo(ctx); err != nil {
+			p.Log.Errorf("GetBuffersInfo failed: %v", err)
+		}
+	}
+	if p.exports(memoryMetricsNamespace) {
+		if stats.memoryInfo, err = p.handler.GetMemory(ctx); err != nil {
+			p.Log.Errorf("GetMemory failed: %v", err)
+		}
+	}
+	if !p.skipped[nodeMetricsNamespace] {
+		if stats.nodeCounters, err = p.handler.GetNodeCounters(ctx); err != nil {
+			p.Log.Errorf("GetNodeCounters failed: %v", err)
+		}
+	}
+	if !p.skipped[ifMetricsNamespace] {
+		if stats.ifStats, err = p.handler.GetInterfaceStats(ctx); err != nil {
+			p.Log.Errorf("GetInterfaceStats failed: %v", err)
+		}
+	}
+	return stats
+}
+
+// exports returns true if the metrics of the namespace are exported to prometheus.
+func (p *Plugin) exports(namespace string) bool {
+	return !p.prometheusDisabled && !p.skipped[namespace]
+}
+
 func (p *Plugin) tracef(f string, a ...interface{}) {
 	if debug && p.Log.GetLevel() >= logging.DebugLevel {
 		s := fmt.Sprintf(f, a...)
@@ -17,6 +17,7 @@ package binapi
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	govppapi "git.fd.io/govpp.git/api"
 	"go.ligato.io/cn-infra/v2/logging"
@@ -74,6 +75,69 @@ func CompatibleVersion(ch CompatibilityChecker) (Version, error) {
 	return "", fmt.Errorf("no compatible binapi version found")
 }
 
+// VersionCompatibility describes compatibility of binapi version with VPP.
+type VersionCompatibility struct {
+	Version Version `json:"version"`
+	// Messages is the number of messages in the version.
+	Messages int `json:"messages"`
+	// Incompatible lists messages unknown to VPP, which
+	// includes messages with changed CRC.
+	Incompatible []MessageInfo `json:"incompatible,omitempty"`
+}
+
+// MessageInfo identifies binapi message.
+type MessageInfo struct {
+	Name string `json:"name"`
+	CRC  string `json:"crc"`
+}
+
+// Compatible returns true if all messages of the version are compatible.
+func (vc VersionCompatibility) Compatible() bool {
+	return len(vc.Incompatible) == 0
+}
+
+// ProbeCompatibility checks compatibility of each message of all binapi versions
+// and returns compatibility of the versions sorted by version.
+func ProbeCompatibility(ch CompatibilityChecker) ([]VersionCompatibility, error) {
+	var compat []VersionCompatibility
+	for version, check := range Versions {
+		vc := VersionCompatibility{Version: version}
+		msgs := check.AllMessages()
+		incompatible := make(map[string]bool)
+		var compErr *govppapi.CompatibilityError
+		if err := ch.CheckCompatiblity(msgs...); errors.As(err, &compErr) {
+			for _, msg := range compErr.IncompatibleMessages {
+				incompatible[msg] = true
+			}
+		} else if err != nil {
+			return nil, fmt.Errorf("binapi version %v check failed: %w", version, err)
+		}
+		seen := make(map[string]bool)
+		for _, msg := range msgs {
+			key := msg.GetMessageName() + "_" + msg.GetCrcString()
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			vc.Messages++
+			if incompatible[key] {
+				vc.Incompatible = append(vc.Incompatible, MessageInfo{
+					Name: msg.GetMessageName(),
+					CRC:  msg.GetCrcString(),
+				})
+			}
+		}
+		sort.Slice(vc.Incompatible, func(i, j int) bool {
+			return vc.Incompatible[i].Name < vc.Incompatible[j].Name
+		})
+		compat = append(compat, vc)
+	}
+	sort.Slice(compat, func(i, j int) bool {
+		return compat[i].Version < compat[j].Version
+	})
+	return compat, nil
+}
+
 // VersionMsgs contains list of messages in version.
 type VersionMsgs struct {
 	Core    MessagesList
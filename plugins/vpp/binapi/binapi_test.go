@@ -0,0 +1,104 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package binapi_test
+
+import (
+	"errors"
+	"testing"
+
+	govppapi "git.fd.io/govpp.git/api"
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip"
+)
+
+// testChecker reports messages (name_crc) as incompatible.
+type testChecker struct {
+	incompatible map[string]bool
+	err          error
+}
+
+func (c *testChecker) CheckCompatiblity(msgs ...govppapi.Message) error {
+	if c.err != nil {
+		return c.err
+	}
+	var incompatible []string
+	for _, msg := range msgs {
+		if key := msg.GetMessageName() + "_" + msg.GetCrcString(); c.incompatible[key] {
+			incompatible = append(incompatible, key)
+		}
+	}
+	if len(incompatible) > 0 {
+		return &govppapi.CompatibilityError{IncompatibleMessages: incompatible}
+	}
+	return nil
+}
+
+func msgKey(msg govppapi.Message) string {
+	return msg.GetMessageName() + "_" + msg.GetCrcString()
+}
+
+func TestProbeCompatibility(t *testing.T) {
+	RegisterTestingT(t)
+
+	versions := binapi.Versions
+	defer func() { binapi.Versions = versions }()
+
+	binapi.Versions = map[binapi.Version]binapi.VersionMsgs{
+		"20.01-test": {
+			Core: binapi.Messages(func() []govppapi.Message {
+				return []govppapi.Message{&vpp_ip.IPTableAddDel{}, &vpp_ip.IPRouteAddDel{}}
+			}),
+			// duplicate messages are counted once
+			Plugins: binapi.Messages(func() []govppapi.Message {
+				return []govppapi.Message{&vpp_ip.IPRouteAddDel{}, &vpp_ip.IPMrouteAddDel{}}
+			}),
+		},
+		"19.08-test": {
+			Core: binapi.Messages(func() []govppapi.Message {
+				return []govppapi.Message{&vpp_ip.IPTableAddDel{}}
+			}),
+		},
+	}
+	checker := &testChecker{incompatible: map[string]bool{
+		msgKey(&vpp_ip.IPRouteAddDel{}):  true,
+		msgKey(&vpp_ip.IPMrouteAddDel{}): true,
+	}}
+
+	compat, err := binapi.ProbeCompatibility(checker)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(compat).To(Equal([]binapi.VersionCompatibility{
+		{
+			Version:  "19.08-test",
+			Messages: 1,
+		},
+		{
+			Version:  "20.01-test",
+			Messages: 3,
+			Incompatible: []binapi.MessageInfo{
+				{Name: "ip_mroute_add_del", CRC: (&vpp_ip.IPMrouteAddDel{}).GetCrcString()},
+				{Name: "ip_route_add_del", CRC: (&vpp_ip.IPRouteAddDel{}).GetCrcString()},
+			},
+		},
+	}))
+	Expect(compat[0].Compatible()).To(BeTrue())
+	Expect(compat[1].Compatible()).To(BeFalse())
+
+	// failed check is not reported as incompatibility
+	checker.err = errors.New("not connected")
+	_, err = binapi.ProbeCompatibility(checker)
+	Expect(err).To(HaveOccurred())
+}
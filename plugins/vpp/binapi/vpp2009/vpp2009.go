@@ -20,11 +20,14 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/abf"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/acl"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/af_packet"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/af_xdp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/arp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/bond"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/det44"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/dhcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/dns"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/flowprobe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/gtpu"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface"
@@ -47,9 +50,12 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/stn"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/tapv2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/teib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vhost_user"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/virtio"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vmxnet3"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vpe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vxlan"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vxlan_gbp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vxlan_gpe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/wireguard"
 )
@@ -79,16 +85,22 @@ func init() {
 			sr.AllMessages,
 			tapv2.AllMessages,
 			teib.AllMessages,
+			vhost_user.AllMessages,
+			virtio.AllMessages,
 			vpe.AllMessages,
 			vxlan.AllMessages,
+			vxlan_gbp.AllMessages,
 			vxlan_gpe.AllMessages,
 		),
 		Plugins: vpp.Messages(
 			abf.AllMessages,
 			acl.AllMessages,
+			af_xdp.AllMessages,
+			det44.AllMessages,
 			dhcp.AllMessages,
 			dns.AllMessages,
 			flowprobe.AllMessages,
+			geneve.AllMessages,
 			gtpu.AllMessages,
 			l3xc.AllMessages,
 			memif.AllMessages,
@@ -121,14 +133,20 @@ func init() {
 //go:generate binapigen --input-file=$VPP_API_DIR/core/sr.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/tapv2.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/teib.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/vhost_user.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/virtio.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vpe.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan_gbp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan_gpe.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/abf.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/acl.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/af_xdp.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/det44.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/dhcp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/dns.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/flowprobe.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/geneve.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/gtpu.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/l3xc.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/memif.api.json
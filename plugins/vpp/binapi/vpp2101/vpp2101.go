@@ -20,11 +20,14 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/abf"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/acl"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/af_packet"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/af_xdp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/arp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/bond"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/det44"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/dhcp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/dns"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/flowprobe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/gtpu"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
@@ -39,6 +42,7 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/memclnt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/memif"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat44"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat64"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/punt"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/rd_cp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/rdma"
@@ -47,9 +51,12 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/stn"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/tapv2"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/teib"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vhost_user"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/virtio"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vmxnet3"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vpe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vxlan"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vxlan_gbp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vxlan_gpe"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/wireguard"
 )
@@ -79,20 +86,27 @@ func init() {
 			sr.AllMessages,
 			tapv2.AllMessages,
 			teib.AllMessages,
+			vhost_user.AllMessages,
+			virtio.AllMessages,
 			vpe.AllMessages,
 			vxlan.AllMessages,
+			vxlan_gbp.AllMessages,
 			vxlan_gpe.AllMessages,
 		),
 		Plugins: vpp.Messages(
 			abf.AllMessages,
 			acl.AllMessages,
+			af_xdp.AllMessages,
+			det44.AllMessages,
 			dhcp.AllMessages,
 			dns.AllMessages,
 			flowprobe.AllMessages,
+			geneve.AllMessages,
 			gtpu.AllMessages,
 			l3xc.AllMessages,
 			memif.AllMessages,
 			nat44.AllMessages,
+			nat64.AllMessages,
 			rdma.AllMessages,
 			stn.AllMessages,
 			vmxnet3.AllMessages,
@@ -121,18 +135,25 @@ func init() {
 //go:generate binapigen --input-file=$VPP_API_DIR/core/sr.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/tapv2.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/teib.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/vhost_user.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/virtio.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vpe.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan_gbp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/core/vxlan_gpe.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/abf.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/acl.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/af_xdp.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/det44.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/dhcp.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/dns.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/flowprobe.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/geneve.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/gtpu.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/l3xc.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/memif.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/nat44.api.json
+//go:generate binapigen --input-file=$VPP_API_DIR/plugins/nat64.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/rdma.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/stn.api.json
 //go:generate binapigen --input-file=$VPP_API_DIR/plugins/vmxnet3.api.json
@@ -75,34 +75,121 @@ func (h *Handler) FindCompatibleVersion(c Client) *HandlerVersion {
 }
 
 // GetCompatibleVersion iterates over all available handler versions and calls
-// their Check method to check compatibility.
+// their Check method to check compatibility. Fully compatible version is preferred,
+// even over the preferred binapi version. If none of the versions is fully
+// compatible, the partly compatible preferred version or the version with the least
+// incompatible messages is returned and only features using the incompatible
+// messages are unavailable. Versions with all messages incompatible (e.g. VPP
+// plugin of the handler is not loaded) are never used.
 func (h *Handler) GetCompatibleVersion(c Client) (*HandlerVersion, error) {
 	if len(h.versions) == 0 {
 		logging.Debugf("VPP handler %s has no registered versions", h.desc.Name)
 		return nil, ErrNoVersions
 	}
+	var picked struct {
+		version      *HandlerVersion
+		incompatible []string
+	}
+	preferred := h.versions[c.BinapiVersion()]
+	pick := func(v *HandlerVersion, incompatible []string) {
+		if picked.version == nil ||
+			(picked.version != preferred && len(picked.incompatible) > len(incompatible)) {
+			picked.version = v
+			picked.incompatible = incompatible
+		}
+	}
+
 	// try preferred binapi version first
-	if ver := c.BinapiVersion(); ver != "" {
-		if v, ok := h.versions[ver]; ok {
-			logging.Debugf("VPP handler %s using preferred version: %s", h.desc.Name, v.Version)
-			return v, nil
+	if preferred != nil {
+		incompatible, usable := h.checkVersion(c, preferred)
+		if usable && len(incompatible) == 0 {
+			logging.Debugf("VPP handler %s using preferred version: %s", h.desc.Name, preferred.Version)
+			return preferred, nil
+		} else if usable {
+			pick(preferred, incompatible)
 		}
 	}
 	// fallback to checking all registered versions
-	for _, v := range h.versions {
-		var compErr *govppapi.CompatibilityError
-		if err := v.Check(c); errors.As(err, &compErr) {
-			logging.Debugf("VPP handler %s incompatible with %s (%d messages)", h.desc.Name, v.Version, len(compErr.IncompatibleMessages))
-		} else if err != nil {
-			logging.Warnf("VPP handler %s version %s check failed: %v", h.desc.Name, v.Version, err)
-		} else {
+	for _, ver := range h.Versions() {
+		v := h.versions[ver]
+		if v == preferred {
+			continue
+		}
+		incompatible, usable := h.checkVersion(c, v)
+		if usable && len(incompatible) == 0 {
 			logging.Debugf("VPP handler %s COMPATIBLE with version: %s", h.desc.Name, v.Version)
 			return v, nil
+		} else if usable {
+			pick(v, incompatible)
 		}
 	}
+	if picked.version != nil {
+		logging.Warnf("VPP handler %s partly compatible, using version %s with %d incompatible messages: %v",
+			h.desc.Name, picked.version.Version, len(picked.incompatible), picked.incompatible)
+		return picked.version, nil
+	}
 	return nil, ErrIncompatible
 }
 
+// checkVersion checks compatibility of the handler version and returns its
+// incompatible messages. The version is usable if at least some of the checked
+// messages are compatible.
+func (h *Handler) checkVersion(c Client, v *HandlerVersion) (incompatible []string, usable bool) {
+	checked := &checkedMessages{Client: c, msgs: make(map[string]bool)}
+	var compErr *govppapi.CompatibilityError
+	if err := v.Check(checked); errors.As(err, &compErr) {
+		if len(compErr.IncompatibleMessages) >= len(checked.msgs) {
+			logging.Debugf("VPP handler %s incompatible with %s (all %d messages): %v",
+				h.desc.Name, v.Version, len(compErr.IncompatibleMessages), compErr.IncompatibleMessages)
+			return compErr.IncompatibleMessages, false
+		}
+		logging.Debugf("VPP handler %s partly incompatible with %s (%d/%d messages): %v", h.desc.Name,
+			v.Version, len(compErr.IncompatibleMessages), len(checked.msgs), compErr.IncompatibleMessages)
+		return compErr.IncompatibleMessages, true
+	} else if err != nil {
+		logging.Warnf("VPP handler %s version %s check failed: %v", h.desc.Name, v.Version, err)
+		return nil, false
+	}
+	return nil, true
+}
+
+// checkedMessages is a client collecting messages checked for compatibility
+// by the Check method of handler version, either by the client itself or by
+// a channel created by the client.
+type checkedMessages struct {
+	Client
+	msgs map[string]bool
+}
+
+func (c *checkedMessages) add(msgs []govppapi.Message) {
+	for _, msg := range msgs {
+		c.msgs[msg.GetMessageName()+"_"+msg.GetCrcString()] = true
+	}
+}
+
+func (c *checkedMessages) CheckCompatiblity(msgs ...govppapi.Message) error {
+	c.add(msgs)
+	return c.Client.CheckCompatiblity(msgs...)
+}
+
+func (c *checkedMessages) NewAPIChannel() (govppapi.Channel, error) {
+	ch, err := c.Client.NewAPIChannel()
+	if err != nil {
+		return nil, err
+	}
+	return &checkedChannel{Channel: ch, checked: c}, nil
+}
+
+type checkedChannel struct {
+	govppapi.Channel
+	checked *checkedMessages
+}
+
+func (ch *checkedChannel) CheckCompatiblity(msgs ...govppapi.Message) error {
+	ch.checked.add(msgs)
+	return ch.Channel.CheckCompatiblity(msgs...)
+}
+
 // Versions returns list of versions from list of available handler versions.
 func (h *Handler) Versions() []Version {
 	vs := make([]Version, 0, len(h.versions))
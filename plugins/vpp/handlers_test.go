@@ -15,11 +15,14 @@
 package vpp_test
 
 import (
+	"errors"
 	"testing"
 
+	govppapi "git.fd.io/govpp.git/api"
 	. "github.com/onsi/gomega"
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	vpp_wg "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/wireguard"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
 )
 
@@ -76,3 +79,143 @@ func TestRegisterHandlerVersions(t *testing.T) {
 	Expect(ver.Version).To(Equal(version))
 	Expect(ver.NewHandler(c.MockVPPClient)).To(BeAssignableToTypeOf(&testHandler{}))
 }
+
+// incompatibleClient reports the given messages as incompatible.
+type incompatibleClient struct {
+	vpp.Client
+	preferred    vpp.Version
+	incompatible map[govppapi.Message]bool
+}
+
+func (c *incompatibleClient) CheckCompatiblity(msgs ...govppapi.Message) error {
+	var compErr govppapi.CompatibilityError
+	for _, msg := range msgs {
+		if c.incompatible[msg] {
+			compErr.IncompatibleMessages = append(compErr.IncompatibleMessages,
+				msg.GetMessageName()+"_"+msg.GetCrcString())
+		}
+	}
+	if len(compErr.IncompatibleMessages) > 0 {
+		return &compErr
+	}
+	return nil
+}
+
+func (c *incompatibleClient) BinapiVersion() vpp.Version {
+	return c.preferred
+}
+
+func (c *incompatibleClient) NewAPIChannel() (govppapi.Channel, error) {
+	return &incompatibleChannel{client: c}, nil
+}
+
+type incompatibleChannel struct {
+	govppapi.Channel
+	client *incompatibleClient
+}
+
+func (ch *incompatibleChannel) CheckCompatiblity(msgs ...govppapi.Message) error {
+	return ch.client.CheckCompatiblity(msgs...)
+}
+
+var (
+	msgA = &vpp_wg.WireguardInterfaceCreate{}
+	msgB = &vpp_wg.WireguardInterfaceDelete{}
+	msgC = &vpp_wg.WireguardPeerAdd{}
+)
+
+func addCheckedVersion(handler *vpp.Handler, version vpp.Version, msgs ...govppapi.Message) {
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: version,
+		Check: func(client vpp.Client) error {
+			return client.CheckCompatiblity(msgs...)
+		},
+		NewHandler: func(client vpp.Client, i ...interface{}) vpp.HandlerAPI {
+			return &testHandler{}
+		},
+	})
+}
+
+func TestHandlerPartlyCompatible(t *testing.T) {
+	c := vppmock.SetupTestCtx(t)
+	vpp.ClearRegisteredHandlers()
+
+	handler := vpp.RegisterHandler(vpp.HandlerDesc{
+		Name:       "handlerA",
+		HandlerAPI: (*testHandlerAPI)(nil),
+	})
+	client := &incompatibleClient{
+		Client:       c.MockVPPClient,
+		incompatible: map[govppapi.Message]bool{msgA: true, msgB: true},
+	}
+	addCheckedVersion(handler, "19.08-a", msgA, msgB, msgC)
+	addCheckedVersion(handler, "19.08-b", msgA, &vpp_wg.WireguardPeerRemove{}, msgC)
+	handler.AddVersion(vpp.HandlerVersion{
+		Version: "19.08-c",
+		Check: func(client vpp.Client) error {
+			return errors.New("check failed")
+		},
+	})
+
+	// version with least incompatible messages is used
+	ver, err := handler.GetCompatibleVersion(client)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ver.Version).To(BeEquivalentTo("19.08-b"))
+
+	// partly compatible preferred version is used over versions
+	// with more incompatible messages
+	client.preferred = "19.08-a"
+	ver, err = handler.GetCompatibleVersion(client)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ver.Version).To(BeEquivalentTo("19.08-a"))
+
+	// fully compatible version is preferred over the preferred version
+	addCheckedVersion(handler, "19.08-d", msgC)
+	ver, err = handler.GetCompatibleVersion(client)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ver.Version).To(BeEquivalentTo("19.08-d"))
+}
+
+func TestHandlerPluginMissing(t *testing.T) {
+	c := vppmock.SetupTestCtx(t)
+	vpp.ClearRegisteredHandlers()
+
+	handler := vpp.RegisterHandler(vpp.HandlerDesc{
+		Name:       "handlerA",
+		HandlerAPI: (*testHandlerAPI)(nil),
+	})
+	// all messages of the VPP plugin are unknown
+	client := &incompatibleClient{
+		Client:       c.MockVPPClient,
+		preferred:    "19.08-a",
+		incompatible: map[govppapi.Message]bool{msgA: true, msgB: true, msgC: true},
+	}
+	addCheckedVersion(handler, "19.08-a", msgA, msgB, msgC)
+	addCheckedVersion(handler, "19.08-b", msgA, msgC)
+
+	_, err := handler.GetCompatibleVersion(client)
+	Expect(err).To(Equal(vpp.ErrIncompatible))
+	Expect(handler.FindCompatibleVersion(client)).To(BeNil())
+
+	// messages checked via channel are counted as well
+	addChannelVersion := func(version vpp.Version, msgs ...govppapi.Message) {
+		handler.AddVersion(vpp.HandlerVersion{
+			Version: version,
+			Check: func(client vpp.Client) error {
+				ch, err := client.NewAPIChannel()
+				if err != nil {
+					return err
+				}
+				return ch.CheckCompatiblity(msgs...)
+			},
+		})
+	}
+	addChannelVersion("19.08-c", msgA, msgB)
+	_, err = handler.GetCompatibleVersion(client)
+	Expect(err).To(Equal(vpp.ErrIncompatible))
+
+	addChannelVersion("19.08-d", msgA, &vpp_wg.WireguardPeerRemove{})
+	ver, err := handler.GetCompatibleVersion(client)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(ver.Version).To(BeEquivalentTo("19.08-d"))
+}
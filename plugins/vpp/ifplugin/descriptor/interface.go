@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"hash/fnv"
 	"net"
+	"regexp"
 	"strings"
 
 	"github.com/golang/protobuf/proto"
@@ -54,10 +55,15 @@ const (
 	vxlanGpeVrfTableDep      = "vrf-table-for-vxlan-gpe-exists"
 	gtpuMulticastDep         = "gtpu-multicast-interface-exists"
 	gtpuVrfTableDep          = "vrf-table-for-gtpu-exists"
+	geneveMulticastDep       = "geneve-multicast-interface-exists"
+	geneveVrfTableDep        = "vrf-table-for-geneve-exists"
+	vxlanGbpMulticastDep     = "vxlan-gbp-multicast-interface-exists"
+	vxlanGbpVrfTableDep      = "vrf-table-for-vxlan-gbp-exists"
 	ipipVrfTableDep          = "vrf-table-for-ipip-exists"
 	microserviceDep          = "microservice-available"
 	parentInterfaceDep       = "parent-interface-exists"
 	rdmaHostInterfaceDep     = "rdma-host-interface-exists"
+	afXdpHostInterfaceDep    = "afxdp-host-interface-exists"
 
 	// how many characters a logical interface name is allowed to have
 	//  - determined by much fits into the VPP interface tag (64 null-terminated character string)
@@ -67,8 +73,8 @@ const (
 	// not found by Retrieve of Linux-ifplugin
 	tapMissingLinuxSideSuffix = "-MISSING_LINUX_SIDE"
 
-	// suffix attached to logical names of dumped AF-PACKET interfaces connected
-	// to missing Linux interfaces
+	// suffix attached to logical names of dumped AF-PACKET and AF_XDP interfaces
+	// connected to missing Linux interfaces
 	afPacketMissingAttachedIfSuffix = "-MISSING_ATTACHED_INTERFACE"
 
 	// default memif attributes
@@ -108,6 +114,10 @@ var (
 	ErrAfPacketWithoutTarget = errors.New(
 		"VPP AF-Packet interface was defined without reference to the target linux interface")
 
+	// ErrAfXdpWithoutTarget is returned when AF_XDP configuration is missing reference to the target linux interface.
+	ErrAfXdpWithoutTarget = errors.New(
+		"VPP AF_XDP interface was defined without reference to the target linux interface")
+
 	// ErrInterfaceLinkMismatch is returned when interface type does not match the link configuration.
 	ErrInterfaceLinkMismatch = errors.New("VPP interface type and link configuration do not match")
 
@@ -162,6 +172,36 @@ var (
 	// ErrGtpuDstAddrBad is returned when destination address was not set to valid IP address.
 	ErrGtpuDstAddrBad = errors.Errorf("bad destination address for GTPU tunnel")
 
+	// ErrGeneveSrcAddrMissing is returned when source address was not set or set to an empty string.
+	ErrGeneveSrcAddrMissing = errors.Errorf("missing source address for GENEVE tunnel")
+
+	// ErrGeneveDstAddrMissing is returned when destination address was not set or set to an empty string.
+	ErrGeneveDstAddrMissing = errors.Errorf("missing destination address for GENEVE tunnel")
+
+	// ErrGeneveSrcAddrBad is returned when source address was not set to valid IP address.
+	ErrGeneveSrcAddrBad = errors.Errorf("bad source address for GENEVE tunnel")
+
+	// ErrGeneveDstAddrBad is returned when destination address was not set to valid IP address.
+	ErrGeneveDstAddrBad = errors.Errorf("bad destination address for GENEVE tunnel")
+
+	// ErrGeneveMulticastIntfMissing is returned when interface for multicast was not specified.
+	ErrGeneveMulticastIntfMissing = errors.Errorf("missing multicast interface name for GENEVE tunnel")
+
+	// ErrVxlanGbpSrcAddrMissing is returned when source address was not set or set to an empty string.
+	ErrVxlanGbpSrcAddrMissing = errors.Errorf("missing source address for VXLAN-GBP tunnel")
+
+	// ErrVxlanGbpDstAddrMissing is returned when destination address was not set or set to an empty string.
+	ErrVxlanGbpDstAddrMissing = errors.Errorf("missing destination address for VXLAN-GBP tunnel")
+
+	// ErrVxlanGbpSrcAddrBad is returned when source address was not set to valid IP address.
+	ErrVxlanGbpSrcAddrBad = errors.Errorf("bad source address for VXLAN-GBP tunnel")
+
+	// ErrVxlanGbpDstAddrBad is returned when destination address was not set to valid IP address.
+	ErrVxlanGbpDstAddrBad = errors.Errorf("bad destination address for VXLAN-GBP tunnel")
+
+	// ErrVxlanGbpMulticastIntfMissing is returned when interface for multicast was not specified.
+	ErrVxlanGbpMulticastIntfMissing = errors.Errorf("missing multicast interface name for VXLAN-GBP tunnel")
+
 	// ErrIpipSrcAddrMissing is returned when source address was not set or set to an empty string.
 	ErrIpipSrcAddrMissing = errors.Errorf("missing source address for IPIP tunnel")
 
@@ -197,8 +237,20 @@ var (
 
 	// ErrRdmaQueueNumTooLarge is returned when the number of configured Rx/Tx queues for RDMA driver exceeds the limit.
 	ErrRdmaQueueNumTooLarge =  errors.Errorf("Number of RDMA queues is too large (more than 16bits)")
+
+	// ErrVhostUserSocketMissing is returned when socket filename is not configured for vhost-user link.
+	ErrVhostUserSocketMissing = errors.Errorf("missing socket filename for vhost-user interface")
+
+	// ErrVirtioPCIAddressMissing is returned when PCI address is not configured for virtio-pci link.
+	ErrVirtioPCIAddressMissing = errors.Errorf("missing PCI address for virtio-pci interface")
+
+	// ErrVirtioPCIAddressInvalid is returned when configured PCI address is not in the dddd:bb:ss.f format.
+	ErrVirtioPCIAddressInvalid = errors.Errorf("invalid PCI address for virtio-pci interface (expected dddd:bb:ss.f)")
 )
 
+// pciAddressRegexp matches PCI address in the dddd:bb:ss.f format.
+var pciAddressRegexp = regexp.MustCompile(`^[0-9a-fA-F]{4}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}\.[0-7]$`)
+
 // InterfaceDescriptor teaches KVScheduler how to configure VPP interfaces.
 type InterfaceDescriptor struct {
 	// config
@@ -320,8 +372,9 @@ func (d *InterfaceDescriptor) EquivalentInterfaces(key string, oldIntf, newIntf
 		}
 	}
 
-	// handle default/unspecified MTU (except VxLAN and IPSec tunnel)
-	if newIntf.Type != interfaces.Interface_VXLAN_TUNNEL && newIntf.Type != interfaces.Interface_IPSEC_TUNNEL {
+	// handle default/unspecified MTU (except VxLAN, GENEVE, VXLAN-GBP and IPSec tunnel)
+	if newIntf.Type != interfaces.Interface_VXLAN_TUNNEL && newIntf.Type != interfaces.Interface_IPSEC_TUNNEL &&
+		newIntf.Type != interfaces.Interface_GENEVE_TUNNEL && newIntf.Type != interfaces.Interface_VXLAN_GBP_TUNNEL {
 		if d.getInterfaceMTU(newIntf) != 0 && d.getInterfaceMTU(oldIntf) != d.getInterfaceMTU(newIntf) {
 			return false
 		}
@@ -397,6 +450,26 @@ func (d *InterfaceDescriptor) equivalentTypeSpecificConfig(oldIntf, newIntf *int
 		if !d.equivalentRdma(oldIntf.GetRdma(), newIntf.GetRdma()) {
 			return false
 		}
+	case interfaces.Interface_VHOST_USER:
+		if !proto.Equal(oldIntf.GetVhostUser(), newIntf.GetVhostUser()) {
+			return false
+		}
+	case interfaces.Interface_VIRTIO_PCI:
+		if !d.equivalentVirtioPCI(oldIntf.GetVirtioPci(), newIntf.GetVirtioPci()) {
+			return false
+		}
+	case interfaces.Interface_AF_XDP:
+		if !proto.Equal(oldIntf.GetAfXdp(), newIntf.GetAfXdp()) {
+			return false
+		}
+	case interfaces.Interface_GENEVE_TUNNEL:
+		if !proto.Equal(oldIntf.GetGeneve(), newIntf.GetGeneve()) {
+			return false
+		}
+	case interfaces.Interface_VXLAN_GBP_TUNNEL:
+		if !proto.Equal(oldIntf.GetVxlanGbp(), newIntf.GetVxlanGbp()) {
+			return false
+		}
 	}
 	return true
 }
@@ -465,6 +538,15 @@ func (d *InterfaceDescriptor) equivalentBond(oldBond, newBond *interfaces.BondLi
 		oldBond.Lb == newBond.Lb
 }
 
+// equivalentVirtioPCI compares two virtio-pci interfaces for equivalence.
+func (d *InterfaceDescriptor) equivalentVirtioPCI(oldVirtio, newVirtio *interfaces.VirtioPCILink) bool {
+	return strings.EqualFold(oldVirtio.GetPciAddress(), newVirtio.GetPciAddress()) &&
+		oldVirtio.GetFeatures() == newVirtio.GetFeatures() &&
+		oldVirtio.GetEnableGso() == newVirtio.GetEnableGso() &&
+		oldVirtio.GetEnableChecksumOffload() == newVirtio.GetEnableChecksumOffload() &&
+		oldVirtio.GetEnablePacked() == newVirtio.GetEnablePacked()
+}
+
 // equivalentRdma compares two RDMA interfaces for equivalence.
 func (d *InterfaceDescriptor) equivalentRdma(oldRdma, newRdma *interfaces.RDMALink) bool {
 	return oldRdma.GetHostIfName() == newRdma.GetHostIfName() &&
@@ -548,6 +630,26 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if intf.Type != interfaces.Interface_RDMA {
 			return linkMismatchErr
 		}
+	case *interfaces.Interface_VhostUser:
+		if intf.Type != interfaces.Interface_VHOST_USER {
+			return linkMismatchErr
+		}
+	case *interfaces.Interface_VirtioPci:
+		if intf.Type != interfaces.Interface_VIRTIO_PCI {
+			return linkMismatchErr
+		}
+	case *interfaces.Interface_AfXdp:
+		if intf.Type != interfaces.Interface_AF_XDP {
+			return linkMismatchErr
+		}
+	case *interfaces.Interface_Geneve:
+		if intf.Type != interfaces.Interface_GENEVE_TUNNEL {
+			return linkMismatchErr
+		}
+	case *interfaces.Interface_VxlanGbp:
+		if intf.Type != interfaces.Interface_VXLAN_GBP_TUNNEL {
+			return linkMismatchErr
+		}
 	case nil:
 		if intf.Type != interfaces.Interface_SOFTWARE_LOOPBACK &&
 			intf.Type != interfaces.Interface_DPDK {
@@ -571,6 +673,12 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 			return kvs.NewInvalidValueError(ErrAfPacketWithoutTarget,
 				"link.afpacket.host_if_name", "link.afpacket.linux_interface")
 		}
+	case interfaces.Interface_AF_XDP:
+		if intf.GetAfXdp().GetHostIfName() == "" &&
+			intf.GetAfXdp().GetLinuxInterface() == "" {
+			return kvs.NewInvalidValueError(ErrAfXdpWithoutTarget,
+				"link.af_xdp.host_if_name", "link.af_xdp.linux_interface")
+		}
 	case interfaces.Interface_BOND_INTERFACE:
 		if name, ok := d.bondIDs[intf.GetBond().GetId()]; ok && name != intf.GetName() {
 			return kvs.NewInvalidValueError(ErrBondInterfaceIDExists, "link.bond.id")
@@ -629,6 +737,44 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 		if net.ParseIP(intf.GetGtpu().DstAddr) == nil {
 			return kvs.NewInvalidValueError(ErrGtpuDstAddrBad, "link.gtpu.dst_addr")
 		}
+	case interfaces.Interface_GENEVE_TUNNEL:
+		if intf.GetGeneve().SrcAddress == "" {
+			return kvs.NewInvalidValueError(ErrGeneveSrcAddrMissing, "link.geneve.src_address")
+		}
+		if net.ParseIP(intf.GetGeneve().SrcAddress) == nil {
+			return kvs.NewInvalidValueError(ErrGeneveSrcAddrBad, "link.geneve.src_address")
+		}
+
+		if intf.GetGeneve().DstAddress == "" {
+			return kvs.NewInvalidValueError(ErrGeneveDstAddrMissing, "link.geneve.dst_address")
+		}
+		if dst := net.ParseIP(intf.GetGeneve().DstAddress); dst != nil {
+			// if destination address is multicast then `Multicast` field must contain interface name.
+			if dst.IsMulticast() && intf.GetGeneve().Multicast == "" {
+				return kvs.NewInvalidValueError(ErrGeneveMulticastIntfMissing, "link.geneve.multicast")
+			}
+		} else {
+			return kvs.NewInvalidValueError(ErrGeneveDstAddrBad, "link.geneve.dst_address")
+		}
+	case interfaces.Interface_VXLAN_GBP_TUNNEL:
+		if intf.GetVxlanGbp().SrcAddress == "" {
+			return kvs.NewInvalidValueError(ErrVxlanGbpSrcAddrMissing, "link.vxlan_gbp.src_address")
+		}
+		if net.ParseIP(intf.GetVxlanGbp().SrcAddress) == nil {
+			return kvs.NewInvalidValueError(ErrVxlanGbpSrcAddrBad, "link.vxlan_gbp.src_address")
+		}
+
+		if intf.GetVxlanGbp().DstAddress == "" {
+			return kvs.NewInvalidValueError(ErrVxlanGbpDstAddrMissing, "link.vxlan_gbp.dst_address")
+		}
+		if dst := net.ParseIP(intf.GetVxlanGbp().DstAddress); dst != nil {
+			// if destination address is multicast then `Multicast` field must contain interface name.
+			if dst.IsMulticast() && intf.GetVxlanGbp().Multicast == "" {
+				return kvs.NewInvalidValueError(ErrVxlanGbpMulticastIntfMissing, "link.vxlan_gbp.multicast")
+			}
+		} else {
+			return kvs.NewInvalidValueError(ErrVxlanGbpDstAddrBad, "link.vxlan_gbp.dst_address")
+		}
 	case interfaces.Interface_IPIP_TUNNEL:
 		if intf.GetIpip().SrcAddr == "" {
 			return kvs.NewInvalidValueError(ErrIpipSrcAddrMissing, "link.ipip.src_addr")
@@ -683,6 +829,17 @@ func (d *InterfaceDescriptor) Validate(key string, intf *interfaces.Interface) e
 				return kvs.NewInvalidValueError(ErrRdmaQueueSizeTooLarge, "link.rdma.txq_size")
 			}
 		}
+	case interfaces.Interface_VHOST_USER:
+		if intf.GetVhostUser().GetSocketFilename() == "" {
+			return kvs.NewInvalidValueError(ErrVhostUserSocketMissing, "link.vhost_user.socket_filename")
+		}
+	case interfaces.Interface_VIRTIO_PCI:
+		if intf.GetVirtioPci().GetPciAddress() == "" {
+			return kvs.NewInvalidValueError(ErrVirtioPCIAddressMissing, "link.virtio_pci.pci_address")
+		}
+		if !pciAddressRegexp.MatchString(intf.GetVirtioPci().GetPciAddress()) {
+			return kvs.NewInvalidValueError(ErrVirtioPCIAddressInvalid, "link.virtio_pci.pci_address")
+		}
 	}
 
 	// validate unnumbered
@@ -719,9 +876,11 @@ func (d *InterfaceDescriptor) UpdateWithRecreate(key string, oldIntf, newIntf *i
 
 	if (oldIntf.GetType() == interfaces.Interface_VXLAN_TUNNEL ||
 		oldIntf.GetType() == interfaces.Interface_GTPU_TUNNEL ||
-		oldIntf.GetType() == interfaces.Interface_IPIP_TUNNEL) &&
+		oldIntf.GetType() == interfaces.Interface_IPIP_TUNNEL ||
+		oldIntf.GetType() == interfaces.Interface_GENEVE_TUNNEL ||
+		oldIntf.GetType() == interfaces.Interface_VXLAN_GBP_TUNNEL) &&
 		oldIntf.Vrf != newIntf.Vrf {
-		// for VXLAN, GTPU and IPIP interfaces a change in the VRF assignment requires full re-creation
+		// for VXLAN, GTPU, IPIP, GENEVE and VXLAN-GBP interfaces a change in the VRF assignment requires full re-creation
 		return true
 	}
 
@@ -749,6 +908,19 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 				Key:   linux_intf.InterfaceHostNameKey(intf.GetAfpacket().GetHostIfName()),
 			})
 		}
+	case interfaces.Interface_AF_XDP:
+		// AF_XDP depends on a referenced Linux interface in the default namespace
+		if intf.GetAfXdp().GetLinuxInterface() != "" {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: afXdpHostInterfaceDep,
+				Key:   linux_intf.InterfaceKey(intf.GetAfXdp().GetLinuxInterface()),
+			})
+		} else if intf.GetAfXdp().GetHostIfName() != "" {
+			dependencies = append(dependencies, kvs.Dependency{
+				Label: afXdpHostInterfaceDep,
+				Key:   linux_intf.InterfaceHostNameKey(intf.GetAfXdp().GetHostIfName()),
+			})
+		}
 	case interfaces.Interface_TAP:
 		// TAP connects VPP with microservice
 		if toMicroservice := intf.GetTap().GetToMicroservice(); toMicroservice != "" {
@@ -760,36 +932,13 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 	case interfaces.Interface_VXLAN_TUNNEL:
 		// VXLAN referencing an interface with Multicast IP address
 		if vxlanMulticast := intf.GetVxlan().GetMulticast(); vxlanMulticast != "" {
-			dependencies = append(dependencies, kvs.Dependency{
-				Label: vxlanMulticastDep,
-				AnyOf: kvs.AnyOfDependency{
-					KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(vxlanMulticast)},
-					KeySelector: func(key string) bool {
-						_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
-						if source != netalloc_api.IPAddressSource_ALLOC_REF {
-							ip, _, err := net.ParseCIDR(ifaceAddr)
-							return err == nil && ip.IsMulticast()
-						}
-						// TODO: handle the case when multicast IP address is allocated
-						// via netalloc (too specific to bother until really needed)
-						return false
-					},
-				},
-			})
+			dependencies = append(dependencies, multicastInterfaceDependency(vxlanMulticastDep, vxlanMulticast))
 		}
 		if intf.GetVrf() != 0 {
 			// binary API for creating VXLAN tunnel requires the VRF table
 			// to be already created
-			var protocol l3.VrfTable_Protocol
-			srcAddr := net.ParseIP(intf.GetVxlan().GetSrcAddress()).To4()
-			dstAddr := net.ParseIP(intf.GetVxlan().GetDstAddress()).To4()
-			if srcAddr == nil && dstAddr == nil {
-				protocol = l3.VrfTable_IPV6
-			}
-			dependencies = append(dependencies, kvs.Dependency{
-				Label: vxlanVrfTableDep,
-				Key:   l3.VrfTableKey(intf.GetVrf(), protocol),
-			})
+			dependencies = append(dependencies, tunnelVrfTableDependency(vxlanVrfTableDep, intf.GetVrf(),
+				intf.GetVxlan().GetSrcAddress(), intf.GetVxlan().GetDstAddress()))
 		}
 
 		if gpe := intf.GetVxlan().Gpe; gpe != nil {
@@ -808,36 +957,37 @@ func (d *InterfaceDescriptor) Dependencies(key string, intf *interfaces.Interfac
 	case interfaces.Interface_GTPU_TUNNEL:
 		// GTPU referencing an interface with Multicast IP address
 		if gtpuMulticast := intf.GetGtpu().GetMulticast(); gtpuMulticast != "" {
-			dependencies = append(dependencies, kvs.Dependency{
-				Label: gtpuMulticastDep,
-				AnyOf: kvs.AnyOfDependency{
-					KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(gtpuMulticast)},
-					KeySelector: func(key string) bool {
-						_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
-						if source != netalloc_api.IPAddressSource_ALLOC_REF {
-							ip, _, err := net.ParseCIDR(ifaceAddr)
-							return err == nil && ip.IsMulticast()
-						}
-						// TODO: handle the case when multicast IP address is allocated
-						// via netalloc (too specific to bother until really needed)
-						return false
-					},
-				},
-			})
+			dependencies = append(dependencies, multicastInterfaceDependency(gtpuMulticastDep, gtpuMulticast))
 		}
 		if intf.GetGtpu().GetEncapVrfId() != 0 {
 			// binary API for creating GTPU tunnel requires the VRF table
 			// to be already created
-			var protocol l3.VrfTable_Protocol
-			srcAddr := net.ParseIP(intf.GetGtpu().GetSrcAddr()).To4()
-			dstAddr := net.ParseIP(intf.GetGtpu().GetDstAddr()).To4()
-			if srcAddr == nil && dstAddr == nil {
-				protocol = l3.VrfTable_IPV6
-			}
-			dependencies = append(dependencies, kvs.Dependency{
-				Label: gtpuVrfTableDep,
-				Key:   l3.VrfTableKey(intf.GetGtpu().GetEncapVrfId(), protocol),
-			})
+			dependencies = append(dependencies, tunnelVrfTableDependency(gtpuVrfTableDep, intf.GetGtpu().GetEncapVrfId(),
+				intf.GetGtpu().GetSrcAddr(), intf.GetGtpu().GetDstAddr()))
+		}
+
+	case interfaces.Interface_GENEVE_TUNNEL:
+		// GENEVE referencing an interface with Multicast IP address
+		if geneveMulticast := intf.GetGeneve().GetMulticast(); geneveMulticast != "" {
+			dependencies = append(dependencies, multicastInterfaceDependency(geneveMulticastDep, geneveMulticast))
+		}
+		if intf.GetVrf() != 0 {
+			// binary API for creating GENEVE tunnel requires the VRF table
+			// to be already created
+			dependencies = append(dependencies, tunnelVrfTableDependency(geneveVrfTableDep, intf.GetVrf(),
+				intf.GetGeneve().GetSrcAddress(), intf.GetGeneve().GetDstAddress()))
+		}
+
+	case interfaces.Interface_VXLAN_GBP_TUNNEL:
+		// VXLAN-GBP referencing an interface with Multicast IP address
+		if vxlanGbpMulticast := intf.GetVxlanGbp().GetMulticast(); vxlanGbpMulticast != "" {
+			dependencies = append(dependencies, multicastInterfaceDependency(vxlanGbpMulticastDep, vxlanGbpMulticast))
+		}
+		if intf.GetVrf() != 0 {
+			// binary API for creating VXLAN-GBP tunnel requires the VRF table
+			// to be already created
+			dependencies = append(dependencies, tunnelVrfTableDependency(vxlanGbpVrfTableDep, intf.GetVrf(),
+				intf.GetVxlanGbp().GetSrcAddress(), intf.GetVxlanGbp().GetDstAddress()))
 		}
 
 	case interfaces.Interface_IPIP_TUNNEL:
@@ -955,6 +1105,22 @@ func (d *InterfaceDescriptor) DerivedValues(key string, intf *interfaces.Interfa
 			} else {
 				hasIPv4 = true
 			}
+		case interfaces.Interface_GENEVE_TUNNEL:
+			srcAddr := net.ParseIP(intf.GetGeneve().GetSrcAddress()).To4()
+			dstAddr := net.ParseIP(intf.GetGeneve().GetDstAddress()).To4()
+			if srcAddr == nil && dstAddr == nil {
+				hasIPv6 = true
+			} else {
+				hasIPv4 = true
+			}
+		case interfaces.Interface_VXLAN_GBP_TUNNEL:
+			srcAddr := net.ParseIP(intf.GetVxlanGbp().GetSrcAddress()).To4()
+			dstAddr := net.ParseIP(intf.GetVxlanGbp().GetDstAddress()).To4()
+			if srcAddr == nil && dstAddr == nil {
+				hasIPv6 = true
+			} else {
+				hasIPv4 = true
+			}
 		case interfaces.Interface_IPIP_TUNNEL:
 			srcAddr := net.ParseIP(intf.GetIpip().GetSrcAddr()).To4()
 			dstAddr := net.ParseIP(intf.GetIpip().GetDstAddr()).To4()
@@ -1033,6 +1199,23 @@ func (d *InterfaceDescriptor) getAfPacketTargetHostIfName(afpacket *interfaces.A
 	return linuxIfMeta.HostIfName, nil
 }
 
+// getAfXdpTargetHostIfName returns the host name of the interface to which the given AF_XDP
+// interface should bind to.
+func (d *InterfaceDescriptor) getAfXdpTargetHostIfName(afXdp *interfaces.AfXdpLink) (string, error) {
+	if afXdp.GetLinuxInterface() == "" {
+		return afXdp.GetHostIfName(), nil
+	}
+	if d.linuxIfPlugin == nil {
+		return "", errors.New("linux ifplugin dependency is needed for AF_XDP interface")
+	}
+	linuxIfIdx := d.linuxIfPlugin.GetInterfaceIndex()
+	linuxIfMeta, exists := linuxIfIdx.LookupByName(afXdp.GetLinuxInterface())
+	if !exists {
+		return "", errors.Errorf("failed to find linux interface %s", afXdp.GetLinuxInterface())
+	}
+	return linuxIfMeta.HostIfName, nil
+}
+
 // resolveMemifSocketFilename returns memif socket filename ID.
 // Registers it if does not exists yet.
 func (d *InterfaceDescriptor) resolveMemifSocketFilename(memifIf *interfaces.MemifLink) (uint32, error) {
@@ -1164,6 +1347,41 @@ func equalStringSets(set1, set2 []string) bool {
 	return true
 }
 
+// multicastInterfaceDependency returns dependency of a tunnel on the given interface
+// having a multicast IP address assigned.
+func multicastInterfaceDependency(label, multicastIf string) kvs.Dependency {
+	return kvs.Dependency{
+		Label: label,
+		AnyOf: kvs.AnyOfDependency{
+			KeyPrefixes: []string{interfaces.InterfaceAddressPrefix(multicastIf)},
+			KeySelector: func(key string) bool {
+				_, ifaceAddr, source, _, _ := interfaces.ParseInterfaceAddressKey(key)
+				if source != netalloc_api.IPAddressSource_ALLOC_REF {
+					ip, _, err := net.ParseCIDR(ifaceAddr)
+					return err == nil && ip.IsMulticast()
+				}
+				// TODO: handle the case when multicast IP address is allocated
+				// via netalloc (too specific to bother until really needed)
+				return false
+			},
+		},
+	}
+}
+
+// tunnelVrfTableDependency returns dependency of a tunnel on the VRF table used
+// to send the encapsulated packets. The IPv6 table is selected when neither
+// of the tunnel endpoints is an IPv4 address.
+func tunnelVrfTableDependency(label string, vrf uint32, srcAddr, dstAddr string) kvs.Dependency {
+	var protocol l3.VrfTable_Protocol
+	if net.ParseIP(srcAddr).To4() == nil && net.ParseIP(dstAddr).To4() == nil {
+		protocol = l3.VrfTable_IPV6
+	}
+	return kvs.Dependency{
+		Label: label,
+		Key:   l3.VrfTableKey(vrf, protocol),
+	}
+}
+
 // getIPAddressVersions returns two flags to tell whether the provided list of addresses
 // contains IPv4 and/or IPv6 type addresses
 func getIPAddressVersions(ipAddrs []string) (hasIPv4, hasIPv6 bool) {
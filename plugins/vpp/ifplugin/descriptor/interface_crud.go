@@ -3,6 +3,7 @@ package descriptor
 import (
 	"context"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
@@ -123,6 +124,17 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			d.log.Error(err)
 			return nil, err
 		}
+	case interfaces.Interface_AF_XDP:
+		targetHostIfName, err := d.getAfXdpTargetHostIfName(intf.GetAfXdp())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+		ifIdx, err = d.ifHandler.AddAfXdpInterface(ctx, intf.Name, targetHostIfName, intf.GetAfXdp())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
 	case interfaces.Interface_IPSEC_TUNNEL:
 		ifIdx, err = d.ifHandler.AddIPSecTunnelInterface(ctx, intf.Name, intf.GetIpsec())
 		if err != nil {
@@ -211,10 +223,71 @@ func (d *InterfaceDescriptor) Create(key string, intf *interfaces.Interface) (me
 			d.log.Error(err)
 			return nil, err
 		}
+
+	case interfaces.Interface_VHOST_USER:
+		ifIdx, err = d.ifHandler.AddVhostUserInterface(ctx, intf.Name, intf.GetVhostUser())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+
+	case interfaces.Interface_VIRTIO_PCI:
+		ifIdx, err = d.ifHandler.AddVirtioPCIInterface(ctx, intf.Name, intf.GetVirtioPci())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+
+	case interfaces.Interface_GENEVE_TUNNEL:
+		var multicastIfIdx uint32
+		multicastIf := intf.GetGeneve().GetMulticast()
+		if multicastIf != "" {
+			multicastMeta, found := d.intfIndex.LookupByName(multicastIf)
+			if !found {
+				err = errors.Errorf("failed to find multicast interface %s referenced by GENEVE %s",
+					multicastIf, intf.Name)
+				d.log.Error(err)
+				return nil, err
+			}
+			multicastIfIdx = multicastMeta.SwIfIndex
+		} else {
+			// not a multicast tunnel
+			multicastIfIdx = 0xFFFFFFFF
+		}
+
+		ifIdx, err = d.ifHandler.AddGeneveTunnel(ctx, intf.Name, intf.GetVrf(), multicastIfIdx, intf.GetGeneve())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
+
+	case interfaces.Interface_VXLAN_GBP_TUNNEL:
+		var multicastIfIdx uint32
+		multicastIf := intf.GetVxlanGbp().GetMulticast()
+		if multicastIf != "" {
+			multicastMeta, found := d.intfIndex.LookupByName(multicastIf)
+			if !found {
+				err = errors.Errorf("failed to find multicast interface %s referenced by VXLAN-GBP %s",
+					multicastIf, intf.Name)
+				d.log.Error(err)
+				return nil, err
+			}
+			multicastIfIdx = multicastMeta.SwIfIndex
+		} else {
+			// not a multicast tunnel
+			multicastIfIdx = 0xFFFFFFFF
+		}
+
+		ifIdx, err = d.ifHandler.AddVxlanGbpTunnel(ctx, intf.Name, intf.GetVrf(), multicastIfIdx, intf.GetVxlanGbp())
+		if err != nil {
+			d.log.Error(err)
+			return nil, err
+		}
 	}
 
 	// MAC address. Note: physical interfaces cannot have the MAC address changed. The bond interface uses its own
-	// binary API call to set MAC address.
+	// binary API call to set MAC address. AF_XDP is created with the MAC address of the host interface, which
+	// is then changed like for other interfaces.
 	if intf.GetPhysAddress() != "" &&
 		intf.GetType() != interfaces.Interface_AF_PACKET &&
 		intf.GetType() != interfaces.Interface_DPDK &&
@@ -328,6 +401,16 @@ func (d *InterfaceDescriptor) Delete(key string, intf *interfaces.Interface, met
 		err = d.ifHandler.DelIpipTunnel(intf.Name, ifIdx)
 	case interfaces.Interface_RDMA:
 		err = d.ifHandler.DeleteRdmaInterface(ctx, intf.Name, ifIdx)
+	case interfaces.Interface_VHOST_USER:
+		err = d.ifHandler.DeleteVhostUserInterface(ctx, intf.Name, ifIdx)
+	case interfaces.Interface_VIRTIO_PCI:
+		err = d.ifHandler.DeleteVirtioPCIInterface(ctx, intf.Name, ifIdx)
+	case interfaces.Interface_AF_XDP:
+		err = d.ifHandler.DeleteAfXdpInterface(ctx, intf.Name, ifIdx)
+	case interfaces.Interface_GENEVE_TUNNEL:
+		err = d.ifHandler.DeleteGeneveTunnel(ctx, intf.Name, ifIdx, intf.GetVrf(), intf.GetGeneve())
+	case interfaces.Interface_VXLAN_GBP_TUNNEL:
+		err = d.ifHandler.DeleteVxlanGbpTunnel(ctx, intf.Name, ifIdx, intf.GetVrf(), intf.GetVxlanGbp())
 	}
 	if err != nil {
 		err = errors.Errorf("failed to remove interface %s, index %d: %v", intf.Name, ifIdx, err)
@@ -517,6 +600,29 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 					intf.Interface.GetMemif().BufferSize = expCfg.GetMemif().GetBufferSize()
 				}
 			}
+			if expCfg.Type == interfaces.Interface_VHOST_USER && intf.Interface.GetVhostUser() != nil {
+				intf.Interface.GetVhostUser().DisableMrgRxbuf = expCfg.GetVhostUser().GetDisableMrgRxbuf()
+				intf.Interface.GetVhostUser().DisableIndirectDesc = expCfg.GetVhostUser().GetDisableIndirectDesc()
+				intf.Interface.GetVhostUser().EnableGso = expCfg.GetVhostUser().GetEnableGso()
+				intf.Interface.GetVhostUser().EnablePacked = expCfg.GetVhostUser().GetEnablePacked()
+			}
+			if expCfg.Type == interfaces.Interface_VIRTIO_PCI && intf.Interface.GetVirtioPci() != nil {
+				// features requested at creation time differ from those negotiated with the device
+				intf.Interface.GetVirtioPci().Features = expCfg.GetVirtioPci().GetFeatures()
+				intf.Interface.GetVirtioPci().EnableGso = expCfg.GetVirtioPci().GetEnableGso()
+				intf.Interface.GetVirtioPci().EnableChecksumOffload = expCfg.GetVirtioPci().GetEnableChecksumOffload()
+				intf.Interface.GetVirtioPci().EnablePacked = expCfg.GetVirtioPci().GetEnablePacked()
+			}
+			if expCfg.Type == interfaces.Interface_AF_XDP && intf.Interface.Type == interfaces.Interface_AF_XDP {
+				// AF_XDP cannot be dumped, the link is taken over from the configuration
+				intf.Interface.Link = &interfaces.Interface_AfXdp{
+					AfXdp: proto.Clone(expCfg.GetAfXdp()).(*interfaces.AfXdpLink),
+				}
+			}
+			if expCfg.Type == interfaces.Interface_GENEVE_TUNNEL && intf.Interface.GetGeneve() != nil {
+				// L3 mode is not included in the GENEVE tunnel dump
+				intf.Interface.GetGeneve().L3Mode = expCfg.GetGeneve().GetL3Mode()
+			}
 			//nolint:staticcheck
 			if expCfg.Type == interfaces.Interface_AF_PACKET && intf.Interface.GetAfpacket() != nil {
 				hostIfName, err := d.getAfPacketTargetHostIfName(expCfg.GetAfpacket())
@@ -571,6 +677,18 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 					intf.Interface.Name += afPacketMissingAttachedIfSuffix
 				}
 			}
+			if intf.Interface.Type == interfaces.Interface_AF_XDP {
+				var exists bool
+				hostIfName, err := d.getAfXdpTargetHostIfName(intf.Interface.GetAfXdp())
+				if err == nil && hostIfName != "" {
+					exists, _ = d.linuxIfHandler.InterfaceExists(hostIfName)
+				}
+				if err != nil || !exists {
+					// the Linux interface that the AF_XDP is attached to does not exist
+					// or the interface is not known by the configuration
+					intf.Interface.Name += afPacketMissingAttachedIfSuffix
+				}
+			}
 			if intf.Interface.Type == interfaces.Interface_TAP {
 				exists, _ := d.linuxIfHandler.InterfaceExists(tapHostIfName)
 				if !exists {
@@ -610,6 +728,8 @@ func (d *InterfaceDescriptor) Retrieve(correlate []adapter.InterfaceKVWithMetada
 func ifaceSupportsSetMTU(intf *interfaces.Interface) bool {
 	switch intf.Type {
 	case interfaces.Interface_VXLAN_TUNNEL,
+		interfaces.Interface_GENEVE_TUNNEL,
+		interfaces.Interface_VXLAN_GBP_TUNNEL,
 		interfaces.Interface_IPSEC_TUNNEL,
 		interfaces.Interface_WIREGUARD_TUNNEL,
 		interfaces.Interface_SUB_INTERFACE:
@@ -90,7 +90,9 @@ func (c *InterfaceStateUpdater) Init(
 	c.lastIfCounters = make(map[uint32]govppapi.InterfaceCounters)
 
 	// Init handlers
-	c.ifHandler = vppcalls.CompatibleInterfaceVppHandler(c.vppClient, logger.NewLogger("if-handler"))
+	// requests polling interface state are not attributed to descriptors
+	c.ifHandler = vppcalls.CompatibleInterfaceVppHandler(vpp.WithCaller(c.vppClient, "if-state"),
+		logger.NewLogger("if-handler"))
 
 	c.ifMetaChan = make(chan ifaceidx.IfaceMetadataDto, 1000)
 	swIfIndexes.WatchInterfaces("ifplugin_ifstate", c.ifMetaChan)
@@ -267,6 +269,11 @@ func (c *InterfaceStateUpdater) doUpdatesIfStateDetails() {
 		c.log.Warnf("dumping interface states failed: %v", err)
 		return
 	}
+	// type-specific state is dumped only for the updated interfaces
+	// and kept in the interface state until the next update
+	if err = c.ifHandler.DumpTypeSpecificStates(c.ctx, ifaces); err != nil {
+		c.log.Warnf("dumping type-specific interface states failed: %v", err)
+	}
 
 	c.access.Lock()
 	for _, ifaceDetails := range ifaces {
@@ -350,6 +357,12 @@ func (c *InterfaceStateUpdater) processIfStateEvent(notif *vppcalls.InterfaceEve
 		return
 	}
 
+	// link of vhost-user and virtio-pci interfaces changes with the run-time state
+	// (e.g. VM connected to vhost-user socket), refresh it with the next update
+	if !notif.Deleted && (ifState.VhostUser != nil || ifState.VirtioPci != nil) {
+		c.ifsForUpdate[notif.SwIfIndex] = struct{}{}
+	}
+
 	if debugIfStates {
 		c.log.Debugf("Interface state notification for %s (idx: %d): %+v",
 			ifState.Name, ifState.IfIndex, notif)
@@ -439,6 +452,8 @@ func (c *InterfaceStateUpdater) updateIfStateDetails(ifDetails *vppcalls.Interfa
 	ifState.Speed = ifDetails.LinkSpeed
 	ifState.Duplex = ifDetails.LinkDuplex
 	ifState.Mtu = uint32(ifDetails.LinkMTU)
+	ifState.VhostUser = ifDetails.VhostUser
+	ifState.VirtioPci = ifDetails.VirtioPCI
 
 	c.publishIfState(&intf.InterfaceNotification{State: ifState})
 }
@@ -34,6 +34,21 @@ var (
 
 	// ErrRdmaUnsupported error is returned if RDMA interface is not supported on given VPP version.
 	ErrRdmaUnsupported = errors.New("RDMA interface not supported")
+
+	// ErrVhostUserUnsupported error is returned if vhost-user interface is not supported on given VPP version.
+	ErrVhostUserUnsupported = errors.New("vhost-user interface not supported")
+
+	// ErrVirtioPCIUnsupported error is returned if virtio-pci interface is not supported on given VPP version.
+	ErrVirtioPCIUnsupported = errors.New("virtio-pci interface not supported")
+
+	// ErrAfXdpUnsupported error is returned if AF_XDP interface is not supported on given VPP version.
+	ErrAfXdpUnsupported = errors.New("AF_XDP interface not supported")
+
+	// ErrGeneveUnsupported error is returned if GENEVE tunnel is not supported on given VPP version.
+	ErrGeneveUnsupported = errors.New("GENEVE tunnel not supported")
+
+	// ErrVxlanGbpUnsupported error is returned if VXLAN-GBP tunnel is not supported on given VPP version.
+	ErrVxlanGbpUnsupported = errors.New("VXLAN-GBP tunnel not supported")
 )
 
 // InterfaceDetails is the wrapper structure for the interface northbound API structure.
@@ -116,6 +131,10 @@ type InterfaceState struct {
 	LinkDuplex interfaces.InterfaceState_Duplex
 	LinkSpeed  uint64
 	LinkMTU    uint16
+
+	// type-specific state (nil if not applicable or not dumped, see DumpTypeSpecificStates)
+	VhostUser *interfaces.InterfaceState_VhostUserState
+	VirtioPCI *interfaces.InterfaceState_VirtioPCIState
 }
 
 // InterfaceSpanDetails is a helper struct grouping SPAN data.
@@ -134,6 +153,11 @@ type InterfaceVppAPI interface {
 	Wmxnet3API
 	IP6ndVppAPI
 	RdmaAPI
+	VhostUserAPI
+	VirtioPCIAPI
+	AfXdpAPI
+	GeneveAPI
+	VxlanGbpAPI
 
 	// AddAfPacketInterface calls AfPacketCreate VPP binary API.
 	AddAfPacketInterface(ifName, hwAddr, targetHostIfName string) (swIndex uint32, err error)
@@ -271,6 +295,41 @@ type RdmaAPI interface {
 	DeleteRdmaInterface(ctx context.Context, ifName string, ifIdx uint32) error
 }
 
+type VhostUserAPI interface {
+	// AddVhostUserInterface adds new vhost-user interface.
+	AddVhostUserInterface(ctx context.Context, ifName string, vhostUser *interfaces.VhostUserLink) (swIdx uint32, err error)
+	// DeleteVhostUserInterface removes vhost-user interface.
+	DeleteVhostUserInterface(ctx context.Context, ifName string, ifIdx uint32) error
+}
+
+type VirtioPCIAPI interface {
+	// AddVirtioPCIInterface adds new interface with virtio-pci driver.
+	AddVirtioPCIInterface(ctx context.Context, ifName string, virtio *interfaces.VirtioPCILink) (swIdx uint32, err error)
+	// DeleteVirtioPCIInterface removes interface with virtio-pci driver.
+	DeleteVirtioPCIInterface(ctx context.Context, ifName string, ifIdx uint32) error
+}
+
+type AfXdpAPI interface {
+	// AddAfXdpInterface adds new AF_XDP interface attached to the given host interface.
+	AddAfXdpInterface(ctx context.Context, ifName, hostIfName string, afXdp *interfaces.AfXdpLink) (swIdx uint32, err error)
+	// DeleteAfXdpInterface removes AF_XDP interface.
+	DeleteAfXdpInterface(ctx context.Context, ifName string, ifIdx uint32) error
+}
+
+type GeneveAPI interface {
+	// AddGeneveTunnel adds new GENEVE tunnel with encapsulation in the given VRF.
+	AddGeneveTunnel(ctx context.Context, ifName string, vrf, multicastIf uint32, geneve *interfaces.GeneveLink) (swIdx uint32, err error)
+	// DeleteGeneveTunnel removes GENEVE tunnel.
+	DeleteGeneveTunnel(ctx context.Context, ifName string, ifIdx, vrf uint32, geneve *interfaces.GeneveLink) error
+}
+
+type VxlanGbpAPI interface {
+	// AddVxlanGbpTunnel adds new VXLAN-GBP tunnel with encapsulation in the given VRF.
+	AddVxlanGbpTunnel(ctx context.Context, ifName string, vrf, multicastIf uint32, vxlanGbp *interfaces.VxlanGbpLink) (swIdx uint32, err error)
+	// DeleteVxlanGbpTunnel removes VXLAN-GBP tunnel.
+	DeleteVxlanGbpTunnel(ctx context.Context, ifName string, ifIdx, vrf uint32, vxlanGbp *interfaces.VxlanGbpLink) error
+}
+
 // InterfaceVppRead provides read methods for interface plugin
 type InterfaceVppRead interface {
 	// DumpInterfaces dumps VPP interface data into the northbound API data structure
@@ -284,6 +343,9 @@ type InterfaceVppRead interface {
 	DumpInterfacesByType(ctx context.Context, reqType interfaces.Interface_Type) (map[uint32]*InterfaceDetails, error)
 	// DumpInterfaceStates dumps link and administrative state of every interface.
 	DumpInterfaceStates(ifIdxs ...uint32) (map[uint32]*InterfaceState, error)
+	// DumpTypeSpecificStates dumps run-time state specific to the interface type (vhost-user,
+	// virtio-pci) into the given interface states.
+	DumpTypeSpecificStates(ctx context.Context, ifStates map[uint32]*InterfaceState) error
 	// DumpSpan returns all records from span table.
 	DumpSpan() ([]*InterfaceSpanDetails, error)
 	// GetInterfaceVrf reads VRF table to interface
@@ -0,0 +1,36 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2001
+
+import (
+	"context"
+	"fmt"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func (h *InterfaceVppHandler) AddVhostUserInterface(ctx context.Context, ifName string, vhostUser *interfaces.VhostUserLink) (uint32, error) {
+	return 0, fmt.Errorf("%w in VPP 20.01", vppcalls.ErrVhostUserUnsupported)
+}
+
+func (h *InterfaceVppHandler) DeleteVhostUserInterface(ctx context.Context, ifName string, ifIdx uint32) error {
+	return fmt.Errorf("%w in VPP 20.01", vppcalls.ErrVhostUserUnsupported)
+}
+
+func (h *InterfaceVppHandler) DumpTypeSpecificStates(ctx context.Context, ifStates map[uint32]*vppcalls.InterfaceState) error {
+	// vhost-user and virtio-pci interfaces are not supported in VPP 20.01
+	return nil
+}
@@ -0,0 +1,87 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2009
+
+import (
+	"context"
+
+	"git.fd.io/govpp.git/api"
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/af_xdp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+)
+
+// AddAfXdpInterface adds new AF_XDP interface attached to the given host interface.
+func (h *InterfaceVppHandler) AddAfXdpInterface(ctx context.Context, ifName, hostIfName string, afXdp *interfaces.AfXdpLink) (swIdx uint32, err error) {
+	if h.afXdp == nil {
+		return 0, errors.WithMessage(vpp.ErrPluginDisabled, "af_xdp")
+	}
+
+	req := &af_xdp.AfXdpCreate{
+		HostIf:  hostIfName,
+		Name:    ifName,
+		RxqNum:  uint16(afXdp.GetRxqNum()),
+		RxqSize: uint16(afXdp.GetRxqSize()),
+		TxqSize: uint16(afXdp.GetTxqSize()),
+		Mode:    afXdpMode(afXdp.GetMode()),
+		Prog:    afXdp.GetProgPath(),
+	}
+	if req.RxqNum == 0 {
+		req.RxqNum = 1
+	}
+
+	reply, err := h.afXdp.AfXdpCreate(ctx, req)
+	if err != nil {
+		return 0, err
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return 0, err
+	}
+	swIdx = uint32(reply.SwIfIndex)
+
+	return swIdx, h.SetInterfaceTag(ifName, swIdx)
+}
+
+// DeleteAfXdpInterface removes AF_XDP interface.
+func (h *InterfaceVppHandler) DeleteAfXdpInterface(ctx context.Context, ifName string, ifIdx uint32) error {
+	if h.afXdp == nil {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "af_xdp")
+	}
+
+	req := &af_xdp.AfXdpDelete{
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+	}
+	if reply, err := h.afXdp.AfXdpDelete(ctx, req); err != nil {
+		return err
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return err
+	}
+
+	return h.RemoveInterfaceTag(ifName, ifIdx)
+}
+
+func afXdpMode(mode interfaces.AfXdpLink_Mode) af_xdp.AfXdpMode {
+	switch mode {
+	case interfaces.AfXdpLink_COPY:
+		return af_xdp.AF_XDP_API_MODE_COPY
+	case interfaces.AfXdpLink_ZERO_COPY:
+		return af_xdp.AF_XDP_API_MODE_ZERO_COPY
+	default:
+		return af_xdp.AF_XDP_API_MODE_AUTO
+	}
+}
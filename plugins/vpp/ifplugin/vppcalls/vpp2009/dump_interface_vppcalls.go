@@ -303,6 +303,26 @@ func (h *InterfaceVppHandler) DumpInterfaces(ctx context.Context) (map[uint32]*v
 		return nil, err
 	}
 
+	err = h.dumpVhostUserDetails(ctx, interfaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.dumpVirtioPCIDetails(ctx, interfaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.dumpGeneveDetails(ctx, interfaces)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.dumpVxlanGbpDetails(ctx, interfaces)
+	if err != nil {
+		return nil, err
+	}
+
 	// Rx-placement dump is last since it uses interface type-specific data
 	err = h.dumpRxPlacement(interfaces)
 	if err != nil {
@@ -402,6 +422,32 @@ func (h *InterfaceVppHandler) DumpInterfaceStates(ifIdxs ...uint32) (map[uint32]
 	return ifStates, nil
 }
 
+// DumpTypeSpecificStates dumps run-time state of vhost-user and virtio-pci interfaces
+// into the given interface states. Interfaces of each type are dumped only if
+// there is an interface of that type among the states.
+func (h *InterfaceVppHandler) DumpTypeSpecificStates(ctx context.Context, ifStates map[uint32]*vppcalls.InterfaceState) error {
+	var hasVhostUser, hasVirtioPCI bool
+	for _, ifState := range ifStates {
+		switch guessInterfaceType("", ifState.InternalName) {
+		case ifs.Interface_VHOST_USER:
+			hasVhostUser = true
+		case ifs.Interface_VIRTIO_PCI:
+			hasVirtioPCI = true
+		}
+	}
+	if hasVhostUser {
+		if err := h.dumpVhostUserStates(ctx, ifStates); err != nil {
+			return fmt.Errorf("failed to dump vhost-user interface states: %v", err)
+		}
+	}
+	if hasVirtioPCI {
+		if err := h.dumpVirtioPCIStates(ctx, ifStates); err != nil {
+			return fmt.Errorf("failed to dump virtio-pci interface states: %v", err)
+		}
+	}
+	return nil
+}
+
 func toLinkDuplex(duplex interface_types.LinkDuplex) ifs.InterfaceState_Duplex {
 	switch duplex {
 	case 1:
@@ -940,6 +986,9 @@ func guessInterfaceType(ifDevType, ifName string) ifs.Interface_Type {
 	case ifDevType == "RDMA interface":
 		return ifs.Interface_RDMA
 
+	case ifDevType == "AF_XDP interface":
+		return ifs.Interface_AF_XDP
+
 	case strings.HasPrefix(ifName, "loop"),
 		strings.HasPrefix(ifName, "local"):
 		return ifs.Interface_SOFTWARE_LOOPBACK
@@ -954,6 +1003,9 @@ func guessInterfaceType(ifDevType, ifName string) ifs.Interface_Type {
 	case strings.HasPrefix(ifName, "host"):
 		return ifs.Interface_AF_PACKET
 
+	case strings.HasPrefix(ifName, "vxlan_gbp_tunnel"):
+		return ifs.Interface_VXLAN_GBP_TUNNEL
+
 	case strings.HasPrefix(ifName, "vxlan"):
 		return ifs.Interface_VXLAN_TUNNEL
 
@@ -975,6 +1027,15 @@ func guessInterfaceType(ifDevType, ifName string) ifs.Interface_Type {
 	case strings.HasPrefix(ifName, "wireguard"):
 		return ifs.Interface_WIREGUARD_TUNNEL
 
+	case strings.HasPrefix(ifName, "VirtualEthernet"):
+		return ifs.Interface_VHOST_USER
+
+	case strings.HasPrefix(ifName, "virtio-"):
+		return ifs.Interface_VIRTIO_PCI
+
+	case strings.HasPrefix(ifName, "geneve_tunnel"):
+		return ifs.Interface_GENEVE_TUNNEL
+
 	default:
 		return ifs.Interface_DPDK
 	}
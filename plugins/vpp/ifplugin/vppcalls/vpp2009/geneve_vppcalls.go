@@ -0,0 +1,130 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2009
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"git.fd.io/govpp.git/api"
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/geneve"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func (h *InterfaceVppHandler) geneveAddDelTunnel(ctx context.Context, isAdd bool, vrf, multicastIf uint32, geneveLink *interfaces.GeneveLink) (uint32, error) {
+	if h.geneve == nil {
+		return 0, errors.WithMessage(vpp.ErrPluginDisabled, "geneve")
+	}
+	if geneveLink.GetL3Mode() {
+		return 0, fmt.Errorf("%w: L3 mode requires VPP 21.01", vppcalls.ErrGeneveUnsupported)
+	}
+
+	srcAddr, dstAddr, err := tunnelEndpoints(geneveLink.GetSrcAddress(), geneveLink.GetDstAddress())
+	if err != nil {
+		return 0, err
+	}
+	var decapNextNode uint32 = defaultDecapNextIndex
+	if geneveLink.GetDecapNextNode() != 0 {
+		decapNextNode = geneveLink.GetDecapNextNode()
+	}
+
+	req := &geneve.GeneveAddDelTunnel{
+		IsAdd:          isAdd,
+		LocalAddress:   srcAddr,
+		RemoteAddress:  dstAddr,
+		McastSwIfIndex: interface_types.InterfaceIndex(multicastIf),
+		EncapVrfID:     vrf,
+		DecapNextIndex: decapNextNode,
+		Vni:            geneveLink.GetVni(),
+	}
+	reply, err := h.geneve.GeneveAddDelTunnel(ctx, req)
+	if err != nil {
+		return 0, err
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return 0, err
+	}
+	return uint32(reply.SwIfIndex), nil
+}
+
+// AddGeneveTunnel adds new GENEVE tunnel with encapsulation in the given VRF.
+func (h *InterfaceVppHandler) AddGeneveTunnel(ctx context.Context, ifName string, vrf, multicastIf uint32, geneveLink *interfaces.GeneveLink) (uint32, error) {
+	swIfIdx, err := h.geneveAddDelTunnel(ctx, true, vrf, multicastIf, geneveLink)
+	if err != nil {
+		return 0, err
+	}
+	return swIfIdx, h.SetInterfaceTag(ifName, swIfIdx)
+}
+
+// DeleteGeneveTunnel removes GENEVE tunnel.
+func (h *InterfaceVppHandler) DeleteGeneveTunnel(ctx context.Context, ifName string, ifIdx, vrf uint32, geneveLink *interfaces.GeneveLink) error {
+	// Multicast does not need to be set
+	if _, err := h.geneveAddDelTunnel(ctx, false, vrf, 0, geneveLink); err != nil {
+		return err
+	}
+	return h.RemoveInterfaceTag(ifName, ifIdx)
+}
+
+// dumpGeneveDetails dumps GENEVE tunnel details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpGeneveDetails(ctx context.Context, ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	if h.geneve == nil {
+		// no-op when disabled
+		return nil
+	}
+
+	dump, err := h.geneve.GeneveTunnelDump(ctx, &geneve.GeneveTunnelDump{
+		SwIfIndex: interface_types.InterfaceIndex(allInterfaces),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		geneveDetails, err := dump.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ifData, ifIdxExists := ifc[uint32(geneveDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		// Multicast interface
+		var multicastIfName string
+		if mcastIf, exists := ifc[uint32(geneveDetails.McastSwIfIndex)]; exists {
+			multicastIfName = mcastIf.Interface.Name
+		}
+		geneveLink := &interfaces.GeneveLink{
+			SrcAddress: geneveDetails.SrcAddress.ToIP().String(),
+			DstAddress: geneveDetails.DstAddress.ToIP().String(),
+			Vni:        geneveDetails.Vni,
+			Multicast:  multicastIfName,
+		}
+		if geneveDetails.DecapNextIndex != defaultDecapNextIndex {
+			geneveLink.DecapNextNode = geneveDetails.DecapNextIndex
+		}
+		// L3 mode is not available in the dump
+		ifData.Interface.Link = &interfaces.Interface_Geneve{Geneve: geneveLink}
+		ifData.Interface.Type = interfaces.Interface_GENEVE_TUNNEL
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2009
+
+import (
+	"context"
+	"io"
+
+	"git.fd.io/govpp.git/api"
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vhost_user"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// AddVhostUserInterface adds new vhost-user interface.
+func (h *InterfaceVppHandler) AddVhostUserInterface(ctx context.Context, ifName string, vhostUser *interfaces.VhostUserLink) (swIdx uint32, err error) {
+	if h.vhostUser == nil {
+		return 0, errors.WithMessage(vpp.ErrPluginDisabled, "vhost-user")
+	}
+
+	req := &vhost_user.CreateVhostUserIf{
+		IsServer:            vhostUser.GetIsServer(),
+		SockFilename:        vhostUser.GetSocketFilename(),
+		DisableMrgRxbuf:     vhostUser.GetDisableMrgRxbuf(),
+		DisableIndirectDesc: vhostUser.GetDisableIndirectDesc(),
+		EnableGso:           vhostUser.GetEnableGso(),
+		EnablePacked:        vhostUser.GetEnablePacked(),
+		Tag:                 ifName,
+	}
+
+	reply, err := h.vhostUser.CreateVhostUserIf(ctx, req)
+	if err != nil {
+		return 0, err
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return 0, err
+	}
+	swIdx = uint32(reply.SwIfIndex)
+
+	return swIdx, h.SetInterfaceTag(ifName, swIdx)
+}
+
+// DeleteVhostUserInterface removes vhost-user interface.
+func (h *InterfaceVppHandler) DeleteVhostUserInterface(ctx context.Context, ifName string, ifIdx uint32) error {
+	if h.vhostUser == nil {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "vhost-user")
+	}
+
+	req := &vhost_user.DeleteVhostUserIf{
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+	}
+	if reply, err := h.vhostUser.DeleteVhostUserIf(ctx, req); err != nil {
+		return err
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return err
+	}
+
+	return h.RemoveInterfaceTag(ifName, ifIdx)
+}
+
+// dumpVhostUserDetails dumps vhost-user interface details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpVhostUserDetails(ctx context.Context, ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	if h.vhostUser == nil {
+		// no-op when disabled
+		return nil
+	}
+
+	dump, err := h.vhostUser.SwInterfaceVhostUserDump(ctx, &vhost_user.SwInterfaceVhostUserDump{
+		SwIfIndex: interface_types.InterfaceIndex(allInterfaces),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		vhostDetails, err := dump.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ifData, ifIdxExists := ifc[uint32(vhostDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		// Flags disabling/enabling features (mrg_rxbuf, indirect_desc, gso, packed)
+		// cannot be reliably derived from the negotiated feature set.
+		ifData.Interface.Link = &interfaces.Interface_VhostUser{
+			VhostUser: &interfaces.VhostUserLink{
+				SocketFilename: vhostDetails.SockFilename,
+				IsServer:       vhostDetails.IsServer,
+			},
+		}
+		ifData.Interface.Type = interfaces.Interface_VHOST_USER
+	}
+	return nil
+}
+
+// dumpVhostUserStates dumps vhost-user runtime state and fills it into the provided state map.
+func (h *InterfaceVppHandler) dumpVhostUserStates(ctx context.Context, ifStates map[uint32]*vppcalls.InterfaceState) error {
+	if h.vhostUser == nil {
+		return nil
+	}
+
+	dump, err := h.vhostUser.SwInterfaceVhostUserDump(ctx, &vhost_user.SwInterfaceVhostUserDump{
+		SwIfIndex: interface_types.InterfaceIndex(allInterfaces),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		vhostDetails, err := dump.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ifState, ifIdxExists := ifStates[uint32(vhostDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		ifState.VhostUser = &interfaces.InterfaceState_VhostUserState{
+			SocketFilename:   vhostDetails.SockFilename,
+			IsServer:         vhostDetails.IsServer,
+			NumRegions:       vhostDetails.NumRegions,
+			SockErrno:        vhostDetails.SockErrno,
+			Features:         uint64(vhostDetails.FeaturesLast32)<<32 | uint64(vhostDetails.FeaturesFirst32),
+			VirtioNetHdrSize: vhostDetails.VirtioNetHdrSz,
+		}
+	}
+	return nil
+}
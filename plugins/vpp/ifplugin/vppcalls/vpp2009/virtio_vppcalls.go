@@ -0,0 +1,164 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2009
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"git.fd.io/govpp.git/api"
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/pci_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/virtio"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+// AddVirtioPCIInterface adds new interface with virtio-pci driver.
+func (h *InterfaceVppHandler) AddVirtioPCIInterface(ctx context.Context, ifName string, virtioLink *interfaces.VirtioPCILink) (swIdx uint32, err error) {
+	if h.virtio == nil {
+		return 0, errors.WithMessage(vpp.ErrPluginDisabled, "virtio")
+	}
+
+	pciAddr, err := parsePCIAddress(virtioLink.GetPciAddress())
+	if err != nil {
+		return 0, err
+	}
+
+	if virtioLink.GetEnablePacked() {
+		return 0, fmt.Errorf("%w: packed ring requires VPP 21.01 or newer", vppcalls.ErrVirtioPCIUnsupported)
+	}
+
+	// MAC address is configured separately by the interface descriptor
+	req := &virtio.VirtioPciCreate{
+		PciAddr:                pciAddr,
+		UseRandomMac:           true,
+		GsoEnabled:             virtioLink.GetEnableGso(),
+		ChecksumOffloadEnabled: virtioLink.GetEnableChecksumOffload(),
+		Features:               virtioLink.GetFeatures(),
+	}
+
+	reply, err := h.virtio.VirtioPciCreate(ctx, req)
+	if err != nil {
+		return 0, err
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return 0, err
+	}
+	swIdx = uint32(reply.SwIfIndex)
+
+	return swIdx, h.SetInterfaceTag(ifName, swIdx)
+}
+
+// DeleteVirtioPCIInterface removes interface with virtio-pci driver.
+func (h *InterfaceVppHandler) DeleteVirtioPCIInterface(ctx context.Context, ifName string, ifIdx uint32) error {
+	if h.virtio == nil {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "virtio")
+	}
+
+	req := &virtio.VirtioPciDelete{
+		SwIfIndex: interface_types.InterfaceIndex(ifIdx),
+	}
+	if reply, err := h.virtio.VirtioPciDelete(ctx, req); err != nil {
+		return err
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return err
+	}
+
+	return h.RemoveInterfaceTag(ifName, ifIdx)
+}
+
+// dumpVirtioPCIDetails dumps virtio-pci interface details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpVirtioPCIDetails(ctx context.Context, ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	if h.virtio == nil {
+		// no-op when disabled
+		return nil
+	}
+
+	dump, err := h.virtio.SwInterfaceVirtioPciDump(ctx, &virtio.SwInterfaceVirtioPciDump{})
+	if err != nil {
+		return err
+	}
+	for {
+		virtioDetails, err := dump.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ifData, ifIdxExists := ifc[uint32(virtioDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		// GSO, checksum offload and packed ring flags are not available in the dump
+		ifData.Interface.Link = &interfaces.Interface_VirtioPci{
+			VirtioPci: &interfaces.VirtioPCILink{
+				PciAddress: pciAddressToString(virtioDetails.PciAddr),
+			},
+		}
+		ifData.Interface.Type = interfaces.Interface_VIRTIO_PCI
+	}
+	return nil
+}
+
+// dumpVirtioPCIStates dumps virtio-pci runtime state and fills it into the provided state map.
+func (h *InterfaceVppHandler) dumpVirtioPCIStates(ctx context.Context, ifStates map[uint32]*vppcalls.InterfaceState) error {
+	if h.virtio == nil {
+		return nil
+	}
+
+	dump, err := h.virtio.SwInterfaceVirtioPciDump(ctx, &virtio.SwInterfaceVirtioPciDump{})
+	if err != nil {
+		return err
+	}
+	for {
+		virtioDetails, err := dump.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		ifState, ifIdxExists := ifStates[uint32(virtioDetails.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		ifState.VirtioPCI = &interfaces.InterfaceState_VirtioPCIState{
+			PciAddress: pciAddressToString(virtioDetails.PciAddr),
+			Features:   virtioDetails.Features,
+			RxRingSize: uint32(virtioDetails.RxRingSz),
+			TxRingSize: uint32(virtioDetails.TxRingSz),
+		}
+	}
+	return nil
+}
+
+// parsePCIAddress parses PCI address in the format dddd:bb:ss.f (hexadecimal).
+func parsePCIAddress(addr string) (pciAddr pci_types.PciAddress, err error) {
+	if _, err = fmt.Sscanf(addr, "%x:%x:%x.%x", &pciAddr.Domain, &pciAddr.Bus, &pciAddr.Slot, &pciAddr.Function); err != nil {
+		return pciAddr, fmt.Errorf("invalid PCI address %q: %v", addr, err)
+	}
+	return pciAddr, nil
+}
+
+func pciAddressToString(pciAddr pci_types.PciAddress) string {
+	return fmt.Sprintf("%04x:%02x:%02x.%x", pciAddr.Domain, pciAddr.Bus, pciAddr.Slot, pciAddr.Function)
+}
@@ -20,8 +20,10 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 	vpp2009 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/af_packet"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/af_xdp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/bond"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/dhcp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/geneve"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/gre"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/gtpu"
 	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface"
@@ -34,8 +36,11 @@ import (
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/rdma"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/span"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/tapv2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vhost_user"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/virtio"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vmxnet3"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vxlan"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vxlan_gbp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/wireguard"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
 )
@@ -54,7 +59,10 @@ var HandlerVersion = vpp.HandlerVersion{
 			l2.AllMessages,
 			span.AllMessages,
 			tapv2.AllMessages,
+			vhost_user.AllMessages,
+			virtio.AllMessages,
 			vxlan.AllMessages,
+			vxlan_gbp.AllMessages,
 		)
 		if c.IsPluginLoaded(gtpu.APIFile) {
 			msgs.Add(gtpu.AllMessages)
@@ -71,6 +79,12 @@ var HandlerVersion = vpp.HandlerVersion{
 		if c.IsPluginLoaded(rdma.APIFile) {
 			msgs.Add(rdma.AllMessages)
 		}
+		if c.IsPluginLoaded(af_xdp.APIFile) {
+			msgs.Add(af_xdp.AllMessages)
+		}
+		if c.IsPluginLoaded(geneve.APIFile) {
+			msgs.Add(geneve.AllMessages)
+		}
 		return c.CheckCompatiblity(msgs.AllMessages()...)
 	},
 	NewHandler: func(c vpp.Client, a ...interface{}) vpp.HandlerAPI {
@@ -94,6 +108,11 @@ type InterfaceVppHandler struct {
 	rpcRdCp      rd_cp.RPCService
 	wireguard    wireguard.RPCService
 	rdma         rdma.RPCService
+	vhostUser    vhost_user.RPCService
+	virtio       virtio.RPCService
+	afXdp        af_xdp.RPCService
+	geneve       geneve.RPCService
+	vxlanGbp     vxlan_gbp.RPCService
 	log          logging.Logger
 }
 
@@ -109,6 +128,9 @@ func NewInterfaceVppHandler(c vpp.Client, log logging.Logger) vppcalls.Interface
 		ipsec:        ipsec.NewServiceClient(c),
 		rpcIP6nd:     ip6_nd.NewServiceClient(c),
 		rpcRdCp:      rd_cp.NewServiceClient(c),
+		vhostUser:    vhost_user.NewServiceClient(c),
+		virtio:       virtio.NewServiceClient(c),
+		vxlanGbp:     vxlan_gbp.NewServiceClient(c),
 		log:          log,
 	}
 	if c.IsPluginLoaded(gtpu.APIFile) {
@@ -126,5 +148,11 @@ func NewInterfaceVppHandler(c vpp.Client, log logging.Logger) vppcalls.Interface
 	if c.IsPluginLoaded(rdma.APIFile) {
 		h.rdma = rdma.NewServiceClient(c)
 	}
+	if c.IsPluginLoaded(af_xdp.APIFile) {
+		h.afXdp = af_xdp.NewServiceClient(c)
+	}
+	if c.IsPluginLoaded(geneve.APIFile) {
+		h.geneve = geneve.NewServiceClient(c)
+	}
 	return h
 }
@@ -0,0 +1,117 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2009
+
+import (
+	"context"
+	"io"
+
+	"git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vxlan_gbp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func (h *InterfaceVppHandler) vxlanGbpAddDelTunnel(ctx context.Context, isAdd bool, vrf, multicastIf uint32, vxlanGbpLink *interfaces.VxlanGbpLink) (uint32, error) {
+	srcAddr, dstAddr, err := tunnelEndpoints(vxlanGbpLink.GetSrcAddress(), vxlanGbpLink.GetDstAddress())
+	if err != nil {
+		return 0, err
+	}
+	mode := vxlan_gbp.VXLAN_GBP_API_TUNNEL_MODE_L2
+	if vxlanGbpLink.GetL3Mode() {
+		mode = vxlan_gbp.VXLAN_GBP_API_TUNNEL_MODE_L3
+	}
+
+	req := &vxlan_gbp.VxlanGbpTunnelAddDel{
+		IsAdd: isAdd,
+		Tunnel: vxlan_gbp.VxlanGbpTunnel{
+			Instance:       ^uint32(0),
+			Src:            srcAddr,
+			Dst:            dstAddr,
+			McastSwIfIndex: interface_types.InterfaceIndex(multicastIf),
+			EncapTableID:   vrf,
+			Vni:            vxlanGbpLink.GetVni(),
+			Mode:           mode,
+		},
+	}
+	reply, err := h.vxlanGbp.VxlanGbpTunnelAddDel(ctx, req)
+	if err != nil {
+		return 0, err
+	} else if err = api.RetvalToVPPApiError(reply.Retval); err != nil {
+		return 0, err
+	}
+	return uint32(reply.SwIfIndex), nil
+}
+
+// AddVxlanGbpTunnel adds new VXLAN-GBP tunnel with encapsulation in the given VRF.
+func (h *InterfaceVppHandler) AddVxlanGbpTunnel(ctx context.Context, ifName string, vrf, multicastIf uint32, vxlanGbpLink *interfaces.VxlanGbpLink) (uint32, error) {
+	swIfIdx, err := h.vxlanGbpAddDelTunnel(ctx, true, vrf, multicastIf, vxlanGbpLink)
+	if err != nil {
+		return 0, err
+	}
+	return swIfIdx, h.SetInterfaceTag(ifName, swIfIdx)
+}
+
+// DeleteVxlanGbpTunnel removes VXLAN-GBP tunnel.
+func (h *InterfaceVppHandler) DeleteVxlanGbpTunnel(ctx context.Context, ifName string, ifIdx, vrf uint32, vxlanGbpLink *interfaces.VxlanGbpLink) error {
+	// Multicast does not need to be set
+	if _, err := h.vxlanGbpAddDelTunnel(ctx, false, vrf, 0, vxlanGbpLink); err != nil {
+		return err
+	}
+	return h.RemoveInterfaceTag(ifName, ifIdx)
+}
+
+// dumpVxlanGbpDetails dumps VXLAN-GBP tunnel details from VPP and fills them into the provided interface map.
+func (h *InterfaceVppHandler) dumpVxlanGbpDetails(ctx context.Context, ifc map[uint32]*vppcalls.InterfaceDetails) error {
+	dump, err := h.vxlanGbp.VxlanGbpTunnelDump(ctx, &vxlan_gbp.VxlanGbpTunnelDump{
+		SwIfIndex: interface_types.InterfaceIndex(allInterfaces),
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		details, err := dump.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		tunnel := details.Tunnel
+		ifData, ifIdxExists := ifc[uint32(tunnel.SwIfIndex)]
+		if !ifIdxExists {
+			continue
+		}
+		// Multicast interface
+		var multicastIfName string
+		if mcastIf, exists := ifc[uint32(tunnel.McastSwIfIndex)]; exists {
+			multicastIfName = mcastIf.Interface.Name
+		}
+		ifData.Interface.Link = &interfaces.Interface_VxlanGbp{
+			VxlanGbp: &interfaces.VxlanGbpLink{
+				SrcAddress: tunnel.Src.ToIP().String(),
+				DstAddress: tunnel.Dst.ToIP().String(),
+				Vni:        tunnel.Vni,
+				Multicast:  multicastIfName,
+				L3Mode:     tunnel.Mode == vxlan_gbp.VXLAN_GBP_API_TUNNEL_MODE_L3,
+			},
+		}
+		ifData.Interface.Type = interfaces.Interface_VXLAN_GBP_TUNNEL
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_afxdp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/af_xdp"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
+
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddAfXdpInterface(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_afxdp.AfXdpCreateReply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	afXdpLink := &ifs.AfXdpLink{
+		HostIfName: "ens4",
+		Mode:       ifs.AfXdpLink_ZERO_COPY,
+		RxqNum:     4,
+		RxqSize:    2048,
+		TxqSize:    2048,
+		ProgPath:   "/opt/xdp/prog.o",
+	}
+
+	index, err := ifHandler.AddAfXdpInterface(ctx.Context, "afxdp1", "ens4", afXdpLink)
+	Expect(err).To(BeNil())
+	Expect(index).To(Equal(uint32(2)))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_afxdp.AfXdpCreate)
+		if ok {
+			Expect(vppMsg.HostIf).To(BeEquivalentTo("ens4"))
+			Expect(vppMsg.Name).To(BeEquivalentTo("afxdp1"))
+			Expect(vppMsg.Mode).To(BeEquivalentTo(vpp_afxdp.AF_XDP_API_MODE_ZERO_COPY))
+			Expect(vppMsg.RxqNum).To(BeEquivalentTo(4))
+			Expect(vppMsg.RxqSize).To(BeEquivalentTo(2048))
+			Expect(vppMsg.TxqSize).To(BeEquivalentTo(2048))
+			Expect(vppMsg.Prog).To(BeEquivalentTo("/opt/xdp/prog.o"))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddAfXdpInterfaceDefaultQueues(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_afxdp.AfXdpCreateReply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	_, err := ifHandler.AddAfXdpInterface(ctx.Context, "afxdp1", "ens4", &ifs.AfXdpLink{
+		LinuxInterface: "linux-ens4",
+	})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_afxdp.AfXdpCreate)
+		if ok {
+			Expect(vppMsg.HostIf).To(BeEquivalentTo("ens4"))
+			Expect(vppMsg.Mode).To(BeEquivalentTo(vpp_afxdp.AF_XDP_API_MODE_AUTO))
+			Expect(vppMsg.RxqNum).To(BeEquivalentTo(1))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestDeleteAfXdpInterface(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+	ctx.MockVpp.MockReply(&vpp_afxdp.AfXdpDeleteReply{})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeleteAfXdpInterface(ctx.Context, "afxdp1", 2)
+	Expect(err).To(BeNil())
+
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_afxdp.AfXdpDelete)
+		if ok {
+			Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(2))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
@@ -0,0 +1,151 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_geneve "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/geneve"
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddGeneveTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_geneve.GeneveAddDelTunnel2Reply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddGeneveTunnel(ctx.Context, "geneve1", 5, 0xFFFFFFFF, &ifs.GeneveLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "20.0.0.1",
+		Vni:        100,
+		L3Mode:     true,
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(2))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_geneve.GeneveAddDelTunnel2)
+		if ok {
+			Expect(vppMsg.IsAdd).To(BeTrue())
+			Expect(vppMsg.LocalAddress.Af).To(BeEquivalentTo(ip_types.ADDRESS_IP4))
+			Expect(vppMsg.LocalAddress.Un.GetIP4()).To(BeEquivalentTo(ip_types.IP4Address{10, 0, 0, 1}))
+			Expect(vppMsg.RemoteAddress.Un.GetIP4()).To(BeEquivalentTo(ip_types.IP4Address{20, 0, 0, 1}))
+			Expect(vppMsg.Vni).To(BeEquivalentTo(100))
+			Expect(vppMsg.EncapVrfID).To(BeEquivalentTo(5))
+			Expect(vppMsg.McastSwIfIndex).To(BeEquivalentTo(0xFFFFFFFF))
+			Expect(vppMsg.DecapNextIndex).To(BeEquivalentTo(0xFFFFFFFF))
+			Expect(vppMsg.L3Mode).To(BeTrue())
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddGeneveTunnelIPv6(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_geneve.GeneveAddDelTunnel2Reply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	_, err := ifHandler.AddGeneveTunnel(ctx.Context, "geneve1", 0, 0xFFFFFFFF, &ifs.GeneveLink{
+		SrcAddress:    "2001:db8::1",
+		DstAddress:    "2001:db8::2",
+		Vni:           100,
+		DecapNextNode: 3,
+	})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_geneve.GeneveAddDelTunnel2)
+		if ok {
+			var dst ip_types.IP6Address
+			copy(dst[:], net.ParseIP("2001:db8::2").To16())
+			Expect(vppMsg.RemoteAddress.Af).To(BeEquivalentTo(ip_types.ADDRESS_IP6))
+			Expect(vppMsg.RemoteAddress.Un.GetIP6()).To(BeEquivalentTo(dst))
+			Expect(vppMsg.DecapNextIndex).To(BeEquivalentTo(3))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddGeneveTunnelIPMismatch(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	_, err := ifHandler.AddGeneveTunnel(ctx.Context, "geneve1", 0, 0xFFFFFFFF, &ifs.GeneveLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "2001:db8::2",
+		Vni:        100,
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestAddGeneveTunnelRetval(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_geneve.GeneveAddDelTunnel2Reply{
+		Retval: 1,
+	})
+
+	_, err := ifHandler.AddGeneveTunnel(ctx.Context, "geneve1", 0, 0xFFFFFFFF, &ifs.GeneveLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "20.0.0.1",
+		Vni:        100,
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteGeneveTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_geneve.GeneveAddDelTunnel2Reply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeleteGeneveTunnel(ctx.Context, "geneve1", 2, 5, &ifs.GeneveLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "20.0.0.1",
+		Vni:        100,
+	})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_geneve.GeneveAddDelTunnel2)
+		if ok {
+			Expect(vppMsg.IsAdd).To(BeFalse())
+			Expect(vppMsg.EncapVrfID).To(BeEquivalentTo(5))
+			Expect(vppMsg.Vni).To(BeEquivalentTo(100))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
@@ -57,3 +57,20 @@ func uintToBool(value uint8) bool {
 func cleanString(s string) string {
 	return strings.SplitN(s, "\x00", 2)[0]
 }
+
+// tunnelEndpoints converts source and destination addresses of a tunnel
+// to binary API addresses, making sure they are of the same IP version.
+func tunnelEndpoints(srcAddr, dstAddr string) (src, dst ip_types.Address, err error) {
+	srcIP, dstIP := net.ParseIP(srcAddr), net.ParseIP(dstAddr)
+	if srcIP == nil || dstIP == nil {
+		return src, dst, fmt.Errorf("invalid tunnel address, src: %q, dst: %q", srcAddr, dstAddr)
+	}
+	if (srcIP.To4() == nil) != (dstIP.To4() == nil) {
+		return src, dst, fmt.Errorf("IP version mismatch for tunnel destination and source IP addresses")
+	}
+	if src, err = IPToAddress(srcAddr); err != nil {
+		return src, dst, err
+	}
+	dst, err = IPToAddress(dstAddr)
+	return src, dst, err
+}
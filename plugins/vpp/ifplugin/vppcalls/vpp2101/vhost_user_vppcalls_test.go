@@ -0,0 +1,141 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
+	vpp_vhost "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vhost_user"
+	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vpe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/vppcalls"
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddVhostUserInterface(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vhost.CreateVhostUserIfReply{
+		SwIfIndex: 3,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	vhostLink := &ifs.VhostUserLink{
+		SocketFilename:  "/var/run/vpp/vhost1.sock",
+		IsServer:        true,
+		DisableMrgRxbuf: true,
+		EnableGso:       true,
+	}
+
+	index, err := ifHandler.AddVhostUserInterface(ctx.Context, "vhost1", vhostLink)
+	Expect(err).To(BeNil())
+	Expect(index).To(Equal(uint32(3)))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_vhost.CreateVhostUserIf)
+		if ok {
+			Expect(vppMsg.SockFilename).To(BeEquivalentTo("/var/run/vpp/vhost1.sock"))
+			Expect(vppMsg.IsServer).To(BeTrue())
+			Expect(vppMsg.DisableMrgRxbuf).To(BeTrue())
+			Expect(vppMsg.DisableIndirectDesc).To(BeFalse())
+			Expect(vppMsg.EnableGso).To(BeTrue())
+			Expect(vppMsg.EnablePacked).To(BeFalse())
+			Expect(vppMsg.Tag).To(BeEquivalentTo("vhost1"))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddVhostUserInterfaceError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vhost.CreateVhostUserIfReply{
+		Retval: 1,
+	})
+
+	_, err := ifHandler.AddVhostUserInterface(ctx.Context, "vhost1", &ifs.VhostUserLink{
+		SocketFilename: "/var/run/vpp/vhost1.sock",
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteVhostUserInterface(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+	ctx.MockVpp.MockReply(&vpp_vhost.DeleteVhostUserIfReply{})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeleteVhostUserInterface(ctx.Context, "vhost1", 3)
+	Expect(err).To(BeNil())
+
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_vhost.DeleteVhostUserIf)
+		if ok {
+			Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(3))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestDumpTypeSpecificStatesVhostUser(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vhost.SwInterfaceVhostUserDetails{
+		SwIfIndex:       3,
+		SockFilename:    "/var/run/vpp/vhost1.sock",
+		IsServer:        true,
+		NumRegions:      2,
+		FeaturesFirst32: 1,
+		FeaturesLast32:  1,
+	})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	ifStates := map[uint32]*vppcalls.InterfaceState{
+		1: {SwIfIndex: 1, InternalName: "loop0"},
+		3: {SwIfIndex: 3, InternalName: "VirtualEthernet0/0/0"},
+	}
+	err := ifHandler.DumpTypeSpecificStates(ctx.Context, ifStates)
+	Expect(err).To(BeNil())
+	Expect(ifStates[1].VhostUser).To(BeNil())
+	Expect(ifStates[3].VhostUser).To(Equal(&ifs.InterfaceState_VhostUserState{
+		SocketFilename: "/var/run/vpp/vhost1.sock",
+		IsServer:       true,
+		NumRegions:     2,
+		Features:       1<<32 | 1,
+	}))
+	Expect(ifStates[3].VirtioPCI).To(BeNil())
+}
+
+func TestDumpTypeSpecificStatesWithoutVhostUserAndVirtio(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ifStates := map[uint32]*vppcalls.InterfaceState{
+		1: {SwIfIndex: 1, InternalName: "loop0"},
+		2: {SwIfIndex: 2, InternalName: "memif1/1"},
+	}
+	err := ifHandler.DumpTypeSpecificStates(ctx.Context, ifStates)
+	Expect(err).To(BeNil())
+	// nothing is dumped
+	Expect(ctx.MockChannel.Msgs).To(BeEmpty())
+}
@@ -0,0 +1,91 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
+	vpp_virtio "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/virtio"
+
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddVirtioPCIInterface(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_virtio.VirtioPciCreateV2Reply{
+		SwIfIndex: 4,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	virtioLink := &ifs.VirtioPCILink{
+		PciAddress:   "0000:00:1a.2",
+		Features:     0x10000,
+		EnableGso:    true,
+		EnablePacked: true,
+	}
+
+	index, err := ifHandler.AddVirtioPCIInterface(ctx.Context, "virtio1", virtioLink)
+	Expect(err).To(BeNil())
+	Expect(index).To(Equal(uint32(4)))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_virtio.VirtioPciCreateV2)
+		if ok {
+			Expect(vppMsg.PciAddr.Domain).To(BeEquivalentTo(0))
+			Expect(vppMsg.PciAddr.Bus).To(BeEquivalentTo(0))
+			Expect(vppMsg.PciAddr.Slot).To(BeEquivalentTo(0x1a))
+			Expect(vppMsg.PciAddr.Function).To(BeEquivalentTo(2))
+			Expect(vppMsg.Features).To(BeEquivalentTo(0x10000))
+			Expect(vppMsg.VirtioFlags).To(Equal(vpp_virtio.VIRTIO_API_FLAG_GSO | vpp_virtio.VIRTIO_API_FLAG_PACKED))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddVirtioPCIInterfaceInvalidAddress(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	_, err := ifHandler.AddVirtioPCIInterface(ctx.Context, "virtio1", &ifs.VirtioPCILink{
+		PciAddress: "not-an-address",
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteVirtioPCIInterface(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+	ctx.MockVpp.MockReply(&vpp_virtio.VirtioPciDeleteReply{})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeleteVirtioPCIInterface(ctx.Context, "virtio1", 4)
+	Expect(err).To(BeNil())
+
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_virtio.VirtioPciDelete)
+		if ok {
+			Expect(vppMsg.SwIfIndex).To(BeEquivalentTo(4))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
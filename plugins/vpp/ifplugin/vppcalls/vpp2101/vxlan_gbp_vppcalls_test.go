@@ -0,0 +1,132 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_ifs "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	vpp_vxlangbp "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vxlan_gbp"
+
+	ifs "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+)
+
+func TestAddVxlanGbpTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vxlangbp.VxlanGbpTunnelAddDelReply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	swIfIdx, err := ifHandler.AddVxlanGbpTunnel(ctx.Context, "vxlangbp1", 10, 1, &ifs.VxlanGbpLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "239.1.1.1",
+		Vni:        200,
+		Multicast:  "mcast-if",
+	})
+	Expect(err).To(BeNil())
+	Expect(swIfIdx).To(BeEquivalentTo(2))
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_vxlangbp.VxlanGbpTunnelAddDel)
+		if ok {
+			Expect(vppMsg.IsAdd).To(BeTrue())
+			Expect(vppMsg.Tunnel.Instance).To(BeEquivalentTo(^uint32(0)))
+			Expect(vppMsg.Tunnel.Src.Un.GetIP4()).To(BeEquivalentTo(ip_types.IP4Address{10, 0, 0, 1}))
+			Expect(vppMsg.Tunnel.Dst.Un.GetIP4()).To(BeEquivalentTo(ip_types.IP4Address{239, 1, 1, 1}))
+			Expect(vppMsg.Tunnel.McastSwIfIndex).To(BeEquivalentTo(1))
+			Expect(vppMsg.Tunnel.EncapTableID).To(BeEquivalentTo(10))
+			Expect(vppMsg.Tunnel.Vni).To(BeEquivalentTo(200))
+			Expect(vppMsg.Tunnel.Mode).To(BeEquivalentTo(vpp_vxlangbp.VXLAN_GBP_API_TUNNEL_MODE_L2))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddVxlanGbpTunnelL3Mode(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vxlangbp.VxlanGbpTunnelAddDelReply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	_, err := ifHandler.AddVxlanGbpTunnel(ctx.Context, "vxlangbp1", 0, 0xFFFFFFFF, &ifs.VxlanGbpLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "20.0.0.1",
+		Vni:        200,
+		L3Mode:     true,
+	})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_vxlangbp.VxlanGbpTunnelAddDel)
+		if ok {
+			Expect(vppMsg.Tunnel.Mode).To(BeEquivalentTo(vpp_vxlangbp.VXLAN_GBP_API_TUNNEL_MODE_L3))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
+
+func TestAddVxlanGbpTunnelError(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vxlangbp.VxlanGbpTunnelAddDelReply{
+		Retval: 1,
+	})
+
+	_, err := ifHandler.AddVxlanGbpTunnel(ctx.Context, "vxlangbp1", 0, 0xFFFFFFFF, &ifs.VxlanGbpLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "20.0.0.1",
+		Vni:        200,
+	})
+	Expect(err).ToNot(BeNil())
+}
+
+func TestDeleteVxlanGbpTunnel(t *testing.T) {
+	ctx, ifHandler := ifTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_vxlangbp.VxlanGbpTunnelAddDelReply{
+		SwIfIndex: 2,
+	})
+	ctx.MockVpp.MockReply(&vpp_ifs.SwInterfaceTagAddDelReply{})
+
+	err := ifHandler.DeleteVxlanGbpTunnel(ctx.Context, "vxlangbp1", 2, 0, &ifs.VxlanGbpLink{
+		SrcAddress: "10.0.0.1",
+		DstAddress: "20.0.0.1",
+		Vni:        200,
+	})
+	Expect(err).To(BeNil())
+	var msgCheck bool
+	for _, msg := range ctx.MockChannel.Msgs {
+		vppMsg, ok := msg.(*vpp_vxlangbp.VxlanGbpTunnelAddDel)
+		if ok {
+			Expect(vppMsg.IsAdd).To(BeFalse())
+			Expect(vppMsg.Tunnel.Vni).To(BeEquivalentTo(200))
+			msgCheck = true
+		}
+	}
+	Expect(msgCheck).To(BeTrue())
+}
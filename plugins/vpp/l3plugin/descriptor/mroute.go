@@ -0,0 +1,246 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	ifdescriptor "go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/descriptor"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	interfaces "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/interfaces"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+const (
+	// MRouteDescriptorName is the name of the descriptor for multicast routes.
+	MRouteDescriptorName = "vpp-mroute"
+
+	// dependency labels
+	mrouteInterfaceDep = "interface-exists-"
+	mrouteVrfTableDep  = "vrf-table-exists"
+)
+
+// MRouteDescriptor teaches KVScheduler how to configure VPP multicast routes.
+type MRouteDescriptor struct {
+	log           logging.Logger
+	mrouteHandler vppcalls.MRouteVppAPI
+}
+
+// NewMRouteDescriptor creates a new instance of the MRoute descriptor.
+func NewMRouteDescriptor(mrouteHandler vppcalls.MRouteVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &MRouteDescriptor{
+		mrouteHandler: mrouteHandler,
+		log:           log.NewLogger("mroute-descriptor"),
+	}
+
+	typedDescr := &adapter.MRouteDescriptor{
+		Name:            MRouteDescriptorName,
+		NBKeyPrefix:     l3.ModelMRoute.KeyPrefix(),
+		ValueTypeName:   l3.ModelMRoute.ProtoName(),
+		KeySelector:     l3.ModelMRoute.IsKeyValid,
+		KeyLabel:        l3.ModelMRoute.StripKeyPrefix,
+		ValueComparator: ctx.EquivalentMRoutes,
+		Validate:        ctx.Validate,
+		Create:          ctx.Create,
+		Delete:          ctx.Delete,
+		Retrieve:        ctx.Retrieve,
+		Dependencies:    ctx.Dependencies,
+		RetrieveDependencies: []string{
+			ifdescriptor.InterfaceDescriptorName,
+			VrfTableDescriptorName},
+	}
+	return adapter.NewMRouteDescriptor(typedDescr)
+}
+
+// EquivalentMRoutes compares multicast routes, the order of paths is irrelevant.
+func (d *MRouteDescriptor) EquivalentMRoutes(key string, oldMRoute, newMRoute *l3.MRoute) bool {
+	if oldMRoute.GetVrfId() != newMRoute.GetVrfId() ||
+		oldMRoute.GetRpfId() != newMRoute.GetRpfId() ||
+		oldMRoute.GetSignal() != newMRoute.GetSignal() ||
+		oldMRoute.GetDrop() != newMRoute.GetDrop() ||
+		oldMRoute.GetConnected() != newMRoute.GetConnected() ||
+		oldMRoute.GetAcceptAllInterfaces() != newMRoute.GetAcceptAllInterfaces() {
+		return false
+	}
+	if !equalNetworks(oldMRoute.GetGroupAddress(), newMRoute.GetGroupAddress()) ||
+		!equalAddrs(oldMRoute.GetSourceAddress(), newMRoute.GetSourceAddress()) {
+		return false
+	}
+	if len(oldMRoute.GetPaths()) != len(newMRoute.GetPaths()) {
+		return false
+	}
+	for _, oldPath := range oldMRoute.GetPaths() {
+		var found bool
+		for _, newPath := range newMRoute.GetPaths() {
+			if equalMRoutePaths(oldPath, newPath) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate validates VPP multicast route configuration.
+func (d *MRouteDescriptor) Validate(key string, mroute *l3.MRoute) error {
+	grpIP, grpNet, err := net.ParseCIDR(mroute.GroupAddress)
+	if err != nil {
+		return kvs.NewInvalidValueError(err, "group_address")
+	}
+	if !grpIP.IsMulticast() {
+		return kvs.NewInvalidValueError(
+			fmt.Errorf("group address %s is not multicast address", mroute.GroupAddress), "group_address")
+	}
+	if strings.ToLower(grpNet.String()) != strings.ToLower(mroute.GroupAddress) {
+		return kvs.NewInvalidValueError(
+			fmt.Errorf("group address (%s) must represent IP network (%s)", mroute.GroupAddress, grpNet),
+			"group_address")
+	}
+	isIPv6 := grpIP.To4() == nil
+
+	if mroute.SourceAddress != "" {
+		srcIP := net.ParseIP(mroute.SourceAddress)
+		if srcIP == nil {
+			return kvs.NewInvalidValueError(
+				fmt.Errorf("invalid source address %q", mroute.SourceAddress), "source_address")
+		}
+		if srcIP.IsMulticast() {
+			return kvs.NewInvalidValueError(
+				fmt.Errorf("source address %s cannot be multicast address", mroute.SourceAddress), "source_address")
+		}
+		if (srcIP.To4() == nil) != isIPv6 {
+			return kvs.NewInvalidValueError(
+				errors.New("source and group address must be of the same IP version"), "source_address")
+		}
+	}
+
+	if len(mroute.Paths) == 0 {
+		return kvs.NewInvalidValueError(errors.New("at least one accepting or forwarding path must be defined"), "paths")
+	}
+	for _, path := range mroute.Paths {
+		if path.Interface == "" {
+			return kvs.NewInvalidValueError(errors.New("path interface is not defined"), "paths.interface")
+		}
+		if path.NextHopAddr != "" {
+			nextHop := net.ParseIP(path.NextHopAddr)
+			if nextHop == nil {
+				return kvs.NewInvalidValueError(
+					fmt.Errorf("invalid next hop address %q", path.NextHopAddr), "paths.next_hop_addr")
+			}
+			if (nextHop.To4() == nil) != isIPv6 {
+				return kvs.NewInvalidValueError(
+					errors.New("next hop and group address must be of the same IP version"), "paths.next_hop_addr")
+			}
+		}
+	}
+	return nil
+}
+
+// Create adds VPP multicast route.
+func (d *MRouteDescriptor) Create(key string, mroute *l3.MRoute) (metadata interface{}, err error) {
+	err = d.mrouteHandler.VppAddMRoute(context.TODO(), mroute)
+	if err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Delete removes VPP multicast route.
+func (d *MRouteDescriptor) Delete(key string, mroute *l3.MRoute, metadata interface{}) error {
+	return d.mrouteHandler.VppDelMRoute(context.TODO(), mroute)
+}
+
+// Retrieve returns all multicast routes from VRF tables managed by this agent.
+func (d *MRouteDescriptor) Retrieve(correlate []adapter.MRouteKVWithMetadata) (
+	retrieved []adapter.MRouteKVWithMetadata, err error,
+) {
+	nbCfg := make(map[string]*l3.MRoute)
+	for _, kv := range correlate {
+		nbCfg[models.Key(kv.Value)] = kv.Value
+	}
+
+	mroutes, err := d.mrouteHandler.DumpMRoutes()
+	if err != nil {
+		return nil, errors.Errorf("failed to dump VPP multicast routes: %v", err)
+	}
+
+	for _, mroute := range mroutes {
+		key := models.Key(mroute.MRoute)
+		value := mroute.MRoute
+		origin := kvs.UnknownOrigin
+
+		// correlate with the expected configuration
+		if nbValue, hasNbCfg := nbCfg[key]; hasNbCfg {
+			if d.EquivalentMRoutes(key, value, nbValue) {
+				value = nbValue
+				origin = kvs.FromNB
+			}
+		}
+
+		retrieved = append(retrieved, adapter.MRouteKVWithMetadata{
+			Key:    key,
+			Value:  value,
+			Origin: origin,
+		})
+	}
+	return retrieved, nil
+}
+
+// Dependencies lists dependencies for a VPP multicast route.
+func (d *MRouteDescriptor) Dependencies(key string, mroute *l3.MRoute) (deps []kvs.Dependency) {
+	// accepting and forwarding interfaces must exist
+	for _, path := range mroute.Paths {
+		deps = append(deps, kvs.Dependency{
+			Label: mrouteInterfaceDep + path.Interface,
+			Key:   interfaces.InterfaceKey(path.Interface),
+		})
+	}
+
+	// non-zero VRF must exist
+	if mroute.VrfId != 0 {
+		var protocol l3.VrfTable_Protocol
+		if grpIP, _, err := net.ParseCIDR(mroute.GroupAddress); err == nil && grpIP.To4() == nil {
+			protocol = l3.VrfTable_IPV6
+		}
+		deps = append(deps, kvs.Dependency{
+			Label: mrouteVrfTableDep,
+			Key:   l3.VrfTableKey(mroute.VrfId, protocol),
+		})
+	}
+	return deps
+}
+
+// equalMRoutePaths compares two multicast route paths for equality.
+func equalMRoutePaths(path1, path2 *l3.MRoute_Path) bool {
+	return path1.GetInterface() == path2.GetInterface() &&
+		path1.GetAccept() == path2.GetAccept() &&
+		path1.GetForward() == path2.GetForward() &&
+		path1.GetSignalPresent() == path2.GetSignalPresent() &&
+		path1.GetNegateSignal() == path2.GetNegateSignal() &&
+		path1.GetDontPreserve() == path2.GetDontPreserve() &&
+		equalAddrs(path1.GetNextHopAddr(), path2.GetNextHopAddr())
+}
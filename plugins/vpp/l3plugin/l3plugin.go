@@ -22,6 +22,7 @@
 //go:generate descriptor-adapter --descriptor-name L3XC --value-type *vpp_l3.L3XConnect --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name TeibEntry --value-type *vpp_l3.TeibEntry --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name VRRPEntry --value-type *vpp_l3.VRRPEntry --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name MRoute --value-type *vpp_l3.MRoute --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3" --output-dir "descriptor"
 
 package l3plugin
 
@@ -108,6 +109,7 @@ func (p *L3Plugin) Init() (err error) {
 	l3xcDescriptor := descriptor.NewL3XCDescriptor(p.l3Handler, p.IfPlugin.GetInterfaceIndex(), p.Log)
 	teibDescriptor := descriptor.NewTeibDescriptor(p.KVScheduler, p.l3Handler, p.Log)
 	vrrpDescriptor := descriptor.NewVrrpDescriptor(p.l3Handler, p.Log)
+	mrouteDescriptor := descriptor.NewMRouteDescriptor(p.l3Handler, p.Log)
 
 	err = p.Deps.KVScheduler.RegisterKVDescriptor(
 		routeDescriptor,
@@ -119,6 +121,7 @@ func (p *L3Plugin) Init() (err error) {
 		l3xcDescriptor,
 		teibDescriptor,
 		vrrpDescriptor,
+		mrouteDescriptor,
 	)
 	if err != nil {
 		return err
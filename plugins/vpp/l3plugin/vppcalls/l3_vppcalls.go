@@ -51,6 +51,7 @@ type L3VppAPI interface {
 	L3XCVppAPI
 	TeibVppAPI
 	VrrpVppAPI
+	MRouteVppAPI
 }
 
 // ArpDetails holds info about ARP entry as a proto model
@@ -182,6 +183,40 @@ type RouteMeta struct {
 // VrrpMeta holds fields returned from the VPP as details which are not in the model
 type VrrpMeta struct{}
 
+// MRouteDetails is object returned as a VPP dump. It contains multicast route data
+// in proto format, and VPP-specific metadata.
+type MRouteDetails struct {
+	MRoute *l3.MRoute
+	Meta   *MRouteMeta
+}
+
+// MRouteMeta holds fields returned from the VPP as details which are not in the model
+type MRouteMeta struct {
+	TableName string
+	IsIPv6    bool
+	// PathIfIndexes contains interface indexes of the multicast route paths
+	// in the order as they are listed in the model.
+	PathIfIndexes []uint32
+}
+
+// defaultMRouteGroups are groups of (*,G) entries which VPP creates in every
+// multicast FIB table: the default entry dropping unmatched traffic and IPv6
+// link-local groups (all-nodes, all-routers, MLDv2 reports, solicited-node).
+var defaultMRouteGroups = map[string]bool{
+	"0.0.0.0/0":          true,
+	"::/0":               true,
+	"ff02::1/128":        true,
+	"ff02::2/128":        true,
+	"ff02::16/128":       true,
+	"ff02::1:ff00:0/104": true,
+}
+
+// IsDefaultMRoute returns true for multicast routes created by VPP
+// in every multicast FIB table, which are not configured by the agent.
+func IsDefaultMRoute(mroute *l3.MRoute) bool {
+	return mroute.SourceAddress == "" && defaultMRouteGroups[mroute.GroupAddress]
+}
+
 // RouteVppAPI provides methods for managing routes
 type RouteVppAPI interface {
 	RouteVppRead
@@ -254,6 +289,24 @@ type VrrpVppAPI interface {
 	DumpVrrpEntries() ([]*VrrpDetails, error)
 }
 
+// MRouteVppAPI provides methods for managing IP multicast routes.
+type MRouteVppAPI interface {
+	MRouteVppRead
+
+	// VppAddMRoute adds new multicast route, according to provided input.
+	// Every multicast route has to contain VRF ID (default is 0).
+	VppAddMRoute(ctx context.Context, mroute *l3.MRoute) error
+	// VppDelMRoute removes old multicast route, according to provided input.
+	// Every multicast route has to contain VRF ID (default is 0).
+	VppDelMRoute(ctx context.Context, mroute *l3.MRoute) error
+}
+
+// MRouteVppRead provides read methods for multicast routes.
+type MRouteVppRead interface {
+	// DumpMRoutes dumps multicast routes from all VRF tables known to the agent.
+	DumpMRoutes() ([]*MRouteDetails, error)
+}
+
 // Path represents FIB path entry.
 type Path struct {
 	SwIfIndex  uint32
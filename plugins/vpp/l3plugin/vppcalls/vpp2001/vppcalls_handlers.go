@@ -60,6 +60,7 @@ type L3VppHandler struct {
 	*L3XCHandler
 	*TeibHandlerUnsupported
 	*VrrpVppHandler
+	*MRouteHandler
 }
 
 func NewL3VppHandler(
@@ -84,6 +85,7 @@ func NewL3VppHandler(
 		L3XCHandler:            NewL3XCHandler(c, ifIdx, log),
 		VrrpVppHandler:         NewVrrpVppHandler(ch, log),
 		TeibHandlerUnsupported: &TeibHandlerUnsupported{},
+		MRouteHandler:          NewMRouteVppHandler(ch, ifIdx, vrfIdx, log),
 	}
 }
 
@@ -135,6 +137,14 @@ type VrrpVppHandler struct {
 	log          logging.Logger
 }
 
+// MRouteHandler is accessor for multicast route-related vppcalls methods
+type MRouteHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	vrfIndexes   vrfidx.VRFMetadataIndex
+	log          logging.Logger
+}
+
 // NewArpVppHandler creates new instance of IPsec vppcalls handler
 func NewArpVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger) *ArpVppHandler {
 	if log == nil {
@@ -259,6 +269,20 @@ func (h *TeibHandlerUnsupported) DumpTeib() ([]*l3.TeibEntry, error) {
 	return nil, fmt.Errorf("%w in VPP %s", vppcalls.ErrTeibUnsupported, vpp2001.Version)
 }
 
+// NewMRouteVppHandler creates new instance of multicast route vppcalls handler
+func NewMRouteVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex,
+	vrfIdx vrfidx.VRFMetadataIndex, log logging.Logger) *MRouteHandler {
+	if log == nil {
+		log = logrus.NewLogger("mroute-handler")
+	}
+	return &MRouteHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		vrfIndexes:   vrfIdx,
+		log:          log,
+	}
+}
+
 func ipToAddress(ipstr string) (addr vpp_ip.Address, err error) {
 	netIP := net.ParseIP(ipstr)
 	if netIP == nil {
@@ -0,0 +1,185 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2005
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2005/fib_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2005/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2005/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2005/mfib_types"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// Multicast entry flags (mfib_entry_flags_t), the enum is not included
+// in the binary API of this VPP version.
+const (
+	mfibEntryFlagSignal uint32 = 1 << iota
+	mfibEntryFlagDrop
+	mfibEntryFlagConnected
+	mfibEntryFlagAcceptAllItf
+)
+
+// VppAddMRoute implements multicast route handler.
+func (h *MRouteHandler) VppAddMRoute(ctx context.Context, mroute *l3.MRoute) error {
+	return h.vppAddDelMRoute(mroute, false)
+}
+
+// VppDelMRoute implements multicast route handler.
+func (h *MRouteHandler) VppDelMRoute(ctx context.Context, mroute *l3.MRoute) error {
+	return h.vppAddDelMRoute(mroute, true)
+}
+
+// vppAddDelMRoute adds or removes multicast route, according to provided input.
+// Removal of all the paths of the entry deletes the entry from the multicast FIB.
+func (h *MRouteHandler) vppAddDelMRoute(mroute *l3.MRoute, delete bool) error {
+	prefix, isIPv6, err := mrouteToMprefix(mroute)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]mfib_types.MfibPath, 0, len(mroute.Paths))
+	for _, path := range mroute.Paths {
+		meta, found := h.ifIndexes.LookupByName(path.Interface)
+		if !found {
+			return errors.Errorf("interface %s not found", path.Interface)
+		}
+		fibPath := fib_types.FibPath{
+			SwIfIndex: meta.SwIfIndex,
+			TableID:   mroute.VrfId,
+			RpfID:     mroute.RpfId,
+		}
+		if path.NextHopAddr != "" {
+			nextHop := net.ParseIP(path.NextHopAddr)
+			if nextHop == nil {
+				return errors.Errorf("invalid next hop address %q", path.NextHopAddr)
+			}
+			fibPath.Nh, fibPath.Proto = setFibPathNhAndProto(nextHop)
+		} else {
+			fibPath.Nh.ViaLabel = NextHopViaLabelUnset
+			fibPath.Nh.ClassifyTableIndex = ClassifyTableIndexUnset
+			fibPath.Proto = fib_types.FIB_API_PATH_NH_PROTO_IP4
+			if isIPv6 {
+				fibPath.Proto = fib_types.FIB_API_PATH_NH_PROTO_IP6
+			}
+		}
+		paths = append(paths, mfib_types.MfibPath{
+			ItfFlags: mrouteItfFlags(path),
+			Path:     fibPath,
+		})
+	}
+
+	req := &vpp_ip.IPMrouteAddDel{
+		IsAdd:       !delete,
+		IsMultipath: true,
+		Route: vpp_ip.IPMroute{
+			TableID:    mroute.VrfId,
+			EntryFlags: mrouteEntryFlags(mroute),
+			RpfID:      mroute.RpfId,
+			Prefix:     prefix,
+			NPaths:     uint8(len(paths)),
+			Paths:      paths,
+		},
+	}
+	if delete {
+		// VPP applies entry flags also when removing paths, the entry with
+		// flags set would be kept in the multicast FIB without any path
+		req.Route.EntryFlags = 0
+	}
+	reply := &vpp_ip.IPMrouteAddDelReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// mrouteToMprefix converts group and source address of the multicast route to VPP mprefix.
+func mrouteToMprefix(mroute *l3.MRoute) (prefix ip_types.Mprefix, isIPv6 bool, err error) {
+	grpIP, grpNet, err := net.ParseCIDR(mroute.GroupAddress)
+	if err != nil {
+		return prefix, false, errors.Errorf("invalid group address %q: %v", mroute.GroupAddress, err)
+	}
+	var srcIP net.IP
+	if mroute.SourceAddress != "" {
+		if srcIP = net.ParseIP(mroute.SourceAddress); srcIP == nil {
+			return prefix, false, errors.Errorf("invalid source address %q", mroute.SourceAddress)
+		}
+	}
+	grpLen, _ := grpNet.Mask.Size()
+	prefix.GrpAddressLength = uint16(grpLen)
+
+	if grpIP.To4() == nil {
+		isIPv6 = true
+		prefix.Af = ip_types.ADDRESS_IP6
+		var grp ip_types.IP6Address
+		copy(grp[:], grpIP.To16())
+		prefix.GrpAddress.SetIP6(grp)
+		if srcIP != nil {
+			var src ip_types.IP6Address
+			copy(src[:], srcIP.To16())
+			prefix.SrcAddress.SetIP6(src)
+		}
+	} else {
+		prefix.Af = ip_types.ADDRESS_IP4
+		var grp ip_types.IP4Address
+		copy(grp[:], grpIP.To4())
+		prefix.GrpAddress.SetIP4(grp)
+		if srcIP != nil {
+			var src ip_types.IP4Address
+			copy(src[:], srcIP.To4())
+			prefix.SrcAddress.SetIP4(src)
+		}
+	}
+	return prefix, isIPv6, nil
+}
+
+func mrouteEntryFlags(mroute *l3.MRoute) (flags uint32) {
+	if mroute.Signal {
+		flags |= mfibEntryFlagSignal
+	}
+	if mroute.Drop {
+		flags |= mfibEntryFlagDrop
+	}
+	if mroute.Connected {
+		flags |= mfibEntryFlagConnected
+	}
+	if mroute.AcceptAllInterfaces {
+		flags |= mfibEntryFlagAcceptAllItf
+	}
+	return flags
+}
+
+func mrouteItfFlags(path *l3.MRoute_Path) (flags mfib_types.MfibItfFlags) {
+	if path.NegateSignal {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_NEGATE_SIGNAL
+	}
+	if path.Accept {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_ACCEPT
+	}
+	if path.Forward {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_FORWARD
+	}
+	if path.SignalPresent {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_SIGNAL_PRESENT
+	}
+	if path.DontPreserve {
+		flags |= mfib_types.MFIB_API_ITF_FLAG_DONT_PRESERVE
+	}
+	return flags
+}
@@ -58,6 +58,7 @@ type L3VppHandler struct {
 	*L3XCHandler
 	*TeibHandler
 	*VrrpVppHandler
+	*MRouteHandler
 }
 
 func NewL3VppHandler(
@@ -82,6 +83,7 @@ func NewL3VppHandler(
 		L3XCHandler:        NewL3XCHandler(c, ifIdx, log),
 		TeibHandler:        NewTeibVppHandler(ch, ifIdx, log),
 		VrrpVppHandler:     NewVrrpVppHandler(ch, ifIdx, log),
+		MRouteHandler:      NewMRouteVppHandler(ch, ifIdx, vrfIdx, log),
 	}
 }
 
@@ -141,6 +143,14 @@ type VrrpVppHandler struct {
 	log          logging.Logger
 }
 
+// MRouteHandler is accessor for multicast route-related vppcalls methods
+type MRouteHandler struct {
+	callsChannel govppapi.Channel
+	ifIndexes    ifaceidx.IfaceMetadataIndex
+	vrfIndexes   vrfidx.VRFMetadataIndex
+	log          logging.Logger
+}
+
 // NewArpVppHandler creates new instance of IPsec vppcalls handler
 func NewArpVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex, log logging.Logger) *ArpVppHandler {
 	if log == nil {
@@ -259,6 +269,20 @@ func NewVrrpVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetad
 	}
 }
 
+// NewMRouteVppHandler creates new instance of multicast route vppcalls handler
+func NewMRouteVppHandler(callsChan govppapi.Channel, ifIndexes ifaceidx.IfaceMetadataIndex,
+	vrfIdx vrfidx.VRFMetadataIndex, log logging.Logger) *MRouteHandler {
+	if log == nil {
+		log = logrus.NewLogger("mroute-handler")
+	}
+	return &MRouteHandler{
+		callsChannel: callsChan,
+		ifIndexes:    ifIndexes,
+		vrfIndexes:   vrfIdx,
+		log:          log,
+	}
+}
+
 func ipToAddress(ipstr string) (addr ip_types.Address, err error) {
 	netIP := net.ParseIP(ipstr)
 	if netIP == nil {
@@ -0,0 +1,136 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101
+
+import (
+	"fmt"
+	"net"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/fib_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/mfib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+// DumpMRoutes implements multicast route handler.
+func (h *MRouteHandler) DumpMRoutes() (mroutes []*vppcalls.MRouteDetails, err error) {
+	// dump multicast routes for every VRF and for both IP versions
+	for _, vrfMeta := range h.vrfIndexes.ListAllVrfMetadata() {
+		ipMRoutes, err := h.dumpMRoutesForVrfAndIP(vrfMeta.GetIndex(), vrfMeta.GetProtocol())
+		if err != nil {
+			return nil, err
+		}
+		mroutes = append(mroutes, ipMRoutes...)
+	}
+	return mroutes, nil
+}
+
+// dumpMRoutesForVrfAndIP returns multicast routes for given VRF and IP version.
+func (h *MRouteHandler) dumpMRoutesForVrfAndIP(vrfID uint32, proto l3.VrfTable_Protocol) (mroutes []*vppcalls.MRouteDetails, err error) {
+	reqCtx := h.callsChannel.SendMultiRequest(&vpp_ip.IPMrouteDump{
+		Table: vpp_ip.IPTable{
+			TableID: vrfID,
+			IsIP6:   protoToUint(proto),
+		},
+	})
+	for {
+		details := &vpp_ip.IPMrouteDetails{}
+		stop, err := reqCtx.ReceiveReply(details)
+		if stop {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		mroute := h.dumpMRouteDetails(details.Route)
+		// skip entries created by VPP in every multicast FIB table
+		if vppcalls.IsDefaultMRoute(mroute.MRoute) {
+			continue
+		}
+		mroutes = append(mroutes, mroute)
+	}
+	return mroutes, nil
+}
+
+// dumpMRouteDetails converts multicast route details to the proto model.
+func (h *MRouteHandler) dumpMRouteDetails(ipMRoute vpp_ip.IPMroute) *vppcalls.MRouteDetails {
+	var grpIP, srcIP net.IP
+	isIPv6 := ipMRoute.Prefix.Af == ip_types.ADDRESS_IP6
+	if isIPv6 {
+		grp := ipMRoute.Prefix.GrpAddress.GetIP6()
+		src := ipMRoute.Prefix.SrcAddress.GetIP6()
+		grpIP, srcIP = net.IP(grp[:]).To16(), net.IP(src[:]).To16()
+	} else {
+		grp := ipMRoute.Prefix.GrpAddress.GetIP4()
+		src := ipMRoute.Prefix.SrcAddress.GetIP4()
+		grpIP, srcIP = net.IP(grp[:]).To4(), net.IP(src[:]).To4()
+	}
+
+	mroute := &l3.MRoute{
+		VrfId:               ipMRoute.TableID,
+		GroupAddress:        fmt.Sprintf("%s/%d", grpIP.String(), ipMRoute.Prefix.GrpAddressLength),
+		RpfId:               ipMRoute.RpfID,
+		Signal:              ipMRoute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_SIGNAL != 0,
+		Drop:                ipMRoute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_DROP != 0,
+		Connected:           ipMRoute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_CONNECTED != 0,
+		AcceptAllInterfaces: ipMRoute.EntryFlags&mfib_types.MFIB_API_ENTRY_FLAG_ACCEPT_ALL_ITF != 0,
+	}
+	// (*,G) entries are dumped with zero source address
+	if !srcIP.IsUnspecified() {
+		mroute.SourceAddress = srcIP.String()
+	}
+
+	meta := &vppcalls.MRouteMeta{
+		IsIPv6: isIPv6,
+	}
+	for _, path := range ipMRoute.Paths {
+		ifName, _, exists := h.ifIndexes.LookupBySwIfIndex(path.Path.SwIfIndex)
+		if !exists {
+			h.log.Warnf("Multicast route dump: interface name for index %d not found", path.Path.SwIfIndex)
+		}
+		var nextHopIP string
+		if path.Path.Proto == fib_types.FIB_API_PATH_NH_PROTO_IP6 {
+			nh := path.Path.Nh.Address.GetIP6()
+			if ip := net.IP(nh[:]).To16(); !ip.IsUnspecified() {
+				nextHopIP = ip.String()
+			}
+		} else {
+			nh := path.Path.Nh.Address.GetIP4()
+			if ip := net.IP(nh[:]).To4(); !ip.IsUnspecified() {
+				nextHopIP = ip.String()
+			}
+		}
+		mroute.Paths = append(mroute.Paths, &l3.MRoute_Path{
+			Interface:     ifName,
+			NextHopAddr:   nextHopIP,
+			Accept:        path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_ACCEPT != 0,
+			Forward:       path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_FORWARD != 0,
+			SignalPresent: path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_SIGNAL_PRESENT != 0,
+			NegateSignal:  path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_NEGATE_SIGNAL != 0,
+			DontPreserve:  path.ItfFlags&mfib_types.MFIB_API_ITF_FLAG_DONT_PRESERVE != 0,
+		})
+		meta.PathIfIndexes = append(meta.PathIfIndexes, path.Path.SwIfIndex)
+	}
+	if vrfName, _, exists := h.vrfIndexes.LookupByVRFIndex(ipMRoute.TableID); exists {
+		meta.TableName = vrfName
+	}
+
+	return &vppcalls.MRouteDetails{
+		MRoute: mroute,
+		Meta:   meta,
+	}
+}
@@ -0,0 +1,214 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.ligato.io/cn-infra/v2/logging/logrus"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/fib_types"
+	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/mfib_types"
+	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vpe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vppcalls/vpp2101"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/l3plugin/vrfidx"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/vppmock"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+)
+
+var mroutes = []*l3.MRoute{
+	{
+		GroupAddress:  "232.1.1.1/32",
+		SourceAddress: "10.0.0.1",
+		Paths: []*l3.MRoute_Path{
+			{Interface: "if1", Accept: true},
+			{Interface: "if2", Forward: true},
+		},
+	},
+	{
+		VrfId:        1,
+		GroupAddress: "ff0e::1/128",
+		Connected:    true,
+		Paths: []*l3.MRoute_Path{
+			{Interface: "if2", NextHopAddr: "2001:db8::1", Forward: true},
+		},
+	},
+}
+
+// Test adding of the multicast route
+func TestAddMRoute(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err := mrouteHandler.VppAddMRoute(context.TODO(), mroutes[0])
+	Expect(err).To(Succeed())
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err = mrouteHandler.VppAddMRoute(context.TODO(), mroutes[1])
+	Expect(err).To(Succeed())
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{Retval: 1})
+	err = mrouteHandler.VppAddMRoute(context.TODO(), mroutes[0])
+	Expect(err).To(Not(BeNil()))
+}
+
+// Test adding of the multicast route with unknown interface
+func TestAddMRouteUnknownInterface(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	err := mrouteHandler.VppAddMRoute(context.TODO(), &l3.MRoute{
+		GroupAddress: "232.1.1.1/32",
+		Paths:        []*l3.MRoute_Path{{Interface: "if3", Forward: true}},
+	})
+	Expect(err).To(Not(BeNil()))
+}
+
+// Test deletion of the multicast route
+func TestDelMRoute(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err := mrouteHandler.VppDelMRoute(context.TODO(), mroutes[0])
+	Expect(err).To(Succeed())
+
+	// entry flags are not set when deleting the entry
+	ctx.MockVpp.MockReply(&vpp_ip.IPMrouteAddDelReply{})
+	err = mrouteHandler.VppDelMRoute(context.TODO(), &l3.MRoute{
+		GroupAddress:        "232.1.1.1/32",
+		Signal:              true,
+		Drop:                true,
+		Connected:           true,
+		AcceptAllInterfaces: true,
+		Paths:               []*l3.MRoute_Path{{Interface: "if1", Accept: true}},
+	})
+	Expect(err).To(Succeed())
+	req, ok := ctx.MockChannel.Msg.(*vpp_ip.IPMrouteAddDel)
+	Expect(ok).To(BeTrue())
+	Expect(req.IsAdd).To(BeFalse())
+	Expect(req.Route.EntryFlags).To(Equal(mfib_types.MFIB_API_ENTRY_FLAG_NONE))
+	Expect(req.Route.Paths).To(HaveLen(1))
+	Expect(req.Route.Paths[0].ItfFlags).To(Equal(mfib_types.MFIB_API_ITF_FLAG_ACCEPT))
+}
+
+// Test dumping of the multicast routes
+func TestDumpMRoutes(t *testing.T) {
+	ctx, mrouteHandler := mrouteTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ip4Mprefix := func(grp, src [4]uint8, length uint16) ip_types.Mprefix {
+		return ip_types.Mprefix{
+			Af:               ip_types.ADDRESS_IP4,
+			GrpAddressLength: length,
+			GrpAddress:       ip_types.AddressUnionIP4(grp),
+			SrcAddress:       ip_types.AddressUnionIP4(src),
+		}
+	}
+	ip6Mprefix := func(grp [16]uint8, length uint16) ip_types.Mprefix {
+		return ip_types.Mprefix{
+			Af:               ip_types.ADDRESS_IP6,
+			GrpAddressLength: length,
+			GrpAddress:       ip_types.AddressUnionIP6(grp),
+		}
+	}
+
+	// IPv4 table: default entry and (S,G) entry
+	ctx.MockVpp.MockReply(
+		&vpp_ip.IPMrouteDetails{Route: vpp_ip.IPMroute{
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_DROP,
+			Prefix:     ip4Mprefix([4]uint8{}, [4]uint8{}, 0),
+		}},
+		&vpp_ip.IPMrouteDetails{Route: vpp_ip.IPMroute{
+			Prefix: ip4Mprefix([4]uint8{232, 1, 1, 1}, [4]uint8{10, 0, 0, 1}, 32),
+			Paths: []mfib_types.MfibPath{
+				{
+					ItfFlags: mfib_types.MFIB_API_ITF_FLAG_ACCEPT,
+					Path:     fib_types.FibPath{SwIfIndex: 1, Proto: fib_types.FIB_API_PATH_NH_PROTO_IP4},
+				},
+				{
+					ItfFlags: mfib_types.MFIB_API_ITF_FLAG_FORWARD,
+					Path:     fib_types.FibPath{SwIfIndex: 2, Proto: fib_types.FIB_API_PATH_NH_PROTO_IP4},
+				},
+			},
+		}})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+	// IPv6 table: default entries, link-local entry with forwarding path and (*,G) entry
+	ctx.MockVpp.MockReply(
+		&vpp_ip.IPMrouteDetails{Route: vpp_ip.IPMroute{
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_DROP,
+			Prefix:     ip6Mprefix([16]uint8{}, 0),
+		}},
+		&vpp_ip.IPMrouteDetails{Route: vpp_ip.IPMroute{
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_ACCEPT_ALL_ITF,
+			Prefix:     ip6Mprefix([16]uint8{0xff, 0x02, 15: 0x01}, 128),
+			Paths: []mfib_types.MfibPath{
+				{
+					ItfFlags: mfib_types.MFIB_API_ITF_FLAG_FORWARD,
+					Path:     fib_types.FibPath{SwIfIndex: 1, Proto: fib_types.FIB_API_PATH_NH_PROTO_IP6},
+				},
+			},
+		}},
+		&vpp_ip.IPMrouteDetails{Route: vpp_ip.IPMroute{
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_ACCEPT_ALL_ITF,
+			Prefix:     ip6Mprefix([16]uint8{0xff, 0x02, 11: 0x01, 12: 0xff}, 104),
+		}},
+		&vpp_ip.IPMrouteDetails{Route: vpp_ip.IPMroute{
+			EntryFlags: mfib_types.MFIB_API_ENTRY_FLAG_CONNECTED,
+			Prefix:     ip6Mprefix([16]uint8{0xff, 0x0e, 15: 0x01}, 128),
+			Paths: []mfib_types.MfibPath{
+				{
+					ItfFlags: mfib_types.MFIB_API_ITF_FLAG_FORWARD,
+					Path:     fib_types.FibPath{SwIfIndex: 2, Proto: fib_types.FIB_API_PATH_NH_PROTO_IP6},
+				},
+			},
+		}})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	details, err := mrouteHandler.DumpMRoutes()
+	Expect(err).To(Succeed())
+	Expect(details).To(HaveLen(2))
+	Expect(details[0].MRoute.GroupAddress).To(Equal("232.1.1.1/32"))
+	Expect(details[0].MRoute.SourceAddress).To(Equal("10.0.0.1"))
+	Expect(details[0].MRoute.Paths).To(HaveLen(2))
+	Expect(details[0].MRoute.Paths[0].Interface).To(Equal("if1"))
+	Expect(details[0].MRoute.Paths[0].Accept).To(BeTrue())
+	Expect(details[0].MRoute.Paths[1].Interface).To(Equal("if2"))
+	Expect(details[0].MRoute.Paths[1].Forward).To(BeTrue())
+	Expect(details[1].MRoute.GroupAddress).To(Equal("ff0e::1/128"))
+	Expect(details[1].MRoute.SourceAddress).To(BeEmpty())
+	Expect(details[1].MRoute.Connected).To(BeTrue())
+	Expect(details[1].Meta.IsIPv6).To(BeTrue())
+}
+
+func mrouteTestSetup(t *testing.T) (*vppmock.TestCtx, vppcalls.MRouteVppAPI) {
+	ctx := vppmock.SetupTestCtx(t)
+	log := logrus.NewLogger("test-log")
+	ifIndexes := ifaceidx.NewIfaceIndex(logrus.NewLogger("test-if"), "test-if")
+	ifIndexes.Put("if1", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+	ifIndexes.Put("if2", &ifaceidx.IfaceMetadata{SwIfIndex: 2})
+	vrfIndexes := vrfidx.NewVRFIndex(logrus.NewLogger("test-vrf"), "test-vrf")
+	vrfIndexes.Put("vrf0-ipv4", &vrfidx.VRFMetadata{Index: 0, Protocol: l3.VrfTable_IPV4})
+	vrfIndexes.Put("vrf0-ipv6", &vrfidx.VRFMetadata{Index: 0, Protocol: l3.VrfTable_IPV6})
+	mrouteHandler := vpp2101.NewMRouteVppHandler(ctx.MockChannel, ifIndexes, vrfIndexes, log)
+	return ctx, mrouteHandler
+}
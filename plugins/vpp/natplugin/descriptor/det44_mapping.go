@@ -0,0 +1,103 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// DET44MappingDescriptorName is the name of the descriptor for deterministic NAT44 mappings.
+	DET44MappingDescriptorName = "vpp-det44-mapping"
+)
+
+// A list of non-retriable errors:
+var (
+	// errInvalidDET44Prefix is returned when inside or outside prefix of DET44 mapping cannot be parsed.
+	errInvalidDET44Prefix = errors.New("invalid IPv4 prefix")
+)
+
+// DET44MappingDescriptor teaches KVScheduler how to add/remove VPP deterministic NAT44 mappings.
+type DET44MappingDescriptor struct {
+	log        logging.Logger
+	natHandler vppcalls.NatVppAPI
+}
+
+// NewDET44MappingDescriptor creates a new instance of the DET44Mapping descriptor.
+func NewDET44MappingDescriptor(natHandler vppcalls.NatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &DET44MappingDescriptor{
+		natHandler: natHandler,
+		log:        log.NewLogger("det44-mapping-descriptor"),
+	}
+	typedDescr := &adapter.DET44MappingDescriptor{
+		Name:          DET44MappingDescriptorName,
+		NBKeyPrefix:   nat.ModelDet44Mapping.KeyPrefix(),
+		ValueTypeName: nat.ModelDet44Mapping.ProtoName(),
+		KeySelector:   nat.ModelDet44Mapping.IsKeyValid,
+		KeyLabel:      nat.ModelDet44Mapping.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+	}
+	return adapter.NewDET44MappingDescriptor(typedDescr)
+}
+
+// Validate validates inside and outside prefix of the DET44 mapping.
+func (d *DET44MappingDescriptor) Validate(key string, mapping *nat.Det44Mapping) error {
+	if ip, _, err := net.ParseCIDR(mapping.InsidePrefix); err != nil || ip.To4() == nil {
+		return kvs.NewInvalidValueError(errInvalidDET44Prefix, "inside_prefix")
+	}
+	if ip, _, err := net.ParseCIDR(mapping.OutsidePrefix); err != nil || ip.To4() == nil {
+		return kvs.NewInvalidValueError(errInvalidDET44Prefix, "outside_prefix")
+	}
+	return nil
+}
+
+// Create adds DET44 mapping.
+func (d *DET44MappingDescriptor) Create(key string, mapping *nat.Det44Mapping) (metadata interface{}, err error) {
+	return nil, d.natHandler.AddDet44Mapping(mapping)
+}
+
+// Delete removes DET44 mapping.
+func (d *DET44MappingDescriptor) Delete(key string, mapping *nat.Det44Mapping, metadata interface{}) error {
+	return d.natHandler.DelDet44Mapping(mapping)
+}
+
+// Retrieve returns DET44 mappings configured in VPP.
+func (d *DET44MappingDescriptor) Retrieve(correlate []adapter.DET44MappingKVWithMetadata) (
+	retrieved []adapter.DET44MappingKVWithMetadata, err error) {
+	mappings, err := d.natHandler.Det44MappingsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, mapping := range mappings {
+		retrieved = append(retrieved, adapter.DET44MappingKVWithMetadata{
+			Key:    models.Key(mapping),
+			Value:  mapping,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"bytes"
+	"net"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64AddressPoolDescriptorName is the name of the descriptor for NAT64 IP address pools.
+	NAT64AddressPoolDescriptorName = "vpp-nat64-address-pool"
+)
+
+// NAT64AddressPoolDescriptor teaches KVScheduler how to add/remove VPP NAT64 IP addresses pools.
+type NAT64AddressPoolDescriptor struct {
+	log        logging.Logger
+	natHandler vppcalls.NatVppAPI
+}
+
+// NewNAT64AddressPoolDescriptor creates a new instance of the NAT64AddressPoolDescriptor.
+func NewNAT64AddressPoolDescriptor(natHandler vppcalls.NatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64AddressPoolDescriptor{
+		natHandler: natHandler,
+		log:        log.NewLogger("nat64-address-pool-descriptor"),
+	}
+	typedDescr := &adapter.NAT64AddressPoolDescriptor{
+		Name:          NAT64AddressPoolDescriptorName,
+		NBKeyPrefix:   nat.ModelNat64AddressPool.KeyPrefix(),
+		ValueTypeName: nat.ModelNat64AddressPool.ProtoName(),
+		KeySelector:   nat.ModelNat64AddressPool.IsKeyValid,
+		KeyLabel:      nat.ModelNat64AddressPool.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewNAT64AddressPoolDescriptor(typedDescr)
+}
+
+// Validate validates configuration for NAT64 IP addresses pool.
+func (d *NAT64AddressPoolDescriptor) Validate(key string, natAddr *nat.Nat64AddressPool) error {
+	firstIP := net.ParseIP(natAddr.FirstIp).To4()
+	if firstIP == nil {
+		return kvs.NewInvalidValueError(errInvalidIPAddress, "first_ip")
+	}
+	if natAddr.LastIp != "" {
+		lastIP := net.ParseIP(natAddr.LastIp).To4()
+		if lastIP == nil {
+			return kvs.NewInvalidValueError(errInvalidIPAddress, "last_ip")
+		}
+		if bytes.Compare(firstIP, lastIP) > 0 {
+			// last IP should be empty or higher than first IP
+			return kvs.NewInvalidValueError(errInvalidLastPoolAddress, "last_ip")
+		}
+	}
+	return nil
+}
+
+// Create adds IP address pool into VPP NAT64 address pools.
+func (d *NAT64AddressPoolDescriptor) Create(key string, natAddr *nat.Nat64AddressPool) (metadata interface{}, err error) {
+	return nil, d.natHandler.AddNat64AddressPool(natAddr.VrfId, natAddr.FirstIp, natAddr.LastIp)
+}
+
+// Delete removes IP address pool from VPP NAT64 address pools.
+func (d *NAT64AddressPoolDescriptor) Delete(key string, natAddr *nat.Nat64AddressPool, metadata interface{}) error {
+	return d.natHandler.DelNat64AddressPool(natAddr.VrfId, natAddr.FirstIp, natAddr.LastIp)
+}
+
+// Retrieve returns NAT64 IP address pools configured on VPP.
+func (d *NAT64AddressPoolDescriptor) Retrieve(correlate []adapter.NAT64AddressPoolKVWithMetadata) (
+	retrieved []adapter.NAT64AddressPoolKVWithMetadata, err error) {
+	natPools, err := d.natHandler.Nat64AddressPoolsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, sbPool := range natPools {
+		pool := sbPool
+		for _, nbPool := range correlate {
+			if nbPool.Value.VrfId == sbPool.VrfId &&
+				equivalentIPv4(nbPool.Value.FirstIp, sbPool.FirstIp) &&
+				equivalentIPv4(nbPool.Value.LastIp, sbPool.LastIp) {
+				pool = nbPool.Value
+				break
+			}
+		}
+		retrieved = append(retrieved, adapter.NAT64AddressPoolKVWithMetadata{
+			Key:    models.Key(pool),
+			Value:  pool,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists non-zero and non-all-ones VRF as the only dependency.
+func (d *NAT64AddressPoolDescriptor) Dependencies(key string, natAddr *nat.Nat64AddressPool) (deps []kvs.Dependency) {
+	if natAddr.VrfId != 0 && natAddr.VrfId != ^uint32(0) {
+		deps = append(deps, kvs.Dependency{
+			Label: addressVrfDep,
+			Key:   l3.VrfTableKey(natAddr.VrfId, l3.VrfTable_IPV4),
+		})
+	}
+	return deps
+}
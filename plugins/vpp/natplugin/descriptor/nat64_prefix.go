@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64IPv6PrefixDescriptorName is the name of the descriptor for NAT64 IPv6 prefixes.
+	NAT64IPv6PrefixDescriptorName = "vpp-nat64-prefix"
+)
+
+// A list of non-retriable errors:
+var (
+	// errInvalidNAT64Prefix is returned when NAT64 prefix cannot be parsed or has unsupported length.
+	errInvalidNAT64Prefix = errors.New("invalid NAT64 prefix (allowed lengths are 32, 40, 48, 56, 64 and 96)")
+)
+
+// NAT64IPv6PrefixDescriptor teaches KVScheduler how to add/remove VPP NAT64 IPv6 prefixes.
+type NAT64IPv6PrefixDescriptor struct {
+	log        logging.Logger
+	natHandler vppcalls.NatVppAPI
+}
+
+// NewNAT64IPv6PrefixDescriptor creates a new instance of the NAT64IPv6Prefix descriptor.
+func NewNAT64IPv6PrefixDescriptor(natHandler vppcalls.NatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64IPv6PrefixDescriptor{
+		natHandler: natHandler,
+		log:        log.NewLogger("nat64-prefix-descriptor"),
+	}
+	typedDescr := &adapter.NAT64IPv6PrefixDescriptor{
+		Name:          NAT64IPv6PrefixDescriptorName,
+		NBKeyPrefix:   nat.ModelNat64IPv6Prefix.KeyPrefix(),
+		ValueTypeName: nat.ModelNat64IPv6Prefix.ProtoName(),
+		KeySelector:   nat.ModelNat64IPv6Prefix.IsKeyValid,
+		KeyLabel:      nat.ModelNat64IPv6Prefix.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewNAT64IPv6PrefixDescriptor(typedDescr)
+}
+
+// Validate validates NAT64 IPv6 prefix.
+func (d *NAT64IPv6PrefixDescriptor) Validate(key string, prefix *nat.Nat64IPv6Prefix) error {
+	ip, ipNet, err := net.ParseCIDR(prefix.Prefix)
+	if err != nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(errInvalidNAT64Prefix, "prefix")
+	}
+	switch ones, _ := ipNet.Mask.Size(); ones {
+	case 32, 40, 48, 56, 64, 96:
+		return nil
+	default:
+		return kvs.NewInvalidValueError(errInvalidNAT64Prefix, "prefix")
+	}
+}
+
+// Create adds NAT64 IPv6 prefix.
+func (d *NAT64IPv6PrefixDescriptor) Create(key string, prefix *nat.Nat64IPv6Prefix) (metadata interface{}, err error) {
+	return nil, d.natHandler.AddNat64IPv6Prefix(prefix.VrfId, prefix.Prefix)
+}
+
+// Delete removes NAT64 IPv6 prefix.
+func (d *NAT64IPv6PrefixDescriptor) Delete(key string, prefix *nat.Nat64IPv6Prefix, metadata interface{}) error {
+	return d.natHandler.DelNat64IPv6Prefix(prefix.VrfId, prefix.Prefix)
+}
+
+// Retrieve returns NAT64 IPv6 prefixes configured in VPP.
+func (d *NAT64IPv6PrefixDescriptor) Retrieve(correlate []adapter.NAT64IPv6PrefixKVWithMetadata) (
+	retrieved []adapter.NAT64IPv6PrefixKVWithMetadata, err error) {
+	prefixes, err := d.natHandler.Nat64IPv6PrefixDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, prefix := range prefixes {
+		retrieved = append(retrieved, adapter.NAT64IPv6PrefixKVWithMetadata{
+			Key:    models.Key(prefix),
+			Value:  prefix,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists non-zero VRF as the only dependency.
+func (d *NAT64IPv6PrefixDescriptor) Dependencies(key string, prefix *nat.Nat64IPv6Prefix) (deps []kvs.Dependency) {
+	if prefix.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: addressVrfDep,
+			Key:   l3.VrfTableKey(prefix.VrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
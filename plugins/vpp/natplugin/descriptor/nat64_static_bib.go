@@ -0,0 +1,110 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package descriptor
+
+import (
+	"net"
+
+	"go.ligato.io/cn-infra/v2/logging"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	kvs "go.ligato.io/vpp-agent/v3/plugins/kvscheduler/api"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/descriptor/adapter"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	l3 "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/l3"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+const (
+	// NAT64StaticBIBDescriptorName is the name of the descriptor for NAT64 static BIB entries.
+	NAT64StaticBIBDescriptorName = "vpp-nat64-static-bib"
+)
+
+// NAT64StaticBIBDescriptor teaches KVScheduler how to add/remove static entries
+// of the VPP NAT64 Binding Information Base.
+type NAT64StaticBIBDescriptor struct {
+	log        logging.Logger
+	natHandler vppcalls.NatVppAPI
+}
+
+// NewNAT64StaticBIBDescriptor creates a new instance of the NAT64StaticBIB descriptor.
+func NewNAT64StaticBIBDescriptor(natHandler vppcalls.NatVppAPI, log logging.PluginLogger) *kvs.KVDescriptor {
+	ctx := &NAT64StaticBIBDescriptor{
+		natHandler: natHandler,
+		log:        log.NewLogger("nat64-static-bib-descriptor"),
+	}
+	typedDescr := &adapter.NAT64StaticBIBDescriptor{
+		Name:          NAT64StaticBIBDescriptorName,
+		NBKeyPrefix:   nat.ModelNat64StaticBIB.KeyPrefix(),
+		ValueTypeName: nat.ModelNat64StaticBIB.ProtoName(),
+		KeySelector:   nat.ModelNat64StaticBIB.IsKeyValid,
+		KeyLabel:      nat.ModelNat64StaticBIB.StripKeyPrefix,
+		Validate:      ctx.Validate,
+		Create:        ctx.Create,
+		Delete:        ctx.Delete,
+		Retrieve:      ctx.Retrieve,
+		Dependencies:  ctx.Dependencies,
+	}
+	return adapter.NewNAT64StaticBIBDescriptor(typedDescr)
+}
+
+// Validate validates NAT64 static BIB entry.
+func (d *NAT64StaticBIBDescriptor) Validate(key string, bib *nat.Nat64StaticBIB) error {
+	if ip := net.ParseIP(bib.InsideIpv6Address); ip == nil || ip.To4() != nil {
+		return kvs.NewInvalidValueError(errInvalidIPAddress, "inside_ipv6_address")
+	}
+	if ip := net.ParseIP(bib.OutsideIpv4Address); ip == nil || ip.To4() == nil {
+		return kvs.NewInvalidValueError(errInvalidIPAddress, "outside_ipv4_address")
+	}
+	return nil
+}
+
+// Create adds NAT64 static BIB entry.
+func (d *NAT64StaticBIBDescriptor) Create(key string, bib *nat.Nat64StaticBIB) (metadata interface{}, err error) {
+	return nil, d.natHandler.AddNat64StaticBIB(bib)
+}
+
+// Delete removes NAT64 static BIB entry.
+func (d *NAT64StaticBIBDescriptor) Delete(key string, bib *nat.Nat64StaticBIB, metadata interface{}) error {
+	return d.natHandler.DelNat64StaticBIB(bib)
+}
+
+// Retrieve returns NAT64 static BIB entries configured in VPP.
+func (d *NAT64StaticBIBDescriptor) Retrieve(correlate []adapter.NAT64StaticBIBKVWithMetadata) (
+	retrieved []adapter.NAT64StaticBIBKVWithMetadata, err error) {
+	bibs, err := d.natHandler.Nat64StaticBIBsDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, bib := range bibs {
+		retrieved = append(retrieved, adapter.NAT64StaticBIBKVWithMetadata{
+			Key:    models.Key(bib),
+			Value:  bib,
+			Origin: kvs.FromNB,
+		})
+	}
+	return
+}
+
+// Dependencies lists non-zero VRF as the only dependency.
+func (d *NAT64StaticBIBDescriptor) Dependencies(key string, bib *nat.Nat64StaticBIB) (deps []kvs.Dependency) {
+	if bib.VrfId != 0 {
+		deps = append(deps, kvs.Dependency{
+			Label: addressVrfDep,
+			Key:   l3.VrfTableKey(bib.VrfId, l3.VrfTable_IPV6),
+		})
+	}
+	return deps
+}
@@ -18,6 +18,11 @@
 //go:generate descriptor-adapter --descriptor-name DNAT44 --value-type *vpp_nat.DNat44 --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44Interface --value-type *vpp_nat.Nat44Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 //go:generate descriptor-adapter --descriptor-name NAT44AddressPool --value-type *vpp_nat.Nat44AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64IPv6Prefix --value-type *vpp_nat.Nat64IPv6Prefix --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64Interface --value-type *vpp_nat.Nat64Interface --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64AddressPool --value-type *vpp_nat.Nat64AddressPool --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name NAT64StaticBIB --value-type *vpp_nat.Nat64StaticBIB --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
+//go:generate descriptor-adapter --descriptor-name DET44Mapping --value-type *vpp_nat.Det44Mapping --import "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat" --output-dir "descriptor"
 
 package natplugin
 
@@ -76,6 +81,11 @@ func (p *NATPlugin) Init() (err error) {
 	dnat44Descriptor := descriptor.NewDNAT44Descriptor(p.natHandler, p.Log)
 	nat44IfaceDescriptor := descriptor.NewNAT44InterfaceDescriptor(nat44GlobalCtx, p.natHandler, p.Log)
 	nat44AddrPoolDescriptor := descriptor.NewNAT44AddressPoolDescriptor(nat44GlobalCtx, p.natHandler, p.Log)
+	nat64PrefixDescriptor := descriptor.NewNAT64IPv6PrefixDescriptor(p.natHandler, p.Log)
+	nat64IfaceDescriptor := descriptor.NewNAT64InterfaceDescriptor(p.natHandler, p.Log)
+	nat64AddrPoolDescriptor := descriptor.NewNAT64AddressPoolDescriptor(p.natHandler, p.Log)
+	nat64StaticBIBDescriptor := descriptor.NewNAT64StaticBIBDescriptor(p.natHandler, p.Log)
+	det44MappingDescriptor := descriptor.NewDET44MappingDescriptor(p.natHandler, p.Log)
 
 	err = p.KVScheduler.RegisterKVDescriptor(
 		nat44GlobalDescriptor,
@@ -84,6 +94,11 @@ func (p *NATPlugin) Init() (err error) {
 		dnat44Descriptor,
 		nat44IfaceDescriptor,
 		nat44AddrPoolDescriptor,
+		nat64PrefixDescriptor,
+		nat64IfaceDescriptor,
+		nat64AddrPoolDescriptor,
+		nat64StaticBIBDescriptor,
+		det44MappingDescriptor,
 	)
 	if err != nil {
 		return err
@@ -15,6 +15,8 @@
 package vppcalls
 
 import (
+	"errors"
+
 	govppapi "git.fd.io/govpp.git/api"
 	"go.ligato.io/cn-infra/v2/idxmap"
 	"go.ligato.io/cn-infra/v2/logging"
@@ -54,6 +56,26 @@ type NatVppAPI interface {
 	AddNat44StaticMapping(mapping *nat.DNat44_StaticMapping, dnatLabel string) error
 	// DelNat44StaticMapping removes existing NAT44 static mapping entry.
 	DelNat44StaticMapping(mapping *nat.DNat44_StaticMapping, dnatLabel string) error
+	// AddNat64IPv6Prefix adds IPv6 prefix used to embed IPv4 addresses for NAT64.
+	AddNat64IPv6Prefix(vrf uint32, prefix string) error
+	// DelNat64IPv6Prefix removes existing NAT64 IPv6 prefix.
+	DelNat64IPv6Prefix(vrf uint32, prefix string) error
+	// EnableNat64Interface enables NAT64 feature for provided interface.
+	EnableNat64Interface(iface string, natIfaceType nat.Nat64Interface_Type) error
+	// DisableNat64Interface disables NAT64 feature for provided interface.
+	DisableNat64Interface(iface string, natIfaceType nat.Nat64Interface_Type) error
+	// AddNat64AddressPool adds new IPv4 address pool into the NAT64 pools.
+	AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error
+	// DelNat64AddressPool removes existing IPv4 address pool from the NAT64 pools.
+	DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error
+	// AddNat64StaticBIB creates new NAT64 static BIB entry.
+	AddNat64StaticBIB(bib *nat.Nat64StaticBIB) error
+	// DelNat64StaticBIB removes existing NAT64 static BIB entry.
+	DelNat64StaticBIB(bib *nat.Nat64StaticBIB) error
+	// AddDet44Mapping creates new deterministic NAT44 mapping.
+	AddDet44Mapping(mapping *nat.Det44Mapping) error
+	// DelDet44Mapping removes existing deterministic NAT44 mapping.
+	DelDet44Mapping(mapping *nat.Det44Mapping) error
 }
 
 // NatVppRead provides read methods for VPP NAT configuration.
@@ -72,8 +94,29 @@ type NatVppRead interface {
 	Nat44InterfacesDump() ([]*nat.Nat44Interface, error)
 	// Nat44AddressPoolsDump dumps all configured NAT44 address pools.
 	Nat44AddressPoolsDump() ([]*nat.Nat44AddressPool, error)
+	// Nat64IPv6PrefixDump dumps all IPv6 prefixes configured for NAT64.
+	Nat64IPv6PrefixDump() ([]*nat.Nat64IPv6Prefix, error)
+	// Nat64InterfacesDump dumps NAT64 config of all NAT64-enabled interfaces.
+	Nat64InterfacesDump() ([]*nat.Nat64Interface, error)
+	// Nat64AddressPoolsDump dumps all configured NAT64 address pools.
+	Nat64AddressPoolsDump() ([]*nat.Nat64AddressPool, error)
+	// Nat64StaticBIBsDump dumps all static entries of the NAT64 BIB.
+	Nat64StaticBIBsDump() ([]*nat.Nat64StaticBIB, error)
+	// Det44MappingsDump dumps all deterministic NAT44 mappings.
+	Det44MappingsDump() ([]*nat.Det44Mapping, error)
+	// Nat44UsersDump dumps state of all NAT44 users (inside hosts with active sessions).
+	Nat44UsersDump() ([]*nat.Nat44UserState, error)
+	// Nat44SessionsDump dumps state of all active NAT44 sessions.
+	Nat44SessionsDump() ([]*nat.Nat44SessionState, error)
 }
 
+var (
+	// ErrNat64Unsupported error is returned if NAT64 is not supported on given VPP version.
+	ErrNat64Unsupported = errors.New("NAT64 is not supported")
+	// ErrDet44Unsupported error is returned if DET44 is not supported on given VPP version.
+	ErrDet44Unsupported = errors.New("DET44 is not supported")
+)
+
 // Previously these options were configured for NAT44 plugin via the startup configuration file.
 // As of VPP 21.01 it is possible to configure/change them in run-time (by disabling and then
 // re-enabling the plugin with changed options).
@@ -0,0 +1,34 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2005
+
+import (
+	"fmt"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func (h *NatVppHandler) AddDet44Mapping(mapping *nat.Det44Mapping) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrDet44Unsupported)
+}
+
+func (h *NatVppHandler) DelDet44Mapping(mapping *nat.Det44Mapping) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrDet44Unsupported)
+}
+
+func (h *NatVppHandler) Det44MappingsDump() ([]*nat.Det44Mapping, error) {
+	return nil, nil
+}
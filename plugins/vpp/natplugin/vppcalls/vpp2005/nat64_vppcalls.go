@@ -0,0 +1,70 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2005
+
+import (
+	"fmt"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func (h *NatVppHandler) AddNat64IPv6Prefix(vrf uint32, prefix string) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrNat64Unsupported)
+}
+
+func (h *NatVppHandler) DelNat64IPv6Prefix(vrf uint32, prefix string) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrNat64Unsupported)
+}
+
+func (h *NatVppHandler) EnableNat64Interface(iface string, natIfaceType nat.Nat64Interface_Type) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrNat64Unsupported)
+}
+
+func (h *NatVppHandler) DisableNat64Interface(iface string, natIfaceType nat.Nat64Interface_Type) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrNat64Unsupported)
+}
+
+func (h *NatVppHandler) AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrNat64Unsupported)
+}
+
+func (h *NatVppHandler) DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrNat64Unsupported)
+}
+
+func (h *NatVppHandler) AddNat64StaticBIB(bib *nat.Nat64StaticBIB) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrNat64Unsupported)
+}
+
+func (h *NatVppHandler) DelNat64StaticBIB(bib *nat.Nat64StaticBIB) error {
+	return fmt.Errorf("%w in VPP 20.05", vppcalls.ErrNat64Unsupported)
+}
+
+func (h *NatVppHandler) Nat64IPv6PrefixDump() ([]*nat.Nat64IPv6Prefix, error) {
+	return nil, nil
+}
+
+func (h *NatVppHandler) Nat64InterfacesDump() ([]*nat.Nat64Interface, error) {
+	return nil, nil
+}
+
+func (h *NatVppHandler) Nat64AddressPoolsDump() ([]*nat.Nat64AddressPool, error) {
+	return nil, nil
+}
+
+func (h *NatVppHandler) Nat64StaticBIBsDump() ([]*nat.Nat64StaticBIB, error) {
+	return nil, nil
+}
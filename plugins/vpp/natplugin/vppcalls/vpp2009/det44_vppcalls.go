@@ -0,0 +1,113 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2009
+
+import (
+	"fmt"
+
+	govppapi "git.fd.io/govpp.git/api"
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	vpp_det44 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/det44"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ip_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// AddDet44Mapping creates new deterministic NAT44 mapping.
+func (h *NatVppHandler) AddDet44Mapping(mapping *nat.Det44Mapping) error {
+	return h.handleDet44Mapping(mapping, true)
+}
+
+// DelDet44Mapping removes existing deterministic NAT44 mapping.
+func (h *NatVppHandler) DelDet44Mapping(mapping *nat.Det44Mapping) error {
+	return h.handleDet44Mapping(mapping, false)
+}
+
+// Det44MappingsDump dumps all deterministic NAT44 mappings.
+func (h *NatVppHandler) Det44MappingsDump() (mappings []*nat.Det44Mapping, err error) {
+	if !h.withDet44 {
+		return nil, nil
+	}
+	req := &vpp_det44.Det44MapDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_det44.Det44MapDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump DET44 mappings: %v", err)
+		}
+		if stop {
+			break
+		}
+		inPrefix := ip_types.IP4Prefix{Address: msg.InAddr, Len: msg.InPlen}
+		outPrefix := ip_types.IP4Prefix{Address: msg.OutAddr, Len: msg.OutPlen}
+		mappings = append(mappings, &nat.Det44Mapping{
+			InsidePrefix:  inPrefix.String(),
+			OutsidePrefix: outPrefix.String(),
+		})
+	}
+	return
+}
+
+// enableDet44Plugin enables the DET44 plugin before a mapping is applied. The request
+// is sent every time since the plugin state is lost when VPP restarts.
+func (h *NatVppHandler) enableDet44Plugin() error {
+	if !h.withDet44 {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "det44")
+	}
+	req := &vpp_det44.Det44PluginEnableDisable{
+		Enable: true,
+	}
+	reply := &vpp_det44.Det44PluginEnableDisableReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		if _, isVppErr := err.(govppapi.VPPApiError); !isVppErr {
+			return err
+		}
+		// plugin has been enabled already
+		h.log.Debugf("DET44 plugin enable returned: %v", err)
+	}
+	return nil
+}
+
+// Calls VPP binary API to add/remove DET44 mapping.
+func (h *NatVppHandler) handleDet44Mapping(mapping *nat.Det44Mapping, isAdd bool) error {
+	if err := h.enableDet44Plugin(); err != nil {
+		return err
+	}
+	inPrefix, err := ip_types.ParseIP4Prefix(mapping.InsidePrefix)
+	if err != nil {
+		return errors.Errorf("unable to parse DET44 inside prefix %s: %v", mapping.InsidePrefix, err)
+	}
+	outPrefix, err := ip_types.ParseIP4Prefix(mapping.OutsidePrefix)
+	if err != nil {
+		return errors.Errorf("unable to parse DET44 outside prefix %s: %v", mapping.OutsidePrefix, err)
+	}
+
+	req := &vpp_det44.Det44AddDelMap{
+		IsAdd:   isAdd,
+		InAddr:  inPrefix.Address,
+		InPlen:  inPrefix.Len,
+		OutAddr: outPrefix.Address,
+		OutPlen: outPrefix.Len,
+	}
+	reply := &vpp_det44.Det44AddDelMapReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
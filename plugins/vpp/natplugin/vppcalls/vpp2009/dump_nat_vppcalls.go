@@ -705,3 +705,92 @@ func incIP(ip net.IP) net.IP {
 	}
 	return retIP
 }
+
+// Nat44UsersDump dumps state of all NAT44 users (inside hosts with active sessions).
+func (h *NatVppHandler) Nat44UsersDump() (users []*nat.Nat44UserState, err error) {
+	req := &vpp_nat.Nat44UserDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat.Nat44UserDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT44 users: %v", err)
+		}
+		if stop {
+			break
+		}
+		users = append(users, &nat.Nat44UserState{
+			IpAddress:      net.IP(msg.IPAddress[:]).String(),
+			VrfId:          msg.VrfID,
+			Sessions:       msg.Nsessions,
+			StaticSessions: msg.Nstaticsessions,
+		})
+	}
+	return
+}
+
+// Nat44SessionsDump dumps state of all active NAT44 sessions.
+func (h *NatVppHandler) Nat44SessionsDump() (sessions []*nat.Nat44SessionState, err error) {
+	// sessions can be dumped only per user
+	users, err := h.Nat44UsersDump()
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range users {
+		userSessions, err := h.nat44UserSessionsDump(user)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, userSessions...)
+	}
+	return
+}
+
+// nat44UserSessionsDump dumps all active NAT44 sessions of the given user.
+func (h *NatVppHandler) nat44UserSessionsDump(user *nat.Nat44UserState) (sessions []*nat.Nat44SessionState, err error) {
+	userAddr, err := ipTo4Address(user.IpAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid NAT44 user address %s: %v", user.IpAddress, err)
+	}
+	req := &vpp_nat.Nat44UserSessionDump{
+		IPAddress: userAddr,
+		VrfID:     user.VrfId,
+	}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat.Nat44UserSessionDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT44 sessions of user %s: %v", user.IpAddress, err)
+		}
+		if stop {
+			break
+		}
+		flags := getNat44Flags(msg.Flags)
+		session := &nat.Nat44SessionState{
+			VrfId:            user.VrfId,
+			InsideIpAddress:  net.IP(msg.InsideIPAddress[:]).String(),
+			InsidePort:       uint32(msg.InsidePort),
+			OutsideIpAddress: net.IP(msg.OutsideIPAddress[:]).String(),
+			OutsidePort:      uint32(msg.OutsidePort),
+			Protocol:         uint32(msg.Protocol),
+			IsStatic:         flags.isStatic,
+			IsTwiceNat:       flags.isTwiceNat,
+			LastHeard:        msg.LastHeard,
+			TotalBytes:       msg.TotalBytes,
+			TotalPackets:     msg.TotalPkts,
+		}
+		if flags.isExtHostValid {
+			session.ExtHostIpAddress = net.IP(msg.ExtHostAddress[:]).String()
+			session.ExtHostPort = uint32(msg.ExtHostPort)
+		}
+		if flags.isTwiceNat {
+			session.ExtHostNatIpAddress = net.IP(msg.ExtHostNatAddress[:]).String()
+			session.ExtHostNatPort = uint32(msg.ExtHostNatPort)
+		}
+		sessions = append(sessions, session)
+	}
+	return
+}
@@ -21,6 +21,7 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009"
+	vpp_det44 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/det44"
 	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ip"
 	vpp_nat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/nat"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
@@ -42,6 +43,9 @@ type NatVppHandler struct {
 	ifIndexes    ifaceidx.IfaceMetadataIndex
 	dhcpIndex    idxmap.NamedMapping
 	log          logging.Logger
+
+	// DET44 is a separate VPP plugin which has to be enabled before it can be configured
+	withDet44 bool
 }
 
 // NewNatVppHandler creates new instance of NAT vppcalls handler.
@@ -56,5 +60,6 @@ func NewNatVppHandler(c vpp.Client,
 		ifIndexes:    ifIndexes,
 		dhcpIndex:    dhcpIndex,
 		log:          log,
+		withDet44:    c.IsPluginLoaded(vpp_det44.APIFile),
 	}
 }
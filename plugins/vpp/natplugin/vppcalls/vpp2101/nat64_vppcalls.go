@@ -0,0 +1,337 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101
+
+import (
+	"fmt"
+	"net"
+
+	govppapi "git.fd.io/govpp.git/api"
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat_types"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+// AddNat64IPv6Prefix adds IPv6 prefix used to embed IPv4 addresses for NAT64.
+func (h *NatVppHandler) AddNat64IPv6Prefix(vrf uint32, prefix string) error {
+	return h.handleNat64IPv6Prefix(vrf, prefix, true)
+}
+
+// DelNat64IPv6Prefix removes existing NAT64 IPv6 prefix.
+func (h *NatVppHandler) DelNat64IPv6Prefix(vrf uint32, prefix string) error {
+	return h.handleNat64IPv6Prefix(vrf, prefix, false)
+}
+
+// EnableNat64Interface enables NAT64 feature for provided interface.
+func (h *NatVppHandler) EnableNat64Interface(iface string, natIfaceType nat.Nat64Interface_Type) error {
+	return h.handleNat64Interface(iface, natIfaceType, true)
+}
+
+// DisableNat64Interface disables NAT64 feature for provided interface.
+func (h *NatVppHandler) DisableNat64Interface(iface string, natIfaceType nat.Nat64Interface_Type) error {
+	return h.handleNat64Interface(iface, natIfaceType, false)
+}
+
+// AddNat64AddressPool adds new IPv4 address pool into the NAT64 pools.
+func (h *NatVppHandler) AddNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return h.handleNat64AddressPool(vrf, firstIP, lastIP, true)
+}
+
+// DelNat64AddressPool removes existing IPv4 address pool from the NAT64 pools.
+func (h *NatVppHandler) DelNat64AddressPool(vrf uint32, firstIP, lastIP string) error {
+	return h.handleNat64AddressPool(vrf, firstIP, lastIP, false)
+}
+
+// AddNat64StaticBIB creates new NAT64 static BIB entry.
+func (h *NatVppHandler) AddNat64StaticBIB(bib *nat.Nat64StaticBIB) error {
+	return h.handleNat64StaticBIB(bib, true)
+}
+
+// DelNat64StaticBIB removes existing NAT64 static BIB entry.
+func (h *NatVppHandler) DelNat64StaticBIB(bib *nat.Nat64StaticBIB) error {
+	return h.handleNat64StaticBIB(bib, false)
+}
+
+// Nat64IPv6PrefixDump dumps all IPv6 prefixes configured for NAT64.
+func (h *NatVppHandler) Nat64IPv6PrefixDump() (prefixes []*nat.Nat64IPv6Prefix, err error) {
+	if !h.withNat64 {
+		return nil, nil
+	}
+	req := &vpp_nat64.Nat64PrefixDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64PrefixDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 prefixes: %v", err)
+		}
+		if stop {
+			break
+		}
+		prefixes = append(prefixes, &nat.Nat64IPv6Prefix{
+			VrfId:  msg.VrfID,
+			Prefix: msg.Prefix.String(),
+		})
+	}
+	return
+}
+
+// Nat64InterfacesDump dumps NAT64 config of all NAT64-enabled interfaces.
+func (h *NatVppHandler) Nat64InterfacesDump() (natIfs []*nat.Nat64Interface, err error) {
+	if !h.withNat64 {
+		return nil, nil
+	}
+	req := &vpp_nat64.Nat64InterfaceDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64InterfaceDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 interfaces: %v", err)
+		}
+		if stop {
+			break
+		}
+		ifName, _, found := h.ifIndexes.LookupBySwIfIndex(uint32(msg.SwIfIndex))
+		if !found {
+			h.log.Warnf("Interface with index %d not found in the mapping", msg.SwIfIndex)
+			continue
+		}
+		natIf := &nat.Nat64Interface{
+			Name: ifName,
+			Type: nat.Nat64Interface_IPV4_OUTSIDE,
+		}
+		if getNat44Flags(msg.Flags).isInside {
+			natIf.Type = nat.Nat64Interface_IPV6_INSIDE
+		}
+		natIfs = append(natIfs, natIf)
+	}
+	return
+}
+
+// Nat64AddressPoolsDump dumps all configured NAT64 address pools.
+func (h *NatVppHandler) Nat64AddressPoolsDump() (natPools []*nat.Nat64AddressPool, err error) {
+	if !h.withNat64 {
+		return nil, nil
+	}
+	var curPool *nat.Nat64AddressPool
+	var lastIP net.IP
+
+	req := &vpp_nat64.Nat64PoolAddrDump{}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64PoolAddrDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 address pool: %v", err)
+		}
+		if stop {
+			break
+		}
+		ip := net.IP(msg.Address[:])
+		// merge subsequent IPs into a single pool
+		if curPool != nil && curPool.VrfId == msg.VrfID && ip.Equal(incIP(lastIP)) {
+			// update current pool
+			curPool.LastIp = ip.String()
+		} else {
+			// start a new pool
+			pool := &nat.Nat64AddressPool{
+				FirstIp: ip.String(),
+				VrfId:   msg.VrfID,
+			}
+			curPool = pool
+			natPools = append(natPools, pool)
+		}
+		lastIP = ip
+	}
+	return
+}
+
+// Nat64StaticBIBsDump dumps all static entries of the NAT64 BIB.
+func (h *NatVppHandler) Nat64StaticBIBsDump() (bibs []*nat.Nat64StaticBIB, err error) {
+	if !h.withNat64 {
+		return nil, nil
+	}
+	// protocol 255 selects BIB entries of all protocols
+	req := &vpp_nat64.Nat64BibDump{Proto: ^uint8(0)}
+	reqContext := h.callsChannel.SendMultiRequest(req)
+
+	for {
+		msg := &vpp_nat64.Nat64BibDetails{}
+		stop, err := reqContext.ReceiveReply(msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump NAT64 BIB: %v", err)
+		}
+		if stop {
+			break
+		}
+		if !getNat44Flags(msg.Flags).isStatic {
+			// dynamic entries are not part of the configuration
+			continue
+		}
+		bibs = append(bibs, &nat.Nat64StaticBIB{
+			VrfId:              msg.VrfID,
+			InsideIpv6Address:  net.IP(msg.IAddr[:]).String(),
+			InsidePort:         uint32(msg.IPort),
+			OutsideIpv4Address: net.IP(msg.OAddr[:]).String(),
+			OutsidePort:        uint32(msg.OPort),
+			Protocol:           h.protocolNumberToNBValue(msg.Proto),
+		})
+	}
+	return
+}
+
+// enableNat64Plugin enables the NAT64 plugin (required since VPP 21.01) before a NAT64
+// configuration item is applied. The request is sent every time since the plugin state
+// is lost when VPP restarts.
+func (h *NatVppHandler) enableNat64Plugin() error {
+	if !h.withNat64 {
+		return errors.WithMessage(vpp.ErrPluginDisabled, "nat64")
+	}
+	req := &vpp_nat64.Nat64PluginEnableDisable{
+		Enable: true,
+	}
+	reply := &vpp_nat64.Nat64PluginEnableDisableReply{}
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		if _, isVppErr := err.(govppapi.VPPApiError); !isVppErr {
+			return err
+		}
+		// plugin has been enabled already
+		h.log.Debugf("NAT64 plugin enable returned: %v", err)
+	}
+	return nil
+}
+
+// Calls VPP binary API to add/remove NAT64 IPv6 prefix.
+func (h *NatVppHandler) handleNat64IPv6Prefix(vrf uint32, prefix string, isAdd bool) error {
+	if err := h.enableNat64Plugin(); err != nil {
+		return err
+	}
+	ip6Prefix, err := ip_types.ParseIP6Prefix(prefix)
+	if err != nil {
+		return errors.Errorf("unable to parse NAT64 prefix %s: %v", prefix, err)
+	}
+	req := &vpp_nat64.Nat64AddDelPrefix{
+		Prefix: ip6Prefix,
+		VrfID:  vrf,
+		IsAdd:  isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelPrefixReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to set/unset interface NAT64 feature.
+func (h *NatVppHandler) handleNat64Interface(iface string, natIfaceType nat.Nat64Interface_Type, isAdd bool) error {
+	if err := h.enableNat64Plugin(); err != nil {
+		return err
+	}
+	// get interface metadata
+	ifaceMeta, found := h.ifIndexes.LookupByName(iface)
+	if !found {
+		return errors.New("failed to get interface metadata")
+	}
+
+	req := &vpp_nat64.Nat64AddDelInterface{
+		SwIfIndex: interface_types.InterfaceIndex(ifaceMeta.SwIfIndex),
+		IsAdd:     isAdd,
+	}
+	if natIfaceType == nat.Nat64Interface_IPV6_INSIDE {
+		req.Flags = nat_types.NAT_IS_INSIDE
+	} else {
+		req.Flags = nat_types.NAT_IS_OUTSIDE
+	}
+	reply := &vpp_nat64.Nat64AddDelInterfaceReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to add/remove addresses to/from the NAT64 pool.
+func (h *NatVppHandler) handleNat64AddressPool(vrf uint32, firstIP, lastIP string, isAdd bool) error {
+	if err := h.enableNat64Plugin(); err != nil {
+		return err
+	}
+	firstAddr, err := ipTo4Address(firstIP)
+	if err != nil {
+		return errors.Errorf("unable to parse address %s from the NAT64 pool: %v", firstIP, err)
+	}
+	lastAddr := firstAddr
+	if lastIP != "" {
+		lastAddr, err = ipTo4Address(lastIP)
+		if err != nil {
+			return errors.Errorf("unable to parse address %s from the NAT64 pool: %v", lastIP, err)
+		}
+	}
+
+	req := &vpp_nat64.Nat64AddDelPoolAddrRange{
+		StartAddr: firstAddr,
+		EndAddr:   lastAddr,
+		VrfID:     vrf,
+		IsAdd:     isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelPoolAddrRangeReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Calls VPP binary API to add/remove NAT64 static BIB entry.
+func (h *NatVppHandler) handleNat64StaticBIB(bib *nat.Nat64StaticBIB, isAdd bool) error {
+	if err := h.enableNat64Plugin(); err != nil {
+		return err
+	}
+	inAddr, err := ip_types.ParseIP6Address(bib.InsideIpv6Address)
+	if err != nil {
+		return errors.Errorf("unable to parse inside IPv6 address %s of NAT64 static BIB: %v",
+			bib.InsideIpv6Address, err)
+	}
+	outAddr, err := ipTo4Address(bib.OutsideIpv4Address)
+	if err != nil {
+		return errors.Errorf("unable to parse outside IPv4 address %s of NAT64 static BIB: %v",
+			bib.OutsideIpv4Address, err)
+	}
+
+	req := &vpp_nat64.Nat64AddDelStaticBib{
+		IAddr: inAddr,
+		OAddr: outAddr,
+		IPort: uint16(bib.InsidePort),
+		OPort: uint16(bib.OutsidePort),
+		VrfID: bib.VrfId,
+		Proto: h.protocolNBValueToNumber(bib.Protocol),
+		IsAdd: isAdd,
+	}
+	reply := &vpp_nat64.Nat64AddDelStaticBibReply{}
+
+	if err := h.callsChannel.SendRequest(req).ReceiveReply(reply); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vpp2101_test
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	vpp_det44 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/det44"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip_types"
+	vpp_nat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat44"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat64"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat_types"
+	vpp_vpe "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/vpe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
+	nat "go.ligato.io/vpp-agent/v3/proto/ligato/vpp/nat"
+)
+
+func TestAddNat64IPv6Prefix(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// the plugin is enabled with every NAT64 request
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{})
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPrefixReply{})
+	err := natHandler.AddNat64IPv6Prefix(1, "64:ff9b::/96")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.VrfID).To(BeEquivalentTo(1))
+	Expect(msg.Prefix.String()).To(Equal("64:ff9b::/96"))
+
+	// enabling the plugin again fails in VPP, which is not an error
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{Retval: -1})
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelPrefixReply{})
+	err = natHandler.DelNat64IPv6Prefix(1, "64:ff9b::/96")
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok = ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelPrefix)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeFalse())
+}
+
+func TestEnableNat64Interface(t *testing.T) {
+	ctx, natHandler, swIfIndexes, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	swIfIndexes.Put("if0", &ifaceidx.IfaceMetadata{SwIfIndex: 1})
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{})
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelInterfaceReply{})
+	err := natHandler.EnableNat64Interface("if0", nat.Nat64Interface_IPV4_OUTSIDE)
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelInterface)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.SwIfIndex).To(BeEquivalentTo(1))
+	Expect(msg.Flags).To(Equal(nat_types.NAT_IS_OUTSIDE))
+}
+
+func TestAddNat64StaticBIB(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64PluginEnableDisableReply{})
+	ctx.MockVpp.MockReply(&vpp_nat64.Nat64AddDelStaticBibReply{})
+	err := natHandler.AddNat64StaticBIB(&nat.Nat64StaticBIB{
+		InsideIpv6Address:  "2001:db8::1",
+		InsidePort:         8080,
+		OutsideIpv4Address: "10.0.0.1",
+		OutsidePort:        80,
+		Protocol:           nat.DNat44_TCP,
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_nat64.Nat64AddDelStaticBib)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(msg.IAddr.String()).To(Equal("2001:db8::1"))
+	Expect(msg.IPort).To(BeEquivalentTo(8080))
+	Expect(addressTo4IP(msg.OAddr)).To(Equal("10.0.0.1"))
+	Expect(msg.OPort).To(BeEquivalentTo(80))
+	Expect(msg.Proto).To(BeEquivalentTo(6))
+}
+
+func TestNat64StaticBIBsDump(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(
+		&vpp_nat64.Nat64BibDetails{
+			IAddr: ipTo6Address("2001:db8::1"),
+			OAddr: ipTo4Address("10.0.0.1"),
+			IPort: 8080,
+			OPort: 80,
+			Proto: 17,
+			Flags: nat_types.NAT_IS_STATIC,
+		},
+		&vpp_nat64.Nat64BibDetails{
+			IAddr: ipTo6Address("2001:db8::2"),
+			OAddr: ipTo4Address("10.0.0.1"),
+			IPort: 1024,
+			OPort: 2048,
+			Proto: 6,
+		})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	bibs, err := natHandler.Nat64StaticBIBsDump()
+	Expect(err).To(Succeed())
+
+	// dynamic entry is skipped
+	Expect(bibs).To(HaveLen(1))
+	Expect(bibs[0].InsideIpv6Address).To(Equal("2001:db8::1"))
+	Expect(bibs[0].InsidePort).To(BeEquivalentTo(8080))
+	Expect(bibs[0].OutsideIpv4Address).To(Equal("10.0.0.1"))
+	Expect(bibs[0].OutsidePort).To(BeEquivalentTo(80))
+	Expect(bibs[0].Protocol).To(Equal(nat.DNat44_UDP))
+}
+
+func TestAddDet44Mapping(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	ctx.MockVpp.MockReply(&vpp_det44.Det44PluginEnableDisableReply{})
+	ctx.MockVpp.MockReply(&vpp_det44.Det44AddDelMapReply{})
+	err := natHandler.AddDet44Mapping(&nat.Det44Mapping{
+		InsidePrefix:  "10.0.0.0/24",
+		OutsidePrefix: "1.2.3.0/28",
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+
+	msg, ok := ctx.MockChannel.Msg.(*vpp_det44.Det44AddDelMap)
+	Expect(ok).To(BeTrue())
+	Expect(msg.IsAdd).To(BeTrue())
+	Expect(addressTo4IP(msg.InAddr)).To(Equal("10.0.0.0"))
+	Expect(msg.InPlen).To(BeEquivalentTo(24))
+	Expect(addressTo4IP(msg.OutAddr)).To(Equal("1.2.3.0"))
+	Expect(msg.OutPlen).To(BeEquivalentTo(28))
+}
+
+func TestNat44SessionsDump(t *testing.T) {
+	ctx, natHandler, _, _ := natTestSetup(t)
+	defer ctx.TeardownTestCtx()
+
+	// users
+	ctx.MockVpp.MockReply(&vpp_nat.Nat44UserDetails{
+		IPAddress:       ipTo4Address("192.168.1.10"),
+		VrfID:           1,
+		Nsessions:       1,
+		Nstaticsessions: 0,
+	})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	// sessions of the user
+	ctx.MockVpp.MockReply(&vpp_nat.Nat44UserSessionDetails{
+		InsideIPAddress:  ipTo4Address("192.168.1.10"),
+		InsidePort:       5000,
+		OutsideIPAddress: ipTo4Address("80.80.80.1"),
+		OutsidePort:      1025,
+		Protocol:         6,
+		Flags:            nat_types.NAT_IS_EXT_HOST_VALID,
+		ExtHostAddress:   ipTo4Address("8.8.8.8"),
+		ExtHostPort:      443,
+		TotalBytes:       1500,
+		TotalPkts:        3,
+	})
+	ctx.MockVpp.MockReply(&vpp_vpe.ControlPingReply{})
+
+	sessions, err := natHandler.Nat44SessionsDump()
+	Expect(err).To(Succeed())
+
+	Expect(sessions).To(HaveLen(1))
+	Expect(sessions[0].VrfId).To(BeEquivalentTo(1))
+	Expect(sessions[0].InsideIpAddress).To(Equal("192.168.1.10"))
+	Expect(sessions[0].InsidePort).To(BeEquivalentTo(5000))
+	Expect(sessions[0].OutsideIpAddress).To(Equal("80.80.80.1"))
+	Expect(sessions[0].OutsidePort).To(BeEquivalentTo(1025))
+	Expect(sessions[0].Protocol).To(BeEquivalentTo(6))
+	Expect(sessions[0].ExtHostIpAddress).To(Equal("8.8.8.8"))
+	Expect(sessions[0].ExtHostPort).To(BeEquivalentTo(443))
+	Expect(sessions[0].ExtHostNatIpAddress).To(BeEmpty())
+	Expect(sessions[0].IsStatic).To(BeFalse())
+	Expect(sessions[0].TotalBytes).To(BeEquivalentTo(1500))
+	Expect(sessions[0].TotalPackets).To(BeEquivalentTo(3))
+}
+
+func ipTo6Address(ipStr string) (addr ip_types.IP6Address) {
+	copy(addr[:], net.ParseIP(ipStr).To16())
+	return
+}
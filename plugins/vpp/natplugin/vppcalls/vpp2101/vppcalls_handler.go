@@ -21,8 +21,10 @@ import (
 
 	"go.ligato.io/vpp-agent/v3/plugins/vpp"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101"
+	vpp_det44 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/det44"
 	vpp_ip "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/ip"
 	vpp_nat "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat44"
+	vpp_nat64 "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2101/nat64"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/ifplugin/ifaceidx"
 	"go.ligato.io/vpp-agent/v3/plugins/vpp/natplugin/vppcalls"
 )
@@ -42,6 +44,11 @@ type NatVppHandler struct {
 	ifIndexes    ifaceidx.IfaceMetadataIndex
 	dhcpIndex    idxmap.NamedMapping
 	log          logging.Logger
+
+	// NAT64 and DET44 are separate VPP plugins which have to be enabled
+	// before they can be configured
+	withNat64 bool
+	withDet44 bool
 }
 
 // NewNatVppHandler creates new instance of NAT vppcalls handler.
@@ -56,5 +63,7 @@ func NewNatVppHandler(c vpp.Client,
 		ifIndexes:    ifIndexes,
 		dhcpIndex:    dhcpIndex,
 		log:          log,
+		withNat64:    c.IsPluginLoaded(vpp_nat64.APIFile),
+		withDet44:    c.IsPluginLoaded(vpp_det44.APIFile),
 	}
 }
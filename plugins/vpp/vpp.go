@@ -16,6 +16,7 @@ package vpp
 
 import (
 	"errors"
+	"fmt"
 
 	govppapi "git.fd.io/govpp.git/api"
 
@@ -29,8 +30,27 @@ var (
 	ErrNoVersions = errors.New("no handler versions")
 	// ErrPluginDisabled is an error returned when disabled plugin is detected.
 	ErrPluginDisabled = errors.New("plugin not available")
+	// ErrIncompatibleMessage matches every IncompatibleMessageError using errors.Is.
+	ErrIncompatibleMessage = errors.New("incompatible binapi message")
 )
 
+// IncompatibleMessageError is an error returned for requests using binapi
+// message that is incompatible with the connected VPP.
+type IncompatibleMessageError struct {
+	Message string
+	CRC     string
+}
+
+func (e *IncompatibleMessageError) Error() string {
+	return fmt.Sprintf("binapi message %s (CRC %s) is incompatible with connected VPP, "+
+		"features using this message are not available", e.Message, e.CRC)
+}
+
+// Is returns true for ErrIncompatibleMessage.
+func (e *IncompatibleMessageError) Is(target error) bool {
+	return target == ErrIncompatibleMessage
+}
+
 type Version = binapi.Version
 
 // Client provides methods for managing VPP.
@@ -49,3 +69,14 @@ type Client interface {
 	// OnReconnect registers handler function to be executed on reconnect.
 	OnReconnect(h func())
 }
+
+// WithCaller returns client which attributes requests sent via the client
+// to the named caller in metrics of binapi requests, if supported by the client.
+// Plugins polling VPP concurrently with descriptors should use it, otherwise
+// their requests are attributed to the descriptor executing an operation.
+func WithCaller(c Client, name string) Client {
+	if cc, ok := c.(interface{ WithCaller(name string) Client }); ok {
+		return cc.WithCaller(name)
+	}
+	return c
+}
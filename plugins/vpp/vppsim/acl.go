@@ -0,0 +1,208 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppsim
+
+import (
+	"git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/acl"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/acl_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+)
+
+const allACLs = ^uint32(0)
+
+// aclState holds ACLs and their assignment to interfaces in simulated VPP.
+type aclState struct {
+	aclList   map[uint32]*acl.ACLDetails
+	aclIfaces map[uint32]*aclInterface
+}
+
+// aclInterface holds ACLs assigned to the interface.
+type aclInterface struct {
+	input  []uint32
+	output []uint32
+}
+
+func (s *VPP) registerACL() {
+	s.aclList = make(map[uint32]*acl.ACLDetails)
+	s.aclIfaces = make(map[uint32]*aclInterface)
+
+	s.handle(&acl.ACLAddReplace{}, s.aclAddReplace)
+	s.handle(&acl.ACLDel{}, s.aclDel)
+	s.handle(&acl.ACLDump{}, s.aclDump)
+	s.handle(&acl.ACLInterfaceAddDel{}, s.aclInterfaceAddDel)
+	s.handle(&acl.ACLInterfaceSetACLList{}, s.aclInterfaceSetACLList)
+	s.handle(&acl.ACLInterfaceListDump{}, s.aclInterfaceListDump)
+}
+
+func (s *VPP) aclAddReplace(req api.Message) []api.Message {
+	r := req.(*acl.ACLAddReplace)
+	reply := &acl.ACLAddReplaceReply{ACLIndex: r.ACLIndex}
+
+	if r.ACLIndex == allACLs {
+		// new ACL gets the lowest free index
+		reply.ACLIndex = 0
+		for s.aclList[reply.ACLIndex] != nil {
+			reply.ACLIndex++
+		}
+	} else if _, ok := s.aclList[r.ACLIndex]; !ok {
+		reply.Retval = errNoSuchEntry
+		return []api.Message{reply}
+	}
+	s.aclList[reply.ACLIndex] = &acl.ACLDetails{
+		ACLIndex: reply.ACLIndex,
+		Tag:      r.Tag,
+		Count:    uint32(len(r.R)),
+		R:        append([]acl_types.ACLRule(nil), r.R...),
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) aclDel(req api.Message) []api.Message {
+	r := req.(*acl.ACLDel)
+	reply := &acl.ACLDelReply{}
+
+	if _, ok := s.aclList[r.ACLIndex]; !ok {
+		reply.Retval = errNoSuchEntry
+		return []api.Message{reply}
+	}
+	for _, ifACLs := range s.aclIfaces {
+		if indexOf(ifACLs.input, r.ACLIndex) >= 0 || indexOf(ifACLs.output, r.ACLIndex) >= 0 {
+			// ACL assigned to interface cannot be removed
+			reply.Retval = errInvalidValue
+			return []api.Message{reply}
+		}
+	}
+	delete(s.aclList, r.ACLIndex)
+	return []api.Message{reply}
+}
+
+func (s *VPP) aclDump(req api.Message) []api.Message {
+	r := req.(*acl.ACLDump)
+
+	var details []api.Message
+	for _, idx := range sortedKeys(s.aclList) {
+		if r.ACLIndex != allACLs && r.ACLIndex != idx {
+			continue
+		}
+		d := *s.aclList[idx]
+		d.R = append([]acl_types.ACLRule(nil), d.R...)
+		details = append(details, &d)
+	}
+	return details
+}
+
+func (s *VPP) aclInterfaceAddDel(req api.Message) []api.Message {
+	r := req.(*acl.ACLInterfaceAddDel)
+	reply := &acl.ACLInterfaceAddDelReply{}
+
+	if _, ok := s.ifaces[uint32(r.SwIfIndex)]; !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	if _, ok := s.aclList[r.ACLIndex]; !ok && r.IsAdd {
+		reply.Retval = errNoSuchEntry
+		return []api.Message{reply}
+	}
+	ifACLs := s.interfaceACLs(uint32(r.SwIfIndex))
+	list := &ifACLs.output
+	if r.IsInput {
+		list = &ifACLs.input
+	}
+	i := indexOf(*list, r.ACLIndex)
+	switch {
+	case r.IsAdd && i >= 0:
+		reply.Retval = errInvalidValue
+	case r.IsAdd:
+		*list = append(*list, r.ACLIndex)
+	case i < 0:
+		reply.Retval = errNoSuchEntry
+	default:
+		*list = append((*list)[:i], (*list)[i+1:]...)
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) aclInterfaceSetACLList(req api.Message) []api.Message {
+	r := req.(*acl.ACLInterfaceSetACLList)
+	reply := &acl.ACLInterfaceSetACLListReply{}
+
+	if _, ok := s.ifaces[uint32(r.SwIfIndex)]; !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	if int(r.NInput) > len(r.Acls) {
+		reply.Retval = errInvalidValue
+		return []api.Message{reply}
+	}
+	for _, aclIndex := range r.Acls {
+		if _, ok := s.aclList[aclIndex]; !ok {
+			reply.Retval = errNoSuchEntry
+			return []api.Message{reply}
+		}
+	}
+	ifACLs := s.interfaceACLs(uint32(r.SwIfIndex))
+	ifACLs.input = append([]uint32(nil), r.Acls[:r.NInput]...)
+	ifACLs.output = append([]uint32(nil), r.Acls[r.NInput:]...)
+	return []api.Message{reply}
+}
+
+func (s *VPP) aclInterfaceListDump(req api.Message) []api.Message {
+	r := req.(*acl.ACLInterfaceListDump)
+
+	var details []api.Message
+	for _, idx := range sortedKeys(s.aclIfaces) {
+		ifACLs := s.aclIfaces[idx]
+		if r.SwIfIndex != allInterfaces && uint32(r.SwIfIndex) != idx {
+			continue
+		}
+		if len(ifACLs.input)+len(ifACLs.output) == 0 {
+			continue
+		}
+		d := &acl.ACLInterfaceListDetails{
+			SwIfIndex: interface_types.InterfaceIndex(idx),
+			NInput:    uint8(len(ifACLs.input)),
+		}
+		d.Acls = append(append(d.Acls, ifACLs.input...), ifACLs.output...)
+		d.Count = uint8(len(d.Acls))
+		details = append(details, d)
+	}
+	return details
+}
+
+// interfaceACLs returns ACLs assigned to the interface.
+func (s *VPP) interfaceACLs(swIfIndex uint32) *aclInterface {
+	ifACLs, ok := s.aclIfaces[swIfIndex]
+	if !ok {
+		ifACLs = &aclInterface{}
+		s.aclIfaces[swIfIndex] = ifACLs
+	}
+	return ifACLs
+}
+
+// removeACLInterface removes ACLs assigned to the interface.
+func (s *VPP) removeACLInterface(swIfIndex uint32) {
+	delete(s.aclIfaces, swIfIndex)
+}
+
+func indexOf(list []uint32, value uint32) int {
+	for i, v := range list {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
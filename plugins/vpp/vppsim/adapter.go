@@ -0,0 +1,112 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppsim
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"git.fd.io/govpp.git/adapter"
+	"github.com/pkg/errors"
+)
+
+// Adapter is VPP binary API adapter connected to simulated VPP.
+type Adapter struct {
+	vpp *VPP
+
+	mu          sync.RWMutex
+	connected   bool
+	clientIndex uint32
+	callback    adapter.MsgCallback
+}
+
+func (a *Adapter) Connect() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if !a.connected {
+		a.clientIndex = a.vpp.newClient()
+		a.connected = true
+	}
+	return nil
+}
+
+func (a *Adapter) Disconnect() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.connected = false
+	return nil
+}
+
+func (a *Adapter) WaitReady() error {
+	return nil
+}
+
+func (a *Adapter) SetMsgCallback(cb adapter.MsgCallback) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.callback = cb
+}
+
+// GetMsgID returns ID of the message, only messages of the simulated
+// binary API version are known.
+func (a *Adapter) GetMsgID(msgName string, msgCrc string) (uint16, error) {
+	return a.vpp.msgID(msgName, msgCrc)
+}
+
+// SendMsg processes the request by simulated VPP and passes its replies
+// to the callback before returning.
+func (a *Adapter) SendMsg(context uint32, data []byte) error {
+	if len(data) < requestHeaderSize {
+		return errors.Errorf("invalid message data, length must be at least %d bytes", requestHeaderSize)
+	}
+	a.mu.RLock()
+	connected, clientIndex, cb := a.connected, a.clientIndex, a.callback
+	a.mu.RUnlock()
+
+	if !connected {
+		return errors.New("not connected to simulated VPP")
+	}
+	replies, err := a.vpp.process(clientIndex, context, data)
+	if err != nil {
+		return err
+	}
+	if cb != nil {
+		for _, reply := range replies {
+			cb(binary.BigEndian.Uint16(reply[0:2]), reply)
+		}
+	}
+	return nil
+}
+
+// newClient returns index for newly connected client.
+func (s *VPP) newClient() uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.numClients++
+	return s.numClients
+}
+
+// msgID returns ID of the message with the name and CRC.
+func (s *VPP) msgID(msgName string, msgCrc string) (uint16, error) {
+	// message IDs are assigned in New and never change
+	if msgID, ok := s.msgIDs[msgName+"_"+msgCrc]; ok {
+		return msgID, nil
+	}
+	return 0, &adapter.UnknownMsgError{MsgName: msgName, MsgCrc: msgCrc}
+}
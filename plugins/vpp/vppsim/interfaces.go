@@ -0,0 +1,603 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppsim
+
+import (
+	"fmt"
+	"strings"
+
+	"git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/af_packet"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ethernet_types"
+	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/memif"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/tapv2"
+)
+
+const (
+	defaultMtu         = 9000
+	defaultMemifSocket = "/run/vpp/memif.sock"
+	defaultRingSize    = 1024
+	defaultBufferSize  = 2048
+
+	allInterfaces = ^interface_types.InterfaceIndex(0)
+
+	adminUp = interface_types.IF_STATUS_API_FLAG_ADMIN_UP
+	linkUp  = interface_types.IF_STATUS_API_FLAG_LINK_UP
+)
+
+// ifState holds interfaces of simulated VPP.
+type ifState struct {
+	ifaces       map[uint32]*iface
+	nextIfIndex  uint32
+	memifSockets map[uint32]string
+}
+
+// iface is simulated VPP interface.
+type iface struct {
+	details  interfaces.SwInterfaceDetails
+	vrf      [2]uint32 // IPv4, IPv6
+	addrs    []ip_types.AddressWithPrefix
+	tap      *tapv2.SwInterfaceTapV2Details
+	memif    *memif.MemifDetails
+	afPacket *af_packet.AfPacketDetails
+}
+
+func (i *iface) name() string {
+	return i.details.InterfaceName
+}
+
+func (s *VPP) registerInterfaces() {
+	s.ifaces = make(map[uint32]*iface)
+	s.memifSockets = map[uint32]string{0: defaultMemifSocket}
+	s.addInterface("local0", "local", ethernet_types.MacAddress{})
+
+	s.handle(&interfaces.SwInterfaceDump{}, s.swInterfaceDump)
+	s.handle(&interfaces.SwInterfaceSetFlags{}, s.swInterfaceSetFlags)
+	s.handle(&interfaces.SwInterfaceTagAddDel{}, s.swInterfaceTagAddDel)
+	s.handle(&interfaces.SwInterfaceAddDelAddress{}, s.swInterfaceAddDelAddress)
+	s.handle(&interfaces.SwInterfaceSetTable{}, s.swInterfaceSetTable)
+	s.handle(&interfaces.SwInterfaceGetTable{}, s.swInterfaceGetTable)
+	s.handle(&interfaces.HwInterfaceSetMtu{}, s.hwInterfaceSetMtu)
+	s.handle(&interfaces.SwInterfaceSetMtu{}, s.swInterfaceSetMtu)
+	s.handle(&interfaces.SwInterfaceSetMacAddress{}, s.swInterfaceSetMacAddress)
+	s.handle(&interfaces.CreateLoopback{}, s.createLoopback)
+	s.handle(&interfaces.CreateLoopbackInstance{}, s.createLoopbackInstance)
+	s.handle(&interfaces.DeleteLoopback{}, s.deleteLoopback)
+	s.handle(&ip.IPAddressDump{}, s.ipAddressDump)
+	s.handle(&tapv2.TapCreateV2{}, s.tapCreateV2)
+	s.handle(&tapv2.TapDeleteV2{}, s.tapDeleteV2)
+	s.handle(&tapv2.SwInterfaceTapV2Dump{}, s.swInterfaceTapV2Dump)
+	s.handle(&memif.MemifSocketFilenameAddDel{}, s.memifSocketFilenameAddDel)
+	s.handle(&memif.MemifSocketFilenameDump{}, s.memifSocketFilenameDump)
+	s.handle(&memif.MemifCreate{}, s.memifCreate)
+	s.handle(&memif.MemifDelete{}, s.memifDelete)
+	s.handle(&memif.MemifDump{}, s.memifDump)
+	s.handle(&af_packet.AfPacketCreate{}, s.afPacketCreate)
+	s.handle(&af_packet.AfPacketDelete{}, s.afPacketDelete)
+	s.handle(&af_packet.AfPacketDump{}, s.afPacketDump)
+}
+
+// addInterface creates new interface with the next free index,
+// MAC address is generated from the index if not set.
+func (s *VPP) addInterface(name, devType string, mac ethernet_types.MacAddress) *iface {
+	swIfIndex := s.nextIfIndex
+	s.nextIfIndex++
+	if mac == (ethernet_types.MacAddress{}) && swIfIndex != 0 {
+		mac = ethernet_types.MacAddress{0x02, 0xfe, 0, 0, byte(swIfIndex >> 8), byte(swIfIndex)}
+	}
+	i := &iface{
+		details: interfaces.SwInterfaceDetails{
+			SwIfIndex:        interface_types.InterfaceIndex(swIfIndex),
+			SupSwIfIndex:     swIfIndex,
+			L2Address:        mac,
+			LinkMtu:          defaultMtu,
+			Mtu:              []uint32{defaultMtu, 0, 0, 0},
+			InterfaceName:    name,
+			InterfaceDevType: devType,
+		},
+	}
+	s.ifaces[swIfIndex] = i
+	return i
+}
+
+// removeInterface removes the interface and its configuration
+// (L2, ACL and NAT) from all tables.
+func (s *VPP) removeInterface(swIfIndex uint32) {
+	delete(s.ifaces, swIfIndex)
+	s.removeL2Interface(swIfIndex)
+	s.removeACLInterface(swIfIndex)
+	s.removeNATInterface(swIfIndex)
+}
+
+// interfaceByName returns interface with the internal name or nil.
+func (s *VPP) interfaceByName(name string) *iface {
+	for _, i := range s.ifaces {
+		if i.name() == name {
+			return i
+		}
+	}
+	return nil
+}
+
+// freeInstance returns the lowest instance number not used by interface
+// with the name prefix.
+func (s *VPP) freeInstance(prefix string) uint32 {
+	for instance := uint32(0); ; instance++ {
+		if s.interfaceByName(fmt.Sprintf("%s%d", prefix, instance)) == nil {
+			return instance
+		}
+	}
+}
+
+func (s *VPP) swInterfaceDump(req api.Message) []api.Message {
+	r := req.(*interfaces.SwInterfaceDump)
+
+	var details []api.Message
+	for _, idx := range sortedKeys(s.ifaces) {
+		i := s.ifaces[idx]
+		if r.SwIfIndex != allInterfaces && r.SwIfIndex != i.details.SwIfIndex {
+			continue
+		}
+		if r.NameFilterValid && !strings.Contains(i.name(), r.NameFilter) {
+			continue
+		}
+		d := i.details
+		d.Mtu = append([]uint32(nil), i.details.Mtu...)
+		details = append(details, &d)
+	}
+	return details
+}
+
+func (s *VPP) swInterfaceSetFlags(req api.Message) []api.Message {
+	r := req.(*interfaces.SwInterfaceSetFlags)
+	reply := &interfaces.SwInterfaceSetFlagsReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	// link of simulated interface is up whenever it is enabled
+	if r.Flags&adminUp != 0 {
+		i.details.Flags = adminUp | linkUp
+	} else {
+		i.details.Flags = 0
+	}
+	if i.memif != nil {
+		i.memif.Flags = i.details.Flags
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) swInterfaceTagAddDel(req api.Message) []api.Message {
+	r := req.(*interfaces.SwInterfaceTagAddDel)
+	reply := &interfaces.SwInterfaceTagAddDelReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	if r.IsAdd {
+		i.details.Tag = r.Tag
+	} else {
+		i.details.Tag = ""
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) swInterfaceAddDelAddress(req api.Message) []api.Message {
+	r := req.(*interfaces.SwInterfaceAddDelAddress)
+	reply := &interfaces.SwInterfaceAddDelAddressReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	if r.DelAll {
+		i.addrs = nil
+		return []api.Message{reply}
+	}
+	addr := r.Prefix.String()
+	for j, a := range i.addrs {
+		if a.String() != addr {
+			continue
+		}
+		if r.IsAdd {
+			reply.Retval = errInvalidValue
+		} else {
+			i.addrs = append(i.addrs[:j], i.addrs[j+1:]...)
+		}
+		return []api.Message{reply}
+	}
+	if r.IsAdd {
+		i.addrs = append(i.addrs, r.Prefix)
+	} else {
+		reply.Retval = errNoSuchEntry
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) ipAddressDump(req api.Message) []api.Message {
+	r := req.(*ip.IPAddressDump)
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		return nil
+	}
+	var details []api.Message
+	for _, addr := range i.addrs {
+		if isIPv6(addr.Address) != r.IsIPv6 {
+			continue
+		}
+		details = append(details, &ip.IPAddressDetails{
+			SwIfIndex: r.SwIfIndex,
+			Prefix:    addr,
+		})
+	}
+	return details
+}
+
+func (s *VPP) swInterfaceSetTable(req api.Message) []api.Message {
+	r := req.(*interfaces.SwInterfaceSetTable)
+	reply := &interfaces.SwInterfaceSetTableReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	if _, ok := s.tables[tableKey{r.VrfID, r.IsIPv6}]; !ok {
+		reply.Retval = errNoSuchFib
+		return []api.Message{reply}
+	}
+	i.vrf[familyIndex(r.IsIPv6)] = r.VrfID
+	return []api.Message{reply}
+}
+
+func (s *VPP) swInterfaceGetTable(req api.Message) []api.Message {
+	r := req.(*interfaces.SwInterfaceGetTable)
+	reply := &interfaces.SwInterfaceGetTableReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	reply.VrfID = i.vrf[familyIndex(r.IsIPv6)]
+	return []api.Message{reply}
+}
+
+func (s *VPP) hwInterfaceSetMtu(req api.Message) []api.Message {
+	r := req.(*interfaces.HwInterfaceSetMtu)
+	reply := &interfaces.HwInterfaceSetMtuReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	i.details.LinkMtu = r.Mtu
+	i.details.Mtu[0] = uint32(r.Mtu)
+	return []api.Message{reply}
+}
+
+func (s *VPP) swInterfaceSetMtu(req api.Message) []api.Message {
+	r := req.(*interfaces.SwInterfaceSetMtu)
+	reply := &interfaces.SwInterfaceSetMtuReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	copy(i.details.Mtu, r.Mtu)
+	return []api.Message{reply}
+}
+
+func (s *VPP) swInterfaceSetMacAddress(req api.Message) []api.Message {
+	r := req.(*interfaces.SwInterfaceSetMacAddress)
+	reply := &interfaces.SwInterfaceSetMacAddressReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	i.details.L2Address = r.MacAddress
+	return []api.Message{reply}
+}
+
+func (s *VPP) createLoopback(req api.Message) []api.Message {
+	r := req.(*interfaces.CreateLoopback)
+
+	name := fmt.Sprintf("loop%d", s.freeInstance("loop"))
+	i := s.addInterface(name, "Loopback", r.MacAddress)
+	return []api.Message{&interfaces.CreateLoopbackReply{
+		SwIfIndex: i.details.SwIfIndex,
+	}}
+}
+
+func (s *VPP) createLoopbackInstance(req api.Message) []api.Message {
+	r := req.(*interfaces.CreateLoopbackInstance)
+	reply := &interfaces.CreateLoopbackInstanceReply{}
+
+	instance := s.freeInstance("loop")
+	if r.IsSpecified {
+		instance = r.UserInstance
+	}
+	name := fmt.Sprintf("loop%d", instance)
+	if s.interfaceByName(name) != nil {
+		reply.Retval = errInvalidValue
+		return []api.Message{reply}
+	}
+	reply.SwIfIndex = s.addInterface(name, "Loopback", r.MacAddress).details.SwIfIndex
+	return []api.Message{reply}
+}
+
+func (s *VPP) deleteLoopback(req api.Message) []api.Message {
+	r := req.(*interfaces.DeleteLoopback)
+	reply := &interfaces.DeleteLoopbackReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok || !strings.HasPrefix(i.name(), "loop") {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	s.removeInterface(uint32(r.SwIfIndex))
+	return []api.Message{reply}
+}
+
+func (s *VPP) tapCreateV2(req api.Message) []api.Message {
+	r := req.(*tapv2.TapCreateV2)
+	reply := &tapv2.TapCreateV2Reply{}
+
+	prefix := "tap"
+	if r.TapFlags&tapv2.TAP_API_FLAG_TUN != 0 {
+		prefix = "tun"
+	}
+	id := r.ID
+	if id == ^uint32(0) {
+		id = s.freeInstance(prefix)
+	}
+	name := fmt.Sprintf("%s%d", prefix, id)
+	if s.interfaceByName(name) != nil {
+		reply.Retval = errInvalidValue
+		return []api.Message{reply}
+	}
+	var mac ethernet_types.MacAddress
+	if !r.UseRandomMac {
+		mac = r.MacAddress
+	}
+	i := s.addInterface(name, "virtio", mac)
+	i.tap = &tapv2.SwInterfaceTapV2Details{
+		SwIfIndex:     uint32(i.details.SwIfIndex),
+		ID:            id,
+		TxRingSz:      r.TxRingSz,
+		RxRingSz:      r.RxRingSz,
+		HostMtuSize:   r.HostMtuSize,
+		HostMacAddr:   r.HostMacAddr,
+		HostIP4Prefix: r.HostIP4Prefix,
+		HostIP6Prefix: r.HostIP6Prefix,
+		TapFlags:      r.TapFlags,
+		DevName:       name,
+		HostIfName:    name,
+		HostNamespace: r.HostNamespace,
+		HostBridge:    r.HostBridge,
+	}
+	if r.HostIfNameSet {
+		i.tap.HostIfName = r.HostIfName
+	}
+	reply.SwIfIndex = i.details.SwIfIndex
+	return []api.Message{reply}
+}
+
+func (s *VPP) tapDeleteV2(req api.Message) []api.Message {
+	r := req.(*tapv2.TapDeleteV2)
+	reply := &tapv2.TapDeleteV2Reply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok || i.tap == nil {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	s.removeInterface(uint32(r.SwIfIndex))
+	return []api.Message{reply}
+}
+
+func (s *VPP) swInterfaceTapV2Dump(req api.Message) []api.Message {
+	r := req.(*tapv2.SwInterfaceTapV2Dump)
+
+	var details []api.Message
+	for _, idx := range sortedKeys(s.ifaces) {
+		i := s.ifaces[idx]
+		if i.tap == nil || (r.SwIfIndex != allInterfaces && r.SwIfIndex != i.details.SwIfIndex) {
+			continue
+		}
+		d := *i.tap
+		details = append(details, &d)
+	}
+	return details
+}
+
+func (s *VPP) memifSocketFilenameAddDel(req api.Message) []api.Message {
+	r := req.(*memif.MemifSocketFilenameAddDel)
+	reply := &memif.MemifSocketFilenameAddDelReply{}
+
+	_, exists := s.memifSockets[r.SocketID]
+	switch {
+	case r.SocketID == 0 || r.SocketID == ^uint32(0):
+		// default socket cannot be modified
+		reply.Retval = errInvalidValue
+	case r.IsAdd && (exists || r.SocketFilename == ""):
+		reply.Retval = errInvalidValue
+	case r.IsAdd:
+		s.memifSockets[r.SocketID] = r.SocketFilename
+	case !exists:
+		reply.Retval = errNoSuchEntry
+	case s.memifSocketInUse(r.SocketID):
+		reply.Retval = errInvalidValue
+	default:
+		delete(s.memifSockets, r.SocketID)
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) memifSocketInUse(socketID uint32) bool {
+	for _, i := range s.ifaces {
+		if i.memif != nil && i.memif.SocketID == socketID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *VPP) memifSocketFilenameDump(api.Message) []api.Message {
+	var details []api.Message
+	for _, id := range sortedKeys(s.memifSockets) {
+		details = append(details, &memif.MemifSocketFilenameDetails{
+			SocketID:       id,
+			SocketFilename: s.memifSockets[id],
+		})
+	}
+	return details
+}
+
+func (s *VPP) memifCreate(req api.Message) []api.Message {
+	r := req.(*memif.MemifCreate)
+	reply := &memif.MemifCreateReply{}
+
+	if _, ok := s.memifSockets[r.SocketID]; !ok {
+		reply.Retval = errInvalidValue
+		return []api.Message{reply}
+	}
+	name := fmt.Sprintf("memif%d/%d", r.SocketID, r.ID)
+	if s.interfaceByName(name) != nil {
+		reply.Retval = errInvalidValue
+		return []api.Message{reply}
+	}
+	i := s.addInterface(name, "memif", r.HwAddr)
+	i.memif = &memif.MemifDetails{
+		SwIfIndex:  i.details.SwIfIndex,
+		HwAddr:     i.details.L2Address,
+		ID:         r.ID,
+		Role:       r.Role,
+		Mode:       r.Mode,
+		ZeroCopy:   !r.NoZeroCopy,
+		SocketID:   r.SocketID,
+		RingSize:   r.RingSize,
+		BufferSize: r.BufferSize,
+		IfName:     name,
+	}
+	if i.memif.RingSize == 0 {
+		i.memif.RingSize = defaultRingSize
+	}
+	if i.memif.BufferSize == 0 {
+		i.memif.BufferSize = defaultBufferSize
+	}
+	reply.SwIfIndex = i.details.SwIfIndex
+	return []api.Message{reply}
+}
+
+func (s *VPP) memifDelete(req api.Message) []api.Message {
+	r := req.(*memif.MemifDelete)
+	reply := &memif.MemifDeleteReply{}
+
+	i, ok := s.ifaces[uint32(r.SwIfIndex)]
+	if !ok || i.memif == nil {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	s.removeInterface(uint32(r.SwIfIndex))
+	return []api.Message{reply}
+}
+
+func (s *VPP) memifDump(api.Message) []api.Message {
+	var details []api.Message
+	for _, idx := range sortedKeys(s.ifaces) {
+		i := s.ifaces[idx]
+		if i.memif == nil {
+			continue
+		}
+		d := *i.memif
+		d.HwAddr = i.details.L2Address
+		details = append(details, &d)
+	}
+	return details
+}
+
+func (s *VPP) afPacketCreate(req api.Message) []api.Message {
+	r := req.(*af_packet.AfPacketCreate)
+	reply := &af_packet.AfPacketCreateReply{}
+
+	name := "host-" + r.HostIfName
+	if r.HostIfName == "" || s.interfaceByName(name) != nil {
+		reply.Retval = errInvalidValue
+		return []api.Message{reply}
+	}
+	var mac ethernet_types.MacAddress
+	if !r.UseRandomHwAddr {
+		mac = r.HwAddr
+	}
+	i := s.addInterface(name, "af-packet", mac)
+	i.afPacket = &af_packet.AfPacketDetails{
+		SwIfIndex:  i.details.SwIfIndex,
+		HostIfName: r.HostIfName,
+	}
+	reply.SwIfIndex = i.details.SwIfIndex
+	return []api.Message{reply}
+}
+
+func (s *VPP) afPacketDelete(req api.Message) []api.Message {
+	r := req.(*af_packet.AfPacketDelete)
+	reply := &af_packet.AfPacketDeleteReply{}
+
+	i := s.interfaceByName("host-" + r.HostIfName)
+	if i == nil || i.afPacket == nil {
+		reply.Retval = errNoSuchEntry
+		return []api.Message{reply}
+	}
+	s.removeInterface(uint32(i.details.SwIfIndex))
+	return []api.Message{reply}
+}
+
+func (s *VPP) afPacketDump(api.Message) []api.Message {
+	var details []api.Message
+	for _, idx := range sortedKeys(s.ifaces) {
+		if i := s.ifaces[idx]; i.afPacket != nil {
+			d := *i.afPacket
+			details = append(details, &d)
+		}
+	}
+	return details
+}
+
+func isIPv6(addr ip_types.Address) bool {
+	return addr.Af == ip_types.ADDRESS_IP6
+}
+
+// familyIndex returns index of the address family in per-family arrays.
+func familyIndex(ipv6 bool) int {
+	if ipv6 {
+		return 1
+	}
+	return 0
+}
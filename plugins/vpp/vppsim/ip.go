@@ -0,0 +1,197 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppsim
+
+import (
+	"fmt"
+	"sort"
+
+	"git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/fib_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ip"
+)
+
+// ipState holds VRF tables and routes of simulated VPP.
+type ipState struct {
+	tables         map[tableKey]*vrfTable
+	nextStatsIndex uint32
+}
+
+type tableKey struct {
+	id   uint32
+	ipv6 bool
+}
+
+type vrfTable struct {
+	ip.IPTable
+	routes map[string]*ip.IPRoute // prefix -> route
+}
+
+func (s *VPP) registerIP() {
+	s.tables = make(map[tableKey]*vrfTable)
+	s.addTable(ip.IPTable{TableID: 0, IsIP6: false})
+	s.addTable(ip.IPTable{TableID: 0, IsIP6: true})
+
+	s.handle(&ip.IPTableAddDel{}, s.ipTableAddDel)
+	s.handle(&ip.IPTableDump{}, s.ipTableDump)
+	s.handle(&ip.IPRouteAddDel{}, s.ipRouteAddDel)
+	s.handle(&ip.IPRouteDump{}, s.ipRouteDump)
+}
+
+func (s *VPP) addTable(table ip.IPTable) {
+	if table.Name == "" {
+		family := "ipv4"
+		if table.IsIP6 {
+			family = "ipv6"
+		}
+		table.Name = fmt.Sprintf("%s-VRF:%d", family, table.TableID)
+	}
+	key := tableKey{table.TableID, table.IsIP6}
+	if t, ok := s.tables[key]; ok {
+		t.Name = table.Name
+		return
+	}
+	s.tables[key] = &vrfTable{
+		IPTable: table,
+		routes:  make(map[string]*ip.IPRoute),
+	}
+}
+
+func (s *VPP) ipTableAddDel(req api.Message) []api.Message {
+	r := req.(*ip.IPTableAddDel)
+	reply := &ip.IPTableAddDelReply{}
+
+	key := tableKey{r.Table.TableID, r.Table.IsIP6}
+	switch {
+	case r.IsAdd:
+		s.addTable(r.Table)
+	case r.Table.TableID == 0:
+		// default table cannot be removed
+	default:
+		if _, ok := s.tables[key]; !ok {
+			reply.Retval = errNoSuchFib
+			break
+		}
+		delete(s.tables, key)
+		for _, i := range s.ifaces {
+			if i.vrf[familyIndex(r.Table.IsIP6)] == r.Table.TableID {
+				i.vrf[familyIndex(r.Table.IsIP6)] = 0
+			}
+		}
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) ipTableDump(api.Message) []api.Message {
+	keys := make([]tableKey, 0, len(s.tables))
+	for key := range s.tables {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].ipv6 != keys[j].ipv6 {
+			return !keys[i].ipv6
+		}
+		return keys[i].id < keys[j].id
+	})
+	var details []api.Message
+	for _, key := range keys {
+		details = append(details, &ip.IPTableDetails{Table: s.tables[key].IPTable})
+	}
+	return details
+}
+
+func (s *VPP) ipRouteAddDel(req api.Message) []api.Message {
+	r := req.(*ip.IPRouteAddDel)
+	reply := &ip.IPRouteAddDelReply{}
+
+	table, ok := s.tables[tableKey{r.Route.TableID, isIPv6(r.Route.Prefix.Address)}]
+	if !ok {
+		reply.Retval = errNoSuchFib
+		return []api.Message{reply}
+	}
+	prefix := r.Route.Prefix.String()
+	route, exists := table.routes[prefix]
+
+	switch {
+	case r.IsAdd && r.IsMultipath && exists:
+		for _, path := range r.Route.Paths {
+			if findPath(route.Paths, path) < 0 {
+				route.Paths = append(route.Paths, path)
+			}
+		}
+	case r.IsAdd:
+		route = &ip.IPRoute{
+			TableID:    r.Route.TableID,
+			StatsIndex: s.nextStatsIndex,
+			Prefix:     r.Route.Prefix,
+			Paths:      append([]fib_types.FibPath(nil), r.Route.Paths...),
+		}
+		table.routes[prefix] = route
+		s.nextStatsIndex++
+	case !exists:
+		reply.Retval = errNoSuchEntry
+		return []api.Message{reply}
+	case r.IsMultipath:
+		for _, path := range r.Route.Paths {
+			if j := findPath(route.Paths, path); j >= 0 {
+				route.Paths = append(route.Paths[:j], route.Paths[j+1:]...)
+			}
+		}
+		if len(route.Paths) == 0 {
+			delete(table.routes, prefix)
+		}
+	default:
+		delete(table.routes, prefix)
+	}
+	if route != nil {
+		reply.StatsIndex = route.StatsIndex
+	}
+	return []api.Message{reply}
+}
+
+// findPath returns index of the path with the same next hop and interface.
+func findPath(paths []fib_types.FibPath, path fib_types.FibPath) int {
+	for i, p := range paths {
+		if p.SwIfIndex == path.SwIfIndex && p.TableID == path.TableID &&
+			p.Nh.Address == path.Nh.Address {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *VPP) ipRouteDump(req api.Message) []api.Message {
+	r := req.(*ip.IPRouteDump)
+
+	table, ok := s.tables[tableKey{r.Table.TableID, r.Table.IsIP6}]
+	if !ok {
+		return nil
+	}
+	prefixes := make([]string, 0, len(table.routes))
+	for prefix := range table.routes {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	var details []api.Message
+	for _, prefix := range prefixes {
+		route := *table.routes[prefix]
+		route.Paths = append([]fib_types.FibPath(nil), route.Paths...)
+		route.NPaths = uint8(len(route.Paths))
+		details = append(details, &ip.IPRouteDetails{Route: route})
+	}
+	return details
+}
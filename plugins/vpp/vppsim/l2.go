@@ -0,0 +1,250 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppsim
+
+import (
+	"sort"
+
+	"git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ethernet_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/l2"
+)
+
+const allBridgeDomains = ^uint32(0)
+
+// l2State holds bridge domains and L2 FIB entries of simulated VPP.
+type l2State struct {
+	bds map[uint32]*bridgeDomain
+	fib map[fibKey]*l2.L2FibTableDetails
+}
+
+type bridgeDomain struct {
+	l2.BridgeDomainDetails
+	// interfaces of the bridge domain in order of assignment
+	ifaces []l2.BridgeDomainSwIf
+}
+
+type fibKey struct {
+	bdID uint32
+	mac  ethernet_types.MacAddress
+}
+
+func (s *VPP) registerL2() {
+	s.bds = make(map[uint32]*bridgeDomain)
+	s.fib = make(map[fibKey]*l2.L2FibTableDetails)
+
+	s.handle(&l2.BridgeDomainAddDel{}, s.bridgeDomainAddDel)
+	s.handle(&l2.BridgeDomainDump{}, s.bridgeDomainDump)
+	s.handle(&l2.SwInterfaceSetL2Bridge{}, s.swInterfaceSetL2Bridge)
+	s.handle(&l2.L2fibAddDel{}, s.l2fibAddDel)
+	s.handle(&l2.L2FibTableDump{}, s.l2FibTableDump)
+}
+
+func (s *VPP) addBridgeDomain(bd l2.BridgeDomainDetails) {
+	bd.BviSwIfIndex = allInterfaces
+	bd.UuFwdSwIfIndex = allInterfaces
+	s.bds[bd.BdID] = &bridgeDomain{BridgeDomainDetails: bd}
+}
+
+func (s *VPP) bridgeDomainAddDel(req api.Message) []api.Message {
+	r := req.(*l2.BridgeDomainAddDel)
+	reply := &l2.BridgeDomainAddDelReply{}
+
+	bd, exists := s.bds[r.BdID]
+	switch {
+	case r.BdID == 0 || r.BdID == allBridgeDomains:
+		reply.Retval = errInvalidValue
+	case r.IsAdd && exists:
+		// existing bridge domain is updated
+		bd.Flood, bd.UuFlood, bd.Forward, bd.Learn = r.Flood, r.UuFlood, r.Forward, r.Learn
+		bd.ArpTerm, bd.ArpUfwd, bd.MacAge, bd.BdTag = r.ArpTerm, r.ArpUfwd, r.MacAge, r.BdTag
+	case r.IsAdd:
+		s.addBridgeDomain(l2.BridgeDomainDetails{
+			BdID:    r.BdID,
+			Flood:   r.Flood,
+			UuFlood: r.UuFlood,
+			Forward: r.Forward,
+			Learn:   r.Learn,
+			ArpTerm: r.ArpTerm,
+			ArpUfwd: r.ArpUfwd,
+			MacAge:  r.MacAge,
+			BdTag:   r.BdTag,
+		})
+	case !exists:
+		reply.Retval = errNoSuchEntry
+	case len(bd.ifaces) > 0:
+		// bridge domain with interfaces cannot be removed
+		reply.Retval = errInvalidValue
+	default:
+		delete(s.bds, r.BdID)
+		for key := range s.fib {
+			if key.bdID == r.BdID {
+				delete(s.fib, key)
+			}
+		}
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) bridgeDomainDump(req api.Message) []api.Message {
+	r := req.(*l2.BridgeDomainDump)
+
+	var details []api.Message
+	for _, id := range sortedKeys(s.bds) {
+		bd := s.bds[id]
+		if r.BdID != allBridgeDomains && r.BdID != id {
+			continue
+		}
+		if r.SwIfIndex != allInterfaces && bd.find(r.SwIfIndex) < 0 {
+			continue
+		}
+		d := bd.BridgeDomainDetails
+		d.SwIfDetails = append([]l2.BridgeDomainSwIf(nil), bd.ifaces...)
+		d.NSwIfs = uint32(len(d.SwIfDetails))
+		details = append(details, &d)
+	}
+	return details
+}
+
+func (bd *bridgeDomain) find(swIfIndex interface_types.InterfaceIndex) int {
+	for i, iface := range bd.ifaces {
+		if iface.SwIfIndex == swIfIndex {
+			return i
+		}
+	}
+	return -1
+}
+
+// removeInterface removes interface from the bridge domain.
+func (bd *bridgeDomain) removeInterface(swIfIndex interface_types.InterfaceIndex) {
+	if i := bd.find(swIfIndex); i >= 0 {
+		bd.ifaces = append(bd.ifaces[:i], bd.ifaces[i+1:]...)
+	}
+	if bd.BviSwIfIndex == swIfIndex {
+		bd.BviSwIfIndex = allInterfaces
+	}
+	if bd.UuFwdSwIfIndex == swIfIndex {
+		bd.UuFwdSwIfIndex = allInterfaces
+	}
+}
+
+func (s *VPP) swInterfaceSetL2Bridge(req api.Message) []api.Message {
+	r := req.(*l2.SwInterfaceSetL2Bridge)
+	reply := &l2.SwInterfaceSetL2BridgeReply{}
+
+	if _, ok := s.ifaces[uint32(r.RxSwIfIndex)]; !ok {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	// interface can be in a single bridge domain only
+	for _, bd := range s.bds {
+		bd.removeInterface(r.RxSwIfIndex)
+	}
+	if !r.Enable {
+		return []api.Message{reply}
+	}
+	bd, ok := s.bds[r.BdID]
+	if !ok {
+		// bridge domain is created by VPP with default settings
+		s.addBridgeDomain(l2.BridgeDomainDetails{
+			BdID:    r.BdID,
+			Flood:   true,
+			UuFlood: true,
+			Forward: true,
+			Learn:   true,
+		})
+		bd = s.bds[r.BdID]
+	}
+	bd.ifaces = append(bd.ifaces, l2.BridgeDomainSwIf{
+		SwIfIndex: r.RxSwIfIndex,
+		Shg:       r.Shg,
+	})
+	switch r.PortType {
+	case l2.L2_API_PORT_TYPE_BVI:
+		bd.BviSwIfIndex = r.RxSwIfIndex
+	case l2.L2_API_PORT_TYPE_UU_FWD:
+		bd.UuFwdSwIfIndex = r.RxSwIfIndex
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) l2fibAddDel(req api.Message) []api.Message {
+	r := req.(*l2.L2fibAddDel)
+	reply := &l2.L2fibAddDelReply{}
+
+	if _, ok := s.bds[r.BdID]; !ok {
+		reply.Retval = errNoSuchEntry
+		return []api.Message{reply}
+	}
+	key := fibKey{r.BdID, r.Mac}
+	if !r.IsAdd {
+		if _, ok := s.fib[key]; !ok {
+			reply.Retval = errNoSuchEntry
+			return []api.Message{reply}
+		}
+		delete(s.fib, key)
+		return []api.Message{reply}
+	}
+	if _, ok := s.ifaces[uint32(r.SwIfIndex)]; !ok && !r.FilterMac {
+		reply.Retval = errInvalidSwIfIndex
+		return []api.Message{reply}
+	}
+	s.fib[key] = &l2.L2FibTableDetails{
+		BdID:      r.BdID,
+		Mac:       r.Mac,
+		SwIfIndex: r.SwIfIndex,
+		StaticMac: r.StaticMac,
+		FilterMac: r.FilterMac,
+		BviMac:    r.BviMac,
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) l2FibTableDump(req api.Message) []api.Message {
+	r := req.(*l2.L2FibTableDump)
+
+	var entries []*l2.L2FibTableDetails
+	for key, entry := range s.fib {
+		if r.BdID == allBridgeDomains || r.BdID == key.bdID {
+			entries = append(entries, entry)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].BdID != entries[j].BdID {
+			return entries[i].BdID < entries[j].BdID
+		}
+		return entries[i].Mac.String() < entries[j].Mac.String()
+	})
+	var details []api.Message
+	for _, entry := range entries {
+		d := *entry
+		details = append(details, &d)
+	}
+	return details
+}
+
+// removeL2Interface removes the interface from bridge domains and L2 FIB.
+func (s *VPP) removeL2Interface(swIfIndex uint32) {
+	for _, bd := range s.bds {
+		bd.removeInterface(interface_types.InterfaceIndex(swIfIndex))
+	}
+	for key, entry := range s.fib {
+		if uint32(entry.SwIfIndex) == swIfIndex {
+			delete(s.fib, key)
+		}
+	}
+}
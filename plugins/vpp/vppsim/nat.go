@@ -0,0 +1,291 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppsim
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/nat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/nat_types"
+)
+
+const (
+	natInside   = nat_types.NAT_IS_INSIDE
+	natOutside  = nat_types.NAT_IS_OUTSIDE
+	natTwiceNat = nat_types.NAT_IS_TWICE_NAT
+)
+
+// natState holds NAT44 configuration of simulated VPP.
+type natState struct {
+	natIfaces        map[uint32]nat_types.NatConfigFlags
+	natOutputIfaces  map[uint32]nat_types.NatConfigFlags
+	natAddrs         map[natAddrKey]*nat.Nat44AddressDetails
+	staticMappings   map[string]*nat.Nat44StaticMappingDetails
+	identityMappings map[string]*nat.Nat44IdentityMappingDetails
+}
+
+type natAddrKey struct {
+	addr     ip_types.IP4Address
+	twiceNat bool
+}
+
+func (s *VPP) registerNAT() {
+	s.natIfaces = make(map[uint32]nat_types.NatConfigFlags)
+	s.natOutputIfaces = make(map[uint32]nat_types.NatConfigFlags)
+	s.natAddrs = make(map[natAddrKey]*nat.Nat44AddressDetails)
+	s.staticMappings = make(map[string]*nat.Nat44StaticMappingDetails)
+	s.identityMappings = make(map[string]*nat.Nat44IdentityMappingDetails)
+
+	s.handle(&nat.Nat44InterfaceAddDelFeature{}, s.nat44InterfaceAddDelFeature)
+	s.handle(&nat.Nat44InterfaceDump{}, s.nat44InterfaceDump)
+	s.handle(&nat.Nat44InterfaceAddDelOutputFeature{}, s.nat44InterfaceAddDelOutputFeature)
+	s.handle(&nat.Nat44InterfaceOutputFeatureDump{}, s.nat44InterfaceOutputFeatureDump)
+	s.handle(&nat.Nat44AddDelAddressRange{}, s.nat44AddDelAddressRange)
+	s.handle(&nat.Nat44AddressDump{}, s.nat44AddressDump)
+	s.handle(&nat.Nat44AddDelStaticMapping{}, s.nat44AddDelStaticMapping)
+	s.handle(&nat.Nat44AddDelStaticMappingV2{}, s.nat44AddDelStaticMappingV2)
+	s.handle(&nat.Nat44StaticMappingDump{}, s.nat44StaticMappingDump)
+	s.handle(&nat.Nat44AddDelIdentityMapping{}, s.nat44AddDelIdentityMapping)
+	s.handle(&nat.Nat44IdentityMappingDump{}, s.nat44IdentityMappingDump)
+}
+
+// setNatFeature enables or disables NAT feature (inside or outside) of the interface.
+func (s *VPP) setNatFeature(features map[uint32]nat_types.NatConfigFlags,
+	swIfIndex interface_types.InterfaceIndex, flags nat_types.NatConfigFlags, isAdd bool) int32 {
+	if _, ok := s.ifaces[uint32(swIfIndex)]; !ok {
+		return errInvalidSwIfIndex
+	}
+	side := natOutside
+	if flags&natInside != 0 {
+		side = natInside
+	}
+	enabled := features[uint32(swIfIndex)]
+	switch {
+	case isAdd && enabled&side != 0:
+		return errInvalidValue
+	case isAdd:
+		features[uint32(swIfIndex)] = enabled | side
+	case enabled&side == 0:
+		return errNoSuchEntry
+	case enabled == side:
+		delete(features, uint32(swIfIndex))
+	default:
+		features[uint32(swIfIndex)] = enabled &^ side
+	}
+	return 0
+}
+
+func (s *VPP) nat44InterfaceAddDelFeature(req api.Message) []api.Message {
+	r := req.(*nat.Nat44InterfaceAddDelFeature)
+	return []api.Message{&nat.Nat44InterfaceAddDelFeatureReply{
+		Retval: s.setNatFeature(s.natIfaces, r.SwIfIndex, r.Flags, r.IsAdd),
+	}}
+}
+
+func (s *VPP) nat44InterfaceDump(api.Message) []api.Message {
+	var details []api.Message
+	for _, idx := range sortedKeys(s.natIfaces) {
+		details = append(details, &nat.Nat44InterfaceDetails{
+			SwIfIndex: interface_types.InterfaceIndex(idx),
+			Flags:     s.natIfaces[idx],
+		})
+	}
+	return details
+}
+
+func (s *VPP) nat44InterfaceAddDelOutputFeature(req api.Message) []api.Message {
+	r := req.(*nat.Nat44InterfaceAddDelOutputFeature)
+	return []api.Message{&nat.Nat44InterfaceAddDelOutputFeatureReply{
+		Retval: s.setNatFeature(s.natOutputIfaces, r.SwIfIndex, r.Flags, r.IsAdd),
+	}}
+}
+
+func (s *VPP) nat44InterfaceOutputFeatureDump(api.Message) []api.Message {
+	var details []api.Message
+	for _, idx := range sortedKeys(s.natOutputIfaces) {
+		details = append(details, &nat.Nat44InterfaceOutputFeatureDetails{
+			SwIfIndex: interface_types.InterfaceIndex(idx),
+			Flags:     s.natOutputIfaces[idx],
+		})
+	}
+	return details
+}
+
+func (s *VPP) nat44AddDelAddressRange(req api.Message) []api.Message {
+	r := req.(*nat.Nat44AddDelAddressRange)
+	reply := &nat.Nat44AddDelAddressRangeReply{}
+
+	first := binary.BigEndian.Uint32(r.FirstIPAddress[:])
+	last := binary.BigEndian.Uint32(r.LastIPAddress[:])
+	if last < first {
+		reply.Retval = errInvalidValue
+		return []api.Message{reply}
+	}
+	var keys []natAddrKey
+	for ip := first; ip <= last && ip >= first; ip++ {
+		key := natAddrKey{twiceNat: r.Flags&natTwiceNat != 0}
+		binary.BigEndian.PutUint32(key.addr[:], ip)
+		_, exists := s.natAddrs[key]
+		if r.IsAdd && exists {
+			reply.Retval = errInvalidValue
+			return []api.Message{reply}
+		} else if !r.IsAdd && !exists {
+			reply.Retval = errNoSuchEntry
+			return []api.Message{reply}
+		}
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		if !r.IsAdd {
+			delete(s.natAddrs, key)
+			continue
+		}
+		s.natAddrs[key] = &nat.Nat44AddressDetails{
+			IPAddress: key.addr,
+			Flags:     r.Flags & natTwiceNat,
+			VrfID:     r.VrfID,
+		}
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) nat44AddressDump(api.Message) []api.Message {
+	var addrs []*nat.Nat44AddressDetails
+	for _, addr := range s.natAddrs {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		a := binary.BigEndian.Uint32(addrs[i].IPAddress[:])
+		b := binary.BigEndian.Uint32(addrs[j].IPAddress[:])
+		if a != b {
+			return a < b
+		}
+		return addrs[i].Flags < addrs[j].Flags
+	})
+	var details []api.Message
+	for _, addr := range addrs {
+		d := *addr
+		details = append(details, &d)
+	}
+	return details
+}
+
+func (s *VPP) nat44AddDelStaticMapping(req api.Message) []api.Message {
+	r := req.(*nat.Nat44AddDelStaticMapping)
+	return []api.Message{&nat.Nat44AddDelStaticMappingReply{
+		Retval: s.addDelStaticMapping(r.IsAdd, nat.Nat44StaticMappingDetails{
+			Flags:             r.Flags,
+			LocalIPAddress:    r.LocalIPAddress,
+			ExternalIPAddress: r.ExternalIPAddress,
+			Protocol:          r.Protocol,
+			LocalPort:         r.LocalPort,
+			ExternalPort:      r.ExternalPort,
+			ExternalSwIfIndex: r.ExternalSwIfIndex,
+			VrfID:             r.VrfID,
+			Tag:               r.Tag,
+		}),
+	}}
+}
+
+func (s *VPP) nat44AddDelStaticMappingV2(req api.Message) []api.Message {
+	r := req.(*nat.Nat44AddDelStaticMappingV2)
+	return []api.Message{&nat.Nat44AddDelStaticMappingV2Reply{
+		Retval: s.addDelStaticMapping(r.IsAdd, nat.Nat44StaticMappingDetails{
+			Flags:             r.Flags,
+			LocalIPAddress:    r.LocalIPAddress,
+			ExternalIPAddress: r.ExternalIPAddress,
+			Protocol:          r.Protocol,
+			LocalPort:         r.LocalPort,
+			ExternalPort:      r.ExternalPort,
+			ExternalSwIfIndex: r.ExternalSwIfIndex,
+			VrfID:             r.VrfID,
+			Tag:               r.Tag,
+		}),
+	}}
+}
+
+func (s *VPP) addDelStaticMapping(isAdd bool, m nat.Nat44StaticMappingDetails) int32 {
+	key := fmt.Sprintf("%s:%d-%s:%d/%d@%d#%d", m.LocalIPAddress, m.LocalPort,
+		m.ExternalIPAddress, m.ExternalPort, m.Protocol, m.VrfID, m.ExternalSwIfIndex)
+	_, exists := s.staticMappings[key]
+	switch {
+	case isAdd && exists:
+		return errInvalidValue
+	case isAdd:
+		s.staticMappings[key] = &m
+	case !exists:
+		return errNoSuchEntry
+	default:
+		delete(s.staticMappings, key)
+	}
+	return 0
+}
+
+func (s *VPP) nat44StaticMappingDump(api.Message) []api.Message {
+	var details []api.Message
+	for _, key := range sortedStrings(s.staticMappings) {
+		d := *s.staticMappings[key]
+		details = append(details, &d)
+	}
+	return details
+}
+
+func (s *VPP) nat44AddDelIdentityMapping(req api.Message) []api.Message {
+	r := req.(*nat.Nat44AddDelIdentityMapping)
+	reply := &nat.Nat44AddDelIdentityMappingReply{}
+
+	key := fmt.Sprintf("%s:%d/%d@%d#%d", r.IPAddress, r.Port, r.Protocol, r.VrfID, r.SwIfIndex)
+	_, exists := s.identityMappings[key]
+	switch {
+	case r.IsAdd && exists:
+		reply.Retval = errInvalidValue
+	case r.IsAdd:
+		s.identityMappings[key] = &nat.Nat44IdentityMappingDetails{
+			Flags:     r.Flags,
+			IPAddress: r.IPAddress,
+			Protocol:  r.Protocol,
+			Port:      r.Port,
+			SwIfIndex: r.SwIfIndex,
+			VrfID:     r.VrfID,
+			Tag:       r.Tag,
+		}
+	case !exists:
+		reply.Retval = errNoSuchEntry
+	default:
+		delete(s.identityMappings, key)
+	}
+	return []api.Message{reply}
+}
+
+func (s *VPP) nat44IdentityMappingDump(api.Message) []api.Message {
+	var details []api.Message
+	for _, key := range sortedStrings(s.identityMappings) {
+		d := *s.identityMappings[key]
+		details = append(details, &d)
+	}
+	return details
+}
+
+// removeNATInterface disables NAT features of the interface.
+func (s *VPP) removeNATInterface(swIfIndex uint32) {
+	delete(s.natIfaces, swIfIndex)
+	delete(s.natOutputIfaces, swIfIndex)
+}
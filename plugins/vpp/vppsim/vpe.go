@@ -0,0 +1,115 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppsim
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"git.fd.io/govpp.git/api"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/memclnt"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vpe"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vpe_types"
+)
+
+const pluginPath = "/usr/lib/x86_64-linux-gnu/vpp_plugins"
+
+// plugins are VPP plugins reported as loaded by the simulator,
+// i.e. plugins with simulated binary API.
+var plugins = []struct {
+	name, description string
+}{
+	{"acl", "Access Control Lists (ACL)"},
+	{"memif", "Packet Memory Interface (memif) -- Experimental"},
+	{"nat", "Network Address Translation (NAT)"},
+}
+
+func (s *VPP) registerVpe() {
+	s.handle(&vpe.ControlPing{}, s.controlPing)
+	s.handle(&vpe.ShowVersion{}, s.showVersion)
+	s.handle(&vpe.ShowVpeSystemTime{}, s.showVpeSystemTime)
+	s.handle(&vpe.ShowThreads{}, s.showThreads)
+	s.handle(&vpe.CliInband{}, s.cliInband)
+	s.handle(&memclnt.APIVersions{}, s.apiVersions)
+}
+
+func (s *VPP) controlPing(api.Message) []api.Message {
+	return []api.Message{&vpe.ControlPingReply{VpePID: s.pid}}
+}
+
+func (s *VPP) showVersion(api.Message) []api.Message {
+	return []api.Message{&vpe.ShowVersionReply{
+		Program:        "vpe",
+		Version:        Version,
+		BuildDate:      s.started.Format(time.UnixDate),
+		BuildDirectory: "vppsim",
+	}}
+}
+
+func (s *VPP) showVpeSystemTime(api.Message) []api.Message {
+	uptime := time.Since(s.started).Seconds()
+	return []api.Message{&vpe.ShowVpeSystemTimeReply{
+		VpeSystemTime: vpe_types.Timestamp(uptime),
+	}}
+}
+
+func (s *VPP) showThreads(api.Message) []api.Message {
+	return []api.Message{&vpe.ShowThreadsReply{
+		Count: 1,
+		ThreadData: []vpe.ThreadData{
+			{ID: 0, Name: "vpp_main", PID: s.pid},
+		},
+	}}
+}
+
+func (s *VPP) cliInband(req api.Message) []api.Message {
+	cmd := strings.Join(strings.Fields(req.(*vpe.CliInband).Cmd), " ")
+
+	var out strings.Builder
+	switch {
+	case strings.HasPrefix(cmd, "show version"), strings.HasPrefix(cmd, "sh version"):
+		fmt.Fprintf(&out, "vpp v%s built by vppsim on %s\n", Version, s.started.Format(time.UnixDate))
+	case cmd == "show plugins", cmd == "sh plugins":
+		fmt.Fprintf(&out, " Plugin path is: %s\n\n", pluginPath)
+		fmt.Fprintf(&out, "     %-40s %-32s %s\n", "Plugin", "Version", "Description")
+		for i, p := range plugins {
+			fmt.Fprintf(&out, "  %d. %-40s %-32s %s\n", i+1, p.name+"_plugin.so", Version, p.description)
+		}
+	}
+	return []api.Message{&vpe.CliInbandReply{Reply: out.String()}}
+}
+
+// apiVersions returns API modules of the simulated binary API version.
+func (s *VPP) apiVersions(api.Message) []api.Message {
+	modules := make(map[string]struct{})
+	for _, typ := range s.msgTypes {
+		modules[path.Base(typ.PkgPath())] = struct{}{}
+	}
+	reply := &memclnt.APIVersionsReply{}
+	for module := range modules {
+		reply.APIVersions = append(reply.APIVersions, memclnt.ModuleVersion{
+			Name: module + ".api",
+		})
+	}
+	sort.Slice(reply.APIVersions, func(i, j int) bool {
+		return reply.APIVersions[i].Name < reply.APIVersions[j].Name
+	})
+	reply.Count = uint32(len(reply.APIVersions))
+	return []api.Message{reply}
+}
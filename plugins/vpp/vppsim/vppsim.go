@@ -0,0 +1,228 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package vppsim implements in-process simulated VPP, which can be used
+// instead of real VPP for testing of the agent and its descriptors.
+//
+// The simulator implements semantics of commonly used binary API messages
+// for interfaces (loopback, tap, memif, af_packet), IP addresses, VRF tables,
+// routes, bridge domains, L2 FIB, ACLs and NAT44. It keeps its own tables,
+// so that objects created via the API are returned by dumps and removed by
+// delete requests. Other requests of the simulated binary API version fail
+// with retval VNET_API_ERROR_UNIMPLEMENTED and dump requests return no details.
+//
+// The agent started with flag -vpp=sim connects to simulated VPP instead
+// of real VPP, which allows running it without VPP and hugepages.
+package vppsim
+
+import (
+	"encoding/binary"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"git.fd.io/govpp.git/adapter"
+	"git.fd.io/govpp.git/api"
+	"github.com/pkg/errors"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vpe"
+)
+
+// Version is the VPP version reported by the simulator.
+const Version = "20.09-sim"
+
+// BinapiVersion is the version of binary API simulated by the simulator.
+const BinapiVersion = vpp2009.Version
+
+const (
+	requestHeaderSize = 10 // msgID (2) + client index (4) + context (4)
+	replyHeaderSize   = 6  // msgID (2) + context (4)
+)
+
+// Return values of VPP binary API used by the simulator.
+const (
+	errUnspecified      int32 = -1
+	errInvalidSwIfIndex int32 = -2
+	errNoSuchFib        int32 = -3
+	errNoSuchEntry      int32 = -6
+	errInvalidValue     int32 = -7
+	errUnimplemented    int32 = -8
+)
+
+// message is binary API message generated by binapi-generator.
+type message interface {
+	api.Message
+	Size() int
+	Marshal([]byte) ([]byte, error)
+	Unmarshal([]byte) error
+}
+
+// handler handles the request and returns its reply, or details
+// in case of dump request.
+type handler func(req api.Message) []api.Message
+
+// VPP is simulated VPP. Use NewAdapter to connect to it via GoVPP.
+type VPP struct {
+	mu sync.Mutex
+
+	msgIDs   map[string]uint16 // name_crc -> ID
+	msgNames map[string]uint16 // name -> ID
+	msgTypes map[uint16]reflect.Type
+	handlers map[string]handler
+
+	pid        uint32
+	started    time.Time
+	numClients uint32
+
+	ifState
+	ipState
+	l2State
+	aclState
+	natState
+}
+
+// New returns new simulated VPP with default configuration
+// (local0 interface, default VRF tables and memif socket).
+func New() *VPP {
+	s := &VPP{
+		msgIDs:   make(map[string]uint16),
+		msgNames: make(map[string]uint16),
+		msgTypes: make(map[uint16]reflect.Type),
+		handlers: make(map[string]handler),
+		pid:      uint32(os.Getpid()),
+		started:  time.Now(),
+	}
+	for _, msg := range binapi.Versions[BinapiVersion].AllMessages() {
+		key := msg.GetMessageName() + "_" + msg.GetCrcString()
+		if _, ok := s.msgIDs[key]; ok {
+			continue
+		}
+		msgID := uint16(len(s.msgTypes) + 1)
+		s.msgIDs[key] = msgID
+		s.msgNames[msg.GetMessageName()] = msgID
+		s.msgTypes[msgID] = reflect.TypeOf(msg).Elem()
+	}
+
+	s.registerVpe()
+	s.registerInterfaces()
+	s.registerIP()
+	s.registerL2()
+	s.registerACL()
+	s.registerNAT()
+
+	return s
+}
+
+// handle registers handler for the request.
+func (s *VPP) handle(req api.Message, h handler) {
+	s.handlers[req.GetMessageName()] = h
+}
+
+// NewAdapter returns binary API adapter connecting to the simulated VPP.
+// Multiple adapters can be connected to the same simulated VPP.
+func (s *VPP) NewAdapter() adapter.VppAPI {
+	return &Adapter{vpp: s}
+}
+
+// process decodes the request, handles it and returns encoded replies.
+func (s *VPP) process(clientIndex, context uint32, data []byte) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	msgID := binary.BigEndian.Uint16(data[0:2])
+	typ, ok := s.msgTypes[msgID]
+	if !ok {
+		return nil, errors.Errorf("unknown message ID %d", msgID)
+	}
+	req := reflect.New(typ).Interface().(message)
+	if err := req.Unmarshal(data[requestHeaderSize:]); err != nil {
+		return nil, errors.Errorf("decoding %s failed: %v", req.GetMessageName(), err)
+	}
+
+	var replies []api.Message
+	name := req.GetMessageName()
+	if h, ok := s.handlers[name]; ok {
+		replies = h(req)
+	} else if replyID, ok := s.msgNames[name+"_reply"]; ok {
+		replies = []api.Message{unimplementedReply(s.msgTypes[replyID])}
+	} else if !strings.HasSuffix(name, "_dump") {
+		return nil, errors.Errorf("message %s is not supported by simulated VPP", name)
+	}
+
+	var out [][]byte
+	for _, reply := range replies {
+		if ping, ok := reply.(*vpe.ControlPingReply); ok {
+			ping.ClientIndex = clientIndex
+		}
+		data, err := s.encode(reply, context)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
+
+// unimplementedReply returns reply of the given type for request which
+// is not simulated, with retval set to VNET_API_ERROR_UNIMPLEMENTED.
+func unimplementedReply(typ reflect.Type) api.Message {
+	reply := reflect.New(typ)
+	if retval := reply.Elem().FieldByName("Retval"); retval.IsValid() && retval.Kind() == reflect.Int32 {
+		retval.SetInt(int64(errUnimplemented))
+	}
+	return reply.Interface().(api.Message)
+}
+
+// encode encodes the reply message with reply header.
+func (s *VPP) encode(reply api.Message, context uint32) ([]byte, error) {
+	msgID, ok := s.msgIDs[reply.GetMessageName()+"_"+reply.GetCrcString()]
+	if !ok {
+		return nil, errors.Errorf("unknown reply message %s", reply.GetMessageName())
+	}
+	body, err := reply.(message).Marshal(nil)
+	if err != nil {
+		return nil, errors.Errorf("encoding %s failed: %v", reply.GetMessageName(), err)
+	}
+	data := make([]byte, replyHeaderSize, replyHeaderSize+len(body))
+	binary.BigEndian.PutUint16(data[0:2], msgID)
+	binary.BigEndian.PutUint32(data[2:6], context)
+	return append(data, body...), nil
+}
+
+// sortedKeys returns sorted keys of the map with uint32 keys.
+func sortedKeys(m interface{}) []uint32 {
+	keys := reflect.ValueOf(m).MapKeys()
+	list := make([]uint32, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, uint32(key.Uint()))
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// sortedStrings returns sorted keys of the map with string keys.
+func sortedStrings(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	list := make([]string, 0, len(keys))
+	for _, key := range keys {
+		list = append(list, key.String())
+	}
+	sort.Strings(list)
+	return list
+}
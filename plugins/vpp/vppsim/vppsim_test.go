@@ -0,0 +1,269 @@
+//  Copyright (c) 2020 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package vppsim
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"git.fd.io/govpp.git/adapter"
+	"git.fd.io/govpp.git/api"
+	. "github.com/onsi/gomega"
+
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/acl"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/acl_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/fib_types"
+	interfaces "go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/interface_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ip"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/ip_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/l2"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/nat"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/nat_types"
+	"go.ligato.io/vpp-agent/v3/plugins/vpp/binapi/vpp2009/vpe"
+)
+
+// testClient sends requests to simulated VPP via its adapter.
+type testClient struct {
+	vpp     *VPP
+	adapter adapter.VppAPI
+	context uint32
+	replies [][]byte
+}
+
+func newTestClient(t *testing.T) *testClient {
+	RegisterTestingT(t)
+
+	c := &testClient{vpp: New()}
+	c.adapter = c.vpp.NewAdapter()
+	c.adapter.SetMsgCallback(func(msgID uint16, data []byte) {
+		c.replies = append(c.replies, data)
+	})
+	Expect(c.adapter.Connect()).To(Succeed())
+	return c
+}
+
+// request sends the request and returns decoded replies.
+func (c *testClient) request(req message) []api.Message {
+	msgID, err := c.adapter.GetMsgID(req.GetMessageName(), req.GetCrcString())
+	Expect(err).ToNot(HaveOccurred())
+	body, err := req.Marshal(nil)
+	Expect(err).ToNot(HaveOccurred())
+	data := make([]byte, requestHeaderSize, requestHeaderSize+len(body))
+	binary.BigEndian.PutUint16(data[0:2], msgID)
+
+	c.context++
+	c.replies = nil
+	Expect(c.adapter.SendMsg(c.context, append(data, body...))).To(Succeed())
+
+	var msgs []api.Message
+	for _, reply := range c.replies {
+		Expect(binary.BigEndian.Uint32(reply[2:6])).To(Equal(c.context))
+		typ := c.vpp.msgTypes[binary.BigEndian.Uint16(reply[0:2])]
+		msg := reflect.New(typ).Interface().(message)
+		Expect(msg.Unmarshal(reply[replyHeaderSize:])).To(Succeed())
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// retval sends the request and returns retval of its single reply.
+func (c *testClient) retval(req message) int32 {
+	replies := c.request(req)
+	Expect(replies).To(HaveLen(1))
+	return reflect.ValueOf(replies[0]).Elem().FieldByName("Retval").Interface().(int32)
+}
+
+func TestMessages(t *testing.T) {
+	c := newTestClient(t)
+
+	_, err := c.adapter.GetMsgID("control_ping", "00000000")
+	Expect(err).To(BeAssignableToTypeOf(&adapter.UnknownMsgError{}))
+
+	replies := c.request(&vpe.ControlPing{})
+	Expect(replies).To(HaveLen(1))
+	Expect(replies[0].(*vpe.ControlPingReply).VpePID).To(Equal(c.vpp.pid))
+
+	replies = c.request(&vpe.ShowVersion{})
+	Expect(replies[0].(*vpe.ShowVersionReply).Version).To(Equal(Version))
+
+	// requests without simulated semantics fail, dumps return no details
+	Expect(c.retval(&interfaces.SwInterfaceSetRxMode{SwIfIndex: 1})).To(Equal(errUnimplemented))
+	Expect(c.retval(&interfaces.SwInterfaceSetUnnumbered{SwIfIndex: 1})).To(Equal(errUnimplemented))
+	Expect(c.request(&interfaces.SwInterfaceRxPlacementDump{})).To(BeEmpty())
+}
+
+func TestInterfaces(t *testing.T) {
+	c := newTestClient(t)
+
+	replies := c.request(&interfaces.CreateLoopback{})
+	Expect(replies).To(HaveLen(1))
+	loop := replies[0].(*interfaces.CreateLoopbackReply).SwIfIndex
+	Expect(loop).To(BeEquivalentTo(1))
+
+	prefix, err := ip_types.ParseAddressWithPrefix("10.0.0.1/24")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(c.retval(&interfaces.SwInterfaceTagAddDel{IsAdd: true, SwIfIndex: loop, Tag: "loop1"})).To(BeZero())
+	Expect(c.retval(&interfaces.SwInterfaceSetFlags{SwIfIndex: loop, Flags: adminUp})).To(BeZero())
+	Expect(c.retval(&interfaces.SwInterfaceAddDelAddress{SwIfIndex: loop, IsAdd: true, Prefix: prefix})).To(BeZero())
+	Expect(c.retval(&interfaces.SwInterfaceAddDelAddress{SwIfIndex: loop, IsAdd: true, Prefix: prefix})).To(Equal(errInvalidValue))
+
+	replies = c.request(&interfaces.SwInterfaceDump{SwIfIndex: allInterfaces})
+	Expect(replies).To(HaveLen(2))
+	Expect(replies[0].(*interfaces.SwInterfaceDetails).InterfaceName).To(Equal("local0"))
+	details := replies[1].(*interfaces.SwInterfaceDetails)
+	Expect(details.SwIfIndex).To(Equal(loop))
+	Expect(details.InterfaceName).To(Equal("loop0"))
+	Expect(details.Tag).To(Equal("loop1"))
+	Expect(details.Flags).To(Equal(adminUp | linkUp))
+
+	replies = c.request(&ip.IPAddressDump{SwIfIndex: loop})
+	Expect(replies).To(HaveLen(1))
+	Expect(replies[0].(*ip.IPAddressDetails).Prefix.String()).To(Equal("10.0.0.1/24"))
+	Expect(c.request(&ip.IPAddressDump{SwIfIndex: loop, IsIPv6: true})).To(BeEmpty())
+
+	Expect(c.retval(&interfaces.DeleteLoopback{SwIfIndex: loop})).To(BeZero())
+	Expect(c.retval(&interfaces.DeleteLoopback{SwIfIndex: loop})).To(Equal(errInvalidSwIfIndex))
+	Expect(c.request(&interfaces.SwInterfaceDump{SwIfIndex: allInterfaces})).To(HaveLen(1))
+}
+
+func TestRoutes(t *testing.T) {
+	c := newTestClient(t)
+
+	prefix, err := ip_types.ParsePrefix("192.168.1.0/24")
+	Expect(err).ToNot(HaveOccurred())
+	nextHop, err := ip_types.ParseAddress("10.0.0.2")
+	Expect(err).ToNot(HaveOccurred())
+	route := ip.IPRoute{
+		TableID: 10,
+		Prefix:  prefix,
+		Paths:   []fib_types.FibPath{{SwIfIndex: 1, Nh: fib_types.FibPathNh{Address: nextHop.Un}}},
+	}
+
+	Expect(c.retval(&ip.IPRouteAddDel{IsAdd: true, Route: route})).To(Equal(errNoSuchFib))
+	Expect(c.retval(&ip.IPTableAddDel{IsAdd: true, Table: ip.IPTable{TableID: 10}})).To(BeZero())
+	Expect(c.retval(&ip.IPRouteAddDel{IsAdd: true, Route: route})).To(BeZero())
+
+	replies := c.request(&ip.IPRouteDump{Table: ip.IPTable{TableID: 10}})
+	Expect(replies).To(HaveLen(1))
+	dumped := replies[0].(*ip.IPRouteDetails).Route
+	Expect(dumped.Prefix.String()).To(Equal("192.168.1.0/24"))
+	Expect(dumped.Paths).To(HaveLen(1))
+	Expect(dumped.Paths[0].Nh.Address).To(Equal(nextHop.Un))
+
+	Expect(c.request(&ip.IPTableDump{})).To(HaveLen(3))
+	Expect(c.retval(&ip.IPRouteAddDel{IsAdd: false, Route: route})).To(BeZero())
+	Expect(c.retval(&ip.IPRouteAddDel{IsAdd: false, Route: route})).To(Equal(errNoSuchEntry))
+	Expect(c.request(&ip.IPRouteDump{Table: ip.IPTable{TableID: 10}})).To(BeEmpty())
+}
+
+func TestBridgeDomains(t *testing.T) {
+	c := newTestClient(t)
+
+	loop := c.request(&interfaces.CreateLoopback{})[0].(*interfaces.CreateLoopbackReply).SwIfIndex
+	Expect(c.retval(&l2.BridgeDomainAddDel{BdID: 1, IsAdd: true, Learn: true})).To(BeZero())
+	Expect(c.retval(&l2.SwInterfaceSetL2Bridge{
+		RxSwIfIndex: loop, BdID: 1, PortType: l2.L2_API_PORT_TYPE_BVI, Enable: true,
+	})).To(BeZero())
+
+	replies := c.request(&l2.BridgeDomainDump{BdID: allBridgeDomains, SwIfIndex: allInterfaces})
+	Expect(replies).To(HaveLen(1))
+	bd := replies[0].(*l2.BridgeDomainDetails)
+	Expect(bd.Learn).To(BeTrue())
+	Expect(bd.BviSwIfIndex).To(Equal(loop))
+	Expect(bd.SwIfDetails).To(HaveLen(1))
+	Expect(bd.SwIfDetails[0].SwIfIndex).To(Equal(loop))
+
+	// bridge domain with interface cannot be removed
+	Expect(c.retval(&l2.BridgeDomainAddDel{BdID: 1, IsAdd: false})).To(Equal(errInvalidValue))
+	Expect(c.retval(&interfaces.DeleteLoopback{SwIfIndex: loop})).To(BeZero())
+
+	bd = c.request(&l2.BridgeDomainDump{BdID: 1, SwIfIndex: allInterfaces})[0].(*l2.BridgeDomainDetails)
+	Expect(bd.SwIfDetails).To(BeEmpty())
+	Expect(bd.BviSwIfIndex).To(Equal(allInterfaces))
+	Expect(c.retval(&l2.BridgeDomainAddDel{BdID: 1, IsAdd: false})).To(BeZero())
+	Expect(c.request(&l2.BridgeDomainDump{BdID: allBridgeDomains, SwIfIndex: allInterfaces})).To(BeEmpty())
+}
+
+func TestACLs(t *testing.T) {
+	c := newTestClient(t)
+
+	loop := c.request(&interfaces.CreateLoopback{})[0].(*interfaces.CreateLoopbackReply).SwIfIndex
+	replies := c.request(&acl.ACLAddReplace{
+		ACLIndex: allACLs,
+		Tag:      "acl1",
+		R:        []acl_types.ACLRule{{IsPermit: acl_types.ACL_ACTION_API_PERMIT}},
+	})
+	Expect(replies).To(HaveLen(1))
+	aclIndex := replies[0].(*acl.ACLAddReplaceReply).ACLIndex
+	Expect(aclIndex).To(BeZero())
+
+	Expect(c.retval(&acl.ACLInterfaceSetACLList{SwIfIndex: loop, NInput: 1, Acls: []uint32{aclIndex}})).To(BeZero())
+	replies = c.request(&acl.ACLInterfaceListDump{SwIfIndex: allInterfaces})
+	Expect(replies).To(HaveLen(1))
+	Expect(replies[0].(*acl.ACLInterfaceListDetails).Acls).To(Equal([]uint32{aclIndex}))
+
+	// ACL assigned to interface cannot be removed
+	Expect(c.retval(&acl.ACLDel{ACLIndex: aclIndex})).To(Equal(errInvalidValue))
+	Expect(c.retval(&acl.ACLInterfaceSetACLList{SwIfIndex: loop})).To(BeZero())
+	Expect(c.request(&acl.ACLInterfaceListDump{SwIfIndex: allInterfaces})).To(BeEmpty())
+
+	replies = c.request(&acl.ACLDump{ACLIndex: allACLs})
+	Expect(replies).To(HaveLen(1))
+	Expect(replies[0].(*acl.ACLDetails).Tag).To(Equal("acl1"))
+	Expect(replies[0].(*acl.ACLDetails).R).To(HaveLen(1))
+
+	Expect(c.retval(&acl.ACLDel{ACLIndex: aclIndex})).To(BeZero())
+	Expect(c.request(&acl.ACLDump{ACLIndex: allACLs})).To(BeEmpty())
+}
+
+func TestNAT(t *testing.T) {
+	c := newTestClient(t)
+
+	loop := c.request(&interfaces.CreateLoopback{})[0].(*interfaces.CreateLoopbackReply).SwIfIndex
+	Expect(c.retval(&nat.Nat44InterfaceAddDelFeature{IsAdd: true, SwIfIndex: loop, Flags: natInside})).To(BeZero())
+	Expect(c.retval(&nat.Nat44InterfaceAddDelFeature{IsAdd: true, SwIfIndex: loop, Flags: natOutside})).To(BeZero())
+
+	replies := c.request(&nat.Nat44InterfaceDump{})
+	Expect(replies).To(HaveLen(1))
+	Expect(replies[0].(*nat.Nat44InterfaceDetails).Flags).To(Equal(natInside | natOutside))
+
+	first, err := ip_types.ParseIP4Address("80.80.80.1")
+	Expect(err).ToNot(HaveOccurred())
+	last, err := ip_types.ParseIP4Address("80.80.80.3")
+	Expect(err).ToNot(HaveOccurred())
+	Expect(c.retval(&nat.Nat44AddDelAddressRange{IsAdd: true, FirstIPAddress: first, LastIPAddress: last})).To(BeZero())
+	replies = c.request(&nat.Nat44AddressDump{})
+	Expect(replies).To(HaveLen(3))
+	Expect(replies[2].(*nat.Nat44AddressDetails).IPAddress).To(Equal(last))
+
+	mapping := &nat.Nat44AddDelStaticMappingV2{
+		IsAdd:             true,
+		Flags:             nat_types.NAT_IS_ADDR_ONLY,
+		LocalIPAddress:    first,
+		ExternalIPAddress: last,
+		ExternalSwIfIndex: ^interface_types.InterfaceIndex(0),
+	}
+	Expect(c.retval(mapping)).To(BeZero())
+	Expect(c.request(&nat.Nat44StaticMappingDump{})).To(HaveLen(1))
+	mapping.IsAdd = false
+	Expect(c.retval(mapping)).To(BeZero())
+	Expect(c.request(&nat.Nat44StaticMappingDump{})).To(BeEmpty())
+
+	// NAT features are removed with the interface
+	Expect(c.retval(&interfaces.DeleteLoopback{SwIfIndex: loop})).To(BeZero())
+	Expect(c.request(&nat.Nat44InterfaceDump{})).To(BeEmpty())
+}
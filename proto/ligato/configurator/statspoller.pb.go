@@ -26,6 +26,64 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type PollStatsRequest_StatsType int32
+
+const (
+	PollStatsRequest_INTERFACE PollStatsRequest_StatsType = 0
+	PollStatsRequest_ERROR     PollStatsRequest_StatsType = 1
+	PollStatsRequest_NODE      PollStatsRequest_StatsType = 2
+	PollStatsRequest_BUFFER    PollStatsRequest_StatsType = 3
+	PollStatsRequest_SYSTEM    PollStatsRequest_StatsType = 4
+	PollStatsRequest_RATES     PollStatsRequest_StatsType = 5
+)
+
+// Enum value maps for PollStatsRequest_StatsType.
+var (
+	PollStatsRequest_StatsType_name = map[int32]string{
+		0: "INTERFACE",
+		1: "ERROR",
+		2: "NODE",
+		3: "BUFFER",
+		4: "SYSTEM",
+		5: "RATES",
+	}
+	PollStatsRequest_StatsType_value = map[string]int32{
+		"INTERFACE": 0,
+		"ERROR":     1,
+		"NODE":      2,
+		"BUFFER":    3,
+		"SYSTEM":    4,
+		"RATES":     5,
+	}
+)
+
+func (x PollStatsRequest_StatsType) Enum() *PollStatsRequest_StatsType {
+	p := new(PollStatsRequest_StatsType)
+	*p = x
+	return p
+}
+
+func (x PollStatsRequest_StatsType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PollStatsRequest_StatsType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_configurator_statspoller_proto_enumTypes[0].Descriptor()
+}
+
+func (PollStatsRequest_StatsType) Type() protoreflect.EnumType {
+	return &file_ligato_configurator_statspoller_proto_enumTypes[0]
+}
+
+func (x PollStatsRequest_StatsType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PollStatsRequest_StatsType.Descriptor instead.
+func (PollStatsRequest_StatsType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_configurator_statspoller_proto_rawDescGZIP(), []int{1, 0}
+}
+
 // Stats defines stats data returned by StatsPollerService.
 type Stats struct {
 	state         protoimpl.MessageState
@@ -104,6 +162,20 @@ type PollStatsRequest struct {
 	// NumPolls defines number of pollings. Set to non-zero number to
 	// stop the polling after specified number of pollings is reached.
 	NumPolls uint32 `protobuf:"varint,2,opt,name=num_polls,json=numPolls,proto3" json:"num_polls,omitempty"`
+	// Types defines types of stats to poll. Only interface stats
+	// are polled if not set.
+	Types []PollStatsRequest_StatsType `protobuf:"varint,3,rep,name=types,proto3,enum=ligato.configurator.PollStatsRequest_StatsType" json:"types,omitempty"`
+	// InterfacePatterns defines regular expressions filtering interface
+	// stats by interface name. Stats of all interfaces are polled if not set.
+	InterfacePatterns []string `protobuf:"bytes,4,rep,name=interface_patterns,json=interfacePatterns,proto3" json:"interface_patterns,omitempty"`
+	// NodePatterns defines regular expressions filtering error and node
+	// stats by node name. Stats of all nodes are polled if not set.
+	NodePatterns []string `protobuf:"bytes,5,rep,name=node_patterns,json=nodePatterns,proto3" json:"node_patterns,omitempty"`
+	// Deltas enables returning increments of counters since the previous
+	// polling instead of absolute values. The first polling returns
+	// absolute values. Gauges (e.g. buffer pool usage, system stats
+	// or rates) are always absolute.
+	Deltas bool `protobuf:"varint,6,opt,name=deltas,proto3" json:"deltas,omitempty"`
 }
 
 func (x *PollStatsRequest) Reset() {
@@ -152,6 +224,34 @@ func (x *PollStatsRequest) GetNumPolls() uint32 {
 	return 0
 }
 
+func (x *PollStatsRequest) GetTypes() []PollStatsRequest_StatsType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *PollStatsRequest) GetInterfacePatterns() []string {
+	if x != nil {
+		return x.InterfacePatterns
+	}
+	return nil
+}
+
+func (x *PollStatsRequest) GetNodePatterns() []string {
+	if x != nil {
+		return x.NodePatterns
+	}
+	return nil
+}
+
+func (x *PollStatsRequest) GetDeltas() bool {
+	if x != nil {
+		return x.Deltas
+	}
+	return false
+}
+
 type PollStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -221,30 +321,47 @@ var file_ligato_configurator_statspoller_proto_rawDesc = []byte{
 	0x70, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
 	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x53, 0x74, 0x61, 0x74,
 	0x73, 0x48, 0x00, 0x52, 0x08, 0x76, 0x70, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x07, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x10, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d,
-	0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75,
-	0x6d, 0x50, 0x6f, 0x6c, 0x6c, 0x73, 0x22, 0x60, 0x0a, 0x11, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70,
-	0x6f, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70,
-	0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x71, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x32, 0x74, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e,
-	0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x42,
-	0x5a, 0x40, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
-	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x10, 0x50, 0x6f, 0x6c, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75,
+	0x6d, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e,
+	0x75, 0x6d, 0x50, 0x6f, 0x6c, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x6f, 0x6c,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x12, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73, 0x22, 0x52, 0x0a, 0x09, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x46, 0x41, 0x43, 0x45, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x55, 0x46, 0x46, 0x45, 0x52, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x59, 0x53, 0x54, 0x45,
+	0x4d, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x54, 0x45, 0x53, 0x10, 0x05, 0x22, 0x60,
+	0x0a, 0x11, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x6f, 0x6c, 0x6c, 0x53, 0x65, 0x71, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x32, 0x74, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x3b, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -259,23 +376,26 @@ func file_ligato_configurator_statspoller_proto_rawDescGZIP() []byte {
 	return file_ligato_configurator_statspoller_proto_rawDescData
 }
 
+var file_ligato_configurator_statspoller_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 var file_ligato_configurator_statspoller_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_ligato_configurator_statspoller_proto_goTypes = []interface{}{
-	(*Stats)(nil),             // 0: ligato.configurator.Stats
-	(*PollStatsRequest)(nil),  // 1: ligato.configurator.PollStatsRequest
-	(*PollStatsResponse)(nil), // 2: ligato.configurator.PollStatsResponse
-	(*vpp.Stats)(nil),         // 3: ligato.vpp.Stats
+	(PollStatsRequest_StatsType)(0), // 0: ligato.configurator.PollStatsRequest.StatsType
+	(*Stats)(nil),                   // 1: ligato.configurator.Stats
+	(*PollStatsRequest)(nil),        // 2: ligato.configurator.PollStatsRequest
+	(*PollStatsResponse)(nil),       // 3: ligato.configurator.PollStatsResponse
+	(*vpp.Stats)(nil),               // 4: ligato.vpp.Stats
 }
 var file_ligato_configurator_statspoller_proto_depIdxs = []int32{
-	3, // 0: ligato.configurator.Stats.vpp_stats:type_name -> ligato.vpp.Stats
-	0, // 1: ligato.configurator.PollStatsResponse.stats:type_name -> ligato.configurator.Stats
-	1, // 2: ligato.configurator.StatsPollerService.PollStats:input_type -> ligato.configurator.PollStatsRequest
-	2, // 3: ligato.configurator.StatsPollerService.PollStats:output_type -> ligato.configurator.PollStatsResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 0: ligato.configurator.Stats.vpp_stats:type_name -> ligato.vpp.Stats
+	0, // 1: ligato.configurator.PollStatsRequest.types:type_name -> ligato.configurator.PollStatsRequest.StatsType
+	1, // 2: ligato.configurator.PollStatsResponse.stats:type_name -> ligato.configurator.Stats
+	2, // 3: ligato.configurator.StatsPollerService.PollStats:input_type -> ligato.configurator.PollStatsRequest
+	3, // 4: ligato.configurator.StatsPollerService.PollStats:output_type -> ligato.configurator.PollStatsResponse
+	4, // [4:5] is the sub-list for method output_type
+	3, // [3:4] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_ligato_configurator_statspoller_proto_init() }
@@ -329,13 +449,14 @@ func file_ligato_configurator_statspoller_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_configurator_statspoller_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      1,
 			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ligato_configurator_statspoller_proto_goTypes,
 		DependencyIndexes: file_ligato_configurator_statspoller_proto_depIdxs,
+		EnumInfos:         file_ligato_configurator_statspoller_proto_enumTypes,
 		MessageInfos:      file_ligato_configurator_statspoller_proto_msgTypes,
 	}.Build()
 	File_ligato_configurator_statspoller_proto = out.File
@@ -63,18 +63,50 @@ const (
 	Interface_VRF_DEVICE Interface_Type = 5
 	// Create a dummy Linux interface which effectively behaves just like the loopback.
 	Interface_DUMMY Interface_Type = 6
+	// Linux bridge (software L2 switch). Interfaces are attached to the bridge as ports
+	// by listing their logical names in BridgeLink.ports, which enslaves them
+	// to the bridge device.
+	Interface_BRIDGE Interface_Type = 7
+	// 802.1Q VLAN sub-interface created over another Linux interface (parent),
+	// which can be of any type (including EXISTING).
+	Interface_VLAN Interface_Type = 8
+	// Bond (link aggregation) interface. Member interfaces are listed
+	// in BondLink.members and get enslaved to the bond device.
+	Interface_BOND Interface_Type = 9
+	// Linux VXLAN tunnel interface (e.g. peering with a VXLAN tunnel configured in VPP).
+	Interface_VXLAN Interface_Type = 10
+	// Linux GRE tunnel interface. Depending on the address family of the tunnel
+	// endpoints, either "gre" (IPv4) or "ip6gre" (IPv6) netdevice is created.
+	Interface_GRE Interface_Type = 11
+	// Linux WireGuard interface (requires wireguard kernel module).
+	Interface_WIREGUARD Interface_Type = 12
+	// MACVLAN interface created over another Linux interface (parent), which can be
+	// of any type (including EXISTING). Typically used to attach containers directly
+	// to the host NIC.
+	Interface_MACVLAN Interface_Type = 13
+	// IPVLAN interface created over another Linux interface (parent). Unlike MACVLAN,
+	// all IPVLAN interfaces share the MAC address of the parent.
+	Interface_IPVLAN Interface_Type = 14
 )
 
 // Enum value maps for Interface_Type.
 var (
 	Interface_Type_name = map[int32]string{
-		0: "UNDEFINED",
-		1: "VETH",
-		2: "TAP_TO_VPP",
-		3: "LOOPBACK",
-		4: "EXISTING",
-		5: "VRF_DEVICE",
-		6: "DUMMY",
+		0:  "UNDEFINED",
+		1:  "VETH",
+		2:  "TAP_TO_VPP",
+		3:  "LOOPBACK",
+		4:  "EXISTING",
+		5:  "VRF_DEVICE",
+		6:  "DUMMY",
+		7:  "BRIDGE",
+		8:  "VLAN",
+		9:  "BOND",
+		10: "VXLAN",
+		11: "GRE",
+		12: "WIREGUARD",
+		13: "MACVLAN",
+		14: "IPVLAN",
 	}
 	Interface_Type_value = map[string]int32{
 		"UNDEFINED":  0,
@@ -84,6 +116,14 @@ var (
 		"EXISTING":   4,
 		"VRF_DEVICE": 5,
 		"DUMMY":      6,
+		"BRIDGE":     7,
+		"VLAN":       8,
+		"BOND":       9,
+		"VXLAN":      10,
+		"GRE":        11,
+		"WIREGUARD":  12,
+		"MACVLAN":    13,
+		"IPVLAN":     14,
 	}
 )
 
@@ -163,6 +203,171 @@ func (VethLink_ChecksumOffloading) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{1, 0}
 }
 
+// Bonding mode, see: https://www.kernel.org/doc/Documentation/networking/bonding.txt
+type BondLink_Mode int32
+
+const (
+	BondLink_BALANCE_RR    BondLink_Mode = 0
+	BondLink_ACTIVE_BACKUP BondLink_Mode = 1
+	BondLink_BALANCE_XOR   BondLink_Mode = 2
+	BondLink_BROADCAST     BondLink_Mode = 3
+	BondLink_LACP          BondLink_Mode = 4 // IEEE 802.3ad dynamic link aggregation
+	BondLink_BALANCE_TLB   BondLink_Mode = 5
+	BondLink_BALANCE_ALB   BondLink_Mode = 6
+)
+
+// Enum value maps for BondLink_Mode.
+var (
+	BondLink_Mode_name = map[int32]string{
+		0: "BALANCE_RR",
+		1: "ACTIVE_BACKUP",
+		2: "BALANCE_XOR",
+		3: "BROADCAST",
+		4: "LACP",
+		5: "BALANCE_TLB",
+		6: "BALANCE_ALB",
+	}
+	BondLink_Mode_value = map[string]int32{
+		"BALANCE_RR":    0,
+		"ACTIVE_BACKUP": 1,
+		"BALANCE_XOR":   2,
+		"BROADCAST":     3,
+		"LACP":          4,
+		"BALANCE_TLB":   5,
+		"BALANCE_ALB":   6,
+	}
+)
+
+func (x BondLink_Mode) Enum() *BondLink_Mode {
+	p := new(BondLink_Mode)
+	*p = x
+	return p
+}
+
+func (x BondLink_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BondLink_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_interfaces_interface_proto_enumTypes[2].Descriptor()
+}
+
+func (BondLink_Mode) Type() protoreflect.EnumType {
+	return &file_ligato_linux_interfaces_interface_proto_enumTypes[2]
+}
+
+func (x BondLink_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BondLink_Mode.Descriptor instead.
+func (BondLink_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{6, 0}
+}
+
+// MACVLAN mode, see: https://www.kernel.org/doc/Documentation/networking/macvlan.txt
+type MacvlanLink_Mode int32
+
+const (
+	MacvlanLink_BRIDGE   MacvlanLink_Mode = 0
+	MacvlanLink_PRIVATE  MacvlanLink_Mode = 1
+	MacvlanLink_VEPA     MacvlanLink_Mode = 2
+	MacvlanLink_PASSTHRU MacvlanLink_Mode = 3
+)
+
+// Enum value maps for MacvlanLink_Mode.
+var (
+	MacvlanLink_Mode_name = map[int32]string{
+		0: "BRIDGE",
+		1: "PRIVATE",
+		2: "VEPA",
+		3: "PASSTHRU",
+	}
+	MacvlanLink_Mode_value = map[string]int32{
+		"BRIDGE":   0,
+		"PRIVATE":  1,
+		"VEPA":     2,
+		"PASSTHRU": 3,
+	}
+)
+
+func (x MacvlanLink_Mode) Enum() *MacvlanLink_Mode {
+	p := new(MacvlanLink_Mode)
+	*p = x
+	return p
+}
+
+func (x MacvlanLink_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MacvlanLink_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_interfaces_interface_proto_enumTypes[3].Descriptor()
+}
+
+func (MacvlanLink_Mode) Type() protoreflect.EnumType {
+	return &file_ligato_linux_interfaces_interface_proto_enumTypes[3]
+}
+
+func (x MacvlanLink_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MacvlanLink_Mode.Descriptor instead.
+func (MacvlanLink_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{10, 0}
+}
+
+// IPVLAN mode, see: https://www.kernel.org/doc/Documentation/networking/ipvlan.txt
+type IpvlanLink_Mode int32
+
+const (
+	IpvlanLink_L2  IpvlanLink_Mode = 0
+	IpvlanLink_L3  IpvlanLink_Mode = 1
+	IpvlanLink_L3S IpvlanLink_Mode = 2
+)
+
+// Enum value maps for IpvlanLink_Mode.
+var (
+	IpvlanLink_Mode_name = map[int32]string{
+		0: "L2",
+		1: "L3",
+		2: "L3S",
+	}
+	IpvlanLink_Mode_value = map[string]int32{
+		"L2":  0,
+		"L3":  1,
+		"L3S": 2,
+	}
+)
+
+func (x IpvlanLink_Mode) Enum() *IpvlanLink_Mode {
+	p := new(IpvlanLink_Mode)
+	*p = x
+	return p
+}
+
+func (x IpvlanLink_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IpvlanLink_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_interfaces_interface_proto_enumTypes[4].Descriptor()
+}
+
+func (IpvlanLink_Mode) Type() protoreflect.EnumType {
+	return &file_ligato_linux_interfaces_interface_proto_enumTypes[4]
+}
+
+func (x IpvlanLink_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IpvlanLink_Mode.Descriptor instead.
+func (IpvlanLink_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{11, 0}
+}
+
 type Interface struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -196,6 +401,14 @@ type Interface struct {
 	//	*Interface_Veth
 	//	*Interface_Tap
 	//	*Interface_VrfDev
+	//	*Interface_Bridge
+	//	*Interface_Vlan
+	//	*Interface_Bond
+	//	*Interface_Vxlan
+	//	*Interface_Gre
+	//	*Interface_Wireguard
+	//	*Interface_Macvlan
+	//	*Interface_Ipvlan
 	Link isInterface_Link `protobuf_oneof:"link"`
 	// Configure/Resync link only. IP/MAC addresses are expected to be configured
 	// externally - i.e. by a different agent or manually via CLI.
@@ -324,6 +537,62 @@ func (x *Interface) GetVrfDev() *VrfDevLink {
 	return nil
 }
 
+func (x *Interface) GetBridge() *BridgeLink {
+	if x, ok := x.GetLink().(*Interface_Bridge); ok {
+		return x.Bridge
+	}
+	return nil
+}
+
+func (x *Interface) GetVlan() *VlanLink {
+	if x, ok := x.GetLink().(*Interface_Vlan); ok {
+		return x.Vlan
+	}
+	return nil
+}
+
+func (x *Interface) GetBond() *BondLink {
+	if x, ok := x.GetLink().(*Interface_Bond); ok {
+		return x.Bond
+	}
+	return nil
+}
+
+func (x *Interface) GetVxlan() *VxlanLink {
+	if x, ok := x.GetLink().(*Interface_Vxlan); ok {
+		return x.Vxlan
+	}
+	return nil
+}
+
+func (x *Interface) GetGre() *GreLink {
+	if x, ok := x.GetLink().(*Interface_Gre); ok {
+		return x.Gre
+	}
+	return nil
+}
+
+func (x *Interface) GetWireguard() *WireguardLink {
+	if x, ok := x.GetLink().(*Interface_Wireguard); ok {
+		return x.Wireguard
+	}
+	return nil
+}
+
+func (x *Interface) GetMacvlan() *MacvlanLink {
+	if x, ok := x.GetLink().(*Interface_Macvlan); ok {
+		return x.Macvlan
+	}
+	return nil
+}
+
+func (x *Interface) GetIpvlan() *IpvlanLink {
+	if x, ok := x.GetLink().(*Interface_Ipvlan); ok {
+		return x.Ipvlan
+	}
+	return nil
+}
+
 func (x *Interface) GetLinkOnly() bool {
 	if x != nil {
 		return x.LinkOnly
@@ -357,12 +626,68 @@ type Interface_VrfDev struct {
 	VrfDev *VrfDevLink `protobuf:"bytes,22,opt,name=vrf_dev,json=vrfDev,proto3,oneof"`
 }
 
+type Interface_Bridge struct {
+	// BRIDGE-specific configuration
+	Bridge *BridgeLink `protobuf:"bytes,23,opt,name=bridge,proto3,oneof"`
+}
+
+type Interface_Vlan struct {
+	// VLAN-specific configuration
+	Vlan *VlanLink `protobuf:"bytes,24,opt,name=vlan,proto3,oneof"`
+}
+
+type Interface_Bond struct {
+	// BOND-specific configuration
+	Bond *BondLink `protobuf:"bytes,25,opt,name=bond,proto3,oneof"`
+}
+
+type Interface_Vxlan struct {
+	// VXLAN-specific configuration
+	Vxlan *VxlanLink `protobuf:"bytes,26,opt,name=vxlan,proto3,oneof"`
+}
+
+type Interface_Gre struct {
+	// GRE-specific configuration
+	Gre *GreLink `protobuf:"bytes,27,opt,name=gre,proto3,oneof"`
+}
+
+type Interface_Wireguard struct {
+	// WIREGUARD-specific configuration
+	Wireguard *WireguardLink `protobuf:"bytes,28,opt,name=wireguard,proto3,oneof"`
+}
+
+type Interface_Macvlan struct {
+	// MACVLAN-specific configuration
+	Macvlan *MacvlanLink `protobuf:"bytes,29,opt,name=macvlan,proto3,oneof"`
+}
+
+type Interface_Ipvlan struct {
+	// IPVLAN-specific configuration
+	Ipvlan *IpvlanLink `protobuf:"bytes,30,opt,name=ipvlan,proto3,oneof"`
+}
+
 func (*Interface_Veth) isInterface_Link() {}
 
 func (*Interface_Tap) isInterface_Link() {}
 
 func (*Interface_VrfDev) isInterface_Link() {}
 
+func (*Interface_Bridge) isInterface_Link() {}
+
+func (*Interface_Vlan) isInterface_Link() {}
+
+func (*Interface_Bond) isInterface_Link() {}
+
+func (*Interface_Vxlan) isInterface_Link() {}
+
+func (*Interface_Gre) isInterface_Link() {}
+
+func (*Interface_Wireguard) isInterface_Link() {}
+
+func (*Interface_Macvlan) isInterface_Link() {}
+
+func (*Interface_Ipvlan) isInterface_Link() {}
+
 type VethLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -529,180 +854,1115 @@ func (x *VrfDevLink) GetRoutingTable() uint32 {
 	return 0
 }
 
-var File_ligato_linux_interfaces_interface_proto protoreflect.FileDescriptor
+type BridgeLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_ligato_linux_interfaces_interface_proto_rawDesc = []byte{
-	0x0a, 0x27, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x1a, 0x26, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78,
-	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x05, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
-	0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x4e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68,
-	0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04,
-	0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x70, 0x68, 0x79, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x1a, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82,
-	0x7d, 0x05, 0x12, 0x03, 0x10, 0x80, 0x48, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x37, 0x0a, 0x04,
-	0x76, 0x65, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
-	0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
-	0x04, 0x76, 0x65, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x03, 0x74, 0x61, 0x70, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75,
-	0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x61, 0x70,
-	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x76,
-	0x72, 0x66, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x72, 0x66, 0x44, 0x65, 0x76, 0x4c, 0x69, 0x6e,
-	0x6b, 0x48, 0x00, 0x52, 0x06, 0x76, 0x72, 0x66, 0x44, 0x65, 0x76, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
-	0x69, 0x6e, 0x6b, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x6c, 0x69, 0x6e, 0x6b, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x5f,
-	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x72, 0x66, 0x4d, 0x61, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x66, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x45, 0x54, 0x48, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
-	0x41, 0x50, 0x5f, 0x54, 0x4f, 0x5f, 0x56, 0x50, 0x50, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c,
-	0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x49,
-	0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x52, 0x46, 0x5f, 0x44,
-	0x45, 0x56, 0x49, 0x43, 0x45, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x55, 0x4d, 0x4d, 0x59,
-	0x10, 0x06, 0x42, 0x06, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xec, 0x02, 0x0a, 0x08, 0x56,
-	0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f,
-	0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x65, 0x65, 0x72, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x16, 0x72, 0x78, 0x5f,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52,
-	0x14, 0x72, 0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x6a, 0x0a, 0x16, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x65, 0x63,
-	0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
-	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
-	0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
-	0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x74, 0x78, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x22, 0x66, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66,
-	0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x4b, 0x53, 0x4d,
-	0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
-	0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x4b, 0x53, 0x4d, 0x5f, 0x4f, 0x46, 0x46, 0x4c,
-	0x4f, 0x41, 0x44, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a,
-	0x16, 0x43, 0x48, 0x4b, 0x53, 0x4d, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x44,
-	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0x30, 0x0a, 0x07, 0x54, 0x61, 0x70,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0f, 0x76, 0x70, 0x70, 0x5f, 0x74, 0x61, 0x70, 0x5f,
-	0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76,
-	0x70, 0x70, 0x54, 0x61, 0x70, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x0a, 0x56,
-	0x72, 0x66, 0x44, 0x65, 0x76, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x4a,
-	0x5a, 0x48, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
-	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	// Logical names of interfaces attached to the bridge as ports.
+	// Ports have to be in the same network namespace as the bridge.
+	Ports []string `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	// Enable (kernel) Spanning Tree Protocol on the bridge.
+	StpEnabled bool `protobuf:"varint,2,opt,name=stp_enabled,json=stpEnabled,proto3" json:"stp_enabled,omitempty"`
+	// Enable VLAN filtering - the bridge then forwards frames based
+	// on the VLAN membership of the ports.
+	VlanFiltering bool `protobuf:"varint,3,opt,name=vlan_filtering,json=vlanFiltering,proto3" json:"vlan_filtering,omitempty"`
 }
 
-var (
-	file_ligato_linux_interfaces_interface_proto_rawDescOnce sync.Once
-	file_ligato_linux_interfaces_interface_proto_rawDescData = file_ligato_linux_interfaces_interface_proto_rawDesc
-)
+func (x *BridgeLink) Reset() {
+	*x = BridgeLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_ligato_linux_interfaces_interface_proto_rawDescGZIP() []byte {
-	file_ligato_linux_interfaces_interface_proto_rawDescOnce.Do(func() {
-		file_ligato_linux_interfaces_interface_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_linux_interfaces_interface_proto_rawDescData)
-	})
-	return file_ligato_linux_interfaces_interface_proto_rawDescData
+func (x *BridgeLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_ligato_linux_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_ligato_linux_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_ligato_linux_interfaces_interface_proto_goTypes = []interface{}{
-	(Interface_Type)(0),              // 0: ligato.linux.interfaces.Interface.Type
-	(VethLink_ChecksumOffloading)(0), // 1: ligato.linux.interfaces.VethLink.ChecksumOffloading
-	(*Interface)(nil),                // 2: ligato.linux.interfaces.Interface
-	(*VethLink)(nil),                 // 3: ligato.linux.interfaces.VethLink
-	(*TapLink)(nil),                  // 4: ligato.linux.interfaces.TapLink
-	(*VrfDevLink)(nil),               // 5: ligato.linux.interfaces.VrfDevLink
-	(*namespace.NetNamespace)(nil),   // 6: ligato.linux.namespace.NetNamespace
+func (*BridgeLink) ProtoMessage() {}
+
+func (x *BridgeLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_ligato_linux_interfaces_interface_proto_depIdxs = []int32{
-	0, // 0: ligato.linux.interfaces.Interface.type:type_name -> ligato.linux.interfaces.Interface.Type
-	6, // 1: ligato.linux.interfaces.Interface.namespace:type_name -> ligato.linux.namespace.NetNamespace
-	3, // 2: ligato.linux.interfaces.Interface.veth:type_name -> ligato.linux.interfaces.VethLink
-	4, // 3: ligato.linux.interfaces.Interface.tap:type_name -> ligato.linux.interfaces.TapLink
-	5, // 4: ligato.linux.interfaces.Interface.vrf_dev:type_name -> ligato.linux.interfaces.VrfDevLink
-	1, // 5: ligato.linux.interfaces.VethLink.rx_checksum_offloading:type_name -> ligato.linux.interfaces.VethLink.ChecksumOffloading
-	1, // 6: ligato.linux.interfaces.VethLink.tx_checksum_offloading:type_name -> ligato.linux.interfaces.VethLink.ChecksumOffloading
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+
+// Deprecated: Use BridgeLink.ProtoReflect.Descriptor instead.
+func (*BridgeLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{4}
 }
 
-func init() { file_ligato_linux_interfaces_interface_proto_init() }
-func file_ligato_linux_interfaces_interface_proto_init() {
-	if File_ligato_linux_interfaces_interface_proto != nil {
-		return
+func (x *BridgeLink) GetPorts() []string {
+	if x != nil {
+		return x.Ports
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_ligato_linux_interfaces_interface_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ligato_linux_interfaces_interface_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VethLink); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ligato_linux_interfaces_interface_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TapLink); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_ligato_linux_interfaces_interface_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VrfDevLink); i {
+	return nil
+}
+
+func (x *BridgeLink) GetStpEnabled() bool {
+	if x != nil {
+		return x.StpEnabled
+	}
+	return false
+}
+
+func (x *BridgeLink) GetVlanFiltering() bool {
+	if x != nil {
+		return x.VlanFiltering
+	}
+	return false
+}
+
+type VlanLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of the parent interface (mandatory for VLAN).
+	// The VLAN interface is created in the namespace of the parent and then moved
+	// into the namespace given by Interface.namespace (if different).
+	ParentInterface string `protobuf:"bytes,1,opt,name=parent_interface,json=parentInterface,proto3" json:"parent_interface,omitempty"`
+	// 802.1Q VLAN ID.
+	VlanId uint32 `protobuf:"varint,2,opt,name=vlan_id,json=vlanId,proto3" json:"vlan_id,omitempty"`
+}
+
+func (x *VlanLink) Reset() {
+	*x = VlanLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VlanLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VlanLink) ProtoMessage() {}
+
+func (x *VlanLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VlanLink.ProtoReflect.Descriptor instead.
+func (*VlanLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VlanLink) GetParentInterface() string {
+	if x != nil {
+		return x.ParentInterface
+	}
+	return ""
+}
+
+func (x *VlanLink) GetVlanId() uint32 {
+	if x != nil {
+		return x.VlanId
+	}
+	return 0
+}
+
+type BondLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode BondLink_Mode `protobuf:"varint,1,opt,name=mode,proto3,enum=ligato.linux.interfaces.BondLink_Mode" json:"mode,omitempty"`
+	// Logical names of interfaces enslaved to the bond.
+	// Members have to be in the same network namespace as the bond.
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// MII link monitoring frequency in milliseconds (0 = disabled).
+	Miimon uint32 `protobuf:"varint,3,opt,name=miimon,proto3" json:"miimon,omitempty"`
+}
+
+func (x *BondLink) Reset() {
+	*x = BondLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BondLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BondLink) ProtoMessage() {}
+
+func (x *BondLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BondLink.ProtoReflect.Descriptor instead.
+func (*BondLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BondLink) GetMode() BondLink_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return BondLink_BALANCE_RR
+}
+
+func (x *BondLink) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *BondLink) GetMiimon() uint32 {
+	if x != nil {
+		return x.Miimon
+	}
+	return 0
+}
+
+type VxlanLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VXLAN Network Identifier.
+	Vni uint32 `protobuf:"varint,1,opt,name=vni,proto3" json:"vni,omitempty"`
+	// Source IP address of the tunnel (optional).
+	LocalIp string `protobuf:"bytes,2,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	// Remote VTEP IP address or multicast group (mandatory for VXLAN).
+	RemoteIp string `protobuf:"bytes,3,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	// UDP destination port (4789 if not set).
+	DstPort uint32 `protobuf:"varint,4,opt,name=dst_port,json=dstPort,proto3" json:"dst_port,omitempty"`
+	// Logical name of the underlying interface used to reach the remote VTEP.
+	// Mandatory if remote_ip is a multicast group. The underlying interface has to be
+	// in the same network namespace as the VXLAN interface.
+	UnderlayInterface string `protobuf:"bytes,5,opt,name=underlay_interface,json=underlayInterface,proto3" json:"underlay_interface,omitempty"`
+	// Enable learning of unknown source link layer addresses (FDB entries).
+	Learning bool `protobuf:"varint,6,opt,name=learning,proto3" json:"learning,omitempty"`
+	// TTL of the outer IP header (0 = inherit).
+	Ttl uint32 `protobuf:"varint,7,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *VxlanLink) Reset() {
+	*x = VxlanLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VxlanLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VxlanLink) ProtoMessage() {}
+
+func (x *VxlanLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VxlanLink.ProtoReflect.Descriptor instead.
+func (*VxlanLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VxlanLink) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *VxlanLink) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+func (x *VxlanLink) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *VxlanLink) GetDstPort() uint32 {
+	if x != nil {
+		return x.DstPort
+	}
+	return 0
+}
+
+func (x *VxlanLink) GetUnderlayInterface() string {
+	if x != nil {
+		return x.UnderlayInterface
+	}
+	return ""
+}
+
+func (x *VxlanLink) GetLearning() bool {
+	if x != nil {
+		return x.Learning
+	}
+	return false
+}
+
+func (x *VxlanLink) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+type GreLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source IP address of the tunnel (optional).
+	LocalIp string `protobuf:"bytes,1,opt,name=local_ip,json=localIp,proto3" json:"local_ip,omitempty"`
+	// Remote IP address of the tunnel (mandatory for GRE).
+	// IPv6 address results in ip6gre tunnel.
+	RemoteIp string `protobuf:"bytes,2,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	// TTL (hop limit for IPv6) of the outer IP header (0 = inherit).
+	Ttl uint32 `protobuf:"varint,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// GRE keys of incoming and outgoing packets (0 = no key).
+	InputKey  uint32 `protobuf:"varint,4,opt,name=input_key,json=inputKey,proto3" json:"input_key,omitempty"`
+	OutputKey uint32 `protobuf:"varint,5,opt,name=output_key,json=outputKey,proto3" json:"output_key,omitempty"`
+	// Logical name of the underlying interface to bind the tunnel to (optional).
+	UnderlayInterface string `protobuf:"bytes,6,opt,name=underlay_interface,json=underlayInterface,proto3" json:"underlay_interface,omitempty"`
+}
+
+func (x *GreLink) Reset() {
+	*x = GreLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GreLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GreLink) ProtoMessage() {}
+
+func (x *GreLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GreLink.ProtoReflect.Descriptor instead.
+func (*GreLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GreLink) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+func (x *GreLink) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *GreLink) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *GreLink) GetInputKey() uint32 {
+	if x != nil {
+		return x.InputKey
+	}
+	return 0
+}
+
+func (x *GreLink) GetOutputKey() uint32 {
+	if x != nil {
+		return x.OutputKey
+	}
+	return 0
+}
+
+func (x *GreLink) GetUnderlayInterface() string {
+	if x != nil {
+		return x.UnderlayInterface
+	}
+	return ""
+}
+
+type WireguardLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base64-encoded private key of the interface.
+	PrivateKey string `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// UDP port to listen on (random port if not set).
+	ListenPort uint32 `protobuf:"varint,2,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	// Firewall mark set to outgoing packets (0 = disabled).
+	Fwmark uint32                `protobuf:"varint,3,opt,name=fwmark,proto3" json:"fwmark,omitempty"`
+	Peers  []*WireguardLink_Peer `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *WireguardLink) Reset() {
+	*x = WireguardLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WireguardLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireguardLink) ProtoMessage() {}
+
+func (x *WireguardLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireguardLink.ProtoReflect.Descriptor instead.
+func (*WireguardLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WireguardLink) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *WireguardLink) GetListenPort() uint32 {
+	if x != nil {
+		return x.ListenPort
+	}
+	return 0
+}
+
+func (x *WireguardLink) GetFwmark() uint32 {
+	if x != nil {
+		return x.Fwmark
+	}
+	return 0
+}
+
+func (x *WireguardLink) GetPeers() []*WireguardLink_Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type MacvlanLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of the parent interface (mandatory for MACVLAN).
+	// The MACVLAN interface is created in the namespace of the parent and then moved
+	// into the namespace given by Interface.namespace (if different).
+	ParentInterface string           `protobuf:"bytes,1,opt,name=parent_interface,json=parentInterface,proto3" json:"parent_interface,omitempty"`
+	Mode            MacvlanLink_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ligato.linux.interfaces.MacvlanLink_Mode" json:"mode,omitempty"`
+}
+
+func (x *MacvlanLink) Reset() {
+	*x = MacvlanLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MacvlanLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MacvlanLink) ProtoMessage() {}
+
+func (x *MacvlanLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MacvlanLink.ProtoReflect.Descriptor instead.
+func (*MacvlanLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MacvlanLink) GetParentInterface() string {
+	if x != nil {
+		return x.ParentInterface
+	}
+	return ""
+}
+
+func (x *MacvlanLink) GetMode() MacvlanLink_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return MacvlanLink_BRIDGE
+}
+
+type IpvlanLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Logical name of the parent interface (mandatory for IPVLAN).
+	// The IPVLAN interface is created in the namespace of the parent and then moved
+	// into the namespace given by Interface.namespace (if different).
+	ParentInterface string          `protobuf:"bytes,1,opt,name=parent_interface,json=parentInterface,proto3" json:"parent_interface,omitempty"`
+	Mode            IpvlanLink_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=ligato.linux.interfaces.IpvlanLink_Mode" json:"mode,omitempty"`
+}
+
+func (x *IpvlanLink) Reset() {
+	*x = IpvlanLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IpvlanLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IpvlanLink) ProtoMessage() {}
+
+func (x *IpvlanLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IpvlanLink.ProtoReflect.Descriptor instead.
+func (*IpvlanLink) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IpvlanLink) GetParentInterface() string {
+	if x != nil {
+		return x.ParentInterface
+	}
+	return ""
+}
+
+func (x *IpvlanLink) GetMode() IpvlanLink_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return IpvlanLink_L2
+}
+
+type WireguardLink_Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Base64-encoded public key of the peer (mandatory).
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Base64-encoded pre-shared key (optional).
+	PresharedKey string `protobuf:"bytes,2,opt,name=preshared_key,json=presharedKey,proto3" json:"preshared_key,omitempty"`
+	// Endpoint of the peer in the <IP>:<port> format (optional).
+	Endpoint string `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Networks allowed to be reached through (and received from) the peer.
+	AllowedIps []string `protobuf:"bytes,4,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	// Interval of keepalive packets in seconds (0 = disabled).
+	PersistentKeepalive uint32 `protobuf:"varint,5,opt,name=persistent_keepalive,json=persistentKeepalive,proto3" json:"persistent_keepalive,omitempty"`
+}
+
+func (x *WireguardLink_Peer) Reset() {
+	*x = WireguardLink_Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WireguardLink_Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireguardLink_Peer) ProtoMessage() {}
+
+func (x *WireguardLink_Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_interfaces_interface_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireguardLink_Peer.ProtoReflect.Descriptor instead.
+func (*WireguardLink_Peer) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_interfaces_interface_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *WireguardLink_Peer) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *WireguardLink_Peer) GetPresharedKey() string {
+	if x != nil {
+		return x.PresharedKey
+	}
+	return ""
+}
+
+func (x *WireguardLink_Peer) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *WireguardLink_Peer) GetAllowedIps() []string {
+	if x != nil {
+		return x.AllowedIps
+	}
+	return nil
+}
+
+func (x *WireguardLink_Peer) GetPersistentKeepalive() uint32 {
+	if x != nil {
+		return x.PersistentKeepalive
+	}
+	return 0
+}
+
+var File_ligato_linux_interfaces_interface_proto protoreflect.FileDescriptor
+
+var file_ligato_linux_interfaces_interface_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x1a, 0x26, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfc, 0x09, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x4e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f,
+	0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68,
+	0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04,
+	0x52, 0x0b, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x68, 0x79, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1a, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82,
+	0x7d, 0x05, 0x12, 0x03, 0x10, 0x80, 0x48, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x37, 0x0a, 0x04,
+	0x76, 0x65, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x04, 0x76, 0x65, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x03, 0x74, 0x61, 0x70, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x61, 0x70,
+	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x74, 0x61, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x76,
+	0x72, 0x66, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x72, 0x66, 0x44, 0x65, 0x76, 0x4c, 0x69, 0x6e,
+	0x6b, 0x48, 0x00, 0x52, 0x06, 0x76, 0x72, 0x66, 0x44, 0x65, 0x76, 0x12, 0x3d, 0x0a, 0x06, 0x62,
+	0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x76, 0x6c,
+	0x61, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x56, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x76,
+	0x6c, 0x61, 0x6e, 0x12, 0x37, 0x0a, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64,
+	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x05,
+	0x76, 0x78, 0x6c, 0x61, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48,
+	0x00, 0x52, 0x05, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x12, 0x34, 0x0a, 0x03, 0x67, 0x72, 0x65, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
+	0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x67, 0x72, 0x65, 0x12, 0x46,
+	0x0a, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78,
+	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x57, 0x69, 0x72, 0x65,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x77, 0x69, 0x72,
+	0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x6d, 0x61, 0x63, 0x76, 0x6c, 0x61,
+	0x6e, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x2e, 0x4d, 0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x07, 0x6d, 0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x3d, 0x0a, 0x06, 0x69, 0x70, 0x76, 0x6c,
+	0x61, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x49, 0x70, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x06, 0x69, 0x70, 0x76, 0x6c, 0x61, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x69, 0x6e, 0x6b,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x5f, 0x6d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x76, 0x72, 0x66, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x56, 0x45, 0x54, 0x48, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x50, 0x5f,
+	0x54, 0x4f, 0x5f, 0x56, 0x50, 0x50, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4c, 0x4f, 0x4f, 0x50,
+	0x42, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x52, 0x46, 0x5f, 0x44, 0x45, 0x56, 0x49,
+	0x43, 0x45, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x55, 0x4d, 0x4d, 0x59, 0x10, 0x06, 0x12,
+	0x0a, 0x0a, 0x06, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x10, 0x07, 0x12, 0x08, 0x0a, 0x04, 0x56,
+	0x4c, 0x41, 0x4e, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4e, 0x44, 0x10, 0x09, 0x12,
+	0x09, 0x0a, 0x05, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x10, 0x0a, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x52,
+	0x45, 0x10, 0x0b, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x49, 0x52, 0x45, 0x47, 0x55, 0x41, 0x52, 0x44,
+	0x10, 0x0c, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x41, 0x43, 0x56, 0x4c, 0x41, 0x4e, 0x10, 0x0d, 0x12,
+	0x0a, 0x0a, 0x06, 0x49, 0x50, 0x56, 0x4c, 0x41, 0x4e, 0x10, 0x0e, 0x42, 0x06, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x6b, 0x22, 0xec, 0x02, 0x0a, 0x08, 0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x49, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x6a, 0x0a, 0x16, 0x72, 0x78, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75,
+	0x6d, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x74,
+	0x68, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66,
+	0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x78, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x6a,
+	0x0a, 0x16, 0x74, 0x78, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x5f, 0x6f, 0x66,
+	0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x74, 0x68, 0x4c, 0x69, 0x6e,
+	0x6b, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x74, 0x78, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x66, 0x0a, 0x12, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x4b, 0x53, 0x4d, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41,
+	0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x43,
+	0x48, 0x4b, 0x53, 0x4d, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x45, 0x4e, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x4b, 0x53, 0x4d, 0x5f,
+	0x4f, 0x46, 0x46, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x02, 0x22, 0x30, 0x0a, 0x07, 0x54, 0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x0a,
+	0x0f, 0x76, 0x70, 0x70, 0x5f, 0x74, 0x61, 0x70, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x70, 0x70, 0x54, 0x61, 0x70, 0x49, 0x66,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31, 0x0a, 0x0a, 0x56, 0x72, 0x66, 0x44, 0x65, 0x76, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x6a, 0x0a, 0x0a, 0x42, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x70, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x73, 0x74, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x76, 0x6c, 0x61, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x76, 0x6c, 0x61, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x22, 0x5a, 0x0a, 0x08, 0x56, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x07, 0x76, 0x6c,
+	0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0x7d, 0x07,
+	0x12, 0x05, 0x10, 0xfe, 0x1f, 0x08, 0x01, 0x52, 0x06, 0x76, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x22,
+	0xef, 0x01, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x3a, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x69, 0x6d, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x6d, 0x69, 0x69, 0x6d, 0x6f, 0x6e, 0x22, 0x75, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x52, 0x52,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x42, 0x41, 0x43,
+	0x4b, 0x55, 0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45,
+	0x5f, 0x58, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
+	0x41, 0x53, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x43, 0x50, 0x10, 0x04, 0x12,
+	0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x4c, 0x42, 0x10, 0x05,
+	0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x41, 0x4c, 0x42, 0x10,
+	0x06, 0x22, 0xfc, 0x01, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x1c, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0x82, 0x7d,
+	0x07, 0x12, 0x05, 0x10, 0xff, 0xff, 0xff, 0x07, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x20, 0x0a,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12,
+	0x22, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x70, 0x12, 0x24, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03,
+	0x52, 0x07, 0x64, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x64,
+	0x65, 0x72, 0x6c, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0xff, 0x01, 0x52, 0x03, 0x74, 0x74, 0x6c,
+	0x22, 0xd6, 0x01, 0x0a, 0x07, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
+	0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x22,
+	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x49, 0x70, 0x12, 0x1a, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x08, 0x82, 0x7d, 0x05, 0x12, 0x03, 0x10, 0xff, 0x01, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e,
+	0x64, 0x65, 0x72, 0x6c, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x6e, 0x64, 0x65, 0x72, 0x6c, 0x61, 0x79,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x86, 0x03, 0x0a, 0x0d, 0x57, 0x69,
+	0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x0b,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x0a, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d, 0x61,
+	0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72, 0x6b,
+	0x12, 0x41, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x1a, 0xcc, 0x01, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4b, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0b,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x69, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x04, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x49, 0x70, 0x73, 0x12, 0x3c, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x13, 0x70,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69,
+	0x76, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x3d, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x63, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x52, 0x49, 0x44, 0x47, 0x45, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x49, 0x56, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a,
+	0x04, 0x56, 0x45, 0x50, 0x41, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x54,
+	0x48, 0x52, 0x55, 0x10, 0x03, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x49, 0x70, 0x76, 0x6c, 0x61, 0x6e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12,
+	0x3c, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x70, 0x76, 0x6c, 0x61, 0x6e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x1f, 0x0a,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x06, 0x0a,
+	0x02, 0x4c, 0x33, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x53, 0x10, 0x02, 0x42, 0x4a,
+	0x5a, 0x48, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_ligato_linux_interfaces_interface_proto_rawDescOnce sync.Once
+	file_ligato_linux_interfaces_interface_proto_rawDescData = file_ligato_linux_interfaces_interface_proto_rawDesc
+)
+
+func file_ligato_linux_interfaces_interface_proto_rawDescGZIP() []byte {
+	file_ligato_linux_interfaces_interface_proto_rawDescOnce.Do(func() {
+		file_ligato_linux_interfaces_interface_proto_rawDescData = protoimpl.X.CompressGZIP(file_ligato_linux_interfaces_interface_proto_rawDescData)
+	})
+	return file_ligato_linux_interfaces_interface_proto_rawDescData
+}
+
+var file_ligato_linux_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_ligato_linux_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_ligato_linux_interfaces_interface_proto_goTypes = []interface{}{
+	(Interface_Type)(0),              // 0: ligato.linux.interfaces.Interface.Type
+	(VethLink_ChecksumOffloading)(0), // 1: ligato.linux.interfaces.VethLink.ChecksumOffloading
+	(BondLink_Mode)(0),               // 2: ligato.linux.interfaces.BondLink.Mode
+	(MacvlanLink_Mode)(0),            // 3: ligato.linux.interfaces.MacvlanLink.Mode
+	(IpvlanLink_Mode)(0),             // 4: ligato.linux.interfaces.IpvlanLink.Mode
+	(*Interface)(nil),                // 5: ligato.linux.interfaces.Interface
+	(*VethLink)(nil),                 // 6: ligato.linux.interfaces.VethLink
+	(*TapLink)(nil),                  // 7: ligato.linux.interfaces.TapLink
+	(*VrfDevLink)(nil),               // 8: ligato.linux.interfaces.VrfDevLink
+	(*BridgeLink)(nil),               // 9: ligato.linux.interfaces.BridgeLink
+	(*VlanLink)(nil),                 // 10: ligato.linux.interfaces.VlanLink
+	(*BondLink)(nil),                 // 11: ligato.linux.interfaces.BondLink
+	(*VxlanLink)(nil),                // 12: ligato.linux.interfaces.VxlanLink
+	(*GreLink)(nil),                  // 13: ligato.linux.interfaces.GreLink
+	(*WireguardLink)(nil),            // 14: ligato.linux.interfaces.WireguardLink
+	(*MacvlanLink)(nil),              // 15: ligato.linux.interfaces.MacvlanLink
+	(*IpvlanLink)(nil),               // 16: ligato.linux.interfaces.IpvlanLink
+	(*WireguardLink_Peer)(nil),       // 17: ligato.linux.interfaces.WireguardLink.Peer
+	(*namespace.NetNamespace)(nil),   // 18: ligato.linux.namespace.NetNamespace
+}
+var file_ligato_linux_interfaces_interface_proto_depIdxs = []int32{
+	0,  // 0: ligato.linux.interfaces.Interface.type:type_name -> ligato.linux.interfaces.Interface.Type
+	18, // 1: ligato.linux.interfaces.Interface.namespace:type_name -> ligato.linux.namespace.NetNamespace
+	6,  // 2: ligato.linux.interfaces.Interface.veth:type_name -> ligato.linux.interfaces.VethLink
+	7,  // 3: ligato.linux.interfaces.Interface.tap:type_name -> ligato.linux.interfaces.TapLink
+	8,  // 4: ligato.linux.interfaces.Interface.vrf_dev:type_name -> ligato.linux.interfaces.VrfDevLink
+	9,  // 5: ligato.linux.interfaces.Interface.bridge:type_name -> ligato.linux.interfaces.BridgeLink
+	10, // 6: ligato.linux.interfaces.Interface.vlan:type_name -> ligato.linux.interfaces.VlanLink
+	11, // 7: ligato.linux.interfaces.Interface.bond:type_name -> ligato.linux.interfaces.BondLink
+	12, // 8: ligato.linux.interfaces.Interface.vxlan:type_name -> ligato.linux.interfaces.VxlanLink
+	13, // 9: ligato.linux.interfaces.Interface.gre:type_name -> ligato.linux.interfaces.GreLink
+	14, // 10: ligato.linux.interfaces.Interface.wireguard:type_name -> ligato.linux.interfaces.WireguardLink
+	15, // 11: ligato.linux.interfaces.Interface.macvlan:type_name -> ligato.linux.interfaces.MacvlanLink
+	16, // 12: ligato.linux.interfaces.Interface.ipvlan:type_name -> ligato.linux.interfaces.IpvlanLink
+	1,  // 13: ligato.linux.interfaces.VethLink.rx_checksum_offloading:type_name -> ligato.linux.interfaces.VethLink.ChecksumOffloading
+	1,  // 14: ligato.linux.interfaces.VethLink.tx_checksum_offloading:type_name -> ligato.linux.interfaces.VethLink.ChecksumOffloading
+	2,  // 15: ligato.linux.interfaces.BondLink.mode:type_name -> ligato.linux.interfaces.BondLink.Mode
+	17, // 16: ligato.linux.interfaces.WireguardLink.peers:type_name -> ligato.linux.interfaces.WireguardLink.Peer
+	3,  // 17: ligato.linux.interfaces.MacvlanLink.mode:type_name -> ligato.linux.interfaces.MacvlanLink.Mode
+	4,  // 18: ligato.linux.interfaces.IpvlanLink.mode:type_name -> ligato.linux.interfaces.IpvlanLink.Mode
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_ligato_linux_interfaces_interface_proto_init() }
+func file_ligato_linux_interfaces_interface_proto_init() {
+	if File_ligato_linux_interfaces_interface_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ligato_linux_interfaces_interface_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VethLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TapLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VrfDevLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BridgeLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VlanLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BondLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VxlanLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GreLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WireguardLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacvlanLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IpvlanLink); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_interfaces_interface_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WireguardLink_Peer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -718,14 +1978,22 @@ func file_ligato_linux_interfaces_interface_proto_init() {
 		(*Interface_Veth)(nil),
 		(*Interface_Tap)(nil),
 		(*Interface_VrfDev)(nil),
+		(*Interface_Bridge)(nil),
+		(*Interface_Vlan)(nil),
+		(*Interface_Bond)(nil),
+		(*Interface_Vxlan)(nil),
+		(*Interface_Gre)(nil),
+		(*Interface_Wireguard)(nil),
+		(*Interface_Macvlan)(nil),
+		(*Interface_Ipvlan)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_linux_interfaces_interface_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      5,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
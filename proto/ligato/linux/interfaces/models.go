@@ -80,6 +80,12 @@ const (
 	// interfaceVrfKeyTmpl is a template for (derived) key representing assignment
 	// of a Linux interface into a VRF.
 	interfaceVrfKeyTmpl = "linux/interface/{iface}/vrf/{vrf}"
+
+	/* Interface Master (derived) */
+
+	// interfaceMasterKeyTmpl is a template for (derived) key representing enslavement
+	// of a Linux interface to a master device (bridge or bond).
+	interfaceMasterKeyTmpl = "linux/interface/{iface}/master/{master}"
 )
 
 const (
@@ -203,8 +209,8 @@ func ParseInterfaceAddressKey(key string) (iface, address string, source netallo
 	addrIdx := -1
 	for idx, part := range parts {
 		switch part {
-		case "vrf":
-			// avoid collision with InterfaceVrfKey
+		case "vrf", "master":
+			// avoid collision with InterfaceVrfKey and InterfaceMasterKey
 			return
 		case "address":
 			addrIdx = idx
@@ -272,8 +278,8 @@ func ParseInterfaceVrfKey(key string) (iface string, vrf string, invalidKey, isV
 	vrfIdx := -1
 	for idx, part := range parts {
 		switch part {
-		case "address":
-			// avoid collision with InterfaceAddressKey
+		case "address", "master":
+			// avoid collision with InterfaceAddressKey and InterfaceMasterKey
 			return
 		case "vrf":
 			vrfIdx = idx
@@ -301,6 +307,65 @@ func ParseInterfaceVrfKey(key string) (iface string, vrf string, invalidKey, isV
 	return
 }
 
+// InterfaceMasterKey returns key representing enslavement of a Linux interface
+// to a master device (bridge or bond).
+func InterfaceMasterKey(iface string, master string) string {
+	if iface == "" {
+		iface = InvalidKeyPart
+	}
+	if master == "" {
+		master = InvalidKeyPart
+	}
+
+	tmpl := interfaceMasterKeyTmpl
+	key := strings.Replace(tmpl, "{iface}", iface, 1)
+	key = strings.Replace(key, "{master}", master, 1)
+	return key
+}
+
+// ParseInterfaceMasterKey parses interface and its master from key derived
+// from master interface by InterfaceMasterKey().
+func ParseInterfaceMasterKey(key string) (iface string, master string, invalidKey, isMasterKey bool) {
+	parts := strings.Split(key, "/")
+	if len(parts) < 4 || parts[0] != "linux" || parts[1] != "interface" {
+		return
+	}
+	if parts[2] == "state" || parts[2] == "host-name" {
+		return
+	}
+
+	masterIdx := -1
+	for idx, part := range parts {
+		switch part {
+		case "address", "vrf":
+			// avoid collision with InterfaceAddressKey and InterfaceVrfKey
+			return
+		case "master":
+			masterIdx = idx
+		}
+	}
+	if masterIdx == -1 {
+		return
+	}
+	isMasterKey = true
+
+	// parse interface name
+	iface = strings.Join(parts[2:masterIdx], "/")
+	if iface == "" {
+		iface = InvalidKeyPart
+		invalidKey = true
+	}
+
+	// parse master
+	if masterIdx == len(parts)-1 {
+		invalidKey = true
+		master = InvalidKeyPart
+		return
+	}
+	master = parts[masterIdx+1]
+	return
+}
+
 // MarshalJSON ensures that field of type 'oneOf' is correctly marshaled
 // by using protobuf json marshaller
 func (m *Interface) MarshalJSON() ([]byte, error) {
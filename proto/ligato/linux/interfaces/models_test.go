@@ -406,3 +406,109 @@ func TestParseInterfaceVrfKey(t *testing.T) {
 		})
 	}
 }
+
+func TestInterfaceMasterKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		master      string
+		expectedKey string
+	}{
+		{
+			name:        "bridge port",
+			iface:       "veth0",
+			master:      "br0",
+			expectedKey: "linux/interface/veth0/master/br0",
+		},
+		{
+			name:        "bond member",
+			iface:       "eth1",
+			master:      "bond0",
+			expectedKey: "linux/interface/eth1/master/bond0",
+		},
+		{
+			name:        "invalid interface",
+			iface:       "",
+			master:      "br0",
+			expectedKey: "linux/interface/<invalid>/master/br0",
+		},
+		{
+			name:        "invalid master",
+			iface:       "veth0",
+			master:      "",
+			expectedKey: "linux/interface/veth0/master/<invalid>",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := InterfaceMasterKey(test.iface, test.master)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s master=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.master, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestParseInterfaceMasterKey(t *testing.T) {
+	tests := []struct {
+		name                string
+		key                 string
+		expectedIface       string
+		expectedMaster      string
+		expectedInvalidKey  bool
+		expectedIsMasterKey bool
+	}{
+		{
+			name:                "bridge port",
+			key:                 "linux/interface/veth0/master/br0",
+			expectedIface:       "veth0",
+			expectedMaster:      "br0",
+			expectedIsMasterKey: true,
+		},
+		{
+			name:                "missing interface",
+			key:                 "linux/interface//master/br0",
+			expectedIface:       "<invalid>",
+			expectedMaster:      "br0",
+			expectedInvalidKey:  true,
+			expectedIsMasterKey: true,
+		},
+		{
+			name:                "missing master",
+			key:                 "linux/interface/veth0/master",
+			expectedIface:       "veth0",
+			expectedMaster:      "<invalid>",
+			expectedInvalidKey:  true,
+			expectedIsMasterKey: true,
+		},
+		{
+			name:                "not interface master key",
+			key:                 "linux/interface/veth0/vrf/blue",
+			expectedIsMasterKey: false,
+		},
+		{
+			name:                "not interface master key #2",
+			key:                 "linux/interface/tap1/address/static/192.168.1.1/32",
+			expectedIsMasterKey: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			iface, master, invalidKey, isMasterKey := ParseInterfaceMasterKey(test.key)
+			if isMasterKey != test.expectedIsMasterKey {
+				t.Errorf("expected isMasterKey: %v\tgot: %v", test.expectedIsMasterKey, isMasterKey)
+			}
+			if invalidKey != test.expectedInvalidKey {
+				t.Errorf("expected invalidKey: %v\tgot: %v", test.expectedInvalidKey, invalidKey)
+			}
+			if iface != test.expectedIface {
+				t.Errorf("expected iface: %s\tgot: %s", test.expectedIface, iface)
+			}
+			if master != test.expectedMaster {
+				t.Errorf("expected master: %s\tgot: %s", test.expectedMaster, master)
+			}
+		})
+	}
+}
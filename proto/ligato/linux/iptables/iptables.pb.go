@@ -8,6 +8,7 @@ package linux_iptables
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
 	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -240,20 +241,192 @@ func (RuleChain_Policy) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{0, 3}
 }
 
+type Rule_Protocol int32
+
+const (
+	Rule_ANY    Rule_Protocol = 0
+	Rule_TCP    Rule_Protocol = 1
+	Rule_UDP    Rule_Protocol = 2
+	Rule_ICMP   Rule_Protocol = 3
+	Rule_ICMPV6 Rule_Protocol = 4
+	Rule_SCTP   Rule_Protocol = 5
+)
+
+// Enum value maps for Rule_Protocol.
+var (
+	Rule_Protocol_name = map[int32]string{
+		0: "ANY",
+		1: "TCP",
+		2: "UDP",
+		3: "ICMP",
+		4: "ICMPV6",
+		5: "SCTP",
+	}
+	Rule_Protocol_value = map[string]int32{
+		"ANY":    0,
+		"TCP":    1,
+		"UDP":    2,
+		"ICMP":   3,
+		"ICMPV6": 4,
+		"SCTP":   5,
+	}
+)
+
+func (x Rule_Protocol) Enum() *Rule_Protocol {
+	p := new(Rule_Protocol)
+	*p = x
+	return p
+}
+
+func (x Rule_Protocol) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_Protocol) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_iptables_iptables_proto_enumTypes[4].Descriptor()
+}
+
+func (Rule_Protocol) Type() protoreflect.EnumType {
+	return &file_ligato_linux_iptables_iptables_proto_enumTypes[4]
+}
+
+func (x Rule_Protocol) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_Protocol.Descriptor instead.
+func (Rule_Protocol) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type Rule_ConnState int32
+
+const (
+	Rule_NEW         Rule_ConnState = 0
+	Rule_ESTABLISHED Rule_ConnState = 1
+	Rule_RELATED     Rule_ConnState = 2
+	Rule_INVALID     Rule_ConnState = 3
+	Rule_UNTRACKED   Rule_ConnState = 4
+)
+
+// Enum value maps for Rule_ConnState.
+var (
+	Rule_ConnState_name = map[int32]string{
+		0: "NEW",
+		1: "ESTABLISHED",
+		2: "RELATED",
+		3: "INVALID",
+		4: "UNTRACKED",
+	}
+	Rule_ConnState_value = map[string]int32{
+		"NEW":         0,
+		"ESTABLISHED": 1,
+		"RELATED":     2,
+		"INVALID":     3,
+		"UNTRACKED":   4,
+	}
+)
+
+func (x Rule_ConnState) Enum() *Rule_ConnState {
+	p := new(Rule_ConnState)
+	*p = x
+	return p
+}
+
+func (x Rule_ConnState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_ConnState) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_iptables_iptables_proto_enumTypes[5].Descriptor()
+}
+
+func (Rule_ConnState) Type() protoreflect.EnumType {
+	return &file_ligato_linux_iptables_iptables_proto_enumTypes[5]
+}
+
+func (x Rule_ConnState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_ConnState.Descriptor instead.
+func (Rule_ConnState) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type Rule_Target int32
+
+const (
+	Rule_ACCEPT Rule_Target = 0
+	Rule_DROP   Rule_Target = 1
+	Rule_DNAT   Rule_Target = 2
+	Rule_SNAT   Rule_Target = 3
+	Rule_MARK   Rule_Target = 4
+	Rule_JUMP   Rule_Target = 5
+)
+
+// Enum value maps for Rule_Target.
+var (
+	Rule_Target_name = map[int32]string{
+		0: "ACCEPT",
+		1: "DROP",
+		2: "DNAT",
+		3: "SNAT",
+		4: "MARK",
+		5: "JUMP",
+	}
+	Rule_Target_value = map[string]int32{
+		"ACCEPT": 0,
+		"DROP":   1,
+		"DNAT":   2,
+		"SNAT":   3,
+		"MARK":   4,
+		"JUMP":   5,
+	}
+)
+
+func (x Rule_Target) Enum() *Rule_Target {
+	p := new(Rule_Target)
+	*p = x
+	return p
+}
+
+func (x Rule_Target) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rule_Target) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_iptables_iptables_proto_enumTypes[6].Descriptor()
+}
+
+func (Rule_Target) Type() protoreflect.EnumType {
+	return &file_ligato_linux_iptables_iptables_proto_enumTypes[6]
+}
+
+func (x Rule_Target) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rule_Target.Descriptor instead.
+func (Rule_Target) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 2}
+}
+
 type RuleChain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Namespace     *namespace.NetNamespace `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                           // network namespace in which this rule chain is applied
-	Interfaces    []string                `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`                                                                         // list of interfaces referred by the rules (optional)
-	Protocol      RuleChain_Protocol      `protobuf:"varint,4,opt,name=protocol,proto3,enum=ligato.linux.iptables.RuleChain_Protocol" json:"protocol,omitempty"`                              // protocol (address family) of the rule chain
-	Table         RuleChain_Table         `protobuf:"varint,5,opt,name=table,proto3,enum=ligato.linux.iptables.RuleChain_Table" json:"table,omitempty"`                                       // table the rule chain belongs to
-	ChainType     RuleChain_ChainType     `protobuf:"varint,6,opt,name=chain_type,json=chainType,proto3,enum=ligato.linux.iptables.RuleChain_ChainType" json:"chain_type,omitempty"`          // type of the chain
-	ChainName     string                  `protobuf:"bytes,7,opt,name=chain_name,json=chainName,proto3" json:"chain_name,omitempty"`                                                          // name of the chain, used only for chains with CUSTOM chain_type
-	DefaultPolicy RuleChain_Policy        `protobuf:"varint,8,opt,name=default_policy,json=defaultPolicy,proto3,enum=ligato.linux.iptables.RuleChain_Policy" json:"default_policy,omitempty"` // default policy of the chain. Used for FILTER tables only.
-	Rules         []string                `protobuf:"bytes,10,rep,name=rules,proto3" json:"rules,omitempty"`
+	Name            string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace       *namespace.NetNamespace `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                           // network namespace in which this rule chain is applied
+	Interfaces      []string                `protobuf:"bytes,3,rep,name=interfaces,proto3" json:"interfaces,omitempty"`                                                                         // list of interfaces referred by the rules (optional)
+	Protocol        RuleChain_Protocol      `protobuf:"varint,4,opt,name=protocol,proto3,enum=ligato.linux.iptables.RuleChain_Protocol" json:"protocol,omitempty"`                              // protocol (address family) of the rule chain
+	Table           RuleChain_Table         `protobuf:"varint,5,opt,name=table,proto3,enum=ligato.linux.iptables.RuleChain_Table" json:"table,omitempty"`                                       // table the rule chain belongs to
+	ChainType       RuleChain_ChainType     `protobuf:"varint,6,opt,name=chain_type,json=chainType,proto3,enum=ligato.linux.iptables.RuleChain_ChainType" json:"chain_type,omitempty"`          // type of the chain
+	ChainName       string                  `protobuf:"bytes,7,opt,name=chain_name,json=chainName,proto3" json:"chain_name,omitempty"`                                                          // name of the chain, used only for chains with CUSTOM chain_type
+	DefaultPolicy   RuleChain_Policy        `protobuf:"varint,8,opt,name=default_policy,json=defaultPolicy,proto3,enum=ligato.linux.iptables.RuleChain_Policy" json:"default_policy,omitempty"` // default policy of the chain. Used for FILTER tables only.
+	Rules           []string                `protobuf:"bytes,10,rep,name=rules,proto3" json:"rules,omitempty"`
+	StructuredRules []*Rule                 `protobuf:"bytes,11,rep,name=structured_rules,json=structuredRules,proto3" json:"structured_rules,omitempty"`
 }
 
 func (x *RuleChain) Reset() {
@@ -351,6 +524,312 @@ func (x *RuleChain) GetRules() []string {
 	return nil
 }
 
+func (x *RuleChain) GetStructuredRules() []*Rule {
+	if x != nil {
+		return x.StructuredRules
+	}
+	return nil
+}
+
+// Rule is a structured definition of an iptables rule (match and action part).
+// Unlike rules defined as strings, structured rules refer to interfaces by their
+// logical names, can reference addresses allocated by the netalloc plugin
+// and are validated by the agent.
+type Rule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Protocol to match (optional).
+	Protocol Rule_Protocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=ligato.linux.iptables.Rule_Protocol" json:"protocol,omitempty"`
+	// Logical name of the incoming interface to match (optional).
+	// Not applicable to OUTPUT and POSTROUTING chains.
+	InInterface string `protobuf:"bytes,2,opt,name=in_interface,json=inInterface,proto3" json:"in_interface,omitempty"`
+	// Logical name of the outgoing interface to match (optional).
+	// Not applicable to INPUT and PREROUTING chains.
+	OutInterface string `protobuf:"bytes,3,opt,name=out_interface,json=outInterface,proto3" json:"out_interface,omitempty"`
+	// Source address or network to match in the format <address>[/<prefix>] (optional).
+	// Address can be also allocated via netalloc plugin and referenced here,
+	// see: api/models/netalloc/netalloc.proto
+	// (referenced address is matched as a single host address)
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	// Destination address or network to match in the format <address>[/<prefix>] (optional).
+	// Address can be also allocated via netalloc plugin and referenced here,
+	// see: api/models/netalloc/netalloc.proto
+	// (referenced address is matched as a single host address)
+	Destination string `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	// Source port(s) to match, applicable only to TCP, UDP and SCTP (optional).
+	SourcePort *Rule_PortRange `protobuf:"bytes,6,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// Destination port(s) to match, applicable only to TCP, UDP and SCTP (optional).
+	DestinationPort *Rule_PortRange `protobuf:"bytes,7,opt,name=destination_port,json=destinationPort,proto3" json:"destination_port,omitempty"`
+	// Connection tracking states to match (optional).
+	ConnStates []Rule_ConnState `protobuf:"varint,8,rep,name=conn_states,json=connStates,proto3,enum=ligato.linux.iptables.Rule_ConnState" json:"conn_states,omitempty"`
+	// Firewall mark of the packet to match (optional).
+	Mark *Rule_Mark `protobuf:"bytes,9,opt,name=mark,proto3" json:"mark,omitempty"`
+	// Target (action) of the rule.
+	// DNAT and SNAT are applicable only in the NAT table, MARK only in the MANGLE table.
+	Target Rule_Target `protobuf:"varint,10,opt,name=target,proto3,enum=ligato.linux.iptables.Rule_Target" json:"target,omitempty"`
+	// Address to translate the destination (DNAT) or the source (SNAT) address to
+	// (mandatory for DNAT and SNAT).
+	// Address can be also allocated via netalloc plugin and referenced here,
+	// see: api/models/netalloc/netalloc.proto
+	ToAddress string `protobuf:"bytes,11,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	// Port(s) to translate the destination (DNAT) or the source (SNAT) port to
+	// (optional, applicable only to TCP, UDP and SCTP).
+	ToPort *Rule_PortRange `protobuf:"bytes,12,opt,name=to_port,json=toPort,proto3" json:"to_port,omitempty"`
+	// Firewall mark to set (mandatory for MARK).
+	SetMark *Rule_Mark `protobuf:"bytes,13,opt,name=set_mark,json=setMark,proto3" json:"set_mark,omitempty"`
+	// Name of the chain to jump to (mandatory for JUMP).
+	// Has to be chain_name of a rule chain with the CUSTOM chain_type configured
+	// in the same namespace, for the same protocol and table - the rule is not
+	// applied until the chain is created.
+	JumpChain string `protobuf:"bytes,14,opt,name=jump_chain,json=jumpChain,proto3" json:"jump_chain,omitempty"`
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rule) GetProtocol() Rule_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return Rule_ANY
+}
+
+func (x *Rule) GetInInterface() string {
+	if x != nil {
+		return x.InInterface
+	}
+	return ""
+}
+
+func (x *Rule) GetOutInterface() string {
+	if x != nil {
+		return x.OutInterface
+	}
+	return ""
+}
+
+func (x *Rule) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *Rule) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *Rule) GetSourcePort() *Rule_PortRange {
+	if x != nil {
+		return x.SourcePort
+	}
+	return nil
+}
+
+func (x *Rule) GetDestinationPort() *Rule_PortRange {
+	if x != nil {
+		return x.DestinationPort
+	}
+	return nil
+}
+
+func (x *Rule) GetConnStates() []Rule_ConnState {
+	if x != nil {
+		return x.ConnStates
+	}
+	return nil
+}
+
+func (x *Rule) GetMark() *Rule_Mark {
+	if x != nil {
+		return x.Mark
+	}
+	return nil
+}
+
+func (x *Rule) GetTarget() Rule_Target {
+	if x != nil {
+		return x.Target
+	}
+	return Rule_ACCEPT
+}
+
+func (x *Rule) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *Rule) GetToPort() *Rule_PortRange {
+	if x != nil {
+		return x.ToPort
+	}
+	return nil
+}
+
+func (x *Rule) GetSetMark() *Rule_Mark {
+	if x != nil {
+		return x.SetMark
+	}
+	return nil
+}
+
+func (x *Rule) GetJumpChain() string {
+	if x != nil {
+		return x.JumpChain
+	}
+	return ""
+}
+
+type Rule_PortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// First (or the only) port of the range.
+	From uint32 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Last port of the range (0 = the range consists of a single port).
+	To uint32 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *Rule_PortRange) Reset() {
+	*x = Rule_PortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_PortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_PortRange) ProtoMessage() {}
+
+func (x *Rule_PortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_PortRange.ProtoReflect.Descriptor instead.
+func (*Rule_PortRange) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Rule_PortRange) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *Rule_PortRange) GetTo() uint32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type Rule_Mark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Value of the firewall mark.
+	Value uint32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	// Mask selecting bits of the firewall mark (0 = all bits).
+	Mask uint32 `protobuf:"varint,2,opt,name=mask,proto3" json:"mask,omitempty"`
+}
+
+func (x *Rule_Mark) Reset() {
+	*x = Rule_Mark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rule_Mark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule_Mark) ProtoMessage() {}
+
+func (x *Rule_Mark) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_iptables_iptables_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule_Mark.ProtoReflect.Descriptor instead.
+func (*Rule_Mark) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_iptables_iptables_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *Rule_Mark) GetValue() uint32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Rule_Mark) GetMask() uint32 {
+	if x != nil {
+		return x.Mask
+	}
+	return 0
+}
+
 var File_ligato_linux_iptables_iptables_proto protoreflect.FileDescriptor
 
 var file_ligato_linux_iptables_iptables_proto_rawDesc = []byte{
@@ -360,57 +839,132 @@ var file_ligato_linux_iptables_iptables_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x26, 0x6c,
 	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6e, 0x61, 0x6d, 0x65,
 	0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd8, 0x05, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x3c, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78,
-	0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x49, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xa0, 0x06, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x3c, 0x0a, 0x05,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
 	0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c,
-	0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0e, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75,
-	0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x22, 0x1e, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x08, 0x0a, 0x04,
-	0x49, 0x50, 0x56, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x01,
-	0x22, 0x3f, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x49, 0x4c,
-	0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x41, 0x54, 0x10, 0x01, 0x12, 0x0a,
-	0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x52, 0x41,
-	0x57, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54, 0x59, 0x10,
-	0x04, 0x22, 0x5c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
-	0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e,
-	0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10,
-	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x03, 0x12, 0x0e,
-	0x0a, 0x0a, 0x50, 0x52, 0x45, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0f,
-	0x0a, 0x0b, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x22,
-	0x3f, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
-	0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x04,
-	0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78,
-	0x2f, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f,
-	0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x1e, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x08,
+	0x0a, 0x04, 0x49, 0x50, 0x56, 0x34, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36,
+	0x10, 0x01, 0x22, 0x3f, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x46,
+	0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x41, 0x54, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x52, 0x41, 0x57, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x43, 0x55, 0x52, 0x49, 0x54,
+	0x59, 0x10, 0x04, 0x22, 0x5c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55,
+	0x54, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x10, 0x03,
+	0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x45, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04,
+	0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x4f, 0x53, 0x54, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x05, 0x22, 0x3f, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e,
+	0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x51,
+	0x55, 0x45, 0x55, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e,
+	0x10, 0x04, 0x22, 0xc6, 0x08, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x07, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x07,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a,
+	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x50, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69,
+	0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x34, 0x0a, 0x04, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52,
+	0x04, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x3a, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x09, 0x74, 0x6f,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x06, 0x74, 0x6f, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x5f, 0x6d,
+	0x61, 0x72, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x52, 0x07, 0x73, 0x65, 0x74,
+	0x4d, 0x61, 0x72, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x75, 0x6d, 0x70, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x75, 0x6d, 0x70, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x1a, 0x45, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x1d, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09,
+	0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x19, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06,
+	0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x1a, 0x30, 0x0a, 0x04, 0x4d, 0x61,
+	0x72, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x45, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x44,
+	0x50, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x43, 0x4d, 0x50, 0x10, 0x03, 0x12, 0x0a, 0x0a,
+	0x06, 0x49, 0x43, 0x4d, 0x50, 0x56, 0x36, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x43, 0x54,
+	0x50, 0x10, 0x05, 0x22, 0x4e, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x53, 0x54,
+	0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45,
+	0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x45,
+	0x44, 0x10, 0x04, 0x22, 0x46, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0a, 0x0a,
+	0x06, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52, 0x4f,
+	0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4e, 0x41, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a,
+	0x04, 0x53, 0x4e, 0x41, 0x54, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52, 0x4b, 0x10,
+	0x04, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x55, 0x4d, 0x50, 0x10, 0x05, 0x42, 0x46, 0x5a, 0x44, 0x67,
+	0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x69, 0x70, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x70, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -425,27 +979,42 @@ func file_ligato_linux_iptables_iptables_proto_rawDescGZIP() []byte {
 	return file_ligato_linux_iptables_iptables_proto_rawDescData
 }
 
-var file_ligato_linux_iptables_iptables_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_ligato_linux_iptables_iptables_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ligato_linux_iptables_iptables_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_ligato_linux_iptables_iptables_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_ligato_linux_iptables_iptables_proto_goTypes = []interface{}{
 	(RuleChain_Protocol)(0),        // 0: ligato.linux.iptables.RuleChain.Protocol
 	(RuleChain_Table)(0),           // 1: ligato.linux.iptables.RuleChain.Table
 	(RuleChain_ChainType)(0),       // 2: ligato.linux.iptables.RuleChain.ChainType
 	(RuleChain_Policy)(0),          // 3: ligato.linux.iptables.RuleChain.Policy
-	(*RuleChain)(nil),              // 4: ligato.linux.iptables.RuleChain
-	(*namespace.NetNamespace)(nil), // 5: ligato.linux.namespace.NetNamespace
+	(Rule_Protocol)(0),             // 4: ligato.linux.iptables.Rule.Protocol
+	(Rule_ConnState)(0),            // 5: ligato.linux.iptables.Rule.ConnState
+	(Rule_Target)(0),               // 6: ligato.linux.iptables.Rule.Target
+	(*RuleChain)(nil),              // 7: ligato.linux.iptables.RuleChain
+	(*Rule)(nil),                   // 8: ligato.linux.iptables.Rule
+	(*Rule_PortRange)(nil),         // 9: ligato.linux.iptables.Rule.PortRange
+	(*Rule_Mark)(nil),              // 10: ligato.linux.iptables.Rule.Mark
+	(*namespace.NetNamespace)(nil), // 11: ligato.linux.namespace.NetNamespace
 }
 var file_ligato_linux_iptables_iptables_proto_depIdxs = []int32{
-	5, // 0: ligato.linux.iptables.RuleChain.namespace:type_name -> ligato.linux.namespace.NetNamespace
-	0, // 1: ligato.linux.iptables.RuleChain.protocol:type_name -> ligato.linux.iptables.RuleChain.Protocol
-	1, // 2: ligato.linux.iptables.RuleChain.table:type_name -> ligato.linux.iptables.RuleChain.Table
-	2, // 3: ligato.linux.iptables.RuleChain.chain_type:type_name -> ligato.linux.iptables.RuleChain.ChainType
-	3, // 4: ligato.linux.iptables.RuleChain.default_policy:type_name -> ligato.linux.iptables.RuleChain.Policy
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	11, // 0: ligato.linux.iptables.RuleChain.namespace:type_name -> ligato.linux.namespace.NetNamespace
+	0,  // 1: ligato.linux.iptables.RuleChain.protocol:type_name -> ligato.linux.iptables.RuleChain.Protocol
+	1,  // 2: ligato.linux.iptables.RuleChain.table:type_name -> ligato.linux.iptables.RuleChain.Table
+	2,  // 3: ligato.linux.iptables.RuleChain.chain_type:type_name -> ligato.linux.iptables.RuleChain.ChainType
+	3,  // 4: ligato.linux.iptables.RuleChain.default_policy:type_name -> ligato.linux.iptables.RuleChain.Policy
+	8,  // 5: ligato.linux.iptables.RuleChain.structured_rules:type_name -> ligato.linux.iptables.Rule
+	4,  // 6: ligato.linux.iptables.Rule.protocol:type_name -> ligato.linux.iptables.Rule.Protocol
+	9,  // 7: ligato.linux.iptables.Rule.source_port:type_name -> ligato.linux.iptables.Rule.PortRange
+	9,  // 8: ligato.linux.iptables.Rule.destination_port:type_name -> ligato.linux.iptables.Rule.PortRange
+	5,  // 9: ligato.linux.iptables.Rule.conn_states:type_name -> ligato.linux.iptables.Rule.ConnState
+	10, // 10: ligato.linux.iptables.Rule.mark:type_name -> ligato.linux.iptables.Rule.Mark
+	6,  // 11: ligato.linux.iptables.Rule.target:type_name -> ligato.linux.iptables.Rule.Target
+	9,  // 12: ligato.linux.iptables.Rule.to_port:type_name -> ligato.linux.iptables.Rule.PortRange
+	10, // 13: ligato.linux.iptables.Rule.set_mark:type_name -> ligato.linux.iptables.Rule.Mark
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_ligato_linux_iptables_iptables_proto_init() }
@@ -466,14 +1035,50 @@ func file_ligato_linux_iptables_iptables_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_linux_iptables_iptables_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_iptables_iptables_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule_PortRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_linux_iptables_iptables_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rule_Mark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_linux_iptables_iptables_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   1,
+			NumEnums:      7,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -15,7 +15,10 @@
 package linux_iptables
 
 import (
+	"strings"
+
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	linux_namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 )
 
 // ModuleName is the module name used for models.
@@ -35,3 +38,28 @@ func RuleChainKey(name string) string {
 		Name: name,
 	})
 }
+
+const (
+	/* Custom chain (derived) */
+
+	// CustomChainKeyPrefix is a prefix for keys derived from rule chains of the CUSTOM type.
+	CustomChainKeyPrefix = "linux/iptables/custom-chain/"
+
+	// customChainKeyTemplate is a template for key derived from rule chain of the CUSTOM type.
+	customChainKeyTemplate = CustomChainKeyPrefix + "{protocol}/{table}/{chain}"
+)
+
+/* Custom chain (derived) */
+
+// CustomChainKey returns a derived key used to represent iptables chain created
+// for a rule chain of the CUSTOM type in the given namespace.
+func CustomChainKey(ns *linux_namespace.NetNamespace, protocol RuleChain_Protocol, table RuleChain_Table,
+	chainName string) string {
+	key := strings.Replace(customChainKeyTemplate, "{protocol}", protocol.String(), 1)
+	key = strings.Replace(key, "{table}", table.String(), 1)
+	key = strings.Replace(key, "{chain}", chainName, 1)
+	if ns != nil && ns.Type != linux_namespace.NetNamespace_UNDEFINED {
+		key += "/ns/" + ns.Type.String() + "/" + ns.Reference
+	}
+	return key
+}
@@ -18,6 +18,7 @@ import (
 	"strings"
 
 	"go.ligato.io/vpp-agent/v3/pkg/models"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 )
 
 // ModuleName is the module name used for models.
@@ -36,7 +37,16 @@ var (
 		Type:    "route",
 	}, models.WithNameTemplate(
 		`{{with ipnet .DstNetwork}}{{printf "%s/%d" .IP .MaskSize}}`+
-			`{{else}}{{.DstNetwork}}{{end}}/{{.OutgoingInterface}}`,
+			`{{else}}{{.DstNetwork}}{{end}}/{{with .OutgoingInterface}}{{.}}{{else}}{{.Type}}{{end}}`+
+			`{{if .Table}}/table/{{.Table}}{{end}}`,
+	))
+
+	ModelRule = models.Register(&Rule{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "rule",
+	}, models.WithNameTemplate(
+		`{{.Family}}/{{.Priority}}{{with .Namespace}}/{{.Type}}/{{.Reference}}{{end}}`,
 	))
 )
 
@@ -56,6 +66,26 @@ func RouteKey(dstNetwork, outgoingInterface string) string {
 	})
 }
 
+// RouteInTableKey returns the key used in ETCD to store configuration of a particular
+// Linux route inside the given routing table.
+func RouteInTableKey(dstNetwork, outgoingInterface string, table uint32) string {
+	return models.Key(&Route{
+		DstNetwork:        dstNetwork,
+		OutgoingInterface: outgoingInterface,
+		Table:             table,
+	})
+}
+
+// RuleKey returns the key used in ETCD to store configuration of a particular
+// Linux policy routing rule.
+func RuleKey(family Rule_AddressFamily, priority uint32, ns *namespace.NetNamespace) string {
+	return models.Key(&Rule{
+		Family:    family,
+		Priority:  priority,
+		Namespace: ns,
+	})
+}
+
 const (
 	/* Link-local route (derived) */
 
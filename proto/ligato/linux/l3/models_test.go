@@ -16,6 +16,9 @@ package linux_l3
 
 import (
 	"testing"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 )
 
 func TestRouteKey(t *testing.T) {
@@ -50,6 +53,126 @@ func TestRouteKey(t *testing.T) {
 	}
 }
 
+func TestRouteInTableKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		outIface    string
+		dstNetwork  string
+		table       uint32
+		expectedKey string
+	}{
+		{
+			name:        "main table",
+			outIface:    "memif1",
+			dstNetwork:  "192.168.1.0/24",
+			expectedKey: "config/linux/l3/v2/route/192.168.1.0/24/memif1",
+		},
+		{
+			name:        "non-default table",
+			outIface:    "memif1",
+			dstNetwork:  "0.0.0.0/0",
+			table:       100,
+			expectedKey: "config/linux/l3/v2/route/0.0.0.0/0/memif1/table/100",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := RouteInTableKey(test.dstNetwork, test.outIface, test.table)
+			if key != test.expectedKey {
+				t.Errorf("failed for: outIface=%s dstNet=%s table=%d\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.outIface, test.dstNetwork, test.table, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestDropRouteKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		route       *Route
+		expectedKey string
+	}{
+		{
+			name: "blackhole route in the default namespace",
+			route: &Route{
+				DstNetwork: "10.0.0.0/8",
+				Type:       Route_BLACKHOLE,
+			},
+			expectedKey: "config/linux/l3/v2/route/10.0.0.0/8/BLACKHOLE",
+		},
+		{
+			name: "unreachable route in non-default table",
+			route: &Route{
+				DstNetwork: "2001:db8::/32",
+				Type:       Route_UNREACHABLE,
+				Table:      100,
+			},
+			expectedKey: "config/linux/l3/v2/route/2001:db8::/32/UNREACHABLE/table/100",
+		},
+		{
+			name: "prohibit route with interface selecting the namespace",
+			route: &Route{
+				DstNetwork:        "192.168.1.0/24",
+				OutgoingInterface: "veth1",
+				Type:              Route_PROHIBIT,
+			},
+			expectedKey: "config/linux/l3/v2/route/192.168.1.0/24/veth1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(test.route)
+			if key != test.expectedKey {
+				t.Errorf("failed for: route=%v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.route, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestRuleKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		family      Rule_AddressFamily
+		priority    uint32
+		namespace   *namespace.NetNamespace
+		expectedKey string
+	}{
+		{
+			name:        "default namespace",
+			priority:    100,
+			expectedKey: "config/linux/l3/v2/rule/IPV4/100",
+		},
+		{
+			name:        "IPv6 rule with the same priority",
+			family:      Rule_IPV6,
+			priority:    100,
+			expectedKey: "config/linux/l3/v2/rule/IPV6/100",
+		},
+		{
+			name:     "microservice namespace",
+			priority: 200,
+			namespace: &namespace.NetNamespace{
+				Type:      namespace.NetNamespace_MICROSERVICE,
+				Reference: "microservice1",
+			},
+			expectedKey: "config/linux/l3/v2/rule/IPV4/200/MICROSERVICE/microservice1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := RuleKey(test.family, test.priority, test.namespace)
+			if key != test.expectedKey {
+				t.Errorf("failed for: family=%v priority=%d namespace=%v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.family, test.priority, test.namespace, test.expectedKey, key)
+			}
+		})
+	}
+}
+
 func TestStaticLinkLocalRouteKey(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -81,12 +81,123 @@ func (Route_Scope) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_linux_l3_route_proto_rawDescGZIP(), []int{0, 0}
 }
 
+type Route_RouteType int32
+
+const (
+	// Ordinary route forwarding packets via gateway(s) or directly via interface.
+	Route_UNICAST Route_RouteType = 0
+	// Route to a local address (destination is the host itself).
+	Route_LOCAL Route_RouteType = 1
+	// Packets are silently dropped.
+	Route_BLACKHOLE Route_RouteType = 2
+	// Packets are dropped and "host unreachable" is reported.
+	Route_UNREACHABLE Route_RouteType = 3
+	// Packets are dropped and "communication administratively prohibited" is reported.
+	Route_PROHIBIT Route_RouteType = 4
+)
+
+// Enum value maps for Route_RouteType.
+var (
+	Route_RouteType_name = map[int32]string{
+		0: "UNICAST",
+		1: "LOCAL",
+		2: "BLACKHOLE",
+		3: "UNREACHABLE",
+		4: "PROHIBIT",
+	}
+	Route_RouteType_value = map[string]int32{
+		"UNICAST":     0,
+		"LOCAL":       1,
+		"BLACKHOLE":   2,
+		"UNREACHABLE": 3,
+		"PROHIBIT":    4,
+	}
+)
+
+func (x Route_RouteType) Enum() *Route_RouteType {
+	p := new(Route_RouteType)
+	*p = x
+	return p
+}
+
+func (x Route_RouteType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Route_RouteType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_l3_route_proto_enumTypes[1].Descriptor()
+}
+
+func (Route_RouteType) Type() protoreflect.EnumType {
+	return &file_ligato_linux_l3_route_proto_enumTypes[1]
+}
+
+func (x Route_RouteType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Route_RouteType.Descriptor instead.
+func (Route_RouteType) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_l3_route_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type Route_IPv6Preference int32
+
+const (
+	Route_MEDIUM Route_IPv6Preference = 0
+	Route_LOW    Route_IPv6Preference = 1
+	Route_HIGH   Route_IPv6Preference = 2
+)
+
+// Enum value maps for Route_IPv6Preference.
+var (
+	Route_IPv6Preference_name = map[int32]string{
+		0: "MEDIUM",
+		1: "LOW",
+		2: "HIGH",
+	}
+	Route_IPv6Preference_value = map[string]int32{
+		"MEDIUM": 0,
+		"LOW":    1,
+		"HIGH":   2,
+	}
+)
+
+func (x Route_IPv6Preference) Enum() *Route_IPv6Preference {
+	p := new(Route_IPv6Preference)
+	*p = x
+	return p
+}
+
+func (x Route_IPv6Preference) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Route_IPv6Preference) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_linux_l3_route_proto_enumTypes[2].Descriptor()
+}
+
+func (Route_IPv6Preference) Type() protoreflect.EnumType {
+	return &file_ligato_linux_l3_route_proto_enumTypes[2]
+}
+
+func (x Route_IPv6Preference) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Route_IPv6Preference.Descriptor instead.
+func (Route_IPv6Preference) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_linux_l3_route_proto_rawDescGZIP(), []int{0, 2}
+}
+
 type Route struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Outgoing interface logical name (mandatory).
+	// Outgoing interface logical name (mandatory for UNICAST and LOCAL routes).
+	// For BLACKHOLE, UNREACHABLE and PROHIBIT routes the interface is optional
+	// and only selects the network namespace (and VRF) where the route is configured.
 	OutgoingInterface string `protobuf:"bytes,1,opt,name=outgoing_interface,json=outgoingInterface,proto3" json:"outgoing_interface,omitempty"`
 	// The scope of the area where the link is valid.
 	Scope Route_Scope `protobuf:"varint,2,opt,name=scope,proto3,enum=ligato.linux.l3.Route_Scope" json:"scope,omitempty"`
@@ -100,6 +211,30 @@ type Route struct {
 	GwAddr string `protobuf:"bytes,4,opt,name=gw_addr,json=gwAddr,proto3" json:"gw_addr,omitempty"`
 	// routing metric (weight)
 	Metric uint32 `protobuf:"varint,5,opt,name=metric,proto3" json:"metric,omitempty"`
+	// Routing table ID (optional).
+	// If undefined (0), the route is added into the routing table of the VRF
+	// the outgoing interface belongs to, or into the main routing table if the
+	// interface is not inside a VRF.
+	// Use together with policy routing rules (see rule.proto) for source-based routing.
+	// LOCAL routes are added into the local routing table if undefined.
+	Table uint32 `protobuf:"varint,6,opt,name=table,proto3" json:"table,omitempty"`
+	// Type of the route.
+	Type Route_RouteType `protobuf:"varint,7,opt,name=type,proto3,enum=ligato.linux.l3.Route_RouteType" json:"type,omitempty"`
+	// List of next hops of a multipath (ECMP) route (optional).
+	// Cannot be combined with gw_addr.
+	NextHops []*Route_NextHop `protobuf:"bytes,8,rep,name=next_hops,json=nextHops,proto3" json:"next_hops,omitempty"`
+	// MTU to use along the path to the destination (0 = undefined).
+	Mtu uint32 `protobuf:"varint,9,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// Maximal TCP segment size to advertise to the destination (0 = undefined).
+	Advmss uint32 `protobuf:"varint,10,opt,name=advmss,proto3" json:"advmss,omitempty"`
+	// Preferred source address used when sending packets to the destination
+	// (without mask, optional).
+	SrcAddr string `protobuf:"bytes,11,opt,name=src_addr,json=srcAddr,proto3" json:"src_addr,omitempty"`
+	// Pretend that the gateway is directly attached to the link even if it does
+	// not match any interface prefix.
+	Onlink bool `protobuf:"varint,12,opt,name=onlink,proto3" json:"onlink,omitempty"`
+	// Preference of the route (RFC 4191), applicable only to IPv6 routes.
+	Ipv6Preference Route_IPv6Preference `protobuf:"varint,13,opt,name=ipv6_preference,json=ipv6Preference,proto3,enum=ligato.linux.l3.Route_IPv6Preference" json:"ipv6_preference,omitempty"`
 }
 
 func (x *Route) Reset() {
@@ -169,6 +304,144 @@ func (x *Route) GetMetric() uint32 {
 	return 0
 }
 
+func (x *Route) GetTable() uint32 {
+	if x != nil {
+		return x.Table
+	}
+	return 0
+}
+
+func (x *Route) GetType() Route_RouteType {
+	if x != nil {
+		return x.Type
+	}
+	return Route_UNICAST
+}
+
+func (x *Route) GetNextHops() []*Route_NextHop {
+	if x != nil {
+		return x.NextHops
+	}
+	return nil
+}
+
+func (x *Route) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *Route) GetAdvmss() uint32 {
+	if x != nil {
+		return x.Advmss
+	}
+	return 0
+}
+
+func (x *Route) GetSrcAddr() string {
+	if x != nil {
+		return x.SrcAddr
+	}
+	return ""
+}
+
+func (x *Route) GetOnlink() bool {
+	if x != nil {
+		return x.Onlink
+	}
+	return false
+}
+
+func (x *Route) GetIpv6Preference() Route_IPv6Preference {
+	if x != nil {
+		return x.Ipv6Preference
+	}
+	return Route_MEDIUM
+}
+
+type Route_NextHop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Gateway IP address (without mask, optional).
+	// Address can be also allocated via netalloc plugin and referenced here,
+	// see: api/models/netalloc/netalloc.proto
+	GwAddr string `protobuf:"bytes,1,opt,name=gw_addr,json=gwAddr,proto3" json:"gw_addr,omitempty"`
+	// Outgoing interface logical name (optional).
+	// If undefined, outgoing interface of the route is used.
+	// For the first next hop it must be either undefined or equal to the outgoing
+	// interface of the route.
+	OutgoingInterface string `protobuf:"bytes,2,opt,name=outgoing_interface,json=outgoingInterface,proto3" json:"outgoing_interface,omitempty"`
+	// Weight of the next hop relative to other next hops (1-256).
+	// Value 0 is treated as 1.
+	Weight uint32 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Pretend that the gateway is directly attached to the link even if it does
+	// not match any interface prefix.
+	Onlink bool `protobuf:"varint,4,opt,name=onlink,proto3" json:"onlink,omitempty"`
+}
+
+func (x *Route_NextHop) Reset() {
+	*x = Route_NextHop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_linux_l3_route_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Route_NextHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route_NextHop) ProtoMessage() {}
+
+func (x *Route_NextHop) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_linux_l3_route_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route_NextHop.ProtoReflect.Descriptor instead.
+func (*Route_NextHop) Descriptor() ([]byte, []int) {
+	return file_ligato_linux_l3_route_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Route_NextHop) GetGwAddr() string {
+	if x != nil {
+		return x.GwAddr
+	}
+	return ""
+}
+
+func (x *Route_NextHop) GetOutgoingInterface() string {
+	if x != nil {
+		return x.OutgoingInterface
+	}
+	return ""
+}
+
+func (x *Route_NextHop) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Route_NextHop) GetOnlink() bool {
+	if x != nil {
+		return x.Onlink
+	}
+	return false
+}
+
 var File_ligato_linux_l3_route_proto protoreflect.FileDescriptor
 
 var file_ligato_linux_l3_route_proto_rawDesc = []byte{
@@ -176,7 +449,7 @@ var file_ligato_linux_l3_route_proto_rawDesc = []byte{
 	0x33, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x6c,
 	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6c, 0x33, 0x1a, 0x18,
 	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x05, 0x52, 0x6f, 0x75,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x06, 0x0a, 0x05, 0x52, 0x6f, 0x75,
 	0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x69,
 	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
 	0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
@@ -189,15 +462,53 @@ var file_ligato_linux_l3_route_proto_rawDesc = []byte{
 	0x07, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
 	0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x06, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a,
 	0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0x40, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0d,
-	0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49, 0x54,
-	0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x03, 0x12, 0x08, 0x0a,
-	0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x6f, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6c, 0x33, 0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78,
-	0x5f, 0x6c, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x4e, 0x65, 0x78,
+	0x74, 0x48, 0x6f, 0x70, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x76, 0x6d, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x61, 0x64, 0x76, 0x6d, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08,
+	0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x12, 0x4e, 0x0a, 0x0f, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x2e, 0x49, 0x50, 0x76, 0x36, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x0e, 0x69, 0x70, 0x76, 0x36, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x1a, 0x92, 0x01, 0x0a, 0x07, 0x4e, 0x65, 0x78, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x1e,
+	0x0a, 0x07, 0x67, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x06, 0x67, 0x77, 0x41, 0x64, 0x64, 0x72, 0x12, 0x2d,
+	0x0a, 0x12, 0x6f, 0x75, 0x74, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x75, 0x74, 0x67,
+	0x6f, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0x82,
+	0x7d, 0x05, 0x12, 0x03, 0x10, 0x80, 0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x40, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53,
+	0x49, 0x54, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x03, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53, 0x54, 0x10, 0x04, 0x22, 0x51, 0x0a, 0x09, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53,
+	0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x42, 0x4c, 0x41, 0x43, 0x4b, 0x48, 0x4f, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x55, 0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x0c,
+	0x0a, 0x08, 0x50, 0x52, 0x4f, 0x48, 0x49, 0x42, 0x49, 0x54, 0x10, 0x04, 0x22, 0x2f, 0x0a, 0x0e,
+	0x49, 0x50, 0x76, 0x36, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0a,
+	0x0a, 0x06, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f,
+	0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02, 0x42, 0x3a, 0x5a,
+	0x38, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70,
+	0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6c, 0x33,
+	0x3b, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x6c, 0x33, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -212,19 +523,25 @@ func file_ligato_linux_l3_route_proto_rawDescGZIP() []byte {
 	return file_ligato_linux_l3_route_proto_rawDescData
 }
 
-var file_ligato_linux_l3_route_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_ligato_linux_l3_route_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ligato_linux_l3_route_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ligato_linux_l3_route_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_ligato_linux_l3_route_proto_goTypes = []interface{}{
-	(Route_Scope)(0), // 0: ligato.linux.l3.Route.Scope
-	(*Route)(nil),    // 1: ligato.linux.l3.Route
+	(Route_Scope)(0),          // 0: ligato.linux.l3.Route.Scope
+	(Route_RouteType)(0),      // 1: ligato.linux.l3.Route.RouteType
+	(Route_IPv6Preference)(0), // 2: ligato.linux.l3.Route.IPv6Preference
+	(*Route)(nil),             // 3: ligato.linux.l3.Route
+	(*Route_NextHop)(nil),     // 4: ligato.linux.l3.Route.NextHop
 }
 var file_ligato_linux_l3_route_proto_depIdxs = []int32{
 	0, // 0: ligato.linux.l3.Route.scope:type_name -> ligato.linux.l3.Route.Scope
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: ligato.linux.l3.Route.type:type_name -> ligato.linux.l3.Route.RouteType
+	4, // 2: ligato.linux.l3.Route.next_hops:type_name -> ligato.linux.l3.Route.NextHop
+	2, // 3: ligato.linux.l3.Route.ipv6_preference:type_name -> ligato.linux.l3.Route.IPv6Preference
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_ligato_linux_l3_route_proto_init() }
@@ -245,14 +562,26 @@ func file_ligato_linux_l3_route_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_linux_l3_route_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Route_NextHop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_linux_l3_route_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      3,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -26,6 +26,7 @@ type (
 
 	// L3
 	Route    = linux_l3.Route
+	Rule     = linux_l3.Rule
 	ARPEntry = linux_l3.ARPEntry
 
 	// IP tables
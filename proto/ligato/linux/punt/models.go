@@ -0,0 +1,51 @@
+// Copyright (c) 2021 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_punt
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "linux.punt"
+
+var (
+	ModelProxy = models.Register(&Proxy{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "proxy",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelProxyState = models.Register(&ProxyState{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v2",
+		Type:    "proxy-state",
+		Class:   "state",
+	}, models.WithNameTemplate("{{.Name}}"))
+)
+
+// ProxyKey returns the key used in KV database to store configuration of a particular punt proxy.
+func ProxyKey(name string) string {
+	return models.Key(&Proxy{
+		Name: name,
+	})
+}
+
+// ProxyStateKey returns the key under which the run-time state of a particular punt proxy is exposed.
+func ProxyStateKey(name string) string {
+	return models.Key(&ProxyState{
+		Name: name,
+	})
+}
@@ -0,0 +1,45 @@
+//  Copyright (c) 2021 Cisco and/or its affiliates.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at:
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package linux_punt
+
+import (
+	"testing"
+)
+
+func TestProxyKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		expectedKey string
+	}{
+		{
+			name:        "proxy config",
+			key:         ProxyKey("dhcp-relay"),
+			expectedKey: "config/linux/punt/v2/proxy/dhcp-relay",
+		},
+		{
+			name:        "proxy state",
+			key:         ProxyStateKey("dhcp-relay"),
+			expectedKey: "state/linux/punt/v2/proxy-state/dhcp-relay",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.key != test.expectedKey {
+				t.Errorf("expected key:\n\t%q\ngot key:\n\t%q", test.expectedKey, test.key)
+			}
+		})
+	}
+}
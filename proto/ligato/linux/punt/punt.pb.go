@@ -9,6 +9,7 @@ package linux_punt
 import (
 	proto "github.com/golang/protobuf/proto"
 	_ "go.ligato.io/vpp-agent/v3/proto/ligato"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -140,7 +141,9 @@ type Proxy struct {
 	// Types that are assignable to Tx:
 	//	*Proxy_TxPort
 	//	*Proxy_TxSocket
-	Tx isProxy_Tx `protobuf_oneof:"tx"`
+	Tx        isProxy_Tx              `protobuf_oneof:"tx"`
+	Name      string                  `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"` // logical name of the proxy (mandatory)
+	Namespace *namespace.NetNamespace `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
 }
 
 func (x *Proxy) Reset() {
@@ -217,6 +220,20 @@ func (x *Proxy) GetTxSocket() *SocketBased {
 	return nil
 }
 
+func (x *Proxy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Proxy) GetNamespace() *namespace.NetNamespace {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
 type isProxy_Rx interface {
 	isProxy_Rx()
 }
@@ -249,7 +266,10 @@ func (*Proxy_TxPort) isProxy_Tx() {}
 
 func (*Proxy_TxSocket) isProxy_Tx() {}
 
-// Define network socket type
+// Define network socket type. For rx the proxy listens on the given port (on all addresses of the L3 protocol),
+// for tx the packets are sent to the given port on the loopback address of the namespace.
+// Unix domain sockets are datagram-based (as used by VPP punt sockets) unless paired with TCP port,
+// in which case a stream socket is used.
 type PortBased struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -368,23 +388,31 @@ var file_ligato_linux_punt_punt_proto_rawDesc = []byte{
 	0x75, 0x6e, 0x74, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11,
 	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x70, 0x75, 0x6e,
 	0x74, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x83, 0x02, 0x0a, 0x05,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x78, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xdb, 0x02, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x37, 0x0a,
+	0x07, 0x72, 0x78, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x70, 0x75,
+	0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06,
+	0x72, 0x78, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x73, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x78, 0x53,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
 	0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x42,
-	0x61, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x72, 0x78, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3d,
-	0x0a, 0x09, 0x72, 0x78, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x61, 0x73, 0x65, 0x64, 0x48, 0x01, 0x52, 0x06, 0x74, 0x78, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3d,
+	0x0a, 0x09, 0x74, 0x78, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78,
 	0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65,
-	0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x78, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x37, 0x0a,
-	0x07, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x70, 0x75,
-	0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x48, 0x01, 0x52, 0x06,
-	0x74, 0x78, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x73, 0x6f, 0x63,
-	0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x53, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x48, 0x01, 0x52, 0x08, 0x74, 0x78, 0x53,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0x0a, 0x02, 0x72, 0x78, 0x42, 0x04, 0x0a, 0x02, 0x74,
+	0x64, 0x48, 0x01, 0x52, 0x08, 0x74, 0x78, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x42, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x04, 0x0a, 0x02, 0x72, 0x78, 0x42, 0x04, 0x0a, 0x02, 0x74,
 	0x78, 0x22, 0xad, 0x02, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x61, 0x73, 0x65, 0x64, 0x12,
 	0x48, 0x0a, 0x0b, 0x6c, 0x34, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x6c, 0x69,
@@ -428,24 +456,26 @@ func file_ligato_linux_punt_punt_proto_rawDescGZIP() []byte {
 var file_ligato_linux_punt_punt_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_ligato_linux_punt_punt_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_ligato_linux_punt_punt_proto_goTypes = []interface{}{
-	(PortBased_L4Protocol)(0), // 0: ligato.linux.punt.PortBased.L4Protocol
-	(PortBased_L3Protocol)(0), // 1: ligato.linux.punt.PortBased.L3Protocol
-	(*Proxy)(nil),             // 2: ligato.linux.punt.Proxy
-	(*PortBased)(nil),         // 3: ligato.linux.punt.PortBased
-	(*SocketBased)(nil),       // 4: ligato.linux.punt.SocketBased
+	(PortBased_L4Protocol)(0),      // 0: ligato.linux.punt.PortBased.L4Protocol
+	(PortBased_L3Protocol)(0),      // 1: ligato.linux.punt.PortBased.L3Protocol
+	(*Proxy)(nil),                  // 2: ligato.linux.punt.Proxy
+	(*PortBased)(nil),              // 3: ligato.linux.punt.PortBased
+	(*SocketBased)(nil),            // 4: ligato.linux.punt.SocketBased
+	(*namespace.NetNamespace)(nil), // 5: ligato.linux.namespace.NetNamespace
 }
 var file_ligato_linux_punt_punt_proto_depIdxs = []int32{
 	3, // 0: ligato.linux.punt.Proxy.rx_port:type_name -> ligato.linux.punt.PortBased
 	4, // 1: ligato.linux.punt.Proxy.rx_socket:type_name -> ligato.linux.punt.SocketBased
 	3, // 2: ligato.linux.punt.Proxy.tx_port:type_name -> ligato.linux.punt.PortBased
 	4, // 3: ligato.linux.punt.Proxy.tx_socket:type_name -> ligato.linux.punt.SocketBased
-	0, // 4: ligato.linux.punt.PortBased.l4_protocol:type_name -> ligato.linux.punt.PortBased.L4Protocol
-	1, // 5: ligato.linux.punt.PortBased.l3_protocol:type_name -> ligato.linux.punt.PortBased.L3Protocol
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	5, // 4: ligato.linux.punt.Proxy.namespace:type_name -> ligato.linux.namespace.NetNamespace
+	0, // 5: ligato.linux.punt.PortBased.l4_protocol:type_name -> ligato.linux.punt.PortBased.L4Protocol
+	1, // 6: ligato.linux.punt.PortBased.l3_protocol:type_name -> ligato.linux.punt.PortBased.L3Protocol
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_ligato_linux_punt_punt_proto_init() }
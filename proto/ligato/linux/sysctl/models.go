@@ -0,0 +1,52 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_sysctl
+
+import (
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "linux.sysctl"
+
+var (
+	ModelSysctl = models.Register(&Sysctl{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "sysctl",
+	}, models.WithNameTemplate(
+		`{{.Name}}{{with .Interface}}/interface/{{.}}{{else}}`+
+			`{{with .Namespace}}/{{.Type}}/{{.Reference}}{{end}}{{end}}`,
+	))
+)
+
+// SysctlKey returns the key used in KV database to store configuration of a particular
+// global kernel network setting.
+func SysctlKey(name string, ns *namespace.NetNamespace) string {
+	return models.Key(&Sysctl{
+		Name:      name,
+		Namespace: ns,
+	})
+}
+
+// InterfaceSysctlKey returns the key used in KV database to store configuration
+// of a particular per-interface kernel network setting.
+func InterfaceSysctlKey(name, iface string) string {
+	return models.Key(&Sysctl{
+		Name:      name,
+		Interface: iface,
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_sysctl
+
+import (
+	"testing"
+
+	namespace "go.ligato.io/vpp-agent/v3/proto/ligato/linux/namespace"
+)
+
+func TestSysctlKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		sysctl      string
+		iface       string
+		ns          *namespace.NetNamespace
+		expectedKey string
+	}{
+		{
+			name:        "global parameter in the default namespace",
+			sysctl:      "net.ipv4.ip_forward",
+			expectedKey: "config/linux/sysctl/v1/sysctl/net.ipv4.ip_forward",
+		},
+		{
+			name:   "global parameter in microservice",
+			sysctl: "net.ipv4.ip_forward",
+			ns: &namespace.NetNamespace{
+				Type:      namespace.NetNamespace_MICROSERVICE,
+				Reference: "ms1",
+			},
+			expectedKey: "config/linux/sysctl/v1/sysctl/net.ipv4.ip_forward/MICROSERVICE/ms1",
+		},
+		{
+			name:        "per-interface parameter",
+			sysctl:      "net.ipv4.conf.rp_filter",
+			iface:       "veth1",
+			expectedKey: "config/linux/sysctl/v1/sysctl/net.ipv4.conf.rp_filter/interface/veth1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var key string
+			if test.iface != "" {
+				key = InterfaceSysctlKey(test.sysctl, test.iface)
+			} else {
+				key = SysctlKey(test.sysctl, test.ns)
+			}
+			if key != test.expectedKey {
+				t.Errorf("failed for: sysctl=%s iface=%s ns=%v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.sysctl, test.iface, test.ns, test.expectedKey, key)
+			}
+		})
+	}
+}
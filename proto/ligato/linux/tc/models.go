@@ -0,0 +1,102 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_tc
+
+import (
+	"strings"
+
+	"go.ligato.io/vpp-agent/v3/pkg/models"
+)
+
+// ModuleName is the module name used for models.
+const ModuleName = "linux.tc"
+
+var (
+	ModelQdisc = models.Register(&Qdisc{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "qdisc",
+	}, models.WithNameTemplate(
+		`{{.Interface}}/parent/{{with .Parent}}{{.}}{{else}}root{{end}}`,
+	))
+
+	ModelClass = models.Register(&Class{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "class",
+	}, models.WithNameTemplate("{{.Interface}}/classid/{{.ClassId}}"))
+
+	ModelFilter = models.Register(&Filter{}, models.Spec{
+		Module:  ModuleName,
+		Version: "v1",
+		Type:    "filter",
+	}, models.WithNameTemplate("{{.Interface}}/parent/{{.Parent}}/prio/{{.Priority}}"))
+)
+
+const (
+	// RootParent is the parent of the root egress qdisc.
+	RootParent = "root"
+
+	// IngressParent is the parent of the ingress qdisc.
+	IngressParent = "ingress"
+)
+
+// QdiscKey returns the key used in ETCD to store configuration of a particular
+// qdisc of a Linux interface.
+func QdiscKey(iface, parent string) string {
+	return models.Key(&Qdisc{
+		Interface: iface,
+		Parent:    parent,
+	})
+}
+
+// ClassKey returns the key used in ETCD to store configuration of a particular
+// traffic class of a Linux interface.
+func ClassKey(iface, classID string) string {
+	return models.Key(&Class{
+		Interface: iface,
+		ClassId:   classID,
+	})
+}
+
+// FilterKey returns the key used in ETCD to store configuration of a particular
+// tc filter of a Linux interface.
+func FilterKey(iface, parent string, priority uint32) string {
+	return models.Key(&Filter{
+		Interface: iface,
+		Parent:    parent,
+		Priority:  priority,
+	})
+}
+
+const (
+	/* Qdisc handle (derived) */
+
+	// QdiscHandleKeyPrefix is a prefix for keys derived from qdiscs with a handle.
+	QdiscHandleKeyPrefix = "linux/tc/qdisc-handle/"
+
+	// qdiscHandleKeyTemplate is a template for key derived from qdisc with a handle.
+	qdiscHandleKeyTemplate = QdiscHandleKeyPrefix + "{iface}/handle/{handle}"
+)
+
+/* Qdisc handle (derived) */
+
+// QdiscHandleKey returns a derived key used to represent a qdisc with the given
+// handle attached to a Linux interface. Classes and filters refer to qdiscs by handles.
+func QdiscHandleKey(iface, handle string) string {
+	key := strings.Replace(qdiscHandleKeyTemplate, "{iface}", iface, 1)
+	key = strings.Replace(key, "{handle}", handle, 1)
+	return key
+}
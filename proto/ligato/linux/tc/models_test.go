@@ -0,0 +1,91 @@
+// Copyright (c) 2020 Cisco and/or its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at:
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linux_tc
+
+import (
+	"testing"
+)
+
+func TestQdiscKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		parent      string
+		expectedKey string
+	}{
+		{
+			name:        "root qdisc",
+			iface:       "veth1",
+			expectedKey: "config/linux/tc/v1/qdisc/veth1/parent/root",
+		},
+		{
+			name:        "ingress qdisc",
+			iface:       "veth1",
+			parent:      IngressParent,
+			expectedKey: "config/linux/tc/v1/qdisc/veth1/parent/ingress",
+		},
+		{
+			name:        "child qdisc of a class",
+			iface:       "tap1",
+			parent:      "1:a",
+			expectedKey: "config/linux/tc/v1/qdisc/tap1/parent/1:a",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := QdiscKey(test.iface, test.parent)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s parent=%s\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.parent, test.expectedKey, key)
+			}
+		})
+	}
+}
+
+func TestFilterKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		iface       string
+		parent      string
+		priority    uint32
+		expectedKey string
+	}{
+		{
+			name:        "filter of HTB qdisc",
+			iface:       "veth1",
+			parent:      "1:",
+			priority:    10,
+			expectedKey: "config/linux/tc/v1/filter/veth1/parent/1:/prio/10",
+		},
+		{
+			name:        "ingress filter",
+			iface:       "veth1",
+			parent:      IngressParent,
+			priority:    1,
+			expectedKey: "config/linux/tc/v1/filter/veth1/parent/ingress/prio/1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := FilterKey(test.iface, test.parent, test.priority)
+			if key != test.expectedKey {
+				t.Errorf("failed for: iface=%s parent=%s priority=%d\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.iface, test.parent, test.priority, test.expectedKey, key)
+			}
+		})
+	}
+}
@@ -48,6 +48,11 @@ const (
 	Interface_IPIP_TUNNEL       Interface_Type = 13
 	Interface_WIREGUARD_TUNNEL  Interface_Type = 14
 	Interface_RDMA              Interface_Type = 15
+	Interface_VHOST_USER        Interface_Type = 16
+	Interface_VIRTIO_PCI        Interface_Type = 17
+	Interface_AF_XDP            Interface_Type = 18
+	Interface_GENEVE_TUNNEL     Interface_Type = 19
+	Interface_VXLAN_GBP_TUNNEL  Interface_Type = 20
 )
 
 // Enum value maps for Interface_Type.
@@ -69,6 +74,11 @@ var (
 		13: "IPIP_TUNNEL",
 		14: "WIREGUARD_TUNNEL",
 		15: "RDMA",
+		16: "VHOST_USER",
+		17: "VIRTIO_PCI",
+		18: "AF_XDP",
+		19: "GENEVE_TUNNEL",
+		20: "VXLAN_GBP_TUNNEL",
 	}
 	Interface_Type_value = map[string]int32{
 		"UNDEFINED_TYPE":    0,
@@ -87,6 +97,11 @@ var (
 		"IPIP_TUNNEL":       13,
 		"WIREGUARD_TUNNEL":  14,
 		"RDMA":              15,
+		"VHOST_USER":        16,
+		"VIRTIO_PCI":        17,
+		"AF_XDP":            18,
+		"GENEVE_TUNNEL":     19,
+		"VXLAN_GBP_TUNNEL":  20,
 	}
 )
 
@@ -675,6 +690,57 @@ func (RDMALink_Mode) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{13, 0}
 }
 
+type AfXdpLink_Mode int32
+
+const (
+	AfXdpLink_AUTO AfXdpLink_Mode = 0
+	// Packets are copied between the kernel and the user-space.
+	AfXdpLink_COPY AfXdpLink_Mode = 1
+	// Zero-copy mode requires support from the network device driver.
+	AfXdpLink_ZERO_COPY AfXdpLink_Mode = 2
+)
+
+// Enum value maps for AfXdpLink_Mode.
+var (
+	AfXdpLink_Mode_name = map[int32]string{
+		0: "AUTO",
+		1: "COPY",
+		2: "ZERO_COPY",
+	}
+	AfXdpLink_Mode_value = map[string]int32{
+		"AUTO":      0,
+		"COPY":      1,
+		"ZERO_COPY": 2,
+	}
+)
+
+func (x AfXdpLink_Mode) Enum() *AfXdpLink_Mode {
+	p := new(AfXdpLink_Mode)
+	*p = x
+	return p
+}
+
+func (x AfXdpLink_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AfXdpLink_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_interfaces_interface_proto_enumTypes[11].Descriptor()
+}
+
+func (AfXdpLink_Mode) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_interfaces_interface_proto_enumTypes[11]
+}
+
+func (x AfXdpLink_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AfXdpLink_Mode.Descriptor instead.
+func (AfXdpLink_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{16, 0}
+}
+
 // Interface defines a VPP interface.
 type Interface struct {
 	state         protoimpl.MessageState
@@ -727,6 +793,11 @@ type Interface struct {
 	//	*Interface_Ipip
 	//	*Interface_Wireguard
 	//	*Interface_Rdma
+	//	*Interface_VhostUser
+	//	*Interface_VirtioPci
+	//	*Interface_AfXdp
+	//	*Interface_Geneve
+	//	*Interface_VxlanGbp
 	Link isInterface_Link `protobuf_oneof:"link"`
 }
 
@@ -945,6 +1016,41 @@ func (x *Interface) GetRdma() *RDMALink {
 	return nil
 }
 
+func (x *Interface) GetVhostUser() *VhostUserLink {
+	if x, ok := x.GetLink().(*Interface_VhostUser); ok {
+		return x.VhostUser
+	}
+	return nil
+}
+
+func (x *Interface) GetVirtioPci() *VirtioPCILink {
+	if x, ok := x.GetLink().(*Interface_VirtioPci); ok {
+		return x.VirtioPci
+	}
+	return nil
+}
+
+func (x *Interface) GetAfXdp() *AfXdpLink {
+	if x, ok := x.GetLink().(*Interface_AfXdp); ok {
+		return x.AfXdp
+	}
+	return nil
+}
+
+func (x *Interface) GetGeneve() *GeneveLink {
+	if x, ok := x.GetLink().(*Interface_Geneve); ok {
+		return x.Geneve
+	}
+	return nil
+}
+
+func (x *Interface) GetVxlanGbp() *VxlanGbpLink {
+	if x, ok := x.GetLink().(*Interface_VxlanGbp); ok {
+		return x.VxlanGbp
+	}
+	return nil
+}
+
 type isInterface_Link interface {
 	isInterface_Link()
 }
@@ -1002,6 +1108,26 @@ type Interface_Rdma struct {
 	Rdma *RDMALink `protobuf:"bytes,112,opt,name=rdma,proto3,oneof"`
 }
 
+type Interface_VhostUser struct {
+	VhostUser *VhostUserLink `protobuf:"bytes,113,opt,name=vhost_user,json=vhostUser,proto3,oneof"`
+}
+
+type Interface_VirtioPci struct {
+	VirtioPci *VirtioPCILink `protobuf:"bytes,114,opt,name=virtio_pci,json=virtioPci,proto3,oneof"`
+}
+
+type Interface_AfXdp struct {
+	AfXdp *AfXdpLink `protobuf:"bytes,115,opt,name=af_xdp,json=afXdp,proto3,oneof"`
+}
+
+type Interface_Geneve struct {
+	Geneve *GeneveLink `protobuf:"bytes,116,opt,name=geneve,proto3,oneof"`
+}
+
+type Interface_VxlanGbp struct {
+	VxlanGbp *VxlanGbpLink `protobuf:"bytes,117,opt,name=vxlan_gbp,json=vxlanGbp,proto3,oneof"`
+}
+
 func (*Interface_Sub) isInterface_Link() {}
 
 func (*Interface_Memif) isInterface_Link() {}
@@ -1028,6 +1154,16 @@ func (*Interface_Wireguard) isInterface_Link() {}
 
 func (*Interface_Rdma) isInterface_Link() {}
 
+func (*Interface_VhostUser) isInterface_Link() {}
+
+func (*Interface_VirtioPci) isInterface_Link() {}
+
+func (*Interface_AfXdp) isInterface_Link() {}
+
+func (*Interface_Geneve) isInterface_Link() {}
+
+func (*Interface_VxlanGbp) isInterface_Link() {}
+
 // SubInterface defines configuration for interface type: SUB_INTERFACE
 type SubInterface struct {
 	state         protoimpl.MessageState
@@ -2214,6 +2350,493 @@ func (x *RDMALink) GetTxqSize() uint32 {
 	return 0
 }
 
+// VhostUserLink defines configuration for interface type: VHOST_USER
+// Vhost-user interface is used to connect VPP with virtio-net device of a VM (e.g. QEMU).
+type VhostUserLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path to the UNIX socket used to negotiate the vhost-user protocol with the VM.
+	SocketFilename string `protobuf:"bytes,1,opt,name=socket_filename,json=socketFilename,proto3" json:"socket_filename,omitempty"`
+	// IsServer defines whether VPP creates the socket and waits for the VM to connect (server),
+	// or VPP connects to the socket created by the VM (client).
+	IsServer bool `protobuf:"varint,2,opt,name=is_server,json=isServer,proto3" json:"is_server,omitempty"`
+	// Disable mergeable RX buffers (VIRTIO_NET_F_MRG_RXBUF feature).
+	DisableMrgRxbuf bool `protobuf:"varint,3,opt,name=disable_mrg_rxbuf,json=disableMrgRxbuf,proto3" json:"disable_mrg_rxbuf,omitempty"`
+	// Disable indirect descriptors (VIRTIO_F_INDIRECT_DESC feature).
+	DisableIndirectDesc bool `protobuf:"varint,4,opt,name=disable_indirect_desc,json=disableIndirectDesc,proto3" json:"disable_indirect_desc,omitempty"`
+	// Enable GSO (Generic Segmentation Offload) support.
+	EnableGso bool `protobuf:"varint,5,opt,name=enable_gso,json=enableGso,proto3" json:"enable_gso,omitempty"`
+	// Enable packed virtqueue layout (VIRTIO_F_RING_PACKED feature).
+	EnablePacked bool `protobuf:"varint,6,opt,name=enable_packed,json=enablePacked,proto3" json:"enable_packed,omitempty"`
+}
+
+func (x *VhostUserLink) Reset() {
+	*x = VhostUserLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VhostUserLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VhostUserLink) ProtoMessage() {}
+
+func (x *VhostUserLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VhostUserLink.ProtoReflect.Descriptor instead.
+func (*VhostUserLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VhostUserLink) GetSocketFilename() string {
+	if x != nil {
+		return x.SocketFilename
+	}
+	return ""
+}
+
+func (x *VhostUserLink) GetIsServer() bool {
+	if x != nil {
+		return x.IsServer
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetDisableMrgRxbuf() bool {
+	if x != nil {
+		return x.DisableMrgRxbuf
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetDisableIndirectDesc() bool {
+	if x != nil {
+		return x.DisableIndirectDesc
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetEnableGso() bool {
+	if x != nil {
+		return x.EnableGso
+	}
+	return false
+}
+
+func (x *VhostUserLink) GetEnablePacked() bool {
+	if x != nil {
+		return x.EnablePacked
+	}
+	return false
+}
+
+// VirtioPCILink defines configuration for interface type: VIRTIO_PCI
+// Virtio-pci interface is used to attach virtio network device (e.g. device passed to VM
+// running VPP) to VPP by its PCI address.
+type VirtioPCILink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// PCI address of the virtio device in format <domain>:<bus>:<slot>.<function>
+	// with all values in base 16, e.g. 0000:00:10.0
+	PciAddress string `protobuf:"bytes,1,opt,name=pci_address,json=pciAddress,proto3" json:"pci_address,omitempty"`
+	// Features is a bitmask of virtio feature bits offered to the device.
+	// If set to zero, VPP default features are used.
+	Features uint64 `protobuf:"varint,2,opt,name=features,proto3" json:"features,omitempty"`
+	// Enable GSO (Generic Segmentation Offload) support.
+	EnableGso bool `protobuf:"varint,3,opt,name=enable_gso,json=enableGso,proto3" json:"enable_gso,omitempty"`
+	// Enable checksum offload.
+	EnableChecksumOffload bool `protobuf:"varint,4,opt,name=enable_checksum_offload,json=enableChecksumOffload,proto3" json:"enable_checksum_offload,omitempty"`
+	// Enable packed virtqueue layout (supported starting from VPP 21.01).
+	EnablePacked bool `protobuf:"varint,5,opt,name=enable_packed,json=enablePacked,proto3" json:"enable_packed,omitempty"`
+}
+
+func (x *VirtioPCILink) Reset() {
+	*x = VirtioPCILink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VirtioPCILink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtioPCILink) ProtoMessage() {}
+
+func (x *VirtioPCILink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtioPCILink.ProtoReflect.Descriptor instead.
+func (*VirtioPCILink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VirtioPCILink) GetPciAddress() string {
+	if x != nil {
+		return x.PciAddress
+	}
+	return ""
+}
+
+func (x *VirtioPCILink) GetFeatures() uint64 {
+	if x != nil {
+		return x.Features
+	}
+	return 0
+}
+
+func (x *VirtioPCILink) GetEnableGso() bool {
+	if x != nil {
+		return x.EnableGso
+	}
+	return false
+}
+
+func (x *VirtioPCILink) GetEnableChecksumOffload() bool {
+	if x != nil {
+		return x.EnableChecksumOffload
+	}
+	return false
+}
+
+func (x *VirtioPCILink) GetEnablePacked() bool {
+	if x != nil {
+		return x.EnablePacked
+	}
+	return false
+}
+
+// AfXdpLink defines configuration for interface type: AF_XDP
+// AF_XDP interface attaches VPP to a Linux network device using the AF_XDP socket
+// (a much faster alternative to AF_PACKET).
+type AfXdpLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the host (Linux) interface to bind to.
+	// Similarly to AF-PACKET, this reference is suitable for target interfaces
+	// not managed by the agent (see AfpacketLink.host_if_name for explanation).
+	// It is mandatory to define either <host_if_name> or <linux_interface>.
+	HostIfName string `protobuf:"bytes,1,opt,name=host_if_name,json=hostIfName,proto3" json:"host_if_name,omitempty"`
+	// Logical name of the Linux interface to bind to.
+	// This is an alternative interface reference to <host_if_name> and preferred
+	// if the target interface is managed by the agent.
+	// It is mandatory to define either <host_if_name> or <linux_interface>.
+	LinuxInterface string `protobuf:"bytes,2,opt,name=linux_interface,json=linuxInterface,proto3" json:"linux_interface,omitempty"`
+	// Mode at which the AF_XDP socket operates.
+	Mode AfXdpLink_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=ligato.vpp.interfaces.AfXdpLink_Mode" json:"mode,omitempty"`
+	// Number of receive queues.
+	// By default only one RX queue is used.
+	RxqNum uint32 `protobuf:"varint,4,opt,name=rxq_num,json=rxqNum,proto3" json:"rxq_num,omitempty"`
+	// The size of each RX queue (VPP default is used if not set).
+	RxqSize uint32 `protobuf:"varint,5,opt,name=rxq_size,json=rxqSize,proto3" json:"rxq_size,omitempty"`
+	// The size of each TX queue (VPP default is used if not set).
+	TxqSize uint32 `protobuf:"varint,6,opt,name=txq_size,json=txqSize,proto3" json:"txq_size,omitempty"`
+	// Path to the eBPF program to load onto the host interface.
+	// If not set, the network device is expected to already have an XDP program
+	// loaded (or no program is needed).
+	ProgPath string `protobuf:"bytes,7,opt,name=prog_path,json=progPath,proto3" json:"prog_path,omitempty"`
+}
+
+func (x *AfXdpLink) Reset() {
+	*x = AfXdpLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AfXdpLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AfXdpLink) ProtoMessage() {}
+
+func (x *AfXdpLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AfXdpLink.ProtoReflect.Descriptor instead.
+func (*AfXdpLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AfXdpLink) GetHostIfName() string {
+	if x != nil {
+		return x.HostIfName
+	}
+	return ""
+}
+
+func (x *AfXdpLink) GetLinuxInterface() string {
+	if x != nil {
+		return x.LinuxInterface
+	}
+	return ""
+}
+
+func (x *AfXdpLink) GetMode() AfXdpLink_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return AfXdpLink_AUTO
+}
+
+func (x *AfXdpLink) GetRxqNum() uint32 {
+	if x != nil {
+		return x.RxqNum
+	}
+	return 0
+}
+
+func (x *AfXdpLink) GetRxqSize() uint32 {
+	if x != nil {
+		return x.RxqSize
+	}
+	return 0
+}
+
+func (x *AfXdpLink) GetTxqSize() uint32 {
+	if x != nil {
+		return x.TxqSize
+	}
+	return 0
+}
+
+func (x *AfXdpLink) GetProgPath() string {
+	if x != nil {
+		return x.ProgPath
+	}
+	return ""
+}
+
+// GeneveLink defines configuration for interface type: GENEVE_TUNNEL
+// The encapsulated packets are sent via VRF table selected by the <vrf> attribute
+// of the interface (same as for VXLAN).
+// GENEVE TLV options are intentionally not modelled: neither geneve_add_del_tunnel
+// nor geneve_add_del_tunnel2 (VPP 20.09, 21.01) carry options and the VPP GENEVE
+// encapsulation always sends the header without them (received options are skipped).
+type GeneveLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source VTEP address
+	SrcAddress string `protobuf:"bytes,1,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	// Destination VTEP address
+	DstAddress string `protobuf:"bytes,2,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	// Virtual Network Identifier
+	Vni uint32 `protobuf:"varint,3,opt,name=vni,proto3" json:"vni,omitempty"`
+	// Name of multicast interface (required if the destination address is multicast)
+	Multicast string `protobuf:"bytes,4,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	// Next VPP node after decapsulation (l2-input is used by default)
+	DecapNextNode uint32 `protobuf:"varint,5,opt,name=decap_next_node,json=decapNextNode,proto3" json:"decap_next_node,omitempty"`
+	// L3 mode carries IP packets without the Ethernet header (VPP 21.01+)
+	L3Mode bool `protobuf:"varint,6,opt,name=l3_mode,json=l3Mode,proto3" json:"l3_mode,omitempty"`
+}
+
+func (x *GeneveLink) Reset() {
+	*x = GeneveLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeneveLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneveLink) ProtoMessage() {}
+
+func (x *GeneveLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneveLink.ProtoReflect.Descriptor instead.
+func (*GeneveLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GeneveLink) GetSrcAddress() string {
+	if x != nil {
+		return x.SrcAddress
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetDstAddress() string {
+	if x != nil {
+		return x.DstAddress
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *GeneveLink) GetMulticast() string {
+	if x != nil {
+		return x.Multicast
+	}
+	return ""
+}
+
+func (x *GeneveLink) GetDecapNextNode() uint32 {
+	if x != nil {
+		return x.DecapNextNode
+	}
+	return 0
+}
+
+func (x *GeneveLink) GetL3Mode() bool {
+	if x != nil {
+		return x.L3Mode
+	}
+	return false
+}
+
+// VxlanGbpLink defines configuration for interface type: VXLAN_GBP_TUNNEL
+// VXLAN with Group Based Policy extension carries the source group (EPG)
+// in the VXLAN header.
+// The encapsulated packets are sent via VRF table selected by the <vrf> attribute
+// of the interface (same as for VXLAN).
+type VxlanGbpLink struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source VTEP address
+	SrcAddress string `protobuf:"bytes,1,opt,name=src_address,json=srcAddress,proto3" json:"src_address,omitempty"`
+	// Destination VTEP address
+	DstAddress string `protobuf:"bytes,2,opt,name=dst_address,json=dstAddress,proto3" json:"dst_address,omitempty"`
+	// Virtual Network Identifier
+	Vni uint32 `protobuf:"varint,3,opt,name=vni,proto3" json:"vni,omitempty"`
+	// Name of multicast interface (required if the destination address is multicast)
+	Multicast string `protobuf:"bytes,4,opt,name=multicast,proto3" json:"multicast,omitempty"`
+	// L3 mode terminates the tunnel at the IP layer (L2 mode is used by default)
+	L3Mode bool `protobuf:"varint,5,opt,name=l3_mode,json=l3Mode,proto3" json:"l3_mode,omitempty"`
+}
+
+func (x *VxlanGbpLink) Reset() {
+	*x = VxlanGbpLink{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VxlanGbpLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VxlanGbpLink) ProtoMessage() {}
+
+func (x *VxlanGbpLink) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VxlanGbpLink.ProtoReflect.Descriptor instead.
+func (*VxlanGbpLink) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_interface_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VxlanGbpLink) GetSrcAddress() string {
+	if x != nil {
+		return x.SrcAddress
+	}
+	return ""
+}
+
+func (x *VxlanGbpLink) GetDstAddress() string {
+	if x != nil {
+		return x.DstAddress
+	}
+	return ""
+}
+
+func (x *VxlanGbpLink) GetVni() uint32 {
+	if x != nil {
+		return x.Vni
+	}
+	return 0
+}
+
+func (x *VxlanGbpLink) GetMulticast() string {
+	if x != nil {
+		return x.Multicast
+	}
+	return ""
+}
+
+func (x *VxlanGbpLink) GetL3Mode() bool {
+	if x != nil {
+		return x.L3Mode
+	}
+	return false
+}
+
 // Ip6Nd is used to enable/disable IPv6 ND address autoconfiguration
 // and setting up default routes
 type Interface_IP6ND struct {
@@ -2230,7 +2853,7 @@ type Interface_IP6ND struct {
 func (x *Interface_IP6ND) Reset() {
 	*x = Interface_IP6ND{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2243,7 +2866,7 @@ func (x *Interface_IP6ND) String() string {
 func (*Interface_IP6ND) ProtoMessage() {}
 
 func (x *Interface_IP6ND) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[14]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2286,7 +2909,7 @@ type Interface_Unnumbered struct {
 func (x *Interface_Unnumbered) Reset() {
 	*x = Interface_Unnumbered{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2299,7 +2922,7 @@ func (x *Interface_Unnumbered) String() string {
 func (*Interface_Unnumbered) ProtoMessage() {}
 
 func (x *Interface_Unnumbered) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[15]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2337,7 +2960,7 @@ type Interface_RxMode struct {
 func (x *Interface_RxMode) Reset() {
 	*x = Interface_RxMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2350,7 +2973,7 @@ func (x *Interface_RxMode) String() string {
 func (*Interface_RxMode) ProtoMessage() {}
 
 func (x *Interface_RxMode) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[16]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2404,7 +3027,7 @@ type Interface_RxPlacement struct {
 func (x *Interface_RxPlacement) Reset() {
 	*x = Interface_RxPlacement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2417,7 +3040,7 @@ func (x *Interface_RxPlacement) String() string {
 func (*Interface_RxPlacement) ProtoMessage() {}
 
 func (x *Interface_RxPlacement) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[17]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2468,7 +3091,7 @@ type VxlanLink_Gpe struct {
 func (x *VxlanLink_Gpe) Reset() {
 	*x = VxlanLink_Gpe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2481,7 +3104,7 @@ func (x *VxlanLink_Gpe) String() string {
 func (*VxlanLink_Gpe) ProtoMessage() {}
 
 func (x *VxlanLink_Gpe) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[18]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2524,7 +3147,7 @@ type BondLink_BondedInterface struct {
 func (x *BondLink_BondedInterface) Reset() {
 	*x = BondLink_BondedInterface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+		mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2537,7 +3160,7 @@ func (x *BondLink_BondedInterface) String() string {
 func (*BondLink_BondedInterface) ProtoMessage() {}
 
 func (x *BondLink_BondedInterface) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[19]
+	mi := &file_ligato_vpp_interfaces_interface_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2584,7 +3207,7 @@ var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
 	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x70, 0x73, 0x65, 0x63,
 	0x2f, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69,
 	0x67, 0x61, 0x74, 0x6f, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac, 0x10, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x13, 0x0a, 0x09, 0x49, 0x6e, 0x74, 0x65, 0x72,
 	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
@@ -2667,295 +3290,391 @@ var file_ligato_vpp_interfaces_interface_proto_rawDesc = []byte{
 	0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x18, 0x70, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
 	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44, 0x4d, 0x41,
-	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x1a, 0x6c, 0x0a, 0x05,
-	0x49, 0x50, 0x36, 0x4e, 0x44, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x11, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f,
-	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x55, 0x6e,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x70, 0x1a, 0xcf, 0x01, 0x0a, 0x06, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x52, 0x55, 0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41,
-	0x44, 0x41, 0x50, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46,
-	0x41, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x1a, 0x5c, 0x0a, 0x0b, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10,
-	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x55, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45,
-	0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44,
-	0x50, 0x44, 0x4b, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x04,
-	0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x50, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x58, 0x4c, 0x41,
-	0x4e, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x0c, 0x49, 0x50,
-	0x53, 0x45, 0x43, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x08, 0x1a, 0x02, 0x08, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x56, 0x4d, 0x58, 0x4e, 0x45, 0x54, 0x33, 0x5f, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4e, 0x44, 0x5f,
-	0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x47,
-	0x52, 0x45, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47,
-	0x54, 0x50, 0x55, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b,
-	0x49, 0x50, 0x49, 0x50, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0d, 0x12, 0x14, 0x0a,
-	0x10, 0x57, 0x49, 0x52, 0x45, 0x47, 0x55, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45,
-	0x4c, 0x10, 0x0e, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x44, 0x4d, 0x41, 0x10, 0x0f, 0x42, 0x06, 0x0a,
-	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x59,
-	0x0a, 0x0d, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75,
-	0x62, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x61,
-	0x67, 0x52, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73,
-	0x68, 0x5f, 0x64, 0x6f, 0x74, 0x31, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70,
-	0x75, 0x73, 0x68, 0x44, 0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x61, 0x67, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32,
-	0x22, 0x8f, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f,
-	0x50, 0x31, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f,
-	0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12,
-	0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06,
-	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10,
-	0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32,
-	0x10, 0x08, 0x22, 0xe0, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b,
-	0x12, 0x3e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b,
-	0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b,
-	0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x69,
-	0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75,
-	0x65, 0x75, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65,
-	0x73, 0x22, 0x32, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c,
-	0x0a, 0x08, 0x45, 0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02,
-	0x49, 0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x4a,
-	0x45, 0x43, 0x54, 0x10, 0x02, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
-	0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64,
-	0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
-	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
-	0x61, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x67, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x04, 0x72, 0x64, 0x6d, 0x61, 0x12, 0x45, 0x0a, 0x0a,
+	0x76, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x71, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69,
-	0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x52, 0x03, 0x67, 0x70, 0x65, 0x1a, 0xb4, 0x01, 0x0a, 0x03,
-	0x47, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70,
-	0x56, 0x72, 0x66, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x76, 0x68, 0x6f, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x0a, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x5f, 0x70, 0x63,
+	0x69, 0x18, 0x72, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
 	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
-	0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x22, 0x40, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34,
-	0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45,
-	0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x53, 0x48,
-	0x10, 0x04, 0x22, 0x59, 0x0a, 0x0c, 0x41, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69,
-	0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c,
-	0x69, 0x6e, 0x75, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xf6, 0x01,
-	0x0a, 0x07, 0x54, 0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49,
-	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x63, 0x72,
-	0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x74, 0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20,
-	0x0a, 0x0c, 0x72, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73,
-	0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e,
-	0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x87, 0x04, 0x0a, 0x09, 0x49, 0x50, 0x53, 0x65, 0x63,
-	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x03, 0x65, 0x73, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72,
-	0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6e, 0x74,
-	0x69, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
-	0x52, 0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x22, 0x0a, 0x09, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d,
-	0x02, 0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x70, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x70, 0x69, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x50, 0x43, 0x49, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52,
+	0x09, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x50, 0x63, 0x69, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x66,
+	0x5f, 0x78, 0x64, 0x70, 0x18, 0x73, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x41, 0x66, 0x58, 0x64, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05,
+	0x61, 0x66, 0x58, 0x64, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x65, 0x76, 0x65, 0x18,
+	0x74, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x76, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x65,
+	0x76, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x76, 0x78, 0x6c, 0x61, 0x6e, 0x5f, 0x67, 0x62, 0x70, 0x18,
+	0x75, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78,
+	0x6c, 0x61, 0x6e, 0x47, 0x62, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x08, 0x76, 0x78,
+	0x6c, 0x61, 0x6e, 0x47, 0x62, 0x70, 0x1a, 0x6c, 0x0a, 0x05, 0x49, 0x50, 0x36, 0x4e, 0x44, 0x12,
+	0x2d, 0x0a, 0x12, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34,
+	0x0a, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x55, 0x6e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x5f, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x1a, 0xcf,
+	0x01, 0x0a, 0x06, 0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12,
+	0x40, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e,
+	0x52, 0x78, 0x4d, 0x6f, 0x64, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4f, 0x4c,
+	0x4c, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x52,
+	0x55, 0x50, 0x54, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x44, 0x41, 0x50, 0x54, 0x49, 0x56,
+	0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x04,
+	0x1a, 0x5c, 0x0a, 0x0b, 0x52, 0x78, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x22, 0xeb,
+	0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x44, 0x45, 0x46,
+	0x49, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x55, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x11, 0x53, 0x4f, 0x46, 0x54, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x4c, 0x4f, 0x4f, 0x50, 0x42,
+	0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x50, 0x44, 0x4b, 0x10, 0x03, 0x12,
+	0x09, 0x0a, 0x05, 0x4d, 0x45, 0x4d, 0x49, 0x46, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41,
+	0x50, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x46, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54,
+	0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x55, 0x4e, 0x4e,
+	0x45, 0x4c, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x0c, 0x49, 0x50, 0x53, 0x45, 0x43, 0x5f, 0x54, 0x55,
+	0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x08, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x4d,
+	0x58, 0x4e, 0x45, 0x54, 0x33, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46, 0x41, 0x43, 0x45, 0x10,
+	0x09, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4f, 0x4e, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x46,
+	0x41, 0x43, 0x45, 0x10, 0x0a, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x52, 0x45, 0x5f, 0x54, 0x55, 0x4e,
+	0x4e, 0x45, 0x4c, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x54, 0x50, 0x55, 0x5f, 0x54, 0x55,
+	0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x50, 0x49, 0x50, 0x5f, 0x54,
+	0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0d, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x52, 0x45, 0x47,
+	0x55, 0x41, 0x52, 0x44, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x0e, 0x12, 0x08, 0x0a,
+	0x04, 0x52, 0x44, 0x4d, 0x41, 0x10, 0x0f, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x48, 0x4f, 0x53, 0x54,
+	0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x49, 0x52, 0x54, 0x49,
+	0x4f, 0x5f, 0x50, 0x43, 0x49, 0x10, 0x11, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x46, 0x5f, 0x58, 0x44,
+	0x50, 0x10, 0x12, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x45, 0x4e, 0x45, 0x56, 0x45, 0x5f, 0x54, 0x55,
+	0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x13, 0x12, 0x14, 0x0a, 0x10, 0x56, 0x58, 0x4c, 0x41, 0x4e, 0x5f,
+	0x47, 0x42, 0x50, 0x5f, 0x54, 0x55, 0x4e, 0x4e, 0x45, 0x4c, 0x10, 0x14, 0x42, 0x06, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x75, 0x62, 0x49, 0x64, 0x12, 0x59, 0x0a,
+	0x0d, 0x74, 0x61, 0x67, 0x5f, 0x72, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x74, 0x61, 0x67,
+	0x52, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x73, 0x68,
+	0x5f, 0x64, 0x6f, 0x74, 0x31, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x75,
+	0x73, 0x68, 0x44, 0x6f, 0x74, 0x31, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x31, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x61, 0x67, 0x32, 0x22,
+	0x8f, 0x01, 0x0a, 0x11, 0x54, 0x61, 0x67, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x31, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x50, 0x55, 0x53, 0x48, 0x32, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50,
+	0x31, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x50, 0x32, 0x10, 0x04, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x31, 0x10, 0x05, 0x12, 0x0f,
+	0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x31, 0x32, 0x10, 0x06, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x31, 0x10, 0x07,
+	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x45, 0x32, 0x32, 0x10,
+	0x08, 0x22, 0xe0, 0x02, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x3e, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4c, 0x69, 0x6e, 0x6b, 0x2e,
+	0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x69, 0x6e,
+	0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x78, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x78, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73,
+	0x22, 0x32, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x69, 0x66, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0c, 0x0a,
+	0x08, 0x45, 0x54, 0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x49,
+	0x50, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x4a, 0x45,
+	0x43, 0x54, 0x10, 0x02, 0x22, 0xfa, 0x02, 0x0a, 0x09, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a,
+	0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b, 0x64, 0x73,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x03, 0x67, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e,
+	0x6b, 0x2e, 0x47, 0x70, 0x65, 0x52, 0x03, 0x67, 0x70, 0x65, 0x1a, 0xb4, 0x01, 0x0a, 0x03, 0x47,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x56,
+	0x72, 0x66, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x56,
+	0x78, 0x6c, 0x61, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x47, 0x70, 0x65, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22,
+	0x40, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10,
+	0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x54,
+	0x48, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x53, 0x48, 0x10,
+	0x04, 0x22, 0x59, 0x0a, 0x0c, 0x41, 0x66, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0xf6, 0x01, 0x0a,
+	0x07, 0x54, 0x61, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x49, 0x66,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x63, 0x72, 0x6f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74,
+	0x6f, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a,
+	0x0c, 0x72, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73, 0x6f,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x87, 0x04, 0x0a, 0x09, 0x49, 0x50, 0x53, 0x65, 0x63, 0x4c,
+	0x69, 0x6e, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x73, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x65, 0x73, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x6e, 0x74, 0x69,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
+	0x07, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x49, 0x70, 0x12, 0x22, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x5f, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02,
+	0x08, 0x01, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x70, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x73, 0x70, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x70, 0x69, 0x12, 0x3a, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x6f, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x41, 0x6c, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79, 0x12, 0x2a,
+	0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
 	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63,
-	0x2e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x52, 0x09, 0x63, 0x72, 0x79, 0x70,
-	0x74, 0x6f, 0x41, 0x6c, 0x67, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79, 0x12,
-	0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x4b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x69,
-	0x6e, 0x74, 0x65, 0x67, 0x5f, 0x61, 0x6c, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65,
-	0x63, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
-	0x67, 0x41, 0x6c, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x6e,
-	0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x5f, 0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x64, 0x70, 0x45, 0x6e, 0x63, 0x61, 0x70,
-	0x22, 0x64, 0x0a, 0x0b, 0x56, 0x6d, 0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
-	0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6c, 0x6f, 0x67,
-	0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
-	0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74,
-	0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c,
-	0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69,
-	0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a,
-	0x02, 0x6c, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-	0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x02, 0x6c, 0x62, 0x12, 0x5c, 0x0a, 0x11, 0x62, 0x6f,
-	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18,
-	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f,
-	0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x10, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0f, 0x42, 0x6f, 0x6e, 0x64,
-	0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x26,
-	0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x54,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x59, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b,
-	0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52,
-	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12,
-	0x07, 0x0a, 0x03, 0x58, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41,
-	0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x43, 0x50, 0x10,
-	0x05, 0x22, 0x3f, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x34, 0x10,
-	0x01, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x32, 0x33, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x52,
-	0x10, 0x03, 0x12, 0x06, 0x0a, 0x02, 0x42, 0x43, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x42,
-	0x10, 0x05, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x44,
-	0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73,
-	0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
-	0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x65,
-	0x72, 0x5f, 0x66, 0x69, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
-	0x6f, 0x75, 0x74, 0x65, 0x72, 0x46, 0x69, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x06,
-	0x0a, 0x02, 0x4c, 0x33, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x45, 0x42, 0x10, 0x02, 0x12,
-	0x0a, 0x0a, 0x06, 0x45, 0x52, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x03, 0x22, 0xeb, 0x02, 0x0a, 0x08,
-	0x47, 0x74, 0x70, 0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08,
-	0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73,
-	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d,
-	0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12, 0x1f,
-	0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x65, 0x69, 0x64, 0x12,
-	0x20, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x49,
-	0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x74,
-	0x70, 0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x09, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26,
-	0x0a, 0x0f, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65,
-	0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x08, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12,
-	0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x02,
-	0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x03, 0x22, 0xca, 0x01, 0x0a, 0x08, 0x49, 0x50,
-	0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x2e, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64,
-	0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a,
-	0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x22, 0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49,
-	0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a,
-	0x13, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50,
-	0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x71, 0x0a, 0x0d, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75,
-	0x61, 0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff,
-	0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
-	0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x08, 0x52, 0x44,
-	0x4d, 0x41, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69,
-	0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f,
-	0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52,
-	0x44, 0x4d, 0x41, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
-	0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x72,
-	0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72,
-	0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a,
-	0x65, 0x22, 0x21, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54,
-	0x4f, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x42, 0x56, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02,
-	0x44, 0x56, 0x10, 0x02, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76,
-	0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
-	0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x76, 0x70,
-	0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x41, 0x6c, 0x67, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67,
+	0x41, 0x6c, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x75, 0x64, 0x70, 0x5f, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x55, 0x64, 0x70, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x22,
+	0x64, 0x0a, 0x0b, 0x56, 0x6d, 0x78, 0x4e, 0x65, 0x74, 0x33, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x6c, 0x6f, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6c, 0x6f, 0x67, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78,
+	0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78,
+	0x71, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xf9, 0x03, 0x0a, 0x08, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69,
+	0x6e, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e,
+	0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x02,
+	0x6c, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x02, 0x6c, 0x62, 0x12, 0x5c, 0x0a, 0x11, 0x62, 0x6f, 0x6e,
+	0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x42, 0x6f, 0x6e,
+	0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x42, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x10, 0x62, 0x6f, 0x6e, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x6c, 0x0a, 0x0f, 0x42, 0x6f, 0x6e, 0x64, 0x65,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a,
+	0x0f, 0x69, 0x73, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73, 0x4c, 0x6f, 0x6e, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x59, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f,
+	0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x12, 0x07,
+	0x0a, 0x03, 0x58, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44,
+	0x43, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x41, 0x43, 0x50, 0x10, 0x05,
+	0x22, 0x3f, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x06, 0x0a, 0x02, 0x4c, 0x32, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x33, 0x34, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x4c, 0x32, 0x33, 0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x52, 0x52, 0x10,
+	0x03, 0x12, 0x06, 0x0a, 0x02, 0x42, 0x43, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x41, 0x42, 0x10,
+	0x05, 0x22, 0x86, 0x02, 0x0a, 0x07, 0x47, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x44, 0x0a,
+	0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x72, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72,
+	0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07,
+	0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x65, 0x72,
+	0x5f, 0x66, 0x69, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6f,
+	0x75, 0x74, 0x65, 0x72, 0x46, 0x69, 0x62, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x06, 0x0a,
+	0x02, 0x4c, 0x33, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x45, 0x42, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x45, 0x52, 0x53, 0x50, 0x41, 0x4e, 0x10, 0x03, 0x22, 0xeb, 0x02, 0x0a, 0x08, 0x47,
+	0x74, 0x70, 0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
+	0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x73, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02,
+	0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x65, 0x69, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x65, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x65, 0x69, 0x64, 0x12, 0x20,
+	0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x5f, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x65, 0x6e, 0x63, 0x61, 0x70, 0x56, 0x72, 0x66, 0x49, 0x64,
+	0x12, 0x4b, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x74, 0x70,
+	0x75, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x09, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x31, 0x0a, 0x08, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x06,
+	0x0a, 0x02, 0x4c, 0x32, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x50, 0x34, 0x10, 0x02, 0x12,
+	0x07, 0x0a, 0x03, 0x49, 0x50, 0x36, 0x10, 0x03, 0x22, 0xca, 0x01, 0x0a, 0x08, 0x49, 0x50, 0x49,
+	0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x49, 0x50, 0x49, 0x50, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x0a, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x08,
+	0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
+	0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x12, 0x20,
+	0x0a, 0x08, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x07, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x22, 0x33, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x4f, 0x49, 0x4e,
+	0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x71, 0x0a, 0x0d, 0x57, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03,
+	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52,
+	0x07, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x08, 0x52, 0x44, 0x4d,
+	0x41, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x66,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73,
+	0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x44,
+	0x4d, 0x41, 0x4c, 0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x72, 0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78,
+	0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78,
+	0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x21, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x42, 0x56, 0x10, 0x01, 0x12, 0x06, 0x0a, 0x02, 0x44,
+	0x56, 0x10, 0x02, 0x22, 0xf9, 0x01, 0x0a, 0x0d, 0x56, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x72, 0x67, 0x5f, 0x72, 0x78, 0x62, 0x75, 0x66,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4d,
+	0x72, 0x67, 0x52, 0x78, 0x62, 0x75, 0x66, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x44, 0x65, 0x73, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x22,
+	0xc8, 0x01, 0x0a, 0x0d, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x50, 0x43, 0x49, 0x4c, 0x69, 0x6e,
+	0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x63, 0x69, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x63, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x73, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x47, 0x73, 0x6f, 0x12, 0x36, 0x0a,
+	0x17, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x4f, 0x66,
+	0x66, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x22, 0xa8, 0x02, 0x0a, 0x09, 0x41,
+	0x66, 0x58, 0x64, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0c, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x66, 0x58, 0x64, 0x70, 0x4c,
+	0x69, 0x6e, 0x6b, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x72, 0x78, 0x71, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x72, 0x78, 0x71, 0x4e, 0x75, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x78, 0x71, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x78, 0x71, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x71, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74, 0x78, 0x71, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x50, 0x61, 0x74, 0x68, 0x22, 0x29, 0x0a, 0x04, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x43, 0x4f, 0x50, 0x59, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x43,
+	0x4f, 0x50, 0x59, 0x10, 0x02, 0x22, 0xcd, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x6e, 0x65, 0x76, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01,
+	0x52, 0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0b,
+	0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63,
+	0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x61, 0x70, 0x5f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64,
+	0x65, 0x63, 0x61, 0x70, 0x4e, 0x65, 0x78, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x6c, 0x33, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c,
+	0x33, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0c, 0x56, 0x78, 0x6c, 0x61, 0x6e, 0x47,
+	0x62, 0x70, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0b, 0x73, 0x72, 0x63, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02,
+	0x08, 0x01, 0x52, 0x0a, 0x73, 0x72, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26,
+	0x0a, 0x0b, 0x64, 0x73, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x6e, 0x69, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x76, 0x6e, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x33, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x33, 0x4d, 0x6f, 0x64, 0x65, 0x42,
+	0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f,
+	0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2970,8 +3689,8 @@ func file_ligato_vpp_interfaces_interface_proto_rawDescGZIP() []byte {
 	return file_ligato_vpp_interfaces_interface_proto_rawDescData
 }
 
-var file_ligato_vpp_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_ligato_vpp_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_ligato_vpp_interfaces_interface_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_ligato_vpp_interfaces_interface_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_ligato_vpp_interfaces_interface_proto_goTypes = []interface{}{
 	(Interface_Type)(0),                 // 0: ligato.vpp.interfaces.Interface.Type
 	(Interface_RxMode_Type)(0),          // 1: ligato.vpp.interfaces.Interface.RxMode.Type
@@ -2984,67 +3703,79 @@ var file_ligato_vpp_interfaces_interface_proto_goTypes = []interface{}{
 	(GtpuLink_NextNode)(0),              // 8: ligato.vpp.interfaces.GtpuLink.NextNode
 	(IPIPLink_Mode)(0),                  // 9: ligato.vpp.interfaces.IPIPLink.Mode
 	(RDMALink_Mode)(0),                  // 10: ligato.vpp.interfaces.RDMALink.Mode
-	(*Interface)(nil),                   // 11: ligato.vpp.interfaces.Interface
-	(*SubInterface)(nil),                // 12: ligato.vpp.interfaces.SubInterface
-	(*MemifLink)(nil),                   // 13: ligato.vpp.interfaces.MemifLink
-	(*VxlanLink)(nil),                   // 14: ligato.vpp.interfaces.VxlanLink
-	(*AfpacketLink)(nil),                // 15: ligato.vpp.interfaces.AfpacketLink
-	(*TapLink)(nil),                     // 16: ligato.vpp.interfaces.TapLink
-	(*IPSecLink)(nil),                   // 17: ligato.vpp.interfaces.IPSecLink
-	(*VmxNet3Link)(nil),                 // 18: ligato.vpp.interfaces.VmxNet3Link
-	(*BondLink)(nil),                    // 19: ligato.vpp.interfaces.BondLink
-	(*GreLink)(nil),                     // 20: ligato.vpp.interfaces.GreLink
-	(*GtpuLink)(nil),                    // 21: ligato.vpp.interfaces.GtpuLink
-	(*IPIPLink)(nil),                    // 22: ligato.vpp.interfaces.IPIPLink
-	(*WireguardLink)(nil),               // 23: ligato.vpp.interfaces.WireguardLink
-	(*RDMALink)(nil),                    // 24: ligato.vpp.interfaces.RDMALink
-	(*Interface_IP6ND)(nil),             // 25: ligato.vpp.interfaces.Interface.IP6ND
-	(*Interface_Unnumbered)(nil),        // 26: ligato.vpp.interfaces.Interface.Unnumbered
-	(*Interface_RxMode)(nil),            // 27: ligato.vpp.interfaces.Interface.RxMode
-	(*Interface_RxPlacement)(nil),       // 28: ligato.vpp.interfaces.Interface.RxPlacement
-	(*VxlanLink_Gpe)(nil),               // 29: ligato.vpp.interfaces.VxlanLink.Gpe
-	(*BondLink_BondedInterface)(nil),    // 30: ligato.vpp.interfaces.BondLink.BondedInterface
-	(ipsec.CryptoAlg)(0),                // 31: ligato.vpp.ipsec.CryptoAlg
-	(ipsec.IntegAlg)(0),                 // 32: ligato.vpp.ipsec.IntegAlg
+	(AfXdpLink_Mode)(0),                 // 11: ligato.vpp.interfaces.AfXdpLink.Mode
+	(*Interface)(nil),                   // 12: ligato.vpp.interfaces.Interface
+	(*SubInterface)(nil),                // 13: ligato.vpp.interfaces.SubInterface
+	(*MemifLink)(nil),                   // 14: ligato.vpp.interfaces.MemifLink
+	(*VxlanLink)(nil),                   // 15: ligato.vpp.interfaces.VxlanLink
+	(*AfpacketLink)(nil),                // 16: ligato.vpp.interfaces.AfpacketLink
+	(*TapLink)(nil),                     // 17: ligato.vpp.interfaces.TapLink
+	(*IPSecLink)(nil),                   // 18: ligato.vpp.interfaces.IPSecLink
+	(*VmxNet3Link)(nil),                 // 19: ligato.vpp.interfaces.VmxNet3Link
+	(*BondLink)(nil),                    // 20: ligato.vpp.interfaces.BondLink
+	(*GreLink)(nil),                     // 21: ligato.vpp.interfaces.GreLink
+	(*GtpuLink)(nil),                    // 22: ligato.vpp.interfaces.GtpuLink
+	(*IPIPLink)(nil),                    // 23: ligato.vpp.interfaces.IPIPLink
+	(*WireguardLink)(nil),               // 24: ligato.vpp.interfaces.WireguardLink
+	(*RDMALink)(nil),                    // 25: ligato.vpp.interfaces.RDMALink
+	(*VhostUserLink)(nil),               // 26: ligato.vpp.interfaces.VhostUserLink
+	(*VirtioPCILink)(nil),               // 27: ligato.vpp.interfaces.VirtioPCILink
+	(*AfXdpLink)(nil),                   // 28: ligato.vpp.interfaces.AfXdpLink
+	(*GeneveLink)(nil),                  // 29: ligato.vpp.interfaces.GeneveLink
+	(*VxlanGbpLink)(nil),                // 30: ligato.vpp.interfaces.VxlanGbpLink
+	(*Interface_IP6ND)(nil),             // 31: ligato.vpp.interfaces.Interface.IP6ND
+	(*Interface_Unnumbered)(nil),        // 32: ligato.vpp.interfaces.Interface.Unnumbered
+	(*Interface_RxMode)(nil),            // 33: ligato.vpp.interfaces.Interface.RxMode
+	(*Interface_RxPlacement)(nil),       // 34: ligato.vpp.interfaces.Interface.RxPlacement
+	(*VxlanLink_Gpe)(nil),               // 35: ligato.vpp.interfaces.VxlanLink.Gpe
+	(*BondLink_BondedInterface)(nil),    // 36: ligato.vpp.interfaces.BondLink.BondedInterface
+	(ipsec.CryptoAlg)(0),                // 37: ligato.vpp.ipsec.CryptoAlg
+	(ipsec.IntegAlg)(0),                 // 38: ligato.vpp.ipsec.IntegAlg
 }
 var file_ligato_vpp_interfaces_interface_proto_depIdxs = []int32{
 	0,  // 0: ligato.vpp.interfaces.Interface.type:type_name -> ligato.vpp.interfaces.Interface.Type
-	25, // 1: ligato.vpp.interfaces.Interface.ip6_nd:type_name -> ligato.vpp.interfaces.Interface.IP6ND
-	26, // 2: ligato.vpp.interfaces.Interface.unnumbered:type_name -> ligato.vpp.interfaces.Interface.Unnumbered
-	27, // 3: ligato.vpp.interfaces.Interface.rx_modes:type_name -> ligato.vpp.interfaces.Interface.RxMode
-	28, // 4: ligato.vpp.interfaces.Interface.rx_placements:type_name -> ligato.vpp.interfaces.Interface.RxPlacement
-	12, // 5: ligato.vpp.interfaces.Interface.sub:type_name -> ligato.vpp.interfaces.SubInterface
-	13, // 6: ligato.vpp.interfaces.Interface.memif:type_name -> ligato.vpp.interfaces.MemifLink
-	15, // 7: ligato.vpp.interfaces.Interface.afpacket:type_name -> ligato.vpp.interfaces.AfpacketLink
-	16, // 8: ligato.vpp.interfaces.Interface.tap:type_name -> ligato.vpp.interfaces.TapLink
-	14, // 9: ligato.vpp.interfaces.Interface.vxlan:type_name -> ligato.vpp.interfaces.VxlanLink
-	17, // 10: ligato.vpp.interfaces.Interface.ipsec:type_name -> ligato.vpp.interfaces.IPSecLink
-	18, // 11: ligato.vpp.interfaces.Interface.vmx_net3:type_name -> ligato.vpp.interfaces.VmxNet3Link
-	19, // 12: ligato.vpp.interfaces.Interface.bond:type_name -> ligato.vpp.interfaces.BondLink
-	20, // 13: ligato.vpp.interfaces.Interface.gre:type_name -> ligato.vpp.interfaces.GreLink
-	21, // 14: ligato.vpp.interfaces.Interface.gtpu:type_name -> ligato.vpp.interfaces.GtpuLink
-	22, // 15: ligato.vpp.interfaces.Interface.ipip:type_name -> ligato.vpp.interfaces.IPIPLink
-	23, // 16: ligato.vpp.interfaces.Interface.wireguard:type_name -> ligato.vpp.interfaces.WireguardLink
-	24, // 17: ligato.vpp.interfaces.Interface.rdma:type_name -> ligato.vpp.interfaces.RDMALink
-	2,  // 18: ligato.vpp.interfaces.SubInterface.tag_rw_option:type_name -> ligato.vpp.interfaces.SubInterface.TagRewriteOptions
-	3,  // 19: ligato.vpp.interfaces.MemifLink.mode:type_name -> ligato.vpp.interfaces.MemifLink.MemifMode
-	29, // 20: ligato.vpp.interfaces.VxlanLink.gpe:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe
-	31, // 21: ligato.vpp.interfaces.IPSecLink.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
-	32, // 22: ligato.vpp.interfaces.IPSecLink.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
-	5,  // 23: ligato.vpp.interfaces.BondLink.mode:type_name -> ligato.vpp.interfaces.BondLink.Mode
-	6,  // 24: ligato.vpp.interfaces.BondLink.lb:type_name -> ligato.vpp.interfaces.BondLink.LoadBalance
-	30, // 25: ligato.vpp.interfaces.BondLink.bonded_interfaces:type_name -> ligato.vpp.interfaces.BondLink.BondedInterface
-	7,  // 26: ligato.vpp.interfaces.GreLink.tunnel_type:type_name -> ligato.vpp.interfaces.GreLink.Type
-	8,  // 27: ligato.vpp.interfaces.GtpuLink.decap_next:type_name -> ligato.vpp.interfaces.GtpuLink.NextNode
-	9,  // 28: ligato.vpp.interfaces.IPIPLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPIPLink.Mode
-	10, // 29: ligato.vpp.interfaces.RDMALink.mode:type_name -> ligato.vpp.interfaces.RDMALink.Mode
-	1,  // 30: ligato.vpp.interfaces.Interface.RxMode.mode:type_name -> ligato.vpp.interfaces.Interface.RxMode.Type
-	4,  // 31: ligato.vpp.interfaces.VxlanLink.Gpe.protocol:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
-	32, // [32:32] is the sub-list for method output_type
-	32, // [32:32] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	31, // 1: ligato.vpp.interfaces.Interface.ip6_nd:type_name -> ligato.vpp.interfaces.Interface.IP6ND
+	32, // 2: ligato.vpp.interfaces.Interface.unnumbered:type_name -> ligato.vpp.interfaces.Interface.Unnumbered
+	33, // 3: ligato.vpp.interfaces.Interface.rx_modes:type_name -> ligato.vpp.interfaces.Interface.RxMode
+	34, // 4: ligato.vpp.interfaces.Interface.rx_placements:type_name -> ligato.vpp.interfaces.Interface.RxPlacement
+	13, // 5: ligato.vpp.interfaces.Interface.sub:type_name -> ligato.vpp.interfaces.SubInterface
+	14, // 6: ligato.vpp.interfaces.Interface.memif:type_name -> ligato.vpp.interfaces.MemifLink
+	16, // 7: ligato.vpp.interfaces.Interface.afpacket:type_name -> ligato.vpp.interfaces.AfpacketLink
+	17, // 8: ligato.vpp.interfaces.Interface.tap:type_name -> ligato.vpp.interfaces.TapLink
+	15, // 9: ligato.vpp.interfaces.Interface.vxlan:type_name -> ligato.vpp.interfaces.VxlanLink
+	18, // 10: ligato.vpp.interfaces.Interface.ipsec:type_name -> ligato.vpp.interfaces.IPSecLink
+	19, // 11: ligato.vpp.interfaces.Interface.vmx_net3:type_name -> ligato.vpp.interfaces.VmxNet3Link
+	20, // 12: ligato.vpp.interfaces.Interface.bond:type_name -> ligato.vpp.interfaces.BondLink
+	21, // 13: ligato.vpp.interfaces.Interface.gre:type_name -> ligato.vpp.interfaces.GreLink
+	22, // 14: ligato.vpp.interfaces.Interface.gtpu:type_name -> ligato.vpp.interfaces.GtpuLink
+	23, // 15: ligato.vpp.interfaces.Interface.ipip:type_name -> ligato.vpp.interfaces.IPIPLink
+	24, // 16: ligato.vpp.interfaces.Interface.wireguard:type_name -> ligato.vpp.interfaces.WireguardLink
+	25, // 17: ligato.vpp.interfaces.Interface.rdma:type_name -> ligato.vpp.interfaces.RDMALink
+	26, // 18: ligato.vpp.interfaces.Interface.vhost_user:type_name -> ligato.vpp.interfaces.VhostUserLink
+	27, // 19: ligato.vpp.interfaces.Interface.virtio_pci:type_name -> ligato.vpp.interfaces.VirtioPCILink
+	28, // 20: ligato.vpp.interfaces.Interface.af_xdp:type_name -> ligato.vpp.interfaces.AfXdpLink
+	29, // 21: ligato.vpp.interfaces.Interface.geneve:type_name -> ligato.vpp.interfaces.GeneveLink
+	30, // 22: ligato.vpp.interfaces.Interface.vxlan_gbp:type_name -> ligato.vpp.interfaces.VxlanGbpLink
+	2,  // 23: ligato.vpp.interfaces.SubInterface.tag_rw_option:type_name -> ligato.vpp.interfaces.SubInterface.TagRewriteOptions
+	3,  // 24: ligato.vpp.interfaces.MemifLink.mode:type_name -> ligato.vpp.interfaces.MemifLink.MemifMode
+	35, // 25: ligato.vpp.interfaces.VxlanLink.gpe:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe
+	37, // 26: ligato.vpp.interfaces.IPSecLink.crypto_alg:type_name -> ligato.vpp.ipsec.CryptoAlg
+	38, // 27: ligato.vpp.interfaces.IPSecLink.integ_alg:type_name -> ligato.vpp.ipsec.IntegAlg
+	5,  // 28: ligato.vpp.interfaces.BondLink.mode:type_name -> ligato.vpp.interfaces.BondLink.Mode
+	6,  // 29: ligato.vpp.interfaces.BondLink.lb:type_name -> ligato.vpp.interfaces.BondLink.LoadBalance
+	36, // 30: ligato.vpp.interfaces.BondLink.bonded_interfaces:type_name -> ligato.vpp.interfaces.BondLink.BondedInterface
+	7,  // 31: ligato.vpp.interfaces.GreLink.tunnel_type:type_name -> ligato.vpp.interfaces.GreLink.Type
+	8,  // 32: ligato.vpp.interfaces.GtpuLink.decap_next:type_name -> ligato.vpp.interfaces.GtpuLink.NextNode
+	9,  // 33: ligato.vpp.interfaces.IPIPLink.tunnel_mode:type_name -> ligato.vpp.interfaces.IPIPLink.Mode
+	10, // 34: ligato.vpp.interfaces.RDMALink.mode:type_name -> ligato.vpp.interfaces.RDMALink.Mode
+	11, // 35: ligato.vpp.interfaces.AfXdpLink.mode:type_name -> ligato.vpp.interfaces.AfXdpLink.Mode
+	1,  // 36: ligato.vpp.interfaces.Interface.RxMode.mode:type_name -> ligato.vpp.interfaces.Interface.RxMode.Type
+	4,  // 37: ligato.vpp.interfaces.VxlanLink.Gpe.protocol:type_name -> ligato.vpp.interfaces.VxlanLink.Gpe.Protocol
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_interfaces_interface_proto_init() }
@@ -3222,7 +3953,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_IP6ND); i {
+			switch v := v.(*VhostUserLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3234,7 +3965,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_Unnumbered); i {
+			switch v := v.(*VirtioPCILink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3246,7 +3977,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_RxMode); i {
+			switch v := v.(*AfXdpLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3258,7 +3989,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Interface_RxPlacement); i {
+			switch v := v.(*GeneveLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3270,7 +4001,7 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VxlanLink_Gpe); i {
+			switch v := v.(*VxlanGbpLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3282,6 +4013,66 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_interface_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_IP6ND); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_Unnumbered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_RxMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Interface_RxPlacement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VxlanLink_Gpe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_interface_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BondLink_BondedInterface); i {
 			case 0:
 				return &v.state
@@ -3308,14 +4099,19 @@ func file_ligato_vpp_interfaces_interface_proto_init() {
 		(*Interface_Ipip)(nil),
 		(*Interface_Wireguard)(nil),
 		(*Interface_Rdma)(nil),
+		(*Interface_VhostUser)(nil),
+		(*Interface_VirtioPci)(nil),
+		(*Interface_AfXdp)(nil),
+		(*Interface_Geneve)(nil),
+		(*Interface_VxlanGbp)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_interfaces_interface_proto_rawDesc,
-			NumEnums:      11,
-			NumMessages:   20,
+			NumEnums:      12,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
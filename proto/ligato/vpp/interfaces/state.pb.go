@@ -364,18 +364,20 @@ type InterfaceState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string                     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	InternalName string                     `protobuf:"bytes,2,opt,name=internal_name,json=internalName,proto3" json:"internal_name,omitempty"`
-	Type         Interface_Type             `protobuf:"varint,3,opt,name=type,proto3,enum=ligato.vpp.interfaces.Interface_Type" json:"type,omitempty"`
-	IfIndex      uint32                     `protobuf:"varint,4,opt,name=if_index,json=ifIndex,proto3" json:"if_index,omitempty"`
-	AdminStatus  InterfaceState_Status      `protobuf:"varint,5,opt,name=admin_status,json=adminStatus,proto3,enum=ligato.vpp.interfaces.InterfaceState_Status" json:"admin_status,omitempty"`
-	OperStatus   InterfaceState_Status      `protobuf:"varint,6,opt,name=oper_status,json=operStatus,proto3,enum=ligato.vpp.interfaces.InterfaceState_Status" json:"oper_status,omitempty"`
-	LastChange   int64                      `protobuf:"varint,7,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
-	PhysAddress  string                     `protobuf:"bytes,8,opt,name=phys_address,json=physAddress,proto3" json:"phys_address,omitempty"`
-	Speed        uint64                     `protobuf:"varint,9,opt,name=speed,proto3" json:"speed,omitempty"`
-	Mtu          uint32                     `protobuf:"varint,10,opt,name=mtu,proto3" json:"mtu,omitempty"`
-	Duplex       InterfaceState_Duplex      `protobuf:"varint,11,opt,name=duplex,proto3,enum=ligato.vpp.interfaces.InterfaceState_Duplex" json:"duplex,omitempty"`
-	Statistics   *InterfaceState_Statistics `protobuf:"bytes,100,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	Name         string                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	InternalName string                         `protobuf:"bytes,2,opt,name=internal_name,json=internalName,proto3" json:"internal_name,omitempty"`
+	Type         Interface_Type                 `protobuf:"varint,3,opt,name=type,proto3,enum=ligato.vpp.interfaces.Interface_Type" json:"type,omitempty"`
+	IfIndex      uint32                         `protobuf:"varint,4,opt,name=if_index,json=ifIndex,proto3" json:"if_index,omitempty"`
+	AdminStatus  InterfaceState_Status          `protobuf:"varint,5,opt,name=admin_status,json=adminStatus,proto3,enum=ligato.vpp.interfaces.InterfaceState_Status" json:"admin_status,omitempty"`
+	OperStatus   InterfaceState_Status          `protobuf:"varint,6,opt,name=oper_status,json=operStatus,proto3,enum=ligato.vpp.interfaces.InterfaceState_Status" json:"oper_status,omitempty"`
+	LastChange   int64                          `protobuf:"varint,7,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+	PhysAddress  string                         `protobuf:"bytes,8,opt,name=phys_address,json=physAddress,proto3" json:"phys_address,omitempty"`
+	Speed        uint64                         `protobuf:"varint,9,opt,name=speed,proto3" json:"speed,omitempty"`
+	Mtu          uint32                         `protobuf:"varint,10,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Duplex       InterfaceState_Duplex          `protobuf:"varint,11,opt,name=duplex,proto3,enum=ligato.vpp.interfaces.InterfaceState_Duplex" json:"duplex,omitempty"`
+	VhostUser    *InterfaceState_VhostUserState `protobuf:"bytes,12,opt,name=vhost_user,json=vhostUser,proto3" json:"vhost_user,omitempty"`
+	VirtioPci    *InterfaceState_VirtioPCIState `protobuf:"bytes,13,opt,name=virtio_pci,json=virtioPci,proto3" json:"virtio_pci,omitempty"`
+	Statistics   *InterfaceState_Statistics     `protobuf:"bytes,100,opt,name=statistics,proto3" json:"statistics,omitempty"`
 }
 
 func (x *InterfaceState) Reset() {
@@ -487,6 +489,20 @@ func (x *InterfaceState) GetDuplex() InterfaceState_Duplex {
 	return InterfaceState_UNKNOWN_DUPLEX
 }
 
+func (x *InterfaceState) GetVhostUser() *InterfaceState_VhostUserState {
+	if x != nil {
+		return x.VhostUser
+	}
+	return nil
+}
+
+func (x *InterfaceState) GetVirtioPci() *InterfaceState_VirtioPCIState {
+	if x != nil {
+		return x.VirtioPci
+	}
+	return nil
+}
+
 func (x *InterfaceState) GetStatistics() *InterfaceState_Statistics {
 	if x != nil {
 		return x.Statistics
@@ -604,6 +620,170 @@ func (x *InterfaceStats_CombinedCounter) GetBytes() uint64 {
 	return 0
 }
 
+// VhostUserState is run-time state specific to VHOST_USER interface.
+type InterfaceState_VhostUserState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SocketFilename string `protobuf:"bytes,1,opt,name=socket_filename,json=socketFilename,proto3" json:"socket_filename,omitempty"`
+	IsServer       bool   `protobuf:"varint,2,opt,name=is_server,json=isServer,proto3" json:"is_server,omitempty"`
+	// Number of memory regions shared by the VM (zero if the VM is not connected).
+	NumRegions uint32 `protobuf:"varint,3,opt,name=num_regions,json=numRegions,proto3" json:"num_regions,omitempty"`
+	// Error number of the last failed socket operation (zero if there is no error).
+	SockErrno int32 `protobuf:"varint,4,opt,name=sock_errno,json=sockErrno,proto3" json:"sock_errno,omitempty"`
+	// Virtio features negotiated with the VM.
+	Features         uint64 `protobuf:"varint,5,opt,name=features,proto3" json:"features,omitempty"`
+	VirtioNetHdrSize uint32 `protobuf:"varint,6,opt,name=virtio_net_hdr_size,json=virtioNetHdrSize,proto3" json:"virtio_net_hdr_size,omitempty"`
+}
+
+func (x *InterfaceState_VhostUserState) Reset() {
+	*x = InterfaceState_VhostUserState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_state_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceState_VhostUserState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceState_VhostUserState) ProtoMessage() {}
+
+func (x *InterfaceState_VhostUserState) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_state_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceState_VhostUserState.ProtoReflect.Descriptor instead.
+func (*InterfaceState_VhostUserState) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_state_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *InterfaceState_VhostUserState) GetSocketFilename() string {
+	if x != nil {
+		return x.SocketFilename
+	}
+	return ""
+}
+
+func (x *InterfaceState_VhostUserState) GetIsServer() bool {
+	if x != nil {
+		return x.IsServer
+	}
+	return false
+}
+
+func (x *InterfaceState_VhostUserState) GetNumRegions() uint32 {
+	if x != nil {
+		return x.NumRegions
+	}
+	return 0
+}
+
+func (x *InterfaceState_VhostUserState) GetSockErrno() int32 {
+	if x != nil {
+		return x.SockErrno
+	}
+	return 0
+}
+
+func (x *InterfaceState_VhostUserState) GetFeatures() uint64 {
+	if x != nil {
+		return x.Features
+	}
+	return 0
+}
+
+func (x *InterfaceState_VhostUserState) GetVirtioNetHdrSize() uint32 {
+	if x != nil {
+		return x.VirtioNetHdrSize
+	}
+	return 0
+}
+
+// VirtioPCIState is run-time state specific to VIRTIO_PCI interface.
+type InterfaceState_VirtioPCIState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PciAddress string `protobuf:"bytes,1,opt,name=pci_address,json=pciAddress,proto3" json:"pci_address,omitempty"`
+	// Virtio features negotiated with the device.
+	Features   uint64 `protobuf:"varint,2,opt,name=features,proto3" json:"features,omitempty"`
+	RxRingSize uint32 `protobuf:"varint,3,opt,name=rx_ring_size,json=rxRingSize,proto3" json:"rx_ring_size,omitempty"`
+	TxRingSize uint32 `protobuf:"varint,4,opt,name=tx_ring_size,json=txRingSize,proto3" json:"tx_ring_size,omitempty"`
+}
+
+func (x *InterfaceState_VirtioPCIState) Reset() {
+	*x = InterfaceState_VirtioPCIState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_interfaces_state_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceState_VirtioPCIState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceState_VirtioPCIState) ProtoMessage() {}
+
+func (x *InterfaceState_VirtioPCIState) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_interfaces_state_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceState_VirtioPCIState.ProtoReflect.Descriptor instead.
+func (*InterfaceState_VirtioPCIState) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_interfaces_state_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *InterfaceState_VirtioPCIState) GetPciAddress() string {
+	if x != nil {
+		return x.PciAddress
+	}
+	return ""
+}
+
+func (x *InterfaceState_VirtioPCIState) GetFeatures() uint64 {
+	if x != nil {
+		return x.Features
+	}
+	return 0
+}
+
+func (x *InterfaceState_VirtioPCIState) GetRxRingSize() uint32 {
+	if x != nil {
+		return x.RxRingSize
+	}
+	return 0
+}
+
+func (x *InterfaceState_VirtioPCIState) GetTxRingSize() uint32 {
+	if x != nil {
+		return x.TxRingSize
+	}
+	return 0
+}
+
 type InterfaceState_Statistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -626,7 +806,7 @@ type InterfaceState_Statistics struct {
 func (x *InterfaceState_Statistics) Reset() {
 	*x = InterfaceState_Statistics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_interfaces_state_proto_msgTypes[4]
+		mi := &file_ligato_vpp_interfaces_state_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -639,7 +819,7 @@ func (x *InterfaceState_Statistics) String() string {
 func (*InterfaceState_Statistics) ProtoMessage() {}
 
 func (x *InterfaceState_Statistics) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_interfaces_state_proto_msgTypes[4]
+	mi := &file_ligato_vpp_interfaces_state_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +832,7 @@ func (x *InterfaceState_Statistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InterfaceState_Statistics.ProtoReflect.Descriptor instead.
 func (*InterfaceState_Statistics) Descriptor() ([]byte, []int) {
-	return file_ligato_vpp_interfaces_state_proto_rawDescGZIP(), []int{1, 0}
+	return file_ligato_vpp_interfaces_state_proto_rawDescGZIP(), []int{1, 2}
 }
 
 func (x *InterfaceState_Statistics) GetInPackets() uint64 {
@@ -811,7 +991,7 @@ var file_ligato_vpp_interfaces_state_proto_rawDesc = []byte{
 	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
 	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
 	0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0xf7, 0x08, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x99, 0x0d, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72,
 	0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
 	0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
@@ -843,65 +1023,99 @@ var file_ligato_vpp_interfaces_state_proto_rawDesc = []byte{
 	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65,
 	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
 	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x52, 0x06, 0x64, 0x75,
-	0x70, 0x6c, 0x65, 0x78, 0x12, 0x50, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x70, 0x6c, 0x65, 0x78, 0x12, 0x53, 0x0a, 0x0a, 0x76, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
 	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
 	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x1a, 0xb8, 0x03, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x50, 0x61, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x70, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x70, 0x76, 0x34, 0x50,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x70,
-	0x76, 0x36, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x6e, 0x5f,
-	0x6e, 0x6f, 0x62, 0x75, 0x66, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x4e, 0x6f, 0x62, 0x75, 0x66, 0x50, 0x61, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73, 0x5f, 0x70,
-	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e,
-	0x4d, 0x69, 0x73, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69,
-	0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x61,
-	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x6f, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74,
-	0x73, 0x22, 0x3b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12,
-	0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57, 0x4e, 0x10,
-	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x22, 0x30,
-	0x0a, 0x06, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x45, 0x58, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x48, 0x41, 0x4c, 0x46, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x02,
-	0x22, 0xd4, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0x32, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x55, 0x50, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4f, 0x55,
-	0x4e, 0x54, 0x45, 0x52, 0x53, 0x10, 0x02, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x56, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09,
+	0x76, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x53, 0x0a, 0x0a, 0x76, 0x69, 0x72,
+	0x74, 0x69, 0x6f, 0x5f, 0x70, 0x63, 0x69, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x50, 0x43, 0x49, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x09, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x50, 0x63, 0x69, 0x12, 0x50,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x1a, 0xe1, 0x01, 0x0a, 0x0e, 0x56, 0x68, 0x6f, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x69, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d,
+	0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x6e, 0x75, 0x6d, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f,
+	0x63, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x73, 0x6f, 0x63, 0x6b, 0x45, 0x72, 0x72, 0x6e, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x5f,
+	0x6e, 0x65, 0x74, 0x5f, 0x68, 0x64, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x76, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x4e, 0x65, 0x74, 0x48, 0x64, 0x72,
+	0x53, 0x69, 0x7a, 0x65, 0x1a, 0x91, 0x01, 0x0a, 0x0e, 0x56, 0x69, 0x72, 0x74, 0x69, 0x6f, 0x50,
+	0x43, 0x49, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x63, 0x69, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x63,
+	0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x78, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x78, 0x52, 0x69,
+	0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x78,
+	0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0xb8, 0x03, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x69, 0x6e, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x72, 0x6f, 0x70, 0x50, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x6e, 0x74, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x70, 0x76,
+	0x34, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x76, 0x36,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x69, 0x70, 0x76, 0x36, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69,
+	0x6e, 0x5f, 0x6e, 0x6f, 0x62, 0x75, 0x66, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x4e, 0x6f, 0x62, 0x75, 0x66, 0x50, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x73, 0x73,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x69, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x50, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x22, 0x3b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10,
+	0x00, 0x12, 0x06, 0x0a, 0x02, 0x55, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x4f, 0x57,
+	0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x22, 0x30, 0x0a, 0x06, 0x44, 0x75, 0x70, 0x6c, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x45, 0x58, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x48, 0x41, 0x4c, 0x46, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c,
+	0x10, 0x02, 0x22, 0xd4, 0x01, 0x0a, 0x15, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x32, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x53, 0x10, 0x02, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x6f, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -917,7 +1131,7 @@ func file_ligato_vpp_interfaces_state_proto_rawDescGZIP() []byte {
 }
 
 var file_ligato_vpp_interfaces_state_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_ligato_vpp_interfaces_state_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ligato_vpp_interfaces_state_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_ligato_vpp_interfaces_state_proto_goTypes = []interface{}{
 	(InterfaceState_Status)(0),             // 0: ligato.vpp.interfaces.InterfaceState.Status
 	(InterfaceState_Duplex)(0),             // 1: ligato.vpp.interfaces.InterfaceState.Duplex
@@ -926,8 +1140,10 @@ var file_ligato_vpp_interfaces_state_proto_goTypes = []interface{}{
 	(*InterfaceState)(nil),                 // 4: ligato.vpp.interfaces.InterfaceState
 	(*InterfaceNotification)(nil),          // 5: ligato.vpp.interfaces.InterfaceNotification
 	(*InterfaceStats_CombinedCounter)(nil), // 6: ligato.vpp.interfaces.InterfaceStats.CombinedCounter
-	(*InterfaceState_Statistics)(nil),      // 7: ligato.vpp.interfaces.InterfaceState.Statistics
-	(Interface_Type)(0),                    // 8: ligato.vpp.interfaces.Interface.Type
+	(*InterfaceState_VhostUserState)(nil),  // 7: ligato.vpp.interfaces.InterfaceState.VhostUserState
+	(*InterfaceState_VirtioPCIState)(nil),  // 8: ligato.vpp.interfaces.InterfaceState.VirtioPCIState
+	(*InterfaceState_Statistics)(nil),      // 9: ligato.vpp.interfaces.InterfaceState.Statistics
+	(Interface_Type)(0),                    // 10: ligato.vpp.interfaces.Interface.Type
 }
 var file_ligato_vpp_interfaces_state_proto_depIdxs = []int32{
 	6,  // 0: ligato.vpp.interfaces.InterfaceStats.rx:type_name -> ligato.vpp.interfaces.InterfaceStats.CombinedCounter
@@ -938,18 +1154,20 @@ var file_ligato_vpp_interfaces_state_proto_depIdxs = []int32{
 	6,  // 5: ligato.vpp.interfaces.InterfaceStats.tx_unicast:type_name -> ligato.vpp.interfaces.InterfaceStats.CombinedCounter
 	6,  // 6: ligato.vpp.interfaces.InterfaceStats.tx_multicast:type_name -> ligato.vpp.interfaces.InterfaceStats.CombinedCounter
 	6,  // 7: ligato.vpp.interfaces.InterfaceStats.tx_broadcast:type_name -> ligato.vpp.interfaces.InterfaceStats.CombinedCounter
-	8,  // 8: ligato.vpp.interfaces.InterfaceState.type:type_name -> ligato.vpp.interfaces.Interface.Type
+	10, // 8: ligato.vpp.interfaces.InterfaceState.type:type_name -> ligato.vpp.interfaces.Interface.Type
 	0,  // 9: ligato.vpp.interfaces.InterfaceState.admin_status:type_name -> ligato.vpp.interfaces.InterfaceState.Status
 	0,  // 10: ligato.vpp.interfaces.InterfaceState.oper_status:type_name -> ligato.vpp.interfaces.InterfaceState.Status
 	1,  // 11: ligato.vpp.interfaces.InterfaceState.duplex:type_name -> ligato.vpp.interfaces.InterfaceState.Duplex
-	7,  // 12: ligato.vpp.interfaces.InterfaceState.statistics:type_name -> ligato.vpp.interfaces.InterfaceState.Statistics
-	2,  // 13: ligato.vpp.interfaces.InterfaceNotification.type:type_name -> ligato.vpp.interfaces.InterfaceNotification.NotifType
-	4,  // 14: ligato.vpp.interfaces.InterfaceNotification.state:type_name -> ligato.vpp.interfaces.InterfaceState
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	7,  // 12: ligato.vpp.interfaces.InterfaceState.vhost_user:type_name -> ligato.vpp.interfaces.InterfaceState.VhostUserState
+	8,  // 13: ligato.vpp.interfaces.InterfaceState.virtio_pci:type_name -> ligato.vpp.interfaces.InterfaceState.VirtioPCIState
+	9,  // 14: ligato.vpp.interfaces.InterfaceState.statistics:type_name -> ligato.vpp.interfaces.InterfaceState.Statistics
+	2,  // 15: ligato.vpp.interfaces.InterfaceNotification.type:type_name -> ligato.vpp.interfaces.InterfaceNotification.NotifType
+	4,  // 16: ligato.vpp.interfaces.InterfaceNotification.state:type_name -> ligato.vpp.interfaces.InterfaceState
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_interfaces_state_proto_init() }
@@ -1008,6 +1226,30 @@ func file_ligato_vpp_interfaces_state_proto_init() {
 			}
 		}
 		file_ligato_vpp_interfaces_state_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterfaceState_VhostUserState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_state_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterfaceState_VirtioPCIState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_interfaces_state_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InterfaceState_Statistics); i {
 			case 0:
 				return &v.state
@@ -1026,7 +1268,7 @@ func file_ligato_vpp_interfaces_state_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_interfaces_state_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
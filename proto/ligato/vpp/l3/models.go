@@ -96,6 +96,16 @@ var (
 	}, models.WithNameTemplate(
 		"{{.Interface}}/vrid/{{.VrId}}",
 	))
+
+	ModelMRoute = models.Register(&MRoute{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "mroute",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		`vrf/{{.VrfId}}/`+
+			`{{with ipnet .GroupAddress}}{{printf "grp/%s/%d" .IP .MaskSize}}{{end}}`+
+			`{{if .SourceAddress}}/src/{{.SourceAddress}}{{end}}`,
+	))
 )
 
 // ProxyARPKey returns key for global proxy arp
@@ -201,6 +211,15 @@ func VrrpEntryKey(iface string, vrId uint32) string {
 	})
 }
 
+// MRouteKey returns the key used to store VPP multicast route.
+func MRouteKey(vrf uint32, groupAddr, srcAddr string) string {
+	return models.Key(&MRoute{
+		VrfId:         vrf,
+		GroupAddress:  groupAddr,
+		SourceAddress: srcAddr,
+	})
+}
+
 func getRouteKeyItem(items []string, itemLabel, nextItemLabel string) (value string, found bool) {
 	begin := len(items)
 	end := len(items)
@@ -194,3 +194,56 @@ func TestParseRouteKey(t *testing.T) {
 		})
 	}
 }
+
+func TestMRouteKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		mroute      MRoute
+		expectedKey string
+	}{
+		{
+			"mroute-star-g",
+			MRoute{
+				VrfId:        0,
+				GroupAddress: "232.1.1.1/32",
+			},
+			"config/vpp/v2/mroute/vrf/0/grp/232.1.1.1/32",
+		},
+		{
+			"mroute-s-g",
+			MRoute{
+				VrfId:         2,
+				GroupAddress:  "232.1.1.1/32",
+				SourceAddress: "10.0.0.1",
+			},
+			"config/vpp/v2/mroute/vrf/2/grp/232.1.1.1/32/src/10.0.0.1",
+		},
+		{
+			"mroute-group-network",
+			MRoute{
+				VrfId:        0,
+				GroupAddress: "239.1.2.3/8",
+			},
+			"config/vpp/v2/mroute/vrf/0/grp/239.0.0.0/8",
+		},
+		{
+			"mroute-ipv6",
+			MRoute{
+				VrfId:         0,
+				GroupAddress:  "FF0E::1/128",
+				SourceAddress: "2001:db8::1",
+			},
+			"config/vpp/v2/mroute/vrf/0/grp/ff0e::1/128/src/2001:db8::1",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(&test.mroute)
+			if key != test.expectedKey {
+				t.Errorf("failed key for mroute: %+v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.mroute, test.expectedKey, key)
+			}
+		})
+	}
+}
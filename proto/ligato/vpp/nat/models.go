@@ -57,6 +57,45 @@ var (
 			"{{if and .LastIp (ne .FirstIp .LastIp)}}-{{.LastIp}}{{end}}"+
 			"{{end}}",
 	))
+
+	ModelNat64IPv6Prefix = models.Register(&Nat64IPv6Prefix{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-prefix",
+		Version: "v2",
+	}, models.WithNameTemplate("vrf/{{.VrfId}}"))
+
+	ModelNat64Interface = models.Register(&Nat64Interface{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-interface",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.Name}}"))
+
+	ModelNat64AddressPool = models.Register(&Nat64AddressPool{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-pool",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"vrf/{{.VrfId}}"+
+			"/address/{{.FirstIp}}"+
+			"{{if and .LastIp (ne .FirstIp .LastIp)}}-{{.LastIp}}{{end}}",
+	))
+
+	ModelNat64StaticBIB = models.Register(&Nat64StaticBIB{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "nat64-static-bib",
+		Version: "v2",
+	}, models.WithNameTemplate(
+		"vrf/{{.VrfId}}"+
+			"/address/{{.InsideIpv6Address}}"+
+			"/port/{{.InsidePort}}"+
+			"/protocol/{{.Protocol}}",
+	))
+
+	ModelDet44Mapping = models.Register(&Det44Mapping{}, models.Spec{
+		Module:  ModuleName,
+		Type:    "det44-mapping",
+		Version: "v2",
+	}, models.WithNameTemplate("{{.InsidePrefix}}"))
 )
 
 // GlobalNAT44Key returns key for Nat44Global.
@@ -80,6 +119,14 @@ func Nat44InterfaceKey(name string) string {
 	})
 }
 
+// Nat64InterfaceKey returns the key used in NB DB to store the configuration of the
+// given NAT64 interface.
+func Nat64InterfaceKey(name string) string {
+	return models.Key(&Nat64Interface{
+		Name: name,
+	})
+}
+
 /* NAT44 mode (derived) */
 
 const (
@@ -17,6 +17,8 @@ package vpp_nat
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
+
 	"go.ligato.io/vpp-agent/v3/pkg/models"
 )
 
@@ -348,3 +350,76 @@ func TestNat44AddressPoolKey(t *testing.T) {
 		})
 	}
 }
+
+func TestNat64AndDet44Keys(t *testing.T) {
+	tests := []struct {
+		name        string
+		model       proto.Message
+		expectedKey string
+	}{
+		{
+			"NAT64 IPv6 prefix",
+			&Nat64IPv6Prefix{
+				VrfId:  1,
+				Prefix: "64:ff9b::/96",
+			},
+			"config/vpp/nat/v2/nat64-prefix/vrf/1",
+		},
+		{
+			"NAT64 interface",
+			&Nat64Interface{
+				Name: "if1",
+				Type: Nat64Interface_IPV4_OUTSIDE,
+			},
+			"config/vpp/nat/v2/nat64-interface/if1",
+		},
+		{
+			"NAT64 pool with multiple IP addresses",
+			&Nat64AddressPool{
+				VrfId:   0,
+				FirstIp: "1.2.3.4",
+				LastIp:  "1.2.3.10",
+			},
+			"config/vpp/nat/v2/nat64-pool/vrf/0/address/1.2.3.4-1.2.3.10",
+		},
+		{
+			"NAT64 pool with 1 IP address",
+			&Nat64AddressPool{
+				VrfId:   0,
+				FirstIp: "1.2.3.4",
+				LastIp:  "1.2.3.4",
+			},
+			"config/vpp/nat/v2/nat64-pool/vrf/0/address/1.2.3.4",
+		},
+		{
+			"NAT64 static BIB",
+			&Nat64StaticBIB{
+				VrfId:              0,
+				InsideIpv6Address:  "2001:db8::1",
+				InsidePort:         8080,
+				OutsideIpv4Address: "1.2.3.4",
+				OutsidePort:        80,
+				Protocol:           DNat44_UDP,
+			},
+			"config/vpp/nat/v2/nat64-static-bib/vrf/0/address/2001:db8::1/port/8080/protocol/UDP",
+		},
+		{
+			"DET44 mapping",
+			&Det44Mapping{
+				InsidePrefix:  "10.0.0.0/24",
+				OutsidePrefix: "1.2.3.0/28",
+			},
+			"config/vpp/nat/v2/det44-mapping/10.0.0.0/24",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key := models.Key(test.model)
+			if key != test.expectedKey {
+				t.Errorf("failed key for %s: %+v\n"+
+					"expected key:\n\t%q\ngot key:\n\t%q",
+					test.name, test.model, test.expectedKey, key)
+			}
+		})
+	}
+}
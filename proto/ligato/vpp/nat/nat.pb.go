@@ -127,6 +127,55 @@ func (DNat44_StaticMapping_TwiceNatMode) EnumDescriptor() ([]byte, []int) {
 	return file_ligato_vpp_nat_nat_proto_rawDescGZIP(), []int{1, 0, 0}
 }
 
+// Role of the interface in the NAT64 translation.
+type Nat64Interface_Type int32
+
+const (
+	// Interface facing the IPv6-only network.
+	Nat64Interface_IPV6_INSIDE Nat64Interface_Type = 0
+	// Interface facing the IPv4 network.
+	Nat64Interface_IPV4_OUTSIDE Nat64Interface_Type = 1
+)
+
+// Enum value maps for Nat64Interface_Type.
+var (
+	Nat64Interface_Type_name = map[int32]string{
+		0: "IPV6_INSIDE",
+		1: "IPV4_OUTSIDE",
+	}
+	Nat64Interface_Type_value = map[string]int32{
+		"IPV6_INSIDE":  0,
+		"IPV4_OUTSIDE": 1,
+	}
+)
+
+func (x Nat64Interface_Type) Enum() *Nat64Interface_Type {
+	p := new(Nat64Interface_Type)
+	*p = x
+	return p
+}
+
+func (x Nat64Interface_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Nat64Interface_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_ligato_vpp_nat_nat_proto_enumTypes[2].Descriptor()
+}
+
+func (Nat64Interface_Type) Type() protoreflect.EnumType {
+	return &file_ligato_vpp_nat_nat_proto_enumTypes[2]
+}
+
+func (x Nat64Interface_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Nat64Interface_Type.Descriptor instead.
+func (Nat64Interface_Type) EnumDescriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat_proto_rawDescGZIP(), []int{6, 0}
+}
+
 // Nat44Global defines global NAT44 configuration.
 // In VPP version 21.01 and newer the NAT44 plugin has to be explicitly enabled (by default it is
 // disabled so that it doesn't consume any computational resources). With ligato control-plane
@@ -540,6 +589,355 @@ func (x *VirtualReassembly) GetDropFragments() bool {
 	return false
 }
 
+// Nat64IPv6Prefix defines the IPv6 prefix used to embed IPv4 addresses of remote hosts
+// into IPv6 addresses (RFC 6052).
+// Without any prefix configured the well-known prefix 64:ff9b::/96 is used by VPP.
+// NAT64 is supported with VPP 20.09 and newer.
+// In VPP version 21.01 and newer the NAT64 plugin has to be explicitly enabled - with ligato
+// control-plane this is done automatically with the first NAT64 configuration item
+// (Nat64IPv6Prefix, Nat64Interface, Nat64AddressPool or Nat64StaticBIB).
+type Nat64IPv6Prefix struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VRF id of tenant. At most one IPv6 prefix can be configured for a given VRF.
+	// Non-zero VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	VrfId uint32 `protobuf:"varint,1,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// NAT64 prefix in the <IPv6-Address>/<IPv6-Prefix> format.
+	// Allowed prefix lengths are 32, 40, 48, 56, 64 and 96.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *Nat64IPv6Prefix) Reset() {
+	*x = Nat64IPv6Prefix{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64IPv6Prefix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64IPv6Prefix) ProtoMessage() {}
+
+func (x *Nat64IPv6Prefix) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64IPv6Prefix.ProtoReflect.Descriptor instead.
+func (*Nat64IPv6Prefix) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Nat64IPv6Prefix) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat64IPv6Prefix) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+// Nat64Interface defines a local network interface enabled for NAT64.
+type Nat64Interface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interface name (logical).
+	Name string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type Nat64Interface_Type `protobuf:"varint,2,opt,name=type,proto3,enum=ligato.vpp.nat.Nat64Interface_Type" json:"type,omitempty"`
+}
+
+func (x *Nat64Interface) Reset() {
+	*x = Nat64Interface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64Interface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64Interface) ProtoMessage() {}
+
+func (x *Nat64Interface) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64Interface.ProtoReflect.Descriptor instead.
+func (*Nat64Interface) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Nat64Interface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Nat64Interface) GetType() Nat64Interface_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Nat64Interface_IPV6_INSIDE
+}
+
+// Nat64AddressPool defines an IPv4 address pool used for NAT64.
+type Nat64AddressPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VRF id of tenant, 0xFFFFFFFF means independent of VRF.
+	// Non-zero (and not all-ones) VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	VrfId uint32 `protobuf:"varint,1,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// First IP address of the pool.
+	FirstIp string `protobuf:"bytes,2,opt,name=first_ip,json=firstIp,proto3" json:"first_ip,omitempty"`
+	// Last IP address of the pool. Should be higher than first_ip or empty.
+	LastIp string `protobuf:"bytes,3,opt,name=last_ip,json=lastIp,proto3" json:"last_ip,omitempty"`
+}
+
+func (x *Nat64AddressPool) Reset() {
+	*x = Nat64AddressPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64AddressPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64AddressPool) ProtoMessage() {}
+
+func (x *Nat64AddressPool) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64AddressPool.ProtoReflect.Descriptor instead.
+func (*Nat64AddressPool) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Nat64AddressPool) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat64AddressPool) GetFirstIp() string {
+	if x != nil {
+		return x.FirstIp
+	}
+	return ""
+}
+
+func (x *Nat64AddressPool) GetLastIp() string {
+	if x != nil {
+		return x.LastIp
+	}
+	return ""
+}
+
+// Nat64StaticBIB defines a static entry of the NAT64 Binding Information Base,
+// i.e. a static mapping between IPv6 transport address and IPv4 transport address.
+type Nat64StaticBIB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VRF (table) ID. Non-zero VRF has to be explicitly created (see api/models/vpp/l3/vrf.proto).
+	VrfId uint32 `protobuf:"varint,1,opt,name=vrf_id,json=vrfId,proto3" json:"vrf_id,omitempty"`
+	// Inside IPv6 address.
+	InsideIpv6Address string `protobuf:"bytes,2,opt,name=inside_ipv6_address,json=insideIpv6Address,proto3" json:"inside_ipv6_address,omitempty"`
+	// Inside port number (of the IPv6 host).
+	InsidePort uint32 `protobuf:"varint,3,opt,name=inside_port,json=insidePort,proto3" json:"inside_port,omitempty"`
+	// Outside IPv4 address (has to be from one of the NAT64 address pools).
+	OutsideIpv4Address string `protobuf:"bytes,4,opt,name=outside_ipv4_address,json=outsideIpv4Address,proto3" json:"outside_ipv4_address,omitempty"`
+	// Outside port number.
+	OutsidePort uint32 `protobuf:"varint,5,opt,name=outside_port,json=outsidePort,proto3" json:"outside_port,omitempty"`
+	// Protocol of the static mapping.
+	Protocol DNat44_Protocol `protobuf:"varint,6,opt,name=protocol,proto3,enum=ligato.vpp.nat.DNat44_Protocol" json:"protocol,omitempty"`
+}
+
+func (x *Nat64StaticBIB) Reset() {
+	*x = Nat64StaticBIB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Nat64StaticBIB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nat64StaticBIB) ProtoMessage() {}
+
+func (x *Nat64StaticBIB) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nat64StaticBIB.ProtoReflect.Descriptor instead.
+func (*Nat64StaticBIB) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Nat64StaticBIB) GetVrfId() uint32 {
+	if x != nil {
+		return x.VrfId
+	}
+	return 0
+}
+
+func (x *Nat64StaticBIB) GetInsideIpv6Address() string {
+	if x != nil {
+		return x.InsideIpv6Address
+	}
+	return ""
+}
+
+func (x *Nat64StaticBIB) GetInsidePort() uint32 {
+	if x != nil {
+		return x.InsidePort
+	}
+	return 0
+}
+
+func (x *Nat64StaticBIB) GetOutsideIpv4Address() string {
+	if x != nil {
+		return x.OutsideIpv4Address
+	}
+	return ""
+}
+
+func (x *Nat64StaticBIB) GetOutsidePort() uint32 {
+	if x != nil {
+		return x.OutsidePort
+	}
+	return 0
+}
+
+func (x *Nat64StaticBIB) GetProtocol() DNat44_Protocol {
+	if x != nil {
+		return x.Protocol
+	}
+	return DNat44_TCP
+}
+
+// Det44Mapping defines deterministic NAT44 (CGNAT) mapping between inside and outside
+// IPv4 prefixes. Each inside host is statically assigned an outside address and a range
+// of outside ports, which makes it possible to reconstruct the mapping from the
+// configuration alone (without logging of individual sessions).
+// Deterministic NAT is supported with VPP 20.09 and newer, where it is provided by the DET44
+// plugin. The plugin is enabled by ligato automatically with the first mapping.
+type Det44Mapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Inside IPv4 prefix in the <IPv4-Address>/<IPv4-Prefix> format.
+	InsidePrefix string `protobuf:"bytes,1,opt,name=inside_prefix,json=insidePrefix,proto3" json:"inside_prefix,omitempty"`
+	// Outside IPv4 prefix in the <IPv4-Address>/<IPv4-Prefix> format.
+	OutsidePrefix string `protobuf:"bytes,2,opt,name=outside_prefix,json=outsidePrefix,proto3" json:"outside_prefix,omitempty"`
+}
+
+func (x *Det44Mapping) Reset() {
+	*x = Det44Mapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Det44Mapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Det44Mapping) ProtoMessage() {}
+
+func (x *Det44Mapping) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Det44Mapping.ProtoReflect.Descriptor instead.
+func (*Det44Mapping) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_nat_nat_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Det44Mapping) GetInsidePrefix() string {
+	if x != nil {
+		return x.InsidePrefix
+	}
+	return ""
+}
+
+func (x *Det44Mapping) GetOutsidePrefix() string {
+	if x != nil {
+		return x.OutsidePrefix
+	}
+	return ""
+}
+
 // Interface defines a network interface enabled for NAT.
 type Nat44Global_Interface struct {
 	state         protoimpl.MessageState
@@ -557,7 +955,7 @@ type Nat44Global_Interface struct {
 func (x *Nat44Global_Interface) Reset() {
 	*x = Nat44Global_Interface{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[5]
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -570,7 +968,7 @@ func (x *Nat44Global_Interface) String() string {
 func (*Nat44Global_Interface) ProtoMessage() {}
 
 func (x *Nat44Global_Interface) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[5]
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +1023,7 @@ type Nat44Global_Address struct {
 func (x *Nat44Global_Address) Reset() {
 	*x = Nat44Global_Address{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[6]
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -638,7 +1036,7 @@ func (x *Nat44Global_Address) String() string {
 func (*Nat44Global_Address) ProtoMessage() {}
 
 func (x *Nat44Global_Address) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[6]
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,7 +1106,7 @@ type DNat44_StaticMapping struct {
 func (x *DNat44_StaticMapping) Reset() {
 	*x = DNat44_StaticMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[7]
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -721,7 +1119,7 @@ func (x *DNat44_StaticMapping) String() string {
 func (*DNat44_StaticMapping) ProtoMessage() {}
 
 func (x *DNat44_StaticMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[7]
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +1212,7 @@ type DNat44_IdentityMapping struct {
 func (x *DNat44_IdentityMapping) Reset() {
 	*x = DNat44_IdentityMapping{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[8]
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -827,7 +1225,7 @@ func (x *DNat44_IdentityMapping) String() string {
 func (*DNat44_IdentityMapping) ProtoMessage() {}
 
 func (x *DNat44_IdentityMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[8]
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1295,7 @@ type DNat44_StaticMapping_LocalIP struct {
 func (x *DNat44_StaticMapping_LocalIP) Reset() {
 	*x = DNat44_StaticMapping_LocalIP{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[9]
+		mi := &file_ligato_vpp_nat_nat_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -910,7 +1308,7 @@ func (x *DNat44_StaticMapping_LocalIP) String() string {
 func (*DNat44_StaticMapping_LocalIP) ProtoMessage() {}
 
 func (x *DNat44_StaticMapping_LocalIP) ProtoReflect() protoreflect.Message {
-	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[9]
+	mi := &file_ligato_vpp_nat_nat_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1091,11 +1489,57 @@ var file_ligato_vpp_nat_nat_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x46, 0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74,
 	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x6d, 0x65,
 	0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x64, 0x72, 0x6f, 0x70, 0x46,
-	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x6f, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6e,
-	0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x61, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x0f, 0x4e, 0x61, 0x74, 0x36,
+	0x34, 0x49, 0x50, 0x76, 0x36, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x15, 0x0a, 0x06, 0x76,
+	0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72, 0x66,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x06, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x22, 0x88, 0x01, 0x0a, 0x0e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x66, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x22, 0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x50, 0x56,
+	0x36, 0x5f, 0x49, 0x4e, 0x53, 0x49, 0x44, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x50,
+	0x56, 0x34, 0x5f, 0x4f, 0x55, 0x54, 0x53, 0x49, 0x44, 0x45, 0x10, 0x01, 0x22, 0x6b, 0x0a, 0x10,
+	0x4e, 0x61, 0x74, 0x36, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c,
+	0x12, 0x15, 0x0a, 0x06, 0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x76, 0x72, 0x66, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x02,
+	0x52, 0x07, 0x66, 0x69, 0x72, 0x73, 0x74, 0x49, 0x70, 0x12, 0x1e, 0x0a, 0x07, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08,
+	0x02, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x70, 0x22, 0xae, 0x02, 0x0a, 0x0e, 0x4e, 0x61,
+	0x74, 0x36, 0x34, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x42, 0x49, 0x42, 0x12, 0x15, 0x0a, 0x06,
+	0x76, 0x72, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x72,
+	0x66, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x13, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x69, 0x70,
+	0x76, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x03, 0x52, 0x11, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65, 0x49,
+	0x70, 0x76, 0x36, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x69,
+	0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x14, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x02, 0x52, 0x12, 0x6f, 0x75, 0x74,
+	0x73, 0x69, 0x64, 0x65, 0x49, 0x70, 0x76, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2c, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0x82, 0x7d, 0x06, 0x12, 0x04, 0x10, 0xff, 0xff, 0x03,
+	0x52, 0x0b, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74,
+	0x2e, 0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x68, 0x0a, 0x0c, 0x44, 0x65,
+	0x74, 0x34, 0x34, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x0d, 0x69, 0x6e,
+	0x73, 0x69, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x05, 0x82, 0x7d, 0x02, 0x08, 0x05, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x69, 0x64, 0x65,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2c, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64,
+	0x65, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05,
+	0x82, 0x7d, 0x02, 0x08, 0x05, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76,
+	0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x3b, 0x76, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1110,37 +1554,45 @@ func file_ligato_vpp_nat_nat_proto_rawDescGZIP() []byte {
 	return file_ligato_vpp_nat_nat_proto_rawDescData
 }
 
-var file_ligato_vpp_nat_nat_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_ligato_vpp_nat_nat_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ligato_vpp_nat_nat_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_ligato_vpp_nat_nat_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_ligato_vpp_nat_nat_proto_goTypes = []interface{}{
 	(DNat44_Protocol)(0),                   // 0: ligato.vpp.nat.DNat44.Protocol
 	(DNat44_StaticMapping_TwiceNatMode)(0), // 1: ligato.vpp.nat.DNat44.StaticMapping.TwiceNatMode
-	(*Nat44Global)(nil),                    // 2: ligato.vpp.nat.Nat44Global
-	(*DNat44)(nil),                         // 3: ligato.vpp.nat.DNat44
-	(*Nat44Interface)(nil),                 // 4: ligato.vpp.nat.Nat44Interface
-	(*Nat44AddressPool)(nil),               // 5: ligato.vpp.nat.Nat44AddressPool
-	(*VirtualReassembly)(nil),              // 6: ligato.vpp.nat.VirtualReassembly
-	(*Nat44Global_Interface)(nil),          // 7: ligato.vpp.nat.Nat44Global.Interface
-	(*Nat44Global_Address)(nil),            // 8: ligato.vpp.nat.Nat44Global.Address
-	(*DNat44_StaticMapping)(nil),           // 9: ligato.vpp.nat.DNat44.StaticMapping
-	(*DNat44_IdentityMapping)(nil),         // 10: ligato.vpp.nat.DNat44.IdentityMapping
-	(*DNat44_StaticMapping_LocalIP)(nil),   // 11: ligato.vpp.nat.DNat44.StaticMapping.LocalIP
+	(Nat64Interface_Type)(0),               // 2: ligato.vpp.nat.Nat64Interface.Type
+	(*Nat44Global)(nil),                    // 3: ligato.vpp.nat.Nat44Global
+	(*DNat44)(nil),                         // 4: ligato.vpp.nat.DNat44
+	(*Nat44Interface)(nil),                 // 5: ligato.vpp.nat.Nat44Interface
+	(*Nat44AddressPool)(nil),               // 6: ligato.vpp.nat.Nat44AddressPool
+	(*VirtualReassembly)(nil),              // 7: ligato.vpp.nat.VirtualReassembly
+	(*Nat64IPv6Prefix)(nil),                // 8: ligato.vpp.nat.Nat64IPv6Prefix
+	(*Nat64Interface)(nil),                 // 9: ligato.vpp.nat.Nat64Interface
+	(*Nat64AddressPool)(nil),               // 10: ligato.vpp.nat.Nat64AddressPool
+	(*Nat64StaticBIB)(nil),                 // 11: ligato.vpp.nat.Nat64StaticBIB
+	(*Det44Mapping)(nil),                   // 12: ligato.vpp.nat.Det44Mapping
+	(*Nat44Global_Interface)(nil),          // 13: ligato.vpp.nat.Nat44Global.Interface
+	(*Nat44Global_Address)(nil),            // 14: ligato.vpp.nat.Nat44Global.Address
+	(*DNat44_StaticMapping)(nil),           // 15: ligato.vpp.nat.DNat44.StaticMapping
+	(*DNat44_IdentityMapping)(nil),         // 16: ligato.vpp.nat.DNat44.IdentityMapping
+	(*DNat44_StaticMapping_LocalIP)(nil),   // 17: ligato.vpp.nat.DNat44.StaticMapping.LocalIP
 }
 var file_ligato_vpp_nat_nat_proto_depIdxs = []int32{
-	7,  // 0: ligato.vpp.nat.Nat44Global.nat_interfaces:type_name -> ligato.vpp.nat.Nat44Global.Interface
-	8,  // 1: ligato.vpp.nat.Nat44Global.address_pool:type_name -> ligato.vpp.nat.Nat44Global.Address
-	6,  // 2: ligato.vpp.nat.Nat44Global.virtual_reassembly:type_name -> ligato.vpp.nat.VirtualReassembly
-	9,  // 3: ligato.vpp.nat.DNat44.st_mappings:type_name -> ligato.vpp.nat.DNat44.StaticMapping
-	10, // 4: ligato.vpp.nat.DNat44.id_mappings:type_name -> ligato.vpp.nat.DNat44.IdentityMapping
-	11, // 5: ligato.vpp.nat.DNat44.StaticMapping.local_ips:type_name -> ligato.vpp.nat.DNat44.StaticMapping.LocalIP
-	0,  // 6: ligato.vpp.nat.DNat44.StaticMapping.protocol:type_name -> ligato.vpp.nat.DNat44.Protocol
-	1,  // 7: ligato.vpp.nat.DNat44.StaticMapping.twice_nat:type_name -> ligato.vpp.nat.DNat44.StaticMapping.TwiceNatMode
-	0,  // 8: ligato.vpp.nat.DNat44.IdentityMapping.protocol:type_name -> ligato.vpp.nat.DNat44.Protocol
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	13, // 0: ligato.vpp.nat.Nat44Global.nat_interfaces:type_name -> ligato.vpp.nat.Nat44Global.Interface
+	14, // 1: ligato.vpp.nat.Nat44Global.address_pool:type_name -> ligato.vpp.nat.Nat44Global.Address
+	7,  // 2: ligato.vpp.nat.Nat44Global.virtual_reassembly:type_name -> ligato.vpp.nat.VirtualReassembly
+	15, // 3: ligato.vpp.nat.DNat44.st_mappings:type_name -> ligato.vpp.nat.DNat44.StaticMapping
+	16, // 4: ligato.vpp.nat.DNat44.id_mappings:type_name -> ligato.vpp.nat.DNat44.IdentityMapping
+	2,  // 5: ligato.vpp.nat.Nat64Interface.type:type_name -> ligato.vpp.nat.Nat64Interface.Type
+	0,  // 6: ligato.vpp.nat.Nat64StaticBIB.protocol:type_name -> ligato.vpp.nat.DNat44.Protocol
+	17, // 7: ligato.vpp.nat.DNat44.StaticMapping.local_ips:type_name -> ligato.vpp.nat.DNat44.StaticMapping.LocalIP
+	0,  // 8: ligato.vpp.nat.DNat44.StaticMapping.protocol:type_name -> ligato.vpp.nat.DNat44.Protocol
+	1,  // 9: ligato.vpp.nat.DNat44.StaticMapping.twice_nat:type_name -> ligato.vpp.nat.DNat44.StaticMapping.TwiceNatMode
+	0,  // 10: ligato.vpp.nat.DNat44.IdentityMapping.protocol:type_name -> ligato.vpp.nat.DNat44.Protocol
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_nat_nat_proto_init() }
@@ -1210,7 +1662,7 @@ func file_ligato_vpp_nat_nat_proto_init() {
 			}
 		}
 		file_ligato_vpp_nat_nat_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Nat44Global_Interface); i {
+			switch v := v.(*Nat64IPv6Prefix); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1222,7 +1674,7 @@ func file_ligato_vpp_nat_nat_proto_init() {
 			}
 		}
 		file_ligato_vpp_nat_nat_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Nat44Global_Address); i {
+			switch v := v.(*Nat64Interface); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1234,7 +1686,7 @@ func file_ligato_vpp_nat_nat_proto_init() {
 			}
 		}
 		file_ligato_vpp_nat_nat_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNat44_StaticMapping); i {
+			switch v := v.(*Nat64AddressPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1246,7 +1698,7 @@ func file_ligato_vpp_nat_nat_proto_init() {
 			}
 		}
 		file_ligato_vpp_nat_nat_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNat44_IdentityMapping); i {
+			switch v := v.(*Nat64StaticBIB); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1258,6 +1710,66 @@ func file_ligato_vpp_nat_nat_proto_init() {
 			}
 		}
 		file_ligato_vpp_nat_nat_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Det44Mapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat44Global_Interface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Nat44Global_Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNat44_StaticMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNat44_IdentityMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_nat_nat_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DNat44_StaticMapping_LocalIP); i {
 			case 0:
 				return &v.state
@@ -1275,8 +1787,8 @@ func file_ligato_vpp_nat_nat_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_nat_nat_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   10,
+			NumEnums:      3,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -58,10 +58,16 @@ type ConfigData struct {
 	L3Xconnects            []*l3.L3XConnect                `protobuf:"bytes,45,rep,name=l3xconnects,proto3" json:"l3xconnects,omitempty"`
 	DhcpProxies            []*l3.DHCPProxy                 `protobuf:"bytes,46,rep,name=dhcp_proxies,json=dhcpProxies,proto3" json:"dhcp_proxies,omitempty"`
 	TeibEntries            []*l3.TeibEntry                 `protobuf:"bytes,47,rep,name=teib_entries,json=teibEntries,proto3" json:"teib_entries,omitempty"`
+	Mroutes                []*l3.MRoute                    `protobuf:"bytes,48,rep,name=mroutes,proto3" json:"mroutes,omitempty"`
 	Nat44Global            *nat.Nat44Global                `protobuf:"bytes,50,opt,name=nat44_global,json=nat44Global,proto3" json:"nat44_global,omitempty"`
 	Dnat44S                []*nat.DNat44                   `protobuf:"bytes,51,rep,name=dnat44s,proto3" json:"dnat44s,omitempty"`
 	Nat44Interfaces        []*nat.Nat44Interface           `protobuf:"bytes,52,rep,name=nat44_interfaces,json=nat44Interfaces,proto3" json:"nat44_interfaces,omitempty"`
 	Nat44Pools             []*nat.Nat44AddressPool         `protobuf:"bytes,53,rep,name=nat44_pools,json=nat44Pools,proto3" json:"nat44_pools,omitempty"`
+	Nat64Prefixes          []*nat.Nat64IPv6Prefix          `protobuf:"bytes,54,rep,name=nat64_prefixes,json=nat64Prefixes,proto3" json:"nat64_prefixes,omitempty"`
+	Nat64Interfaces        []*nat.Nat64Interface           `protobuf:"bytes,55,rep,name=nat64_interfaces,json=nat64Interfaces,proto3" json:"nat64_interfaces,omitempty"`
+	Nat64Pools             []*nat.Nat64AddressPool         `protobuf:"bytes,56,rep,name=nat64_pools,json=nat64Pools,proto3" json:"nat64_pools,omitempty"`
+	Nat64StaticBibs        []*nat.Nat64StaticBIB           `protobuf:"bytes,57,rep,name=nat64_static_bibs,json=nat64StaticBibs,proto3" json:"nat64_static_bibs,omitempty"`
+	Det44Mappings          []*nat.Det44Mapping             `protobuf:"bytes,58,rep,name=det44_mappings,json=det44Mappings,proto3" json:"det44_mappings,omitempty"`
 	IpsecSpds              []*ipsec.SecurityPolicyDatabase `protobuf:"bytes,60,rep,name=ipsec_spds,json=ipsecSpds,proto3" json:"ipsec_spds,omitempty"`
 	IpsecSas               []*ipsec.SecurityAssociation    `protobuf:"bytes,61,rep,name=ipsec_sas,json=ipsecSas,proto3" json:"ipsec_sas,omitempty"`
 	IpsecTunnelProtections []*ipsec.TunnelProtection       `protobuf:"bytes,62,rep,name=ipsec_tunnel_protections,json=ipsecTunnelProtections,proto3" json:"ipsec_tunnel_protections,omitempty"`
@@ -217,6 +223,13 @@ func (x *ConfigData) GetTeibEntries() []*l3.TeibEntry {
 	return nil
 }
 
+func (x *ConfigData) GetMroutes() []*l3.MRoute {
+	if x != nil {
+		return x.Mroutes
+	}
+	return nil
+}
+
 func (x *ConfigData) GetNat44Global() *nat.Nat44Global {
 	if x != nil {
 		return x.Nat44Global
@@ -245,6 +258,41 @@ func (x *ConfigData) GetNat44Pools() []*nat.Nat44AddressPool {
 	return nil
 }
 
+func (x *ConfigData) GetNat64Prefixes() []*nat.Nat64IPv6Prefix {
+	if x != nil {
+		return x.Nat64Prefixes
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Interfaces() []*nat.Nat64Interface {
+	if x != nil {
+		return x.Nat64Interfaces
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64Pools() []*nat.Nat64AddressPool {
+	if x != nil {
+		return x.Nat64Pools
+	}
+	return nil
+}
+
+func (x *ConfigData) GetNat64StaticBibs() []*nat.Nat64StaticBIB {
+	if x != nil {
+		return x.Nat64StaticBibs
+	}
+	return nil
+}
+
+func (x *ConfigData) GetDet44Mappings() []*nat.Det44Mapping {
+	if x != nil {
+		return x.Det44Mappings
+	}
+	return nil
+}
+
 func (x *ConfigData) GetIpsecSpds() []*ipsec.SecurityPolicyDatabase {
 	if x != nil {
 		return x.IpsecSpds
@@ -409,7 +457,13 @@ type Stats struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Interface *interfaces.InterfaceStats `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Interface      *interfaces.InterfaceStats `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Error          *ErrorStats                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Node           *NodeStats                 `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Buffer         *BufferStats               `protobuf:"bytes,4,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	System         *SystemStats               `protobuf:"bytes,5,opt,name=system,proto3" json:"system,omitempty"`
+	InterfaceRates *InterfaceRates            `protobuf:"bytes,6,opt,name=interface_rates,json=interfaceRates,proto3" json:"interface_rates,omitempty"`
+	NodeRates      *NodeRates                 `protobuf:"bytes,7,opt,name=node_rates,json=nodeRates,proto3" json:"node_rates,omitempty"`
 }
 
 func (x *Stats) Reset() {
@@ -451,6 +505,589 @@ func (x *Stats) GetInterface() *interfaces.InterfaceStats {
 	return nil
 }
 
+func (x *Stats) GetError() *ErrorStats {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *Stats) GetNode() *NodeStats {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *Stats) GetBuffer() *BufferStats {
+	if x != nil {
+		return x.Buffer
+	}
+	return nil
+}
+
+func (x *Stats) GetSystem() *SystemStats {
+	if x != nil {
+		return x.System
+	}
+	return nil
+}
+
+func (x *Stats) GetInterfaceRates() *InterfaceRates {
+	if x != nil {
+		return x.InterfaceRates
+	}
+	return nil
+}
+
+func (x *Stats) GetNodeRates() *NodeRates {
+	if x != nil {
+		return x.NodeRates
+	}
+	return nil
+}
+
+// ErrorStats is a VPP error counter of a graph node.
+type ErrorStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node   string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Value  uint64 `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ErrorStats) Reset() {
+	*x = ErrorStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_vpp_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorStats) ProtoMessage() {}
+
+func (x *ErrorStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_vpp_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorStats.ProtoReflect.Descriptor instead.
+func (*ErrorStats) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_vpp_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ErrorStats) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *ErrorStats) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ErrorStats) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// NodeStats are runtime counters of a VPP graph node.
+type NodeStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// ID of the thread (zero if the counters are summed over all threads).
+	ThreadId       uint32  `protobuf:"varint,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Calls          uint64  `protobuf:"varint,4,opt,name=calls,proto3" json:"calls,omitempty"`
+	Vectors        uint64  `protobuf:"varint,5,opt,name=vectors,proto3" json:"vectors,omitempty"`
+	Suspends       uint64  `protobuf:"varint,6,opt,name=suspends,proto3" json:"suspends,omitempty"`
+	Clocks         float64 `protobuf:"fixed64,7,opt,name=clocks,proto3" json:"clocks,omitempty"`
+	VectorsPerCall float64 `protobuf:"fixed64,8,opt,name=vectors_per_call,json=vectorsPerCall,proto3" json:"vectors_per_call,omitempty"`
+}
+
+func (x *NodeStats) Reset() {
+	*x = NodeStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_vpp_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStats) ProtoMessage() {}
+
+func (x *NodeStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_vpp_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStats.ProtoReflect.Descriptor instead.
+func (*NodeStats) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_vpp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *NodeStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeStats) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NodeStats) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *NodeStats) GetCalls() uint64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *NodeStats) GetVectors() uint64 {
+	if x != nil {
+		return x.Vectors
+	}
+	return 0
+}
+
+func (x *NodeStats) GetSuspends() uint64 {
+	if x != nil {
+		return x.Suspends
+	}
+	return 0
+}
+
+func (x *NodeStats) GetClocks() float64 {
+	if x != nil {
+		return x.Clocks
+	}
+	return 0
+}
+
+func (x *NodeStats) GetVectorsPerCall() float64 {
+	if x != nil {
+		return x.VectorsPerCall
+	}
+	return 0
+}
+
+// BufferStats are counters of a VPP buffer pool.
+type BufferStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Index    uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	ThreadId uint32 `protobuf:"varint,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Size     uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Alloc    uint64 `protobuf:"varint,5,opt,name=alloc,proto3" json:"alloc,omitempty"`
+	Free     uint64 `protobuf:"varint,6,opt,name=free,proto3" json:"free,omitempty"`
+	NumAlloc uint64 `protobuf:"varint,7,opt,name=num_alloc,json=numAlloc,proto3" json:"num_alloc,omitempty"`
+	NumFree  uint64 `protobuf:"varint,8,opt,name=num_free,json=numFree,proto3" json:"num_free,omitempty"`
+}
+
+func (x *BufferStats) Reset() {
+	*x = BufferStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_vpp_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufferStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferStats) ProtoMessage() {}
+
+func (x *BufferStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_vpp_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferStats.ProtoReflect.Descriptor instead.
+func (*BufferStats) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_vpp_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BufferStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BufferStats) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BufferStats) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *BufferStats) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BufferStats) GetAlloc() uint64 {
+	if x != nil {
+		return x.Alloc
+	}
+	return 0
+}
+
+func (x *BufferStats) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *BufferStats) GetNumAlloc() uint64 {
+	if x != nil {
+		return x.NumAlloc
+	}
+	return 0
+}
+
+func (x *BufferStats) GetNumFree() uint64 {
+	if x != nil {
+		return x.NumFree
+	}
+	return 0
+}
+
+// SystemStats are global VPP statistics.
+type SystemStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VectorRate          uint64   `protobuf:"varint,1,opt,name=vector_rate,json=vectorRate,proto3" json:"vector_rate,omitempty"`
+	NumWorkerThreads    uint64   `protobuf:"varint,2,opt,name=num_worker_threads,json=numWorkerThreads,proto3" json:"num_worker_threads,omitempty"`
+	VectorRatePerWorker []uint64 `protobuf:"varint,3,rep,packed,name=vector_rate_per_worker,json=vectorRatePerWorker,proto3" json:"vector_rate_per_worker,omitempty"`
+	InputRate           uint64   `protobuf:"varint,4,opt,name=input_rate,json=inputRate,proto3" json:"input_rate,omitempty"`
+	LastUpdate          uint64   `protobuf:"varint,5,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	LastStatsClear      uint64   `protobuf:"varint,6,opt,name=last_stats_clear,json=lastStatsClear,proto3" json:"last_stats_clear,omitempty"`
+	Heartbeat           uint64   `protobuf:"varint,7,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+}
+
+func (x *SystemStats) Reset() {
+	*x = SystemStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_vpp_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemStats) ProtoMessage() {}
+
+func (x *SystemStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_vpp_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemStats.ProtoReflect.Descriptor instead.
+func (*SystemStats) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_vpp_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SystemStats) GetVectorRate() uint64 {
+	if x != nil {
+		return x.VectorRate
+	}
+	return 0
+}
+
+func (x *SystemStats) GetNumWorkerThreads() uint64 {
+	if x != nil {
+		return x.NumWorkerThreads
+	}
+	return 0
+}
+
+func (x *SystemStats) GetVectorRatePerWorker() []uint64 {
+	if x != nil {
+		return x.VectorRatePerWorker
+	}
+	return nil
+}
+
+func (x *SystemStats) GetInputRate() uint64 {
+	if x != nil {
+		return x.InputRate
+	}
+	return 0
+}
+
+func (x *SystemStats) GetLastUpdate() uint64 {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return 0
+}
+
+func (x *SystemStats) GetLastStatsClear() uint64 {
+	if x != nil {
+		return x.LastStatsClear
+	}
+	return 0
+}
+
+func (x *SystemStats) GetHeartbeat() uint64 {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return 0
+}
+
+// InterfaceRates are rates of interface counters computed over a sliding window.
+type InterfaceRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Packets per second.
+	RxPps float64 `protobuf:"fixed64,3,opt,name=rx_pps,json=rxPps,proto3" json:"rx_pps,omitempty"`
+	TxPps float64 `protobuf:"fixed64,4,opt,name=tx_pps,json=txPps,proto3" json:"tx_pps,omitempty"`
+	// Bits per second.
+	RxBps float64 `protobuf:"fixed64,5,opt,name=rx_bps,json=rxBps,proto3" json:"rx_bps,omitempty"`
+	TxBps float64 `protobuf:"fixed64,6,opt,name=tx_bps,json=txBps,proto3" json:"tx_bps,omitempty"`
+	// Dropped packets per second.
+	DropRate float64 `protobuf:"fixed64,7,opt,name=drop_rate,json=dropRate,proto3" json:"drop_rate,omitempty"`
+}
+
+func (x *InterfaceRates) Reset() {
+	*x = InterfaceRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_vpp_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InterfaceRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceRates) ProtoMessage() {}
+
+func (x *InterfaceRates) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_vpp_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceRates.ProtoReflect.Descriptor instead.
+func (*InterfaceRates) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_vpp_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InterfaceRates) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InterfaceRates) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *InterfaceRates) GetRxPps() float64 {
+	if x != nil {
+		return x.RxPps
+	}
+	return 0
+}
+
+func (x *InterfaceRates) GetTxPps() float64 {
+	if x != nil {
+		return x.TxPps
+	}
+	return 0
+}
+
+func (x *InterfaceRates) GetRxBps() float64 {
+	if x != nil {
+		return x.RxBps
+	}
+	return 0
+}
+
+func (x *InterfaceRates) GetTxBps() float64 {
+	if x != nil {
+		return x.TxBps
+	}
+	return 0
+}
+
+func (x *InterfaceRates) GetDropRate() float64 {
+	if x != nil {
+		return x.DropRate
+	}
+	return 0
+}
+
+// NodeRates are rates of VPP graph node counters (summed over all threads)
+// computed over a sliding window.
+type NodeRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name          string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CallsPerSec   float64 `protobuf:"fixed64,2,opt,name=calls_per_sec,json=callsPerSec,proto3" json:"calls_per_sec,omitempty"`
+	VectorsPerSec float64 `protobuf:"fixed64,3,opt,name=vectors_per_sec,json=vectorsPerSec,proto3" json:"vectors_per_sec,omitempty"`
+	ErrorsPerSec  float64 `protobuf:"fixed64,4,opt,name=errors_per_sec,json=errorsPerSec,proto3" json:"errors_per_sec,omitempty"`
+}
+
+func (x *NodeRates) Reset() {
+	*x = NodeRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ligato_vpp_vpp_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRates) ProtoMessage() {}
+
+func (x *NodeRates) ProtoReflect() protoreflect.Message {
+	mi := &file_ligato_vpp_vpp_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeRates.ProtoReflect.Descriptor instead.
+func (*NodeRates) Descriptor() ([]byte, []int) {
+	return file_ligato_vpp_vpp_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NodeRates) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeRates) GetCallsPerSec() float64 {
+	if x != nil {
+		return x.CallsPerSec
+	}
+	return 0
+}
+
+func (x *NodeRates) GetVectorsPerSec() float64 {
+	if x != nil {
+		return x.VectorsPerSec
+	}
+	return 0
+}
+
+func (x *NodeRates) GetErrorsPerSec() float64 {
+	if x != nil {
+		return x.ErrorsPerSec
+	}
+	return 0
+}
+
 var File_ligato_vpp_vpp_proto protoreflect.FileDescriptor
 
 var file_ligato_vpp_vpp_proto_rawDesc = []byte{
@@ -484,170 +1121,287 @@ var file_ligato_vpp_vpp_proto_rawDesc = []byte{
 	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70,
 	0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6c, 0x33, 0x78, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x19, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x74, 0x65, 0x69, 0x62, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2f, 0x6c, 0x33, 0x2f, 0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f,
-	0x76, 0x70, 0x70, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x6d,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76,
+	0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f, 0x74, 0x65, 0x69, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x17, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6c, 0x33, 0x2f,
+	0x76, 0x72, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x6e, 0x61, 0x74, 0x2f, 0x6e, 0x61, 0x74, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f,
-	0x73, 0x72, 0x76, 0x36, 0x2f, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x24, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x77, 0x69, 0x72, 0x65,
-	0x67, 0x75, 0x61, 0x72, 0x64, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x11, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
-	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
-	0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65,
-	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x70,
-	0x61, 0x6e, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x63, 0x6c,
-	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63,
-	0x6c, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x62, 0x66, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x62,
-	0x66, 0x2e, 0x41, 0x42, 0x46, 0x52, 0x04, 0x61, 0x62, 0x66, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x1e, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x6c, 0x32, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
-	0x52, 0x0d, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12,
-	0x2b, 0x0a, 0x04, 0x66, 0x69, 0x62, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x46, 0x49,
-	0x42, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x66, 0x69, 0x62, 0x73, 0x12, 0x42, 0x0a, 0x0e,
-	0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x20,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
-	0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x58, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69,
-	0x72, 0x52, 0x0d, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73,
-	0x12, 0x2c, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33,
-	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b,
-	0x0a, 0x04, 0x61, 0x72, 0x70, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x41, 0x52, 0x50,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x61, 0x72, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x5f, 0x61, 0x72, 0x70, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x50,
-	0x72, 0x6f, 0x78, 0x79, 0x41, 0x52, 0x50, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x72,
-	0x70, 0x12, 0x46, 0x0a, 0x0f, 0x69, 0x70, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6e, 0x65, 0x69, 0x67,
-	0x68, 0x62, 0x6f, 0x72, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x49, 0x50, 0x53, 0x63, 0x61,
-	0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x0e, 0x69, 0x70, 0x73, 0x63, 0x61,
-	0x6e, 0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x76, 0x72, 0x66,
-	0x73, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x56, 0x72, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x52, 0x04, 0x76, 0x72, 0x66, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x73, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4c, 0x33, 0x58, 0x43,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x64, 0x68, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x78,
-	0x69, 0x65, 0x73, 0x18, 0x2e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x50, 0x72,
-	0x6f, 0x78, 0x79, 0x52, 0x0b, 0x64, 0x68, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73,
-	0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x65, 0x69, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x2f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x54, 0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0b, 0x74, 0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a,
-	0x0c, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x32, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
-	0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x52, 0x0b, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x30, 0x0a,
-	0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e,
-	0x44, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x52, 0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x12,
-	0x49, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-	0x63, 0x65, 0x73, 0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0f, 0x6e, 0x61, 0x74, 0x34, 0x34,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x6e, 0x61,
-	0x74, 0x34, 0x34, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74,
-	0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x47, 0x0a,
-	0x0a, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x70, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
-	0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x09, 0x69, 0x70, 0x73,
-	0x65, 0x63, 0x53, 0x70, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f,
-	0x73, 0x61, 0x73, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61,
-	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63,
-	0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x08, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x61, 0x73, 0x12, 0x5c, 0x0a, 0x18, 0x69, 0x70,
-	0x73, 0x65, 0x63, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x3e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e,
-	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x16, 0x69, 0x70, 0x73, 0x65, 0x63, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f,
-	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x09, 0x69, 0x70, 0x73, 0x65,
-	0x63, 0x5f, 0x73, 0x70, 0x73, 0x18, 0x3f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69,
-	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53,
-	0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x69,
-	0x70, 0x73, 0x65, 0x63, 0x53, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x70, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0x46, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70,
-	0x75, 0x6e, 0x74, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x0f,
-	0x70, 0x75, 0x6e, 0x74, 0x49, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
-	0x3a, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18,
-	0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x0b,
-	0x70, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x70,
-	0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x48,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
-	0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0e, 0x70, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18,
-	0x53, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x52, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62,
-	0x61, 0x6c, 0x52, 0x0a, 0x73, 0x72, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x40,
-	0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x69, 0x64, 0x73,
-	0x18, 0x50, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x49,
-	0x44, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x73, 0x69, 0x64, 0x73,
-	0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x18, 0x51, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
-	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x0c, 0x73, 0x72, 0x76, 0x36, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x40,
-	0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x18, 0x52, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e,
-	0x67, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x53, 0x74, 0x65, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x73,
-	0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x49, 0x50, 0x46, 0x49, 0x58, 0x52,
-	0x0b, 0x69, 0x70, 0x66, 0x69, 0x78, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x57, 0x0a, 0x16,
-	0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c,
-	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e,
-	0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x14, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66,
-	0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x5c, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66,
-	0x69, 0x78, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x46, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x0f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x67, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73,
-	0x18, 0x5d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
-	0x76, 0x70, 0x70, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x50, 0x65,
-	0x65, 0x72, 0x52, 0x07, 0x77, 0x67, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x64,
-	0x6e, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x64, 0x6e, 0x73, 0x2e,
-	0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x43, 0x61, 0x63,
-	0x68, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
-	0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x4c,
-	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x67,
-	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x42, 0x2c, 0x5a, 0x2a,
-	0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70, 0x70,
-	0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x70, 0x75, 0x6e, 0x74, 0x2f, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1a, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x73, 0x72, 0x76, 0x36,
+	0x2f, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2f, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa2, 0x14, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x40, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x05,
+	0x73, 0x70, 0x61, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x14, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x61, 0x63, 0x6c, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x27,
+	0x0a, 0x04, 0x61, 0x62, 0x66, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x61, 0x62, 0x66, 0x2e, 0x41, 0x42,
+	0x46, 0x52, 0x04, 0x61, 0x62, 0x66, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x62, 0x72, 0x69, 0x64, 0x67,
+	0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e,
+	0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x52, 0x0d, 0x62, 0x72,
+	0x69, 0x64, 0x67, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x66,
+	0x69, 0x62, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32, 0x2e, 0x46, 0x49, 0x42, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x66, 0x69, 0x62, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x78, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x32,
+	0x2e, 0x58, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0d, 0x78,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x61, 0x72,
+	0x70, 0x73, 0x18, 0x29, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x41, 0x52, 0x50, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x61, 0x72, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x5f, 0x61, 0x72, 0x70, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x41, 0x52, 0x50, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x72, 0x70, 0x12, 0x46, 0x0a,
+	0x0f, 0x69, 0x70, 0x73, 0x63, 0x61, 0x6e, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x49, 0x50, 0x53, 0x63, 0x61, 0x6e, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x52, 0x0e, 0x69, 0x70, 0x73, 0x63, 0x61, 0x6e, 0x4e, 0x65, 0x69,
+	0x67, 0x68, 0x62, 0x6f, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x76, 0x72, 0x66, 0x73, 0x18, 0x2c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x6c, 0x33, 0x2e, 0x56, 0x72, 0x66, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x04, 0x76, 0x72,
+	0x66, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x73, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f,
+	0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4c, 0x33, 0x58, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x52, 0x0b, 0x6c, 0x33, 0x78, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x73, 0x12,
+	0x3b, 0x0a, 0x0c, 0x64, 0x68, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18,
+	0x2e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x44, 0x48, 0x43, 0x50, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52,
+	0x0b, 0x64, 0x68, 0x63, 0x70, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c,
+	0x74, 0x65, 0x69, 0x62, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x2f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x6c, 0x33, 0x2e, 0x54, 0x65, 0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x74, 0x65,
+	0x69, 0x62, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x18, 0x30, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6c, 0x33, 0x2e, 0x4d, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x07, 0x6d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x61,
+	0x74, 0x34, 0x34, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61,
+	0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x0b, 0x6e,
+	0x61, 0x74, 0x34, 0x34, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x6e,
+	0x61, 0x74, 0x34, 0x34, 0x73, 0x18, 0x33, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x4e, 0x61,
+	0x74, 0x34, 0x34, 0x52, 0x07, 0x64, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x73, 0x12, 0x49, 0x0a, 0x10,
+	0x6e, 0x61, 0x74, 0x34, 0x34, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x34, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e,
+	0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x34, 0x34, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0f, 0x6e, 0x61, 0x74, 0x34, 0x34, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x34, 0x34,
+	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61,
+	0x74, 0x34, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0a,
+	0x6e, 0x61, 0x74, 0x34, 0x34, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x46, 0x0a, 0x0e, 0x6e, 0x61,
+	0x74, 0x36, 0x34, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x36, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x49, 0x50, 0x76, 0x36, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x52, 0x0d, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x65, 0x73, 0x12, 0x49, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x37, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c,
+	0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61,
+	0x74, 0x36, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x0f, 0x6e, 0x61,
+	0x74, 0x36, 0x34, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12, 0x41, 0x0a,
+	0x0b, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x38, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74, 0x36, 0x34, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x50, 0x6f, 0x6f, 0x6c, 0x73,
+	0x12, 0x4a, 0x0a, 0x11, 0x6e, 0x61, 0x74, 0x36, 0x34, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x5f, 0x62, 0x69, 0x62, 0x73, 0x18, 0x39, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x74,
+	0x36, 0x34, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x42, 0x49, 0x42, 0x52, 0x0f, 0x6e, 0x61, 0x74,
+	0x36, 0x34, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x42, 0x69, 0x62, 0x73, 0x12, 0x43, 0x0a, 0x0e,
+	0x64, 0x65, 0x74, 0x34, 0x34, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x3a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70,
+	0x70, 0x2e, 0x6e, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x74, 0x34, 0x34, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x0d, 0x64, 0x65, 0x74, 0x34, 0x34, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x70, 0x64, 0x73, 0x18,
+	0x3c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x09, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x70, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x5f, 0x73, 0x61, 0x73, 0x18, 0x3d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73, 0x65, 0x63,
+	0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x41, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x61, 0x73, 0x12, 0x5c,
+	0x0a, 0x18, 0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x3e, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70,
+	0x73, 0x65, 0x63, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x69, 0x70, 0x73, 0x65, 0x63, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a, 0x09,
+	0x69, 0x70, 0x73, 0x65, 0x63, 0x5f, 0x73, 0x70, 0x73, 0x18, 0x3f, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x73,
+	0x65, 0x63, 0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x08, 0x69, 0x70, 0x73, 0x65, 0x63, 0x53, 0x70, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x70,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18,
+	0x46, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x49, 0x50, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x52, 0x0f, 0x70, 0x75, 0x6e, 0x74, 0x49, 0x70, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x47, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x54, 0x6f, 0x48, 0x6f,
+	0x73, 0x74, 0x52, 0x0b, 0x70, 0x75, 0x6e, 0x74, 0x54, 0x6f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x12,
+	0x43, 0x0a, 0x0f, 0x70, 0x75, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x48, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74,
+	0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x70, 0x75, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x70, 0x75, 0x6e, 0x74, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x67, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x52, 0x76, 0x36,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x0a, 0x73, 0x72, 0x76, 0x36, 0x47, 0x6c, 0x6f, 0x62,
+	0x61, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x73, 0x69, 0x64, 0x73, 0x18, 0x50, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x53, 0x49, 0x44, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x73, 0x69, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x51, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x73, 0x72, 0x76, 0x36, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x72, 0x76, 0x36, 0x5f, 0x73, 0x74, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x52, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x73, 0x72, 0x76, 0x36, 0x2e, 0x53, 0x74, 0x65,
+	0x65, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x73, 0x72, 0x76, 0x36, 0x53, 0x74, 0x65, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x67, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x49, 0x50,
+	0x46, 0x49, 0x58, 0x52, 0x0b, 0x69, 0x70, 0x66, 0x69, 0x78, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x12, 0x57, 0x0a, 0x16, 0x69, 0x70, 0x66, 0x69, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x70,
+	0x66, 0x69, 0x78, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x14, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c, 0x6f, 0x77, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x70, 0x66,
+	0x69, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0x5c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70,
+	0x2e, 0x69, 0x70, 0x66, 0x69, 0x78, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0f, 0x69, 0x70, 0x66, 0x69, 0x78, 0x46, 0x6c,
+	0x6f, 0x77, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x77, 0x67, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x5d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x07, 0x77, 0x67, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x35, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e,
+	0x64, 0x6e, 0x73, 0x2e, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x08, 0x64, 0x6e,
+	0x73, 0x43, 0x61, 0x63, 0x68, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6c, 0x69, 0x67, 0x61,
+	0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x22, 0x82, 0x03, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x12, 0x2c, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x29, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x67,
+	0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69,
+	0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76, 0x70, 0x70, 0x2e, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x43, 0x0a, 0x0f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x76,
+	0x70, 0x70, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x52, 0x09, 0x6e, 0x6f,
+	0x64, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x22, 0x4e, 0x0a, 0x0a, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x63, 0x61, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x6c, 0x6c, 0x22, 0xca, 0x01, 0x0a, 0x0b, 0x42,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x75, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x6e, 0x75, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x22, 0x99, 0x02, 0x0a, 0x0b, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x75, 0x6d, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x13, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x22, 0xb3, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x15, 0x0a, 0x06, 0x72, 0x78, 0x5f, 0x70, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x72, 0x78, 0x50, 0x70, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x70, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x78, 0x50, 0x70, 0x73, 0x12, 0x15,
+	0x0a, 0x06, 0x72, 0x78, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x72, 0x78, 0x42, 0x70, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x78, 0x5f, 0x62, 0x70, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x78, 0x42, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x72, 0x6f, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x64, 0x72, 0x6f, 0x70, 0x52, 0x61, 0x74, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x09, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12,
+	0x26, 0x0a, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x42, 0x2c, 0x5a,
+	0x2a, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x70,
+	0x70, 0x2d, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6c, 0x69, 0x67, 0x61, 0x74, 0x6f, 0x2f, 0x76, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -662,92 +1416,116 @@ func file_ligato_vpp_vpp_proto_rawDescGZIP() []byte {
 	return file_ligato_vpp_vpp_proto_rawDescData
 }
 
-var file_ligato_vpp_vpp_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ligato_vpp_vpp_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_ligato_vpp_vpp_proto_goTypes = []interface{}{
 	(*ConfigData)(nil),                       // 0: ligato.vpp.ConfigData
 	(*Notification)(nil),                     // 1: ligato.vpp.Notification
 	(*Stats)(nil),                            // 2: ligato.vpp.Stats
-	(*interfaces.Interface)(nil),             // 3: ligato.vpp.interfaces.Interface
-	(*interfaces.Span)(nil),                  // 4: ligato.vpp.interfaces.Span
-	(*acl.ACL)(nil),                          // 5: ligato.vpp.acl.ACL
-	(*abf.ABF)(nil),                          // 6: ligato.vpp.abf.ABF
-	(*l2.BridgeDomain)(nil),                  // 7: ligato.vpp.l2.BridgeDomain
-	(*l2.FIBEntry)(nil),                      // 8: ligato.vpp.l2.FIBEntry
-	(*l2.XConnectPair)(nil),                  // 9: ligato.vpp.l2.XConnectPair
-	(*l3.Route)(nil),                         // 10: ligato.vpp.l3.Route
-	(*l3.ARPEntry)(nil),                      // 11: ligato.vpp.l3.ARPEntry
-	(*l3.ProxyARP)(nil),                      // 12: ligato.vpp.l3.ProxyARP
-	(*l3.IPScanNeighbor)(nil),                // 13: ligato.vpp.l3.IPScanNeighbor
-	(*l3.VrfTable)(nil),                      // 14: ligato.vpp.l3.VrfTable
-	(*l3.L3XConnect)(nil),                    // 15: ligato.vpp.l3.L3XConnect
-	(*l3.DHCPProxy)(nil),                     // 16: ligato.vpp.l3.DHCPProxy
-	(*l3.TeibEntry)(nil),                     // 17: ligato.vpp.l3.TeibEntry
-	(*nat.Nat44Global)(nil),                  // 18: ligato.vpp.nat.Nat44Global
-	(*nat.DNat44)(nil),                       // 19: ligato.vpp.nat.DNat44
-	(*nat.Nat44Interface)(nil),               // 20: ligato.vpp.nat.Nat44Interface
-	(*nat.Nat44AddressPool)(nil),             // 21: ligato.vpp.nat.Nat44AddressPool
-	(*ipsec.SecurityPolicyDatabase)(nil),     // 22: ligato.vpp.ipsec.SecurityPolicyDatabase
-	(*ipsec.SecurityAssociation)(nil),        // 23: ligato.vpp.ipsec.SecurityAssociation
-	(*ipsec.TunnelProtection)(nil),           // 24: ligato.vpp.ipsec.TunnelProtection
-	(*ipsec.SecurityPolicy)(nil),             // 25: ligato.vpp.ipsec.SecurityPolicy
-	(*punt.IPRedirect)(nil),                  // 26: ligato.vpp.punt.IPRedirect
-	(*punt.ToHost)(nil),                      // 27: ligato.vpp.punt.ToHost
-	(*punt.Exception)(nil),                   // 28: ligato.vpp.punt.Exception
-	(*srv6.SRv6Global)(nil),                  // 29: ligato.vpp.srv6.SRv6Global
-	(*srv6.LocalSID)(nil),                    // 30: ligato.vpp.srv6.LocalSID
-	(*srv6.Policy)(nil),                      // 31: ligato.vpp.srv6.Policy
-	(*srv6.Steering)(nil),                    // 32: ligato.vpp.srv6.Steering
-	(*ipfix.IPFIX)(nil),                      // 33: ligato.vpp.ipfix.IPFIX
-	(*ipfix.FlowProbeParams)(nil),            // 34: ligato.vpp.ipfix.FlowProbeParams
-	(*ipfix.FlowProbeFeature)(nil),           // 35: ligato.vpp.ipfix.FlowProbeFeature
-	(*wireguard.Peer)(nil),                   // 36: ligato.vpp.wireguard.Peer
-	(*dns.DNSCache)(nil),                     // 37: ligato.vpp.dns.DNSCache
-	(*interfaces.InterfaceNotification)(nil), // 38: ligato.vpp.interfaces.InterfaceNotification
-	(*interfaces.InterfaceStats)(nil),        // 39: ligato.vpp.interfaces.InterfaceStats
+	(*ErrorStats)(nil),                       // 3: ligato.vpp.ErrorStats
+	(*NodeStats)(nil),                        // 4: ligato.vpp.NodeStats
+	(*BufferStats)(nil),                      // 5: ligato.vpp.BufferStats
+	(*SystemStats)(nil),                      // 6: ligato.vpp.SystemStats
+	(*InterfaceRates)(nil),                   // 7: ligato.vpp.InterfaceRates
+	(*NodeRates)(nil),                        // 8: ligato.vpp.NodeRates
+	(*interfaces.Interface)(nil),             // 9: ligato.vpp.interfaces.Interface
+	(*interfaces.Span)(nil),                  // 10: ligato.vpp.interfaces.Span
+	(*acl.ACL)(nil),                          // 11: ligato.vpp.acl.ACL
+	(*abf.ABF)(nil),                          // 12: ligato.vpp.abf.ABF
+	(*l2.BridgeDomain)(nil),                  // 13: ligato.vpp.l2.BridgeDomain
+	(*l2.FIBEntry)(nil),                      // 14: ligato.vpp.l2.FIBEntry
+	(*l2.XConnectPair)(nil),                  // 15: ligato.vpp.l2.XConnectPair
+	(*l3.Route)(nil),                         // 16: ligato.vpp.l3.Route
+	(*l3.ARPEntry)(nil),                      // 17: ligato.vpp.l3.ARPEntry
+	(*l3.ProxyARP)(nil),                      // 18: ligato.vpp.l3.ProxyARP
+	(*l3.IPScanNeighbor)(nil),                // 19: ligato.vpp.l3.IPScanNeighbor
+	(*l3.VrfTable)(nil),                      // 20: ligato.vpp.l3.VrfTable
+	(*l3.L3XConnect)(nil),                    // 21: ligato.vpp.l3.L3XConnect
+	(*l3.DHCPProxy)(nil),                     // 22: ligato.vpp.l3.DHCPProxy
+	(*l3.TeibEntry)(nil),                     // 23: ligato.vpp.l3.TeibEntry
+	(*l3.MRoute)(nil),                        // 24: ligato.vpp.l3.MRoute
+	(*nat.Nat44Global)(nil),                  // 25: ligato.vpp.nat.Nat44Global
+	(*nat.DNat44)(nil),                       // 26: ligato.vpp.nat.DNat44
+	(*nat.Nat44Interface)(nil),               // 27: ligato.vpp.nat.Nat44Interface
+	(*nat.Nat44AddressPool)(nil),             // 28: ligato.vpp.nat.Nat44AddressPool
+	(*nat.Nat64IPv6Prefix)(nil),              // 29: ligato.vpp.nat.Nat64IPv6Prefix
+	(*nat.Nat64Interface)(nil),               // 30: ligato.vpp.nat.Nat64Interface
+	(*nat.Nat64AddressPool)(nil),             // 31: ligato.vpp.nat.Nat64AddressPool
+	(*nat.Nat64StaticBIB)(nil),               // 32: ligato.vpp.nat.Nat64StaticBIB
+	(*nat.Det44Mapping)(nil),                 // 33: ligato.vpp.nat.Det44Mapping
+	(*ipsec.SecurityPolicyDatabase)(nil),     // 34: ligato.vpp.ipsec.SecurityPolicyDatabase
+	(*ipsec.SecurityAssociation)(nil),        // 35: ligato.vpp.ipsec.SecurityAssociation
+	(*ipsec.TunnelProtection)(nil),           // 36: ligato.vpp.ipsec.TunnelProtection
+	(*ipsec.SecurityPolicy)(nil),             // 37: ligato.vpp.ipsec.SecurityPolicy
+	(*punt.IPRedirect)(nil),                  // 38: ligato.vpp.punt.IPRedirect
+	(*punt.ToHost)(nil),                      // 39: ligato.vpp.punt.ToHost
+	(*punt.Exception)(nil),                   // 40: ligato.vpp.punt.Exception
+	(*srv6.SRv6Global)(nil),                  // 41: ligato.vpp.srv6.SRv6Global
+	(*srv6.LocalSID)(nil),                    // 42: ligato.vpp.srv6.LocalSID
+	(*srv6.Policy)(nil),                      // 43: ligato.vpp.srv6.Policy
+	(*srv6.Steering)(nil),                    // 44: ligato.vpp.srv6.Steering
+	(*ipfix.IPFIX)(nil),                      // 45: ligato.vpp.ipfix.IPFIX
+	(*ipfix.FlowProbeParams)(nil),            // 46: ligato.vpp.ipfix.FlowProbeParams
+	(*ipfix.FlowProbeFeature)(nil),           // 47: ligato.vpp.ipfix.FlowProbeFeature
+	(*wireguard.Peer)(nil),                   // 48: ligato.vpp.wireguard.Peer
+	(*dns.DNSCache)(nil),                     // 49: ligato.vpp.dns.DNSCache
+	(*interfaces.InterfaceNotification)(nil), // 50: ligato.vpp.interfaces.InterfaceNotification
+	(*interfaces.InterfaceStats)(nil),        // 51: ligato.vpp.interfaces.InterfaceStats
 }
 var file_ligato_vpp_vpp_proto_depIdxs = []int32{
-	3,  // 0: ligato.vpp.ConfigData.interfaces:type_name -> ligato.vpp.interfaces.Interface
-	4,  // 1: ligato.vpp.ConfigData.spans:type_name -> ligato.vpp.interfaces.Span
-	5,  // 2: ligato.vpp.ConfigData.acls:type_name -> ligato.vpp.acl.ACL
-	6,  // 3: ligato.vpp.ConfigData.abfs:type_name -> ligato.vpp.abf.ABF
-	7,  // 4: ligato.vpp.ConfigData.bridge_domains:type_name -> ligato.vpp.l2.BridgeDomain
-	8,  // 5: ligato.vpp.ConfigData.fibs:type_name -> ligato.vpp.l2.FIBEntry
-	9,  // 6: ligato.vpp.ConfigData.xconnect_pairs:type_name -> ligato.vpp.l2.XConnectPair
-	10, // 7: ligato.vpp.ConfigData.routes:type_name -> ligato.vpp.l3.Route
-	11, // 8: ligato.vpp.ConfigData.arps:type_name -> ligato.vpp.l3.ARPEntry
-	12, // 9: ligato.vpp.ConfigData.proxy_arp:type_name -> ligato.vpp.l3.ProxyARP
-	13, // 10: ligato.vpp.ConfigData.ipscan_neighbor:type_name -> ligato.vpp.l3.IPScanNeighbor
-	14, // 11: ligato.vpp.ConfigData.vrfs:type_name -> ligato.vpp.l3.VrfTable
-	15, // 12: ligato.vpp.ConfigData.l3xconnects:type_name -> ligato.vpp.l3.L3XConnect
-	16, // 13: ligato.vpp.ConfigData.dhcp_proxies:type_name -> ligato.vpp.l3.DHCPProxy
-	17, // 14: ligato.vpp.ConfigData.teib_entries:type_name -> ligato.vpp.l3.TeibEntry
-	18, // 15: ligato.vpp.ConfigData.nat44_global:type_name -> ligato.vpp.nat.Nat44Global
-	19, // 16: ligato.vpp.ConfigData.dnat44s:type_name -> ligato.vpp.nat.DNat44
-	20, // 17: ligato.vpp.ConfigData.nat44_interfaces:type_name -> ligato.vpp.nat.Nat44Interface
-	21, // 18: ligato.vpp.ConfigData.nat44_pools:type_name -> ligato.vpp.nat.Nat44AddressPool
-	22, // 19: ligato.vpp.ConfigData.ipsec_spds:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase
-	23, // 20: ligato.vpp.ConfigData.ipsec_sas:type_name -> ligato.vpp.ipsec.SecurityAssociation
-	24, // 21: ligato.vpp.ConfigData.ipsec_tunnel_protections:type_name -> ligato.vpp.ipsec.TunnelProtection
-	25, // 22: ligato.vpp.ConfigData.ipsec_sps:type_name -> ligato.vpp.ipsec.SecurityPolicy
-	26, // 23: ligato.vpp.ConfigData.punt_ipredirects:type_name -> ligato.vpp.punt.IPRedirect
-	27, // 24: ligato.vpp.ConfigData.punt_tohosts:type_name -> ligato.vpp.punt.ToHost
-	28, // 25: ligato.vpp.ConfigData.punt_exceptions:type_name -> ligato.vpp.punt.Exception
-	29, // 26: ligato.vpp.ConfigData.srv6_global:type_name -> ligato.vpp.srv6.SRv6Global
-	30, // 27: ligato.vpp.ConfigData.srv6_localsids:type_name -> ligato.vpp.srv6.LocalSID
-	31, // 28: ligato.vpp.ConfigData.srv6_policies:type_name -> ligato.vpp.srv6.Policy
-	32, // 29: ligato.vpp.ConfigData.srv6_steerings:type_name -> ligato.vpp.srv6.Steering
-	33, // 30: ligato.vpp.ConfigData.ipfix_global:type_name -> ligato.vpp.ipfix.IPFIX
-	34, // 31: ligato.vpp.ConfigData.ipfix_flowprobe_params:type_name -> ligato.vpp.ipfix.FlowProbeParams
-	35, // 32: ligato.vpp.ConfigData.ipfix_flowprobes:type_name -> ligato.vpp.ipfix.FlowProbeFeature
-	36, // 33: ligato.vpp.ConfigData.wg_peers:type_name -> ligato.vpp.wireguard.Peer
-	37, // 34: ligato.vpp.ConfigData.dns_cache:type_name -> ligato.vpp.dns.DNSCache
-	38, // 35: ligato.vpp.Notification.interface:type_name -> ligato.vpp.interfaces.InterfaceNotification
-	39, // 36: ligato.vpp.Stats.interface:type_name -> ligato.vpp.interfaces.InterfaceStats
-	37, // [37:37] is the sub-list for method output_type
-	37, // [37:37] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	9,  // 0: ligato.vpp.ConfigData.interfaces:type_name -> ligato.vpp.interfaces.Interface
+	10, // 1: ligato.vpp.ConfigData.spans:type_name -> ligato.vpp.interfaces.Span
+	11, // 2: ligato.vpp.ConfigData.acls:type_name -> ligato.vpp.acl.ACL
+	12, // 3: ligato.vpp.ConfigData.abfs:type_name -> ligato.vpp.abf.ABF
+	13, // 4: ligato.vpp.ConfigData.bridge_domains:type_name -> ligato.vpp.l2.BridgeDomain
+	14, // 5: ligato.vpp.ConfigData.fibs:type_name -> ligato.vpp.l2.FIBEntry
+	15, // 6: ligato.vpp.ConfigData.xconnect_pairs:type_name -> ligato.vpp.l2.XConnectPair
+	16, // 7: ligato.vpp.ConfigData.routes:type_name -> ligato.vpp.l3.Route
+	17, // 8: ligato.vpp.ConfigData.arps:type_name -> ligato.vpp.l3.ARPEntry
+	18, // 9: ligato.vpp.ConfigData.proxy_arp:type_name -> ligato.vpp.l3.ProxyARP
+	19, // 10: ligato.vpp.ConfigData.ipscan_neighbor:type_name -> ligato.vpp.l3.IPScanNeighbor
+	20, // 11: ligato.vpp.ConfigData.vrfs:type_name -> ligato.vpp.l3.VrfTable
+	21, // 12: ligato.vpp.ConfigData.l3xconnects:type_name -> ligato.vpp.l3.L3XConnect
+	22, // 13: ligato.vpp.ConfigData.dhcp_proxies:type_name -> ligato.vpp.l3.DHCPProxy
+	23, // 14: ligato.vpp.ConfigData.teib_entries:type_name -> ligato.vpp.l3.TeibEntry
+	24, // 15: ligato.vpp.ConfigData.mroutes:type_name -> ligato.vpp.l3.MRoute
+	25, // 16: ligato.vpp.ConfigData.nat44_global:type_name -> ligato.vpp.nat.Nat44Global
+	26, // 17: ligato.vpp.ConfigData.dnat44s:type_name -> ligato.vpp.nat.DNat44
+	27, // 18: ligato.vpp.ConfigData.nat44_interfaces:type_name -> ligato.vpp.nat.Nat44Interface
+	28, // 19: ligato.vpp.ConfigData.nat44_pools:type_name -> ligato.vpp.nat.Nat44AddressPool
+	29, // 20: ligato.vpp.ConfigData.nat64_prefixes:type_name -> ligato.vpp.nat.Nat64IPv6Prefix
+	30, // 21: ligato.vpp.ConfigData.nat64_interfaces:type_name -> ligato.vpp.nat.Nat64Interface
+	31, // 22: ligato.vpp.ConfigData.nat64_pools:type_name -> ligato.vpp.nat.Nat64AddressPool
+	32, // 23: ligato.vpp.ConfigData.nat64_static_bibs:type_name -> ligato.vpp.nat.Nat64StaticBIB
+	33, // 24: ligato.vpp.ConfigData.det44_mappings:type_name -> ligato.vpp.nat.Det44Mapping
+	34, // 25: ligato.vpp.ConfigData.ipsec_spds:type_name -> ligato.vpp.ipsec.SecurityPolicyDatabase
+	35, // 26: ligato.vpp.ConfigData.ipsec_sas:type_name -> ligato.vpp.ipsec.SecurityAssociation
+	36, // 27: ligato.vpp.ConfigData.ipsec_tunnel_protections:type_name -> ligato.vpp.ipsec.TunnelProtection
+	37, // 28: ligato.vpp.ConfigData.ipsec_sps:type_name -> ligato.vpp.ipsec.SecurityPolicy
+	38, // 29: ligato.vpp.ConfigData.punt_ipredirects:type_name -> ligato.vpp.punt.IPRedirect
+	39, // 30: ligato.vpp.ConfigData.punt_tohosts:type_name -> ligato.vpp.punt.ToHost
+	40, // 31: ligato.vpp.ConfigData.punt_exceptions:type_name -> ligato.vpp.punt.Exception
+	41, // 32: ligato.vpp.ConfigData.srv6_global:type_name -> ligato.vpp.srv6.SRv6Global
+	42, // 33: ligato.vpp.ConfigData.srv6_localsids:type_name -> ligato.vpp.srv6.LocalSID
+	43, // 34: ligato.vpp.ConfigData.srv6_policies:type_name -> ligato.vpp.srv6.Policy
+	44, // 35: ligato.vpp.ConfigData.srv6_steerings:type_name -> ligato.vpp.srv6.Steering
+	45, // 36: ligato.vpp.ConfigData.ipfix_global:type_name -> ligato.vpp.ipfix.IPFIX
+	46, // 37: ligato.vpp.ConfigData.ipfix_flowprobe_params:type_name -> ligato.vpp.ipfix.FlowProbeParams
+	47, // 38: ligato.vpp.ConfigData.ipfix_flowprobes:type_name -> ligato.vpp.ipfix.FlowProbeFeature
+	48, // 39: ligato.vpp.ConfigData.wg_peers:type_name -> ligato.vpp.wireguard.Peer
+	49, // 40: ligato.vpp.ConfigData.dns_cache:type_name -> ligato.vpp.dns.DNSCache
+	50, // 41: ligato.vpp.Notification.interface:type_name -> ligato.vpp.interfaces.InterfaceNotification
+	51, // 42: ligato.vpp.Stats.interface:type_name -> ligato.vpp.interfaces.InterfaceStats
+	3,  // 43: ligato.vpp.Stats.error:type_name -> ligato.vpp.ErrorStats
+	4,  // 44: ligato.vpp.Stats.node:type_name -> ligato.vpp.NodeStats
+	5,  // 45: ligato.vpp.Stats.buffer:type_name -> ligato.vpp.BufferStats
+	6,  // 46: ligato.vpp.Stats.system:type_name -> ligato.vpp.SystemStats
+	7,  // 47: ligato.vpp.Stats.interface_rates:type_name -> ligato.vpp.InterfaceRates
+	8,  // 48: ligato.vpp.Stats.node_rates:type_name -> ligato.vpp.NodeRates
+	49, // [49:49] is the sub-list for method output_type
+	49, // [49:49] is the sub-list for method input_type
+	49, // [49:49] is the sub-list for extension type_name
+	49, // [49:49] is the sub-list for extension extendee
+	0,  // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_ligato_vpp_vpp_proto_init() }
@@ -792,6 +1570,78 @@ func file_ligato_vpp_vpp_proto_init() {
 				return nil
 			}
 		}
+		file_ligato_vpp_vpp_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_vpp_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_vpp_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufferStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_vpp_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_vpp_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InterfaceRates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ligato_vpp_vpp_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeRates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -799,7 +1649,7 @@ func file_ligato_vpp_vpp_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ligato_vpp_vpp_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -56,6 +56,11 @@ type (
 	DNAT44           = vpp_nat.DNat44
 	Nat44AddressPool = vpp_nat.Nat44AddressPool
 	Nat44Interface   = vpp_nat.Nat44Interface
+	Nat64IPv6Prefix  = vpp_nat.Nat64IPv6Prefix
+	Nat64Interface   = vpp_nat.Nat64Interface
+	Nat64AddressPool = vpp_nat.Nat64AddressPool
+	Nat64StaticBIB   = vpp_nat.Nat64StaticBIB
+	Det44Mapping     = vpp_nat.Det44Mapping
 
 	// IPSec
 	IPSecSPD = vpp_ipsec.SecurityPolicyDatabase